@@ -0,0 +1,26 @@
+package access
+
+import (
+	"context"
+)
+
+// Цели доступа к данным пациентов
+const (
+	PurposeTreatment      = "лечение"
+	PurposeAdministration = "администрирование"
+	PurposeUnknown        = "не указана"
+)
+
+type purposeCtx struct{}
+
+func GetPurposeFromCtx(ctx context.Context) string {
+	purpose, ok := ctx.Value(purposeCtx{}).(string)
+	if !ok {
+		return PurposeUnknown
+	}
+	return purpose
+}
+
+func SetPurposeToCtx(ctx context.Context, purpose string) context.Context {
+	return context.WithValue(ctx, purposeCtx{}, purpose)
+}
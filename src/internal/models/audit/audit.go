@@ -0,0 +1,10 @@
+package audit
+
+// Change - значение поля до и после изменения записи
+type Change struct {
+	Before any `json:"before,omitempty"`
+	After  any `json:"after,omitempty"`
+}
+
+// Changes - изменения записи по именам полей
+type Changes map[string]Change
@@ -1,26 +1,77 @@
 package errors
 
+import "errors"
+
+// Const - ошибка со стабильным кодом. Код не зависит от текста сообщения:
+// представление переводит его на язык пользователя, Error() дает текст для журналов.
 type Const string
 
 func (e Const) Error() string {
+	if msg, ok := messages[e]; ok {
+		return msg
+	}
+	return string(e)
+}
+
+// Code - стабильный код ошибки
+func (e Const) Code() string {
 	return string(e)
 }
 
 const (
-	ErrIdValidate = Const("неверно указан Id объекта")
+	ErrIdValidate = Const("id_validate")
 
-	ErrInvalidToken = Const("некорректный токен")
+	ErrInvalidToken = Const("invalid_token")
 
 	MsgBadRequest = "ошибка параметров запроса"
-	ErrBadRequest = Const(MsgBadRequest)
+	ErrBadRequest = Const("bad_request")
 
 	MsgJsonUnMarshal = "не удалось декодировать JSON"
-	ErrJsonUnMarshal = Const(MsgJsonUnMarshal)
+	ErrJsonUnMarshal = Const("json_unmarshal")
 	MsgJsonMarshal   = "не удалось упаковать данные в JSON"
-	ErrJsonMarshal   = Const(MsgJsonMarshal)
+	ErrJsonMarshal   = Const("json_marshal")
+
+	ErrDatabaseRecordNotFound = Const("record_not_found")
+	ErrUniqueViolation        = Const("unique_violation")
+
+	ErrValidation = Const("validation")
+
+	ErrAccessDenied = Const("access_denied")
 
-	ErrDatabaseRecordNotFound = Const("запись не найдена")
-	ErrUniqueViolation        = Const("нарушение уникальности ключа")
+	ErrUnauthorized   = Const("unauthorized")
+	ErrSessionExpired = Const("session_expired")
+	ErrNotRegistered  = Const("not_registered")
 
-	ErrAccessDenied = Const("недостаточно прав")
+	ErrConfirmExpired = Const("confirm_expired")
+
+	ErrCallbackForeign = Const("callback_foreign")
 )
+
+// CodeInternal - код ошибок, у которых нет собственного кода
+const CodeInternal = "internal"
+
+var messages = map[Const]string{
+	ErrIdValidate:             "неверно указан Id объекта",
+	ErrInvalidToken:           "некорректный токен",
+	ErrBadRequest:             MsgBadRequest,
+	ErrJsonUnMarshal:          MsgJsonUnMarshal,
+	ErrJsonMarshal:            MsgJsonMarshal,
+	ErrDatabaseRecordNotFound: "запись не найдена",
+	ErrUniqueViolation:        "нарушение уникальности ключа",
+	ErrValidation:             "недопустимые значения полей",
+	ErrAccessDenied:           "недостаточно прав",
+	ErrUnauthorized:           "пользователь не авторизован",
+	ErrSessionExpired:         "сессия истекла",
+	ErrNotRegistered:          "пользователь не зарегистрирован",
+	ErrConfirmExpired:         "время подтверждения истекло",
+	ErrCallbackForeign:        "кнопка предназначена другому пользователю",
+}
+
+// Code - код первой ошибки Const в цепочке err или CodeInternal
+func Code(err error) string {
+	var c Const
+	if errors.As(err, &c) {
+		return c.Code()
+	}
+	return CodeInternal
+}
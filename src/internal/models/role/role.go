@@ -0,0 +1,28 @@
+package role
+
+const (
+	Admin         = "Администратор"
+	HeadPhysician = "Глав врач"
+	Doctor        = "Врач"
+	Nurse         = "Медсестра"
+)
+
+// Rank - старшинство роли: менять роль можно только тем, кто младше. Неизвестная роль младше всех.
+func Rank(role string) int {
+	switch role {
+	case Admin:
+		return 4
+	case HeadPhysician:
+		return 3
+	case Doctor:
+		return 2
+	case Nurse:
+		return 1
+	}
+	return 0
+}
+
+// IsAdmin - видит ли роль данные всех отделений и может ли управлять справочниками
+func IsAdmin(role string) bool {
+	return role == Admin || role == HeadPhysician
+}
@@ -5,8 +5,11 @@ import (
 )
 
 type Session struct {
-	SessionID string
-	UserId    int
+	SessionID      string
+	UserId         int
+	Role           string
+	DepartmentId   int
+	OrganizationId int
 }
 
 type sessionCtx struct{}
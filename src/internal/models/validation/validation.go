@@ -0,0 +1,120 @@
+package validation
+
+import (
+	"fmt"
+	"hospital/internal/models/errors"
+	"strings"
+)
+
+// Коды нарушений; представление переводит их в сообщение на языке пользователя
+const (
+	CodeRequired = "required"
+	CodePositive = "positive"
+	CodeRange    = "range"
+	CodeInvalid  = "invalid"
+)
+
+// messages - сообщения по умолчанию: подпись поля и аргументы кода
+var messages = map[string]string{
+	CodeRequired: "%s: обязательное поле",
+	CodePositive: "%s: должно быть больше 0",
+	CodeRange:    "%s: допустимые значения от %v до %v",
+	CodeInvalid:  "%s: недопустимое значение",
+}
+
+// FieldError - недопустимое значение поля DTO. Field - имя поля структуры,
+// Label - подпись поля, Code и Args - нарушение и его параметры,
+// Message - сообщение для пользователя на языке по умолчанию.
+type FieldError struct {
+	Field   string
+	Label   string
+	Code    string
+	Args    []interface{}
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return e.Message
+}
+
+// Errors - ошибки всех полей, не прошедших проверку
+type Errors []*FieldError
+
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i := range e {
+		messages[i] = e[i].Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Unwrap позволяет проверять ошибку через errors.Is(err, errors.ErrValidation)
+func (e Errors) Unwrap() error {
+	return errors.ErrValidation
+}
+
+// Field возвращает ошибку поля или nil, если поле корректно
+func (e Errors) Field(name string) *FieldError {
+	for i := range e {
+		if strings.EqualFold(e[i].Field, name) {
+			return e[i]
+		}
+	}
+	return nil
+}
+
+// Validator собирает ошибки полей
+type Validator struct {
+	errs Errors
+}
+
+// Check добавляет ошибку поля с готовым сообщением, если условие не выполнено.
+// Такое сообщение показывается пользователю без перевода.
+func (v *Validator) Check(ok bool, field string, format string, args ...interface{}) {
+	if !ok {
+		v.errs = append(v.errs, &FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+}
+
+// CheckCode добавляет ошибку поля с кодом code, если условие не выполнено;
+// по коду и подписи поля представление переводит сообщение
+func (v *Validator) CheckCode(ok bool, field string, label string, code string, args ...interface{}) {
+	if ok {
+		return
+	}
+	format, found := messages[code]
+	if !found {
+		format = messages[CodeInvalid]
+	}
+	v.errs = append(v.errs, &FieldError{
+		Field:   field,
+		Label:   label,
+		Code:    code,
+		Args:    args,
+		Message: fmt.Sprintf(format, append([]interface{}{label}, args...)...),
+	})
+}
+
+func (v *Validator) Required(field string, label string, value string) {
+	v.CheckCode(strings.TrimSpace(value) != "", field, label, CodeRequired)
+}
+
+func (v *Validator) Positive(field string, label string, value int) {
+	v.CheckCode(value > 0, field, label, CodePositive)
+}
+
+func (v *Validator) IntRange(field string, label string, value int, min int, max int) {
+	v.CheckCode(value >= min && value <= max, field, label, CodeRange, min, max)
+}
+
+func (v *Validator) FloatRange(field string, label string, value float64, min float64, max float64) {
+	v.CheckCode(value >= min && value <= max, field, label, CodeRange, min, max)
+}
+
+// Err возвращает Errors или nil, если все поля корректны
+func (v *Validator) Err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
@@ -3,11 +3,17 @@ package config
 import (
 	"github.com/kelseyhightower/envconfig"
 	"go.uber.org/zap"
+	"time"
 )
 
 type Config struct {
+	// Secret подписывает кнопки бота; обязателен и одинаков на всех экземплярах бота
 	Secret string `envconfig:"SECRET"`
 
+	// Врачи с этими Telegram ID регистрируются администраторами без клиники: они заводят
+	// первые клиники и отделения и назначают в них администраторов
+	BootstrapAdmins []string `envconfig:"BOOTSTRAP_ADMINS"`
+
 	DBDriver     string `envconfig:"DB_DRIVER" default:"postgres"`
 	DBConnection string `envconfig:"DB_CONN_STRING"`
 
@@ -23,6 +29,62 @@ type Config struct {
 	TraceSQLCommands bool
 
 	TelegramToken string `envconfig:"TELEGRAM_APITOKEN"`
+	TelegramMode  string `envconfig:"TELEGRAM_MODE" default:"polling" validate:"oneof=polling webhook"`
+	// TelegramAPIEndpoint - шаблон адреса методов Bot API; в тестах указывает на локальный сервер
+	TelegramAPIEndpoint string `envconfig:"TELEGRAM_API_ENDPOINT" default:"https://api.telegram.org/bot%s/%s"`
+
+	// WebhookURL - публичный адрес, на который Telegram присылает обновления
+	WebhookURL    string `envconfig:"WEBHOOK_URL"`
+	WebhookListen string `envconfig:"WEBHOOK_LISTEN" default:"0.0.0.0:8443"`
+	WebhookSecret string `envconfig:"WEBHOOK_SECRET"`
+	// Без сертификата и ключа слушаем HTTP: TLS завершается на обратном прокси
+	WebhookCert           string `envconfig:"WEBHOOK_CERT"`
+	WebhookKey            string `envconfig:"WEBHOOK_KEY"`
+	WebhookSelfSigned     bool   `envconfig:"WEBHOOK_SELF_SIGNED" default:"false"`
+	WebhookMaxConnections int    `envconfig:"WEBHOOK_MAX_CONNECTIONS" default:"40"`
+
+	SessionIdleTimeout time.Duration `envconfig:"SESSION_IDLE_TIMEOUT" default:"30m"`
+	SessionTTL         time.Duration `envconfig:"SESSION_TTL" default:"12h"`
+
+	AccessAnomalyThreshold int           `envconfig:"ACCESS_ANOMALY_THRESHOLD" default:"20"`
+	AccessAnomalyWindow    time.Duration `envconfig:"ACCESS_ANOMALY_WINDOW" default:"24h"`
+
+	ConfirmTTL time.Duration `envconfig:"CONFIRM_TTL" default:"2m"`
+
+	DialogIdleTimeout time.Duration `envconfig:"DIALOG_IDLE_TIMEOUT" default:"10m"`
+	// Диалоги, которые менялись позже DIALOG_RESUME_GRACE назад, при запуске бота не ставятся
+	// на паузу: их может вести другой экземпляр бота
+	DialogResumeGrace time.Duration `envconfig:"DIALOG_RESUME_GRACE" default:"1m"`
+
+	// Обновления разных чатов обрабатываются параллельно BOT_WORKERS обработчиками
+	BotWorkers        int           `envconfig:"BOT_WORKERS" default:"8"`
+	BotQueueSize      int           `envconfig:"BOT_QUEUE_SIZE" default:"1000"`
+	BotHandlerTimeout time.Duration `envconfig:"BOT_HANDLER_TIMEOUT" default:"30s"`
+	// Исходящие сообщения: не больше BOT_SEND_RATE в секунду на всех и BOT_CHAT_RATE в секунду
+	// в один чат с запасом BOT_CHAT_BURST подряд; временные ошибки повторяются BOT_SEND_RETRIES раз
+	BotSendRate    float64       `envconfig:"BOT_SEND_RATE" default:"25"`
+	BotChatRate    float64       `envconfig:"BOT_CHAT_RATE" default:"1"`
+	BotChatBurst   int           `envconfig:"BOT_CHAT_BURST" default:"3"`
+	BotSendRetries int           `envconfig:"BOT_SEND_RETRIES" default:"5"`
+	BotSendBackoff time.Duration `envconfig:"BOT_SEND_BACKOFF" default:"1s"`
+
+	// Время тишины врачей отсчитывается по часам ALERT_TIMEZONE
+	AlertTimezone     string        `envconfig:"ALERT_TIMEZONE" default:"Europe/Moscow"`
+	AlertPollInterval time.Duration `envconfig:"ALERT_POLL_INTERVAL" default:"15s"`
+	AlertMaxAttempts  int           `envconfig:"ALERT_MAX_ATTEMPTS" default:"5"`
+	// Забранное на отправку уведомление выдается снова, если за ALERT_CLAIM_TIMEOUT бот его не отправил
+	AlertClaimTimeout time.Duration `envconfig:"ALERT_CLAIM_TIMEOUT" default:"5m"`
+	// Неподтвержденное критическое уведомление пересылается дежурным врачам, затем главврачу;
+	// время отсчитывается от отправки, 0 отключает шаг
+	AlertEscalateOnCallAfter time.Duration `envconfig:"ALERT_ESCALATE_ON_CALL_AFTER" default:"10m"`
+	AlertEscalateHeadAfter   time.Duration `envconfig:"ALERT_ESCALATE_HEAD_AFTER" default:"30m"`
+	AlertReportWindow        time.Duration `envconfig:"ALERT_REPORT_WINDOW" default:"168h"`
+
+	// Расписания фоновых задач отсчитываются по часам SCHEDULER_TIMEZONE
+	SchedulerTimezone string `envconfig:"SCHEDULER_TIMEZONE" default:"Europe/Moscow"`
+	// Упавшая задача повторяется SCHEDULER_RETRIES раз, пауза перед повтором каждый раз удваивается
+	SchedulerRetries      int           `envconfig:"SCHEDULER_RETRIES" default:"3"`
+	SchedulerRetryBackoff time.Duration `envconfig:"SCHEDULER_RETRY_BACKOFF" default:"30s"`
 }
 
 func NewConfig(logger *zap.Logger, logLevel zap.AtomicLevel) (Config, error) {
@@ -7,11 +7,12 @@ import (
 	"go.uber.org/zap"
 	"hospital/internal/modules/config"
 	"hospital/internal/modules/db/ent"
+	"hospital/internal/modules/db/ent/privacy"
 
 	_ "hospital/internal/modules/db/ent/runtime"
 )
 
-//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --target ./ent ./schema
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --feature privacy,entql --target ./ent ./schema
 
 func NewDBClient(cfg config.Config, logger *zap.Logger) (*ent.Client, error) {
 	client, err := connectDB(cfg, logger)
@@ -38,20 +39,111 @@ func InvokeDBClient(client *ent.Client, cfg config.Config, lifecycle fx.Lifecycl
 	return nil
 }
 
+// SystemContext отключает проверки прав для внутренних вызовов, не связанных с пользователем
+func SystemContext(ctx context.Context) context.Context {
+	return privacy.DecisionContext(ctx, privacy.Allow)
+}
+
+// TxClient возвращает клиент транзакции из контекста, а вне транзакции - client.
+// Так записи, которые хуки создают по ходу изменения, сохраняются вместе с ним.
+func TxClient(ctx context.Context, client *ent.Client) *ent.Client {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+	return client
+}
+
+// WithTx выполняет fn в одной транзакции: если fn вернула ошибку, изменения откатываются
+func WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return WrapError(err)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if err = fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: ошибка отката транзакции: %v", err, rerr)
+		}
+		return err
+	}
+	return WrapError(tx.Commit())
+}
+
 func TruncateAll(client *ent.Client) error {
-	_, err := client.Patient.Delete().Exec(context.Background())
+	ctx := SystemContext(context.Background())
+
+	_, err := client.Session.Delete().Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Conversation.Delete().Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Patient.Delete().Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Doctor.Delete().Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Room.Delete().Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Disease.Delete().Exec(ctx)
 	if err != nil {
 		return err
 	}
 
-	_, err = client.Doctor.Delete().Exec(context.Background())
+	_, err = client.Alert.Delete().Exec(ctx)
 	if err != nil {
 		return err
 	}
 
-	_, err = client.Room.Delete().Exec(context.Background())
-	_, err = client.Disease.Delete().Exec(context.Background())
+	_, err = client.NotificationPreference.Delete().Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.JobState.Delete().Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.WardNotice.Delete().Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.WardChat.Delete().Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.ConfirmToken.Delete().Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Department.Delete().Exec(ctx)
+	if err != nil {
+		return err
+	}
 
+	_, err = client.Organization.Delete().Exec(ctx)
 	if err != nil {
 		return err
 	}
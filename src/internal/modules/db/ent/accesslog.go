@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"hospital/internal/modules/db/ent/accesslog"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AccessLog is the model entity for the AccessLog schema.
+type AccessLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// OrganizationId holds the value of the "organizationId" field.
+	OrganizationId int `json:"organizationId,omitempty"`
+	// ActorId holds the value of the "actorId" field.
+	ActorId *int `json:"actorId,omitempty"`
+	// SessionId holds the value of the "sessionId" field.
+	SessionId string `json:"sessionId,omitempty"`
+	// PatientId holds the value of the "patientId" field.
+	PatientId int `json:"patientId,omitempty"`
+	// Action holds the value of the "action" field.
+	Action string `json:"action,omitempty"`
+	// Purpose holds the value of the "purpose" field.
+	Purpose string `json:"purpose,omitempty"`
+	// CreatedAt holds the value of the "createdAt" field.
+	CreatedAt    time.Time `json:"createdAt,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AccessLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case accesslog.FieldID, accesslog.FieldOrganizationId, accesslog.FieldActorId, accesslog.FieldPatientId:
+			values[i] = new(sql.NullInt64)
+		case accesslog.FieldSessionId, accesslog.FieldAction, accesslog.FieldPurpose:
+			values[i] = new(sql.NullString)
+		case accesslog.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AccessLog fields.
+func (al *AccessLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case accesslog.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			al.ID = int(value.Int64)
+		case accesslog.FieldOrganizationId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field organizationId", values[i])
+			} else if value.Valid {
+				al.OrganizationId = int(value.Int64)
+			}
+		case accesslog.FieldActorId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field actorId", values[i])
+			} else if value.Valid {
+				al.ActorId = new(int)
+				*al.ActorId = int(value.Int64)
+			}
+		case accesslog.FieldSessionId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sessionId", values[i])
+			} else if value.Valid {
+				al.SessionId = value.String
+			}
+		case accesslog.FieldPatientId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field patientId", values[i])
+			} else if value.Valid {
+				al.PatientId = int(value.Int64)
+			}
+		case accesslog.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				al.Action = value.String
+			}
+		case accesslog.FieldPurpose:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field purpose", values[i])
+			} else if value.Valid {
+				al.Purpose = value.String
+			}
+		case accesslog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field createdAt", values[i])
+			} else if value.Valid {
+				al.CreatedAt = value.Time
+			}
+		default:
+			al.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AccessLog.
+// This includes values selected through modifiers, order, etc.
+func (al *AccessLog) Value(name string) (ent.Value, error) {
+	return al.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AccessLog.
+// Note that you need to call AccessLog.Unwrap() before calling this method if this AccessLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (al *AccessLog) Update() *AccessLogUpdateOne {
+	return NewAccessLogClient(al.config).UpdateOne(al)
+}
+
+// Unwrap unwraps the AccessLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (al *AccessLog) Unwrap() *AccessLog {
+	_tx, ok := al.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AccessLog is not a transactional entity")
+	}
+	al.config.driver = _tx.drv
+	return al
+}
+
+// String implements the fmt.Stringer.
+func (al *AccessLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("AccessLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", al.ID))
+	builder.WriteString("organizationId=")
+	builder.WriteString(fmt.Sprintf("%v", al.OrganizationId))
+	builder.WriteString(", ")
+	if v := al.ActorId; v != nil {
+		builder.WriteString("actorId=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("sessionId=")
+	builder.WriteString(al.SessionId)
+	builder.WriteString(", ")
+	builder.WriteString("patientId=")
+	builder.WriteString(fmt.Sprintf("%v", al.PatientId))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(al.Action)
+	builder.WriteString(", ")
+	builder.WriteString("purpose=")
+	builder.WriteString(al.Purpose)
+	builder.WriteString(", ")
+	builder.WriteString("createdAt=")
+	builder.WriteString(al.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AccessLogs is a parsable slice of AccessLog.
+type AccessLogs []*AccessLog
@@ -0,0 +1,110 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accesslog
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the accesslog type in the database.
+	Label = "access_log"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOrganizationId holds the string denoting the organizationid field in the database.
+	FieldOrganizationId = "organization_id"
+	// FieldActorId holds the string denoting the actorid field in the database.
+	FieldActorId = "actor_id"
+	// FieldSessionId holds the string denoting the sessionid field in the database.
+	FieldSessionId = "session_id"
+	// FieldPatientId holds the string denoting the patientid field in the database.
+	FieldPatientId = "patient_id"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldPurpose holds the string denoting the purpose field in the database.
+	FieldPurpose = "purpose"
+	// FieldCreatedAt holds the string denoting the createdat field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the accesslog in the database.
+	Table = "access_logs"
+)
+
+// Columns holds all SQL columns for accesslog fields.
+var Columns = []string{
+	FieldID,
+	FieldOrganizationId,
+	FieldActorId,
+	FieldSessionId,
+	FieldPatientId,
+	FieldAction,
+	FieldPurpose,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "hospital/internal/modules/db/ent/runtime"
+var (
+	Hooks  [3]ent.Hook
+	Policy ent.Policy
+	// DefaultCreatedAt holds the default value on creation for the "createdAt" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Order defines the ordering method for the AccessLog queries.
+type Order func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByOrganizationId orders the results by the organizationId field.
+func ByOrganizationId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldOrganizationId, opts...).ToFunc()
+}
+
+// ByActorId orders the results by the actorId field.
+func ByActorId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldActorId, opts...).ToFunc()
+}
+
+// BySessionId orders the results by the sessionId field.
+func BySessionId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldSessionId, opts...).ToFunc()
+}
+
+// ByPatientId orders the results by the patientId field.
+func ByPatientId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldPatientId, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByPurpose orders the results by the purpose field.
+func ByPurpose(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldPurpose, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the createdAt field.
+func ByCreatedAt(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,507 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accesslog
+
+import (
+	"hospital/internal/modules/db/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldLTE(FieldID, id))
+}
+
+// OrganizationId applies equality check predicate on the "organizationId" field. It's identical to OrganizationIdEQ.
+func OrganizationId(v int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldEQ(FieldOrganizationId, v))
+}
+
+// ActorId applies equality check predicate on the "actorId" field. It's identical to ActorIdEQ.
+func ActorId(v int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldEQ(FieldActorId, v))
+}
+
+// SessionId applies equality check predicate on the "sessionId" field. It's identical to SessionIdEQ.
+func SessionId(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldEQ(FieldSessionId, v))
+}
+
+// PatientId applies equality check predicate on the "patientId" field. It's identical to PatientIdEQ.
+func PatientId(v int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldEQ(FieldPatientId, v))
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldEQ(FieldAction, v))
+}
+
+// Purpose applies equality check predicate on the "purpose" field. It's identical to PurposeEQ.
+func Purpose(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldEQ(FieldPurpose, v))
+}
+
+// CreatedAt applies equality check predicate on the "createdAt" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// OrganizationIdEQ applies the EQ predicate on the "organizationId" field.
+func OrganizationIdEQ(v int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldEQ(FieldOrganizationId, v))
+}
+
+// OrganizationIdNEQ applies the NEQ predicate on the "organizationId" field.
+func OrganizationIdNEQ(v int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldNEQ(FieldOrganizationId, v))
+}
+
+// OrganizationIdIn applies the In predicate on the "organizationId" field.
+func OrganizationIdIn(vs ...int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldIn(FieldOrganizationId, vs...))
+}
+
+// OrganizationIdNotIn applies the NotIn predicate on the "organizationId" field.
+func OrganizationIdNotIn(vs ...int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldNotIn(FieldOrganizationId, vs...))
+}
+
+// OrganizationIdGT applies the GT predicate on the "organizationId" field.
+func OrganizationIdGT(v int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldGT(FieldOrganizationId, v))
+}
+
+// OrganizationIdGTE applies the GTE predicate on the "organizationId" field.
+func OrganizationIdGTE(v int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldGTE(FieldOrganizationId, v))
+}
+
+// OrganizationIdLT applies the LT predicate on the "organizationId" field.
+func OrganizationIdLT(v int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldLT(FieldOrganizationId, v))
+}
+
+// OrganizationIdLTE applies the LTE predicate on the "organizationId" field.
+func OrganizationIdLTE(v int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldLTE(FieldOrganizationId, v))
+}
+
+// OrganizationIdIsNil applies the IsNil predicate on the "organizationId" field.
+func OrganizationIdIsNil() predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldIsNull(FieldOrganizationId))
+}
+
+// OrganizationIdNotNil applies the NotNil predicate on the "organizationId" field.
+func OrganizationIdNotNil() predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldNotNull(FieldOrganizationId))
+}
+
+// ActorIdEQ applies the EQ predicate on the "actorId" field.
+func ActorIdEQ(v int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldEQ(FieldActorId, v))
+}
+
+// ActorIdNEQ applies the NEQ predicate on the "actorId" field.
+func ActorIdNEQ(v int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldNEQ(FieldActorId, v))
+}
+
+// ActorIdIn applies the In predicate on the "actorId" field.
+func ActorIdIn(vs ...int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldIn(FieldActorId, vs...))
+}
+
+// ActorIdNotIn applies the NotIn predicate on the "actorId" field.
+func ActorIdNotIn(vs ...int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldNotIn(FieldActorId, vs...))
+}
+
+// ActorIdGT applies the GT predicate on the "actorId" field.
+func ActorIdGT(v int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldGT(FieldActorId, v))
+}
+
+// ActorIdGTE applies the GTE predicate on the "actorId" field.
+func ActorIdGTE(v int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldGTE(FieldActorId, v))
+}
+
+// ActorIdLT applies the LT predicate on the "actorId" field.
+func ActorIdLT(v int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldLT(FieldActorId, v))
+}
+
+// ActorIdLTE applies the LTE predicate on the "actorId" field.
+func ActorIdLTE(v int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldLTE(FieldActorId, v))
+}
+
+// ActorIdIsNil applies the IsNil predicate on the "actorId" field.
+func ActorIdIsNil() predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldIsNull(FieldActorId))
+}
+
+// ActorIdNotNil applies the NotNil predicate on the "actorId" field.
+func ActorIdNotNil() predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldNotNull(FieldActorId))
+}
+
+// SessionIdEQ applies the EQ predicate on the "sessionId" field.
+func SessionIdEQ(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldEQ(FieldSessionId, v))
+}
+
+// SessionIdNEQ applies the NEQ predicate on the "sessionId" field.
+func SessionIdNEQ(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldNEQ(FieldSessionId, v))
+}
+
+// SessionIdIn applies the In predicate on the "sessionId" field.
+func SessionIdIn(vs ...string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldIn(FieldSessionId, vs...))
+}
+
+// SessionIdNotIn applies the NotIn predicate on the "sessionId" field.
+func SessionIdNotIn(vs ...string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldNotIn(FieldSessionId, vs...))
+}
+
+// SessionIdGT applies the GT predicate on the "sessionId" field.
+func SessionIdGT(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldGT(FieldSessionId, v))
+}
+
+// SessionIdGTE applies the GTE predicate on the "sessionId" field.
+func SessionIdGTE(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldGTE(FieldSessionId, v))
+}
+
+// SessionIdLT applies the LT predicate on the "sessionId" field.
+func SessionIdLT(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldLT(FieldSessionId, v))
+}
+
+// SessionIdLTE applies the LTE predicate on the "sessionId" field.
+func SessionIdLTE(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldLTE(FieldSessionId, v))
+}
+
+// SessionIdContains applies the Contains predicate on the "sessionId" field.
+func SessionIdContains(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldContains(FieldSessionId, v))
+}
+
+// SessionIdHasPrefix applies the HasPrefix predicate on the "sessionId" field.
+func SessionIdHasPrefix(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldHasPrefix(FieldSessionId, v))
+}
+
+// SessionIdHasSuffix applies the HasSuffix predicate on the "sessionId" field.
+func SessionIdHasSuffix(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldHasSuffix(FieldSessionId, v))
+}
+
+// SessionIdIsNil applies the IsNil predicate on the "sessionId" field.
+func SessionIdIsNil() predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldIsNull(FieldSessionId))
+}
+
+// SessionIdNotNil applies the NotNil predicate on the "sessionId" field.
+func SessionIdNotNil() predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldNotNull(FieldSessionId))
+}
+
+// SessionIdEqualFold applies the EqualFold predicate on the "sessionId" field.
+func SessionIdEqualFold(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldEqualFold(FieldSessionId, v))
+}
+
+// SessionIdContainsFold applies the ContainsFold predicate on the "sessionId" field.
+func SessionIdContainsFold(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldContainsFold(FieldSessionId, v))
+}
+
+// PatientIdEQ applies the EQ predicate on the "patientId" field.
+func PatientIdEQ(v int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldEQ(FieldPatientId, v))
+}
+
+// PatientIdNEQ applies the NEQ predicate on the "patientId" field.
+func PatientIdNEQ(v int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldNEQ(FieldPatientId, v))
+}
+
+// PatientIdIn applies the In predicate on the "patientId" field.
+func PatientIdIn(vs ...int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldIn(FieldPatientId, vs...))
+}
+
+// PatientIdNotIn applies the NotIn predicate on the "patientId" field.
+func PatientIdNotIn(vs ...int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldNotIn(FieldPatientId, vs...))
+}
+
+// PatientIdGT applies the GT predicate on the "patientId" field.
+func PatientIdGT(v int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldGT(FieldPatientId, v))
+}
+
+// PatientIdGTE applies the GTE predicate on the "patientId" field.
+func PatientIdGTE(v int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldGTE(FieldPatientId, v))
+}
+
+// PatientIdLT applies the LT predicate on the "patientId" field.
+func PatientIdLT(v int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldLT(FieldPatientId, v))
+}
+
+// PatientIdLTE applies the LTE predicate on the "patientId" field.
+func PatientIdLTE(v int) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldLTE(FieldPatientId, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldGT(FieldAction, v))
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldGTE(FieldAction, v))
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldLT(FieldAction, v))
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldLTE(FieldAction, v))
+}
+
+// ActionContains applies the Contains predicate on the "action" field.
+func ActionContains(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldContains(FieldAction, v))
+}
+
+// ActionHasPrefix applies the HasPrefix predicate on the "action" field.
+func ActionHasPrefix(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldHasPrefix(FieldAction, v))
+}
+
+// ActionHasSuffix applies the HasSuffix predicate on the "action" field.
+func ActionHasSuffix(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldHasSuffix(FieldAction, v))
+}
+
+// ActionEqualFold applies the EqualFold predicate on the "action" field.
+func ActionEqualFold(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldEqualFold(FieldAction, v))
+}
+
+// ActionContainsFold applies the ContainsFold predicate on the "action" field.
+func ActionContainsFold(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldContainsFold(FieldAction, v))
+}
+
+// PurposeEQ applies the EQ predicate on the "purpose" field.
+func PurposeEQ(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldEQ(FieldPurpose, v))
+}
+
+// PurposeNEQ applies the NEQ predicate on the "purpose" field.
+func PurposeNEQ(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldNEQ(FieldPurpose, v))
+}
+
+// PurposeIn applies the In predicate on the "purpose" field.
+func PurposeIn(vs ...string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldIn(FieldPurpose, vs...))
+}
+
+// PurposeNotIn applies the NotIn predicate on the "purpose" field.
+func PurposeNotIn(vs ...string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldNotIn(FieldPurpose, vs...))
+}
+
+// PurposeGT applies the GT predicate on the "purpose" field.
+func PurposeGT(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldGT(FieldPurpose, v))
+}
+
+// PurposeGTE applies the GTE predicate on the "purpose" field.
+func PurposeGTE(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldGTE(FieldPurpose, v))
+}
+
+// PurposeLT applies the LT predicate on the "purpose" field.
+func PurposeLT(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldLT(FieldPurpose, v))
+}
+
+// PurposeLTE applies the LTE predicate on the "purpose" field.
+func PurposeLTE(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldLTE(FieldPurpose, v))
+}
+
+// PurposeContains applies the Contains predicate on the "purpose" field.
+func PurposeContains(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldContains(FieldPurpose, v))
+}
+
+// PurposeHasPrefix applies the HasPrefix predicate on the "purpose" field.
+func PurposeHasPrefix(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldHasPrefix(FieldPurpose, v))
+}
+
+// PurposeHasSuffix applies the HasSuffix predicate on the "purpose" field.
+func PurposeHasSuffix(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldHasSuffix(FieldPurpose, v))
+}
+
+// PurposeEqualFold applies the EqualFold predicate on the "purpose" field.
+func PurposeEqualFold(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldEqualFold(FieldPurpose, v))
+}
+
+// PurposeContainsFold applies the ContainsFold predicate on the "purpose" field.
+func PurposeContainsFold(v string) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldContainsFold(FieldPurpose, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "createdAt" field.
+func CreatedAtEQ(v time.Time) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "createdAt" field.
+func CreatedAtNEQ(v time.Time) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "createdAt" field.
+func CreatedAtIn(vs ...time.Time) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "createdAt" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "createdAt" field.
+func CreatedAtGT(v time.Time) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "createdAt" field.
+func CreatedAtGTE(v time.Time) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "createdAt" field.
+func CreatedAtLT(v time.Time) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "createdAt" field.
+func CreatedAtLTE(v time.Time) predicate.AccessLog {
+	return predicate.AccessLog(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AccessLog) predicate.AccessLog {
+	return predicate.AccessLog(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AccessLog) predicate.AccessLog {
+	return predicate.AccessLog(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AccessLog) predicate.AccessLog {
+	return predicate.AccessLog(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,297 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hospital/internal/modules/db/ent/accesslog"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AccessLogCreate is the builder for creating a AccessLog entity.
+type AccessLogCreate struct {
+	config
+	mutation *AccessLogMutation
+	hooks    []Hook
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (alc *AccessLogCreate) SetOrganizationId(i int) *AccessLogCreate {
+	alc.mutation.SetOrganizationId(i)
+	return alc
+}
+
+// SetNillableOrganizationId sets the "organizationId" field if the given value is not nil.
+func (alc *AccessLogCreate) SetNillableOrganizationId(i *int) *AccessLogCreate {
+	if i != nil {
+		alc.SetOrganizationId(*i)
+	}
+	return alc
+}
+
+// SetActorId sets the "actorId" field.
+func (alc *AccessLogCreate) SetActorId(i int) *AccessLogCreate {
+	alc.mutation.SetActorId(i)
+	return alc
+}
+
+// SetNillableActorId sets the "actorId" field if the given value is not nil.
+func (alc *AccessLogCreate) SetNillableActorId(i *int) *AccessLogCreate {
+	if i != nil {
+		alc.SetActorId(*i)
+	}
+	return alc
+}
+
+// SetSessionId sets the "sessionId" field.
+func (alc *AccessLogCreate) SetSessionId(s string) *AccessLogCreate {
+	alc.mutation.SetSessionId(s)
+	return alc
+}
+
+// SetNillableSessionId sets the "sessionId" field if the given value is not nil.
+func (alc *AccessLogCreate) SetNillableSessionId(s *string) *AccessLogCreate {
+	if s != nil {
+		alc.SetSessionId(*s)
+	}
+	return alc
+}
+
+// SetPatientId sets the "patientId" field.
+func (alc *AccessLogCreate) SetPatientId(i int) *AccessLogCreate {
+	alc.mutation.SetPatientId(i)
+	return alc
+}
+
+// SetAction sets the "action" field.
+func (alc *AccessLogCreate) SetAction(s string) *AccessLogCreate {
+	alc.mutation.SetAction(s)
+	return alc
+}
+
+// SetPurpose sets the "purpose" field.
+func (alc *AccessLogCreate) SetPurpose(s string) *AccessLogCreate {
+	alc.mutation.SetPurpose(s)
+	return alc
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (alc *AccessLogCreate) SetCreatedAt(t time.Time) *AccessLogCreate {
+	alc.mutation.SetCreatedAt(t)
+	return alc
+}
+
+// SetNillableCreatedAt sets the "createdAt" field if the given value is not nil.
+func (alc *AccessLogCreate) SetNillableCreatedAt(t *time.Time) *AccessLogCreate {
+	if t != nil {
+		alc.SetCreatedAt(*t)
+	}
+	return alc
+}
+
+// Mutation returns the AccessLogMutation object of the builder.
+func (alc *AccessLogCreate) Mutation() *AccessLogMutation {
+	return alc.mutation
+}
+
+// Save creates the AccessLog in the database.
+func (alc *AccessLogCreate) Save(ctx context.Context) (*AccessLog, error) {
+	if err := alc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks[*AccessLog, AccessLogMutation](ctx, alc.sqlSave, alc.mutation, alc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (alc *AccessLogCreate) SaveX(ctx context.Context) *AccessLog {
+	v, err := alc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alc *AccessLogCreate) Exec(ctx context.Context) error {
+	_, err := alc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alc *AccessLogCreate) ExecX(ctx context.Context) {
+	if err := alc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (alc *AccessLogCreate) defaults() error {
+	if _, ok := alc.mutation.CreatedAt(); !ok {
+		if accesslog.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized accesslog.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := accesslog.DefaultCreatedAt()
+		alc.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (alc *AccessLogCreate) check() error {
+	if _, ok := alc.mutation.PatientId(); !ok {
+		return &ValidationError{Name: "patientId", err: errors.New(`ent: missing required field "AccessLog.patientId"`)}
+	}
+	if _, ok := alc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "AccessLog.action"`)}
+	}
+	if _, ok := alc.mutation.Purpose(); !ok {
+		return &ValidationError{Name: "purpose", err: errors.New(`ent: missing required field "AccessLog.purpose"`)}
+	}
+	if _, ok := alc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "createdAt", err: errors.New(`ent: missing required field "AccessLog.createdAt"`)}
+	}
+	return nil
+}
+
+func (alc *AccessLogCreate) sqlSave(ctx context.Context) (*AccessLog, error) {
+	if err := alc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := alc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, alc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	alc.mutation.id = &_node.ID
+	alc.mutation.done = true
+	return _node, nil
+}
+
+func (alc *AccessLogCreate) createSpec() (*AccessLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccessLog{config: alc.config}
+		_spec = sqlgraph.NewCreateSpec(accesslog.Table, sqlgraph.NewFieldSpec(accesslog.FieldID, field.TypeInt))
+	)
+	if value, ok := alc.mutation.OrganizationId(); ok {
+		_spec.SetField(accesslog.FieldOrganizationId, field.TypeInt, value)
+		_node.OrganizationId = value
+	}
+	if value, ok := alc.mutation.ActorId(); ok {
+		_spec.SetField(accesslog.FieldActorId, field.TypeInt, value)
+		_node.ActorId = &value
+	}
+	if value, ok := alc.mutation.SessionId(); ok {
+		_spec.SetField(accesslog.FieldSessionId, field.TypeString, value)
+		_node.SessionId = value
+	}
+	if value, ok := alc.mutation.PatientId(); ok {
+		_spec.SetField(accesslog.FieldPatientId, field.TypeInt, value)
+		_node.PatientId = value
+	}
+	if value, ok := alc.mutation.Action(); ok {
+		_spec.SetField(accesslog.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := alc.mutation.Purpose(); ok {
+		_spec.SetField(accesslog.FieldPurpose, field.TypeString, value)
+		_node.Purpose = value
+	}
+	if value, ok := alc.mutation.CreatedAt(); ok {
+		_spec.SetField(accesslog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AccessLogCreateBulk is the builder for creating many AccessLog entities in bulk.
+type AccessLogCreateBulk struct {
+	config
+	builders []*AccessLogCreate
+}
+
+// Save creates the AccessLog entities in the database.
+func (alcb *AccessLogCreateBulk) Save(ctx context.Context) ([]*AccessLog, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(alcb.builders))
+	nodes := make([]*AccessLog, len(alcb.builders))
+	mutators := make([]Mutator, len(alcb.builders))
+	for i := range alcb.builders {
+		func(i int, root context.Context) {
+			builder := alcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccessLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, alcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, alcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, alcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alcb *AccessLogCreateBulk) SaveX(ctx context.Context) []*AccessLog {
+	v, err := alcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alcb *AccessLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := alcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alcb *AccessLogCreateBulk) ExecX(ctx context.Context) {
+	if err := alcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hospital/internal/modules/db/ent/accesslog"
+	"hospital/internal/modules/db/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AccessLogUpdate is the builder for updating AccessLog entities.
+type AccessLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AccessLogMutation
+}
+
+// Where appends a list predicates to the AccessLogUpdate builder.
+func (alu *AccessLogUpdate) Where(ps ...predicate.AccessLog) *AccessLogUpdate {
+	alu.mutation.Where(ps...)
+	return alu
+}
+
+// Mutation returns the AccessLogMutation object of the builder.
+func (alu *AccessLogUpdate) Mutation() *AccessLogMutation {
+	return alu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (alu *AccessLogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks[int, AccessLogMutation](ctx, alu.sqlSave, alu.mutation, alu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alu *AccessLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := alu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (alu *AccessLogUpdate) Exec(ctx context.Context) error {
+	_, err := alu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alu *AccessLogUpdate) ExecX(ctx context.Context) {
+	if err := alu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (alu *AccessLogUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(accesslog.Table, accesslog.Columns, sqlgraph.NewFieldSpec(accesslog.FieldID, field.TypeInt))
+	if ps := alu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if alu.mutation.OrganizationIdCleared() {
+		_spec.ClearField(accesslog.FieldOrganizationId, field.TypeInt)
+	}
+	if alu.mutation.ActorIdCleared() {
+		_spec.ClearField(accesslog.FieldActorId, field.TypeInt)
+	}
+	if alu.mutation.SessionIdCleared() {
+		_spec.ClearField(accesslog.FieldSessionId, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, alu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accesslog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	alu.mutation.done = true
+	return n, nil
+}
+
+// AccessLogUpdateOne is the builder for updating a single AccessLog entity.
+type AccessLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AccessLogMutation
+}
+
+// Mutation returns the AccessLogMutation object of the builder.
+func (aluo *AccessLogUpdateOne) Mutation() *AccessLogMutation {
+	return aluo.mutation
+}
+
+// Where appends a list predicates to the AccessLogUpdate builder.
+func (aluo *AccessLogUpdateOne) Where(ps ...predicate.AccessLog) *AccessLogUpdateOne {
+	aluo.mutation.Where(ps...)
+	return aluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aluo *AccessLogUpdateOne) Select(field string, fields ...string) *AccessLogUpdateOne {
+	aluo.fields = append([]string{field}, fields...)
+	return aluo
+}
+
+// Save executes the query and returns the updated AccessLog entity.
+func (aluo *AccessLogUpdateOne) Save(ctx context.Context) (*AccessLog, error) {
+	return withHooks[*AccessLog, AccessLogMutation](ctx, aluo.sqlSave, aluo.mutation, aluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aluo *AccessLogUpdateOne) SaveX(ctx context.Context) *AccessLog {
+	node, err := aluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aluo *AccessLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := aluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aluo *AccessLogUpdateOne) ExecX(ctx context.Context) {
+	if err := aluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (aluo *AccessLogUpdateOne) sqlSave(ctx context.Context) (_node *AccessLog, err error) {
+	_spec := sqlgraph.NewUpdateSpec(accesslog.Table, accesslog.Columns, sqlgraph.NewFieldSpec(accesslog.FieldID, field.TypeInt))
+	id, ok := aluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AccessLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accesslog.FieldID)
+		for _, f := range fields {
+			if !accesslog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != accesslog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if aluo.mutation.OrganizationIdCleared() {
+		_spec.ClearField(accesslog.FieldOrganizationId, field.TypeInt)
+	}
+	if aluo.mutation.ActorIdCleared() {
+		_spec.ClearField(accesslog.FieldActorId, field.TypeInt)
+	}
+	if aluo.mutation.SessionIdCleared() {
+		_spec.ClearField(accesslog.FieldSessionId, field.TypeString)
+	}
+	_node = &AccessLog{config: aluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accesslog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aluo.mutation.done = true
+	return _node, nil
+}
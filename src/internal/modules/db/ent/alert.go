@@ -0,0 +1,294 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"hospital/internal/modules/db/ent/alert"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Alert is the model entity for the Alert schema.
+type Alert struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// OrganizationId holds the value of the "organizationId" field.
+	OrganizationId int `json:"organizationId,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// Severity holds the value of the "severity" field.
+	Severity string `json:"severity,omitempty"`
+	// PatientId holds the value of the "patientId" field.
+	PatientId int `json:"patientId,omitempty"`
+	// DoctorId holds the value of the "doctorId" field.
+	DoctorId int `json:"doctorId,omitempty"`
+	// Params holds the value of the "params" field.
+	Params map[string]string `json:"params,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// DeliverAfter holds the value of the "deliverAfter" field.
+	DeliverAfter *time.Time `json:"deliverAfter,omitempty"`
+	// SentAt holds the value of the "sentAt" field.
+	SentAt *time.Time `json:"sentAt,omitempty"`
+	// ClaimedUntil holds the value of the "claimedUntil" field.
+	ClaimedUntil *time.Time `json:"claimedUntil,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// AckedAt holds the value of the "ackedAt" field.
+	AckedAt *time.Time `json:"ackedAt,omitempty"`
+	// AckedBy holds the value of the "ackedBy" field.
+	AckedBy *int `json:"ackedBy,omitempty"`
+	// ParentId holds the value of the "parentId" field.
+	ParentId *int `json:"parentId,omitempty"`
+	// EscalationLevel holds the value of the "escalationLevel" field.
+	EscalationLevel int `json:"escalationLevel,omitempty"`
+	// CreatedAt holds the value of the "createdAt" field.
+	CreatedAt    time.Time `json:"createdAt,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Alert) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case alert.FieldParams:
+			values[i] = new([]byte)
+		case alert.FieldID, alert.FieldOrganizationId, alert.FieldPatientId, alert.FieldDoctorId, alert.FieldAttempts, alert.FieldAckedBy, alert.FieldParentId, alert.FieldEscalationLevel:
+			values[i] = new(sql.NullInt64)
+		case alert.FieldKind, alert.FieldSeverity, alert.FieldStatus:
+			values[i] = new(sql.NullString)
+		case alert.FieldDeliverAfter, alert.FieldSentAt, alert.FieldClaimedUntil, alert.FieldAckedAt, alert.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Alert fields.
+func (a *Alert) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case alert.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			a.ID = int(value.Int64)
+		case alert.FieldOrganizationId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field organizationId", values[i])
+			} else if value.Valid {
+				a.OrganizationId = int(value.Int64)
+			}
+		case alert.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				a.Kind = value.String
+			}
+		case alert.FieldSeverity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field severity", values[i])
+			} else if value.Valid {
+				a.Severity = value.String
+			}
+		case alert.FieldPatientId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field patientId", values[i])
+			} else if value.Valid {
+				a.PatientId = int(value.Int64)
+			}
+		case alert.FieldDoctorId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field doctorId", values[i])
+			} else if value.Valid {
+				a.DoctorId = int(value.Int64)
+			}
+		case alert.FieldParams:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field params", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.Params); err != nil {
+					return fmt.Errorf("unmarshal field params: %w", err)
+				}
+			}
+		case alert.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				a.Status = value.String
+			}
+		case alert.FieldDeliverAfter:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deliverAfter", values[i])
+			} else if value.Valid {
+				a.DeliverAfter = new(time.Time)
+				*a.DeliverAfter = value.Time
+			}
+		case alert.FieldSentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sentAt", values[i])
+			} else if value.Valid {
+				a.SentAt = new(time.Time)
+				*a.SentAt = value.Time
+			}
+		case alert.FieldClaimedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field claimedUntil", values[i])
+			} else if value.Valid {
+				a.ClaimedUntil = new(time.Time)
+				*a.ClaimedUntil = value.Time
+			}
+		case alert.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				a.Attempts = int(value.Int64)
+			}
+		case alert.FieldAckedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ackedAt", values[i])
+			} else if value.Valid {
+				a.AckedAt = new(time.Time)
+				*a.AckedAt = value.Time
+			}
+		case alert.FieldAckedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ackedBy", values[i])
+			} else if value.Valid {
+				a.AckedBy = new(int)
+				*a.AckedBy = int(value.Int64)
+			}
+		case alert.FieldParentId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parentId", values[i])
+			} else if value.Valid {
+				a.ParentId = new(int)
+				*a.ParentId = int(value.Int64)
+			}
+		case alert.FieldEscalationLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field escalationLevel", values[i])
+			} else if value.Valid {
+				a.EscalationLevel = int(value.Int64)
+			}
+		case alert.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field createdAt", values[i])
+			} else if value.Valid {
+				a.CreatedAt = value.Time
+			}
+		default:
+			a.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Alert.
+// This includes values selected through modifiers, order, etc.
+func (a *Alert) Value(name string) (ent.Value, error) {
+	return a.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Alert.
+// Note that you need to call Alert.Unwrap() before calling this method if this Alert
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (a *Alert) Update() *AlertUpdateOne {
+	return NewAlertClient(a.config).UpdateOne(a)
+}
+
+// Unwrap unwraps the Alert entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (a *Alert) Unwrap() *Alert {
+	_tx, ok := a.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Alert is not a transactional entity")
+	}
+	a.config.driver = _tx.drv
+	return a
+}
+
+// String implements the fmt.Stringer.
+func (a *Alert) String() string {
+	var builder strings.Builder
+	builder.WriteString("Alert(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", a.ID))
+	builder.WriteString("organizationId=")
+	builder.WriteString(fmt.Sprintf("%v", a.OrganizationId))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(a.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("severity=")
+	builder.WriteString(a.Severity)
+	builder.WriteString(", ")
+	builder.WriteString("patientId=")
+	builder.WriteString(fmt.Sprintf("%v", a.PatientId))
+	builder.WriteString(", ")
+	builder.WriteString("doctorId=")
+	builder.WriteString(fmt.Sprintf("%v", a.DoctorId))
+	builder.WriteString(", ")
+	builder.WriteString("params=")
+	builder.WriteString(fmt.Sprintf("%v", a.Params))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(a.Status)
+	builder.WriteString(", ")
+	if v := a.DeliverAfter; v != nil {
+		builder.WriteString("deliverAfter=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := a.SentAt; v != nil {
+		builder.WriteString("sentAt=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := a.ClaimedUntil; v != nil {
+		builder.WriteString("claimedUntil=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", a.Attempts))
+	builder.WriteString(", ")
+	if v := a.AckedAt; v != nil {
+		builder.WriteString("ackedAt=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := a.AckedBy; v != nil {
+		builder.WriteString("ackedBy=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := a.ParentId; v != nil {
+		builder.WriteString("parentId=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("escalationLevel=")
+	builder.WriteString(fmt.Sprintf("%v", a.EscalationLevel))
+	builder.WriteString(", ")
+	builder.WriteString("createdAt=")
+	builder.WriteString(a.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Alerts is a parsable slice of Alert.
+type Alerts []*Alert
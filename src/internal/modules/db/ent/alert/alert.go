@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package alert
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the alert type in the database.
+	Label = "alert"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOrganizationId holds the string denoting the organizationid field in the database.
+	FieldOrganizationId = "organization_id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldSeverity holds the string denoting the severity field in the database.
+	FieldSeverity = "severity"
+	// FieldPatientId holds the string denoting the patientid field in the database.
+	FieldPatientId = "patient_id"
+	// FieldDoctorId holds the string denoting the doctorid field in the database.
+	FieldDoctorId = "doctor_id"
+	// FieldParams holds the string denoting the params field in the database.
+	FieldParams = "params"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldDeliverAfter holds the string denoting the deliverafter field in the database.
+	FieldDeliverAfter = "deliver_after"
+	// FieldSentAt holds the string denoting the sentat field in the database.
+	FieldSentAt = "sent_at"
+	// FieldClaimedUntil holds the string denoting the claimeduntil field in the database.
+	FieldClaimedUntil = "claimed_until"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldAckedAt holds the string denoting the ackedat field in the database.
+	FieldAckedAt = "acked_at"
+	// FieldAckedBy holds the string denoting the ackedby field in the database.
+	FieldAckedBy = "acked_by"
+	// FieldParentId holds the string denoting the parentid field in the database.
+	FieldParentId = "parent_id"
+	// FieldEscalationLevel holds the string denoting the escalationlevel field in the database.
+	FieldEscalationLevel = "escalation_level"
+	// FieldCreatedAt holds the string denoting the createdat field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the alert in the database.
+	Table = "alerts"
+)
+
+// Columns holds all SQL columns for alert fields.
+var Columns = []string{
+	FieldID,
+	FieldOrganizationId,
+	FieldKind,
+	FieldSeverity,
+	FieldPatientId,
+	FieldDoctorId,
+	FieldParams,
+	FieldStatus,
+	FieldDeliverAfter,
+	FieldSentAt,
+	FieldClaimedUntil,
+	FieldAttempts,
+	FieldAckedAt,
+	FieldAckedBy,
+	FieldParentId,
+	FieldEscalationLevel,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "hospital/internal/modules/db/ent/runtime"
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// DefaultEscalationLevel holds the default value on creation for the "escalationLevel" field.
+	DefaultEscalationLevel int
+	// DefaultCreatedAt holds the default value on creation for the "createdAt" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Order defines the ordering method for the Alert queries.
+type Order func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByOrganizationId orders the results by the organizationId field.
+func ByOrganizationId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldOrganizationId, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// BySeverity orders the results by the severity field.
+func BySeverity(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldSeverity, opts...).ToFunc()
+}
+
+// ByPatientId orders the results by the patientId field.
+func ByPatientId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldPatientId, opts...).ToFunc()
+}
+
+// ByDoctorId orders the results by the doctorId field.
+func ByDoctorId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldDoctorId, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByDeliverAfter orders the results by the deliverAfter field.
+func ByDeliverAfter(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldDeliverAfter, opts...).ToFunc()
+}
+
+// BySentAt orders the results by the sentAt field.
+func BySentAt(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldSentAt, opts...).ToFunc()
+}
+
+// ByClaimedUntil orders the results by the claimedUntil field.
+func ByClaimedUntil(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldClaimedUntil, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByAckedAt orders the results by the ackedAt field.
+func ByAckedAt(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldAckedAt, opts...).ToFunc()
+}
+
+// ByAckedBy orders the results by the ackedBy field.
+func ByAckedBy(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldAckedBy, opts...).ToFunc()
+}
+
+// ByParentId orders the results by the parentId field.
+func ByParentId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldParentId, opts...).ToFunc()
+}
+
+// ByEscalationLevel orders the results by the escalationLevel field.
+func ByEscalationLevel(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldEscalationLevel, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the createdAt field.
+func ByCreatedAt(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
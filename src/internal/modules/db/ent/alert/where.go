@@ -0,0 +1,917 @@
+// Code generated by ent, DO NOT EDIT.
+
+package alert
+
+import (
+	"hospital/internal/modules/db/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Alert {
+	return predicate.Alert(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Alert {
+	return predicate.Alert(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Alert {
+	return predicate.Alert(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Alert {
+	return predicate.Alert(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Alert {
+	return predicate.Alert(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Alert {
+	return predicate.Alert(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Alert {
+	return predicate.Alert(sql.FieldLTE(FieldID, id))
+}
+
+// OrganizationId applies equality check predicate on the "organizationId" field. It's identical to OrganizationIdEQ.
+func OrganizationId(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldOrganizationId, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldKind, v))
+}
+
+// Severity applies equality check predicate on the "severity" field. It's identical to SeverityEQ.
+func Severity(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldSeverity, v))
+}
+
+// PatientId applies equality check predicate on the "patientId" field. It's identical to PatientIdEQ.
+func PatientId(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldPatientId, v))
+}
+
+// DoctorId applies equality check predicate on the "doctorId" field. It's identical to DoctorIdEQ.
+func DoctorId(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldDoctorId, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldStatus, v))
+}
+
+// DeliverAfter applies equality check predicate on the "deliverAfter" field. It's identical to DeliverAfterEQ.
+func DeliverAfter(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldDeliverAfter, v))
+}
+
+// SentAt applies equality check predicate on the "sentAt" field. It's identical to SentAtEQ.
+func SentAt(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldSentAt, v))
+}
+
+// ClaimedUntil applies equality check predicate on the "claimedUntil" field. It's identical to ClaimedUntilEQ.
+func ClaimedUntil(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldClaimedUntil, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AckedAt applies equality check predicate on the "ackedAt" field. It's identical to AckedAtEQ.
+func AckedAt(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldAckedAt, v))
+}
+
+// AckedBy applies equality check predicate on the "ackedBy" field. It's identical to AckedByEQ.
+func AckedBy(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldAckedBy, v))
+}
+
+// ParentId applies equality check predicate on the "parentId" field. It's identical to ParentIdEQ.
+func ParentId(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldParentId, v))
+}
+
+// EscalationLevel applies equality check predicate on the "escalationLevel" field. It's identical to EscalationLevelEQ.
+func EscalationLevel(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldEscalationLevel, v))
+}
+
+// CreatedAt applies equality check predicate on the "createdAt" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// OrganizationIdEQ applies the EQ predicate on the "organizationId" field.
+func OrganizationIdEQ(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldOrganizationId, v))
+}
+
+// OrganizationIdNEQ applies the NEQ predicate on the "organizationId" field.
+func OrganizationIdNEQ(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldNEQ(FieldOrganizationId, v))
+}
+
+// OrganizationIdIn applies the In predicate on the "organizationId" field.
+func OrganizationIdIn(vs ...int) predicate.Alert {
+	return predicate.Alert(sql.FieldIn(FieldOrganizationId, vs...))
+}
+
+// OrganizationIdNotIn applies the NotIn predicate on the "organizationId" field.
+func OrganizationIdNotIn(vs ...int) predicate.Alert {
+	return predicate.Alert(sql.FieldNotIn(FieldOrganizationId, vs...))
+}
+
+// OrganizationIdGT applies the GT predicate on the "organizationId" field.
+func OrganizationIdGT(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldGT(FieldOrganizationId, v))
+}
+
+// OrganizationIdGTE applies the GTE predicate on the "organizationId" field.
+func OrganizationIdGTE(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldGTE(FieldOrganizationId, v))
+}
+
+// OrganizationIdLT applies the LT predicate on the "organizationId" field.
+func OrganizationIdLT(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldLT(FieldOrganizationId, v))
+}
+
+// OrganizationIdLTE applies the LTE predicate on the "organizationId" field.
+func OrganizationIdLTE(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldLTE(FieldOrganizationId, v))
+}
+
+// OrganizationIdIsNil applies the IsNil predicate on the "organizationId" field.
+func OrganizationIdIsNil() predicate.Alert {
+	return predicate.Alert(sql.FieldIsNull(FieldOrganizationId))
+}
+
+// OrganizationIdNotNil applies the NotNil predicate on the "organizationId" field.
+func OrganizationIdNotNil() predicate.Alert {
+	return predicate.Alert(sql.FieldNotNull(FieldOrganizationId))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.Alert {
+	return predicate.Alert(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.Alert {
+	return predicate.Alert(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldContainsFold(FieldKind, v))
+}
+
+// SeverityEQ applies the EQ predicate on the "severity" field.
+func SeverityEQ(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldSeverity, v))
+}
+
+// SeverityNEQ applies the NEQ predicate on the "severity" field.
+func SeverityNEQ(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldNEQ(FieldSeverity, v))
+}
+
+// SeverityIn applies the In predicate on the "severity" field.
+func SeverityIn(vs ...string) predicate.Alert {
+	return predicate.Alert(sql.FieldIn(FieldSeverity, vs...))
+}
+
+// SeverityNotIn applies the NotIn predicate on the "severity" field.
+func SeverityNotIn(vs ...string) predicate.Alert {
+	return predicate.Alert(sql.FieldNotIn(FieldSeverity, vs...))
+}
+
+// SeverityGT applies the GT predicate on the "severity" field.
+func SeverityGT(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldGT(FieldSeverity, v))
+}
+
+// SeverityGTE applies the GTE predicate on the "severity" field.
+func SeverityGTE(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldGTE(FieldSeverity, v))
+}
+
+// SeverityLT applies the LT predicate on the "severity" field.
+func SeverityLT(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldLT(FieldSeverity, v))
+}
+
+// SeverityLTE applies the LTE predicate on the "severity" field.
+func SeverityLTE(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldLTE(FieldSeverity, v))
+}
+
+// SeverityContains applies the Contains predicate on the "severity" field.
+func SeverityContains(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldContains(FieldSeverity, v))
+}
+
+// SeverityHasPrefix applies the HasPrefix predicate on the "severity" field.
+func SeverityHasPrefix(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldHasPrefix(FieldSeverity, v))
+}
+
+// SeverityHasSuffix applies the HasSuffix predicate on the "severity" field.
+func SeverityHasSuffix(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldHasSuffix(FieldSeverity, v))
+}
+
+// SeverityEqualFold applies the EqualFold predicate on the "severity" field.
+func SeverityEqualFold(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldEqualFold(FieldSeverity, v))
+}
+
+// SeverityContainsFold applies the ContainsFold predicate on the "severity" field.
+func SeverityContainsFold(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldContainsFold(FieldSeverity, v))
+}
+
+// PatientIdEQ applies the EQ predicate on the "patientId" field.
+func PatientIdEQ(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldPatientId, v))
+}
+
+// PatientIdNEQ applies the NEQ predicate on the "patientId" field.
+func PatientIdNEQ(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldNEQ(FieldPatientId, v))
+}
+
+// PatientIdIn applies the In predicate on the "patientId" field.
+func PatientIdIn(vs ...int) predicate.Alert {
+	return predicate.Alert(sql.FieldIn(FieldPatientId, vs...))
+}
+
+// PatientIdNotIn applies the NotIn predicate on the "patientId" field.
+func PatientIdNotIn(vs ...int) predicate.Alert {
+	return predicate.Alert(sql.FieldNotIn(FieldPatientId, vs...))
+}
+
+// PatientIdGT applies the GT predicate on the "patientId" field.
+func PatientIdGT(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldGT(FieldPatientId, v))
+}
+
+// PatientIdGTE applies the GTE predicate on the "patientId" field.
+func PatientIdGTE(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldGTE(FieldPatientId, v))
+}
+
+// PatientIdLT applies the LT predicate on the "patientId" field.
+func PatientIdLT(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldLT(FieldPatientId, v))
+}
+
+// PatientIdLTE applies the LTE predicate on the "patientId" field.
+func PatientIdLTE(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldLTE(FieldPatientId, v))
+}
+
+// DoctorIdEQ applies the EQ predicate on the "doctorId" field.
+func DoctorIdEQ(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldDoctorId, v))
+}
+
+// DoctorIdNEQ applies the NEQ predicate on the "doctorId" field.
+func DoctorIdNEQ(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldNEQ(FieldDoctorId, v))
+}
+
+// DoctorIdIn applies the In predicate on the "doctorId" field.
+func DoctorIdIn(vs ...int) predicate.Alert {
+	return predicate.Alert(sql.FieldIn(FieldDoctorId, vs...))
+}
+
+// DoctorIdNotIn applies the NotIn predicate on the "doctorId" field.
+func DoctorIdNotIn(vs ...int) predicate.Alert {
+	return predicate.Alert(sql.FieldNotIn(FieldDoctorId, vs...))
+}
+
+// DoctorIdGT applies the GT predicate on the "doctorId" field.
+func DoctorIdGT(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldGT(FieldDoctorId, v))
+}
+
+// DoctorIdGTE applies the GTE predicate on the "doctorId" field.
+func DoctorIdGTE(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldGTE(FieldDoctorId, v))
+}
+
+// DoctorIdLT applies the LT predicate on the "doctorId" field.
+func DoctorIdLT(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldLT(FieldDoctorId, v))
+}
+
+// DoctorIdLTE applies the LTE predicate on the "doctorId" field.
+func DoctorIdLTE(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldLTE(FieldDoctorId, v))
+}
+
+// ParamsIsNil applies the IsNil predicate on the "params" field.
+func ParamsIsNil() predicate.Alert {
+	return predicate.Alert(sql.FieldIsNull(FieldParams))
+}
+
+// ParamsNotNil applies the NotNil predicate on the "params" field.
+func ParamsNotNil() predicate.Alert {
+	return predicate.Alert(sql.FieldNotNull(FieldParams))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.Alert {
+	return predicate.Alert(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.Alert {
+	return predicate.Alert(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// DeliverAfterEQ applies the EQ predicate on the "deliverAfter" field.
+func DeliverAfterEQ(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldDeliverAfter, v))
+}
+
+// DeliverAfterNEQ applies the NEQ predicate on the "deliverAfter" field.
+func DeliverAfterNEQ(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldNEQ(FieldDeliverAfter, v))
+}
+
+// DeliverAfterIn applies the In predicate on the "deliverAfter" field.
+func DeliverAfterIn(vs ...time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldIn(FieldDeliverAfter, vs...))
+}
+
+// DeliverAfterNotIn applies the NotIn predicate on the "deliverAfter" field.
+func DeliverAfterNotIn(vs ...time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldNotIn(FieldDeliverAfter, vs...))
+}
+
+// DeliverAfterGT applies the GT predicate on the "deliverAfter" field.
+func DeliverAfterGT(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldGT(FieldDeliverAfter, v))
+}
+
+// DeliverAfterGTE applies the GTE predicate on the "deliverAfter" field.
+func DeliverAfterGTE(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldGTE(FieldDeliverAfter, v))
+}
+
+// DeliverAfterLT applies the LT predicate on the "deliverAfter" field.
+func DeliverAfterLT(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldLT(FieldDeliverAfter, v))
+}
+
+// DeliverAfterLTE applies the LTE predicate on the "deliverAfter" field.
+func DeliverAfterLTE(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldLTE(FieldDeliverAfter, v))
+}
+
+// DeliverAfterIsNil applies the IsNil predicate on the "deliverAfter" field.
+func DeliverAfterIsNil() predicate.Alert {
+	return predicate.Alert(sql.FieldIsNull(FieldDeliverAfter))
+}
+
+// DeliverAfterNotNil applies the NotNil predicate on the "deliverAfter" field.
+func DeliverAfterNotNil() predicate.Alert {
+	return predicate.Alert(sql.FieldNotNull(FieldDeliverAfter))
+}
+
+// SentAtEQ applies the EQ predicate on the "sentAt" field.
+func SentAtEQ(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldSentAt, v))
+}
+
+// SentAtNEQ applies the NEQ predicate on the "sentAt" field.
+func SentAtNEQ(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldNEQ(FieldSentAt, v))
+}
+
+// SentAtIn applies the In predicate on the "sentAt" field.
+func SentAtIn(vs ...time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldIn(FieldSentAt, vs...))
+}
+
+// SentAtNotIn applies the NotIn predicate on the "sentAt" field.
+func SentAtNotIn(vs ...time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldNotIn(FieldSentAt, vs...))
+}
+
+// SentAtGT applies the GT predicate on the "sentAt" field.
+func SentAtGT(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldGT(FieldSentAt, v))
+}
+
+// SentAtGTE applies the GTE predicate on the "sentAt" field.
+func SentAtGTE(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldGTE(FieldSentAt, v))
+}
+
+// SentAtLT applies the LT predicate on the "sentAt" field.
+func SentAtLT(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldLT(FieldSentAt, v))
+}
+
+// SentAtLTE applies the LTE predicate on the "sentAt" field.
+func SentAtLTE(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldLTE(FieldSentAt, v))
+}
+
+// SentAtIsNil applies the IsNil predicate on the "sentAt" field.
+func SentAtIsNil() predicate.Alert {
+	return predicate.Alert(sql.FieldIsNull(FieldSentAt))
+}
+
+// SentAtNotNil applies the NotNil predicate on the "sentAt" field.
+func SentAtNotNil() predicate.Alert {
+	return predicate.Alert(sql.FieldNotNull(FieldSentAt))
+}
+
+// ClaimedUntilEQ applies the EQ predicate on the "claimedUntil" field.
+func ClaimedUntilEQ(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldClaimedUntil, v))
+}
+
+// ClaimedUntilNEQ applies the NEQ predicate on the "claimedUntil" field.
+func ClaimedUntilNEQ(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldNEQ(FieldClaimedUntil, v))
+}
+
+// ClaimedUntilIn applies the In predicate on the "claimedUntil" field.
+func ClaimedUntilIn(vs ...time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldIn(FieldClaimedUntil, vs...))
+}
+
+// ClaimedUntilNotIn applies the NotIn predicate on the "claimedUntil" field.
+func ClaimedUntilNotIn(vs ...time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldNotIn(FieldClaimedUntil, vs...))
+}
+
+// ClaimedUntilGT applies the GT predicate on the "claimedUntil" field.
+func ClaimedUntilGT(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldGT(FieldClaimedUntil, v))
+}
+
+// ClaimedUntilGTE applies the GTE predicate on the "claimedUntil" field.
+func ClaimedUntilGTE(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldGTE(FieldClaimedUntil, v))
+}
+
+// ClaimedUntilLT applies the LT predicate on the "claimedUntil" field.
+func ClaimedUntilLT(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldLT(FieldClaimedUntil, v))
+}
+
+// ClaimedUntilLTE applies the LTE predicate on the "claimedUntil" field.
+func ClaimedUntilLTE(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldLTE(FieldClaimedUntil, v))
+}
+
+// ClaimedUntilIsNil applies the IsNil predicate on the "claimedUntil" field.
+func ClaimedUntilIsNil() predicate.Alert {
+	return predicate.Alert(sql.FieldIsNull(FieldClaimedUntil))
+}
+
+// ClaimedUntilNotNil applies the NotNil predicate on the "claimedUntil" field.
+func ClaimedUntilNotNil() predicate.Alert {
+	return predicate.Alert(sql.FieldNotNull(FieldClaimedUntil))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.Alert {
+	return predicate.Alert(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.Alert {
+	return predicate.Alert(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldLTE(FieldAttempts, v))
+}
+
+// AckedAtEQ applies the EQ predicate on the "ackedAt" field.
+func AckedAtEQ(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldAckedAt, v))
+}
+
+// AckedAtNEQ applies the NEQ predicate on the "ackedAt" field.
+func AckedAtNEQ(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldNEQ(FieldAckedAt, v))
+}
+
+// AckedAtIn applies the In predicate on the "ackedAt" field.
+func AckedAtIn(vs ...time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldIn(FieldAckedAt, vs...))
+}
+
+// AckedAtNotIn applies the NotIn predicate on the "ackedAt" field.
+func AckedAtNotIn(vs ...time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldNotIn(FieldAckedAt, vs...))
+}
+
+// AckedAtGT applies the GT predicate on the "ackedAt" field.
+func AckedAtGT(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldGT(FieldAckedAt, v))
+}
+
+// AckedAtGTE applies the GTE predicate on the "ackedAt" field.
+func AckedAtGTE(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldGTE(FieldAckedAt, v))
+}
+
+// AckedAtLT applies the LT predicate on the "ackedAt" field.
+func AckedAtLT(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldLT(FieldAckedAt, v))
+}
+
+// AckedAtLTE applies the LTE predicate on the "ackedAt" field.
+func AckedAtLTE(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldLTE(FieldAckedAt, v))
+}
+
+// AckedAtIsNil applies the IsNil predicate on the "ackedAt" field.
+func AckedAtIsNil() predicate.Alert {
+	return predicate.Alert(sql.FieldIsNull(FieldAckedAt))
+}
+
+// AckedAtNotNil applies the NotNil predicate on the "ackedAt" field.
+func AckedAtNotNil() predicate.Alert {
+	return predicate.Alert(sql.FieldNotNull(FieldAckedAt))
+}
+
+// AckedByEQ applies the EQ predicate on the "ackedBy" field.
+func AckedByEQ(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldAckedBy, v))
+}
+
+// AckedByNEQ applies the NEQ predicate on the "ackedBy" field.
+func AckedByNEQ(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldNEQ(FieldAckedBy, v))
+}
+
+// AckedByIn applies the In predicate on the "ackedBy" field.
+func AckedByIn(vs ...int) predicate.Alert {
+	return predicate.Alert(sql.FieldIn(FieldAckedBy, vs...))
+}
+
+// AckedByNotIn applies the NotIn predicate on the "ackedBy" field.
+func AckedByNotIn(vs ...int) predicate.Alert {
+	return predicate.Alert(sql.FieldNotIn(FieldAckedBy, vs...))
+}
+
+// AckedByGT applies the GT predicate on the "ackedBy" field.
+func AckedByGT(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldGT(FieldAckedBy, v))
+}
+
+// AckedByGTE applies the GTE predicate on the "ackedBy" field.
+func AckedByGTE(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldGTE(FieldAckedBy, v))
+}
+
+// AckedByLT applies the LT predicate on the "ackedBy" field.
+func AckedByLT(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldLT(FieldAckedBy, v))
+}
+
+// AckedByLTE applies the LTE predicate on the "ackedBy" field.
+func AckedByLTE(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldLTE(FieldAckedBy, v))
+}
+
+// AckedByIsNil applies the IsNil predicate on the "ackedBy" field.
+func AckedByIsNil() predicate.Alert {
+	return predicate.Alert(sql.FieldIsNull(FieldAckedBy))
+}
+
+// AckedByNotNil applies the NotNil predicate on the "ackedBy" field.
+func AckedByNotNil() predicate.Alert {
+	return predicate.Alert(sql.FieldNotNull(FieldAckedBy))
+}
+
+// ParentIdEQ applies the EQ predicate on the "parentId" field.
+func ParentIdEQ(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldParentId, v))
+}
+
+// ParentIdNEQ applies the NEQ predicate on the "parentId" field.
+func ParentIdNEQ(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldNEQ(FieldParentId, v))
+}
+
+// ParentIdIn applies the In predicate on the "parentId" field.
+func ParentIdIn(vs ...int) predicate.Alert {
+	return predicate.Alert(sql.FieldIn(FieldParentId, vs...))
+}
+
+// ParentIdNotIn applies the NotIn predicate on the "parentId" field.
+func ParentIdNotIn(vs ...int) predicate.Alert {
+	return predicate.Alert(sql.FieldNotIn(FieldParentId, vs...))
+}
+
+// ParentIdGT applies the GT predicate on the "parentId" field.
+func ParentIdGT(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldGT(FieldParentId, v))
+}
+
+// ParentIdGTE applies the GTE predicate on the "parentId" field.
+func ParentIdGTE(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldGTE(FieldParentId, v))
+}
+
+// ParentIdLT applies the LT predicate on the "parentId" field.
+func ParentIdLT(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldLT(FieldParentId, v))
+}
+
+// ParentIdLTE applies the LTE predicate on the "parentId" field.
+func ParentIdLTE(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldLTE(FieldParentId, v))
+}
+
+// ParentIdIsNil applies the IsNil predicate on the "parentId" field.
+func ParentIdIsNil() predicate.Alert {
+	return predicate.Alert(sql.FieldIsNull(FieldParentId))
+}
+
+// ParentIdNotNil applies the NotNil predicate on the "parentId" field.
+func ParentIdNotNil() predicate.Alert {
+	return predicate.Alert(sql.FieldNotNull(FieldParentId))
+}
+
+// EscalationLevelEQ applies the EQ predicate on the "escalationLevel" field.
+func EscalationLevelEQ(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldEscalationLevel, v))
+}
+
+// EscalationLevelNEQ applies the NEQ predicate on the "escalationLevel" field.
+func EscalationLevelNEQ(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldNEQ(FieldEscalationLevel, v))
+}
+
+// EscalationLevelIn applies the In predicate on the "escalationLevel" field.
+func EscalationLevelIn(vs ...int) predicate.Alert {
+	return predicate.Alert(sql.FieldIn(FieldEscalationLevel, vs...))
+}
+
+// EscalationLevelNotIn applies the NotIn predicate on the "escalationLevel" field.
+func EscalationLevelNotIn(vs ...int) predicate.Alert {
+	return predicate.Alert(sql.FieldNotIn(FieldEscalationLevel, vs...))
+}
+
+// EscalationLevelGT applies the GT predicate on the "escalationLevel" field.
+func EscalationLevelGT(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldGT(FieldEscalationLevel, v))
+}
+
+// EscalationLevelGTE applies the GTE predicate on the "escalationLevel" field.
+func EscalationLevelGTE(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldGTE(FieldEscalationLevel, v))
+}
+
+// EscalationLevelLT applies the LT predicate on the "escalationLevel" field.
+func EscalationLevelLT(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldLT(FieldEscalationLevel, v))
+}
+
+// EscalationLevelLTE applies the LTE predicate on the "escalationLevel" field.
+func EscalationLevelLTE(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldLTE(FieldEscalationLevel, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "createdAt" field.
+func CreatedAtEQ(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "createdAt" field.
+func CreatedAtNEQ(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "createdAt" field.
+func CreatedAtIn(vs ...time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "createdAt" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "createdAt" field.
+func CreatedAtGT(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "createdAt" field.
+func CreatedAtGTE(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "createdAt" field.
+func CreatedAtLT(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "createdAt" field.
+func CreatedAtLTE(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Alert) predicate.Alert {
+	return predicate.Alert(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Alert) predicate.Alert {
+	return predicate.Alert(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Alert) predicate.Alert {
+	return predicate.Alert(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,455 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hospital/internal/modules/db/ent/alert"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AlertCreate is the builder for creating a Alert entity.
+type AlertCreate struct {
+	config
+	mutation *AlertMutation
+	hooks    []Hook
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (ac *AlertCreate) SetOrganizationId(i int) *AlertCreate {
+	ac.mutation.SetOrganizationId(i)
+	return ac
+}
+
+// SetNillableOrganizationId sets the "organizationId" field if the given value is not nil.
+func (ac *AlertCreate) SetNillableOrganizationId(i *int) *AlertCreate {
+	if i != nil {
+		ac.SetOrganizationId(*i)
+	}
+	return ac
+}
+
+// SetKind sets the "kind" field.
+func (ac *AlertCreate) SetKind(s string) *AlertCreate {
+	ac.mutation.SetKind(s)
+	return ac
+}
+
+// SetSeverity sets the "severity" field.
+func (ac *AlertCreate) SetSeverity(s string) *AlertCreate {
+	ac.mutation.SetSeverity(s)
+	return ac
+}
+
+// SetPatientId sets the "patientId" field.
+func (ac *AlertCreate) SetPatientId(i int) *AlertCreate {
+	ac.mutation.SetPatientId(i)
+	return ac
+}
+
+// SetDoctorId sets the "doctorId" field.
+func (ac *AlertCreate) SetDoctorId(i int) *AlertCreate {
+	ac.mutation.SetDoctorId(i)
+	return ac
+}
+
+// SetParams sets the "params" field.
+func (ac *AlertCreate) SetParams(m map[string]string) *AlertCreate {
+	ac.mutation.SetParams(m)
+	return ac
+}
+
+// SetStatus sets the "status" field.
+func (ac *AlertCreate) SetStatus(s string) *AlertCreate {
+	ac.mutation.SetStatus(s)
+	return ac
+}
+
+// SetDeliverAfter sets the "deliverAfter" field.
+func (ac *AlertCreate) SetDeliverAfter(t time.Time) *AlertCreate {
+	ac.mutation.SetDeliverAfter(t)
+	return ac
+}
+
+// SetNillableDeliverAfter sets the "deliverAfter" field if the given value is not nil.
+func (ac *AlertCreate) SetNillableDeliverAfter(t *time.Time) *AlertCreate {
+	if t != nil {
+		ac.SetDeliverAfter(*t)
+	}
+	return ac
+}
+
+// SetSentAt sets the "sentAt" field.
+func (ac *AlertCreate) SetSentAt(t time.Time) *AlertCreate {
+	ac.mutation.SetSentAt(t)
+	return ac
+}
+
+// SetNillableSentAt sets the "sentAt" field if the given value is not nil.
+func (ac *AlertCreate) SetNillableSentAt(t *time.Time) *AlertCreate {
+	if t != nil {
+		ac.SetSentAt(*t)
+	}
+	return ac
+}
+
+// SetClaimedUntil sets the "claimedUntil" field.
+func (ac *AlertCreate) SetClaimedUntil(t time.Time) *AlertCreate {
+	ac.mutation.SetClaimedUntil(t)
+	return ac
+}
+
+// SetNillableClaimedUntil sets the "claimedUntil" field if the given value is not nil.
+func (ac *AlertCreate) SetNillableClaimedUntil(t *time.Time) *AlertCreate {
+	if t != nil {
+		ac.SetClaimedUntil(*t)
+	}
+	return ac
+}
+
+// SetAttempts sets the "attempts" field.
+func (ac *AlertCreate) SetAttempts(i int) *AlertCreate {
+	ac.mutation.SetAttempts(i)
+	return ac
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (ac *AlertCreate) SetNillableAttempts(i *int) *AlertCreate {
+	if i != nil {
+		ac.SetAttempts(*i)
+	}
+	return ac
+}
+
+// SetAckedAt sets the "ackedAt" field.
+func (ac *AlertCreate) SetAckedAt(t time.Time) *AlertCreate {
+	ac.mutation.SetAckedAt(t)
+	return ac
+}
+
+// SetNillableAckedAt sets the "ackedAt" field if the given value is not nil.
+func (ac *AlertCreate) SetNillableAckedAt(t *time.Time) *AlertCreate {
+	if t != nil {
+		ac.SetAckedAt(*t)
+	}
+	return ac
+}
+
+// SetAckedBy sets the "ackedBy" field.
+func (ac *AlertCreate) SetAckedBy(i int) *AlertCreate {
+	ac.mutation.SetAckedBy(i)
+	return ac
+}
+
+// SetNillableAckedBy sets the "ackedBy" field if the given value is not nil.
+func (ac *AlertCreate) SetNillableAckedBy(i *int) *AlertCreate {
+	if i != nil {
+		ac.SetAckedBy(*i)
+	}
+	return ac
+}
+
+// SetParentId sets the "parentId" field.
+func (ac *AlertCreate) SetParentId(i int) *AlertCreate {
+	ac.mutation.SetParentId(i)
+	return ac
+}
+
+// SetNillableParentId sets the "parentId" field if the given value is not nil.
+func (ac *AlertCreate) SetNillableParentId(i *int) *AlertCreate {
+	if i != nil {
+		ac.SetParentId(*i)
+	}
+	return ac
+}
+
+// SetEscalationLevel sets the "escalationLevel" field.
+func (ac *AlertCreate) SetEscalationLevel(i int) *AlertCreate {
+	ac.mutation.SetEscalationLevel(i)
+	return ac
+}
+
+// SetNillableEscalationLevel sets the "escalationLevel" field if the given value is not nil.
+func (ac *AlertCreate) SetNillableEscalationLevel(i *int) *AlertCreate {
+	if i != nil {
+		ac.SetEscalationLevel(*i)
+	}
+	return ac
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (ac *AlertCreate) SetCreatedAt(t time.Time) *AlertCreate {
+	ac.mutation.SetCreatedAt(t)
+	return ac
+}
+
+// SetNillableCreatedAt sets the "createdAt" field if the given value is not nil.
+func (ac *AlertCreate) SetNillableCreatedAt(t *time.Time) *AlertCreate {
+	if t != nil {
+		ac.SetCreatedAt(*t)
+	}
+	return ac
+}
+
+// Mutation returns the AlertMutation object of the builder.
+func (ac *AlertCreate) Mutation() *AlertMutation {
+	return ac.mutation
+}
+
+// Save creates the Alert in the database.
+func (ac *AlertCreate) Save(ctx context.Context) (*Alert, error) {
+	if err := ac.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks[*Alert, AlertMutation](ctx, ac.sqlSave, ac.mutation, ac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ac *AlertCreate) SaveX(ctx context.Context) *Alert {
+	v, err := ac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ac *AlertCreate) Exec(ctx context.Context) error {
+	_, err := ac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ac *AlertCreate) ExecX(ctx context.Context) {
+	if err := ac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ac *AlertCreate) defaults() error {
+	if _, ok := ac.mutation.Attempts(); !ok {
+		v := alert.DefaultAttempts
+		ac.mutation.SetAttempts(v)
+	}
+	if _, ok := ac.mutation.EscalationLevel(); !ok {
+		v := alert.DefaultEscalationLevel
+		ac.mutation.SetEscalationLevel(v)
+	}
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		if alert.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized alert.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := alert.DefaultCreatedAt()
+		ac.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ac *AlertCreate) check() error {
+	if _, ok := ac.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Alert.kind"`)}
+	}
+	if _, ok := ac.mutation.Severity(); !ok {
+		return &ValidationError{Name: "severity", err: errors.New(`ent: missing required field "Alert.severity"`)}
+	}
+	if _, ok := ac.mutation.PatientId(); !ok {
+		return &ValidationError{Name: "patientId", err: errors.New(`ent: missing required field "Alert.patientId"`)}
+	}
+	if _, ok := ac.mutation.DoctorId(); !ok {
+		return &ValidationError{Name: "doctorId", err: errors.New(`ent: missing required field "Alert.doctorId"`)}
+	}
+	if _, ok := ac.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Alert.status"`)}
+	}
+	if _, ok := ac.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "Alert.attempts"`)}
+	}
+	if _, ok := ac.mutation.EscalationLevel(); !ok {
+		return &ValidationError{Name: "escalationLevel", err: errors.New(`ent: missing required field "Alert.escalationLevel"`)}
+	}
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "createdAt", err: errors.New(`ent: missing required field "Alert.createdAt"`)}
+	}
+	return nil
+}
+
+func (ac *AlertCreate) sqlSave(ctx context.Context) (*Alert, error) {
+	if err := ac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ac.mutation.id = &_node.ID
+	ac.mutation.done = true
+	return _node, nil
+}
+
+func (ac *AlertCreate) createSpec() (*Alert, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Alert{config: ac.config}
+		_spec = sqlgraph.NewCreateSpec(alert.Table, sqlgraph.NewFieldSpec(alert.FieldID, field.TypeInt))
+	)
+	if value, ok := ac.mutation.OrganizationId(); ok {
+		_spec.SetField(alert.FieldOrganizationId, field.TypeInt, value)
+		_node.OrganizationId = value
+	}
+	if value, ok := ac.mutation.Kind(); ok {
+		_spec.SetField(alert.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := ac.mutation.Severity(); ok {
+		_spec.SetField(alert.FieldSeverity, field.TypeString, value)
+		_node.Severity = value
+	}
+	if value, ok := ac.mutation.PatientId(); ok {
+		_spec.SetField(alert.FieldPatientId, field.TypeInt, value)
+		_node.PatientId = value
+	}
+	if value, ok := ac.mutation.DoctorId(); ok {
+		_spec.SetField(alert.FieldDoctorId, field.TypeInt, value)
+		_node.DoctorId = value
+	}
+	if value, ok := ac.mutation.Params(); ok {
+		_spec.SetField(alert.FieldParams, field.TypeJSON, value)
+		_node.Params = value
+	}
+	if value, ok := ac.mutation.Status(); ok {
+		_spec.SetField(alert.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := ac.mutation.DeliverAfter(); ok {
+		_spec.SetField(alert.FieldDeliverAfter, field.TypeTime, value)
+		_node.DeliverAfter = &value
+	}
+	if value, ok := ac.mutation.SentAt(); ok {
+		_spec.SetField(alert.FieldSentAt, field.TypeTime, value)
+		_node.SentAt = &value
+	}
+	if value, ok := ac.mutation.ClaimedUntil(); ok {
+		_spec.SetField(alert.FieldClaimedUntil, field.TypeTime, value)
+		_node.ClaimedUntil = &value
+	}
+	if value, ok := ac.mutation.Attempts(); ok {
+		_spec.SetField(alert.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := ac.mutation.AckedAt(); ok {
+		_spec.SetField(alert.FieldAckedAt, field.TypeTime, value)
+		_node.AckedAt = &value
+	}
+	if value, ok := ac.mutation.AckedBy(); ok {
+		_spec.SetField(alert.FieldAckedBy, field.TypeInt, value)
+		_node.AckedBy = &value
+	}
+	if value, ok := ac.mutation.ParentId(); ok {
+		_spec.SetField(alert.FieldParentId, field.TypeInt, value)
+		_node.ParentId = &value
+	}
+	if value, ok := ac.mutation.EscalationLevel(); ok {
+		_spec.SetField(alert.FieldEscalationLevel, field.TypeInt, value)
+		_node.EscalationLevel = value
+	}
+	if value, ok := ac.mutation.CreatedAt(); ok {
+		_spec.SetField(alert.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AlertCreateBulk is the builder for creating many Alert entities in bulk.
+type AlertCreateBulk struct {
+	config
+	builders []*AlertCreate
+}
+
+// Save creates the Alert entities in the database.
+func (acb *AlertCreateBulk) Save(ctx context.Context) ([]*Alert, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(acb.builders))
+	nodes := make([]*Alert, len(acb.builders))
+	mutators := make([]Mutator, len(acb.builders))
+	for i := range acb.builders {
+		func(i int, root context.Context) {
+			builder := acb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AlertMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, acb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, acb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acb *AlertCreateBulk) SaveX(ctx context.Context) []*Alert {
+	v, err := acb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acb *AlertCreateBulk) Exec(ctx context.Context) error {
+	_, err := acb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acb *AlertCreateBulk) ExecX(ctx context.Context) {
+	if err := acb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,600 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hospital/internal/modules/db/ent/alert"
+	"hospital/internal/modules/db/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AlertUpdate is the builder for updating Alert entities.
+type AlertUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AlertMutation
+}
+
+// Where appends a list predicates to the AlertUpdate builder.
+func (au *AlertUpdate) Where(ps ...predicate.Alert) *AlertUpdate {
+	au.mutation.Where(ps...)
+	return au
+}
+
+// SetStatus sets the "status" field.
+func (au *AlertUpdate) SetStatus(s string) *AlertUpdate {
+	au.mutation.SetStatus(s)
+	return au
+}
+
+// SetDeliverAfter sets the "deliverAfter" field.
+func (au *AlertUpdate) SetDeliverAfter(t time.Time) *AlertUpdate {
+	au.mutation.SetDeliverAfter(t)
+	return au
+}
+
+// SetNillableDeliverAfter sets the "deliverAfter" field if the given value is not nil.
+func (au *AlertUpdate) SetNillableDeliverAfter(t *time.Time) *AlertUpdate {
+	if t != nil {
+		au.SetDeliverAfter(*t)
+	}
+	return au
+}
+
+// ClearDeliverAfter clears the value of the "deliverAfter" field.
+func (au *AlertUpdate) ClearDeliverAfter() *AlertUpdate {
+	au.mutation.ClearDeliverAfter()
+	return au
+}
+
+// SetSentAt sets the "sentAt" field.
+func (au *AlertUpdate) SetSentAt(t time.Time) *AlertUpdate {
+	au.mutation.SetSentAt(t)
+	return au
+}
+
+// SetNillableSentAt sets the "sentAt" field if the given value is not nil.
+func (au *AlertUpdate) SetNillableSentAt(t *time.Time) *AlertUpdate {
+	if t != nil {
+		au.SetSentAt(*t)
+	}
+	return au
+}
+
+// ClearSentAt clears the value of the "sentAt" field.
+func (au *AlertUpdate) ClearSentAt() *AlertUpdate {
+	au.mutation.ClearSentAt()
+	return au
+}
+
+// SetClaimedUntil sets the "claimedUntil" field.
+func (au *AlertUpdate) SetClaimedUntil(t time.Time) *AlertUpdate {
+	au.mutation.SetClaimedUntil(t)
+	return au
+}
+
+// SetNillableClaimedUntil sets the "claimedUntil" field if the given value is not nil.
+func (au *AlertUpdate) SetNillableClaimedUntil(t *time.Time) *AlertUpdate {
+	if t != nil {
+		au.SetClaimedUntil(*t)
+	}
+	return au
+}
+
+// ClearClaimedUntil clears the value of the "claimedUntil" field.
+func (au *AlertUpdate) ClearClaimedUntil() *AlertUpdate {
+	au.mutation.ClearClaimedUntil()
+	return au
+}
+
+// SetAttempts sets the "attempts" field.
+func (au *AlertUpdate) SetAttempts(i int) *AlertUpdate {
+	au.mutation.ResetAttempts()
+	au.mutation.SetAttempts(i)
+	return au
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (au *AlertUpdate) SetNillableAttempts(i *int) *AlertUpdate {
+	if i != nil {
+		au.SetAttempts(*i)
+	}
+	return au
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (au *AlertUpdate) AddAttempts(i int) *AlertUpdate {
+	au.mutation.AddAttempts(i)
+	return au
+}
+
+// SetAckedAt sets the "ackedAt" field.
+func (au *AlertUpdate) SetAckedAt(t time.Time) *AlertUpdate {
+	au.mutation.SetAckedAt(t)
+	return au
+}
+
+// SetNillableAckedAt sets the "ackedAt" field if the given value is not nil.
+func (au *AlertUpdate) SetNillableAckedAt(t *time.Time) *AlertUpdate {
+	if t != nil {
+		au.SetAckedAt(*t)
+	}
+	return au
+}
+
+// ClearAckedAt clears the value of the "ackedAt" field.
+func (au *AlertUpdate) ClearAckedAt() *AlertUpdate {
+	au.mutation.ClearAckedAt()
+	return au
+}
+
+// SetAckedBy sets the "ackedBy" field.
+func (au *AlertUpdate) SetAckedBy(i int) *AlertUpdate {
+	au.mutation.ResetAckedBy()
+	au.mutation.SetAckedBy(i)
+	return au
+}
+
+// SetNillableAckedBy sets the "ackedBy" field if the given value is not nil.
+func (au *AlertUpdate) SetNillableAckedBy(i *int) *AlertUpdate {
+	if i != nil {
+		au.SetAckedBy(*i)
+	}
+	return au
+}
+
+// AddAckedBy adds i to the "ackedBy" field.
+func (au *AlertUpdate) AddAckedBy(i int) *AlertUpdate {
+	au.mutation.AddAckedBy(i)
+	return au
+}
+
+// ClearAckedBy clears the value of the "ackedBy" field.
+func (au *AlertUpdate) ClearAckedBy() *AlertUpdate {
+	au.mutation.ClearAckedBy()
+	return au
+}
+
+// SetEscalationLevel sets the "escalationLevel" field.
+func (au *AlertUpdate) SetEscalationLevel(i int) *AlertUpdate {
+	au.mutation.ResetEscalationLevel()
+	au.mutation.SetEscalationLevel(i)
+	return au
+}
+
+// SetNillableEscalationLevel sets the "escalationLevel" field if the given value is not nil.
+func (au *AlertUpdate) SetNillableEscalationLevel(i *int) *AlertUpdate {
+	if i != nil {
+		au.SetEscalationLevel(*i)
+	}
+	return au
+}
+
+// AddEscalationLevel adds i to the "escalationLevel" field.
+func (au *AlertUpdate) AddEscalationLevel(i int) *AlertUpdate {
+	au.mutation.AddEscalationLevel(i)
+	return au
+}
+
+// Mutation returns the AlertMutation object of the builder.
+func (au *AlertUpdate) Mutation() *AlertMutation {
+	return au.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *AlertUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks[int, AlertMutation](ctx, au.sqlSave, au.mutation, au.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *AlertUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *AlertUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *AlertUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (au *AlertUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(alert.Table, alert.Columns, sqlgraph.NewFieldSpec(alert.FieldID, field.TypeInt))
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if au.mutation.OrganizationIdCleared() {
+		_spec.ClearField(alert.FieldOrganizationId, field.TypeInt)
+	}
+	if au.mutation.ParamsCleared() {
+		_spec.ClearField(alert.FieldParams, field.TypeJSON)
+	}
+	if value, ok := au.mutation.Status(); ok {
+		_spec.SetField(alert.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := au.mutation.DeliverAfter(); ok {
+		_spec.SetField(alert.FieldDeliverAfter, field.TypeTime, value)
+	}
+	if au.mutation.DeliverAfterCleared() {
+		_spec.ClearField(alert.FieldDeliverAfter, field.TypeTime)
+	}
+	if value, ok := au.mutation.SentAt(); ok {
+		_spec.SetField(alert.FieldSentAt, field.TypeTime, value)
+	}
+	if au.mutation.SentAtCleared() {
+		_spec.ClearField(alert.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := au.mutation.ClaimedUntil(); ok {
+		_spec.SetField(alert.FieldClaimedUntil, field.TypeTime, value)
+	}
+	if au.mutation.ClaimedUntilCleared() {
+		_spec.ClearField(alert.FieldClaimedUntil, field.TypeTime)
+	}
+	if value, ok := au.mutation.Attempts(); ok {
+		_spec.SetField(alert.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedAttempts(); ok {
+		_spec.AddField(alert.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AckedAt(); ok {
+		_spec.SetField(alert.FieldAckedAt, field.TypeTime, value)
+	}
+	if au.mutation.AckedAtCleared() {
+		_spec.ClearField(alert.FieldAckedAt, field.TypeTime)
+	}
+	if value, ok := au.mutation.AckedBy(); ok {
+		_spec.SetField(alert.FieldAckedBy, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedAckedBy(); ok {
+		_spec.AddField(alert.FieldAckedBy, field.TypeInt, value)
+	}
+	if au.mutation.AckedByCleared() {
+		_spec.ClearField(alert.FieldAckedBy, field.TypeInt)
+	}
+	if au.mutation.ParentIdCleared() {
+		_spec.ClearField(alert.FieldParentId, field.TypeInt)
+	}
+	if value, ok := au.mutation.EscalationLevel(); ok {
+		_spec.SetField(alert.FieldEscalationLevel, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedEscalationLevel(); ok {
+		_spec.AddField(alert.FieldEscalationLevel, field.TypeInt, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{alert.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	au.mutation.done = true
+	return n, nil
+}
+
+// AlertUpdateOne is the builder for updating a single Alert entity.
+type AlertUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AlertMutation
+}
+
+// SetStatus sets the "status" field.
+func (auo *AlertUpdateOne) SetStatus(s string) *AlertUpdateOne {
+	auo.mutation.SetStatus(s)
+	return auo
+}
+
+// SetDeliverAfter sets the "deliverAfter" field.
+func (auo *AlertUpdateOne) SetDeliverAfter(t time.Time) *AlertUpdateOne {
+	auo.mutation.SetDeliverAfter(t)
+	return auo
+}
+
+// SetNillableDeliverAfter sets the "deliverAfter" field if the given value is not nil.
+func (auo *AlertUpdateOne) SetNillableDeliverAfter(t *time.Time) *AlertUpdateOne {
+	if t != nil {
+		auo.SetDeliverAfter(*t)
+	}
+	return auo
+}
+
+// ClearDeliverAfter clears the value of the "deliverAfter" field.
+func (auo *AlertUpdateOne) ClearDeliverAfter() *AlertUpdateOne {
+	auo.mutation.ClearDeliverAfter()
+	return auo
+}
+
+// SetSentAt sets the "sentAt" field.
+func (auo *AlertUpdateOne) SetSentAt(t time.Time) *AlertUpdateOne {
+	auo.mutation.SetSentAt(t)
+	return auo
+}
+
+// SetNillableSentAt sets the "sentAt" field if the given value is not nil.
+func (auo *AlertUpdateOne) SetNillableSentAt(t *time.Time) *AlertUpdateOne {
+	if t != nil {
+		auo.SetSentAt(*t)
+	}
+	return auo
+}
+
+// ClearSentAt clears the value of the "sentAt" field.
+func (auo *AlertUpdateOne) ClearSentAt() *AlertUpdateOne {
+	auo.mutation.ClearSentAt()
+	return auo
+}
+
+// SetClaimedUntil sets the "claimedUntil" field.
+func (auo *AlertUpdateOne) SetClaimedUntil(t time.Time) *AlertUpdateOne {
+	auo.mutation.SetClaimedUntil(t)
+	return auo
+}
+
+// SetNillableClaimedUntil sets the "claimedUntil" field if the given value is not nil.
+func (auo *AlertUpdateOne) SetNillableClaimedUntil(t *time.Time) *AlertUpdateOne {
+	if t != nil {
+		auo.SetClaimedUntil(*t)
+	}
+	return auo
+}
+
+// ClearClaimedUntil clears the value of the "claimedUntil" field.
+func (auo *AlertUpdateOne) ClearClaimedUntil() *AlertUpdateOne {
+	auo.mutation.ClearClaimedUntil()
+	return auo
+}
+
+// SetAttempts sets the "attempts" field.
+func (auo *AlertUpdateOne) SetAttempts(i int) *AlertUpdateOne {
+	auo.mutation.ResetAttempts()
+	auo.mutation.SetAttempts(i)
+	return auo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (auo *AlertUpdateOne) SetNillableAttempts(i *int) *AlertUpdateOne {
+	if i != nil {
+		auo.SetAttempts(*i)
+	}
+	return auo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (auo *AlertUpdateOne) AddAttempts(i int) *AlertUpdateOne {
+	auo.mutation.AddAttempts(i)
+	return auo
+}
+
+// SetAckedAt sets the "ackedAt" field.
+func (auo *AlertUpdateOne) SetAckedAt(t time.Time) *AlertUpdateOne {
+	auo.mutation.SetAckedAt(t)
+	return auo
+}
+
+// SetNillableAckedAt sets the "ackedAt" field if the given value is not nil.
+func (auo *AlertUpdateOne) SetNillableAckedAt(t *time.Time) *AlertUpdateOne {
+	if t != nil {
+		auo.SetAckedAt(*t)
+	}
+	return auo
+}
+
+// ClearAckedAt clears the value of the "ackedAt" field.
+func (auo *AlertUpdateOne) ClearAckedAt() *AlertUpdateOne {
+	auo.mutation.ClearAckedAt()
+	return auo
+}
+
+// SetAckedBy sets the "ackedBy" field.
+func (auo *AlertUpdateOne) SetAckedBy(i int) *AlertUpdateOne {
+	auo.mutation.ResetAckedBy()
+	auo.mutation.SetAckedBy(i)
+	return auo
+}
+
+// SetNillableAckedBy sets the "ackedBy" field if the given value is not nil.
+func (auo *AlertUpdateOne) SetNillableAckedBy(i *int) *AlertUpdateOne {
+	if i != nil {
+		auo.SetAckedBy(*i)
+	}
+	return auo
+}
+
+// AddAckedBy adds i to the "ackedBy" field.
+func (auo *AlertUpdateOne) AddAckedBy(i int) *AlertUpdateOne {
+	auo.mutation.AddAckedBy(i)
+	return auo
+}
+
+// ClearAckedBy clears the value of the "ackedBy" field.
+func (auo *AlertUpdateOne) ClearAckedBy() *AlertUpdateOne {
+	auo.mutation.ClearAckedBy()
+	return auo
+}
+
+// SetEscalationLevel sets the "escalationLevel" field.
+func (auo *AlertUpdateOne) SetEscalationLevel(i int) *AlertUpdateOne {
+	auo.mutation.ResetEscalationLevel()
+	auo.mutation.SetEscalationLevel(i)
+	return auo
+}
+
+// SetNillableEscalationLevel sets the "escalationLevel" field if the given value is not nil.
+func (auo *AlertUpdateOne) SetNillableEscalationLevel(i *int) *AlertUpdateOne {
+	if i != nil {
+		auo.SetEscalationLevel(*i)
+	}
+	return auo
+}
+
+// AddEscalationLevel adds i to the "escalationLevel" field.
+func (auo *AlertUpdateOne) AddEscalationLevel(i int) *AlertUpdateOne {
+	auo.mutation.AddEscalationLevel(i)
+	return auo
+}
+
+// Mutation returns the AlertMutation object of the builder.
+func (auo *AlertUpdateOne) Mutation() *AlertMutation {
+	return auo.mutation
+}
+
+// Where appends a list predicates to the AlertUpdate builder.
+func (auo *AlertUpdateOne) Where(ps ...predicate.Alert) *AlertUpdateOne {
+	auo.mutation.Where(ps...)
+	return auo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (auo *AlertUpdateOne) Select(field string, fields ...string) *AlertUpdateOne {
+	auo.fields = append([]string{field}, fields...)
+	return auo
+}
+
+// Save executes the query and returns the updated Alert entity.
+func (auo *AlertUpdateOne) Save(ctx context.Context) (*Alert, error) {
+	return withHooks[*Alert, AlertMutation](ctx, auo.sqlSave, auo.mutation, auo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *AlertUpdateOne) SaveX(ctx context.Context) *Alert {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *AlertUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *AlertUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (auo *AlertUpdateOne) sqlSave(ctx context.Context) (_node *Alert, err error) {
+	_spec := sqlgraph.NewUpdateSpec(alert.Table, alert.Columns, sqlgraph.NewFieldSpec(alert.FieldID, field.TypeInt))
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Alert.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := auo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, alert.FieldID)
+		for _, f := range fields {
+			if !alert.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != alert.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := auo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if auo.mutation.OrganizationIdCleared() {
+		_spec.ClearField(alert.FieldOrganizationId, field.TypeInt)
+	}
+	if auo.mutation.ParamsCleared() {
+		_spec.ClearField(alert.FieldParams, field.TypeJSON)
+	}
+	if value, ok := auo.mutation.Status(); ok {
+		_spec.SetField(alert.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.DeliverAfter(); ok {
+		_spec.SetField(alert.FieldDeliverAfter, field.TypeTime, value)
+	}
+	if auo.mutation.DeliverAfterCleared() {
+		_spec.ClearField(alert.FieldDeliverAfter, field.TypeTime)
+	}
+	if value, ok := auo.mutation.SentAt(); ok {
+		_spec.SetField(alert.FieldSentAt, field.TypeTime, value)
+	}
+	if auo.mutation.SentAtCleared() {
+		_spec.ClearField(alert.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := auo.mutation.ClaimedUntil(); ok {
+		_spec.SetField(alert.FieldClaimedUntil, field.TypeTime, value)
+	}
+	if auo.mutation.ClaimedUntilCleared() {
+		_spec.ClearField(alert.FieldClaimedUntil, field.TypeTime)
+	}
+	if value, ok := auo.mutation.Attempts(); ok {
+		_spec.SetField(alert.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedAttempts(); ok {
+		_spec.AddField(alert.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AckedAt(); ok {
+		_spec.SetField(alert.FieldAckedAt, field.TypeTime, value)
+	}
+	if auo.mutation.AckedAtCleared() {
+		_spec.ClearField(alert.FieldAckedAt, field.TypeTime)
+	}
+	if value, ok := auo.mutation.AckedBy(); ok {
+		_spec.SetField(alert.FieldAckedBy, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedAckedBy(); ok {
+		_spec.AddField(alert.FieldAckedBy, field.TypeInt, value)
+	}
+	if auo.mutation.AckedByCleared() {
+		_spec.ClearField(alert.FieldAckedBy, field.TypeInt)
+	}
+	if auo.mutation.ParentIdCleared() {
+		_spec.ClearField(alert.FieldParentId, field.TypeInt)
+	}
+	if value, ok := auo.mutation.EscalationLevel(); ok {
+		_spec.SetField(alert.FieldEscalationLevel, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedEscalationLevel(); ok {
+		_spec.AddField(alert.FieldEscalationLevel, field.TypeInt, value)
+	}
+	_node = &Alert{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{alert.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	auo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"hospital/internal/models/audit"
+	"hospital/internal/modules/db/ent/auditlog"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AuditLog is the model entity for the AuditLog schema.
+type AuditLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// OrganizationId holds the value of the "organizationId" field.
+	OrganizationId int `json:"organizationId,omitempty"`
+	// ActorId holds the value of the "actorId" field.
+	ActorId *int `json:"actorId,omitempty"`
+	// SessionId holds the value of the "sessionId" field.
+	SessionId string `json:"sessionId,omitempty"`
+	// Entity holds the value of the "entity" field.
+	Entity string `json:"entity,omitempty"`
+	// EntityId holds the value of the "entityId" field.
+	EntityId int `json:"entityId,omitempty"`
+	// Action holds the value of the "action" field.
+	Action string `json:"action,omitempty"`
+	// Changes holds the value of the "changes" field.
+	Changes audit.Changes `json:"changes,omitempty"`
+	// CreatedAt holds the value of the "createdAt" field.
+	CreatedAt    time.Time `json:"createdAt,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditlog.FieldChanges:
+			values[i] = new([]byte)
+		case auditlog.FieldID, auditlog.FieldOrganizationId, auditlog.FieldActorId, auditlog.FieldEntityId:
+			values[i] = new(sql.NullInt64)
+		case auditlog.FieldSessionId, auditlog.FieldEntity, auditlog.FieldAction:
+			values[i] = new(sql.NullString)
+		case auditlog.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditLog fields.
+func (al *AuditLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditlog.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			al.ID = int(value.Int64)
+		case auditlog.FieldOrganizationId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field organizationId", values[i])
+			} else if value.Valid {
+				al.OrganizationId = int(value.Int64)
+			}
+		case auditlog.FieldActorId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field actorId", values[i])
+			} else if value.Valid {
+				al.ActorId = new(int)
+				*al.ActorId = int(value.Int64)
+			}
+		case auditlog.FieldSessionId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sessionId", values[i])
+			} else if value.Valid {
+				al.SessionId = value.String
+			}
+		case auditlog.FieldEntity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entity", values[i])
+			} else if value.Valid {
+				al.Entity = value.String
+			}
+		case auditlog.FieldEntityId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field entityId", values[i])
+			} else if value.Valid {
+				al.EntityId = int(value.Int64)
+			}
+		case auditlog.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				al.Action = value.String
+			}
+		case auditlog.FieldChanges:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field changes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &al.Changes); err != nil {
+					return fmt.Errorf("unmarshal field changes: %w", err)
+				}
+			}
+		case auditlog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field createdAt", values[i])
+			} else if value.Valid {
+				al.CreatedAt = value.Time
+			}
+		default:
+			al.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AuditLog.
+// This includes values selected through modifiers, order, etc.
+func (al *AuditLog) Value(name string) (ent.Value, error) {
+	return al.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AuditLog.
+// Note that you need to call AuditLog.Unwrap() before calling this method if this AuditLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (al *AuditLog) Update() *AuditLogUpdateOne {
+	return NewAuditLogClient(al.config).UpdateOne(al)
+}
+
+// Unwrap unwraps the AuditLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (al *AuditLog) Unwrap() *AuditLog {
+	_tx, ok := al.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuditLog is not a transactional entity")
+	}
+	al.config.driver = _tx.drv
+	return al
+}
+
+// String implements the fmt.Stringer.
+func (al *AuditLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", al.ID))
+	builder.WriteString("organizationId=")
+	builder.WriteString(fmt.Sprintf("%v", al.OrganizationId))
+	builder.WriteString(", ")
+	if v := al.ActorId; v != nil {
+		builder.WriteString("actorId=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("sessionId=")
+	builder.WriteString(al.SessionId)
+	builder.WriteString(", ")
+	builder.WriteString("entity=")
+	builder.WriteString(al.Entity)
+	builder.WriteString(", ")
+	builder.WriteString("entityId=")
+	builder.WriteString(fmt.Sprintf("%v", al.EntityId))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(al.Action)
+	builder.WriteString(", ")
+	builder.WriteString("changes=")
+	builder.WriteString(fmt.Sprintf("%v", al.Changes))
+	builder.WriteString(", ")
+	builder.WriteString("createdAt=")
+	builder.WriteString(al.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditLogs is a parsable slice of AuditLog.
+type AuditLogs []*AuditLog
@@ -0,0 +1,113 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditlog
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the auditlog type in the database.
+	Label = "audit_log"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOrganizationId holds the string denoting the organizationid field in the database.
+	FieldOrganizationId = "organization_id"
+	// FieldActorId holds the string denoting the actorid field in the database.
+	FieldActorId = "actor_id"
+	// FieldSessionId holds the string denoting the sessionid field in the database.
+	FieldSessionId = "session_id"
+	// FieldEntity holds the string denoting the entity field in the database.
+	FieldEntity = "entity"
+	// FieldEntityId holds the string denoting the entityid field in the database.
+	FieldEntityId = "entity_id"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldChanges holds the string denoting the changes field in the database.
+	FieldChanges = "changes"
+	// FieldCreatedAt holds the string denoting the createdat field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the auditlog in the database.
+	Table = "audit_logs"
+)
+
+// Columns holds all SQL columns for auditlog fields.
+var Columns = []string{
+	FieldID,
+	FieldOrganizationId,
+	FieldActorId,
+	FieldSessionId,
+	FieldEntity,
+	FieldEntityId,
+	FieldAction,
+	FieldChanges,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "hospital/internal/modules/db/ent/runtime"
+var (
+	Hooks  [3]ent.Hook
+	Policy ent.Policy
+	// DefaultCreatedAt holds the default value on creation for the "createdAt" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Order defines the ordering method for the AuditLog queries.
+type Order func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByOrganizationId orders the results by the organizationId field.
+func ByOrganizationId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldOrganizationId, opts...).ToFunc()
+}
+
+// ByActorId orders the results by the actorId field.
+func ByActorId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldActorId, opts...).ToFunc()
+}
+
+// BySessionId orders the results by the sessionId field.
+func BySessionId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldSessionId, opts...).ToFunc()
+}
+
+// ByEntity orders the results by the entity field.
+func ByEntity(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldEntity, opts...).ToFunc()
+}
+
+// ByEntityId orders the results by the entityId field.
+func ByEntityId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldEntityId, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the createdAt field.
+func ByCreatedAt(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,507 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditlog
+
+import (
+	"hospital/internal/modules/db/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldID, id))
+}
+
+// OrganizationId applies equality check predicate on the "organizationId" field. It's identical to OrganizationIdEQ.
+func OrganizationId(v int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldOrganizationId, v))
+}
+
+// ActorId applies equality check predicate on the "actorId" field. It's identical to ActorIdEQ.
+func ActorId(v int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldActorId, v))
+}
+
+// SessionId applies equality check predicate on the "sessionId" field. It's identical to SessionIdEQ.
+func SessionId(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldSessionId, v))
+}
+
+// Entity applies equality check predicate on the "entity" field. It's identical to EntityEQ.
+func Entity(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldEntity, v))
+}
+
+// EntityId applies equality check predicate on the "entityId" field. It's identical to EntityIdEQ.
+func EntityId(v int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldEntityId, v))
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldAction, v))
+}
+
+// CreatedAt applies equality check predicate on the "createdAt" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// OrganizationIdEQ applies the EQ predicate on the "organizationId" field.
+func OrganizationIdEQ(v int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldOrganizationId, v))
+}
+
+// OrganizationIdNEQ applies the NEQ predicate on the "organizationId" field.
+func OrganizationIdNEQ(v int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldOrganizationId, v))
+}
+
+// OrganizationIdIn applies the In predicate on the "organizationId" field.
+func OrganizationIdIn(vs ...int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldOrganizationId, vs...))
+}
+
+// OrganizationIdNotIn applies the NotIn predicate on the "organizationId" field.
+func OrganizationIdNotIn(vs ...int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldOrganizationId, vs...))
+}
+
+// OrganizationIdGT applies the GT predicate on the "organizationId" field.
+func OrganizationIdGT(v int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldOrganizationId, v))
+}
+
+// OrganizationIdGTE applies the GTE predicate on the "organizationId" field.
+func OrganizationIdGTE(v int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldOrganizationId, v))
+}
+
+// OrganizationIdLT applies the LT predicate on the "organizationId" field.
+func OrganizationIdLT(v int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldOrganizationId, v))
+}
+
+// OrganizationIdLTE applies the LTE predicate on the "organizationId" field.
+func OrganizationIdLTE(v int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldOrganizationId, v))
+}
+
+// OrganizationIdIsNil applies the IsNil predicate on the "organizationId" field.
+func OrganizationIdIsNil() predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIsNull(FieldOrganizationId))
+}
+
+// OrganizationIdNotNil applies the NotNil predicate on the "organizationId" field.
+func OrganizationIdNotNil() predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotNull(FieldOrganizationId))
+}
+
+// ActorIdEQ applies the EQ predicate on the "actorId" field.
+func ActorIdEQ(v int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldActorId, v))
+}
+
+// ActorIdNEQ applies the NEQ predicate on the "actorId" field.
+func ActorIdNEQ(v int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldActorId, v))
+}
+
+// ActorIdIn applies the In predicate on the "actorId" field.
+func ActorIdIn(vs ...int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldActorId, vs...))
+}
+
+// ActorIdNotIn applies the NotIn predicate on the "actorId" field.
+func ActorIdNotIn(vs ...int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldActorId, vs...))
+}
+
+// ActorIdGT applies the GT predicate on the "actorId" field.
+func ActorIdGT(v int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldActorId, v))
+}
+
+// ActorIdGTE applies the GTE predicate on the "actorId" field.
+func ActorIdGTE(v int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldActorId, v))
+}
+
+// ActorIdLT applies the LT predicate on the "actorId" field.
+func ActorIdLT(v int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldActorId, v))
+}
+
+// ActorIdLTE applies the LTE predicate on the "actorId" field.
+func ActorIdLTE(v int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldActorId, v))
+}
+
+// ActorIdIsNil applies the IsNil predicate on the "actorId" field.
+func ActorIdIsNil() predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIsNull(FieldActorId))
+}
+
+// ActorIdNotNil applies the NotNil predicate on the "actorId" field.
+func ActorIdNotNil() predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotNull(FieldActorId))
+}
+
+// SessionIdEQ applies the EQ predicate on the "sessionId" field.
+func SessionIdEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldSessionId, v))
+}
+
+// SessionIdNEQ applies the NEQ predicate on the "sessionId" field.
+func SessionIdNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldSessionId, v))
+}
+
+// SessionIdIn applies the In predicate on the "sessionId" field.
+func SessionIdIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldSessionId, vs...))
+}
+
+// SessionIdNotIn applies the NotIn predicate on the "sessionId" field.
+func SessionIdNotIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldSessionId, vs...))
+}
+
+// SessionIdGT applies the GT predicate on the "sessionId" field.
+func SessionIdGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldSessionId, v))
+}
+
+// SessionIdGTE applies the GTE predicate on the "sessionId" field.
+func SessionIdGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldSessionId, v))
+}
+
+// SessionIdLT applies the LT predicate on the "sessionId" field.
+func SessionIdLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldSessionId, v))
+}
+
+// SessionIdLTE applies the LTE predicate on the "sessionId" field.
+func SessionIdLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldSessionId, v))
+}
+
+// SessionIdContains applies the Contains predicate on the "sessionId" field.
+func SessionIdContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContains(FieldSessionId, v))
+}
+
+// SessionIdHasPrefix applies the HasPrefix predicate on the "sessionId" field.
+func SessionIdHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasPrefix(FieldSessionId, v))
+}
+
+// SessionIdHasSuffix applies the HasSuffix predicate on the "sessionId" field.
+func SessionIdHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasSuffix(FieldSessionId, v))
+}
+
+// SessionIdIsNil applies the IsNil predicate on the "sessionId" field.
+func SessionIdIsNil() predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIsNull(FieldSessionId))
+}
+
+// SessionIdNotNil applies the NotNil predicate on the "sessionId" field.
+func SessionIdNotNil() predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotNull(FieldSessionId))
+}
+
+// SessionIdEqualFold applies the EqualFold predicate on the "sessionId" field.
+func SessionIdEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEqualFold(FieldSessionId, v))
+}
+
+// SessionIdContainsFold applies the ContainsFold predicate on the "sessionId" field.
+func SessionIdContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContainsFold(FieldSessionId, v))
+}
+
+// EntityEQ applies the EQ predicate on the "entity" field.
+func EntityEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldEntity, v))
+}
+
+// EntityNEQ applies the NEQ predicate on the "entity" field.
+func EntityNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldEntity, v))
+}
+
+// EntityIn applies the In predicate on the "entity" field.
+func EntityIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldEntity, vs...))
+}
+
+// EntityNotIn applies the NotIn predicate on the "entity" field.
+func EntityNotIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldEntity, vs...))
+}
+
+// EntityGT applies the GT predicate on the "entity" field.
+func EntityGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldEntity, v))
+}
+
+// EntityGTE applies the GTE predicate on the "entity" field.
+func EntityGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldEntity, v))
+}
+
+// EntityLT applies the LT predicate on the "entity" field.
+func EntityLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldEntity, v))
+}
+
+// EntityLTE applies the LTE predicate on the "entity" field.
+func EntityLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldEntity, v))
+}
+
+// EntityContains applies the Contains predicate on the "entity" field.
+func EntityContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContains(FieldEntity, v))
+}
+
+// EntityHasPrefix applies the HasPrefix predicate on the "entity" field.
+func EntityHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasPrefix(FieldEntity, v))
+}
+
+// EntityHasSuffix applies the HasSuffix predicate on the "entity" field.
+func EntityHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasSuffix(FieldEntity, v))
+}
+
+// EntityEqualFold applies the EqualFold predicate on the "entity" field.
+func EntityEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEqualFold(FieldEntity, v))
+}
+
+// EntityContainsFold applies the ContainsFold predicate on the "entity" field.
+func EntityContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContainsFold(FieldEntity, v))
+}
+
+// EntityIdEQ applies the EQ predicate on the "entityId" field.
+func EntityIdEQ(v int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldEntityId, v))
+}
+
+// EntityIdNEQ applies the NEQ predicate on the "entityId" field.
+func EntityIdNEQ(v int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldEntityId, v))
+}
+
+// EntityIdIn applies the In predicate on the "entityId" field.
+func EntityIdIn(vs ...int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldEntityId, vs...))
+}
+
+// EntityIdNotIn applies the NotIn predicate on the "entityId" field.
+func EntityIdNotIn(vs ...int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldEntityId, vs...))
+}
+
+// EntityIdGT applies the GT predicate on the "entityId" field.
+func EntityIdGT(v int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldEntityId, v))
+}
+
+// EntityIdGTE applies the GTE predicate on the "entityId" field.
+func EntityIdGTE(v int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldEntityId, v))
+}
+
+// EntityIdLT applies the LT predicate on the "entityId" field.
+func EntityIdLT(v int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldEntityId, v))
+}
+
+// EntityIdLTE applies the LTE predicate on the "entityId" field.
+func EntityIdLTE(v int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldEntityId, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldAction, v))
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldAction, v))
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldAction, v))
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldAction, v))
+}
+
+// ActionContains applies the Contains predicate on the "action" field.
+func ActionContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContains(FieldAction, v))
+}
+
+// ActionHasPrefix applies the HasPrefix predicate on the "action" field.
+func ActionHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasPrefix(FieldAction, v))
+}
+
+// ActionHasSuffix applies the HasSuffix predicate on the "action" field.
+func ActionHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasSuffix(FieldAction, v))
+}
+
+// ActionEqualFold applies the EqualFold predicate on the "action" field.
+func ActionEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEqualFold(FieldAction, v))
+}
+
+// ActionContainsFold applies the ContainsFold predicate on the "action" field.
+func ActionContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContainsFold(FieldAction, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "createdAt" field.
+func CreatedAtEQ(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "createdAt" field.
+func CreatedAtNEQ(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "createdAt" field.
+func CreatedAtIn(vs ...time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "createdAt" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "createdAt" field.
+func CreatedAtGT(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "createdAt" field.
+func CreatedAtGTE(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "createdAt" field.
+func CreatedAtLT(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "createdAt" field.
+func CreatedAtLTE(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AuditLog) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AuditLog) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AuditLog) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
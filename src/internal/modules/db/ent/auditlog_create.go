@@ -0,0 +1,311 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hospital/internal/models/audit"
+	"hospital/internal/modules/db/ent/auditlog"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuditLogCreate is the builder for creating a AuditLog entity.
+type AuditLogCreate struct {
+	config
+	mutation *AuditLogMutation
+	hooks    []Hook
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (alc *AuditLogCreate) SetOrganizationId(i int) *AuditLogCreate {
+	alc.mutation.SetOrganizationId(i)
+	return alc
+}
+
+// SetNillableOrganizationId sets the "organizationId" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableOrganizationId(i *int) *AuditLogCreate {
+	if i != nil {
+		alc.SetOrganizationId(*i)
+	}
+	return alc
+}
+
+// SetActorId sets the "actorId" field.
+func (alc *AuditLogCreate) SetActorId(i int) *AuditLogCreate {
+	alc.mutation.SetActorId(i)
+	return alc
+}
+
+// SetNillableActorId sets the "actorId" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableActorId(i *int) *AuditLogCreate {
+	if i != nil {
+		alc.SetActorId(*i)
+	}
+	return alc
+}
+
+// SetSessionId sets the "sessionId" field.
+func (alc *AuditLogCreate) SetSessionId(s string) *AuditLogCreate {
+	alc.mutation.SetSessionId(s)
+	return alc
+}
+
+// SetNillableSessionId sets the "sessionId" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableSessionId(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetSessionId(*s)
+	}
+	return alc
+}
+
+// SetEntity sets the "entity" field.
+func (alc *AuditLogCreate) SetEntity(s string) *AuditLogCreate {
+	alc.mutation.SetEntity(s)
+	return alc
+}
+
+// SetEntityId sets the "entityId" field.
+func (alc *AuditLogCreate) SetEntityId(i int) *AuditLogCreate {
+	alc.mutation.SetEntityId(i)
+	return alc
+}
+
+// SetAction sets the "action" field.
+func (alc *AuditLogCreate) SetAction(s string) *AuditLogCreate {
+	alc.mutation.SetAction(s)
+	return alc
+}
+
+// SetChanges sets the "changes" field.
+func (alc *AuditLogCreate) SetChanges(a audit.Changes) *AuditLogCreate {
+	alc.mutation.SetChanges(a)
+	return alc
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (alc *AuditLogCreate) SetCreatedAt(t time.Time) *AuditLogCreate {
+	alc.mutation.SetCreatedAt(t)
+	return alc
+}
+
+// SetNillableCreatedAt sets the "createdAt" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableCreatedAt(t *time.Time) *AuditLogCreate {
+	if t != nil {
+		alc.SetCreatedAt(*t)
+	}
+	return alc
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (alc *AuditLogCreate) Mutation() *AuditLogMutation {
+	return alc.mutation
+}
+
+// Save creates the AuditLog in the database.
+func (alc *AuditLogCreate) Save(ctx context.Context) (*AuditLog, error) {
+	if err := alc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks[*AuditLog, AuditLogMutation](ctx, alc.sqlSave, alc.mutation, alc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (alc *AuditLogCreate) SaveX(ctx context.Context) *AuditLog {
+	v, err := alc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alc *AuditLogCreate) Exec(ctx context.Context) error {
+	_, err := alc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alc *AuditLogCreate) ExecX(ctx context.Context) {
+	if err := alc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (alc *AuditLogCreate) defaults() error {
+	if _, ok := alc.mutation.CreatedAt(); !ok {
+		if auditlog.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized auditlog.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := auditlog.DefaultCreatedAt()
+		alc.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (alc *AuditLogCreate) check() error {
+	if _, ok := alc.mutation.Entity(); !ok {
+		return &ValidationError{Name: "entity", err: errors.New(`ent: missing required field "AuditLog.entity"`)}
+	}
+	if _, ok := alc.mutation.EntityId(); !ok {
+		return &ValidationError{Name: "entityId", err: errors.New(`ent: missing required field "AuditLog.entityId"`)}
+	}
+	if _, ok := alc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "AuditLog.action"`)}
+	}
+	if _, ok := alc.mutation.Changes(); !ok {
+		return &ValidationError{Name: "changes", err: errors.New(`ent: missing required field "AuditLog.changes"`)}
+	}
+	if _, ok := alc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "createdAt", err: errors.New(`ent: missing required field "AuditLog.createdAt"`)}
+	}
+	return nil
+}
+
+func (alc *AuditLogCreate) sqlSave(ctx context.Context) (*AuditLog, error) {
+	if err := alc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := alc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, alc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	alc.mutation.id = &_node.ID
+	alc.mutation.done = true
+	return _node, nil
+}
+
+func (alc *AuditLogCreate) createSpec() (*AuditLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditLog{config: alc.config}
+		_spec = sqlgraph.NewCreateSpec(auditlog.Table, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt))
+	)
+	if value, ok := alc.mutation.OrganizationId(); ok {
+		_spec.SetField(auditlog.FieldOrganizationId, field.TypeInt, value)
+		_node.OrganizationId = value
+	}
+	if value, ok := alc.mutation.ActorId(); ok {
+		_spec.SetField(auditlog.FieldActorId, field.TypeInt, value)
+		_node.ActorId = &value
+	}
+	if value, ok := alc.mutation.SessionId(); ok {
+		_spec.SetField(auditlog.FieldSessionId, field.TypeString, value)
+		_node.SessionId = value
+	}
+	if value, ok := alc.mutation.Entity(); ok {
+		_spec.SetField(auditlog.FieldEntity, field.TypeString, value)
+		_node.Entity = value
+	}
+	if value, ok := alc.mutation.EntityId(); ok {
+		_spec.SetField(auditlog.FieldEntityId, field.TypeInt, value)
+		_node.EntityId = value
+	}
+	if value, ok := alc.mutation.Action(); ok {
+		_spec.SetField(auditlog.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := alc.mutation.Changes(); ok {
+		_spec.SetField(auditlog.FieldChanges, field.TypeJSON, value)
+		_node.Changes = value
+	}
+	if value, ok := alc.mutation.CreatedAt(); ok {
+		_spec.SetField(auditlog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AuditLogCreateBulk is the builder for creating many AuditLog entities in bulk.
+type AuditLogCreateBulk struct {
+	config
+	builders []*AuditLogCreate
+}
+
+// Save creates the AuditLog entities in the database.
+func (alcb *AuditLogCreateBulk) Save(ctx context.Context) ([]*AuditLog, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(alcb.builders))
+	nodes := make([]*AuditLog, len(alcb.builders))
+	mutators := make([]Mutator, len(alcb.builders))
+	for i := range alcb.builders {
+		func(i int, root context.Context) {
+			builder := alcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, alcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, alcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, alcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) SaveX(ctx context.Context) []*AuditLog {
+	v, err := alcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alcb *AuditLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := alcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) ExecX(ctx context.Context) {
+	if err := alcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -10,10 +10,23 @@ import (
 
 	"hospital/internal/modules/db/ent/migrate"
 
+	"hospital/internal/modules/db/ent/accesslog"
+	"hospital/internal/modules/db/ent/alert"
+	"hospital/internal/modules/db/ent/auditlog"
+	"hospital/internal/modules/db/ent/confirmtoken"
+	"hospital/internal/modules/db/ent/conversation"
+	"hospital/internal/modules/db/ent/department"
 	"hospital/internal/modules/db/ent/disease"
 	"hospital/internal/modules/db/ent/doctor"
+	"hospital/internal/modules/db/ent/jobstate"
+	"hospital/internal/modules/db/ent/notificationpreference"
+	"hospital/internal/modules/db/ent/organization"
 	"hospital/internal/modules/db/ent/patient"
 	"hospital/internal/modules/db/ent/room"
+	"hospital/internal/modules/db/ent/session"
+	"hospital/internal/modules/db/ent/vital"
+	"hospital/internal/modules/db/ent/wardchat"
+	"hospital/internal/modules/db/ent/wardnotice"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -26,14 +39,40 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AccessLog is the client for interacting with the AccessLog builders.
+	AccessLog *AccessLogClient
+	// Alert is the client for interacting with the Alert builders.
+	Alert *AlertClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// ConfirmToken is the client for interacting with the ConfirmToken builders.
+	ConfirmToken *ConfirmTokenClient
+	// Conversation is the client for interacting with the Conversation builders.
+	Conversation *ConversationClient
+	// Department is the client for interacting with the Department builders.
+	Department *DepartmentClient
 	// Disease is the client for interacting with the Disease builders.
 	Disease *DiseaseClient
 	// Doctor is the client for interacting with the Doctor builders.
 	Doctor *DoctorClient
+	// JobState is the client for interacting with the JobState builders.
+	JobState *JobStateClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
+	// Organization is the client for interacting with the Organization builders.
+	Organization *OrganizationClient
 	// Patient is the client for interacting with the Patient builders.
 	Patient *PatientClient
 	// Room is the client for interacting with the Room builders.
 	Room *RoomClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
+	// Vital is the client for interacting with the Vital builders.
+	Vital *VitalClient
+	// WardChat is the client for interacting with the WardChat builders.
+	WardChat *WardChatClient
+	// WardNotice is the client for interacting with the WardNotice builders.
+	WardNotice *WardNoticeClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -47,10 +86,23 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AccessLog = NewAccessLogClient(c.config)
+	c.Alert = NewAlertClient(c.config)
+	c.AuditLog = NewAuditLogClient(c.config)
+	c.ConfirmToken = NewConfirmTokenClient(c.config)
+	c.Conversation = NewConversationClient(c.config)
+	c.Department = NewDepartmentClient(c.config)
 	c.Disease = NewDiseaseClient(c.config)
 	c.Doctor = NewDoctorClient(c.config)
+	c.JobState = NewJobStateClient(c.config)
+	c.NotificationPreference = NewNotificationPreferenceClient(c.config)
+	c.Organization = NewOrganizationClient(c.config)
 	c.Patient = NewPatientClient(c.config)
 	c.Room = NewRoomClient(c.config)
+	c.Session = NewSessionClient(c.config)
+	c.Vital = NewVitalClient(c.config)
+	c.WardChat = NewWardChatClient(c.config)
+	c.WardNotice = NewWardNoticeClient(c.config)
 }
 
 type (
@@ -131,12 +183,25 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Disease: NewDiseaseClient(cfg),
-		Doctor:  NewDoctorClient(cfg),
-		Patient: NewPatientClient(cfg),
-		Room:    NewRoomClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		AccessLog:              NewAccessLogClient(cfg),
+		Alert:                  NewAlertClient(cfg),
+		AuditLog:               NewAuditLogClient(cfg),
+		ConfirmToken:           NewConfirmTokenClient(cfg),
+		Conversation:           NewConversationClient(cfg),
+		Department:             NewDepartmentClient(cfg),
+		Disease:                NewDiseaseClient(cfg),
+		Doctor:                 NewDoctorClient(cfg),
+		JobState:               NewJobStateClient(cfg),
+		NotificationPreference: NewNotificationPreferenceClient(cfg),
+		Organization:           NewOrganizationClient(cfg),
+		Patient:                NewPatientClient(cfg),
+		Room:                   NewRoomClient(cfg),
+		Session:                NewSessionClient(cfg),
+		Vital:                  NewVitalClient(cfg),
+		WardChat:               NewWardChatClient(cfg),
+		WardNotice:             NewWardNoticeClient(cfg),
 	}, nil
 }
 
@@ -154,19 +219,32 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Disease: NewDiseaseClient(cfg),
-		Doctor:  NewDoctorClient(cfg),
-		Patient: NewPatientClient(cfg),
-		Room:    NewRoomClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		AccessLog:              NewAccessLogClient(cfg),
+		Alert:                  NewAlertClient(cfg),
+		AuditLog:               NewAuditLogClient(cfg),
+		ConfirmToken:           NewConfirmTokenClient(cfg),
+		Conversation:           NewConversationClient(cfg),
+		Department:             NewDepartmentClient(cfg),
+		Disease:                NewDiseaseClient(cfg),
+		Doctor:                 NewDoctorClient(cfg),
+		JobState:               NewJobStateClient(cfg),
+		NotificationPreference: NewNotificationPreferenceClient(cfg),
+		Organization:           NewOrganizationClient(cfg),
+		Patient:                NewPatientClient(cfg),
+		Room:                   NewRoomClient(cfg),
+		Session:                NewSessionClient(cfg),
+		Vital:                  NewVitalClient(cfg),
+		WardChat:               NewWardChatClient(cfg),
+		WardNotice:             NewWardNoticeClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Disease.
+//		AccessLog.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -188,123 +266,155 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Disease.Use(hooks...)
-	c.Doctor.Use(hooks...)
-	c.Patient.Use(hooks...)
-	c.Room.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.AccessLog, c.Alert, c.AuditLog, c.ConfirmToken, c.Conversation, c.Department,
+		c.Disease, c.Doctor, c.JobState, c.NotificationPreference, c.Organization,
+		c.Patient, c.Room, c.Session, c.Vital, c.WardChat, c.WardNotice,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Disease.Intercept(interceptors...)
-	c.Doctor.Intercept(interceptors...)
-	c.Patient.Intercept(interceptors...)
-	c.Room.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.AccessLog, c.Alert, c.AuditLog, c.ConfirmToken, c.Conversation, c.Department,
+		c.Disease, c.Doctor, c.JobState, c.NotificationPreference, c.Organization,
+		c.Patient, c.Room, c.Session, c.Vital, c.WardChat, c.WardNotice,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AccessLogMutation:
+		return c.AccessLog.mutate(ctx, m)
+	case *AlertMutation:
+		return c.Alert.mutate(ctx, m)
+	case *AuditLogMutation:
+		return c.AuditLog.mutate(ctx, m)
+	case *ConfirmTokenMutation:
+		return c.ConfirmToken.mutate(ctx, m)
+	case *ConversationMutation:
+		return c.Conversation.mutate(ctx, m)
+	case *DepartmentMutation:
+		return c.Department.mutate(ctx, m)
 	case *DiseaseMutation:
 		return c.Disease.mutate(ctx, m)
 	case *DoctorMutation:
 		return c.Doctor.mutate(ctx, m)
+	case *JobStateMutation:
+		return c.JobState.mutate(ctx, m)
+	case *NotificationPreferenceMutation:
+		return c.NotificationPreference.mutate(ctx, m)
+	case *OrganizationMutation:
+		return c.Organization.mutate(ctx, m)
 	case *PatientMutation:
 		return c.Patient.mutate(ctx, m)
 	case *RoomMutation:
 		return c.Room.mutate(ctx, m)
+	case *SessionMutation:
+		return c.Session.mutate(ctx, m)
+	case *VitalMutation:
+		return c.Vital.mutate(ctx, m)
+	case *WardChatMutation:
+		return c.WardChat.mutate(ctx, m)
+	case *WardNoticeMutation:
+		return c.WardNotice.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
-// DiseaseClient is a client for the Disease schema.
-type DiseaseClient struct {
+// AccessLogClient is a client for the AccessLog schema.
+type AccessLogClient struct {
 	config
 }
 
-// NewDiseaseClient returns a client for the Disease from the given config.
-func NewDiseaseClient(c config) *DiseaseClient {
-	return &DiseaseClient{config: c}
+// NewAccessLogClient returns a client for the AccessLog from the given config.
+func NewAccessLogClient(c config) *AccessLogClient {
+	return &AccessLogClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `disease.Hooks(f(g(h())))`.
-func (c *DiseaseClient) Use(hooks ...Hook) {
-	c.hooks.Disease = append(c.hooks.Disease, hooks...)
+// A call to `Use(f, g, h)` equals to `accesslog.Hooks(f(g(h())))`.
+func (c *AccessLogClient) Use(hooks ...Hook) {
+	c.hooks.AccessLog = append(c.hooks.AccessLog, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `disease.Intercept(f(g(h())))`.
-func (c *DiseaseClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Disease = append(c.inters.Disease, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `accesslog.Intercept(f(g(h())))`.
+func (c *AccessLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AccessLog = append(c.inters.AccessLog, interceptors...)
 }
 
-// Create returns a builder for creating a Disease entity.
-func (c *DiseaseClient) Create() *DiseaseCreate {
-	mutation := newDiseaseMutation(c.config, OpCreate)
-	return &DiseaseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AccessLog entity.
+func (c *AccessLogClient) Create() *AccessLogCreate {
+	mutation := newAccessLogMutation(c.config, OpCreate)
+	return &AccessLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Disease entities.
-func (c *DiseaseClient) CreateBulk(builders ...*DiseaseCreate) *DiseaseCreateBulk {
-	return &DiseaseCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AccessLog entities.
+func (c *AccessLogClient) CreateBulk(builders ...*AccessLogCreate) *AccessLogCreateBulk {
+	return &AccessLogCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Disease.
-func (c *DiseaseClient) Update() *DiseaseUpdate {
-	mutation := newDiseaseMutation(c.config, OpUpdate)
-	return &DiseaseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AccessLog.
+func (c *AccessLogClient) Update() *AccessLogUpdate {
+	mutation := newAccessLogMutation(c.config, OpUpdate)
+	return &AccessLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DiseaseClient) UpdateOne(d *Disease) *DiseaseUpdateOne {
-	mutation := newDiseaseMutation(c.config, OpUpdateOne, withDisease(d))
-	return &DiseaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AccessLogClient) UpdateOne(al *AccessLog) *AccessLogUpdateOne {
+	mutation := newAccessLogMutation(c.config, OpUpdateOne, withAccessLog(al))
+	return &AccessLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DiseaseClient) UpdateOneID(id int) *DiseaseUpdateOne {
-	mutation := newDiseaseMutation(c.config, OpUpdateOne, withDiseaseID(id))
-	return &DiseaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AccessLogClient) UpdateOneID(id int) *AccessLogUpdateOne {
+	mutation := newAccessLogMutation(c.config, OpUpdateOne, withAccessLogID(id))
+	return &AccessLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Disease.
-func (c *DiseaseClient) Delete() *DiseaseDelete {
-	mutation := newDiseaseMutation(c.config, OpDelete)
-	return &DiseaseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AccessLog.
+func (c *AccessLogClient) Delete() *AccessLogDelete {
+	mutation := newAccessLogMutation(c.config, OpDelete)
+	return &AccessLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DiseaseClient) DeleteOne(d *Disease) *DiseaseDeleteOne {
-	return c.DeleteOneID(d.ID)
+func (c *AccessLogClient) DeleteOne(al *AccessLog) *AccessLogDeleteOne {
+	return c.DeleteOneID(al.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DiseaseClient) DeleteOneID(id int) *DiseaseDeleteOne {
-	builder := c.Delete().Where(disease.ID(id))
+func (c *AccessLogClient) DeleteOneID(id int) *AccessLogDeleteOne {
+	builder := c.Delete().Where(accesslog.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DiseaseDeleteOne{builder}
+	return &AccessLogDeleteOne{builder}
 }
 
-// Query returns a query builder for Disease.
-func (c *DiseaseClient) Query() *DiseaseQuery {
-	return &DiseaseQuery{
+// Query returns a query builder for AccessLog.
+func (c *AccessLogClient) Query() *AccessLogQuery {
+	return &AccessLogQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDisease},
+		ctx:    &QueryContext{Type: TypeAccessLog},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Disease entity by its id.
-func (c *DiseaseClient) Get(ctx context.Context, id int) (*Disease, error) {
-	return c.Query().Where(disease.ID(id)).Only(ctx)
+// Get returns a AccessLog entity by its id.
+func (c *AccessLogClient) Get(ctx context.Context, id int) (*AccessLog, error) {
+	return c.Query().Where(accesslog.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DiseaseClient) GetX(ctx context.Context, id int) *Disease {
+func (c *AccessLogClient) GetX(ctx context.Context, id int) *AccessLog {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -312,133 +422,118 @@ func (c *DiseaseClient) GetX(ctx context.Context, id int) *Disease {
 	return obj
 }
 
-// QueryHas queries the has edge of a Disease.
-func (c *DiseaseClient) QueryHas(d *Disease) *PatientQuery {
-	query := (&PatientClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := d.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(disease.Table, disease.FieldID, id),
-			sqlgraph.To(patient.Table, patient.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, disease.HasTable, disease.HasColumn),
-		)
-		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *DiseaseClient) Hooks() []Hook {
-	return c.hooks.Disease
+func (c *AccessLogClient) Hooks() []Hook {
+	hooks := c.hooks.AccessLog
+	return append(hooks[:len(hooks):len(hooks)], accesslog.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *DiseaseClient) Interceptors() []Interceptor {
-	return c.inters.Disease
+func (c *AccessLogClient) Interceptors() []Interceptor {
+	return c.inters.AccessLog
 }
 
-func (c *DiseaseClient) mutate(ctx context.Context, m *DiseaseMutation) (Value, error) {
+func (c *AccessLogClient) mutate(ctx context.Context, m *AccessLogMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DiseaseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AccessLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DiseaseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AccessLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DiseaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AccessLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DiseaseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AccessLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Disease mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AccessLog mutation op: %q", m.Op())
 	}
 }
 
-// DoctorClient is a client for the Doctor schema.
-type DoctorClient struct {
+// AlertClient is a client for the Alert schema.
+type AlertClient struct {
 	config
 }
 
-// NewDoctorClient returns a client for the Doctor from the given config.
-func NewDoctorClient(c config) *DoctorClient {
-	return &DoctorClient{config: c}
+// NewAlertClient returns a client for the Alert from the given config.
+func NewAlertClient(c config) *AlertClient {
+	return &AlertClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `doctor.Hooks(f(g(h())))`.
-func (c *DoctorClient) Use(hooks ...Hook) {
-	c.hooks.Doctor = append(c.hooks.Doctor, hooks...)
+// A call to `Use(f, g, h)` equals to `alert.Hooks(f(g(h())))`.
+func (c *AlertClient) Use(hooks ...Hook) {
+	c.hooks.Alert = append(c.hooks.Alert, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `doctor.Intercept(f(g(h())))`.
-func (c *DoctorClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Doctor = append(c.inters.Doctor, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `alert.Intercept(f(g(h())))`.
+func (c *AlertClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Alert = append(c.inters.Alert, interceptors...)
 }
 
-// Create returns a builder for creating a Doctor entity.
-func (c *DoctorClient) Create() *DoctorCreate {
-	mutation := newDoctorMutation(c.config, OpCreate)
-	return &DoctorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Alert entity.
+func (c *AlertClient) Create() *AlertCreate {
+	mutation := newAlertMutation(c.config, OpCreate)
+	return &AlertCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Doctor entities.
-func (c *DoctorClient) CreateBulk(builders ...*DoctorCreate) *DoctorCreateBulk {
-	return &DoctorCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Alert entities.
+func (c *AlertClient) CreateBulk(builders ...*AlertCreate) *AlertCreateBulk {
+	return &AlertCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Doctor.
-func (c *DoctorClient) Update() *DoctorUpdate {
-	mutation := newDoctorMutation(c.config, OpUpdate)
-	return &DoctorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Alert.
+func (c *AlertClient) Update() *AlertUpdate {
+	mutation := newAlertMutation(c.config, OpUpdate)
+	return &AlertUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DoctorClient) UpdateOne(d *Doctor) *DoctorUpdateOne {
-	mutation := newDoctorMutation(c.config, OpUpdateOne, withDoctor(d))
-	return &DoctorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AlertClient) UpdateOne(a *Alert) *AlertUpdateOne {
+	mutation := newAlertMutation(c.config, OpUpdateOne, withAlert(a))
+	return &AlertUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DoctorClient) UpdateOneID(id int) *DoctorUpdateOne {
-	mutation := newDoctorMutation(c.config, OpUpdateOne, withDoctorID(id))
-	return &DoctorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AlertClient) UpdateOneID(id int) *AlertUpdateOne {
+	mutation := newAlertMutation(c.config, OpUpdateOne, withAlertID(id))
+	return &AlertUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Doctor.
-func (c *DoctorClient) Delete() *DoctorDelete {
-	mutation := newDoctorMutation(c.config, OpDelete)
-	return &DoctorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Alert.
+func (c *AlertClient) Delete() *AlertDelete {
+	mutation := newAlertMutation(c.config, OpDelete)
+	return &AlertDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DoctorClient) DeleteOne(d *Doctor) *DoctorDeleteOne {
-	return c.DeleteOneID(d.ID)
+func (c *AlertClient) DeleteOne(a *Alert) *AlertDeleteOne {
+	return c.DeleteOneID(a.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DoctorClient) DeleteOneID(id int) *DoctorDeleteOne {
-	builder := c.Delete().Where(doctor.ID(id))
+func (c *AlertClient) DeleteOneID(id int) *AlertDeleteOne {
+	builder := c.Delete().Where(alert.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DoctorDeleteOne{builder}
+	return &AlertDeleteOne{builder}
 }
 
-// Query returns a query builder for Doctor.
-func (c *DoctorClient) Query() *DoctorQuery {
-	return &DoctorQuery{
+// Query returns a query builder for Alert.
+func (c *AlertClient) Query() *AlertQuery {
+	return &AlertQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDoctor},
+		ctx:    &QueryContext{Type: TypeAlert},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Doctor entity by its id.
-func (c *DoctorClient) Get(ctx context.Context, id int) (*Doctor, error) {
-	return c.Query().Where(doctor.ID(id)).Only(ctx)
+// Get returns a Alert entity by its id.
+func (c *AlertClient) Get(ctx context.Context, id int) (*Alert, error) {
+	return c.Query().Where(alert.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DoctorClient) GetX(ctx context.Context, id int) *Doctor {
+func (c *AlertClient) GetX(ctx context.Context, id int) *Alert {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -446,133 +541,118 @@ func (c *DoctorClient) GetX(ctx context.Context, id int) *Doctor {
 	return obj
 }
 
-// QueryTreats queries the treats edge of a Doctor.
-func (c *DoctorClient) QueryTreats(d *Doctor) *PatientQuery {
-	query := (&PatientClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := d.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(doctor.Table, doctor.FieldID, id),
-			sqlgraph.To(patient.Table, patient.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, doctor.TreatsTable, doctor.TreatsPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *DoctorClient) Hooks() []Hook {
-	return c.hooks.Doctor
+func (c *AlertClient) Hooks() []Hook {
+	hooks := c.hooks.Alert
+	return append(hooks[:len(hooks):len(hooks)], alert.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *DoctorClient) Interceptors() []Interceptor {
-	return c.inters.Doctor
+func (c *AlertClient) Interceptors() []Interceptor {
+	return c.inters.Alert
 }
 
-func (c *DoctorClient) mutate(ctx context.Context, m *DoctorMutation) (Value, error) {
+func (c *AlertClient) mutate(ctx context.Context, m *AlertMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DoctorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AlertCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DoctorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AlertUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DoctorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AlertUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DoctorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AlertDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Doctor mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Alert mutation op: %q", m.Op())
 	}
 }
 
-// PatientClient is a client for the Patient schema.
-type PatientClient struct {
+// AuditLogClient is a client for the AuditLog schema.
+type AuditLogClient struct {
 	config
 }
 
-// NewPatientClient returns a client for the Patient from the given config.
-func NewPatientClient(c config) *PatientClient {
-	return &PatientClient{config: c}
+// NewAuditLogClient returns a client for the AuditLog from the given config.
+func NewAuditLogClient(c config) *AuditLogClient {
+	return &AuditLogClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `patient.Hooks(f(g(h())))`.
-func (c *PatientClient) Use(hooks ...Hook) {
-	c.hooks.Patient = append(c.hooks.Patient, hooks...)
+// A call to `Use(f, g, h)` equals to `auditlog.Hooks(f(g(h())))`.
+func (c *AuditLogClient) Use(hooks ...Hook) {
+	c.hooks.AuditLog = append(c.hooks.AuditLog, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `patient.Intercept(f(g(h())))`.
-func (c *PatientClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Patient = append(c.inters.Patient, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `auditlog.Intercept(f(g(h())))`.
+func (c *AuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditLog = append(c.inters.AuditLog, interceptors...)
 }
 
-// Create returns a builder for creating a Patient entity.
-func (c *PatientClient) Create() *PatientCreate {
-	mutation := newPatientMutation(c.config, OpCreate)
-	return &PatientCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AuditLog entity.
+func (c *AuditLogClient) Create() *AuditLogCreate {
+	mutation := newAuditLogMutation(c.config, OpCreate)
+	return &AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Patient entities.
-func (c *PatientClient) CreateBulk(builders ...*PatientCreate) *PatientCreateBulk {
-	return &PatientCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AuditLog entities.
+func (c *AuditLogClient) CreateBulk(builders ...*AuditLogCreate) *AuditLogCreateBulk {
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Patient.
-func (c *PatientClient) Update() *PatientUpdate {
-	mutation := newPatientMutation(c.config, OpUpdate)
-	return &PatientUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AuditLog.
+func (c *AuditLogClient) Update() *AuditLogUpdate {
+	mutation := newAuditLogMutation(c.config, OpUpdate)
+	return &AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PatientClient) UpdateOne(pa *Patient) *PatientUpdateOne {
-	mutation := newPatientMutation(c.config, OpUpdateOne, withPatient(pa))
-	return &PatientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AuditLogClient) UpdateOne(al *AuditLog) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLog(al))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PatientClient) UpdateOneID(id int) *PatientUpdateOne {
-	mutation := newPatientMutation(c.config, OpUpdateOne, withPatientID(id))
-	return &PatientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AuditLogClient) UpdateOneID(id int) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLogID(id))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Patient.
-func (c *PatientClient) Delete() *PatientDelete {
-	mutation := newPatientMutation(c.config, OpDelete)
-	return &PatientDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AuditLog.
+func (c *AuditLogClient) Delete() *AuditLogDelete {
+	mutation := newAuditLogMutation(c.config, OpDelete)
+	return &AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PatientClient) DeleteOne(pa *Patient) *PatientDeleteOne {
-	return c.DeleteOneID(pa.ID)
+func (c *AuditLogClient) DeleteOne(al *AuditLog) *AuditLogDeleteOne {
+	return c.DeleteOneID(al.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PatientClient) DeleteOneID(id int) *PatientDeleteOne {
-	builder := c.Delete().Where(patient.ID(id))
+func (c *AuditLogClient) DeleteOneID(id int) *AuditLogDeleteOne {
+	builder := c.Delete().Where(auditlog.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PatientDeleteOne{builder}
+	return &AuditLogDeleteOne{builder}
 }
 
-// Query returns a query builder for Patient.
-func (c *PatientClient) Query() *PatientQuery {
-	return &PatientQuery{
+// Query returns a query builder for AuditLog.
+func (c *AuditLogClient) Query() *AuditLogQuery {
+	return &AuditLogQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePatient},
+		ctx:    &QueryContext{Type: TypeAuditLog},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Patient entity by its id.
-func (c *PatientClient) Get(ctx context.Context, id int) (*Patient, error) {
-	return c.Query().Where(patient.ID(id)).Only(ctx)
+// Get returns a AuditLog entity by its id.
+func (c *AuditLogClient) Get(ctx context.Context, id int) (*AuditLog, error) {
+	return c.Query().Where(auditlog.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PatientClient) GetX(ctx context.Context, id int) *Patient {
+func (c *AuditLogClient) GetX(ctx context.Context, id int) *AuditLog {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -580,165 +660,1512 @@ func (c *PatientClient) GetX(ctx context.Context, id int) *Patient {
 	return obj
 }
 
-// QueryRepo queries the repo edge of a Patient.
-func (c *PatientClient) QueryRepo(pa *Patient) *RoomQuery {
-	query := (&RoomClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pa.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(patient.Table, patient.FieldID, id),
-			sqlgraph.To(room.Table, room.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, patient.RepoTable, patient.RepoColumn),
-		)
-		fromV = sqlgraph.Neighbors(pa.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryDoctor queries the doctor edge of a Patient.
-func (c *PatientClient) QueryDoctor(pa *Patient) *DoctorQuery {
-	query := (&DoctorClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pa.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(patient.Table, patient.FieldID, id),
-			sqlgraph.To(doctor.Table, doctor.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, patient.DoctorTable, patient.DoctorPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(pa.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryIlls queries the ills edge of a Patient.
-func (c *PatientClient) QueryIlls(pa *Patient) *DiseaseQuery {
-	query := (&DiseaseClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pa.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(patient.Table, patient.FieldID, id),
-			sqlgraph.To(disease.Table, disease.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, patient.IllsTable, patient.IllsColumn),
-		)
-		fromV = sqlgraph.Neighbors(pa.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *PatientClient) Hooks() []Hook {
-	return c.hooks.Patient
+func (c *AuditLogClient) Hooks() []Hook {
+	hooks := c.hooks.AuditLog
+	return append(hooks[:len(hooks):len(hooks)], auditlog.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *PatientClient) Interceptors() []Interceptor {
-	return c.inters.Patient
+func (c *AuditLogClient) Interceptors() []Interceptor {
+	return c.inters.AuditLog
 }
 
-func (c *PatientClient) mutate(ctx context.Context, m *PatientMutation) (Value, error) {
+func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PatientCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PatientUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PatientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PatientDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Patient mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AuditLog mutation op: %q", m.Op())
 	}
 }
 
-// RoomClient is a client for the Room schema.
-type RoomClient struct {
+// ConfirmTokenClient is a client for the ConfirmToken schema.
+type ConfirmTokenClient struct {
 	config
 }
 
-// NewRoomClient returns a client for the Room from the given config.
-func NewRoomClient(c config) *RoomClient {
-	return &RoomClient{config: c}
+// NewConfirmTokenClient returns a client for the ConfirmToken from the given config.
+func NewConfirmTokenClient(c config) *ConfirmTokenClient {
+	return &ConfirmTokenClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `room.Hooks(f(g(h())))`.
-func (c *RoomClient) Use(hooks ...Hook) {
-	c.hooks.Room = append(c.hooks.Room, hooks...)
+// A call to `Use(f, g, h)` equals to `confirmtoken.Hooks(f(g(h())))`.
+func (c *ConfirmTokenClient) Use(hooks ...Hook) {
+	c.hooks.ConfirmToken = append(c.hooks.ConfirmToken, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `room.Intercept(f(g(h())))`.
-func (c *RoomClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Room = append(c.inters.Room, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `confirmtoken.Intercept(f(g(h())))`.
+func (c *ConfirmTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ConfirmToken = append(c.inters.ConfirmToken, interceptors...)
 }
 
-// Create returns a builder for creating a Room entity.
+// Create returns a builder for creating a ConfirmToken entity.
+func (c *ConfirmTokenClient) Create() *ConfirmTokenCreate {
+	mutation := newConfirmTokenMutation(c.config, OpCreate)
+	return &ConfirmTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ConfirmToken entities.
+func (c *ConfirmTokenClient) CreateBulk(builders ...*ConfirmTokenCreate) *ConfirmTokenCreateBulk {
+	return &ConfirmTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ConfirmToken.
+func (c *ConfirmTokenClient) Update() *ConfirmTokenUpdate {
+	mutation := newConfirmTokenMutation(c.config, OpUpdate)
+	return &ConfirmTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ConfirmTokenClient) UpdateOne(ct *ConfirmToken) *ConfirmTokenUpdateOne {
+	mutation := newConfirmTokenMutation(c.config, OpUpdateOne, withConfirmToken(ct))
+	return &ConfirmTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ConfirmTokenClient) UpdateOneID(id int) *ConfirmTokenUpdateOne {
+	mutation := newConfirmTokenMutation(c.config, OpUpdateOne, withConfirmTokenID(id))
+	return &ConfirmTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ConfirmToken.
+func (c *ConfirmTokenClient) Delete() *ConfirmTokenDelete {
+	mutation := newConfirmTokenMutation(c.config, OpDelete)
+	return &ConfirmTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ConfirmTokenClient) DeleteOne(ct *ConfirmToken) *ConfirmTokenDeleteOne {
+	return c.DeleteOneID(ct.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ConfirmTokenClient) DeleteOneID(id int) *ConfirmTokenDeleteOne {
+	builder := c.Delete().Where(confirmtoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ConfirmTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for ConfirmToken.
+func (c *ConfirmTokenClient) Query() *ConfirmTokenQuery {
+	return &ConfirmTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeConfirmToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ConfirmToken entity by its id.
+func (c *ConfirmTokenClient) Get(ctx context.Context, id int) (*ConfirmToken, error) {
+	return c.Query().Where(confirmtoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ConfirmTokenClient) GetX(ctx context.Context, id int) *ConfirmToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ConfirmTokenClient) Hooks() []Hook {
+	return c.hooks.ConfirmToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *ConfirmTokenClient) Interceptors() []Interceptor {
+	return c.inters.ConfirmToken
+}
+
+func (c *ConfirmTokenClient) mutate(ctx context.Context, m *ConfirmTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ConfirmTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ConfirmTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ConfirmTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ConfirmTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ConfirmToken mutation op: %q", m.Op())
+	}
+}
+
+// ConversationClient is a client for the Conversation schema.
+type ConversationClient struct {
+	config
+}
+
+// NewConversationClient returns a client for the Conversation from the given config.
+func NewConversationClient(c config) *ConversationClient {
+	return &ConversationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `conversation.Hooks(f(g(h())))`.
+func (c *ConversationClient) Use(hooks ...Hook) {
+	c.hooks.Conversation = append(c.hooks.Conversation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `conversation.Intercept(f(g(h())))`.
+func (c *ConversationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Conversation = append(c.inters.Conversation, interceptors...)
+}
+
+// Create returns a builder for creating a Conversation entity.
+func (c *ConversationClient) Create() *ConversationCreate {
+	mutation := newConversationMutation(c.config, OpCreate)
+	return &ConversationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Conversation entities.
+func (c *ConversationClient) CreateBulk(builders ...*ConversationCreate) *ConversationCreateBulk {
+	return &ConversationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Conversation.
+func (c *ConversationClient) Update() *ConversationUpdate {
+	mutation := newConversationMutation(c.config, OpUpdate)
+	return &ConversationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ConversationClient) UpdateOne(co *Conversation) *ConversationUpdateOne {
+	mutation := newConversationMutation(c.config, OpUpdateOne, withConversation(co))
+	return &ConversationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ConversationClient) UpdateOneID(id int) *ConversationUpdateOne {
+	mutation := newConversationMutation(c.config, OpUpdateOne, withConversationID(id))
+	return &ConversationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Conversation.
+func (c *ConversationClient) Delete() *ConversationDelete {
+	mutation := newConversationMutation(c.config, OpDelete)
+	return &ConversationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ConversationClient) DeleteOne(co *Conversation) *ConversationDeleteOne {
+	return c.DeleteOneID(co.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ConversationClient) DeleteOneID(id int) *ConversationDeleteOne {
+	builder := c.Delete().Where(conversation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ConversationDeleteOne{builder}
+}
+
+// Query returns a query builder for Conversation.
+func (c *ConversationClient) Query() *ConversationQuery {
+	return &ConversationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeConversation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Conversation entity by its id.
+func (c *ConversationClient) Get(ctx context.Context, id int) (*Conversation, error) {
+	return c.Query().Where(conversation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ConversationClient) GetX(ctx context.Context, id int) *Conversation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ConversationClient) Hooks() []Hook {
+	return c.hooks.Conversation
+}
+
+// Interceptors returns the client interceptors.
+func (c *ConversationClient) Interceptors() []Interceptor {
+	return c.inters.Conversation
+}
+
+func (c *ConversationClient) mutate(ctx context.Context, m *ConversationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ConversationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ConversationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ConversationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ConversationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Conversation mutation op: %q", m.Op())
+	}
+}
+
+// DepartmentClient is a client for the Department schema.
+type DepartmentClient struct {
+	config
+}
+
+// NewDepartmentClient returns a client for the Department from the given config.
+func NewDepartmentClient(c config) *DepartmentClient {
+	return &DepartmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `department.Hooks(f(g(h())))`.
+func (c *DepartmentClient) Use(hooks ...Hook) {
+	c.hooks.Department = append(c.hooks.Department, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `department.Intercept(f(g(h())))`.
+func (c *DepartmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Department = append(c.inters.Department, interceptors...)
+}
+
+// Create returns a builder for creating a Department entity.
+func (c *DepartmentClient) Create() *DepartmentCreate {
+	mutation := newDepartmentMutation(c.config, OpCreate)
+	return &DepartmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Department entities.
+func (c *DepartmentClient) CreateBulk(builders ...*DepartmentCreate) *DepartmentCreateBulk {
+	return &DepartmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Department.
+func (c *DepartmentClient) Update() *DepartmentUpdate {
+	mutation := newDepartmentMutation(c.config, OpUpdate)
+	return &DepartmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DepartmentClient) UpdateOne(d *Department) *DepartmentUpdateOne {
+	mutation := newDepartmentMutation(c.config, OpUpdateOne, withDepartment(d))
+	return &DepartmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DepartmentClient) UpdateOneID(id int) *DepartmentUpdateOne {
+	mutation := newDepartmentMutation(c.config, OpUpdateOne, withDepartmentID(id))
+	return &DepartmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Department.
+func (c *DepartmentClient) Delete() *DepartmentDelete {
+	mutation := newDepartmentMutation(c.config, OpDelete)
+	return &DepartmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DepartmentClient) DeleteOne(d *Department) *DepartmentDeleteOne {
+	return c.DeleteOneID(d.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DepartmentClient) DeleteOneID(id int) *DepartmentDeleteOne {
+	builder := c.Delete().Where(department.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DepartmentDeleteOne{builder}
+}
+
+// Query returns a query builder for Department.
+func (c *DepartmentClient) Query() *DepartmentQuery {
+	return &DepartmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDepartment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Department entity by its id.
+func (c *DepartmentClient) Get(ctx context.Context, id int) (*Department, error) {
+	return c.Query().Where(department.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DepartmentClient) GetX(ctx context.Context, id int) *Department {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrganization queries the organization edge of a Department.
+func (c *DepartmentClient) QueryOrganization(d *Department) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(department.Table, department.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, department.OrganizationTable, department.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRooms queries the rooms edge of a Department.
+func (c *DepartmentClient) QueryRooms(d *Department) *RoomQuery {
+	query := (&RoomClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(department.Table, department.FieldID, id),
+			sqlgraph.To(room.Table, room.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, department.RoomsTable, department.RoomsColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDoctors queries the doctors edge of a Department.
+func (c *DepartmentClient) QueryDoctors(d *Department) *DoctorQuery {
+	query := (&DoctorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(department.Table, department.FieldID, id),
+			sqlgraph.To(doctor.Table, doctor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, department.DoctorsTable, department.DoctorsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DepartmentClient) Hooks() []Hook {
+	hooks := c.hooks.Department
+	return append(hooks[:len(hooks):len(hooks)], department.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *DepartmentClient) Interceptors() []Interceptor {
+	return c.inters.Department
+}
+
+func (c *DepartmentClient) mutate(ctx context.Context, m *DepartmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DepartmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DepartmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DepartmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DepartmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Department mutation op: %q", m.Op())
+	}
+}
+
+// DiseaseClient is a client for the Disease schema.
+type DiseaseClient struct {
+	config
+}
+
+// NewDiseaseClient returns a client for the Disease from the given config.
+func NewDiseaseClient(c config) *DiseaseClient {
+	return &DiseaseClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `disease.Hooks(f(g(h())))`.
+func (c *DiseaseClient) Use(hooks ...Hook) {
+	c.hooks.Disease = append(c.hooks.Disease, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `disease.Intercept(f(g(h())))`.
+func (c *DiseaseClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Disease = append(c.inters.Disease, interceptors...)
+}
+
+// Create returns a builder for creating a Disease entity.
+func (c *DiseaseClient) Create() *DiseaseCreate {
+	mutation := newDiseaseMutation(c.config, OpCreate)
+	return &DiseaseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Disease entities.
+func (c *DiseaseClient) CreateBulk(builders ...*DiseaseCreate) *DiseaseCreateBulk {
+	return &DiseaseCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Disease.
+func (c *DiseaseClient) Update() *DiseaseUpdate {
+	mutation := newDiseaseMutation(c.config, OpUpdate)
+	return &DiseaseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DiseaseClient) UpdateOne(d *Disease) *DiseaseUpdateOne {
+	mutation := newDiseaseMutation(c.config, OpUpdateOne, withDisease(d))
+	return &DiseaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DiseaseClient) UpdateOneID(id int) *DiseaseUpdateOne {
+	mutation := newDiseaseMutation(c.config, OpUpdateOne, withDiseaseID(id))
+	return &DiseaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Disease.
+func (c *DiseaseClient) Delete() *DiseaseDelete {
+	mutation := newDiseaseMutation(c.config, OpDelete)
+	return &DiseaseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DiseaseClient) DeleteOne(d *Disease) *DiseaseDeleteOne {
+	return c.DeleteOneID(d.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DiseaseClient) DeleteOneID(id int) *DiseaseDeleteOne {
+	builder := c.Delete().Where(disease.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DiseaseDeleteOne{builder}
+}
+
+// Query returns a query builder for Disease.
+func (c *DiseaseClient) Query() *DiseaseQuery {
+	return &DiseaseQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDisease},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Disease entity by its id.
+func (c *DiseaseClient) Get(ctx context.Context, id int) (*Disease, error) {
+	return c.Query().Where(disease.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DiseaseClient) GetX(ctx context.Context, id int) *Disease {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryHas queries the has edge of a Disease.
+func (c *DiseaseClient) QueryHas(d *Disease) *PatientQuery {
+	query := (&PatientClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(disease.Table, disease.FieldID, id),
+			sqlgraph.To(patient.Table, patient.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, disease.HasTable, disease.HasColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DiseaseClient) Hooks() []Hook {
+	hooks := c.hooks.Disease
+	return append(hooks[:len(hooks):len(hooks)], disease.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *DiseaseClient) Interceptors() []Interceptor {
+	return c.inters.Disease
+}
+
+func (c *DiseaseClient) mutate(ctx context.Context, m *DiseaseMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DiseaseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DiseaseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DiseaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DiseaseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Disease mutation op: %q", m.Op())
+	}
+}
+
+// DoctorClient is a client for the Doctor schema.
+type DoctorClient struct {
+	config
+}
+
+// NewDoctorClient returns a client for the Doctor from the given config.
+func NewDoctorClient(c config) *DoctorClient {
+	return &DoctorClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `doctor.Hooks(f(g(h())))`.
+func (c *DoctorClient) Use(hooks ...Hook) {
+	c.hooks.Doctor = append(c.hooks.Doctor, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `doctor.Intercept(f(g(h())))`.
+func (c *DoctorClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Doctor = append(c.inters.Doctor, interceptors...)
+}
+
+// Create returns a builder for creating a Doctor entity.
+func (c *DoctorClient) Create() *DoctorCreate {
+	mutation := newDoctorMutation(c.config, OpCreate)
+	return &DoctorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Doctor entities.
+func (c *DoctorClient) CreateBulk(builders ...*DoctorCreate) *DoctorCreateBulk {
+	return &DoctorCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Doctor.
+func (c *DoctorClient) Update() *DoctorUpdate {
+	mutation := newDoctorMutation(c.config, OpUpdate)
+	return &DoctorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DoctorClient) UpdateOne(d *Doctor) *DoctorUpdateOne {
+	mutation := newDoctorMutation(c.config, OpUpdateOne, withDoctor(d))
+	return &DoctorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DoctorClient) UpdateOneID(id int) *DoctorUpdateOne {
+	mutation := newDoctorMutation(c.config, OpUpdateOne, withDoctorID(id))
+	return &DoctorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Doctor.
+func (c *DoctorClient) Delete() *DoctorDelete {
+	mutation := newDoctorMutation(c.config, OpDelete)
+	return &DoctorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DoctorClient) DeleteOne(d *Doctor) *DoctorDeleteOne {
+	return c.DeleteOneID(d.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DoctorClient) DeleteOneID(id int) *DoctorDeleteOne {
+	builder := c.Delete().Where(doctor.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DoctorDeleteOne{builder}
+}
+
+// Query returns a query builder for Doctor.
+func (c *DoctorClient) Query() *DoctorQuery {
+	return &DoctorQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDoctor},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Doctor entity by its id.
+func (c *DoctorClient) Get(ctx context.Context, id int) (*Doctor, error) {
+	return c.Query().Where(doctor.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DoctorClient) GetX(ctx context.Context, id int) *Doctor {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTreats queries the treats edge of a Doctor.
+func (c *DoctorClient) QueryTreats(d *Doctor) *PatientQuery {
+	query := (&PatientClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(doctor.Table, doctor.FieldID, id),
+			sqlgraph.To(patient.Table, patient.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, doctor.TreatsTable, doctor.TreatsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySessions queries the sessions edge of a Doctor.
+func (c *DoctorClient) QuerySessions(d *Doctor) *SessionQuery {
+	query := (&SessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(doctor.Table, doctor.FieldID, id),
+			sqlgraph.To(session.Table, session.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, doctor.SessionsTable, doctor.SessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDepartments queries the departments edge of a Doctor.
+func (c *DoctorClient) QueryDepartments(d *Doctor) *DepartmentQuery {
+	query := (&DepartmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(doctor.Table, doctor.FieldID, id),
+			sqlgraph.To(department.Table, department.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, doctor.DepartmentsTable, doctor.DepartmentsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DoctorClient) Hooks() []Hook {
+	hooks := c.hooks.Doctor
+	return append(hooks[:len(hooks):len(hooks)], doctor.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *DoctorClient) Interceptors() []Interceptor {
+	return c.inters.Doctor
+}
+
+func (c *DoctorClient) mutate(ctx context.Context, m *DoctorMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DoctorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DoctorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DoctorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DoctorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Doctor mutation op: %q", m.Op())
+	}
+}
+
+// JobStateClient is a client for the JobState schema.
+type JobStateClient struct {
+	config
+}
+
+// NewJobStateClient returns a client for the JobState from the given config.
+func NewJobStateClient(c config) *JobStateClient {
+	return &JobStateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `jobstate.Hooks(f(g(h())))`.
+func (c *JobStateClient) Use(hooks ...Hook) {
+	c.hooks.JobState = append(c.hooks.JobState, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `jobstate.Intercept(f(g(h())))`.
+func (c *JobStateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.JobState = append(c.inters.JobState, interceptors...)
+}
+
+// Create returns a builder for creating a JobState entity.
+func (c *JobStateClient) Create() *JobStateCreate {
+	mutation := newJobStateMutation(c.config, OpCreate)
+	return &JobStateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of JobState entities.
+func (c *JobStateClient) CreateBulk(builders ...*JobStateCreate) *JobStateCreateBulk {
+	return &JobStateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for JobState.
+func (c *JobStateClient) Update() *JobStateUpdate {
+	mutation := newJobStateMutation(c.config, OpUpdate)
+	return &JobStateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JobStateClient) UpdateOne(js *JobState) *JobStateUpdateOne {
+	mutation := newJobStateMutation(c.config, OpUpdateOne, withJobState(js))
+	return &JobStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JobStateClient) UpdateOneID(id int) *JobStateUpdateOne {
+	mutation := newJobStateMutation(c.config, OpUpdateOne, withJobStateID(id))
+	return &JobStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for JobState.
+func (c *JobStateClient) Delete() *JobStateDelete {
+	mutation := newJobStateMutation(c.config, OpDelete)
+	return &JobStateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JobStateClient) DeleteOne(js *JobState) *JobStateDeleteOne {
+	return c.DeleteOneID(js.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JobStateClient) DeleteOneID(id int) *JobStateDeleteOne {
+	builder := c.Delete().Where(jobstate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JobStateDeleteOne{builder}
+}
+
+// Query returns a query builder for JobState.
+func (c *JobStateClient) Query() *JobStateQuery {
+	return &JobStateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJobState},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a JobState entity by its id.
+func (c *JobStateClient) Get(ctx context.Context, id int) (*JobState, error) {
+	return c.Query().Where(jobstate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JobStateClient) GetX(ctx context.Context, id int) *JobState {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *JobStateClient) Hooks() []Hook {
+	return c.hooks.JobState
+}
+
+// Interceptors returns the client interceptors.
+func (c *JobStateClient) Interceptors() []Interceptor {
+	return c.inters.JobState
+}
+
+func (c *JobStateClient) mutate(ctx context.Context, m *JobStateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JobStateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JobStateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JobStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JobStateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown JobState mutation op: %q", m.Op())
+	}
+}
+
+// NotificationPreferenceClient is a client for the NotificationPreference schema.
+type NotificationPreferenceClient struct {
+	config
+}
+
+// NewNotificationPreferenceClient returns a client for the NotificationPreference from the given config.
+func NewNotificationPreferenceClient(c config) *NotificationPreferenceClient {
+	return &NotificationPreferenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notificationpreference.Hooks(f(g(h())))`.
+func (c *NotificationPreferenceClient) Use(hooks ...Hook) {
+	c.hooks.NotificationPreference = append(c.hooks.NotificationPreference, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notificationpreference.Intercept(f(g(h())))`.
+func (c *NotificationPreferenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NotificationPreference = append(c.inters.NotificationPreference, interceptors...)
+}
+
+// Create returns a builder for creating a NotificationPreference entity.
+func (c *NotificationPreferenceClient) Create() *NotificationPreferenceCreate {
+	mutation := newNotificationPreferenceMutation(c.config, OpCreate)
+	return &NotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NotificationPreference entities.
+func (c *NotificationPreferenceClient) CreateBulk(builders ...*NotificationPreferenceCreate) *NotificationPreferenceCreateBulk {
+	return &NotificationPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Update() *NotificationPreferenceUpdate {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdate)
+	return &NotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationPreferenceClient) UpdateOne(np *NotificationPreference) *NotificationPreferenceUpdateOne {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdateOne, withNotificationPreference(np))
+	return &NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationPreferenceClient) UpdateOneID(id int) *NotificationPreferenceUpdateOne {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdateOne, withNotificationPreferenceID(id))
+	return &NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Delete() *NotificationPreferenceDelete {
+	mutation := newNotificationPreferenceMutation(c.config, OpDelete)
+	return &NotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationPreferenceClient) DeleteOne(np *NotificationPreference) *NotificationPreferenceDeleteOne {
+	return c.DeleteOneID(np.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationPreferenceClient) DeleteOneID(id int) *NotificationPreferenceDeleteOne {
+	builder := c.Delete().Where(notificationpreference.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationPreferenceDeleteOne{builder}
+}
+
+// Query returns a query builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Query() *NotificationPreferenceQuery {
+	return &NotificationPreferenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotificationPreference},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NotificationPreference entity by its id.
+func (c *NotificationPreferenceClient) Get(ctx context.Context, id int) (*NotificationPreference, error) {
+	return c.Query().Where(notificationpreference.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationPreferenceClient) GetX(ctx context.Context, id int) *NotificationPreference {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationPreferenceClient) Hooks() []Hook {
+	return c.hooks.NotificationPreference
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationPreferenceClient) Interceptors() []Interceptor {
+	return c.inters.NotificationPreference
+}
+
+func (c *NotificationPreferenceClient) mutate(ctx context.Context, m *NotificationPreferenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NotificationPreference mutation op: %q", m.Op())
+	}
+}
+
+// OrganizationClient is a client for the Organization schema.
+type OrganizationClient struct {
+	config
+}
+
+// NewOrganizationClient returns a client for the Organization from the given config.
+func NewOrganizationClient(c config) *OrganizationClient {
+	return &OrganizationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `organization.Hooks(f(g(h())))`.
+func (c *OrganizationClient) Use(hooks ...Hook) {
+	c.hooks.Organization = append(c.hooks.Organization, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `organization.Intercept(f(g(h())))`.
+func (c *OrganizationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Organization = append(c.inters.Organization, interceptors...)
+}
+
+// Create returns a builder for creating a Organization entity.
+func (c *OrganizationClient) Create() *OrganizationCreate {
+	mutation := newOrganizationMutation(c.config, OpCreate)
+	return &OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Organization entities.
+func (c *OrganizationClient) CreateBulk(builders ...*OrganizationCreate) *OrganizationCreateBulk {
+	return &OrganizationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Organization.
+func (c *OrganizationClient) Update() *OrganizationUpdate {
+	mutation := newOrganizationMutation(c.config, OpUpdate)
+	return &OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrganizationClient) UpdateOne(o *Organization) *OrganizationUpdateOne {
+	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganization(o))
+	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrganizationClient) UpdateOneID(id int) *OrganizationUpdateOne {
+	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganizationID(id))
+	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Organization.
+func (c *OrganizationClient) Delete() *OrganizationDelete {
+	mutation := newOrganizationMutation(c.config, OpDelete)
+	return &OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrganizationClient) DeleteOne(o *Organization) *OrganizationDeleteOne {
+	return c.DeleteOneID(o.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrganizationClient) DeleteOneID(id int) *OrganizationDeleteOne {
+	builder := c.Delete().Where(organization.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrganizationDeleteOne{builder}
+}
+
+// Query returns a query builder for Organization.
+func (c *OrganizationClient) Query() *OrganizationQuery {
+	return &OrganizationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrganization},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Organization entity by its id.
+func (c *OrganizationClient) Get(ctx context.Context, id int) (*Organization, error) {
+	return c.Query().Where(organization.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrganizationClient) GetX(ctx context.Context, id int) *Organization {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDepartments queries the departments edge of a Organization.
+func (c *OrganizationClient) QueryDepartments(o *Organization) *DepartmentQuery {
+	query := (&DepartmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(department.Table, department.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.DepartmentsTable, organization.DepartmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrganizationClient) Hooks() []Hook {
+	hooks := c.hooks.Organization
+	return append(hooks[:len(hooks):len(hooks)], organization.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrganizationClient) Interceptors() []Interceptor {
+	return c.inters.Organization
+}
+
+func (c *OrganizationClient) mutate(ctx context.Context, m *OrganizationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Organization mutation op: %q", m.Op())
+	}
+}
+
+// PatientClient is a client for the Patient schema.
+type PatientClient struct {
+	config
+}
+
+// NewPatientClient returns a client for the Patient from the given config.
+func NewPatientClient(c config) *PatientClient {
+	return &PatientClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `patient.Hooks(f(g(h())))`.
+func (c *PatientClient) Use(hooks ...Hook) {
+	c.hooks.Patient = append(c.hooks.Patient, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `patient.Intercept(f(g(h())))`.
+func (c *PatientClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Patient = append(c.inters.Patient, interceptors...)
+}
+
+// Create returns a builder for creating a Patient entity.
+func (c *PatientClient) Create() *PatientCreate {
+	mutation := newPatientMutation(c.config, OpCreate)
+	return &PatientCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Patient entities.
+func (c *PatientClient) CreateBulk(builders ...*PatientCreate) *PatientCreateBulk {
+	return &PatientCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Patient.
+func (c *PatientClient) Update() *PatientUpdate {
+	mutation := newPatientMutation(c.config, OpUpdate)
+	return &PatientUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PatientClient) UpdateOne(pa *Patient) *PatientUpdateOne {
+	mutation := newPatientMutation(c.config, OpUpdateOne, withPatient(pa))
+	return &PatientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PatientClient) UpdateOneID(id int) *PatientUpdateOne {
+	mutation := newPatientMutation(c.config, OpUpdateOne, withPatientID(id))
+	return &PatientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Patient.
+func (c *PatientClient) Delete() *PatientDelete {
+	mutation := newPatientMutation(c.config, OpDelete)
+	return &PatientDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PatientClient) DeleteOne(pa *Patient) *PatientDeleteOne {
+	return c.DeleteOneID(pa.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PatientClient) DeleteOneID(id int) *PatientDeleteOne {
+	builder := c.Delete().Where(patient.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PatientDeleteOne{builder}
+}
+
+// Query returns a query builder for Patient.
+func (c *PatientClient) Query() *PatientQuery {
+	return &PatientQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePatient},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Patient entity by its id.
+func (c *PatientClient) Get(ctx context.Context, id int) (*Patient, error) {
+	return c.Query().Where(patient.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PatientClient) GetX(ctx context.Context, id int) *Patient {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRepo queries the repo edge of a Patient.
+func (c *PatientClient) QueryRepo(pa *Patient) *RoomQuery {
+	query := (&RoomClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, id),
+			sqlgraph.To(room.Table, room.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, patient.RepoTable, patient.RepoColumn),
+		)
+		fromV = sqlgraph.Neighbors(pa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDoctor queries the doctor edge of a Patient.
+func (c *PatientClient) QueryDoctor(pa *Patient) *DoctorQuery {
+	query := (&DoctorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, id),
+			sqlgraph.To(doctor.Table, doctor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, patient.DoctorTable, patient.DoctorPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIlls queries the ills edge of a Patient.
+func (c *PatientClient) QueryIlls(pa *Patient) *DiseaseQuery {
+	query := (&DiseaseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, id),
+			sqlgraph.To(disease.Table, disease.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, patient.IllsTable, patient.IllsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PatientClient) Hooks() []Hook {
+	hooks := c.hooks.Patient
+	return append(hooks[:len(hooks):len(hooks)], patient.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *PatientClient) Interceptors() []Interceptor {
+	return c.inters.Patient
+}
+
+func (c *PatientClient) mutate(ctx context.Context, m *PatientMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PatientCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PatientUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PatientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PatientDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Patient mutation op: %q", m.Op())
+	}
+}
+
+// RoomClient is a client for the Room schema.
+type RoomClient struct {
+	config
+}
+
+// NewRoomClient returns a client for the Room from the given config.
+func NewRoomClient(c config) *RoomClient {
+	return &RoomClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `room.Hooks(f(g(h())))`.
+func (c *RoomClient) Use(hooks ...Hook) {
+	c.hooks.Room = append(c.hooks.Room, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `room.Intercept(f(g(h())))`.
+func (c *RoomClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Room = append(c.inters.Room, interceptors...)
+}
+
+// Create returns a builder for creating a Room entity.
 func (c *RoomClient) Create() *RoomCreate {
 	mutation := newRoomMutation(c.config, OpCreate)
 	return &RoomCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Room entities.
-func (c *RoomClient) CreateBulk(builders ...*RoomCreate) *RoomCreateBulk {
-	return &RoomCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Room entities.
+func (c *RoomClient) CreateBulk(builders ...*RoomCreate) *RoomCreateBulk {
+	return &RoomCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Room.
+func (c *RoomClient) Update() *RoomUpdate {
+	mutation := newRoomMutation(c.config, OpUpdate)
+	return &RoomUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoomClient) UpdateOne(r *Room) *RoomUpdateOne {
+	mutation := newRoomMutation(c.config, OpUpdateOne, withRoom(r))
+	return &RoomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoomClient) UpdateOneID(id int) *RoomUpdateOne {
+	mutation := newRoomMutation(c.config, OpUpdateOne, withRoomID(id))
+	return &RoomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Room.
+func (c *RoomClient) Delete() *RoomDelete {
+	mutation := newRoomMutation(c.config, OpDelete)
+	return &RoomDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoomClient) DeleteOne(r *Room) *RoomDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoomClient) DeleteOneID(id int) *RoomDeleteOne {
+	builder := c.Delete().Where(room.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoomDeleteOne{builder}
+}
+
+// Query returns a query builder for Room.
+func (c *RoomClient) Query() *RoomQuery {
+	return &RoomQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoom},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Room entity by its id.
+func (c *RoomClient) Get(ctx context.Context, id int) (*Room, error) {
+	return c.Query().Where(room.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoomClient) GetX(ctx context.Context, id int) *Room {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryContains queries the contains edge of a Room.
+func (c *RoomClient) QueryContains(r *Room) *PatientQuery {
+	query := (&PatientClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(room.Table, room.FieldID, id),
+			sqlgraph.To(patient.Table, patient.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, room.ContainsTable, room.ContainsColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDepartment queries the department edge of a Room.
+func (c *RoomClient) QueryDepartment(r *Room) *DepartmentQuery {
+	query := (&DepartmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(room.Table, room.FieldID, id),
+			sqlgraph.To(department.Table, department.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, room.DepartmentTable, room.DepartmentColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RoomClient) Hooks() []Hook {
+	hooks := c.hooks.Room
+	return append(hooks[:len(hooks):len(hooks)], room.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoomClient) Interceptors() []Interceptor {
+	return c.inters.Room
+}
+
+func (c *RoomClient) mutate(ctx context.Context, m *RoomMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoomCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoomUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoomDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Room mutation op: %q", m.Op())
+	}
+}
+
+// SessionClient is a client for the Session schema.
+type SessionClient struct {
+	config
 }
 
-// Update returns an update builder for Room.
-func (c *RoomClient) Update() *RoomUpdate {
-	mutation := newRoomMutation(c.config, OpUpdate)
-	return &RoomUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// NewSessionClient returns a client for the Session from the given config.
+func NewSessionClient(c config) *SessionClient {
+	return &SessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `session.Hooks(f(g(h())))`.
+func (c *SessionClient) Use(hooks ...Hook) {
+	c.hooks.Session = append(c.hooks.Session, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `session.Intercept(f(g(h())))`.
+func (c *SessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Session = append(c.inters.Session, interceptors...)
+}
+
+// Create returns a builder for creating a Session entity.
+func (c *SessionClient) Create() *SessionCreate {
+	mutation := newSessionMutation(c.config, OpCreate)
+	return &SessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Session entities.
+func (c *SessionClient) CreateBulk(builders ...*SessionCreate) *SessionCreateBulk {
+	return &SessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Session.
+func (c *SessionClient) Update() *SessionUpdate {
+	mutation := newSessionMutation(c.config, OpUpdate)
+	return &SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RoomClient) UpdateOne(r *Room) *RoomUpdateOne {
-	mutation := newRoomMutation(c.config, OpUpdateOne, withRoom(r))
-	return &RoomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SessionClient) UpdateOne(s *Session) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSession(s))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RoomClient) UpdateOneID(id int) *RoomUpdateOne {
-	mutation := newRoomMutation(c.config, OpUpdateOne, withRoomID(id))
-	return &RoomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SessionClient) UpdateOneID(id int) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSessionID(id))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Room.
-func (c *RoomClient) Delete() *RoomDelete {
-	mutation := newRoomMutation(c.config, OpDelete)
-	return &RoomDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Session.
+func (c *SessionClient) Delete() *SessionDelete {
+	mutation := newSessionMutation(c.config, OpDelete)
+	return &SessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RoomClient) DeleteOne(r *Room) *RoomDeleteOne {
-	return c.DeleteOneID(r.ID)
+func (c *SessionClient) DeleteOne(s *Session) *SessionDeleteOne {
+	return c.DeleteOneID(s.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RoomClient) DeleteOneID(id int) *RoomDeleteOne {
-	builder := c.Delete().Where(room.ID(id))
+func (c *SessionClient) DeleteOneID(id int) *SessionDeleteOne {
+	builder := c.Delete().Where(session.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RoomDeleteOne{builder}
+	return &SessionDeleteOne{builder}
 }
 
-// Query returns a query builder for Room.
-func (c *RoomClient) Query() *RoomQuery {
-	return &RoomQuery{
+// Query returns a query builder for Session.
+func (c *SessionClient) Query() *SessionQuery {
+	return &SessionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRoom},
+		ctx:    &QueryContext{Type: TypeSession},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Room entity by its id.
-func (c *RoomClient) Get(ctx context.Context, id int) (*Room, error) {
-	return c.Query().Where(room.ID(id)).Only(ctx)
+// Get returns a Session entity by its id.
+func (c *SessionClient) Get(ctx context.Context, id int) (*Session, error) {
+	return c.Query().Where(session.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RoomClient) GetX(ctx context.Context, id int) *Room {
+func (c *SessionClient) GetX(ctx context.Context, id int) *Session {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -746,53 +2173,414 @@ func (c *RoomClient) GetX(ctx context.Context, id int) *Room {
 	return obj
 }
 
-// QueryContains queries the contains edge of a Room.
-func (c *RoomClient) QueryContains(r *Room) *PatientQuery {
-	query := (&PatientClient{config: c.config}).Query()
+// QueryDoctor queries the doctor edge of a Session.
+func (c *SessionClient) QueryDoctor(s *Session) *DoctorQuery {
+	query := (&DoctorClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := r.ID
+		id := s.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(room.Table, room.FieldID, id),
-			sqlgraph.To(patient.Table, patient.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, room.ContainsTable, room.ContainsColumn),
+			sqlgraph.From(session.Table, session.FieldID, id),
+			sqlgraph.To(doctor.Table, doctor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, session.DoctorTable, session.DoctorColumn),
 		)
-		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *RoomClient) Hooks() []Hook {
-	return c.hooks.Room
+func (c *SessionClient) Hooks() []Hook {
+	return c.hooks.Session
 }
 
 // Interceptors returns the client interceptors.
-func (c *RoomClient) Interceptors() []Interceptor {
-	return c.inters.Room
+func (c *SessionClient) Interceptors() []Interceptor {
+	return c.inters.Session
 }
 
-func (c *RoomClient) mutate(ctx context.Context, m *RoomMutation) (Value, error) {
+func (c *SessionClient) mutate(ctx context.Context, m *SessionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RoomCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RoomUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RoomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RoomDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Room mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Session mutation op: %q", m.Op())
+	}
+}
+
+// VitalClient is a client for the Vital schema.
+type VitalClient struct {
+	config
+}
+
+// NewVitalClient returns a client for the Vital from the given config.
+func NewVitalClient(c config) *VitalClient {
+	return &VitalClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `vital.Hooks(f(g(h())))`.
+func (c *VitalClient) Use(hooks ...Hook) {
+	c.hooks.Vital = append(c.hooks.Vital, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `vital.Intercept(f(g(h())))`.
+func (c *VitalClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Vital = append(c.inters.Vital, interceptors...)
+}
+
+// Create returns a builder for creating a Vital entity.
+func (c *VitalClient) Create() *VitalCreate {
+	mutation := newVitalMutation(c.config, OpCreate)
+	return &VitalCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Vital entities.
+func (c *VitalClient) CreateBulk(builders ...*VitalCreate) *VitalCreateBulk {
+	return &VitalCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Vital.
+func (c *VitalClient) Update() *VitalUpdate {
+	mutation := newVitalMutation(c.config, OpUpdate)
+	return &VitalUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VitalClient) UpdateOne(v *Vital) *VitalUpdateOne {
+	mutation := newVitalMutation(c.config, OpUpdateOne, withVital(v))
+	return &VitalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VitalClient) UpdateOneID(id int) *VitalUpdateOne {
+	mutation := newVitalMutation(c.config, OpUpdateOne, withVitalID(id))
+	return &VitalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Vital.
+func (c *VitalClient) Delete() *VitalDelete {
+	mutation := newVitalMutation(c.config, OpDelete)
+	return &VitalDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VitalClient) DeleteOne(v *Vital) *VitalDeleteOne {
+	return c.DeleteOneID(v.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VitalClient) DeleteOneID(id int) *VitalDeleteOne {
+	builder := c.Delete().Where(vital.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VitalDeleteOne{builder}
+}
+
+// Query returns a query builder for Vital.
+func (c *VitalClient) Query() *VitalQuery {
+	return &VitalQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVital},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Vital entity by its id.
+func (c *VitalClient) Get(ctx context.Context, id int) (*Vital, error) {
+	return c.Query().Where(vital.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VitalClient) GetX(ctx context.Context, id int) *Vital {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *VitalClient) Hooks() []Hook {
+	hooks := c.hooks.Vital
+	return append(hooks[:len(hooks):len(hooks)], vital.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *VitalClient) Interceptors() []Interceptor {
+	return c.inters.Vital
+}
+
+func (c *VitalClient) mutate(ctx context.Context, m *VitalMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VitalCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VitalUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VitalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VitalDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Vital mutation op: %q", m.Op())
+	}
+}
+
+// WardChatClient is a client for the WardChat schema.
+type WardChatClient struct {
+	config
+}
+
+// NewWardChatClient returns a client for the WardChat from the given config.
+func NewWardChatClient(c config) *WardChatClient {
+	return &WardChatClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `wardchat.Hooks(f(g(h())))`.
+func (c *WardChatClient) Use(hooks ...Hook) {
+	c.hooks.WardChat = append(c.hooks.WardChat, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `wardchat.Intercept(f(g(h())))`.
+func (c *WardChatClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WardChat = append(c.inters.WardChat, interceptors...)
+}
+
+// Create returns a builder for creating a WardChat entity.
+func (c *WardChatClient) Create() *WardChatCreate {
+	mutation := newWardChatMutation(c.config, OpCreate)
+	return &WardChatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WardChat entities.
+func (c *WardChatClient) CreateBulk(builders ...*WardChatCreate) *WardChatCreateBulk {
+	return &WardChatCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WardChat.
+func (c *WardChatClient) Update() *WardChatUpdate {
+	mutation := newWardChatMutation(c.config, OpUpdate)
+	return &WardChatUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WardChatClient) UpdateOne(wc *WardChat) *WardChatUpdateOne {
+	mutation := newWardChatMutation(c.config, OpUpdateOne, withWardChat(wc))
+	return &WardChatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WardChatClient) UpdateOneID(id int) *WardChatUpdateOne {
+	mutation := newWardChatMutation(c.config, OpUpdateOne, withWardChatID(id))
+	return &WardChatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WardChat.
+func (c *WardChatClient) Delete() *WardChatDelete {
+	mutation := newWardChatMutation(c.config, OpDelete)
+	return &WardChatDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WardChatClient) DeleteOne(wc *WardChat) *WardChatDeleteOne {
+	return c.DeleteOneID(wc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WardChatClient) DeleteOneID(id int) *WardChatDeleteOne {
+	builder := c.Delete().Where(wardchat.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WardChatDeleteOne{builder}
+}
+
+// Query returns a query builder for WardChat.
+func (c *WardChatClient) Query() *WardChatQuery {
+	return &WardChatQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWardChat},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WardChat entity by its id.
+func (c *WardChatClient) Get(ctx context.Context, id int) (*WardChat, error) {
+	return c.Query().Where(wardchat.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WardChatClient) GetX(ctx context.Context, id int) *WardChat {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WardChatClient) Hooks() []Hook {
+	hooks := c.hooks.WardChat
+	return append(hooks[:len(hooks):len(hooks)], wardchat.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *WardChatClient) Interceptors() []Interceptor {
+	return c.inters.WardChat
+}
+
+func (c *WardChatClient) mutate(ctx context.Context, m *WardChatMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WardChatCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WardChatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WardChatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WardChatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WardChat mutation op: %q", m.Op())
+	}
+}
+
+// WardNoticeClient is a client for the WardNotice schema.
+type WardNoticeClient struct {
+	config
+}
+
+// NewWardNoticeClient returns a client for the WardNotice from the given config.
+func NewWardNoticeClient(c config) *WardNoticeClient {
+	return &WardNoticeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `wardnotice.Hooks(f(g(h())))`.
+func (c *WardNoticeClient) Use(hooks ...Hook) {
+	c.hooks.WardNotice = append(c.hooks.WardNotice, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `wardnotice.Intercept(f(g(h())))`.
+func (c *WardNoticeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WardNotice = append(c.inters.WardNotice, interceptors...)
+}
+
+// Create returns a builder for creating a WardNotice entity.
+func (c *WardNoticeClient) Create() *WardNoticeCreate {
+	mutation := newWardNoticeMutation(c.config, OpCreate)
+	return &WardNoticeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WardNotice entities.
+func (c *WardNoticeClient) CreateBulk(builders ...*WardNoticeCreate) *WardNoticeCreateBulk {
+	return &WardNoticeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WardNotice.
+func (c *WardNoticeClient) Update() *WardNoticeUpdate {
+	mutation := newWardNoticeMutation(c.config, OpUpdate)
+	return &WardNoticeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WardNoticeClient) UpdateOne(wn *WardNotice) *WardNoticeUpdateOne {
+	mutation := newWardNoticeMutation(c.config, OpUpdateOne, withWardNotice(wn))
+	return &WardNoticeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WardNoticeClient) UpdateOneID(id int) *WardNoticeUpdateOne {
+	mutation := newWardNoticeMutation(c.config, OpUpdateOne, withWardNoticeID(id))
+	return &WardNoticeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WardNotice.
+func (c *WardNoticeClient) Delete() *WardNoticeDelete {
+	mutation := newWardNoticeMutation(c.config, OpDelete)
+	return &WardNoticeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WardNoticeClient) DeleteOne(wn *WardNotice) *WardNoticeDeleteOne {
+	return c.DeleteOneID(wn.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WardNoticeClient) DeleteOneID(id int) *WardNoticeDeleteOne {
+	builder := c.Delete().Where(wardnotice.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WardNoticeDeleteOne{builder}
+}
+
+// Query returns a query builder for WardNotice.
+func (c *WardNoticeClient) Query() *WardNoticeQuery {
+	return &WardNoticeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWardNotice},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WardNotice entity by its id.
+func (c *WardNoticeClient) Get(ctx context.Context, id int) (*WardNotice, error) {
+	return c.Query().Where(wardnotice.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WardNoticeClient) GetX(ctx context.Context, id int) *WardNotice {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WardNoticeClient) Hooks() []Hook {
+	hooks := c.hooks.WardNotice
+	return append(hooks[:len(hooks):len(hooks)], wardnotice.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *WardNoticeClient) Interceptors() []Interceptor {
+	return c.inters.WardNotice
+}
+
+func (c *WardNoticeClient) mutate(ctx context.Context, m *WardNoticeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WardNoticeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WardNoticeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WardNoticeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WardNoticeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WardNotice mutation op: %q", m.Op())
 	}
 }
 
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Disease, Doctor, Patient, Room []ent.Hook
+		AccessLog, Alert, AuditLog, ConfirmToken, Conversation, Department, Disease,
+		Doctor, JobState, NotificationPreference, Organization, Patient, Room, Session,
+		Vital, WardChat, WardNotice []ent.Hook
 	}
 	inters struct {
-		Disease, Doctor, Patient, Room []ent.Interceptor
+		AccessLog, Alert, AuditLog, ConfirmToken, Conversation, Department, Disease,
+		Doctor, JobState, NotificationPreference, Organization, Patient, Room, Session,
+		Vital, WardChat, WardNotice []ent.Interceptor
 	}
 )
@@ -0,0 +1,117 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"hospital/internal/modules/db/ent/confirmtoken"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ConfirmToken is the model entity for the ConfirmToken schema.
+type ConfirmToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Key holds the value of the "key" field.
+	Key string `json:"key,omitempty"`
+	// ExpiresAt holds the value of the "expiresAt" field.
+	ExpiresAt    time.Time `json:"expiresAt,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ConfirmToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case confirmtoken.FieldID:
+			values[i] = new(sql.NullInt64)
+		case confirmtoken.FieldKey:
+			values[i] = new(sql.NullString)
+		case confirmtoken.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ConfirmToken fields.
+func (ct *ConfirmToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case confirmtoken.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ct.ID = int(value.Int64)
+		case confirmtoken.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				ct.Key = value.String
+			}
+		case confirmtoken.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expiresAt", values[i])
+			} else if value.Valid {
+				ct.ExpiresAt = value.Time
+			}
+		default:
+			ct.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ConfirmToken.
+// This includes values selected through modifiers, order, etc.
+func (ct *ConfirmToken) Value(name string) (ent.Value, error) {
+	return ct.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ConfirmToken.
+// Note that you need to call ConfirmToken.Unwrap() before calling this method if this ConfirmToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ct *ConfirmToken) Update() *ConfirmTokenUpdateOne {
+	return NewConfirmTokenClient(ct.config).UpdateOne(ct)
+}
+
+// Unwrap unwraps the ConfirmToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ct *ConfirmToken) Unwrap() *ConfirmToken {
+	_tx, ok := ct.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ConfirmToken is not a transactional entity")
+	}
+	ct.config.driver = _tx.drv
+	return ct
+}
+
+// String implements the fmt.Stringer.
+func (ct *ConfirmToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("ConfirmToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ct.ID))
+	builder.WriteString("key=")
+	builder.WriteString(ct.Key)
+	builder.WriteString(", ")
+	builder.WriteString("expiresAt=")
+	builder.WriteString(ct.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ConfirmTokens is a parsable slice of ConfirmToken.
+type ConfirmTokens []*ConfirmToken
@@ -0,0 +1,55 @@
+// Code generated by ent, DO NOT EDIT.
+
+package confirmtoken
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the confirmtoken type in the database.
+	Label = "confirm_token"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldKey holds the string denoting the key field in the database.
+	FieldKey = "key"
+	// FieldExpiresAt holds the string denoting the expiresat field in the database.
+	FieldExpiresAt = "expires_at"
+	// Table holds the table name of the confirmtoken in the database.
+	Table = "confirm_tokens"
+)
+
+// Columns holds all SQL columns for confirmtoken fields.
+var Columns = []string{
+	FieldID,
+	FieldKey,
+	FieldExpiresAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Order defines the ordering method for the ConfirmToken queries.
+type Order func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByKey orders the results by the key field.
+func ByKey(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldKey, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expiresAt field.
+func ByExpiresAt(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package confirmtoken
+
+import (
+	"hospital/internal/modules/db/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldLTE(FieldID, id))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldEQ(FieldKey, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expiresAt" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldContainsFold(FieldKey, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expiresAt" field.
+func ExpiresAtEQ(v time.Time) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expiresAt" field.
+func ExpiresAtNEQ(v time.Time) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expiresAt" field.
+func ExpiresAtIn(vs ...time.Time) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expiresAt" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expiresAt" field.
+func ExpiresAtGT(v time.Time) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expiresAt" field.
+func ExpiresAtGTE(v time.Time) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expiresAt" field.
+func ExpiresAtLT(v time.Time) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expiresAt" field.
+func ExpiresAtLTE(v time.Time) predicate.ConfirmToken {
+	return predicate.ConfirmToken(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ConfirmToken) predicate.ConfirmToken {
+	return predicate.ConfirmToken(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ConfirmToken) predicate.ConfirmToken {
+	return predicate.ConfirmToken(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ConfirmToken) predicate.ConfirmToken {
+	return predicate.ConfirmToken(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hospital/internal/modules/db/ent/confirmtoken"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConfirmTokenCreate is the builder for creating a ConfirmToken entity.
+type ConfirmTokenCreate struct {
+	config
+	mutation *ConfirmTokenMutation
+	hooks    []Hook
+}
+
+// SetKey sets the "key" field.
+func (ctc *ConfirmTokenCreate) SetKey(s string) *ConfirmTokenCreate {
+	ctc.mutation.SetKey(s)
+	return ctc
+}
+
+// SetExpiresAt sets the "expiresAt" field.
+func (ctc *ConfirmTokenCreate) SetExpiresAt(t time.Time) *ConfirmTokenCreate {
+	ctc.mutation.SetExpiresAt(t)
+	return ctc
+}
+
+// Mutation returns the ConfirmTokenMutation object of the builder.
+func (ctc *ConfirmTokenCreate) Mutation() *ConfirmTokenMutation {
+	return ctc.mutation
+}
+
+// Save creates the ConfirmToken in the database.
+func (ctc *ConfirmTokenCreate) Save(ctx context.Context) (*ConfirmToken, error) {
+	return withHooks[*ConfirmToken, ConfirmTokenMutation](ctx, ctc.sqlSave, ctc.mutation, ctc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ctc *ConfirmTokenCreate) SaveX(ctx context.Context) *ConfirmToken {
+	v, err := ctc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ctc *ConfirmTokenCreate) Exec(ctx context.Context) error {
+	_, err := ctc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctc *ConfirmTokenCreate) ExecX(ctx context.Context) {
+	if err := ctc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ctc *ConfirmTokenCreate) check() error {
+	if _, ok := ctc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "ConfirmToken.key"`)}
+	}
+	if _, ok := ctc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expiresAt", err: errors.New(`ent: missing required field "ConfirmToken.expiresAt"`)}
+	}
+	return nil
+}
+
+func (ctc *ConfirmTokenCreate) sqlSave(ctx context.Context) (*ConfirmToken, error) {
+	if err := ctc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ctc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ctc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ctc.mutation.id = &_node.ID
+	ctc.mutation.done = true
+	return _node, nil
+}
+
+func (ctc *ConfirmTokenCreate) createSpec() (*ConfirmToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConfirmToken{config: ctc.config}
+		_spec = sqlgraph.NewCreateSpec(confirmtoken.Table, sqlgraph.NewFieldSpec(confirmtoken.FieldID, field.TypeInt))
+	)
+	if value, ok := ctc.mutation.Key(); ok {
+		_spec.SetField(confirmtoken.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := ctc.mutation.ExpiresAt(); ok {
+		_spec.SetField(confirmtoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// ConfirmTokenCreateBulk is the builder for creating many ConfirmToken entities in bulk.
+type ConfirmTokenCreateBulk struct {
+	config
+	builders []*ConfirmTokenCreate
+}
+
+// Save creates the ConfirmToken entities in the database.
+func (ctcb *ConfirmTokenCreateBulk) Save(ctx context.Context) ([]*ConfirmToken, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ctcb.builders))
+	nodes := make([]*ConfirmToken, len(ctcb.builders))
+	mutators := make([]Mutator, len(ctcb.builders))
+	for i := range ctcb.builders {
+		func(i int, root context.Context) {
+			builder := ctcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConfirmTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ctcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ctcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ctcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ctcb *ConfirmTokenCreateBulk) SaveX(ctx context.Context) []*ConfirmToken {
+	v, err := ctcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ctcb *ConfirmTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := ctcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctcb *ConfirmTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := ctcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"hospital/internal/modules/db/ent/confirmtoken"
+	"hospital/internal/modules/db/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConfirmTokenDelete is the builder for deleting a ConfirmToken entity.
+type ConfirmTokenDelete struct {
+	config
+	hooks    []Hook
+	mutation *ConfirmTokenMutation
+}
+
+// Where appends a list predicates to the ConfirmTokenDelete builder.
+func (ctd *ConfirmTokenDelete) Where(ps ...predicate.ConfirmToken) *ConfirmTokenDelete {
+	ctd.mutation.Where(ps...)
+	return ctd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ctd *ConfirmTokenDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, ConfirmTokenMutation](ctx, ctd.sqlExec, ctd.mutation, ctd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctd *ConfirmTokenDelete) ExecX(ctx context.Context) int {
+	n, err := ctd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ctd *ConfirmTokenDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(confirmtoken.Table, sqlgraph.NewFieldSpec(confirmtoken.FieldID, field.TypeInt))
+	if ps := ctd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ctd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ctd.mutation.done = true
+	return affected, err
+}
+
+// ConfirmTokenDeleteOne is the builder for deleting a single ConfirmToken entity.
+type ConfirmTokenDeleteOne struct {
+	ctd *ConfirmTokenDelete
+}
+
+// Where appends a list predicates to the ConfirmTokenDelete builder.
+func (ctdo *ConfirmTokenDeleteOne) Where(ps ...predicate.ConfirmToken) *ConfirmTokenDeleteOne {
+	ctdo.ctd.mutation.Where(ps...)
+	return ctdo
+}
+
+// Exec executes the deletion query.
+func (ctdo *ConfirmTokenDeleteOne) Exec(ctx context.Context) error {
+	n, err := ctdo.ctd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{confirmtoken.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctdo *ConfirmTokenDeleteOne) ExecX(ctx context.Context) {
+	if err := ctdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
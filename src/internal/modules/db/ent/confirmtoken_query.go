@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"hospital/internal/modules/db/ent/confirmtoken"
+	"hospital/internal/modules/db/ent/predicate"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConfirmTokenQuery is the builder for querying ConfirmToken entities.
+type ConfirmTokenQuery struct {
+	config
+	ctx        *QueryContext
+	order      []confirmtoken.Order
+	inters     []Interceptor
+	predicates []predicate.ConfirmToken
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ConfirmTokenQuery builder.
+func (ctq *ConfirmTokenQuery) Where(ps ...predicate.ConfirmToken) *ConfirmTokenQuery {
+	ctq.predicates = append(ctq.predicates, ps...)
+	return ctq
+}
+
+// Limit the number of records to be returned by this query.
+func (ctq *ConfirmTokenQuery) Limit(limit int) *ConfirmTokenQuery {
+	ctq.ctx.Limit = &limit
+	return ctq
+}
+
+// Offset to start from.
+func (ctq *ConfirmTokenQuery) Offset(offset int) *ConfirmTokenQuery {
+	ctq.ctx.Offset = &offset
+	return ctq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ctq *ConfirmTokenQuery) Unique(unique bool) *ConfirmTokenQuery {
+	ctq.ctx.Unique = &unique
+	return ctq
+}
+
+// Order specifies how the records should be ordered.
+func (ctq *ConfirmTokenQuery) Order(o ...confirmtoken.Order) *ConfirmTokenQuery {
+	ctq.order = append(ctq.order, o...)
+	return ctq
+}
+
+// First returns the first ConfirmToken entity from the query.
+// Returns a *NotFoundError when no ConfirmToken was found.
+func (ctq *ConfirmTokenQuery) First(ctx context.Context) (*ConfirmToken, error) {
+	nodes, err := ctq.Limit(1).All(setContextOp(ctx, ctq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{confirmtoken.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ctq *ConfirmTokenQuery) FirstX(ctx context.Context) *ConfirmToken {
+	node, err := ctq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ConfirmToken ID from the query.
+// Returns a *NotFoundError when no ConfirmToken ID was found.
+func (ctq *ConfirmTokenQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ctq.Limit(1).IDs(setContextOp(ctx, ctq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{confirmtoken.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ctq *ConfirmTokenQuery) FirstIDX(ctx context.Context) int {
+	id, err := ctq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ConfirmToken entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ConfirmToken entity is found.
+// Returns a *NotFoundError when no ConfirmToken entities are found.
+func (ctq *ConfirmTokenQuery) Only(ctx context.Context) (*ConfirmToken, error) {
+	nodes, err := ctq.Limit(2).All(setContextOp(ctx, ctq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{confirmtoken.Label}
+	default:
+		return nil, &NotSingularError{confirmtoken.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ctq *ConfirmTokenQuery) OnlyX(ctx context.Context) *ConfirmToken {
+	node, err := ctq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ConfirmToken ID in the query.
+// Returns a *NotSingularError when more than one ConfirmToken ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ctq *ConfirmTokenQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ctq.Limit(2).IDs(setContextOp(ctx, ctq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{confirmtoken.Label}
+	default:
+		err = &NotSingularError{confirmtoken.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ctq *ConfirmTokenQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ctq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ConfirmTokens.
+func (ctq *ConfirmTokenQuery) All(ctx context.Context) ([]*ConfirmToken, error) {
+	ctx = setContextOp(ctx, ctq.ctx, "All")
+	if err := ctq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ConfirmToken, *ConfirmTokenQuery]()
+	return withInterceptors[[]*ConfirmToken](ctx, ctq, qr, ctq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ctq *ConfirmTokenQuery) AllX(ctx context.Context) []*ConfirmToken {
+	nodes, err := ctq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ConfirmToken IDs.
+func (ctq *ConfirmTokenQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ctq.ctx.Unique == nil && ctq.path != nil {
+		ctq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ctq.ctx, "IDs")
+	if err = ctq.Select(confirmtoken.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ctq *ConfirmTokenQuery) IDsX(ctx context.Context) []int {
+	ids, err := ctq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ctq *ConfirmTokenQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ctq.ctx, "Count")
+	if err := ctq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ctq, querierCount[*ConfirmTokenQuery](), ctq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ctq *ConfirmTokenQuery) CountX(ctx context.Context) int {
+	count, err := ctq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ctq *ConfirmTokenQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ctq.ctx, "Exist")
+	switch _, err := ctq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ctq *ConfirmTokenQuery) ExistX(ctx context.Context) bool {
+	exist, err := ctq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ConfirmTokenQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ctq *ConfirmTokenQuery) Clone() *ConfirmTokenQuery {
+	if ctq == nil {
+		return nil
+	}
+	return &ConfirmTokenQuery{
+		config:     ctq.config,
+		ctx:        ctq.ctx.Clone(),
+		order:      append([]confirmtoken.Order{}, ctq.order...),
+		inters:     append([]Interceptor{}, ctq.inters...),
+		predicates: append([]predicate.ConfirmToken{}, ctq.predicates...),
+		// clone intermediate query.
+		sql:  ctq.sql.Clone(),
+		path: ctq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Key string `json:"key,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ConfirmToken.Query().
+//		GroupBy(confirmtoken.FieldKey).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ctq *ConfirmTokenQuery) GroupBy(field string, fields ...string) *ConfirmTokenGroupBy {
+	ctq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ConfirmTokenGroupBy{build: ctq}
+	grbuild.flds = &ctq.ctx.Fields
+	grbuild.label = confirmtoken.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Key string `json:"key,omitempty"`
+//	}
+//
+//	client.ConfirmToken.Query().
+//		Select(confirmtoken.FieldKey).
+//		Scan(ctx, &v)
+func (ctq *ConfirmTokenQuery) Select(fields ...string) *ConfirmTokenSelect {
+	ctq.ctx.Fields = append(ctq.ctx.Fields, fields...)
+	sbuild := &ConfirmTokenSelect{ConfirmTokenQuery: ctq}
+	sbuild.label = confirmtoken.Label
+	sbuild.flds, sbuild.scan = &ctq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ConfirmTokenSelect configured with the given aggregations.
+func (ctq *ConfirmTokenQuery) Aggregate(fns ...AggregateFunc) *ConfirmTokenSelect {
+	return ctq.Select().Aggregate(fns...)
+}
+
+func (ctq *ConfirmTokenQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ctq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ctq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ctq.ctx.Fields {
+		if !confirmtoken.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ctq.path != nil {
+		prev, err := ctq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ctq.sql = prev
+	}
+	return nil
+}
+
+func (ctq *ConfirmTokenQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ConfirmToken, error) {
+	var (
+		nodes = []*ConfirmToken{}
+		_spec = ctq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ConfirmToken).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ConfirmToken{config: ctq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ctq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ctq *ConfirmTokenQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ctq.querySpec()
+	_spec.Node.Columns = ctq.ctx.Fields
+	if len(ctq.ctx.Fields) > 0 {
+		_spec.Unique = ctq.ctx.Unique != nil && *ctq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ctq.driver, _spec)
+}
+
+func (ctq *ConfirmTokenQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(confirmtoken.Table, confirmtoken.Columns, sqlgraph.NewFieldSpec(confirmtoken.FieldID, field.TypeInt))
+	_spec.From = ctq.sql
+	if unique := ctq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ctq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ctq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, confirmtoken.FieldID)
+		for i := range fields {
+			if fields[i] != confirmtoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ctq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ctq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ctq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ctq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ctq *ConfirmTokenQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ctq.driver.Dialect())
+	t1 := builder.Table(confirmtoken.Table)
+	columns := ctq.ctx.Fields
+	if len(columns) == 0 {
+		columns = confirmtoken.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ctq.sql != nil {
+		selector = ctq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ctq.ctx.Unique != nil && *ctq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ctq.predicates {
+		p(selector)
+	}
+	for _, p := range ctq.order {
+		p(selector)
+	}
+	if offset := ctq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ctq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ConfirmTokenGroupBy is the group-by builder for ConfirmToken entities.
+type ConfirmTokenGroupBy struct {
+	selector
+	build *ConfirmTokenQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ctgb *ConfirmTokenGroupBy) Aggregate(fns ...AggregateFunc) *ConfirmTokenGroupBy {
+	ctgb.fns = append(ctgb.fns, fns...)
+	return ctgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ctgb *ConfirmTokenGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ctgb.build.ctx, "GroupBy")
+	if err := ctgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ConfirmTokenQuery, *ConfirmTokenGroupBy](ctx, ctgb.build, ctgb, ctgb.build.inters, v)
+}
+
+func (ctgb *ConfirmTokenGroupBy) sqlScan(ctx context.Context, root *ConfirmTokenQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ctgb.fns))
+	for _, fn := range ctgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ctgb.flds)+len(ctgb.fns))
+		for _, f := range *ctgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ctgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ctgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ConfirmTokenSelect is the builder for selecting fields of ConfirmToken entities.
+type ConfirmTokenSelect struct {
+	*ConfirmTokenQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cts *ConfirmTokenSelect) Aggregate(fns ...AggregateFunc) *ConfirmTokenSelect {
+	cts.fns = append(cts.fns, fns...)
+	return cts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cts *ConfirmTokenSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cts.ctx, "Select")
+	if err := cts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ConfirmTokenQuery, *ConfirmTokenSelect](ctx, cts.ConfirmTokenQuery, cts, cts.inters, v)
+}
+
+func (cts *ConfirmTokenSelect) sqlScan(ctx context.Context, root *ConfirmTokenQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cts.fns))
+	for _, fn := range cts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hospital/internal/modules/db/ent/confirmtoken"
+	"hospital/internal/modules/db/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConfirmTokenUpdate is the builder for updating ConfirmToken entities.
+type ConfirmTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConfirmTokenMutation
+}
+
+// Where appends a list predicates to the ConfirmTokenUpdate builder.
+func (ctu *ConfirmTokenUpdate) Where(ps ...predicate.ConfirmToken) *ConfirmTokenUpdate {
+	ctu.mutation.Where(ps...)
+	return ctu
+}
+
+// Mutation returns the ConfirmTokenMutation object of the builder.
+func (ctu *ConfirmTokenUpdate) Mutation() *ConfirmTokenMutation {
+	return ctu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ctu *ConfirmTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks[int, ConfirmTokenMutation](ctx, ctu.sqlSave, ctu.mutation, ctu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ctu *ConfirmTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := ctu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ctu *ConfirmTokenUpdate) Exec(ctx context.Context) error {
+	_, err := ctu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctu *ConfirmTokenUpdate) ExecX(ctx context.Context) {
+	if err := ctu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ctu *ConfirmTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(confirmtoken.Table, confirmtoken.Columns, sqlgraph.NewFieldSpec(confirmtoken.FieldID, field.TypeInt))
+	if ps := ctu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ctu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{confirmtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ctu.mutation.done = true
+	return n, nil
+}
+
+// ConfirmTokenUpdateOne is the builder for updating a single ConfirmToken entity.
+type ConfirmTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConfirmTokenMutation
+}
+
+// Mutation returns the ConfirmTokenMutation object of the builder.
+func (ctuo *ConfirmTokenUpdateOne) Mutation() *ConfirmTokenMutation {
+	return ctuo.mutation
+}
+
+// Where appends a list predicates to the ConfirmTokenUpdate builder.
+func (ctuo *ConfirmTokenUpdateOne) Where(ps ...predicate.ConfirmToken) *ConfirmTokenUpdateOne {
+	ctuo.mutation.Where(ps...)
+	return ctuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ctuo *ConfirmTokenUpdateOne) Select(field string, fields ...string) *ConfirmTokenUpdateOne {
+	ctuo.fields = append([]string{field}, fields...)
+	return ctuo
+}
+
+// Save executes the query and returns the updated ConfirmToken entity.
+func (ctuo *ConfirmTokenUpdateOne) Save(ctx context.Context) (*ConfirmToken, error) {
+	return withHooks[*ConfirmToken, ConfirmTokenMutation](ctx, ctuo.sqlSave, ctuo.mutation, ctuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ctuo *ConfirmTokenUpdateOne) SaveX(ctx context.Context) *ConfirmToken {
+	node, err := ctuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ctuo *ConfirmTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := ctuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctuo *ConfirmTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := ctuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ctuo *ConfirmTokenUpdateOne) sqlSave(ctx context.Context) (_node *ConfirmToken, err error) {
+	_spec := sqlgraph.NewUpdateSpec(confirmtoken.Table, confirmtoken.Columns, sqlgraph.NewFieldSpec(confirmtoken.FieldID, field.TypeInt))
+	id, ok := ctuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ConfirmToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ctuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, confirmtoken.FieldID)
+		for _, f := range fields {
+			if !confirmtoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != confirmtoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ctuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &ConfirmToken{config: ctuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ctuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{confirmtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ctuo.mutation.done = true
+	return _node, nil
+}
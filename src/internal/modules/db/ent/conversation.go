@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"hospital/internal/modules/db/ent/conversation"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Conversation is the model entity for the Conversation schema.
+type Conversation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ChatId holds the value of the "chatId" field.
+	ChatId int64 `json:"chatId,omitempty"`
+	// UserId holds the value of the "userId" field.
+	UserId int64 `json:"userId,omitempty"`
+	// Dialog holds the value of the "dialog" field.
+	Dialog string `json:"dialog,omitempty"`
+	// State holds the value of the "state" field.
+	State string `json:"state,omitempty"`
+	// Values holds the value of the "values" field.
+	Values map[string]string `json:"values,omitempty"`
+	// History holds the value of the "history" field.
+	History []string `json:"history,omitempty"`
+	// Suspended holds the value of the "suspended" field.
+	Suspended bool `json:"suspended,omitempty"`
+	// UpdatedAt holds the value of the "updatedAt" field.
+	UpdatedAt    time.Time `json:"updatedAt,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Conversation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case conversation.FieldValues, conversation.FieldHistory:
+			values[i] = new([]byte)
+		case conversation.FieldSuspended:
+			values[i] = new(sql.NullBool)
+		case conversation.FieldID, conversation.FieldChatId, conversation.FieldUserId:
+			values[i] = new(sql.NullInt64)
+		case conversation.FieldDialog, conversation.FieldState:
+			values[i] = new(sql.NullString)
+		case conversation.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Conversation fields.
+func (c *Conversation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case conversation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			c.ID = int(value.Int64)
+		case conversation.FieldChatId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field chatId", values[i])
+			} else if value.Valid {
+				c.ChatId = value.Int64
+			}
+		case conversation.FieldUserId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field userId", values[i])
+			} else if value.Valid {
+				c.UserId = value.Int64
+			}
+		case conversation.FieldDialog:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dialog", values[i])
+			} else if value.Valid {
+				c.Dialog = value.String
+			}
+		case conversation.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				c.State = value.String
+			}
+		case conversation.FieldValues:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field values", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.Values); err != nil {
+					return fmt.Errorf("unmarshal field values: %w", err)
+				}
+			}
+		case conversation.FieldHistory:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field history", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.History); err != nil {
+					return fmt.Errorf("unmarshal field history: %w", err)
+				}
+			}
+		case conversation.FieldSuspended:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field suspended", values[i])
+			} else if value.Valid {
+				c.Suspended = value.Bool
+			}
+		case conversation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updatedAt", values[i])
+			} else if value.Valid {
+				c.UpdatedAt = value.Time
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Conversation.
+// This includes values selected through modifiers, order, etc.
+func (c *Conversation) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Conversation.
+// Note that you need to call Conversation.Unwrap() before calling this method if this Conversation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Conversation) Update() *ConversationUpdateOne {
+	return NewConversationClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Conversation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Conversation) Unwrap() *Conversation {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Conversation is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Conversation) String() string {
+	var builder strings.Builder
+	builder.WriteString("Conversation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("chatId=")
+	builder.WriteString(fmt.Sprintf("%v", c.ChatId))
+	builder.WriteString(", ")
+	builder.WriteString("userId=")
+	builder.WriteString(fmt.Sprintf("%v", c.UserId))
+	builder.WriteString(", ")
+	builder.WriteString("dialog=")
+	builder.WriteString(c.Dialog)
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(c.State)
+	builder.WriteString(", ")
+	builder.WriteString("values=")
+	builder.WriteString(fmt.Sprintf("%v", c.Values))
+	builder.WriteString(", ")
+	builder.WriteString("history=")
+	builder.WriteString(fmt.Sprintf("%v", c.History))
+	builder.WriteString(", ")
+	builder.WriteString("suspended=")
+	builder.WriteString(fmt.Sprintf("%v", c.Suspended))
+	builder.WriteString(", ")
+	builder.WriteString("updatedAt=")
+	builder.WriteString(c.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Conversations is a parsable slice of Conversation.
+type Conversations []*Conversation
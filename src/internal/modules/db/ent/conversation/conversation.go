@@ -0,0 +1,102 @@
+// Code generated by ent, DO NOT EDIT.
+
+package conversation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the conversation type in the database.
+	Label = "conversation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldChatId holds the string denoting the chatid field in the database.
+	FieldChatId = "chat_id"
+	// FieldUserId holds the string denoting the userid field in the database.
+	FieldUserId = "user_id"
+	// FieldDialog holds the string denoting the dialog field in the database.
+	FieldDialog = "dialog"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// FieldValues holds the string denoting the values field in the database.
+	FieldValues = "values"
+	// FieldHistory holds the string denoting the history field in the database.
+	FieldHistory = "history"
+	// FieldSuspended holds the string denoting the suspended field in the database.
+	FieldSuspended = "suspended"
+	// FieldUpdatedAt holds the string denoting the updatedat field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the conversation in the database.
+	Table = "conversations"
+)
+
+// Columns holds all SQL columns for conversation fields.
+var Columns = []string{
+	FieldID,
+	FieldChatId,
+	FieldUserId,
+	FieldDialog,
+	FieldState,
+	FieldValues,
+	FieldHistory,
+	FieldSuspended,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultSuspended holds the default value on creation for the "suspended" field.
+	DefaultSuspended bool
+	// DefaultUpdatedAt holds the default value on creation for the "updatedAt" field.
+	DefaultUpdatedAt func() time.Time
+)
+
+// Order defines the ordering method for the Conversation queries.
+type Order func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByChatId orders the results by the chatId field.
+func ByChatId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldChatId, opts...).ToFunc()
+}
+
+// ByUserId orders the results by the userId field.
+func ByUserId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldUserId, opts...).ToFunc()
+}
+
+// ByDialog orders the results by the dialog field.
+func ByDialog(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldDialog, opts...).ToFunc()
+}
+
+// ByState orders the results by the state field.
+func ByState(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldState, opts...).ToFunc()
+}
+
+// BySuspended orders the results by the suspended field.
+func BySuspended(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldSuspended, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updatedAt field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
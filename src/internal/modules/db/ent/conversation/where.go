@@ -0,0 +1,387 @@
+// Code generated by ent, DO NOT EDIT.
+
+package conversation
+
+import (
+	"hospital/internal/modules/db/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldID, id))
+}
+
+// ChatId applies equality check predicate on the "chatId" field. It's identical to ChatIdEQ.
+func ChatId(v int64) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldChatId, v))
+}
+
+// UserId applies equality check predicate on the "userId" field. It's identical to UserIdEQ.
+func UserId(v int64) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldUserId, v))
+}
+
+// Dialog applies equality check predicate on the "dialog" field. It's identical to DialogEQ.
+func Dialog(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldDialog, v))
+}
+
+// State applies equality check predicate on the "state" field. It's identical to StateEQ.
+func State(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldState, v))
+}
+
+// Suspended applies equality check predicate on the "suspended" field. It's identical to SuspendedEQ.
+func Suspended(v bool) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldSuspended, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updatedAt" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ChatIdEQ applies the EQ predicate on the "chatId" field.
+func ChatIdEQ(v int64) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldChatId, v))
+}
+
+// ChatIdNEQ applies the NEQ predicate on the "chatId" field.
+func ChatIdNEQ(v int64) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldChatId, v))
+}
+
+// ChatIdIn applies the In predicate on the "chatId" field.
+func ChatIdIn(vs ...int64) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldChatId, vs...))
+}
+
+// ChatIdNotIn applies the NotIn predicate on the "chatId" field.
+func ChatIdNotIn(vs ...int64) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldChatId, vs...))
+}
+
+// ChatIdGT applies the GT predicate on the "chatId" field.
+func ChatIdGT(v int64) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldChatId, v))
+}
+
+// ChatIdGTE applies the GTE predicate on the "chatId" field.
+func ChatIdGTE(v int64) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldChatId, v))
+}
+
+// ChatIdLT applies the LT predicate on the "chatId" field.
+func ChatIdLT(v int64) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldChatId, v))
+}
+
+// ChatIdLTE applies the LTE predicate on the "chatId" field.
+func ChatIdLTE(v int64) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldChatId, v))
+}
+
+// UserIdEQ applies the EQ predicate on the "userId" field.
+func UserIdEQ(v int64) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldUserId, v))
+}
+
+// UserIdNEQ applies the NEQ predicate on the "userId" field.
+func UserIdNEQ(v int64) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldUserId, v))
+}
+
+// UserIdIn applies the In predicate on the "userId" field.
+func UserIdIn(vs ...int64) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldUserId, vs...))
+}
+
+// UserIdNotIn applies the NotIn predicate on the "userId" field.
+func UserIdNotIn(vs ...int64) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldUserId, vs...))
+}
+
+// UserIdGT applies the GT predicate on the "userId" field.
+func UserIdGT(v int64) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldUserId, v))
+}
+
+// UserIdGTE applies the GTE predicate on the "userId" field.
+func UserIdGTE(v int64) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldUserId, v))
+}
+
+// UserIdLT applies the LT predicate on the "userId" field.
+func UserIdLT(v int64) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldUserId, v))
+}
+
+// UserIdLTE applies the LTE predicate on the "userId" field.
+func UserIdLTE(v int64) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldUserId, v))
+}
+
+// DialogEQ applies the EQ predicate on the "dialog" field.
+func DialogEQ(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldDialog, v))
+}
+
+// DialogNEQ applies the NEQ predicate on the "dialog" field.
+func DialogNEQ(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldDialog, v))
+}
+
+// DialogIn applies the In predicate on the "dialog" field.
+func DialogIn(vs ...string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldDialog, vs...))
+}
+
+// DialogNotIn applies the NotIn predicate on the "dialog" field.
+func DialogNotIn(vs ...string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldDialog, vs...))
+}
+
+// DialogGT applies the GT predicate on the "dialog" field.
+func DialogGT(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldDialog, v))
+}
+
+// DialogGTE applies the GTE predicate on the "dialog" field.
+func DialogGTE(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldDialog, v))
+}
+
+// DialogLT applies the LT predicate on the "dialog" field.
+func DialogLT(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldDialog, v))
+}
+
+// DialogLTE applies the LTE predicate on the "dialog" field.
+func DialogLTE(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldDialog, v))
+}
+
+// DialogContains applies the Contains predicate on the "dialog" field.
+func DialogContains(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldContains(FieldDialog, v))
+}
+
+// DialogHasPrefix applies the HasPrefix predicate on the "dialog" field.
+func DialogHasPrefix(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldHasPrefix(FieldDialog, v))
+}
+
+// DialogHasSuffix applies the HasSuffix predicate on the "dialog" field.
+func DialogHasSuffix(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldHasSuffix(FieldDialog, v))
+}
+
+// DialogEqualFold applies the EqualFold predicate on the "dialog" field.
+func DialogEqualFold(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEqualFold(FieldDialog, v))
+}
+
+// DialogContainsFold applies the ContainsFold predicate on the "dialog" field.
+func DialogContainsFold(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldContainsFold(FieldDialog, v))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldState, vs...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldState, vs...))
+}
+
+// StateGT applies the GT predicate on the "state" field.
+func StateGT(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldState, v))
+}
+
+// StateGTE applies the GTE predicate on the "state" field.
+func StateGTE(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldState, v))
+}
+
+// StateLT applies the LT predicate on the "state" field.
+func StateLT(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldState, v))
+}
+
+// StateLTE applies the LTE predicate on the "state" field.
+func StateLTE(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldState, v))
+}
+
+// StateContains applies the Contains predicate on the "state" field.
+func StateContains(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldContains(FieldState, v))
+}
+
+// StateHasPrefix applies the HasPrefix predicate on the "state" field.
+func StateHasPrefix(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldHasPrefix(FieldState, v))
+}
+
+// StateHasSuffix applies the HasSuffix predicate on the "state" field.
+func StateHasSuffix(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldHasSuffix(FieldState, v))
+}
+
+// StateEqualFold applies the EqualFold predicate on the "state" field.
+func StateEqualFold(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEqualFold(FieldState, v))
+}
+
+// StateContainsFold applies the ContainsFold predicate on the "state" field.
+func StateContainsFold(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldContainsFold(FieldState, v))
+}
+
+// HistoryIsNil applies the IsNil predicate on the "history" field.
+func HistoryIsNil() predicate.Conversation {
+	return predicate.Conversation(sql.FieldIsNull(FieldHistory))
+}
+
+// HistoryNotNil applies the NotNil predicate on the "history" field.
+func HistoryNotNil() predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotNull(FieldHistory))
+}
+
+// SuspendedEQ applies the EQ predicate on the "suspended" field.
+func SuspendedEQ(v bool) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldSuspended, v))
+}
+
+// SuspendedNEQ applies the NEQ predicate on the "suspended" field.
+func SuspendedNEQ(v bool) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldSuspended, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updatedAt" field.
+func UpdatedAtEQ(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updatedAt" field.
+func UpdatedAtNEQ(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updatedAt" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updatedAt" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updatedAt" field.
+func UpdatedAtGT(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updatedAt" field.
+func UpdatedAtGTE(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updatedAt" field.
+func UpdatedAtLT(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updatedAt" field.
+func UpdatedAtLTE(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Conversation) predicate.Conversation {
+	return predicate.Conversation(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Conversation) predicate.Conversation {
+	return predicate.Conversation(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Conversation) predicate.Conversation {
+	return predicate.Conversation(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,298 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hospital/internal/modules/db/ent/conversation"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConversationCreate is the builder for creating a Conversation entity.
+type ConversationCreate struct {
+	config
+	mutation *ConversationMutation
+	hooks    []Hook
+}
+
+// SetChatId sets the "chatId" field.
+func (cc *ConversationCreate) SetChatId(i int64) *ConversationCreate {
+	cc.mutation.SetChatId(i)
+	return cc
+}
+
+// SetUserId sets the "userId" field.
+func (cc *ConversationCreate) SetUserId(i int64) *ConversationCreate {
+	cc.mutation.SetUserId(i)
+	return cc
+}
+
+// SetDialog sets the "dialog" field.
+func (cc *ConversationCreate) SetDialog(s string) *ConversationCreate {
+	cc.mutation.SetDialog(s)
+	return cc
+}
+
+// SetState sets the "state" field.
+func (cc *ConversationCreate) SetState(s string) *ConversationCreate {
+	cc.mutation.SetState(s)
+	return cc
+}
+
+// SetValues sets the "values" field.
+func (cc *ConversationCreate) SetValues(m map[string]string) *ConversationCreate {
+	cc.mutation.SetValues(m)
+	return cc
+}
+
+// SetHistory sets the "history" field.
+func (cc *ConversationCreate) SetHistory(s []string) *ConversationCreate {
+	cc.mutation.SetHistory(s)
+	return cc
+}
+
+// SetSuspended sets the "suspended" field.
+func (cc *ConversationCreate) SetSuspended(b bool) *ConversationCreate {
+	cc.mutation.SetSuspended(b)
+	return cc
+}
+
+// SetNillableSuspended sets the "suspended" field if the given value is not nil.
+func (cc *ConversationCreate) SetNillableSuspended(b *bool) *ConversationCreate {
+	if b != nil {
+		cc.SetSuspended(*b)
+	}
+	return cc
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (cc *ConversationCreate) SetUpdatedAt(t time.Time) *ConversationCreate {
+	cc.mutation.SetUpdatedAt(t)
+	return cc
+}
+
+// SetNillableUpdatedAt sets the "updatedAt" field if the given value is not nil.
+func (cc *ConversationCreate) SetNillableUpdatedAt(t *time.Time) *ConversationCreate {
+	if t != nil {
+		cc.SetUpdatedAt(*t)
+	}
+	return cc
+}
+
+// Mutation returns the ConversationMutation object of the builder.
+func (cc *ConversationCreate) Mutation() *ConversationMutation {
+	return cc.mutation
+}
+
+// Save creates the Conversation in the database.
+func (cc *ConversationCreate) Save(ctx context.Context) (*Conversation, error) {
+	cc.defaults()
+	return withHooks[*Conversation, ConversationMutation](ctx, cc.sqlSave, cc.mutation, cc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *ConversationCreate) SaveX(ctx context.Context) *Conversation {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *ConversationCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *ConversationCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *ConversationCreate) defaults() {
+	if _, ok := cc.mutation.Suspended(); !ok {
+		v := conversation.DefaultSuspended
+		cc.mutation.SetSuspended(v)
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		v := conversation.DefaultUpdatedAt()
+		cc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *ConversationCreate) check() error {
+	if _, ok := cc.mutation.ChatId(); !ok {
+		return &ValidationError{Name: "chatId", err: errors.New(`ent: missing required field "Conversation.chatId"`)}
+	}
+	if _, ok := cc.mutation.UserId(); !ok {
+		return &ValidationError{Name: "userId", err: errors.New(`ent: missing required field "Conversation.userId"`)}
+	}
+	if _, ok := cc.mutation.Dialog(); !ok {
+		return &ValidationError{Name: "dialog", err: errors.New(`ent: missing required field "Conversation.dialog"`)}
+	}
+	if _, ok := cc.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "Conversation.state"`)}
+	}
+	if _, ok := cc.mutation.Values(); !ok {
+		return &ValidationError{Name: "values", err: errors.New(`ent: missing required field "Conversation.values"`)}
+	}
+	if _, ok := cc.mutation.Suspended(); !ok {
+		return &ValidationError{Name: "suspended", err: errors.New(`ent: missing required field "Conversation.suspended"`)}
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updatedAt", err: errors.New(`ent: missing required field "Conversation.updatedAt"`)}
+	}
+	return nil
+}
+
+func (cc *ConversationCreate) sqlSave(ctx context.Context) (*Conversation, error) {
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cc.mutation.id = &_node.ID
+	cc.mutation.done = true
+	return _node, nil
+}
+
+func (cc *ConversationCreate) createSpec() (*Conversation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Conversation{config: cc.config}
+		_spec = sqlgraph.NewCreateSpec(conversation.Table, sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeInt))
+	)
+	if value, ok := cc.mutation.ChatId(); ok {
+		_spec.SetField(conversation.FieldChatId, field.TypeInt64, value)
+		_node.ChatId = value
+	}
+	if value, ok := cc.mutation.UserId(); ok {
+		_spec.SetField(conversation.FieldUserId, field.TypeInt64, value)
+		_node.UserId = value
+	}
+	if value, ok := cc.mutation.Dialog(); ok {
+		_spec.SetField(conversation.FieldDialog, field.TypeString, value)
+		_node.Dialog = value
+	}
+	if value, ok := cc.mutation.State(); ok {
+		_spec.SetField(conversation.FieldState, field.TypeString, value)
+		_node.State = value
+	}
+	if value, ok := cc.mutation.Values(); ok {
+		_spec.SetField(conversation.FieldValues, field.TypeJSON, value)
+		_node.Values = value
+	}
+	if value, ok := cc.mutation.History(); ok {
+		_spec.SetField(conversation.FieldHistory, field.TypeJSON, value)
+		_node.History = value
+	}
+	if value, ok := cc.mutation.Suspended(); ok {
+		_spec.SetField(conversation.FieldSuspended, field.TypeBool, value)
+		_node.Suspended = value
+	}
+	if value, ok := cc.mutation.UpdatedAt(); ok {
+		_spec.SetField(conversation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ConversationCreateBulk is the builder for creating many Conversation entities in bulk.
+type ConversationCreateBulk struct {
+	config
+	builders []*ConversationCreate
+}
+
+// Save creates the Conversation entities in the database.
+func (ccb *ConversationCreateBulk) Save(ctx context.Context) ([]*Conversation, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Conversation, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConversationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *ConversationCreateBulk) SaveX(ctx context.Context) []*Conversation {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *ConversationCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *ConversationCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,433 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hospital/internal/modules/db/ent/conversation"
+	"hospital/internal/modules/db/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// ConversationUpdate is the builder for updating Conversation entities.
+type ConversationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConversationMutation
+}
+
+// Where appends a list predicates to the ConversationUpdate builder.
+func (cu *ConversationUpdate) Where(ps ...predicate.Conversation) *ConversationUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetChatId sets the "chatId" field.
+func (cu *ConversationUpdate) SetChatId(i int64) *ConversationUpdate {
+	cu.mutation.ResetChatId()
+	cu.mutation.SetChatId(i)
+	return cu
+}
+
+// AddChatId adds i to the "chatId" field.
+func (cu *ConversationUpdate) AddChatId(i int64) *ConversationUpdate {
+	cu.mutation.AddChatId(i)
+	return cu
+}
+
+// SetUserId sets the "userId" field.
+func (cu *ConversationUpdate) SetUserId(i int64) *ConversationUpdate {
+	cu.mutation.ResetUserId()
+	cu.mutation.SetUserId(i)
+	return cu
+}
+
+// AddUserId adds i to the "userId" field.
+func (cu *ConversationUpdate) AddUserId(i int64) *ConversationUpdate {
+	cu.mutation.AddUserId(i)
+	return cu
+}
+
+// SetDialog sets the "dialog" field.
+func (cu *ConversationUpdate) SetDialog(s string) *ConversationUpdate {
+	cu.mutation.SetDialog(s)
+	return cu
+}
+
+// SetState sets the "state" field.
+func (cu *ConversationUpdate) SetState(s string) *ConversationUpdate {
+	cu.mutation.SetState(s)
+	return cu
+}
+
+// SetValues sets the "values" field.
+func (cu *ConversationUpdate) SetValues(m map[string]string) *ConversationUpdate {
+	cu.mutation.SetValues(m)
+	return cu
+}
+
+// SetHistory sets the "history" field.
+func (cu *ConversationUpdate) SetHistory(s []string) *ConversationUpdate {
+	cu.mutation.SetHistory(s)
+	return cu
+}
+
+// AppendHistory appends s to the "history" field.
+func (cu *ConversationUpdate) AppendHistory(s []string) *ConversationUpdate {
+	cu.mutation.AppendHistory(s)
+	return cu
+}
+
+// ClearHistory clears the value of the "history" field.
+func (cu *ConversationUpdate) ClearHistory() *ConversationUpdate {
+	cu.mutation.ClearHistory()
+	return cu
+}
+
+// SetSuspended sets the "suspended" field.
+func (cu *ConversationUpdate) SetSuspended(b bool) *ConversationUpdate {
+	cu.mutation.SetSuspended(b)
+	return cu
+}
+
+// SetNillableSuspended sets the "suspended" field if the given value is not nil.
+func (cu *ConversationUpdate) SetNillableSuspended(b *bool) *ConversationUpdate {
+	if b != nil {
+		cu.SetSuspended(*b)
+	}
+	return cu
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (cu *ConversationUpdate) SetUpdatedAt(t time.Time) *ConversationUpdate {
+	cu.mutation.SetUpdatedAt(t)
+	return cu
+}
+
+// SetNillableUpdatedAt sets the "updatedAt" field if the given value is not nil.
+func (cu *ConversationUpdate) SetNillableUpdatedAt(t *time.Time) *ConversationUpdate {
+	if t != nil {
+		cu.SetUpdatedAt(*t)
+	}
+	return cu
+}
+
+// Mutation returns the ConversationMutation object of the builder.
+func (cu *ConversationUpdate) Mutation() *ConversationMutation {
+	return cu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *ConversationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks[int, ConversationMutation](ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *ConversationUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *ConversationUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *ConversationUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cu *ConversationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(conversation.Table, conversation.Columns, sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeInt))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.ChatId(); ok {
+		_spec.SetField(conversation.FieldChatId, field.TypeInt64, value)
+	}
+	if value, ok := cu.mutation.AddedChatId(); ok {
+		_spec.AddField(conversation.FieldChatId, field.TypeInt64, value)
+	}
+	if value, ok := cu.mutation.UserId(); ok {
+		_spec.SetField(conversation.FieldUserId, field.TypeInt64, value)
+	}
+	if value, ok := cu.mutation.AddedUserId(); ok {
+		_spec.AddField(conversation.FieldUserId, field.TypeInt64, value)
+	}
+	if value, ok := cu.mutation.Dialog(); ok {
+		_spec.SetField(conversation.FieldDialog, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.State(); ok {
+		_spec.SetField(conversation.FieldState, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Values(); ok {
+		_spec.SetField(conversation.FieldValues, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.History(); ok {
+		_spec.SetField(conversation.FieldHistory, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.AppendedHistory(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, conversation.FieldHistory, value)
+		})
+	}
+	if cu.mutation.HistoryCleared() {
+		_spec.ClearField(conversation.FieldHistory, field.TypeJSON)
+	}
+	if value, ok := cu.mutation.Suspended(); ok {
+		_spec.SetField(conversation.FieldSuspended, field.TypeBool, value)
+	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.SetField(conversation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{conversation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// ConversationUpdateOne is the builder for updating a single Conversation entity.
+type ConversationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConversationMutation
+}
+
+// SetChatId sets the "chatId" field.
+func (cuo *ConversationUpdateOne) SetChatId(i int64) *ConversationUpdateOne {
+	cuo.mutation.ResetChatId()
+	cuo.mutation.SetChatId(i)
+	return cuo
+}
+
+// AddChatId adds i to the "chatId" field.
+func (cuo *ConversationUpdateOne) AddChatId(i int64) *ConversationUpdateOne {
+	cuo.mutation.AddChatId(i)
+	return cuo
+}
+
+// SetUserId sets the "userId" field.
+func (cuo *ConversationUpdateOne) SetUserId(i int64) *ConversationUpdateOne {
+	cuo.mutation.ResetUserId()
+	cuo.mutation.SetUserId(i)
+	return cuo
+}
+
+// AddUserId adds i to the "userId" field.
+func (cuo *ConversationUpdateOne) AddUserId(i int64) *ConversationUpdateOne {
+	cuo.mutation.AddUserId(i)
+	return cuo
+}
+
+// SetDialog sets the "dialog" field.
+func (cuo *ConversationUpdateOne) SetDialog(s string) *ConversationUpdateOne {
+	cuo.mutation.SetDialog(s)
+	return cuo
+}
+
+// SetState sets the "state" field.
+func (cuo *ConversationUpdateOne) SetState(s string) *ConversationUpdateOne {
+	cuo.mutation.SetState(s)
+	return cuo
+}
+
+// SetValues sets the "values" field.
+func (cuo *ConversationUpdateOne) SetValues(m map[string]string) *ConversationUpdateOne {
+	cuo.mutation.SetValues(m)
+	return cuo
+}
+
+// SetHistory sets the "history" field.
+func (cuo *ConversationUpdateOne) SetHistory(s []string) *ConversationUpdateOne {
+	cuo.mutation.SetHistory(s)
+	return cuo
+}
+
+// AppendHistory appends s to the "history" field.
+func (cuo *ConversationUpdateOne) AppendHistory(s []string) *ConversationUpdateOne {
+	cuo.mutation.AppendHistory(s)
+	return cuo
+}
+
+// ClearHistory clears the value of the "history" field.
+func (cuo *ConversationUpdateOne) ClearHistory() *ConversationUpdateOne {
+	cuo.mutation.ClearHistory()
+	return cuo
+}
+
+// SetSuspended sets the "suspended" field.
+func (cuo *ConversationUpdateOne) SetSuspended(b bool) *ConversationUpdateOne {
+	cuo.mutation.SetSuspended(b)
+	return cuo
+}
+
+// SetNillableSuspended sets the "suspended" field if the given value is not nil.
+func (cuo *ConversationUpdateOne) SetNillableSuspended(b *bool) *ConversationUpdateOne {
+	if b != nil {
+		cuo.SetSuspended(*b)
+	}
+	return cuo
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (cuo *ConversationUpdateOne) SetUpdatedAt(t time.Time) *ConversationUpdateOne {
+	cuo.mutation.SetUpdatedAt(t)
+	return cuo
+}
+
+// SetNillableUpdatedAt sets the "updatedAt" field if the given value is not nil.
+func (cuo *ConversationUpdateOne) SetNillableUpdatedAt(t *time.Time) *ConversationUpdateOne {
+	if t != nil {
+		cuo.SetUpdatedAt(*t)
+	}
+	return cuo
+}
+
+// Mutation returns the ConversationMutation object of the builder.
+func (cuo *ConversationUpdateOne) Mutation() *ConversationMutation {
+	return cuo.mutation
+}
+
+// Where appends a list predicates to the ConversationUpdate builder.
+func (cuo *ConversationUpdateOne) Where(ps ...predicate.Conversation) *ConversationUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *ConversationUpdateOne) Select(field string, fields ...string) *ConversationUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Conversation entity.
+func (cuo *ConversationUpdateOne) Save(ctx context.Context) (*Conversation, error) {
+	return withHooks[*Conversation, ConversationMutation](ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *ConversationUpdateOne) SaveX(ctx context.Context) *Conversation {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *ConversationUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *ConversationUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cuo *ConversationUpdateOne) sqlSave(ctx context.Context) (_node *Conversation, err error) {
+	_spec := sqlgraph.NewUpdateSpec(conversation.Table, conversation.Columns, sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeInt))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Conversation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, conversation.FieldID)
+		for _, f := range fields {
+			if !conversation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != conversation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.ChatId(); ok {
+		_spec.SetField(conversation.FieldChatId, field.TypeInt64, value)
+	}
+	if value, ok := cuo.mutation.AddedChatId(); ok {
+		_spec.AddField(conversation.FieldChatId, field.TypeInt64, value)
+	}
+	if value, ok := cuo.mutation.UserId(); ok {
+		_spec.SetField(conversation.FieldUserId, field.TypeInt64, value)
+	}
+	if value, ok := cuo.mutation.AddedUserId(); ok {
+		_spec.AddField(conversation.FieldUserId, field.TypeInt64, value)
+	}
+	if value, ok := cuo.mutation.Dialog(); ok {
+		_spec.SetField(conversation.FieldDialog, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.State(); ok {
+		_spec.SetField(conversation.FieldState, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Values(); ok {
+		_spec.SetField(conversation.FieldValues, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.History(); ok {
+		_spec.SetField(conversation.FieldHistory, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.AppendedHistory(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, conversation.FieldHistory, value)
+		})
+	}
+	if cuo.mutation.HistoryCleared() {
+		_spec.ClearField(conversation.FieldHistory, field.TypeJSON)
+	}
+	if value, ok := cuo.mutation.Suspended(); ok {
+		_spec.SetField(conversation.FieldSuspended, field.TypeBool, value)
+	}
+	if value, ok := cuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(conversation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Conversation{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{conversation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
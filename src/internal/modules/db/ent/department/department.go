@@ -0,0 +1,154 @@
+// Code generated by ent, DO NOT EDIT.
+
+package department
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the department type in the database.
+	Label = "department"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOrganizationId holds the string denoting the organizationid field in the database.
+	FieldOrganizationId = "organization_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// EdgeRooms holds the string denoting the rooms edge name in mutations.
+	EdgeRooms = "rooms"
+	// EdgeDoctors holds the string denoting the doctors edge name in mutations.
+	EdgeDoctors = "doctors"
+	// Table holds the table name of the department in the database.
+	Table = "departments"
+	// OrganizationTable is the table that holds the organization relation/edge.
+	OrganizationTable = "departments"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+	// RoomsTable is the table that holds the rooms relation/edge.
+	RoomsTable = "rooms"
+	// RoomsInverseTable is the table name for the Room entity.
+	// It exists in this package in order to avoid circular dependency with the "room" package.
+	RoomsInverseTable = "rooms"
+	// RoomsColumn is the table column denoting the rooms relation/edge.
+	RoomsColumn = "department_id"
+	// DoctorsTable is the table that holds the doctors relation/edge. The primary key declared below.
+	DoctorsTable = "department_doctor"
+	// DoctorsInverseTable is the table name for the Doctor entity.
+	// It exists in this package in order to avoid circular dependency with the "doctor" package.
+	DoctorsInverseTable = "doctors"
+)
+
+// Columns holds all SQL columns for department fields.
+var Columns = []string{
+	FieldID,
+	FieldOrganizationId,
+	FieldName,
+}
+
+var (
+	// DoctorsPrimaryKey and DoctorsColumn2 are the table columns denoting the
+	// primary key for the doctors relation (M2M).
+	DoctorsPrimaryKey = []string{"department_id", "doctor_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "hospital/internal/modules/db/ent/runtime"
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+)
+
+// Order defines the ordering method for the Department queries.
+type Order func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByOrganizationId orders the results by the organizationId field.
+func ByOrganizationId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldOrganizationId, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByOrganizationField orders the results by organization field.
+func ByOrganizationField(field string, opts ...sql.OrderTermOption) Order {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrganizationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRoomsCount orders the results by rooms count.
+func ByRoomsCount(opts ...sql.OrderTermOption) Order {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRoomsStep(), opts...)
+	}
+}
+
+// ByRooms orders the results by rooms terms.
+func ByRooms(term sql.OrderTerm, terms ...sql.OrderTerm) Order {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRoomsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDoctorsCount orders the results by doctors count.
+func ByDoctorsCount(opts ...sql.OrderTermOption) Order {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDoctorsStep(), opts...)
+	}
+}
+
+// ByDoctors orders the results by doctors terms.
+func ByDoctors(term sql.OrderTerm, terms ...sql.OrderTerm) Order {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDoctorsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newOrganizationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrganizationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+	)
+}
+func newRoomsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RoomsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RoomsTable, RoomsColumn),
+	)
+}
+func newDoctorsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DoctorsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, DoctorsTable, DoctorsPrimaryKey...),
+	)
+}
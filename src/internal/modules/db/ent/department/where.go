@@ -0,0 +1,261 @@
+// Code generated by ent, DO NOT EDIT.
+
+package department
+
+import (
+	"hospital/internal/modules/db/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Department {
+	return predicate.Department(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Department {
+	return predicate.Department(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Department {
+	return predicate.Department(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Department {
+	return predicate.Department(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Department {
+	return predicate.Department(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Department {
+	return predicate.Department(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Department {
+	return predicate.Department(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Department {
+	return predicate.Department(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Department {
+	return predicate.Department(sql.FieldLTE(FieldID, id))
+}
+
+// OrganizationId applies equality check predicate on the "organizationId" field. It's identical to OrganizationIdEQ.
+func OrganizationId(v int) predicate.Department {
+	return predicate.Department(sql.FieldEQ(FieldOrganizationId, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Department {
+	return predicate.Department(sql.FieldEQ(FieldName, v))
+}
+
+// OrganizationIdEQ applies the EQ predicate on the "organizationId" field.
+func OrganizationIdEQ(v int) predicate.Department {
+	return predicate.Department(sql.FieldEQ(FieldOrganizationId, v))
+}
+
+// OrganizationIdNEQ applies the NEQ predicate on the "organizationId" field.
+func OrganizationIdNEQ(v int) predicate.Department {
+	return predicate.Department(sql.FieldNEQ(FieldOrganizationId, v))
+}
+
+// OrganizationIdIn applies the In predicate on the "organizationId" field.
+func OrganizationIdIn(vs ...int) predicate.Department {
+	return predicate.Department(sql.FieldIn(FieldOrganizationId, vs...))
+}
+
+// OrganizationIdNotIn applies the NotIn predicate on the "organizationId" field.
+func OrganizationIdNotIn(vs ...int) predicate.Department {
+	return predicate.Department(sql.FieldNotIn(FieldOrganizationId, vs...))
+}
+
+// OrganizationIdIsNil applies the IsNil predicate on the "organizationId" field.
+func OrganizationIdIsNil() predicate.Department {
+	return predicate.Department(sql.FieldIsNull(FieldOrganizationId))
+}
+
+// OrganizationIdNotNil applies the NotNil predicate on the "organizationId" field.
+func OrganizationIdNotNil() predicate.Department {
+	return predicate.Department(sql.FieldNotNull(FieldOrganizationId))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Department {
+	return predicate.Department(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Department {
+	return predicate.Department(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Department {
+	return predicate.Department(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Department {
+	return predicate.Department(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Department {
+	return predicate.Department(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Department {
+	return predicate.Department(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Department {
+	return predicate.Department(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Department {
+	return predicate.Department(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Department {
+	return predicate.Department(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Department {
+	return predicate.Department(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Department {
+	return predicate.Department(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Department {
+	return predicate.Department(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Department {
+	return predicate.Department(sql.FieldContainsFold(FieldName, v))
+}
+
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.Department {
+	return predicate.Department(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.Department {
+	return predicate.Department(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRooms applies the HasEdge predicate on the "rooms" edge.
+func HasRooms() predicate.Department {
+	return predicate.Department(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RoomsTable, RoomsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRoomsWith applies the HasEdge predicate on the "rooms" edge with a given conditions (other predicates).
+func HasRoomsWith(preds ...predicate.Room) predicate.Department {
+	return predicate.Department(func(s *sql.Selector) {
+		step := newRoomsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDoctors applies the HasEdge predicate on the "doctors" edge.
+func HasDoctors() predicate.Department {
+	return predicate.Department(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, DoctorsTable, DoctorsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDoctorsWith applies the HasEdge predicate on the "doctors" edge with a given conditions (other predicates).
+func HasDoctorsWith(preds ...predicate.Doctor) predicate.Department {
+	return predicate.Department(func(s *sql.Selector) {
+		step := newDoctorsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Department) predicate.Department {
+	return predicate.Department(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Department) predicate.Department {
+	return predicate.Department(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Department) predicate.Department {
+	return predicate.Department(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,294 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hospital/internal/modules/db/ent/department"
+	"hospital/internal/modules/db/ent/doctor"
+	"hospital/internal/modules/db/ent/organization"
+	"hospital/internal/modules/db/ent/room"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DepartmentCreate is the builder for creating a Department entity.
+type DepartmentCreate struct {
+	config
+	mutation *DepartmentMutation
+	hooks    []Hook
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (dc *DepartmentCreate) SetOrganizationId(i int) *DepartmentCreate {
+	dc.mutation.SetOrganizationId(i)
+	return dc
+}
+
+// SetNillableOrganizationId sets the "organizationId" field if the given value is not nil.
+func (dc *DepartmentCreate) SetNillableOrganizationId(i *int) *DepartmentCreate {
+	if i != nil {
+		dc.SetOrganizationId(*i)
+	}
+	return dc
+}
+
+// SetName sets the "name" field.
+func (dc *DepartmentCreate) SetName(s string) *DepartmentCreate {
+	dc.mutation.SetName(s)
+	return dc
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (dc *DepartmentCreate) SetOrganizationID(id int) *DepartmentCreate {
+	dc.mutation.SetOrganizationID(id)
+	return dc
+}
+
+// SetNillableOrganizationID sets the "organization" edge to the Organization entity by ID if the given value is not nil.
+func (dc *DepartmentCreate) SetNillableOrganizationID(id *int) *DepartmentCreate {
+	if id != nil {
+		dc = dc.SetOrganizationID(*id)
+	}
+	return dc
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (dc *DepartmentCreate) SetOrganization(o *Organization) *DepartmentCreate {
+	return dc.SetOrganizationID(o.ID)
+}
+
+// AddRoomIDs adds the "rooms" edge to the Room entity by IDs.
+func (dc *DepartmentCreate) AddRoomIDs(ids ...int) *DepartmentCreate {
+	dc.mutation.AddRoomIDs(ids...)
+	return dc
+}
+
+// AddRooms adds the "rooms" edges to the Room entity.
+func (dc *DepartmentCreate) AddRooms(r ...*Room) *DepartmentCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return dc.AddRoomIDs(ids...)
+}
+
+// AddDoctorIDs adds the "doctors" edge to the Doctor entity by IDs.
+func (dc *DepartmentCreate) AddDoctorIDs(ids ...int) *DepartmentCreate {
+	dc.mutation.AddDoctorIDs(ids...)
+	return dc
+}
+
+// AddDoctors adds the "doctors" edges to the Doctor entity.
+func (dc *DepartmentCreate) AddDoctors(d ...*Doctor) *DepartmentCreate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return dc.AddDoctorIDs(ids...)
+}
+
+// Mutation returns the DepartmentMutation object of the builder.
+func (dc *DepartmentCreate) Mutation() *DepartmentMutation {
+	return dc.mutation
+}
+
+// Save creates the Department in the database.
+func (dc *DepartmentCreate) Save(ctx context.Context) (*Department, error) {
+	return withHooks[*Department, DepartmentMutation](ctx, dc.sqlSave, dc.mutation, dc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dc *DepartmentCreate) SaveX(ctx context.Context) *Department {
+	v, err := dc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dc *DepartmentCreate) Exec(ctx context.Context) error {
+	_, err := dc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dc *DepartmentCreate) ExecX(ctx context.Context) {
+	if err := dc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dc *DepartmentCreate) check() error {
+	if _, ok := dc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Department.name"`)}
+	}
+	return nil
+}
+
+func (dc *DepartmentCreate) sqlSave(ctx context.Context) (*Department, error) {
+	if err := dc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	dc.mutation.id = &_node.ID
+	dc.mutation.done = true
+	return _node, nil
+}
+
+func (dc *DepartmentCreate) createSpec() (*Department, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Department{config: dc.config}
+		_spec = sqlgraph.NewCreateSpec(department.Table, sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt))
+	)
+	if value, ok := dc.mutation.Name(); ok {
+		_spec.SetField(department.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if nodes := dc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   department.OrganizationTable,
+			Columns: []string{department.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationId = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.RoomsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   department.RoomsTable,
+			Columns: []string{department.RoomsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.DoctorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   department.DoctorsTable,
+			Columns: department.DoctorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DepartmentCreateBulk is the builder for creating many Department entities in bulk.
+type DepartmentCreateBulk struct {
+	config
+	builders []*DepartmentCreate
+}
+
+// Save creates the Department entities in the database.
+func (dcb *DepartmentCreateBulk) Save(ctx context.Context) ([]*Department, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(dcb.builders))
+	nodes := make([]*Department, len(dcb.builders))
+	mutators := make([]Mutator, len(dcb.builders))
+	for i := range dcb.builders {
+		func(i int, root context.Context) {
+			builder := dcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DepartmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dcb *DepartmentCreateBulk) SaveX(ctx context.Context) []*Department {
+	v, err := dcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dcb *DepartmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := dcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcb *DepartmentCreateBulk) ExecX(ctx context.Context) {
+	if err := dcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
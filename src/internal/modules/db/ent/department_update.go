@@ -0,0 +1,519 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hospital/internal/modules/db/ent/department"
+	"hospital/internal/modules/db/ent/doctor"
+	"hospital/internal/modules/db/ent/predicate"
+	"hospital/internal/modules/db/ent/room"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DepartmentUpdate is the builder for updating Department entities.
+type DepartmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DepartmentMutation
+}
+
+// Where appends a list predicates to the DepartmentUpdate builder.
+func (du *DepartmentUpdate) Where(ps ...predicate.Department) *DepartmentUpdate {
+	du.mutation.Where(ps...)
+	return du
+}
+
+// SetName sets the "name" field.
+func (du *DepartmentUpdate) SetName(s string) *DepartmentUpdate {
+	du.mutation.SetName(s)
+	return du
+}
+
+// AddRoomIDs adds the "rooms" edge to the Room entity by IDs.
+func (du *DepartmentUpdate) AddRoomIDs(ids ...int) *DepartmentUpdate {
+	du.mutation.AddRoomIDs(ids...)
+	return du
+}
+
+// AddRooms adds the "rooms" edges to the Room entity.
+func (du *DepartmentUpdate) AddRooms(r ...*Room) *DepartmentUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return du.AddRoomIDs(ids...)
+}
+
+// AddDoctorIDs adds the "doctors" edge to the Doctor entity by IDs.
+func (du *DepartmentUpdate) AddDoctorIDs(ids ...int) *DepartmentUpdate {
+	du.mutation.AddDoctorIDs(ids...)
+	return du
+}
+
+// AddDoctors adds the "doctors" edges to the Doctor entity.
+func (du *DepartmentUpdate) AddDoctors(d ...*Doctor) *DepartmentUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return du.AddDoctorIDs(ids...)
+}
+
+// Mutation returns the DepartmentMutation object of the builder.
+func (du *DepartmentUpdate) Mutation() *DepartmentMutation {
+	return du.mutation
+}
+
+// ClearRooms clears all "rooms" edges to the Room entity.
+func (du *DepartmentUpdate) ClearRooms() *DepartmentUpdate {
+	du.mutation.ClearRooms()
+	return du
+}
+
+// RemoveRoomIDs removes the "rooms" edge to Room entities by IDs.
+func (du *DepartmentUpdate) RemoveRoomIDs(ids ...int) *DepartmentUpdate {
+	du.mutation.RemoveRoomIDs(ids...)
+	return du
+}
+
+// RemoveRooms removes "rooms" edges to Room entities.
+func (du *DepartmentUpdate) RemoveRooms(r ...*Room) *DepartmentUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return du.RemoveRoomIDs(ids...)
+}
+
+// ClearDoctors clears all "doctors" edges to the Doctor entity.
+func (du *DepartmentUpdate) ClearDoctors() *DepartmentUpdate {
+	du.mutation.ClearDoctors()
+	return du
+}
+
+// RemoveDoctorIDs removes the "doctors" edge to Doctor entities by IDs.
+func (du *DepartmentUpdate) RemoveDoctorIDs(ids ...int) *DepartmentUpdate {
+	du.mutation.RemoveDoctorIDs(ids...)
+	return du
+}
+
+// RemoveDoctors removes "doctors" edges to Doctor entities.
+func (du *DepartmentUpdate) RemoveDoctors(d ...*Doctor) *DepartmentUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return du.RemoveDoctorIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (du *DepartmentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks[int, DepartmentMutation](ctx, du.sqlSave, du.mutation, du.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (du *DepartmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := du.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (du *DepartmentUpdate) Exec(ctx context.Context) error {
+	_, err := du.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (du *DepartmentUpdate) ExecX(ctx context.Context) {
+	if err := du.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (du *DepartmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(department.Table, department.Columns, sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt))
+	if ps := du.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := du.mutation.Name(); ok {
+		_spec.SetField(department.FieldName, field.TypeString, value)
+	}
+	if du.mutation.RoomsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   department.RoomsTable,
+			Columns: []string{department.RoomsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RemovedRoomsIDs(); len(nodes) > 0 && !du.mutation.RoomsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   department.RoomsTable,
+			Columns: []string{department.RoomsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RoomsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   department.RoomsTable,
+			Columns: []string{department.RoomsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if du.mutation.DoctorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   department.DoctorsTable,
+			Columns: department.DoctorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RemovedDoctorsIDs(); len(nodes) > 0 && !du.mutation.DoctorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   department.DoctorsTable,
+			Columns: department.DoctorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.DoctorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   department.DoctorsTable,
+			Columns: department.DoctorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{department.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	du.mutation.done = true
+	return n, nil
+}
+
+// DepartmentUpdateOne is the builder for updating a single Department entity.
+type DepartmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DepartmentMutation
+}
+
+// SetName sets the "name" field.
+func (duo *DepartmentUpdateOne) SetName(s string) *DepartmentUpdateOne {
+	duo.mutation.SetName(s)
+	return duo
+}
+
+// AddRoomIDs adds the "rooms" edge to the Room entity by IDs.
+func (duo *DepartmentUpdateOne) AddRoomIDs(ids ...int) *DepartmentUpdateOne {
+	duo.mutation.AddRoomIDs(ids...)
+	return duo
+}
+
+// AddRooms adds the "rooms" edges to the Room entity.
+func (duo *DepartmentUpdateOne) AddRooms(r ...*Room) *DepartmentUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return duo.AddRoomIDs(ids...)
+}
+
+// AddDoctorIDs adds the "doctors" edge to the Doctor entity by IDs.
+func (duo *DepartmentUpdateOne) AddDoctorIDs(ids ...int) *DepartmentUpdateOne {
+	duo.mutation.AddDoctorIDs(ids...)
+	return duo
+}
+
+// AddDoctors adds the "doctors" edges to the Doctor entity.
+func (duo *DepartmentUpdateOne) AddDoctors(d ...*Doctor) *DepartmentUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return duo.AddDoctorIDs(ids...)
+}
+
+// Mutation returns the DepartmentMutation object of the builder.
+func (duo *DepartmentUpdateOne) Mutation() *DepartmentMutation {
+	return duo.mutation
+}
+
+// ClearRooms clears all "rooms" edges to the Room entity.
+func (duo *DepartmentUpdateOne) ClearRooms() *DepartmentUpdateOne {
+	duo.mutation.ClearRooms()
+	return duo
+}
+
+// RemoveRoomIDs removes the "rooms" edge to Room entities by IDs.
+func (duo *DepartmentUpdateOne) RemoveRoomIDs(ids ...int) *DepartmentUpdateOne {
+	duo.mutation.RemoveRoomIDs(ids...)
+	return duo
+}
+
+// RemoveRooms removes "rooms" edges to Room entities.
+func (duo *DepartmentUpdateOne) RemoveRooms(r ...*Room) *DepartmentUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return duo.RemoveRoomIDs(ids...)
+}
+
+// ClearDoctors clears all "doctors" edges to the Doctor entity.
+func (duo *DepartmentUpdateOne) ClearDoctors() *DepartmentUpdateOne {
+	duo.mutation.ClearDoctors()
+	return duo
+}
+
+// RemoveDoctorIDs removes the "doctors" edge to Doctor entities by IDs.
+func (duo *DepartmentUpdateOne) RemoveDoctorIDs(ids ...int) *DepartmentUpdateOne {
+	duo.mutation.RemoveDoctorIDs(ids...)
+	return duo
+}
+
+// RemoveDoctors removes "doctors" edges to Doctor entities.
+func (duo *DepartmentUpdateOne) RemoveDoctors(d ...*Doctor) *DepartmentUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return duo.RemoveDoctorIDs(ids...)
+}
+
+// Where appends a list predicates to the DepartmentUpdate builder.
+func (duo *DepartmentUpdateOne) Where(ps ...predicate.Department) *DepartmentUpdateOne {
+	duo.mutation.Where(ps...)
+	return duo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (duo *DepartmentUpdateOne) Select(field string, fields ...string) *DepartmentUpdateOne {
+	duo.fields = append([]string{field}, fields...)
+	return duo
+}
+
+// Save executes the query and returns the updated Department entity.
+func (duo *DepartmentUpdateOne) Save(ctx context.Context) (*Department, error) {
+	return withHooks[*Department, DepartmentMutation](ctx, duo.sqlSave, duo.mutation, duo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (duo *DepartmentUpdateOne) SaveX(ctx context.Context) *Department {
+	node, err := duo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (duo *DepartmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := duo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (duo *DepartmentUpdateOne) ExecX(ctx context.Context) {
+	if err := duo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (duo *DepartmentUpdateOne) sqlSave(ctx context.Context) (_node *Department, err error) {
+	_spec := sqlgraph.NewUpdateSpec(department.Table, department.Columns, sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt))
+	id, ok := duo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Department.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := duo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, department.FieldID)
+		for _, f := range fields {
+			if !department.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != department.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := duo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := duo.mutation.Name(); ok {
+		_spec.SetField(department.FieldName, field.TypeString, value)
+	}
+	if duo.mutation.RoomsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   department.RoomsTable,
+			Columns: []string{department.RoomsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedRoomsIDs(); len(nodes) > 0 && !duo.mutation.RoomsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   department.RoomsTable,
+			Columns: []string{department.RoomsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RoomsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   department.RoomsTable,
+			Columns: []string{department.RoomsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.DoctorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   department.DoctorsTable,
+			Columns: department.DoctorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedDoctorsIDs(); len(nodes) > 0 && !duo.mutation.DoctorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   department.DoctorsTable,
+			Columns: department.DoctorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.DoctorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   department.DoctorsTable,
+			Columns: department.DoctorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Department{config: duo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, duo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{department.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	duo.mutation.done = true
+	return _node, nil
+}
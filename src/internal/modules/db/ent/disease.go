@@ -16,6 +16,8 @@ type Disease struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// OrganizationId holds the value of the "organizationId" field.
+	OrganizationId int `json:"organizationId,omitempty"`
 	// Threat holds the value of the "threat" field.
 	Threat string `json:"threat,omitempty"`
 	// Name holds the value of the "name" field.
@@ -51,7 +53,7 @@ func (*Disease) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case disease.FieldID, disease.FieldDegreeOfDanger:
+		case disease.FieldID, disease.FieldOrganizationId, disease.FieldDegreeOfDanger:
 			values[i] = new(sql.NullInt64)
 		case disease.FieldThreat, disease.FieldName:
 			values[i] = new(sql.NullString)
@@ -76,6 +78,12 @@ func (d *Disease) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			d.ID = int(value.Int64)
+		case disease.FieldOrganizationId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field organizationId", values[i])
+			} else if value.Valid {
+				d.OrganizationId = int(value.Int64)
+			}
 		case disease.FieldThreat:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field threat", values[i])
@@ -135,6 +143,9 @@ func (d *Disease) String() string {
 	var builder strings.Builder
 	builder.WriteString("Disease(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", d.ID))
+	builder.WriteString("organizationId=")
+	builder.WriteString(fmt.Sprintf("%v", d.OrganizationId))
+	builder.WriteString(", ")
 	builder.WriteString("threat=")
 	builder.WriteString(d.Threat)
 	builder.WriteString(", ")
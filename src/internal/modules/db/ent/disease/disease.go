@@ -3,6 +3,7 @@
 package disease
 
 import (
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -12,6 +13,8 @@ const (
 	Label = "disease"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldOrganizationId holds the string denoting the organizationid field in the database.
+	FieldOrganizationId = "organization_id"
 	// FieldThreat holds the string denoting the threat field in the database.
 	FieldThreat = "threat"
 	// FieldName holds the string denoting the name field in the database.
@@ -34,6 +37,7 @@ const (
 // Columns holds all SQL columns for disease fields.
 var Columns = []string{
 	FieldID,
+	FieldOrganizationId,
 	FieldThreat,
 	FieldName,
 	FieldDegreeOfDanger,
@@ -49,6 +53,16 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "hospital/internal/modules/db/ent/runtime"
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+)
+
 // Order defines the ordering method for the Disease queries.
 type Order func(*sql.Selector)
 
@@ -57,6 +71,11 @@ func ByID(opts ...sql.OrderTermOption) Order {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByOrganizationId orders the results by the organizationId field.
+func ByOrganizationId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldOrganizationId, opts...).ToFunc()
+}
+
 // ByThreat orders the results by the threat field.
 func ByThreat(opts ...sql.OrderTermOption) Order {
 	return sql.OrderByField(FieldThreat, opts...).ToFunc()
@@ -54,6 +54,11 @@ func IDLTE(id int) predicate.Disease {
 	return predicate.Disease(sql.FieldLTE(FieldID, id))
 }
 
+// OrganizationId applies equality check predicate on the "organizationId" field. It's identical to OrganizationIdEQ.
+func OrganizationId(v int) predicate.Disease {
+	return predicate.Disease(sql.FieldEQ(FieldOrganizationId, v))
+}
+
 // Threat applies equality check predicate on the "threat" field. It's identical to ThreatEQ.
 func Threat(v string) predicate.Disease {
 	return predicate.Disease(sql.FieldEQ(FieldThreat, v))
@@ -69,6 +74,56 @@ func DegreeOfDanger(v int) predicate.Disease {
 	return predicate.Disease(sql.FieldEQ(FieldDegreeOfDanger, v))
 }
 
+// OrganizationIdEQ applies the EQ predicate on the "organizationId" field.
+func OrganizationIdEQ(v int) predicate.Disease {
+	return predicate.Disease(sql.FieldEQ(FieldOrganizationId, v))
+}
+
+// OrganizationIdNEQ applies the NEQ predicate on the "organizationId" field.
+func OrganizationIdNEQ(v int) predicate.Disease {
+	return predicate.Disease(sql.FieldNEQ(FieldOrganizationId, v))
+}
+
+// OrganizationIdIn applies the In predicate on the "organizationId" field.
+func OrganizationIdIn(vs ...int) predicate.Disease {
+	return predicate.Disease(sql.FieldIn(FieldOrganizationId, vs...))
+}
+
+// OrganizationIdNotIn applies the NotIn predicate on the "organizationId" field.
+func OrganizationIdNotIn(vs ...int) predicate.Disease {
+	return predicate.Disease(sql.FieldNotIn(FieldOrganizationId, vs...))
+}
+
+// OrganizationIdGT applies the GT predicate on the "organizationId" field.
+func OrganizationIdGT(v int) predicate.Disease {
+	return predicate.Disease(sql.FieldGT(FieldOrganizationId, v))
+}
+
+// OrganizationIdGTE applies the GTE predicate on the "organizationId" field.
+func OrganizationIdGTE(v int) predicate.Disease {
+	return predicate.Disease(sql.FieldGTE(FieldOrganizationId, v))
+}
+
+// OrganizationIdLT applies the LT predicate on the "organizationId" field.
+func OrganizationIdLT(v int) predicate.Disease {
+	return predicate.Disease(sql.FieldLT(FieldOrganizationId, v))
+}
+
+// OrganizationIdLTE applies the LTE predicate on the "organizationId" field.
+func OrganizationIdLTE(v int) predicate.Disease {
+	return predicate.Disease(sql.FieldLTE(FieldOrganizationId, v))
+}
+
+// OrganizationIdIsNil applies the IsNil predicate on the "organizationId" field.
+func OrganizationIdIsNil() predicate.Disease {
+	return predicate.Disease(sql.FieldIsNull(FieldOrganizationId))
+}
+
+// OrganizationIdNotNil applies the NotNil predicate on the "organizationId" field.
+func OrganizationIdNotNil() predicate.Disease {
+	return predicate.Disease(sql.FieldNotNull(FieldOrganizationId))
+}
+
 // ThreatEQ applies the EQ predicate on the "threat" field.
 func ThreatEQ(v string) predicate.Disease {
 	return predicate.Disease(sql.FieldEQ(FieldThreat, v))
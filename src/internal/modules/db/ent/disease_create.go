@@ -20,6 +20,20 @@ type DiseaseCreate struct {
 	hooks    []Hook
 }
 
+// SetOrganizationId sets the "organizationId" field.
+func (dc *DiseaseCreate) SetOrganizationId(i int) *DiseaseCreate {
+	dc.mutation.SetOrganizationId(i)
+	return dc
+}
+
+// SetNillableOrganizationId sets the "organizationId" field if the given value is not nil.
+func (dc *DiseaseCreate) SetNillableOrganizationId(i *int) *DiseaseCreate {
+	if i != nil {
+		dc.SetOrganizationId(*i)
+	}
+	return dc
+}
+
 // SetThreat sets the "threat" field.
 func (dc *DiseaseCreate) SetThreat(s string) *DiseaseCreate {
 	dc.mutation.SetThreat(s)
@@ -122,6 +136,10 @@ func (dc *DiseaseCreate) createSpec() (*Disease, *sqlgraph.CreateSpec) {
 		_node = &Disease{config: dc.config}
 		_spec = sqlgraph.NewCreateSpec(disease.Table, sqlgraph.NewFieldSpec(disease.FieldID, field.TypeInt))
 	)
+	if value, ok := dc.mutation.OrganizationId(); ok {
+		_spec.SetField(disease.FieldOrganizationId, field.TypeInt, value)
+		_node.OrganizationId = value
+	}
 	if value, ok := dc.mutation.Threat(); ok {
 		_spec.SetField(disease.FieldThreat, field.TypeString, value)
 		_node.Threat = value
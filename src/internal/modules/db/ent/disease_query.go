@@ -5,6 +5,7 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"hospital/internal/modules/db/ent/disease"
 	"hospital/internal/modules/db/ent/patient"
@@ -298,12 +299,12 @@ func (dq *DiseaseQuery) WithHas(opts ...func(*PatientQuery)) *DiseaseQuery {
 // Example:
 //
 //	var v []struct {
-//		Threat string `json:"threat,omitempty"`
+//		OrganizationId int `json:"organizationId,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Disease.Query().
-//		GroupBy(disease.FieldThreat).
+//		GroupBy(disease.FieldOrganizationId).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (dq *DiseaseQuery) GroupBy(field string, fields ...string) *DiseaseGroupBy {
@@ -321,11 +322,11 @@ func (dq *DiseaseQuery) GroupBy(field string, fields ...string) *DiseaseGroupBy
 // Example:
 //
 //	var v []struct {
-//		Threat string `json:"threat,omitempty"`
+//		OrganizationId int `json:"organizationId,omitempty"`
 //	}
 //
 //	client.Disease.Query().
-//		Select(disease.FieldThreat).
+//		Select(disease.FieldOrganizationId).
 //		Scan(ctx, &v)
 func (dq *DiseaseQuery) Select(fields ...string) *DiseaseSelect {
 	dq.ctx.Fields = append(dq.ctx.Fields, fields...)
@@ -363,6 +364,12 @@ func (dq *DiseaseQuery) prepareQuery(ctx context.Context) error {
 		}
 		dq.sql = prev
 	}
+	if disease.Policy == nil {
+		return errors.New("ent: uninitialized disease.Policy (forgotten import ent/runtime?)")
+	}
+	if err := disease.Policy.EvalQuery(ctx, dq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -130,6 +130,9 @@ func (du *DiseaseUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if du.mutation.OrganizationIdCleared() {
+		_spec.ClearField(disease.FieldOrganizationId, field.TypeInt)
+	}
 	if value, ok := du.mutation.Threat(); ok {
 		_spec.SetField(disease.FieldThreat, field.TypeString, value)
 	}
@@ -339,6 +342,9 @@ func (duo *DiseaseUpdateOne) sqlSave(ctx context.Context) (_node *Disease, err e
 			}
 		}
 	}
+	if duo.mutation.OrganizationIdCleared() {
+		_spec.ClearField(disease.FieldOrganizationId, field.TypeInt)
+	}
 	if value, ok := duo.mutation.Threat(); ok {
 		_spec.SetField(disease.FieldThreat, field.TypeString, value)
 	}
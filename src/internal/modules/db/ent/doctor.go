@@ -24,6 +24,10 @@ type Doctor struct {
 	Speciality string `json:"speciality,omitempty"`
 	// Role holds the value of the "role" field.
 	Role string `json:"role,omitempty"`
+	// Language holds the value of the "language" field.
+	Language string `json:"language,omitempty"`
+	// OnCall holds the value of the "onCall" field.
+	OnCall bool `json:"onCall,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DoctorQuery when eager-loading is set.
 	Edges        DoctorEdges `json:"edges"`
@@ -34,9 +38,13 @@ type Doctor struct {
 type DoctorEdges struct {
 	// Treats holds the value of the treats edge.
 	Treats []*Patient `json:"treats,omitempty"`
+	// Sessions holds the value of the sessions edge.
+	Sessions []*Session `json:"sessions,omitempty"`
+	// Departments holds the value of the departments edge.
+	Departments []*Department `json:"departments,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // TreatsOrErr returns the Treats value or an error if the edge
@@ -48,14 +56,34 @@ func (e DoctorEdges) TreatsOrErr() ([]*Patient, error) {
 	return nil, &NotLoadedError{edge: "treats"}
 }
 
+// SessionsOrErr returns the Sessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e DoctorEdges) SessionsOrErr() ([]*Session, error) {
+	if e.loadedTypes[1] {
+		return e.Sessions, nil
+	}
+	return nil, &NotLoadedError{edge: "sessions"}
+}
+
+// DepartmentsOrErr returns the Departments value or an error if the edge
+// was not loaded in eager-loading.
+func (e DoctorEdges) DepartmentsOrErr() ([]*Department, error) {
+	if e.loadedTypes[2] {
+		return e.Departments, nil
+	}
+	return nil, &NotLoadedError{edge: "departments"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Doctor) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case doctor.FieldOnCall:
+			values[i] = new(sql.NullBool)
 		case doctor.FieldID:
 			values[i] = new(sql.NullInt64)
-		case doctor.FieldTokenId, doctor.FieldSurname, doctor.FieldSpeciality, doctor.FieldRole:
+		case doctor.FieldTokenId, doctor.FieldSurname, doctor.FieldSpeciality, doctor.FieldRole, doctor.FieldLanguage:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -102,6 +130,18 @@ func (d *Doctor) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				d.Role = value.String
 			}
+		case doctor.FieldLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field language", values[i])
+			} else if value.Valid {
+				d.Language = value.String
+			}
+		case doctor.FieldOnCall:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field onCall", values[i])
+			} else if value.Valid {
+				d.OnCall = value.Bool
+			}
 		default:
 			d.selectValues.Set(columns[i], values[i])
 		}
@@ -120,6 +160,16 @@ func (d *Doctor) QueryTreats() *PatientQuery {
 	return NewDoctorClient(d.config).QueryTreats(d)
 }
 
+// QuerySessions queries the "sessions" edge of the Doctor entity.
+func (d *Doctor) QuerySessions() *SessionQuery {
+	return NewDoctorClient(d.config).QuerySessions(d)
+}
+
+// QueryDepartments queries the "departments" edge of the Doctor entity.
+func (d *Doctor) QueryDepartments() *DepartmentQuery {
+	return NewDoctorClient(d.config).QueryDepartments(d)
+}
+
 // Update returns a builder for updating this Doctor.
 // Note that you need to call Doctor.Unwrap() before calling this method if this Doctor
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -154,6 +204,12 @@ func (d *Doctor) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("role=")
 	builder.WriteString(d.Role)
+	builder.WriteString(", ")
+	builder.WriteString("language=")
+	builder.WriteString(d.Language)
+	builder.WriteString(", ")
+	builder.WriteString("onCall=")
+	builder.WriteString(fmt.Sprintf("%v", d.OnCall))
 	builder.WriteByte(')')
 	return builder.String()
 }
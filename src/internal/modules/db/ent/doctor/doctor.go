@@ -3,6 +3,7 @@
 package doctor
 
 import (
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -20,8 +21,16 @@ const (
 	FieldSpeciality = "speciality"
 	// FieldRole holds the string denoting the role field in the database.
 	FieldRole = "role"
+	// FieldLanguage holds the string denoting the language field in the database.
+	FieldLanguage = "language"
+	// FieldOnCall holds the string denoting the oncall field in the database.
+	FieldOnCall = "on_call"
 	// EdgeTreats holds the string denoting the treats edge name in mutations.
 	EdgeTreats = "treats"
+	// EdgeSessions holds the string denoting the sessions edge name in mutations.
+	EdgeSessions = "sessions"
+	// EdgeDepartments holds the string denoting the departments edge name in mutations.
+	EdgeDepartments = "departments"
 	// Table holds the table name of the doctor in the database.
 	Table = "doctors"
 	// TreatsTable is the table that holds the treats relation/edge. The primary key declared below.
@@ -29,6 +38,18 @@ const (
 	// TreatsInverseTable is the table name for the Patient entity.
 	// It exists in this package in order to avoid circular dependency with the "patient" package.
 	TreatsInverseTable = "patients"
+	// SessionsTable is the table that holds the sessions relation/edge.
+	SessionsTable = "sessions"
+	// SessionsInverseTable is the table name for the Session entity.
+	// It exists in this package in order to avoid circular dependency with the "session" package.
+	SessionsInverseTable = "sessions"
+	// SessionsColumn is the table column denoting the sessions relation/edge.
+	SessionsColumn = "doctor_id"
+	// DepartmentsTable is the table that holds the departments relation/edge. The primary key declared below.
+	DepartmentsTable = "department_doctor"
+	// DepartmentsInverseTable is the table name for the Department entity.
+	// It exists in this package in order to avoid circular dependency with the "department" package.
+	DepartmentsInverseTable = "departments"
 )
 
 // Columns holds all SQL columns for doctor fields.
@@ -38,12 +59,17 @@ var Columns = []string{
 	FieldSurname,
 	FieldSpeciality,
 	FieldRole,
+	FieldLanguage,
+	FieldOnCall,
 }
 
 var (
 	// TreatsPrimaryKey and TreatsColumn2 are the table columns denoting the
 	// primary key for the treats relation (M2M).
 	TreatsPrimaryKey = []string{"doctor_id", "patient_id"}
+	// DepartmentsPrimaryKey and DepartmentsColumn2 are the table columns denoting the
+	// primary key for the departments relation (M2M).
+	DepartmentsPrimaryKey = []string{"department_id", "doctor_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -56,6 +82,18 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "hospital/internal/modules/db/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultOnCall holds the default value on creation for the "onCall" field.
+	DefaultOnCall bool
+)
+
 // Order defines the ordering method for the Doctor queries.
 type Order func(*sql.Selector)
 
@@ -84,6 +122,16 @@ func ByRole(opts ...sql.OrderTermOption) Order {
 	return sql.OrderByField(FieldRole, opts...).ToFunc()
 }
 
+// ByLanguage orders the results by the language field.
+func ByLanguage(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldLanguage, opts...).ToFunc()
+}
+
+// ByOnCall orders the results by the onCall field.
+func ByOnCall(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldOnCall, opts...).ToFunc()
+}
+
 // ByTreatsCount orders the results by treats count.
 func ByTreatsCount(opts ...sql.OrderTermOption) Order {
 	return func(s *sql.Selector) {
@@ -97,6 +145,34 @@ func ByTreats(term sql.OrderTerm, terms ...sql.OrderTerm) Order {
 		sqlgraph.OrderByNeighborTerms(s, newTreatsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// BySessionsCount orders the results by sessions count.
+func BySessionsCount(opts ...sql.OrderTermOption) Order {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSessionsStep(), opts...)
+	}
+}
+
+// BySessions orders the results by sessions terms.
+func BySessions(term sql.OrderTerm, terms ...sql.OrderTerm) Order {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDepartmentsCount orders the results by departments count.
+func ByDepartmentsCount(opts ...sql.OrderTermOption) Order {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDepartmentsStep(), opts...)
+	}
+}
+
+// ByDepartments orders the results by departments terms.
+func ByDepartments(term sql.OrderTerm, terms ...sql.OrderTerm) Order {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDepartmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTreatsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -104,3 +180,17 @@ func newTreatsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, false, TreatsTable, TreatsPrimaryKey...),
 	)
 }
+func newSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SessionsTable, SessionsColumn),
+	)
+}
+func newDepartmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DepartmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, DepartmentsTable, DepartmentsPrimaryKey...),
+	)
+}
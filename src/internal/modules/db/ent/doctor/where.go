@@ -74,6 +74,16 @@ func Role(v string) predicate.Doctor {
 	return predicate.Doctor(sql.FieldEQ(FieldRole, v))
 }
 
+// Language applies equality check predicate on the "language" field. It's identical to LanguageEQ.
+func Language(v string) predicate.Doctor {
+	return predicate.Doctor(sql.FieldEQ(FieldLanguage, v))
+}
+
+// OnCall applies equality check predicate on the "onCall" field. It's identical to OnCallEQ.
+func OnCall(v bool) predicate.Doctor {
+	return predicate.Doctor(sql.FieldEQ(FieldOnCall, v))
+}
+
 // TokenIdEQ applies the EQ predicate on the "tokenId" field.
 func TokenIdEQ(v string) predicate.Doctor {
 	return predicate.Doctor(sql.FieldEQ(FieldTokenId, v))
@@ -334,6 +344,91 @@ func RoleContainsFold(v string) predicate.Doctor {
 	return predicate.Doctor(sql.FieldContainsFold(FieldRole, v))
 }
 
+// LanguageEQ applies the EQ predicate on the "language" field.
+func LanguageEQ(v string) predicate.Doctor {
+	return predicate.Doctor(sql.FieldEQ(FieldLanguage, v))
+}
+
+// LanguageNEQ applies the NEQ predicate on the "language" field.
+func LanguageNEQ(v string) predicate.Doctor {
+	return predicate.Doctor(sql.FieldNEQ(FieldLanguage, v))
+}
+
+// LanguageIn applies the In predicate on the "language" field.
+func LanguageIn(vs ...string) predicate.Doctor {
+	return predicate.Doctor(sql.FieldIn(FieldLanguage, vs...))
+}
+
+// LanguageNotIn applies the NotIn predicate on the "language" field.
+func LanguageNotIn(vs ...string) predicate.Doctor {
+	return predicate.Doctor(sql.FieldNotIn(FieldLanguage, vs...))
+}
+
+// LanguageGT applies the GT predicate on the "language" field.
+func LanguageGT(v string) predicate.Doctor {
+	return predicate.Doctor(sql.FieldGT(FieldLanguage, v))
+}
+
+// LanguageGTE applies the GTE predicate on the "language" field.
+func LanguageGTE(v string) predicate.Doctor {
+	return predicate.Doctor(sql.FieldGTE(FieldLanguage, v))
+}
+
+// LanguageLT applies the LT predicate on the "language" field.
+func LanguageLT(v string) predicate.Doctor {
+	return predicate.Doctor(sql.FieldLT(FieldLanguage, v))
+}
+
+// LanguageLTE applies the LTE predicate on the "language" field.
+func LanguageLTE(v string) predicate.Doctor {
+	return predicate.Doctor(sql.FieldLTE(FieldLanguage, v))
+}
+
+// LanguageContains applies the Contains predicate on the "language" field.
+func LanguageContains(v string) predicate.Doctor {
+	return predicate.Doctor(sql.FieldContains(FieldLanguage, v))
+}
+
+// LanguageHasPrefix applies the HasPrefix predicate on the "language" field.
+func LanguageHasPrefix(v string) predicate.Doctor {
+	return predicate.Doctor(sql.FieldHasPrefix(FieldLanguage, v))
+}
+
+// LanguageHasSuffix applies the HasSuffix predicate on the "language" field.
+func LanguageHasSuffix(v string) predicate.Doctor {
+	return predicate.Doctor(sql.FieldHasSuffix(FieldLanguage, v))
+}
+
+// LanguageIsNil applies the IsNil predicate on the "language" field.
+func LanguageIsNil() predicate.Doctor {
+	return predicate.Doctor(sql.FieldIsNull(FieldLanguage))
+}
+
+// LanguageNotNil applies the NotNil predicate on the "language" field.
+func LanguageNotNil() predicate.Doctor {
+	return predicate.Doctor(sql.FieldNotNull(FieldLanguage))
+}
+
+// LanguageEqualFold applies the EqualFold predicate on the "language" field.
+func LanguageEqualFold(v string) predicate.Doctor {
+	return predicate.Doctor(sql.FieldEqualFold(FieldLanguage, v))
+}
+
+// LanguageContainsFold applies the ContainsFold predicate on the "language" field.
+func LanguageContainsFold(v string) predicate.Doctor {
+	return predicate.Doctor(sql.FieldContainsFold(FieldLanguage, v))
+}
+
+// OnCallEQ applies the EQ predicate on the "onCall" field.
+func OnCallEQ(v bool) predicate.Doctor {
+	return predicate.Doctor(sql.FieldEQ(FieldOnCall, v))
+}
+
+// OnCallNEQ applies the NEQ predicate on the "onCall" field.
+func OnCallNEQ(v bool) predicate.Doctor {
+	return predicate.Doctor(sql.FieldNEQ(FieldOnCall, v))
+}
+
 // HasTreats applies the HasEdge predicate on the "treats" edge.
 func HasTreats() predicate.Doctor {
 	return predicate.Doctor(func(s *sql.Selector) {
@@ -357,6 +452,52 @@ func HasTreatsWith(preds ...predicate.Patient) predicate.Doctor {
 	})
 }
 
+// HasSessions applies the HasEdge predicate on the "sessions" edge.
+func HasSessions() predicate.Doctor {
+	return predicate.Doctor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SessionsTable, SessionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSessionsWith applies the HasEdge predicate on the "sessions" edge with a given conditions (other predicates).
+func HasSessionsWith(preds ...predicate.Session) predicate.Doctor {
+	return predicate.Doctor(func(s *sql.Selector) {
+		step := newSessionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDepartments applies the HasEdge predicate on the "departments" edge.
+func HasDepartments() predicate.Doctor {
+	return predicate.Doctor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, DepartmentsTable, DepartmentsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDepartmentsWith applies the HasEdge predicate on the "departments" edge with a given conditions (other predicates).
+func HasDepartmentsWith(preds ...predicate.Department) predicate.Doctor {
+	return predicate.Doctor(func(s *sql.Selector) {
+		step := newDepartmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Doctor) predicate.Doctor {
 	return predicate.Doctor(func(s *sql.Selector) {
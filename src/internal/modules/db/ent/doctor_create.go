@@ -6,8 +6,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"hospital/internal/modules/db/ent/department"
 	"hospital/internal/modules/db/ent/doctor"
 	"hospital/internal/modules/db/ent/patient"
+	"hospital/internal/modules/db/ent/session"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -44,6 +46,34 @@ func (dc *DoctorCreate) SetRole(s string) *DoctorCreate {
 	return dc
 }
 
+// SetLanguage sets the "language" field.
+func (dc *DoctorCreate) SetLanguage(s string) *DoctorCreate {
+	dc.mutation.SetLanguage(s)
+	return dc
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (dc *DoctorCreate) SetNillableLanguage(s *string) *DoctorCreate {
+	if s != nil {
+		dc.SetLanguage(*s)
+	}
+	return dc
+}
+
+// SetOnCall sets the "onCall" field.
+func (dc *DoctorCreate) SetOnCall(b bool) *DoctorCreate {
+	dc.mutation.SetOnCall(b)
+	return dc
+}
+
+// SetNillableOnCall sets the "onCall" field if the given value is not nil.
+func (dc *DoctorCreate) SetNillableOnCall(b *bool) *DoctorCreate {
+	if b != nil {
+		dc.SetOnCall(*b)
+	}
+	return dc
+}
+
 // AddTreatIDs adds the "treats" edge to the Patient entity by IDs.
 func (dc *DoctorCreate) AddTreatIDs(ids ...int) *DoctorCreate {
 	dc.mutation.AddTreatIDs(ids...)
@@ -59,6 +89,36 @@ func (dc *DoctorCreate) AddTreats(p ...*Patient) *DoctorCreate {
 	return dc.AddTreatIDs(ids...)
 }
 
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (dc *DoctorCreate) AddSessionIDs(ids ...int) *DoctorCreate {
+	dc.mutation.AddSessionIDs(ids...)
+	return dc
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (dc *DoctorCreate) AddSessions(s ...*Session) *DoctorCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return dc.AddSessionIDs(ids...)
+}
+
+// AddDepartmentIDs adds the "departments" edge to the Department entity by IDs.
+func (dc *DoctorCreate) AddDepartmentIDs(ids ...int) *DoctorCreate {
+	dc.mutation.AddDepartmentIDs(ids...)
+	return dc
+}
+
+// AddDepartments adds the "departments" edges to the Department entity.
+func (dc *DoctorCreate) AddDepartments(d ...*Department) *DoctorCreate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return dc.AddDepartmentIDs(ids...)
+}
+
 // Mutation returns the DoctorMutation object of the builder.
 func (dc *DoctorCreate) Mutation() *DoctorMutation {
 	return dc.mutation
@@ -66,6 +126,9 @@ func (dc *DoctorCreate) Mutation() *DoctorMutation {
 
 // Save creates the Doctor in the database.
 func (dc *DoctorCreate) Save(ctx context.Context) (*Doctor, error) {
+	if err := dc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks[*Doctor, DoctorMutation](ctx, dc.sqlSave, dc.mutation, dc.hooks)
 }
 
@@ -91,6 +154,15 @@ func (dc *DoctorCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (dc *DoctorCreate) defaults() error {
+	if _, ok := dc.mutation.OnCall(); !ok {
+		v := doctor.DefaultOnCall
+		dc.mutation.SetOnCall(v)
+	}
+	return nil
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (dc *DoctorCreate) check() error {
 	if _, ok := dc.mutation.TokenId(); !ok {
@@ -105,6 +177,9 @@ func (dc *DoctorCreate) check() error {
 	if _, ok := dc.mutation.Role(); !ok {
 		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "Doctor.role"`)}
 	}
+	if _, ok := dc.mutation.OnCall(); !ok {
+		return &ValidationError{Name: "onCall", err: errors.New(`ent: missing required field "Doctor.onCall"`)}
+	}
 	return nil
 }
 
@@ -147,6 +222,14 @@ func (dc *DoctorCreate) createSpec() (*Doctor, *sqlgraph.CreateSpec) {
 		_spec.SetField(doctor.FieldRole, field.TypeString, value)
 		_node.Role = value
 	}
+	if value, ok := dc.mutation.Language(); ok {
+		_spec.SetField(doctor.FieldLanguage, field.TypeString, value)
+		_node.Language = value
+	}
+	if value, ok := dc.mutation.OnCall(); ok {
+		_spec.SetField(doctor.FieldOnCall, field.TypeBool, value)
+		_node.OnCall = value
+	}
 	if nodes := dc.mutation.TreatsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -163,6 +246,38 @@ func (dc *DoctorCreate) createSpec() (*Doctor, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := dc.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.SessionsTable,
+			Columns: []string{doctor.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.DepartmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   doctor.DepartmentsTable,
+			Columns: doctor.DepartmentsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -180,6 +295,7 @@ func (dcb *DoctorCreateBulk) Save(ctx context.Context) ([]*Doctor, error) {
 	for i := range dcb.builders {
 		func(i int, root context.Context) {
 			builder := dcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*DoctorMutation)
 				if !ok {
@@ -5,10 +5,13 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
+	"hospital/internal/modules/db/ent/department"
 	"hospital/internal/modules/db/ent/doctor"
 	"hospital/internal/modules/db/ent/patient"
 	"hospital/internal/modules/db/ent/predicate"
+	"hospital/internal/modules/db/ent/session"
 	"math"
 
 	"entgo.io/ent/dialect/sql"
@@ -19,11 +22,13 @@ import (
 // DoctorQuery is the builder for querying Doctor entities.
 type DoctorQuery struct {
 	config
-	ctx        *QueryContext
-	order      []doctor.Order
-	inters     []Interceptor
-	predicates []predicate.Doctor
-	withTreats *PatientQuery
+	ctx             *QueryContext
+	order           []doctor.Order
+	inters          []Interceptor
+	predicates      []predicate.Doctor
+	withTreats      *PatientQuery
+	withSessions    *SessionQuery
+	withDepartments *DepartmentQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -82,6 +87,50 @@ func (dq *DoctorQuery) QueryTreats() *PatientQuery {
 	return query
 }
 
+// QuerySessions chains the current query on the "sessions" edge.
+func (dq *DoctorQuery) QuerySessions() *SessionQuery {
+	query := (&SessionClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(doctor.Table, doctor.FieldID, selector),
+			sqlgraph.To(session.Table, session.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, doctor.SessionsTable, doctor.SessionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDepartments chains the current query on the "departments" edge.
+func (dq *DoctorQuery) QueryDepartments() *DepartmentQuery {
+	query := (&DepartmentClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(doctor.Table, doctor.FieldID, selector),
+			sqlgraph.To(department.Table, department.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, doctor.DepartmentsTable, doctor.DepartmentsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Doctor entity from the query.
 // Returns a *NotFoundError when no Doctor was found.
 func (dq *DoctorQuery) First(ctx context.Context) (*Doctor, error) {
@@ -269,12 +318,14 @@ func (dq *DoctorQuery) Clone() *DoctorQuery {
 		return nil
 	}
 	return &DoctorQuery{
-		config:     dq.config,
-		ctx:        dq.ctx.Clone(),
-		order:      append([]doctor.Order{}, dq.order...),
-		inters:     append([]Interceptor{}, dq.inters...),
-		predicates: append([]predicate.Doctor{}, dq.predicates...),
-		withTreats: dq.withTreats.Clone(),
+		config:          dq.config,
+		ctx:             dq.ctx.Clone(),
+		order:           append([]doctor.Order{}, dq.order...),
+		inters:          append([]Interceptor{}, dq.inters...),
+		predicates:      append([]predicate.Doctor{}, dq.predicates...),
+		withTreats:      dq.withTreats.Clone(),
+		withSessions:    dq.withSessions.Clone(),
+		withDepartments: dq.withDepartments.Clone(),
 		// clone intermediate query.
 		sql:  dq.sql.Clone(),
 		path: dq.path,
@@ -292,6 +343,28 @@ func (dq *DoctorQuery) WithTreats(opts ...func(*PatientQuery)) *DoctorQuery {
 	return dq
 }
 
+// WithSessions tells the query-builder to eager-load the nodes that are connected to
+// the "sessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DoctorQuery) WithSessions(opts ...func(*SessionQuery)) *DoctorQuery {
+	query := (&SessionClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withSessions = query
+	return dq
+}
+
+// WithDepartments tells the query-builder to eager-load the nodes that are connected to
+// the "departments" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DoctorQuery) WithDepartments(opts ...func(*DepartmentQuery)) *DoctorQuery {
+	query := (&DepartmentClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withDepartments = query
+	return dq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -363,6 +436,12 @@ func (dq *DoctorQuery) prepareQuery(ctx context.Context) error {
 		}
 		dq.sql = prev
 	}
+	if doctor.Policy == nil {
+		return errors.New("ent: uninitialized doctor.Policy (forgotten import ent/runtime?)")
+	}
+	if err := doctor.Policy.EvalQuery(ctx, dq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -370,8 +449,10 @@ func (dq *DoctorQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Docto
 	var (
 		nodes       = []*Doctor{}
 		_spec       = dq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [3]bool{
 			dq.withTreats != nil,
+			dq.withSessions != nil,
+			dq.withDepartments != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -399,6 +480,20 @@ func (dq *DoctorQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Docto
 			return nil, err
 		}
 	}
+	if query := dq.withSessions; query != nil {
+		if err := dq.loadSessions(ctx, query, nodes,
+			func(n *Doctor) { n.Edges.Sessions = []*Session{} },
+			func(n *Doctor, e *Session) { n.Edges.Sessions = append(n.Edges.Sessions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := dq.withDepartments; query != nil {
+		if err := dq.loadDepartments(ctx, query, nodes,
+			func(n *Doctor) { n.Edges.Departments = []*Department{} },
+			func(n *Doctor, e *Department) { n.Edges.Departments = append(n.Edges.Departments, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -463,6 +558,94 @@ func (dq *DoctorQuery) loadTreats(ctx context.Context, query *PatientQuery, node
 	}
 	return nil
 }
+func (dq *DoctorQuery) loadSessions(ctx context.Context, query *SessionQuery, nodes []*Doctor, init func(*Doctor), assign func(*Doctor, *Session)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Doctor)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.Session(func(s *sql.Selector) {
+		s.Where(sql.InValues(doctor.SessionsColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DoctorId
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "doctorId" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (dq *DoctorQuery) loadDepartments(ctx context.Context, query *DepartmentQuery, nodes []*Doctor, init func(*Doctor), assign func(*Doctor, *Department)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Doctor)
+	nids := make(map[int]map[*Doctor]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(doctor.DepartmentsTable)
+		s.Join(joinT).On(s.C(department.FieldID), joinT.C(doctor.DepartmentsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(doctor.DepartmentsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(doctor.DepartmentsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Doctor]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Department](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "departments" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 
 func (dq *DoctorQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := dq.querySpec()
@@ -6,9 +6,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"hospital/internal/modules/db/ent/department"
 	"hospital/internal/modules/db/ent/doctor"
 	"hospital/internal/modules/db/ent/patient"
 	"hospital/internal/modules/db/ent/predicate"
+	"hospital/internal/modules/db/ent/session"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -52,6 +54,40 @@ func (du *DoctorUpdate) SetRole(s string) *DoctorUpdate {
 	return du
 }
 
+// SetLanguage sets the "language" field.
+func (du *DoctorUpdate) SetLanguage(s string) *DoctorUpdate {
+	du.mutation.SetLanguage(s)
+	return du
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (du *DoctorUpdate) SetNillableLanguage(s *string) *DoctorUpdate {
+	if s != nil {
+		du.SetLanguage(*s)
+	}
+	return du
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (du *DoctorUpdate) ClearLanguage() *DoctorUpdate {
+	du.mutation.ClearLanguage()
+	return du
+}
+
+// SetOnCall sets the "onCall" field.
+func (du *DoctorUpdate) SetOnCall(b bool) *DoctorUpdate {
+	du.mutation.SetOnCall(b)
+	return du
+}
+
+// SetNillableOnCall sets the "onCall" field if the given value is not nil.
+func (du *DoctorUpdate) SetNillableOnCall(b *bool) *DoctorUpdate {
+	if b != nil {
+		du.SetOnCall(*b)
+	}
+	return du
+}
+
 // AddTreatIDs adds the "treats" edge to the Patient entity by IDs.
 func (du *DoctorUpdate) AddTreatIDs(ids ...int) *DoctorUpdate {
 	du.mutation.AddTreatIDs(ids...)
@@ -67,6 +103,36 @@ func (du *DoctorUpdate) AddTreats(p ...*Patient) *DoctorUpdate {
 	return du.AddTreatIDs(ids...)
 }
 
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (du *DoctorUpdate) AddSessionIDs(ids ...int) *DoctorUpdate {
+	du.mutation.AddSessionIDs(ids...)
+	return du
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (du *DoctorUpdate) AddSessions(s ...*Session) *DoctorUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return du.AddSessionIDs(ids...)
+}
+
+// AddDepartmentIDs adds the "departments" edge to the Department entity by IDs.
+func (du *DoctorUpdate) AddDepartmentIDs(ids ...int) *DoctorUpdate {
+	du.mutation.AddDepartmentIDs(ids...)
+	return du
+}
+
+// AddDepartments adds the "departments" edges to the Department entity.
+func (du *DoctorUpdate) AddDepartments(d ...*Department) *DoctorUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return du.AddDepartmentIDs(ids...)
+}
+
 // Mutation returns the DoctorMutation object of the builder.
 func (du *DoctorUpdate) Mutation() *DoctorMutation {
 	return du.mutation
@@ -93,6 +159,48 @@ func (du *DoctorUpdate) RemoveTreats(p ...*Patient) *DoctorUpdate {
 	return du.RemoveTreatIDs(ids...)
 }
 
+// ClearSessions clears all "sessions" edges to the Session entity.
+func (du *DoctorUpdate) ClearSessions() *DoctorUpdate {
+	du.mutation.ClearSessions()
+	return du
+}
+
+// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
+func (du *DoctorUpdate) RemoveSessionIDs(ids ...int) *DoctorUpdate {
+	du.mutation.RemoveSessionIDs(ids...)
+	return du
+}
+
+// RemoveSessions removes "sessions" edges to Session entities.
+func (du *DoctorUpdate) RemoveSessions(s ...*Session) *DoctorUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return du.RemoveSessionIDs(ids...)
+}
+
+// ClearDepartments clears all "departments" edges to the Department entity.
+func (du *DoctorUpdate) ClearDepartments() *DoctorUpdate {
+	du.mutation.ClearDepartments()
+	return du
+}
+
+// RemoveDepartmentIDs removes the "departments" edge to Department entities by IDs.
+func (du *DoctorUpdate) RemoveDepartmentIDs(ids ...int) *DoctorUpdate {
+	du.mutation.RemoveDepartmentIDs(ids...)
+	return du
+}
+
+// RemoveDepartments removes "departments" edges to Department entities.
+func (du *DoctorUpdate) RemoveDepartments(d ...*Department) *DoctorUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return du.RemoveDepartmentIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (du *DoctorUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks[int, DoctorMutation](ctx, du.sqlSave, du.mutation, du.hooks)
@@ -141,6 +249,15 @@ func (du *DoctorUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := du.mutation.Role(); ok {
 		_spec.SetField(doctor.FieldRole, field.TypeString, value)
 	}
+	if value, ok := du.mutation.Language(); ok {
+		_spec.SetField(doctor.FieldLanguage, field.TypeString, value)
+	}
+	if du.mutation.LanguageCleared() {
+		_spec.ClearField(doctor.FieldLanguage, field.TypeString)
+	}
+	if value, ok := du.mutation.OnCall(); ok {
+		_spec.SetField(doctor.FieldOnCall, field.TypeBool, value)
+	}
 	if du.mutation.TreatsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -186,6 +303,96 @@ func (du *DoctorUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if du.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.SessionsTable,
+			Columns: []string{doctor.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !du.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.SessionsTable,
+			Columns: []string{doctor.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.SessionsTable,
+			Columns: []string{doctor.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if du.mutation.DepartmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   doctor.DepartmentsTable,
+			Columns: doctor.DepartmentsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RemovedDepartmentsIDs(); len(nodes) > 0 && !du.mutation.DepartmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   doctor.DepartmentsTable,
+			Columns: doctor.DepartmentsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.DepartmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   doctor.DepartmentsTable,
+			Columns: doctor.DepartmentsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{doctor.Label}
@@ -230,6 +437,40 @@ func (duo *DoctorUpdateOne) SetRole(s string) *DoctorUpdateOne {
 	return duo
 }
 
+// SetLanguage sets the "language" field.
+func (duo *DoctorUpdateOne) SetLanguage(s string) *DoctorUpdateOne {
+	duo.mutation.SetLanguage(s)
+	return duo
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (duo *DoctorUpdateOne) SetNillableLanguage(s *string) *DoctorUpdateOne {
+	if s != nil {
+		duo.SetLanguage(*s)
+	}
+	return duo
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (duo *DoctorUpdateOne) ClearLanguage() *DoctorUpdateOne {
+	duo.mutation.ClearLanguage()
+	return duo
+}
+
+// SetOnCall sets the "onCall" field.
+func (duo *DoctorUpdateOne) SetOnCall(b bool) *DoctorUpdateOne {
+	duo.mutation.SetOnCall(b)
+	return duo
+}
+
+// SetNillableOnCall sets the "onCall" field if the given value is not nil.
+func (duo *DoctorUpdateOne) SetNillableOnCall(b *bool) *DoctorUpdateOne {
+	if b != nil {
+		duo.SetOnCall(*b)
+	}
+	return duo
+}
+
 // AddTreatIDs adds the "treats" edge to the Patient entity by IDs.
 func (duo *DoctorUpdateOne) AddTreatIDs(ids ...int) *DoctorUpdateOne {
 	duo.mutation.AddTreatIDs(ids...)
@@ -245,6 +486,36 @@ func (duo *DoctorUpdateOne) AddTreats(p ...*Patient) *DoctorUpdateOne {
 	return duo.AddTreatIDs(ids...)
 }
 
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (duo *DoctorUpdateOne) AddSessionIDs(ids ...int) *DoctorUpdateOne {
+	duo.mutation.AddSessionIDs(ids...)
+	return duo
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (duo *DoctorUpdateOne) AddSessions(s ...*Session) *DoctorUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return duo.AddSessionIDs(ids...)
+}
+
+// AddDepartmentIDs adds the "departments" edge to the Department entity by IDs.
+func (duo *DoctorUpdateOne) AddDepartmentIDs(ids ...int) *DoctorUpdateOne {
+	duo.mutation.AddDepartmentIDs(ids...)
+	return duo
+}
+
+// AddDepartments adds the "departments" edges to the Department entity.
+func (duo *DoctorUpdateOne) AddDepartments(d ...*Department) *DoctorUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return duo.AddDepartmentIDs(ids...)
+}
+
 // Mutation returns the DoctorMutation object of the builder.
 func (duo *DoctorUpdateOne) Mutation() *DoctorMutation {
 	return duo.mutation
@@ -271,6 +542,48 @@ func (duo *DoctorUpdateOne) RemoveTreats(p ...*Patient) *DoctorUpdateOne {
 	return duo.RemoveTreatIDs(ids...)
 }
 
+// ClearSessions clears all "sessions" edges to the Session entity.
+func (duo *DoctorUpdateOne) ClearSessions() *DoctorUpdateOne {
+	duo.mutation.ClearSessions()
+	return duo
+}
+
+// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
+func (duo *DoctorUpdateOne) RemoveSessionIDs(ids ...int) *DoctorUpdateOne {
+	duo.mutation.RemoveSessionIDs(ids...)
+	return duo
+}
+
+// RemoveSessions removes "sessions" edges to Session entities.
+func (duo *DoctorUpdateOne) RemoveSessions(s ...*Session) *DoctorUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return duo.RemoveSessionIDs(ids...)
+}
+
+// ClearDepartments clears all "departments" edges to the Department entity.
+func (duo *DoctorUpdateOne) ClearDepartments() *DoctorUpdateOne {
+	duo.mutation.ClearDepartments()
+	return duo
+}
+
+// RemoveDepartmentIDs removes the "departments" edge to Department entities by IDs.
+func (duo *DoctorUpdateOne) RemoveDepartmentIDs(ids ...int) *DoctorUpdateOne {
+	duo.mutation.RemoveDepartmentIDs(ids...)
+	return duo
+}
+
+// RemoveDepartments removes "departments" edges to Department entities.
+func (duo *DoctorUpdateOne) RemoveDepartments(d ...*Department) *DoctorUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return duo.RemoveDepartmentIDs(ids...)
+}
+
 // Where appends a list predicates to the DoctorUpdate builder.
 func (duo *DoctorUpdateOne) Where(ps ...predicate.Doctor) *DoctorUpdateOne {
 	duo.mutation.Where(ps...)
@@ -349,6 +662,15 @@ func (duo *DoctorUpdateOne) sqlSave(ctx context.Context) (_node *Doctor, err err
 	if value, ok := duo.mutation.Role(); ok {
 		_spec.SetField(doctor.FieldRole, field.TypeString, value)
 	}
+	if value, ok := duo.mutation.Language(); ok {
+		_spec.SetField(doctor.FieldLanguage, field.TypeString, value)
+	}
+	if duo.mutation.LanguageCleared() {
+		_spec.ClearField(doctor.FieldLanguage, field.TypeString)
+	}
+	if value, ok := duo.mutation.OnCall(); ok {
+		_spec.SetField(doctor.FieldOnCall, field.TypeBool, value)
+	}
 	if duo.mutation.TreatsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -394,6 +716,96 @@ func (duo *DoctorUpdateOne) sqlSave(ctx context.Context) (_node *Doctor, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if duo.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.SessionsTable,
+			Columns: []string{doctor.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !duo.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.SessionsTable,
+			Columns: []string{doctor.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.SessionsTable,
+			Columns: []string{doctor.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.DepartmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   doctor.DepartmentsTable,
+			Columns: doctor.DepartmentsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedDepartmentsIDs(); len(nodes) > 0 && !duo.mutation.DepartmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   doctor.DepartmentsTable,
+			Columns: doctor.DepartmentsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.DepartmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   doctor.DepartmentsTable,
+			Columns: doctor.DepartmentsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Doctor{config: duo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -6,10 +6,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"hospital/internal/modules/db/ent/accesslog"
+	"hospital/internal/modules/db/ent/alert"
+	"hospital/internal/modules/db/ent/auditlog"
+	"hospital/internal/modules/db/ent/confirmtoken"
+	"hospital/internal/modules/db/ent/conversation"
+	"hospital/internal/modules/db/ent/department"
 	"hospital/internal/modules/db/ent/disease"
 	"hospital/internal/modules/db/ent/doctor"
+	"hospital/internal/modules/db/ent/jobstate"
+	"hospital/internal/modules/db/ent/notificationpreference"
+	"hospital/internal/modules/db/ent/organization"
 	"hospital/internal/modules/db/ent/patient"
 	"hospital/internal/modules/db/ent/room"
+	"hospital/internal/modules/db/ent/session"
+	"hospital/internal/modules/db/ent/vital"
+	"hospital/internal/modules/db/ent/wardchat"
+	"hospital/internal/modules/db/ent/wardnotice"
 	"reflect"
 	"sync"
 
@@ -76,10 +89,23 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			disease.Table: disease.ValidColumn,
-			doctor.Table:  doctor.ValidColumn,
-			patient.Table: patient.ValidColumn,
-			room.Table:    room.ValidColumn,
+			accesslog.Table:              accesslog.ValidColumn,
+			alert.Table:                  alert.ValidColumn,
+			auditlog.Table:               auditlog.ValidColumn,
+			confirmtoken.Table:           confirmtoken.ValidColumn,
+			conversation.Table:           conversation.ValidColumn,
+			department.Table:             department.ValidColumn,
+			disease.Table:                disease.ValidColumn,
+			doctor.Table:                 doctor.ValidColumn,
+			jobstate.Table:               jobstate.ValidColumn,
+			notificationpreference.Table: notificationpreference.ValidColumn,
+			organization.Table:           organization.ValidColumn,
+			patient.Table:                patient.ValidColumn,
+			room.Table:                   room.ValidColumn,
+			session.Table:                session.ValidColumn,
+			vital.Table:                  vital.ValidColumn,
+			wardchat.Table:               wardchat.ValidColumn,
+			wardnotice.Table:             wardnotice.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
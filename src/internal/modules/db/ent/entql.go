@@ -0,0 +1,2002 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"hospital/internal/modules/db/ent/accesslog"
+	"hospital/internal/modules/db/ent/alert"
+	"hospital/internal/modules/db/ent/auditlog"
+	"hospital/internal/modules/db/ent/confirmtoken"
+	"hospital/internal/modules/db/ent/conversation"
+	"hospital/internal/modules/db/ent/department"
+	"hospital/internal/modules/db/ent/disease"
+	"hospital/internal/modules/db/ent/doctor"
+	"hospital/internal/modules/db/ent/jobstate"
+	"hospital/internal/modules/db/ent/notificationpreference"
+	"hospital/internal/modules/db/ent/organization"
+	"hospital/internal/modules/db/ent/patient"
+	"hospital/internal/modules/db/ent/predicate"
+	"hospital/internal/modules/db/ent/room"
+	"hospital/internal/modules/db/ent/session"
+	"hospital/internal/modules/db/ent/vital"
+	"hospital/internal/modules/db/ent/wardchat"
+	"hospital/internal/modules/db/ent/wardnotice"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/entql"
+	"entgo.io/ent/schema/field"
+)
+
+// schemaGraph holds a representation of ent/schema at runtime.
+var schemaGraph = func() *sqlgraph.Schema {
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 17)}
+	graph.Nodes[0] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   accesslog.Table,
+			Columns: accesslog.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: accesslog.FieldID,
+			},
+		},
+		Type: "AccessLog",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			accesslog.FieldOrganizationId: {Type: field.TypeInt, Column: accesslog.FieldOrganizationId},
+			accesslog.FieldActorId:        {Type: field.TypeInt, Column: accesslog.FieldActorId},
+			accesslog.FieldSessionId:      {Type: field.TypeString, Column: accesslog.FieldSessionId},
+			accesslog.FieldPatientId:      {Type: field.TypeInt, Column: accesslog.FieldPatientId},
+			accesslog.FieldAction:         {Type: field.TypeString, Column: accesslog.FieldAction},
+			accesslog.FieldPurpose:        {Type: field.TypeString, Column: accesslog.FieldPurpose},
+			accesslog.FieldCreatedAt:      {Type: field.TypeTime, Column: accesslog.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[1] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   alert.Table,
+			Columns: alert.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: alert.FieldID,
+			},
+		},
+		Type: "Alert",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			alert.FieldOrganizationId:  {Type: field.TypeInt, Column: alert.FieldOrganizationId},
+			alert.FieldKind:            {Type: field.TypeString, Column: alert.FieldKind},
+			alert.FieldSeverity:        {Type: field.TypeString, Column: alert.FieldSeverity},
+			alert.FieldPatientId:       {Type: field.TypeInt, Column: alert.FieldPatientId},
+			alert.FieldDoctorId:        {Type: field.TypeInt, Column: alert.FieldDoctorId},
+			alert.FieldParams:          {Type: field.TypeJSON, Column: alert.FieldParams},
+			alert.FieldStatus:          {Type: field.TypeString, Column: alert.FieldStatus},
+			alert.FieldDeliverAfter:    {Type: field.TypeTime, Column: alert.FieldDeliverAfter},
+			alert.FieldSentAt:          {Type: field.TypeTime, Column: alert.FieldSentAt},
+			alert.FieldClaimedUntil:    {Type: field.TypeTime, Column: alert.FieldClaimedUntil},
+			alert.FieldAttempts:        {Type: field.TypeInt, Column: alert.FieldAttempts},
+			alert.FieldAckedAt:         {Type: field.TypeTime, Column: alert.FieldAckedAt},
+			alert.FieldAckedBy:         {Type: field.TypeInt, Column: alert.FieldAckedBy},
+			alert.FieldParentId:        {Type: field.TypeInt, Column: alert.FieldParentId},
+			alert.FieldEscalationLevel: {Type: field.TypeInt, Column: alert.FieldEscalationLevel},
+			alert.FieldCreatedAt:       {Type: field.TypeTime, Column: alert.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[2] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   auditlog.Table,
+			Columns: auditlog.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: auditlog.FieldID,
+			},
+		},
+		Type: "AuditLog",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			auditlog.FieldOrganizationId: {Type: field.TypeInt, Column: auditlog.FieldOrganizationId},
+			auditlog.FieldActorId:        {Type: field.TypeInt, Column: auditlog.FieldActorId},
+			auditlog.FieldSessionId:      {Type: field.TypeString, Column: auditlog.FieldSessionId},
+			auditlog.FieldEntity:         {Type: field.TypeString, Column: auditlog.FieldEntity},
+			auditlog.FieldEntityId:       {Type: field.TypeInt, Column: auditlog.FieldEntityId},
+			auditlog.FieldAction:         {Type: field.TypeString, Column: auditlog.FieldAction},
+			auditlog.FieldChanges:        {Type: field.TypeJSON, Column: auditlog.FieldChanges},
+			auditlog.FieldCreatedAt:      {Type: field.TypeTime, Column: auditlog.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[3] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   confirmtoken.Table,
+			Columns: confirmtoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: confirmtoken.FieldID,
+			},
+		},
+		Type: "ConfirmToken",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			confirmtoken.FieldKey:       {Type: field.TypeString, Column: confirmtoken.FieldKey},
+			confirmtoken.FieldExpiresAt: {Type: field.TypeTime, Column: confirmtoken.FieldExpiresAt},
+		},
+	}
+	graph.Nodes[4] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   conversation.Table,
+			Columns: conversation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: conversation.FieldID,
+			},
+		},
+		Type: "Conversation",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			conversation.FieldChatId:    {Type: field.TypeInt64, Column: conversation.FieldChatId},
+			conversation.FieldUserId:    {Type: field.TypeInt64, Column: conversation.FieldUserId},
+			conversation.FieldDialog:    {Type: field.TypeString, Column: conversation.FieldDialog},
+			conversation.FieldState:     {Type: field.TypeString, Column: conversation.FieldState},
+			conversation.FieldValues:    {Type: field.TypeJSON, Column: conversation.FieldValues},
+			conversation.FieldHistory:   {Type: field.TypeJSON, Column: conversation.FieldHistory},
+			conversation.FieldSuspended: {Type: field.TypeBool, Column: conversation.FieldSuspended},
+			conversation.FieldUpdatedAt: {Type: field.TypeTime, Column: conversation.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[5] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   department.Table,
+			Columns: department.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: department.FieldID,
+			},
+		},
+		Type: "Department",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			department.FieldOrganizationId: {Type: field.TypeInt, Column: department.FieldOrganizationId},
+			department.FieldName:           {Type: field.TypeString, Column: department.FieldName},
+		},
+	}
+	graph.Nodes[6] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   disease.Table,
+			Columns: disease.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: disease.FieldID,
+			},
+		},
+		Type: "Disease",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			disease.FieldOrganizationId: {Type: field.TypeInt, Column: disease.FieldOrganizationId},
+			disease.FieldThreat:         {Type: field.TypeString, Column: disease.FieldThreat},
+			disease.FieldName:           {Type: field.TypeString, Column: disease.FieldName},
+			disease.FieldDegreeOfDanger: {Type: field.TypeInt, Column: disease.FieldDegreeOfDanger},
+		},
+	}
+	graph.Nodes[7] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   doctor.Table,
+			Columns: doctor.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: doctor.FieldID,
+			},
+		},
+		Type: "Doctor",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			doctor.FieldTokenId:    {Type: field.TypeString, Column: doctor.FieldTokenId},
+			doctor.FieldSurname:    {Type: field.TypeString, Column: doctor.FieldSurname},
+			doctor.FieldSpeciality: {Type: field.TypeString, Column: doctor.FieldSpeciality},
+			doctor.FieldRole:       {Type: field.TypeString, Column: doctor.FieldRole},
+			doctor.FieldLanguage:   {Type: field.TypeString, Column: doctor.FieldLanguage},
+			doctor.FieldOnCall:     {Type: field.TypeBool, Column: doctor.FieldOnCall},
+		},
+	}
+	graph.Nodes[8] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   jobstate.Table,
+			Columns: jobstate.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: jobstate.FieldID,
+			},
+		},
+		Type: "JobState",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			jobstate.FieldName:          {Type: field.TypeString, Column: jobstate.FieldName},
+			jobstate.FieldLastRunAt:     {Type: field.TypeTime, Column: jobstate.FieldLastRunAt},
+			jobstate.FieldLastSuccessAt: {Type: field.TypeTime, Column: jobstate.FieldLastSuccessAt},
+			jobstate.FieldLastError:     {Type: field.TypeString, Column: jobstate.FieldLastError},
+			jobstate.FieldAttempts:      {Type: field.TypeInt, Column: jobstate.FieldAttempts},
+			jobstate.FieldUpdatedAt:     {Type: field.TypeTime, Column: jobstate.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[9] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   notificationpreference.Table,
+			Columns: notificationpreference.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: notificationpreference.FieldID,
+			},
+		},
+		Type: "NotificationPreference",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			notificationpreference.FieldDoctorId:    {Type: field.TypeInt, Column: notificationpreference.FieldDoctorId},
+			notificationpreference.FieldMinSeverity: {Type: field.TypeString, Column: notificationpreference.FieldMinSeverity},
+			notificationpreference.FieldMutedKinds:  {Type: field.TypeJSON, Column: notificationpreference.FieldMutedKinds},
+			notificationpreference.FieldQuietStart:  {Type: field.TypeInt, Column: notificationpreference.FieldQuietStart},
+			notificationpreference.FieldQuietEnd:    {Type: field.TypeInt, Column: notificationpreference.FieldQuietEnd},
+		},
+	}
+	graph.Nodes[10] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   organization.Table,
+			Columns: organization.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: organization.FieldID,
+			},
+		},
+		Type: "Organization",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			organization.FieldName: {Type: field.TypeString, Column: organization.FieldName},
+		},
+	}
+	graph.Nodes[11] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   patient.Table,
+			Columns: patient.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: patient.FieldID,
+			},
+		},
+		Type: "Patient",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			patient.FieldOrganizationId: {Type: field.TypeInt, Column: patient.FieldOrganizationId},
+			patient.FieldSurname:        {Type: field.TypeString, Column: patient.FieldSurname},
+			patient.FieldName:           {Type: field.TypeString, Column: patient.FieldName},
+			patient.FieldPatronymic:     {Type: field.TypeString, Column: patient.FieldPatronymic},
+			patient.FieldHeight:         {Type: field.TypeInt, Column: patient.FieldHeight},
+			patient.FieldWeight:         {Type: field.TypeFloat64, Column: patient.FieldWeight},
+			patient.FieldRoomNumber:     {Type: field.TypeInt, Column: patient.FieldRoomNumber},
+			patient.FieldDegreeOfDanger: {Type: field.TypeInt, Column: patient.FieldDegreeOfDanger},
+		},
+	}
+	graph.Nodes[12] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   room.Table,
+			Columns: room.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: room.FieldID,
+			},
+		},
+		Type: "Room",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			room.FieldOrganizationId: {Type: field.TypeInt, Column: room.FieldOrganizationId},
+			room.FieldNumber:         {Type: field.TypeInt, Column: room.FieldNumber},
+			room.FieldFloor:          {Type: field.TypeInt, Column: room.FieldFloor},
+			room.FieldNumberBeds:     {Type: field.TypeInt, Column: room.FieldNumberBeds},
+			room.FieldNumberPatients: {Type: field.TypeInt, Column: room.FieldNumberPatients},
+			room.FieldTypeRoom:       {Type: field.TypeString, Column: room.FieldTypeRoom},
+			room.FieldDepartmentId:   {Type: field.TypeInt, Column: room.FieldDepartmentId},
+		},
+	}
+	graph.Nodes[13] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   session.Table,
+			Columns: session.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: session.FieldID,
+			},
+		},
+		Type: "Session",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			session.FieldSessionId:      {Type: field.TypeString, Column: session.FieldSessionId},
+			session.FieldDoctorId:       {Type: field.TypeInt, Column: session.FieldDoctorId},
+			session.FieldCreatedAt:      {Type: field.TypeTime, Column: session.FieldCreatedAt},
+			session.FieldLastSeenAt:     {Type: field.TypeTime, Column: session.FieldLastSeenAt},
+			session.FieldExpiresAt:      {Type: field.TypeTime, Column: session.FieldExpiresAt},
+			session.FieldRevokedAt:      {Type: field.TypeTime, Column: session.FieldRevokedAt},
+			session.FieldDepartmentId:   {Type: field.TypeInt, Column: session.FieldDepartmentId},
+			session.FieldOrganizationId: {Type: field.TypeInt, Column: session.FieldOrganizationId},
+		},
+	}
+	graph.Nodes[14] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   vital.Table,
+			Columns: vital.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: vital.FieldID,
+			},
+		},
+		Type: "Vital",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			vital.FieldOrganizationId: {Type: field.TypeInt, Column: vital.FieldOrganizationId},
+			vital.FieldPatientId:      {Type: field.TypeInt, Column: vital.FieldPatientId},
+			vital.FieldAuthorId:       {Type: field.TypeInt, Column: vital.FieldAuthorId},
+			vital.FieldPulse:          {Type: field.TypeInt, Column: vital.FieldPulse},
+			vital.FieldSystolic:       {Type: field.TypeInt, Column: vital.FieldSystolic},
+			vital.FieldDiastolic:      {Type: field.TypeInt, Column: vital.FieldDiastolic},
+			vital.FieldTemperature:    {Type: field.TypeFloat64, Column: vital.FieldTemperature},
+			vital.FieldSaturation:     {Type: field.TypeInt, Column: vital.FieldSaturation},
+			vital.FieldCreatedAt:      {Type: field.TypeTime, Column: vital.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[15] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   wardchat.Table,
+			Columns: wardchat.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: wardchat.FieldID,
+			},
+		},
+		Type: "WardChat",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			wardchat.FieldOrganizationId: {Type: field.TypeInt, Column: wardchat.FieldOrganizationId},
+			wardchat.FieldChatId:         {Type: field.TypeInt64, Column: wardchat.FieldChatId},
+			wardchat.FieldTitle:          {Type: field.TypeString, Column: wardchat.FieldTitle},
+			wardchat.FieldDepartmentId:   {Type: field.TypeInt, Column: wardchat.FieldDepartmentId},
+			wardchat.FieldRooms:          {Type: field.TypeJSON, Column: wardchat.FieldRooms},
+			wardchat.FieldLanguage:       {Type: field.TypeString, Column: wardchat.FieldLanguage},
+			wardchat.FieldCreatedBy:      {Type: field.TypeInt, Column: wardchat.FieldCreatedBy},
+			wardchat.FieldCreatedAt:      {Type: field.TypeTime, Column: wardchat.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[16] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   wardnotice.Table,
+			Columns: wardnotice.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: wardnotice.FieldID,
+			},
+		},
+		Type: "WardNotice",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			wardnotice.FieldOrganizationId: {Type: field.TypeInt, Column: wardnotice.FieldOrganizationId},
+			wardnotice.FieldChatId:         {Type: field.TypeInt64, Column: wardnotice.FieldChatId},
+			wardnotice.FieldKind:           {Type: field.TypeString, Column: wardnotice.FieldKind},
+			wardnotice.FieldSeverity:       {Type: field.TypeString, Column: wardnotice.FieldSeverity},
+			wardnotice.FieldPatientId:      {Type: field.TypeInt, Column: wardnotice.FieldPatientId},
+			wardnotice.FieldParams:         {Type: field.TypeJSON, Column: wardnotice.FieldParams},
+			wardnotice.FieldStatus:         {Type: field.TypeString, Column: wardnotice.FieldStatus},
+			wardnotice.FieldSentAt:         {Type: field.TypeTime, Column: wardnotice.FieldSentAt},
+			wardnotice.FieldClaimedUntil:   {Type: field.TypeTime, Column: wardnotice.FieldClaimedUntil},
+			wardnotice.FieldAttempts:       {Type: field.TypeInt, Column: wardnotice.FieldAttempts},
+			wardnotice.FieldCreatedAt:      {Type: field.TypeTime, Column: wardnotice.FieldCreatedAt},
+		},
+	}
+	graph.MustAddE(
+		"organization",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   department.OrganizationTable,
+			Columns: []string{department.OrganizationColumn},
+			Bidi:    false,
+		},
+		"Department",
+		"Organization",
+	)
+	graph.MustAddE(
+		"rooms",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   department.RoomsTable,
+			Columns: []string{department.RoomsColumn},
+			Bidi:    false,
+		},
+		"Department",
+		"Room",
+	)
+	graph.MustAddE(
+		"doctors",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   department.DoctorsTable,
+			Columns: department.DoctorsPrimaryKey,
+			Bidi:    false,
+		},
+		"Department",
+		"Doctor",
+	)
+	graph.MustAddE(
+		"has",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   disease.HasTable,
+			Columns: []string{disease.HasColumn},
+			Bidi:    false,
+		},
+		"Disease",
+		"Patient",
+	)
+	graph.MustAddE(
+		"treats",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   doctor.TreatsTable,
+			Columns: doctor.TreatsPrimaryKey,
+			Bidi:    false,
+		},
+		"Doctor",
+		"Patient",
+	)
+	graph.MustAddE(
+		"sessions",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.SessionsTable,
+			Columns: []string{doctor.SessionsColumn},
+			Bidi:    false,
+		},
+		"Doctor",
+		"Session",
+	)
+	graph.MustAddE(
+		"departments",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   doctor.DepartmentsTable,
+			Columns: doctor.DepartmentsPrimaryKey,
+			Bidi:    false,
+		},
+		"Doctor",
+		"Department",
+	)
+	graph.MustAddE(
+		"departments",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.DepartmentsTable,
+			Columns: []string{organization.DepartmentsColumn},
+			Bidi:    false,
+		},
+		"Organization",
+		"Department",
+	)
+	graph.MustAddE(
+		"repo",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   patient.RepoTable,
+			Columns: []string{patient.RepoColumn},
+			Bidi:    false,
+		},
+		"Patient",
+		"Room",
+	)
+	graph.MustAddE(
+		"doctor",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   patient.DoctorTable,
+			Columns: patient.DoctorPrimaryKey,
+			Bidi:    false,
+		},
+		"Patient",
+		"Doctor",
+	)
+	graph.MustAddE(
+		"ills",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   patient.IllsTable,
+			Columns: []string{patient.IllsColumn},
+			Bidi:    false,
+		},
+		"Patient",
+		"Disease",
+	)
+	graph.MustAddE(
+		"contains",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   room.ContainsTable,
+			Columns: []string{room.ContainsColumn},
+			Bidi:    false,
+		},
+		"Room",
+		"Patient",
+	)
+	graph.MustAddE(
+		"department",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   room.DepartmentTable,
+			Columns: []string{room.DepartmentColumn},
+			Bidi:    false,
+		},
+		"Room",
+		"Department",
+	)
+	graph.MustAddE(
+		"doctor",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   session.DoctorTable,
+			Columns: []string{session.DoctorColumn},
+			Bidi:    false,
+		},
+		"Session",
+		"Doctor",
+	)
+	return graph
+}()
+
+// predicateAdder wraps the addPredicate method.
+// All update, update-one and query builders implement this interface.
+type predicateAdder interface {
+	addPredicate(func(s *sql.Selector))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (alq *AccessLogQuery) addPredicate(pred func(s *sql.Selector)) {
+	alq.predicates = append(alq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the AccessLogQuery builder.
+func (alq *AccessLogQuery) Filter() *AccessLogFilter {
+	return &AccessLogFilter{config: alq.config, predicateAdder: alq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *AccessLogMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the AccessLogMutation builder.
+func (m *AccessLogMutation) Filter() *AccessLogFilter {
+	return &AccessLogFilter{config: m.config, predicateAdder: m}
+}
+
+// AccessLogFilter provides a generic filtering capability at runtime for AccessLogQuery.
+type AccessLogFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *AccessLogFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *AccessLogFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(accesslog.FieldID))
+}
+
+// WhereOrganizationId applies the entql int predicate on the organizationId field.
+func (f *AccessLogFilter) WhereOrganizationId(p entql.IntP) {
+	f.Where(p.Field(accesslog.FieldOrganizationId))
+}
+
+// WhereActorId applies the entql int predicate on the actorId field.
+func (f *AccessLogFilter) WhereActorId(p entql.IntP) {
+	f.Where(p.Field(accesslog.FieldActorId))
+}
+
+// WhereSessionId applies the entql string predicate on the sessionId field.
+func (f *AccessLogFilter) WhereSessionId(p entql.StringP) {
+	f.Where(p.Field(accesslog.FieldSessionId))
+}
+
+// WherePatientId applies the entql int predicate on the patientId field.
+func (f *AccessLogFilter) WherePatientId(p entql.IntP) {
+	f.Where(p.Field(accesslog.FieldPatientId))
+}
+
+// WhereAction applies the entql string predicate on the action field.
+func (f *AccessLogFilter) WhereAction(p entql.StringP) {
+	f.Where(p.Field(accesslog.FieldAction))
+}
+
+// WherePurpose applies the entql string predicate on the purpose field.
+func (f *AccessLogFilter) WherePurpose(p entql.StringP) {
+	f.Where(p.Field(accesslog.FieldPurpose))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the createdAt field.
+func (f *AccessLogFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(accesslog.FieldCreatedAt))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (aq *AlertQuery) addPredicate(pred func(s *sql.Selector)) {
+	aq.predicates = append(aq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the AlertQuery builder.
+func (aq *AlertQuery) Filter() *AlertFilter {
+	return &AlertFilter{config: aq.config, predicateAdder: aq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *AlertMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the AlertMutation builder.
+func (m *AlertMutation) Filter() *AlertFilter {
+	return &AlertFilter{config: m.config, predicateAdder: m}
+}
+
+// AlertFilter provides a generic filtering capability at runtime for AlertQuery.
+type AlertFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *AlertFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *AlertFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(alert.FieldID))
+}
+
+// WhereOrganizationId applies the entql int predicate on the organizationId field.
+func (f *AlertFilter) WhereOrganizationId(p entql.IntP) {
+	f.Where(p.Field(alert.FieldOrganizationId))
+}
+
+// WhereKind applies the entql string predicate on the kind field.
+func (f *AlertFilter) WhereKind(p entql.StringP) {
+	f.Where(p.Field(alert.FieldKind))
+}
+
+// WhereSeverity applies the entql string predicate on the severity field.
+func (f *AlertFilter) WhereSeverity(p entql.StringP) {
+	f.Where(p.Field(alert.FieldSeverity))
+}
+
+// WherePatientId applies the entql int predicate on the patientId field.
+func (f *AlertFilter) WherePatientId(p entql.IntP) {
+	f.Where(p.Field(alert.FieldPatientId))
+}
+
+// WhereDoctorId applies the entql int predicate on the doctorId field.
+func (f *AlertFilter) WhereDoctorId(p entql.IntP) {
+	f.Where(p.Field(alert.FieldDoctorId))
+}
+
+// WhereParams applies the entql json.RawMessage predicate on the params field.
+func (f *AlertFilter) WhereParams(p entql.BytesP) {
+	f.Where(p.Field(alert.FieldParams))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *AlertFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(alert.FieldStatus))
+}
+
+// WhereDeliverAfter applies the entql time.Time predicate on the deliverAfter field.
+func (f *AlertFilter) WhereDeliverAfter(p entql.TimeP) {
+	f.Where(p.Field(alert.FieldDeliverAfter))
+}
+
+// WhereSentAt applies the entql time.Time predicate on the sentAt field.
+func (f *AlertFilter) WhereSentAt(p entql.TimeP) {
+	f.Where(p.Field(alert.FieldSentAt))
+}
+
+// WhereClaimedUntil applies the entql time.Time predicate on the claimedUntil field.
+func (f *AlertFilter) WhereClaimedUntil(p entql.TimeP) {
+	f.Where(p.Field(alert.FieldClaimedUntil))
+}
+
+// WhereAttempts applies the entql int predicate on the attempts field.
+func (f *AlertFilter) WhereAttempts(p entql.IntP) {
+	f.Where(p.Field(alert.FieldAttempts))
+}
+
+// WhereAckedAt applies the entql time.Time predicate on the ackedAt field.
+func (f *AlertFilter) WhereAckedAt(p entql.TimeP) {
+	f.Where(p.Field(alert.FieldAckedAt))
+}
+
+// WhereAckedBy applies the entql int predicate on the ackedBy field.
+func (f *AlertFilter) WhereAckedBy(p entql.IntP) {
+	f.Where(p.Field(alert.FieldAckedBy))
+}
+
+// WhereParentId applies the entql int predicate on the parentId field.
+func (f *AlertFilter) WhereParentId(p entql.IntP) {
+	f.Where(p.Field(alert.FieldParentId))
+}
+
+// WhereEscalationLevel applies the entql int predicate on the escalationLevel field.
+func (f *AlertFilter) WhereEscalationLevel(p entql.IntP) {
+	f.Where(p.Field(alert.FieldEscalationLevel))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the createdAt field.
+func (f *AlertFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(alert.FieldCreatedAt))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (alq *AuditLogQuery) addPredicate(pred func(s *sql.Selector)) {
+	alq.predicates = append(alq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the AuditLogQuery builder.
+func (alq *AuditLogQuery) Filter() *AuditLogFilter {
+	return &AuditLogFilter{config: alq.config, predicateAdder: alq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *AuditLogMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the AuditLogMutation builder.
+func (m *AuditLogMutation) Filter() *AuditLogFilter {
+	return &AuditLogFilter{config: m.config, predicateAdder: m}
+}
+
+// AuditLogFilter provides a generic filtering capability at runtime for AuditLogQuery.
+type AuditLogFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *AuditLogFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *AuditLogFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(auditlog.FieldID))
+}
+
+// WhereOrganizationId applies the entql int predicate on the organizationId field.
+func (f *AuditLogFilter) WhereOrganizationId(p entql.IntP) {
+	f.Where(p.Field(auditlog.FieldOrganizationId))
+}
+
+// WhereActorId applies the entql int predicate on the actorId field.
+func (f *AuditLogFilter) WhereActorId(p entql.IntP) {
+	f.Where(p.Field(auditlog.FieldActorId))
+}
+
+// WhereSessionId applies the entql string predicate on the sessionId field.
+func (f *AuditLogFilter) WhereSessionId(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldSessionId))
+}
+
+// WhereEntity applies the entql string predicate on the entity field.
+func (f *AuditLogFilter) WhereEntity(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldEntity))
+}
+
+// WhereEntityId applies the entql int predicate on the entityId field.
+func (f *AuditLogFilter) WhereEntityId(p entql.IntP) {
+	f.Where(p.Field(auditlog.FieldEntityId))
+}
+
+// WhereAction applies the entql string predicate on the action field.
+func (f *AuditLogFilter) WhereAction(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldAction))
+}
+
+// WhereChanges applies the entql json.RawMessage predicate on the changes field.
+func (f *AuditLogFilter) WhereChanges(p entql.BytesP) {
+	f.Where(p.Field(auditlog.FieldChanges))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the createdAt field.
+func (f *AuditLogFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(auditlog.FieldCreatedAt))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (ctq *ConfirmTokenQuery) addPredicate(pred func(s *sql.Selector)) {
+	ctq.predicates = append(ctq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the ConfirmTokenQuery builder.
+func (ctq *ConfirmTokenQuery) Filter() *ConfirmTokenFilter {
+	return &ConfirmTokenFilter{config: ctq.config, predicateAdder: ctq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *ConfirmTokenMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the ConfirmTokenMutation builder.
+func (m *ConfirmTokenMutation) Filter() *ConfirmTokenFilter {
+	return &ConfirmTokenFilter{config: m.config, predicateAdder: m}
+}
+
+// ConfirmTokenFilter provides a generic filtering capability at runtime for ConfirmTokenQuery.
+type ConfirmTokenFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *ConfirmTokenFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *ConfirmTokenFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(confirmtoken.FieldID))
+}
+
+// WhereKey applies the entql string predicate on the key field.
+func (f *ConfirmTokenFilter) WhereKey(p entql.StringP) {
+	f.Where(p.Field(confirmtoken.FieldKey))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expiresAt field.
+func (f *ConfirmTokenFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(confirmtoken.FieldExpiresAt))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (cq *ConversationQuery) addPredicate(pred func(s *sql.Selector)) {
+	cq.predicates = append(cq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the ConversationQuery builder.
+func (cq *ConversationQuery) Filter() *ConversationFilter {
+	return &ConversationFilter{config: cq.config, predicateAdder: cq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *ConversationMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the ConversationMutation builder.
+func (m *ConversationMutation) Filter() *ConversationFilter {
+	return &ConversationFilter{config: m.config, predicateAdder: m}
+}
+
+// ConversationFilter provides a generic filtering capability at runtime for ConversationQuery.
+type ConversationFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *ConversationFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *ConversationFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(conversation.FieldID))
+}
+
+// WhereChatId applies the entql int64 predicate on the chatId field.
+func (f *ConversationFilter) WhereChatId(p entql.Int64P) {
+	f.Where(p.Field(conversation.FieldChatId))
+}
+
+// WhereUserId applies the entql int64 predicate on the userId field.
+func (f *ConversationFilter) WhereUserId(p entql.Int64P) {
+	f.Where(p.Field(conversation.FieldUserId))
+}
+
+// WhereDialog applies the entql string predicate on the dialog field.
+func (f *ConversationFilter) WhereDialog(p entql.StringP) {
+	f.Where(p.Field(conversation.FieldDialog))
+}
+
+// WhereState applies the entql string predicate on the state field.
+func (f *ConversationFilter) WhereState(p entql.StringP) {
+	f.Where(p.Field(conversation.FieldState))
+}
+
+// WhereValues applies the entql json.RawMessage predicate on the values field.
+func (f *ConversationFilter) WhereValues(p entql.BytesP) {
+	f.Where(p.Field(conversation.FieldValues))
+}
+
+// WhereHistory applies the entql json.RawMessage predicate on the history field.
+func (f *ConversationFilter) WhereHistory(p entql.BytesP) {
+	f.Where(p.Field(conversation.FieldHistory))
+}
+
+// WhereSuspended applies the entql bool predicate on the suspended field.
+func (f *ConversationFilter) WhereSuspended(p entql.BoolP) {
+	f.Where(p.Field(conversation.FieldSuspended))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updatedAt field.
+func (f *ConversationFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(conversation.FieldUpdatedAt))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (dq *DepartmentQuery) addPredicate(pred func(s *sql.Selector)) {
+	dq.predicates = append(dq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the DepartmentQuery builder.
+func (dq *DepartmentQuery) Filter() *DepartmentFilter {
+	return &DepartmentFilter{config: dq.config, predicateAdder: dq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *DepartmentMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the DepartmentMutation builder.
+func (m *DepartmentMutation) Filter() *DepartmentFilter {
+	return &DepartmentFilter{config: m.config, predicateAdder: m}
+}
+
+// DepartmentFilter provides a generic filtering capability at runtime for DepartmentQuery.
+type DepartmentFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *DepartmentFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *DepartmentFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(department.FieldID))
+}
+
+// WhereOrganizationId applies the entql int predicate on the organizationId field.
+func (f *DepartmentFilter) WhereOrganizationId(p entql.IntP) {
+	f.Where(p.Field(department.FieldOrganizationId))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *DepartmentFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(department.FieldName))
+}
+
+// WhereHasOrganization applies a predicate to check if query has an edge organization.
+func (f *DepartmentFilter) WhereHasOrganization() {
+	f.Where(entql.HasEdge("organization"))
+}
+
+// WhereHasOrganizationWith applies a predicate to check if query has an edge organization with a given conditions (other predicates).
+func (f *DepartmentFilter) WhereHasOrganizationWith(preds ...predicate.Organization) {
+	f.Where(entql.HasEdgeWith("organization", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasRooms applies a predicate to check if query has an edge rooms.
+func (f *DepartmentFilter) WhereHasRooms() {
+	f.Where(entql.HasEdge("rooms"))
+}
+
+// WhereHasRoomsWith applies a predicate to check if query has an edge rooms with a given conditions (other predicates).
+func (f *DepartmentFilter) WhereHasRoomsWith(preds ...predicate.Room) {
+	f.Where(entql.HasEdgeWith("rooms", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasDoctors applies a predicate to check if query has an edge doctors.
+func (f *DepartmentFilter) WhereHasDoctors() {
+	f.Where(entql.HasEdge("doctors"))
+}
+
+// WhereHasDoctorsWith applies a predicate to check if query has an edge doctors with a given conditions (other predicates).
+func (f *DepartmentFilter) WhereHasDoctorsWith(preds ...predicate.Doctor) {
+	f.Where(entql.HasEdgeWith("doctors", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (dq *DiseaseQuery) addPredicate(pred func(s *sql.Selector)) {
+	dq.predicates = append(dq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the DiseaseQuery builder.
+func (dq *DiseaseQuery) Filter() *DiseaseFilter {
+	return &DiseaseFilter{config: dq.config, predicateAdder: dq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *DiseaseMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the DiseaseMutation builder.
+func (m *DiseaseMutation) Filter() *DiseaseFilter {
+	return &DiseaseFilter{config: m.config, predicateAdder: m}
+}
+
+// DiseaseFilter provides a generic filtering capability at runtime for DiseaseQuery.
+type DiseaseFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *DiseaseFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *DiseaseFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(disease.FieldID))
+}
+
+// WhereOrganizationId applies the entql int predicate on the organizationId field.
+func (f *DiseaseFilter) WhereOrganizationId(p entql.IntP) {
+	f.Where(p.Field(disease.FieldOrganizationId))
+}
+
+// WhereThreat applies the entql string predicate on the threat field.
+func (f *DiseaseFilter) WhereThreat(p entql.StringP) {
+	f.Where(p.Field(disease.FieldThreat))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *DiseaseFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(disease.FieldName))
+}
+
+// WhereDegreeOfDanger applies the entql int predicate on the degreeOfDanger field.
+func (f *DiseaseFilter) WhereDegreeOfDanger(p entql.IntP) {
+	f.Where(p.Field(disease.FieldDegreeOfDanger))
+}
+
+// WhereHasHas applies a predicate to check if query has an edge has.
+func (f *DiseaseFilter) WhereHasHas() {
+	f.Where(entql.HasEdge("has"))
+}
+
+// WhereHasHasWith applies a predicate to check if query has an edge has with a given conditions (other predicates).
+func (f *DiseaseFilter) WhereHasHasWith(preds ...predicate.Patient) {
+	f.Where(entql.HasEdgeWith("has", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (dq *DoctorQuery) addPredicate(pred func(s *sql.Selector)) {
+	dq.predicates = append(dq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the DoctorQuery builder.
+func (dq *DoctorQuery) Filter() *DoctorFilter {
+	return &DoctorFilter{config: dq.config, predicateAdder: dq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *DoctorMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the DoctorMutation builder.
+func (m *DoctorMutation) Filter() *DoctorFilter {
+	return &DoctorFilter{config: m.config, predicateAdder: m}
+}
+
+// DoctorFilter provides a generic filtering capability at runtime for DoctorQuery.
+type DoctorFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *DoctorFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *DoctorFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(doctor.FieldID))
+}
+
+// WhereTokenId applies the entql string predicate on the tokenId field.
+func (f *DoctorFilter) WhereTokenId(p entql.StringP) {
+	f.Where(p.Field(doctor.FieldTokenId))
+}
+
+// WhereSurname applies the entql string predicate on the surname field.
+func (f *DoctorFilter) WhereSurname(p entql.StringP) {
+	f.Where(p.Field(doctor.FieldSurname))
+}
+
+// WhereSpeciality applies the entql string predicate on the speciality field.
+func (f *DoctorFilter) WhereSpeciality(p entql.StringP) {
+	f.Where(p.Field(doctor.FieldSpeciality))
+}
+
+// WhereRole applies the entql string predicate on the role field.
+func (f *DoctorFilter) WhereRole(p entql.StringP) {
+	f.Where(p.Field(doctor.FieldRole))
+}
+
+// WhereLanguage applies the entql string predicate on the language field.
+func (f *DoctorFilter) WhereLanguage(p entql.StringP) {
+	f.Where(p.Field(doctor.FieldLanguage))
+}
+
+// WhereOnCall applies the entql bool predicate on the onCall field.
+func (f *DoctorFilter) WhereOnCall(p entql.BoolP) {
+	f.Where(p.Field(doctor.FieldOnCall))
+}
+
+// WhereHasTreats applies a predicate to check if query has an edge treats.
+func (f *DoctorFilter) WhereHasTreats() {
+	f.Where(entql.HasEdge("treats"))
+}
+
+// WhereHasTreatsWith applies a predicate to check if query has an edge treats with a given conditions (other predicates).
+func (f *DoctorFilter) WhereHasTreatsWith(preds ...predicate.Patient) {
+	f.Where(entql.HasEdgeWith("treats", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasSessions applies a predicate to check if query has an edge sessions.
+func (f *DoctorFilter) WhereHasSessions() {
+	f.Where(entql.HasEdge("sessions"))
+}
+
+// WhereHasSessionsWith applies a predicate to check if query has an edge sessions with a given conditions (other predicates).
+func (f *DoctorFilter) WhereHasSessionsWith(preds ...predicate.Session) {
+	f.Where(entql.HasEdgeWith("sessions", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasDepartments applies a predicate to check if query has an edge departments.
+func (f *DoctorFilter) WhereHasDepartments() {
+	f.Where(entql.HasEdge("departments"))
+}
+
+// WhereHasDepartmentsWith applies a predicate to check if query has an edge departments with a given conditions (other predicates).
+func (f *DoctorFilter) WhereHasDepartmentsWith(preds ...predicate.Department) {
+	f.Where(entql.HasEdgeWith("departments", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (jsq *JobStateQuery) addPredicate(pred func(s *sql.Selector)) {
+	jsq.predicates = append(jsq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the JobStateQuery builder.
+func (jsq *JobStateQuery) Filter() *JobStateFilter {
+	return &JobStateFilter{config: jsq.config, predicateAdder: jsq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *JobStateMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the JobStateMutation builder.
+func (m *JobStateMutation) Filter() *JobStateFilter {
+	return &JobStateFilter{config: m.config, predicateAdder: m}
+}
+
+// JobStateFilter provides a generic filtering capability at runtime for JobStateQuery.
+type JobStateFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *JobStateFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *JobStateFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(jobstate.FieldID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *JobStateFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(jobstate.FieldName))
+}
+
+// WhereLastRunAt applies the entql time.Time predicate on the lastRunAt field.
+func (f *JobStateFilter) WhereLastRunAt(p entql.TimeP) {
+	f.Where(p.Field(jobstate.FieldLastRunAt))
+}
+
+// WhereLastSuccessAt applies the entql time.Time predicate on the lastSuccessAt field.
+func (f *JobStateFilter) WhereLastSuccessAt(p entql.TimeP) {
+	f.Where(p.Field(jobstate.FieldLastSuccessAt))
+}
+
+// WhereLastError applies the entql string predicate on the lastError field.
+func (f *JobStateFilter) WhereLastError(p entql.StringP) {
+	f.Where(p.Field(jobstate.FieldLastError))
+}
+
+// WhereAttempts applies the entql int predicate on the attempts field.
+func (f *JobStateFilter) WhereAttempts(p entql.IntP) {
+	f.Where(p.Field(jobstate.FieldAttempts))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updatedAt field.
+func (f *JobStateFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(jobstate.FieldUpdatedAt))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (npq *NotificationPreferenceQuery) addPredicate(pred func(s *sql.Selector)) {
+	npq.predicates = append(npq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the NotificationPreferenceQuery builder.
+func (npq *NotificationPreferenceQuery) Filter() *NotificationPreferenceFilter {
+	return &NotificationPreferenceFilter{config: npq.config, predicateAdder: npq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *NotificationPreferenceMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the NotificationPreferenceMutation builder.
+func (m *NotificationPreferenceMutation) Filter() *NotificationPreferenceFilter {
+	return &NotificationPreferenceFilter{config: m.config, predicateAdder: m}
+}
+
+// NotificationPreferenceFilter provides a generic filtering capability at runtime for NotificationPreferenceQuery.
+type NotificationPreferenceFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *NotificationPreferenceFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *NotificationPreferenceFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(notificationpreference.FieldID))
+}
+
+// WhereDoctorId applies the entql int predicate on the doctorId field.
+func (f *NotificationPreferenceFilter) WhereDoctorId(p entql.IntP) {
+	f.Where(p.Field(notificationpreference.FieldDoctorId))
+}
+
+// WhereMinSeverity applies the entql string predicate on the minSeverity field.
+func (f *NotificationPreferenceFilter) WhereMinSeverity(p entql.StringP) {
+	f.Where(p.Field(notificationpreference.FieldMinSeverity))
+}
+
+// WhereMutedKinds applies the entql json.RawMessage predicate on the mutedKinds field.
+func (f *NotificationPreferenceFilter) WhereMutedKinds(p entql.BytesP) {
+	f.Where(p.Field(notificationpreference.FieldMutedKinds))
+}
+
+// WhereQuietStart applies the entql int predicate on the quietStart field.
+func (f *NotificationPreferenceFilter) WhereQuietStart(p entql.IntP) {
+	f.Where(p.Field(notificationpreference.FieldQuietStart))
+}
+
+// WhereQuietEnd applies the entql int predicate on the quietEnd field.
+func (f *NotificationPreferenceFilter) WhereQuietEnd(p entql.IntP) {
+	f.Where(p.Field(notificationpreference.FieldQuietEnd))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (oq *OrganizationQuery) addPredicate(pred func(s *sql.Selector)) {
+	oq.predicates = append(oq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the OrganizationQuery builder.
+func (oq *OrganizationQuery) Filter() *OrganizationFilter {
+	return &OrganizationFilter{config: oq.config, predicateAdder: oq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *OrganizationMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the OrganizationMutation builder.
+func (m *OrganizationMutation) Filter() *OrganizationFilter {
+	return &OrganizationFilter{config: m.config, predicateAdder: m}
+}
+
+// OrganizationFilter provides a generic filtering capability at runtime for OrganizationQuery.
+type OrganizationFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *OrganizationFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *OrganizationFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(organization.FieldID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *OrganizationFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(organization.FieldName))
+}
+
+// WhereHasDepartments applies a predicate to check if query has an edge departments.
+func (f *OrganizationFilter) WhereHasDepartments() {
+	f.Where(entql.HasEdge("departments"))
+}
+
+// WhereHasDepartmentsWith applies a predicate to check if query has an edge departments with a given conditions (other predicates).
+func (f *OrganizationFilter) WhereHasDepartmentsWith(preds ...predicate.Department) {
+	f.Where(entql.HasEdgeWith("departments", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (pq *PatientQuery) addPredicate(pred func(s *sql.Selector)) {
+	pq.predicates = append(pq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the PatientQuery builder.
+func (pq *PatientQuery) Filter() *PatientFilter {
+	return &PatientFilter{config: pq.config, predicateAdder: pq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *PatientMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the PatientMutation builder.
+func (m *PatientMutation) Filter() *PatientFilter {
+	return &PatientFilter{config: m.config, predicateAdder: m}
+}
+
+// PatientFilter provides a generic filtering capability at runtime for PatientQuery.
+type PatientFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *PatientFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[11].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *PatientFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(patient.FieldID))
+}
+
+// WhereOrganizationId applies the entql int predicate on the organizationId field.
+func (f *PatientFilter) WhereOrganizationId(p entql.IntP) {
+	f.Where(p.Field(patient.FieldOrganizationId))
+}
+
+// WhereSurname applies the entql string predicate on the surname field.
+func (f *PatientFilter) WhereSurname(p entql.StringP) {
+	f.Where(p.Field(patient.FieldSurname))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *PatientFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(patient.FieldName))
+}
+
+// WherePatronymic applies the entql string predicate on the patronymic field.
+func (f *PatientFilter) WherePatronymic(p entql.StringP) {
+	f.Where(p.Field(patient.FieldPatronymic))
+}
+
+// WhereHeight applies the entql int predicate on the height field.
+func (f *PatientFilter) WhereHeight(p entql.IntP) {
+	f.Where(p.Field(patient.FieldHeight))
+}
+
+// WhereWeight applies the entql float64 predicate on the weight field.
+func (f *PatientFilter) WhereWeight(p entql.Float64P) {
+	f.Where(p.Field(patient.FieldWeight))
+}
+
+// WhereRoomNumber applies the entql int predicate on the roomNumber field.
+func (f *PatientFilter) WhereRoomNumber(p entql.IntP) {
+	f.Where(p.Field(patient.FieldRoomNumber))
+}
+
+// WhereDegreeOfDanger applies the entql int predicate on the degreeOfDanger field.
+func (f *PatientFilter) WhereDegreeOfDanger(p entql.IntP) {
+	f.Where(p.Field(patient.FieldDegreeOfDanger))
+}
+
+// WhereHasRepo applies a predicate to check if query has an edge repo.
+func (f *PatientFilter) WhereHasRepo() {
+	f.Where(entql.HasEdge("repo"))
+}
+
+// WhereHasRepoWith applies a predicate to check if query has an edge repo with a given conditions (other predicates).
+func (f *PatientFilter) WhereHasRepoWith(preds ...predicate.Room) {
+	f.Where(entql.HasEdgeWith("repo", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasDoctor applies a predicate to check if query has an edge doctor.
+func (f *PatientFilter) WhereHasDoctor() {
+	f.Where(entql.HasEdge("doctor"))
+}
+
+// WhereHasDoctorWith applies a predicate to check if query has an edge doctor with a given conditions (other predicates).
+func (f *PatientFilter) WhereHasDoctorWith(preds ...predicate.Doctor) {
+	f.Where(entql.HasEdgeWith("doctor", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasIlls applies a predicate to check if query has an edge ills.
+func (f *PatientFilter) WhereHasIlls() {
+	f.Where(entql.HasEdge("ills"))
+}
+
+// WhereHasIllsWith applies a predicate to check if query has an edge ills with a given conditions (other predicates).
+func (f *PatientFilter) WhereHasIllsWith(preds ...predicate.Disease) {
+	f.Where(entql.HasEdgeWith("ills", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (rq *RoomQuery) addPredicate(pred func(s *sql.Selector)) {
+	rq.predicates = append(rq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the RoomQuery builder.
+func (rq *RoomQuery) Filter() *RoomFilter {
+	return &RoomFilter{config: rq.config, predicateAdder: rq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *RoomMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the RoomMutation builder.
+func (m *RoomMutation) Filter() *RoomFilter {
+	return &RoomFilter{config: m.config, predicateAdder: m}
+}
+
+// RoomFilter provides a generic filtering capability at runtime for RoomQuery.
+type RoomFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *RoomFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[12].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *RoomFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(room.FieldID))
+}
+
+// WhereOrganizationId applies the entql int predicate on the organizationId field.
+func (f *RoomFilter) WhereOrganizationId(p entql.IntP) {
+	f.Where(p.Field(room.FieldOrganizationId))
+}
+
+// WhereNumber applies the entql int predicate on the number field.
+func (f *RoomFilter) WhereNumber(p entql.IntP) {
+	f.Where(p.Field(room.FieldNumber))
+}
+
+// WhereFloor applies the entql int predicate on the floor field.
+func (f *RoomFilter) WhereFloor(p entql.IntP) {
+	f.Where(p.Field(room.FieldFloor))
+}
+
+// WhereNumberBeds applies the entql int predicate on the numberBeds field.
+func (f *RoomFilter) WhereNumberBeds(p entql.IntP) {
+	f.Where(p.Field(room.FieldNumberBeds))
+}
+
+// WhereNumberPatients applies the entql int predicate on the numberPatients field.
+func (f *RoomFilter) WhereNumberPatients(p entql.IntP) {
+	f.Where(p.Field(room.FieldNumberPatients))
+}
+
+// WhereTypeRoom applies the entql string predicate on the typeRoom field.
+func (f *RoomFilter) WhereTypeRoom(p entql.StringP) {
+	f.Where(p.Field(room.FieldTypeRoom))
+}
+
+// WhereDepartmentId applies the entql int predicate on the departmentId field.
+func (f *RoomFilter) WhereDepartmentId(p entql.IntP) {
+	f.Where(p.Field(room.FieldDepartmentId))
+}
+
+// WhereHasContains applies a predicate to check if query has an edge contains.
+func (f *RoomFilter) WhereHasContains() {
+	f.Where(entql.HasEdge("contains"))
+}
+
+// WhereHasContainsWith applies a predicate to check if query has an edge contains with a given conditions (other predicates).
+func (f *RoomFilter) WhereHasContainsWith(preds ...predicate.Patient) {
+	f.Where(entql.HasEdgeWith("contains", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasDepartment applies a predicate to check if query has an edge department.
+func (f *RoomFilter) WhereHasDepartment() {
+	f.Where(entql.HasEdge("department"))
+}
+
+// WhereHasDepartmentWith applies a predicate to check if query has an edge department with a given conditions (other predicates).
+func (f *RoomFilter) WhereHasDepartmentWith(preds ...predicate.Department) {
+	f.Where(entql.HasEdgeWith("department", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (sq *SessionQuery) addPredicate(pred func(s *sql.Selector)) {
+	sq.predicates = append(sq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the SessionQuery builder.
+func (sq *SessionQuery) Filter() *SessionFilter {
+	return &SessionFilter{config: sq.config, predicateAdder: sq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *SessionMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the SessionMutation builder.
+func (m *SessionMutation) Filter() *SessionFilter {
+	return &SessionFilter{config: m.config, predicateAdder: m}
+}
+
+// SessionFilter provides a generic filtering capability at runtime for SessionQuery.
+type SessionFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *SessionFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[13].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *SessionFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(session.FieldID))
+}
+
+// WhereSessionId applies the entql string predicate on the sessionId field.
+func (f *SessionFilter) WhereSessionId(p entql.StringP) {
+	f.Where(p.Field(session.FieldSessionId))
+}
+
+// WhereDoctorId applies the entql int predicate on the doctorId field.
+func (f *SessionFilter) WhereDoctorId(p entql.IntP) {
+	f.Where(p.Field(session.FieldDoctorId))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the createdAt field.
+func (f *SessionFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(session.FieldCreatedAt))
+}
+
+// WhereLastSeenAt applies the entql time.Time predicate on the lastSeenAt field.
+func (f *SessionFilter) WhereLastSeenAt(p entql.TimeP) {
+	f.Where(p.Field(session.FieldLastSeenAt))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expiresAt field.
+func (f *SessionFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(session.FieldExpiresAt))
+}
+
+// WhereRevokedAt applies the entql time.Time predicate on the revokedAt field.
+func (f *SessionFilter) WhereRevokedAt(p entql.TimeP) {
+	f.Where(p.Field(session.FieldRevokedAt))
+}
+
+// WhereDepartmentId applies the entql int predicate on the departmentId field.
+func (f *SessionFilter) WhereDepartmentId(p entql.IntP) {
+	f.Where(p.Field(session.FieldDepartmentId))
+}
+
+// WhereOrganizationId applies the entql int predicate on the organizationId field.
+func (f *SessionFilter) WhereOrganizationId(p entql.IntP) {
+	f.Where(p.Field(session.FieldOrganizationId))
+}
+
+// WhereHasDoctor applies a predicate to check if query has an edge doctor.
+func (f *SessionFilter) WhereHasDoctor() {
+	f.Where(entql.HasEdge("doctor"))
+}
+
+// WhereHasDoctorWith applies a predicate to check if query has an edge doctor with a given conditions (other predicates).
+func (f *SessionFilter) WhereHasDoctorWith(preds ...predicate.Doctor) {
+	f.Where(entql.HasEdgeWith("doctor", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (vq *VitalQuery) addPredicate(pred func(s *sql.Selector)) {
+	vq.predicates = append(vq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the VitalQuery builder.
+func (vq *VitalQuery) Filter() *VitalFilter {
+	return &VitalFilter{config: vq.config, predicateAdder: vq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *VitalMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the VitalMutation builder.
+func (m *VitalMutation) Filter() *VitalFilter {
+	return &VitalFilter{config: m.config, predicateAdder: m}
+}
+
+// VitalFilter provides a generic filtering capability at runtime for VitalQuery.
+type VitalFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *VitalFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[14].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *VitalFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(vital.FieldID))
+}
+
+// WhereOrganizationId applies the entql int predicate on the organizationId field.
+func (f *VitalFilter) WhereOrganizationId(p entql.IntP) {
+	f.Where(p.Field(vital.FieldOrganizationId))
+}
+
+// WherePatientId applies the entql int predicate on the patientId field.
+func (f *VitalFilter) WherePatientId(p entql.IntP) {
+	f.Where(p.Field(vital.FieldPatientId))
+}
+
+// WhereAuthorId applies the entql int predicate on the authorId field.
+func (f *VitalFilter) WhereAuthorId(p entql.IntP) {
+	f.Where(p.Field(vital.FieldAuthorId))
+}
+
+// WherePulse applies the entql int predicate on the pulse field.
+func (f *VitalFilter) WherePulse(p entql.IntP) {
+	f.Where(p.Field(vital.FieldPulse))
+}
+
+// WhereSystolic applies the entql int predicate on the systolic field.
+func (f *VitalFilter) WhereSystolic(p entql.IntP) {
+	f.Where(p.Field(vital.FieldSystolic))
+}
+
+// WhereDiastolic applies the entql int predicate on the diastolic field.
+func (f *VitalFilter) WhereDiastolic(p entql.IntP) {
+	f.Where(p.Field(vital.FieldDiastolic))
+}
+
+// WhereTemperature applies the entql float64 predicate on the temperature field.
+func (f *VitalFilter) WhereTemperature(p entql.Float64P) {
+	f.Where(p.Field(vital.FieldTemperature))
+}
+
+// WhereSaturation applies the entql int predicate on the saturation field.
+func (f *VitalFilter) WhereSaturation(p entql.IntP) {
+	f.Where(p.Field(vital.FieldSaturation))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the createdAt field.
+func (f *VitalFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(vital.FieldCreatedAt))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (wcq *WardChatQuery) addPredicate(pred func(s *sql.Selector)) {
+	wcq.predicates = append(wcq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the WardChatQuery builder.
+func (wcq *WardChatQuery) Filter() *WardChatFilter {
+	return &WardChatFilter{config: wcq.config, predicateAdder: wcq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *WardChatMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the WardChatMutation builder.
+func (m *WardChatMutation) Filter() *WardChatFilter {
+	return &WardChatFilter{config: m.config, predicateAdder: m}
+}
+
+// WardChatFilter provides a generic filtering capability at runtime for WardChatQuery.
+type WardChatFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *WardChatFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[15].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *WardChatFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(wardchat.FieldID))
+}
+
+// WhereOrganizationId applies the entql int predicate on the organizationId field.
+func (f *WardChatFilter) WhereOrganizationId(p entql.IntP) {
+	f.Where(p.Field(wardchat.FieldOrganizationId))
+}
+
+// WhereChatId applies the entql int64 predicate on the chatId field.
+func (f *WardChatFilter) WhereChatId(p entql.Int64P) {
+	f.Where(p.Field(wardchat.FieldChatId))
+}
+
+// WhereTitle applies the entql string predicate on the title field.
+func (f *WardChatFilter) WhereTitle(p entql.StringP) {
+	f.Where(p.Field(wardchat.FieldTitle))
+}
+
+// WhereDepartmentId applies the entql int predicate on the departmentId field.
+func (f *WardChatFilter) WhereDepartmentId(p entql.IntP) {
+	f.Where(p.Field(wardchat.FieldDepartmentId))
+}
+
+// WhereRooms applies the entql json.RawMessage predicate on the rooms field.
+func (f *WardChatFilter) WhereRooms(p entql.BytesP) {
+	f.Where(p.Field(wardchat.FieldRooms))
+}
+
+// WhereLanguage applies the entql string predicate on the language field.
+func (f *WardChatFilter) WhereLanguage(p entql.StringP) {
+	f.Where(p.Field(wardchat.FieldLanguage))
+}
+
+// WhereCreatedBy applies the entql int predicate on the createdBy field.
+func (f *WardChatFilter) WhereCreatedBy(p entql.IntP) {
+	f.Where(p.Field(wardchat.FieldCreatedBy))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the createdAt field.
+func (f *WardChatFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(wardchat.FieldCreatedAt))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (wnq *WardNoticeQuery) addPredicate(pred func(s *sql.Selector)) {
+	wnq.predicates = append(wnq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the WardNoticeQuery builder.
+func (wnq *WardNoticeQuery) Filter() *WardNoticeFilter {
+	return &WardNoticeFilter{config: wnq.config, predicateAdder: wnq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *WardNoticeMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the WardNoticeMutation builder.
+func (m *WardNoticeMutation) Filter() *WardNoticeFilter {
+	return &WardNoticeFilter{config: m.config, predicateAdder: m}
+}
+
+// WardNoticeFilter provides a generic filtering capability at runtime for WardNoticeQuery.
+type WardNoticeFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *WardNoticeFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[16].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *WardNoticeFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(wardnotice.FieldID))
+}
+
+// WhereOrganizationId applies the entql int predicate on the organizationId field.
+func (f *WardNoticeFilter) WhereOrganizationId(p entql.IntP) {
+	f.Where(p.Field(wardnotice.FieldOrganizationId))
+}
+
+// WhereChatId applies the entql int64 predicate on the chatId field.
+func (f *WardNoticeFilter) WhereChatId(p entql.Int64P) {
+	f.Where(p.Field(wardnotice.FieldChatId))
+}
+
+// WhereKind applies the entql string predicate on the kind field.
+func (f *WardNoticeFilter) WhereKind(p entql.StringP) {
+	f.Where(p.Field(wardnotice.FieldKind))
+}
+
+// WhereSeverity applies the entql string predicate on the severity field.
+func (f *WardNoticeFilter) WhereSeverity(p entql.StringP) {
+	f.Where(p.Field(wardnotice.FieldSeverity))
+}
+
+// WherePatientId applies the entql int predicate on the patientId field.
+func (f *WardNoticeFilter) WherePatientId(p entql.IntP) {
+	f.Where(p.Field(wardnotice.FieldPatientId))
+}
+
+// WhereParams applies the entql json.RawMessage predicate on the params field.
+func (f *WardNoticeFilter) WhereParams(p entql.BytesP) {
+	f.Where(p.Field(wardnotice.FieldParams))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *WardNoticeFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(wardnotice.FieldStatus))
+}
+
+// WhereSentAt applies the entql time.Time predicate on the sentAt field.
+func (f *WardNoticeFilter) WhereSentAt(p entql.TimeP) {
+	f.Where(p.Field(wardnotice.FieldSentAt))
+}
+
+// WhereClaimedUntil applies the entql time.Time predicate on the claimedUntil field.
+func (f *WardNoticeFilter) WhereClaimedUntil(p entql.TimeP) {
+	f.Where(p.Field(wardnotice.FieldClaimedUntil))
+}
+
+// WhereAttempts applies the entql int predicate on the attempts field.
+func (f *WardNoticeFilter) WhereAttempts(p entql.IntP) {
+	f.Where(p.Field(wardnotice.FieldAttempts))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the createdAt field.
+func (f *WardNoticeFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(wardnotice.FieldCreatedAt))
+}
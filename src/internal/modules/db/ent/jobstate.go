@@ -0,0 +1,167 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"hospital/internal/modules/db/ent/jobstate"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// JobState is the model entity for the JobState schema.
+type JobState struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// LastRunAt holds the value of the "lastRunAt" field.
+	LastRunAt *time.Time `json:"lastRunAt,omitempty"`
+	// LastSuccessAt holds the value of the "lastSuccessAt" field.
+	LastSuccessAt *time.Time `json:"lastSuccessAt,omitempty"`
+	// LastError holds the value of the "lastError" field.
+	LastError string `json:"lastError,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// UpdatedAt holds the value of the "updatedAt" field.
+	UpdatedAt    time.Time `json:"updatedAt,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*JobState) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case jobstate.FieldID, jobstate.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case jobstate.FieldName, jobstate.FieldLastError:
+			values[i] = new(sql.NullString)
+		case jobstate.FieldLastRunAt, jobstate.FieldLastSuccessAt, jobstate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the JobState fields.
+func (js *JobState) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case jobstate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			js.ID = int(value.Int64)
+		case jobstate.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				js.Name = value.String
+			}
+		case jobstate.FieldLastRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field lastRunAt", values[i])
+			} else if value.Valid {
+				js.LastRunAt = new(time.Time)
+				*js.LastRunAt = value.Time
+			}
+		case jobstate.FieldLastSuccessAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field lastSuccessAt", values[i])
+			} else if value.Valid {
+				js.LastSuccessAt = new(time.Time)
+				*js.LastSuccessAt = value.Time
+			}
+		case jobstate.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lastError", values[i])
+			} else if value.Valid {
+				js.LastError = value.String
+			}
+		case jobstate.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				js.Attempts = int(value.Int64)
+			}
+		case jobstate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updatedAt", values[i])
+			} else if value.Valid {
+				js.UpdatedAt = value.Time
+			}
+		default:
+			js.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the JobState.
+// This includes values selected through modifiers, order, etc.
+func (js *JobState) Value(name string) (ent.Value, error) {
+	return js.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this JobState.
+// Note that you need to call JobState.Unwrap() before calling this method if this JobState
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (js *JobState) Update() *JobStateUpdateOne {
+	return NewJobStateClient(js.config).UpdateOne(js)
+}
+
+// Unwrap unwraps the JobState entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (js *JobState) Unwrap() *JobState {
+	_tx, ok := js.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: JobState is not a transactional entity")
+	}
+	js.config.driver = _tx.drv
+	return js
+}
+
+// String implements the fmt.Stringer.
+func (js *JobState) String() string {
+	var builder strings.Builder
+	builder.WriteString("JobState(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", js.ID))
+	builder.WriteString("name=")
+	builder.WriteString(js.Name)
+	builder.WriteString(", ")
+	if v := js.LastRunAt; v != nil {
+		builder.WriteString("lastRunAt=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := js.LastSuccessAt; v != nil {
+		builder.WriteString("lastSuccessAt=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("lastError=")
+	builder.WriteString(js.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", js.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("updatedAt=")
+	builder.WriteString(js.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// JobStates is a parsable slice of JobState.
+type JobStates []*JobState
@@ -0,0 +1,98 @@
+// Code generated by ent, DO NOT EDIT.
+
+package jobstate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the jobstate type in the database.
+	Label = "job_state"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldLastRunAt holds the string denoting the lastrunat field in the database.
+	FieldLastRunAt = "last_run_at"
+	// FieldLastSuccessAt holds the string denoting the lastsuccessat field in the database.
+	FieldLastSuccessAt = "last_success_at"
+	// FieldLastError holds the string denoting the lasterror field in the database.
+	FieldLastError = "last_error"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldUpdatedAt holds the string denoting the updatedat field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the jobstate in the database.
+	Table = "job_states"
+)
+
+// Columns holds all SQL columns for jobstate fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldLastRunAt,
+	FieldLastSuccessAt,
+	FieldLastError,
+	FieldAttempts,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// DefaultUpdatedAt holds the default value on creation for the "updatedAt" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updatedAt" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Order defines the ordering method for the JobState queries.
+type Order func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByLastRunAt orders the results by the lastRunAt field.
+func ByLastRunAt(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldLastRunAt, opts...).ToFunc()
+}
+
+// ByLastSuccessAt orders the results by the lastSuccessAt field.
+func ByLastSuccessAt(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldLastSuccessAt, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the lastError field.
+func ByLastError(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updatedAt field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,437 @@
+// Code generated by ent, DO NOT EDIT.
+
+package jobstate
+
+import (
+	"hospital/internal/modules/db/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.JobState {
+	return predicate.JobState(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.JobState {
+	return predicate.JobState(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.JobState {
+	return predicate.JobState(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.JobState {
+	return predicate.JobState(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.JobState {
+	return predicate.JobState(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.JobState {
+	return predicate.JobState(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.JobState {
+	return predicate.JobState(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.JobState {
+	return predicate.JobState(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.JobState {
+	return predicate.JobState(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldEQ(FieldName, v))
+}
+
+// LastRunAt applies equality check predicate on the "lastRunAt" field. It's identical to LastRunAtEQ.
+func LastRunAt(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// LastSuccessAt applies equality check predicate on the "lastSuccessAt" field. It's identical to LastSuccessAtEQ.
+func LastSuccessAt(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldEQ(FieldLastSuccessAt, v))
+}
+
+// LastError applies equality check predicate on the "lastError" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldEQ(FieldLastError, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.JobState {
+	return predicate.JobState(sql.FieldEQ(FieldAttempts, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updatedAt" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.JobState {
+	return predicate.JobState(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.JobState {
+	return predicate.JobState(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldContainsFold(FieldName, v))
+}
+
+// LastRunAtEQ applies the EQ predicate on the "lastRunAt" field.
+func LastRunAtEQ(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtNEQ applies the NEQ predicate on the "lastRunAt" field.
+func LastRunAtNEQ(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldNEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtIn applies the In predicate on the "lastRunAt" field.
+func LastRunAtIn(vs ...time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtNotIn applies the NotIn predicate on the "lastRunAt" field.
+func LastRunAtNotIn(vs ...time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldNotIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtGT applies the GT predicate on the "lastRunAt" field.
+func LastRunAtGT(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldGT(FieldLastRunAt, v))
+}
+
+// LastRunAtGTE applies the GTE predicate on the "lastRunAt" field.
+func LastRunAtGTE(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldGTE(FieldLastRunAt, v))
+}
+
+// LastRunAtLT applies the LT predicate on the "lastRunAt" field.
+func LastRunAtLT(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldLT(FieldLastRunAt, v))
+}
+
+// LastRunAtLTE applies the LTE predicate on the "lastRunAt" field.
+func LastRunAtLTE(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldLTE(FieldLastRunAt, v))
+}
+
+// LastRunAtIsNil applies the IsNil predicate on the "lastRunAt" field.
+func LastRunAtIsNil() predicate.JobState {
+	return predicate.JobState(sql.FieldIsNull(FieldLastRunAt))
+}
+
+// LastRunAtNotNil applies the NotNil predicate on the "lastRunAt" field.
+func LastRunAtNotNil() predicate.JobState {
+	return predicate.JobState(sql.FieldNotNull(FieldLastRunAt))
+}
+
+// LastSuccessAtEQ applies the EQ predicate on the "lastSuccessAt" field.
+func LastSuccessAtEQ(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldEQ(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtNEQ applies the NEQ predicate on the "lastSuccessAt" field.
+func LastSuccessAtNEQ(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldNEQ(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtIn applies the In predicate on the "lastSuccessAt" field.
+func LastSuccessAtIn(vs ...time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldIn(FieldLastSuccessAt, vs...))
+}
+
+// LastSuccessAtNotIn applies the NotIn predicate on the "lastSuccessAt" field.
+func LastSuccessAtNotIn(vs ...time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldNotIn(FieldLastSuccessAt, vs...))
+}
+
+// LastSuccessAtGT applies the GT predicate on the "lastSuccessAt" field.
+func LastSuccessAtGT(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldGT(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtGTE applies the GTE predicate on the "lastSuccessAt" field.
+func LastSuccessAtGTE(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldGTE(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtLT applies the LT predicate on the "lastSuccessAt" field.
+func LastSuccessAtLT(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldLT(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtLTE applies the LTE predicate on the "lastSuccessAt" field.
+func LastSuccessAtLTE(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldLTE(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtIsNil applies the IsNil predicate on the "lastSuccessAt" field.
+func LastSuccessAtIsNil() predicate.JobState {
+	return predicate.JobState(sql.FieldIsNull(FieldLastSuccessAt))
+}
+
+// LastSuccessAtNotNil applies the NotNil predicate on the "lastSuccessAt" field.
+func LastSuccessAtNotNil() predicate.JobState {
+	return predicate.JobState(sql.FieldNotNull(FieldLastSuccessAt))
+}
+
+// LastErrorEQ applies the EQ predicate on the "lastError" field.
+func LastErrorEQ(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "lastError" field.
+func LastErrorNEQ(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "lastError" field.
+func LastErrorIn(vs ...string) predicate.JobState {
+	return predicate.JobState(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "lastError" field.
+func LastErrorNotIn(vs ...string) predicate.JobState {
+	return predicate.JobState(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "lastError" field.
+func LastErrorGT(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "lastError" field.
+func LastErrorGTE(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "lastError" field.
+func LastErrorLT(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "lastError" field.
+func LastErrorLTE(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "lastError" field.
+func LastErrorContains(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "lastError" field.
+func LastErrorHasPrefix(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "lastError" field.
+func LastErrorHasSuffix(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "lastError" field.
+func LastErrorIsNil() predicate.JobState {
+	return predicate.JobState(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "lastError" field.
+func LastErrorNotNil() predicate.JobState {
+	return predicate.JobState(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "lastError" field.
+func LastErrorEqualFold(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "lastError" field.
+func LastErrorContainsFold(v string) predicate.JobState {
+	return predicate.JobState(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.JobState {
+	return predicate.JobState(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.JobState {
+	return predicate.JobState(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.JobState {
+	return predicate.JobState(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.JobState {
+	return predicate.JobState(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.JobState {
+	return predicate.JobState(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.JobState {
+	return predicate.JobState(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.JobState {
+	return predicate.JobState(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.JobState {
+	return predicate.JobState(sql.FieldLTE(FieldAttempts, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updatedAt" field.
+func UpdatedAtEQ(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updatedAt" field.
+func UpdatedAtNEQ(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updatedAt" field.
+func UpdatedAtIn(vs ...time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updatedAt" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updatedAt" field.
+func UpdatedAtGT(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updatedAt" field.
+func UpdatedAtGTE(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updatedAt" field.
+func UpdatedAtLT(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updatedAt" field.
+func UpdatedAtLTE(v time.Time) predicate.JobState {
+	return predicate.JobState(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.JobState) predicate.JobState {
+	return predicate.JobState(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.JobState) predicate.JobState {
+	return predicate.JobState(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.JobState) predicate.JobState {
+	return predicate.JobState(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
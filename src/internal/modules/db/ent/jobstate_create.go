@@ -0,0 +1,290 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hospital/internal/modules/db/ent/jobstate"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JobStateCreate is the builder for creating a JobState entity.
+type JobStateCreate struct {
+	config
+	mutation *JobStateMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (jsc *JobStateCreate) SetName(s string) *JobStateCreate {
+	jsc.mutation.SetName(s)
+	return jsc
+}
+
+// SetLastRunAt sets the "lastRunAt" field.
+func (jsc *JobStateCreate) SetLastRunAt(t time.Time) *JobStateCreate {
+	jsc.mutation.SetLastRunAt(t)
+	return jsc
+}
+
+// SetNillableLastRunAt sets the "lastRunAt" field if the given value is not nil.
+func (jsc *JobStateCreate) SetNillableLastRunAt(t *time.Time) *JobStateCreate {
+	if t != nil {
+		jsc.SetLastRunAt(*t)
+	}
+	return jsc
+}
+
+// SetLastSuccessAt sets the "lastSuccessAt" field.
+func (jsc *JobStateCreate) SetLastSuccessAt(t time.Time) *JobStateCreate {
+	jsc.mutation.SetLastSuccessAt(t)
+	return jsc
+}
+
+// SetNillableLastSuccessAt sets the "lastSuccessAt" field if the given value is not nil.
+func (jsc *JobStateCreate) SetNillableLastSuccessAt(t *time.Time) *JobStateCreate {
+	if t != nil {
+		jsc.SetLastSuccessAt(*t)
+	}
+	return jsc
+}
+
+// SetLastError sets the "lastError" field.
+func (jsc *JobStateCreate) SetLastError(s string) *JobStateCreate {
+	jsc.mutation.SetLastError(s)
+	return jsc
+}
+
+// SetNillableLastError sets the "lastError" field if the given value is not nil.
+func (jsc *JobStateCreate) SetNillableLastError(s *string) *JobStateCreate {
+	if s != nil {
+		jsc.SetLastError(*s)
+	}
+	return jsc
+}
+
+// SetAttempts sets the "attempts" field.
+func (jsc *JobStateCreate) SetAttempts(i int) *JobStateCreate {
+	jsc.mutation.SetAttempts(i)
+	return jsc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (jsc *JobStateCreate) SetNillableAttempts(i *int) *JobStateCreate {
+	if i != nil {
+		jsc.SetAttempts(*i)
+	}
+	return jsc
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (jsc *JobStateCreate) SetUpdatedAt(t time.Time) *JobStateCreate {
+	jsc.mutation.SetUpdatedAt(t)
+	return jsc
+}
+
+// SetNillableUpdatedAt sets the "updatedAt" field if the given value is not nil.
+func (jsc *JobStateCreate) SetNillableUpdatedAt(t *time.Time) *JobStateCreate {
+	if t != nil {
+		jsc.SetUpdatedAt(*t)
+	}
+	return jsc
+}
+
+// Mutation returns the JobStateMutation object of the builder.
+func (jsc *JobStateCreate) Mutation() *JobStateMutation {
+	return jsc.mutation
+}
+
+// Save creates the JobState in the database.
+func (jsc *JobStateCreate) Save(ctx context.Context) (*JobState, error) {
+	jsc.defaults()
+	return withHooks[*JobState, JobStateMutation](ctx, jsc.sqlSave, jsc.mutation, jsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (jsc *JobStateCreate) SaveX(ctx context.Context) *JobState {
+	v, err := jsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jsc *JobStateCreate) Exec(ctx context.Context) error {
+	_, err := jsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jsc *JobStateCreate) ExecX(ctx context.Context) {
+	if err := jsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jsc *JobStateCreate) defaults() {
+	if _, ok := jsc.mutation.Attempts(); !ok {
+		v := jobstate.DefaultAttempts
+		jsc.mutation.SetAttempts(v)
+	}
+	if _, ok := jsc.mutation.UpdatedAt(); !ok {
+		v := jobstate.DefaultUpdatedAt()
+		jsc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jsc *JobStateCreate) check() error {
+	if _, ok := jsc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "JobState.name"`)}
+	}
+	if _, ok := jsc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "JobState.attempts"`)}
+	}
+	if _, ok := jsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updatedAt", err: errors.New(`ent: missing required field "JobState.updatedAt"`)}
+	}
+	return nil
+}
+
+func (jsc *JobStateCreate) sqlSave(ctx context.Context) (*JobState, error) {
+	if err := jsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := jsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, jsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	jsc.mutation.id = &_node.ID
+	jsc.mutation.done = true
+	return _node, nil
+}
+
+func (jsc *JobStateCreate) createSpec() (*JobState, *sqlgraph.CreateSpec) {
+	var (
+		_node = &JobState{config: jsc.config}
+		_spec = sqlgraph.NewCreateSpec(jobstate.Table, sqlgraph.NewFieldSpec(jobstate.FieldID, field.TypeInt))
+	)
+	if value, ok := jsc.mutation.Name(); ok {
+		_spec.SetField(jobstate.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := jsc.mutation.LastRunAt(); ok {
+		_spec.SetField(jobstate.FieldLastRunAt, field.TypeTime, value)
+		_node.LastRunAt = &value
+	}
+	if value, ok := jsc.mutation.LastSuccessAt(); ok {
+		_spec.SetField(jobstate.FieldLastSuccessAt, field.TypeTime, value)
+		_node.LastSuccessAt = &value
+	}
+	if value, ok := jsc.mutation.LastError(); ok {
+		_spec.SetField(jobstate.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := jsc.mutation.Attempts(); ok {
+		_spec.SetField(jobstate.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := jsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(jobstate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// JobStateCreateBulk is the builder for creating many JobState entities in bulk.
+type JobStateCreateBulk struct {
+	config
+	builders []*JobStateCreate
+}
+
+// Save creates the JobState entities in the database.
+func (jscb *JobStateCreateBulk) Save(ctx context.Context) ([]*JobState, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(jscb.builders))
+	nodes := make([]*JobState, len(jscb.builders))
+	mutators := make([]Mutator, len(jscb.builders))
+	for i := range jscb.builders {
+		func(i int, root context.Context) {
+			builder := jscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JobStateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, jscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, jscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, jscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jscb *JobStateCreateBulk) SaveX(ctx context.Context) []*JobState {
+	v, err := jscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jscb *JobStateCreateBulk) Exec(ctx context.Context) error {
+	_, err := jscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jscb *JobStateCreateBulk) ExecX(ctx context.Context) {
+	if err := jscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
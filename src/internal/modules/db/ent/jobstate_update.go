@@ -0,0 +1,422 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hospital/internal/modules/db/ent/jobstate"
+	"hospital/internal/modules/db/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JobStateUpdate is the builder for updating JobState entities.
+type JobStateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *JobStateMutation
+}
+
+// Where appends a list predicates to the JobStateUpdate builder.
+func (jsu *JobStateUpdate) Where(ps ...predicate.JobState) *JobStateUpdate {
+	jsu.mutation.Where(ps...)
+	return jsu
+}
+
+// SetLastRunAt sets the "lastRunAt" field.
+func (jsu *JobStateUpdate) SetLastRunAt(t time.Time) *JobStateUpdate {
+	jsu.mutation.SetLastRunAt(t)
+	return jsu
+}
+
+// SetNillableLastRunAt sets the "lastRunAt" field if the given value is not nil.
+func (jsu *JobStateUpdate) SetNillableLastRunAt(t *time.Time) *JobStateUpdate {
+	if t != nil {
+		jsu.SetLastRunAt(*t)
+	}
+	return jsu
+}
+
+// ClearLastRunAt clears the value of the "lastRunAt" field.
+func (jsu *JobStateUpdate) ClearLastRunAt() *JobStateUpdate {
+	jsu.mutation.ClearLastRunAt()
+	return jsu
+}
+
+// SetLastSuccessAt sets the "lastSuccessAt" field.
+func (jsu *JobStateUpdate) SetLastSuccessAt(t time.Time) *JobStateUpdate {
+	jsu.mutation.SetLastSuccessAt(t)
+	return jsu
+}
+
+// SetNillableLastSuccessAt sets the "lastSuccessAt" field if the given value is not nil.
+func (jsu *JobStateUpdate) SetNillableLastSuccessAt(t *time.Time) *JobStateUpdate {
+	if t != nil {
+		jsu.SetLastSuccessAt(*t)
+	}
+	return jsu
+}
+
+// ClearLastSuccessAt clears the value of the "lastSuccessAt" field.
+func (jsu *JobStateUpdate) ClearLastSuccessAt() *JobStateUpdate {
+	jsu.mutation.ClearLastSuccessAt()
+	return jsu
+}
+
+// SetLastError sets the "lastError" field.
+func (jsu *JobStateUpdate) SetLastError(s string) *JobStateUpdate {
+	jsu.mutation.SetLastError(s)
+	return jsu
+}
+
+// SetNillableLastError sets the "lastError" field if the given value is not nil.
+func (jsu *JobStateUpdate) SetNillableLastError(s *string) *JobStateUpdate {
+	if s != nil {
+		jsu.SetLastError(*s)
+	}
+	return jsu
+}
+
+// ClearLastError clears the value of the "lastError" field.
+func (jsu *JobStateUpdate) ClearLastError() *JobStateUpdate {
+	jsu.mutation.ClearLastError()
+	return jsu
+}
+
+// SetAttempts sets the "attempts" field.
+func (jsu *JobStateUpdate) SetAttempts(i int) *JobStateUpdate {
+	jsu.mutation.ResetAttempts()
+	jsu.mutation.SetAttempts(i)
+	return jsu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (jsu *JobStateUpdate) SetNillableAttempts(i *int) *JobStateUpdate {
+	if i != nil {
+		jsu.SetAttempts(*i)
+	}
+	return jsu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (jsu *JobStateUpdate) AddAttempts(i int) *JobStateUpdate {
+	jsu.mutation.AddAttempts(i)
+	return jsu
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (jsu *JobStateUpdate) SetUpdatedAt(t time.Time) *JobStateUpdate {
+	jsu.mutation.SetUpdatedAt(t)
+	return jsu
+}
+
+// Mutation returns the JobStateMutation object of the builder.
+func (jsu *JobStateUpdate) Mutation() *JobStateMutation {
+	return jsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (jsu *JobStateUpdate) Save(ctx context.Context) (int, error) {
+	jsu.defaults()
+	return withHooks[int, JobStateMutation](ctx, jsu.sqlSave, jsu.mutation, jsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jsu *JobStateUpdate) SaveX(ctx context.Context) int {
+	affected, err := jsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (jsu *JobStateUpdate) Exec(ctx context.Context) error {
+	_, err := jsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jsu *JobStateUpdate) ExecX(ctx context.Context) {
+	if err := jsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jsu *JobStateUpdate) defaults() {
+	if _, ok := jsu.mutation.UpdatedAt(); !ok {
+		v := jobstate.UpdateDefaultUpdatedAt()
+		jsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (jsu *JobStateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(jobstate.Table, jobstate.Columns, sqlgraph.NewFieldSpec(jobstate.FieldID, field.TypeInt))
+	if ps := jsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jsu.mutation.LastRunAt(); ok {
+		_spec.SetField(jobstate.FieldLastRunAt, field.TypeTime, value)
+	}
+	if jsu.mutation.LastRunAtCleared() {
+		_spec.ClearField(jobstate.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := jsu.mutation.LastSuccessAt(); ok {
+		_spec.SetField(jobstate.FieldLastSuccessAt, field.TypeTime, value)
+	}
+	if jsu.mutation.LastSuccessAtCleared() {
+		_spec.ClearField(jobstate.FieldLastSuccessAt, field.TypeTime)
+	}
+	if value, ok := jsu.mutation.LastError(); ok {
+		_spec.SetField(jobstate.FieldLastError, field.TypeString, value)
+	}
+	if jsu.mutation.LastErrorCleared() {
+		_spec.ClearField(jobstate.FieldLastError, field.TypeString)
+	}
+	if value, ok := jsu.mutation.Attempts(); ok {
+		_spec.SetField(jobstate.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := jsu.mutation.AddedAttempts(); ok {
+		_spec.AddField(jobstate.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := jsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(jobstate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, jsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	jsu.mutation.done = true
+	return n, nil
+}
+
+// JobStateUpdateOne is the builder for updating a single JobState entity.
+type JobStateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *JobStateMutation
+}
+
+// SetLastRunAt sets the "lastRunAt" field.
+func (jsuo *JobStateUpdateOne) SetLastRunAt(t time.Time) *JobStateUpdateOne {
+	jsuo.mutation.SetLastRunAt(t)
+	return jsuo
+}
+
+// SetNillableLastRunAt sets the "lastRunAt" field if the given value is not nil.
+func (jsuo *JobStateUpdateOne) SetNillableLastRunAt(t *time.Time) *JobStateUpdateOne {
+	if t != nil {
+		jsuo.SetLastRunAt(*t)
+	}
+	return jsuo
+}
+
+// ClearLastRunAt clears the value of the "lastRunAt" field.
+func (jsuo *JobStateUpdateOne) ClearLastRunAt() *JobStateUpdateOne {
+	jsuo.mutation.ClearLastRunAt()
+	return jsuo
+}
+
+// SetLastSuccessAt sets the "lastSuccessAt" field.
+func (jsuo *JobStateUpdateOne) SetLastSuccessAt(t time.Time) *JobStateUpdateOne {
+	jsuo.mutation.SetLastSuccessAt(t)
+	return jsuo
+}
+
+// SetNillableLastSuccessAt sets the "lastSuccessAt" field if the given value is not nil.
+func (jsuo *JobStateUpdateOne) SetNillableLastSuccessAt(t *time.Time) *JobStateUpdateOne {
+	if t != nil {
+		jsuo.SetLastSuccessAt(*t)
+	}
+	return jsuo
+}
+
+// ClearLastSuccessAt clears the value of the "lastSuccessAt" field.
+func (jsuo *JobStateUpdateOne) ClearLastSuccessAt() *JobStateUpdateOne {
+	jsuo.mutation.ClearLastSuccessAt()
+	return jsuo
+}
+
+// SetLastError sets the "lastError" field.
+func (jsuo *JobStateUpdateOne) SetLastError(s string) *JobStateUpdateOne {
+	jsuo.mutation.SetLastError(s)
+	return jsuo
+}
+
+// SetNillableLastError sets the "lastError" field if the given value is not nil.
+func (jsuo *JobStateUpdateOne) SetNillableLastError(s *string) *JobStateUpdateOne {
+	if s != nil {
+		jsuo.SetLastError(*s)
+	}
+	return jsuo
+}
+
+// ClearLastError clears the value of the "lastError" field.
+func (jsuo *JobStateUpdateOne) ClearLastError() *JobStateUpdateOne {
+	jsuo.mutation.ClearLastError()
+	return jsuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (jsuo *JobStateUpdateOne) SetAttempts(i int) *JobStateUpdateOne {
+	jsuo.mutation.ResetAttempts()
+	jsuo.mutation.SetAttempts(i)
+	return jsuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (jsuo *JobStateUpdateOne) SetNillableAttempts(i *int) *JobStateUpdateOne {
+	if i != nil {
+		jsuo.SetAttempts(*i)
+	}
+	return jsuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (jsuo *JobStateUpdateOne) AddAttempts(i int) *JobStateUpdateOne {
+	jsuo.mutation.AddAttempts(i)
+	return jsuo
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (jsuo *JobStateUpdateOne) SetUpdatedAt(t time.Time) *JobStateUpdateOne {
+	jsuo.mutation.SetUpdatedAt(t)
+	return jsuo
+}
+
+// Mutation returns the JobStateMutation object of the builder.
+func (jsuo *JobStateUpdateOne) Mutation() *JobStateMutation {
+	return jsuo.mutation
+}
+
+// Where appends a list predicates to the JobStateUpdate builder.
+func (jsuo *JobStateUpdateOne) Where(ps ...predicate.JobState) *JobStateUpdateOne {
+	jsuo.mutation.Where(ps...)
+	return jsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (jsuo *JobStateUpdateOne) Select(field string, fields ...string) *JobStateUpdateOne {
+	jsuo.fields = append([]string{field}, fields...)
+	return jsuo
+}
+
+// Save executes the query and returns the updated JobState entity.
+func (jsuo *JobStateUpdateOne) Save(ctx context.Context) (*JobState, error) {
+	jsuo.defaults()
+	return withHooks[*JobState, JobStateMutation](ctx, jsuo.sqlSave, jsuo.mutation, jsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jsuo *JobStateUpdateOne) SaveX(ctx context.Context) *JobState {
+	node, err := jsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (jsuo *JobStateUpdateOne) Exec(ctx context.Context) error {
+	_, err := jsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jsuo *JobStateUpdateOne) ExecX(ctx context.Context) {
+	if err := jsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jsuo *JobStateUpdateOne) defaults() {
+	if _, ok := jsuo.mutation.UpdatedAt(); !ok {
+		v := jobstate.UpdateDefaultUpdatedAt()
+		jsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (jsuo *JobStateUpdateOne) sqlSave(ctx context.Context) (_node *JobState, err error) {
+	_spec := sqlgraph.NewUpdateSpec(jobstate.Table, jobstate.Columns, sqlgraph.NewFieldSpec(jobstate.FieldID, field.TypeInt))
+	id, ok := jsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "JobState.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := jsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, jobstate.FieldID)
+		for _, f := range fields {
+			if !jobstate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != jobstate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := jsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jsuo.mutation.LastRunAt(); ok {
+		_spec.SetField(jobstate.FieldLastRunAt, field.TypeTime, value)
+	}
+	if jsuo.mutation.LastRunAtCleared() {
+		_spec.ClearField(jobstate.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := jsuo.mutation.LastSuccessAt(); ok {
+		_spec.SetField(jobstate.FieldLastSuccessAt, field.TypeTime, value)
+	}
+	if jsuo.mutation.LastSuccessAtCleared() {
+		_spec.ClearField(jobstate.FieldLastSuccessAt, field.TypeTime)
+	}
+	if value, ok := jsuo.mutation.LastError(); ok {
+		_spec.SetField(jobstate.FieldLastError, field.TypeString, value)
+	}
+	if jsuo.mutation.LastErrorCleared() {
+		_spec.ClearField(jobstate.FieldLastError, field.TypeString)
+	}
+	if value, ok := jsuo.mutation.Attempts(); ok {
+		_spec.SetField(jobstate.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := jsuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(jobstate.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := jsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(jobstate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &JobState{config: jsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, jsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	jsuo.mutation.done = true
+	return _node, nil
+}
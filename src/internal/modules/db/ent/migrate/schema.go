@@ -8,9 +8,191 @@ import (
 )
 
 var (
+	// AccessLogsColumns holds the columns for the "access_logs" table.
+	AccessLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+		{Name: "actor_id", Type: field.TypeInt, Nullable: true},
+		{Name: "session_id", Type: field.TypeString, Nullable: true},
+		{Name: "patient_id", Type: field.TypeInt},
+		{Name: "action", Type: field.TypeString},
+		{Name: "purpose", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AccessLogsTable holds the schema information for the "access_logs" table.
+	AccessLogsTable = &schema.Table{
+		Name:       "access_logs",
+		Columns:    AccessLogsColumns,
+		PrimaryKey: []*schema.Column{AccessLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accesslog_organization_id",
+				Unique:  false,
+				Columns: []*schema.Column{AccessLogsColumns[1]},
+			},
+			{
+				Name:    "accesslog_patient_id",
+				Unique:  false,
+				Columns: []*schema.Column{AccessLogsColumns[4]},
+			},
+			{
+				Name:    "accesslog_actor_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AccessLogsColumns[2], AccessLogsColumns[7]},
+			},
+		},
+	}
+	// AlertsColumns holds the columns for the "alerts" table.
+	AlertsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "severity", Type: field.TypeString},
+		{Name: "patient_id", Type: field.TypeInt},
+		{Name: "doctor_id", Type: field.TypeInt},
+		{Name: "params", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeString},
+		{Name: "deliver_after", Type: field.TypeTime, Nullable: true},
+		{Name: "sent_at", Type: field.TypeTime, Nullable: true},
+		{Name: "claimed_until", Type: field.TypeTime, Nullable: true},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "acked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "acked_by", Type: field.TypeInt, Nullable: true},
+		{Name: "parent_id", Type: field.TypeInt, Nullable: true},
+		{Name: "escalation_level", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AlertsTable holds the schema information for the "alerts" table.
+	AlertsTable = &schema.Table{
+		Name:       "alerts",
+		Columns:    AlertsColumns,
+		PrimaryKey: []*schema.Column{AlertsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "alert_organization_id",
+				Unique:  false,
+				Columns: []*schema.Column{AlertsColumns[1]},
+			},
+			{
+				Name:    "alert_status_deliver_after",
+				Unique:  false,
+				Columns: []*schema.Column{AlertsColumns[7], AlertsColumns[8]},
+			},
+			{
+				Name:    "alert_doctor_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AlertsColumns[5], AlertsColumns[16]},
+			},
+			{
+				Name:    "alert_parent_id",
+				Unique:  false,
+				Columns: []*schema.Column{AlertsColumns[14]},
+			},
+		},
+	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+		{Name: "actor_id", Type: field.TypeInt, Nullable: true},
+		{Name: "session_id", Type: field.TypeString, Nullable: true},
+		{Name: "entity", Type: field.TypeString},
+		{Name: "entity_id", Type: field.TypeInt},
+		{Name: "action", Type: field.TypeString},
+		{Name: "changes", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_organization_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1]},
+			},
+			{
+				Name:    "auditlog_entity_entity_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[4], AuditLogsColumns[5]},
+			},
+		},
+	}
+	// ConfirmTokensColumns holds the columns for the "confirm_tokens" table.
+	ConfirmTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// ConfirmTokensTable holds the schema information for the "confirm_tokens" table.
+	ConfirmTokensTable = &schema.Table{
+		Name:       "confirm_tokens",
+		Columns:    ConfirmTokensColumns,
+		PrimaryKey: []*schema.Column{ConfirmTokensColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "confirmtoken_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{ConfirmTokensColumns[2]},
+			},
+		},
+	}
+	// ConversationsColumns holds the columns for the "conversations" table.
+	ConversationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "chat_id", Type: field.TypeInt64, Unique: true},
+		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "dialog", Type: field.TypeString},
+		{Name: "state", Type: field.TypeString},
+		{Name: "values", Type: field.TypeJSON},
+		{Name: "history", Type: field.TypeJSON, Nullable: true},
+		{Name: "suspended", Type: field.TypeBool, Default: false},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ConversationsTable holds the schema information for the "conversations" table.
+	ConversationsTable = &schema.Table{
+		Name:       "conversations",
+		Columns:    ConversationsColumns,
+		PrimaryKey: []*schema.Column{ConversationsColumns[0]},
+	}
+	// DepartmentsColumns holds the columns for the "departments" table.
+	DepartmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+	}
+	// DepartmentsTable holds the schema information for the "departments" table.
+	DepartmentsTable = &schema.Table{
+		Name:       "departments",
+		Columns:    DepartmentsColumns,
+		PrimaryKey: []*schema.Column{DepartmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "departments_organizations_departments",
+				Columns:    []*schema.Column{DepartmentsColumns[2]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "department_organization_id",
+				Unique:  false,
+				Columns: []*schema.Column{DepartmentsColumns[2]},
+			},
+			{
+				Name:    "department_organization_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{DepartmentsColumns[2], DepartmentsColumns[1]},
+			},
+		},
+	}
 	// DiseasesColumns holds the columns for the "diseases" table.
 	DiseasesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
 		{Name: "threat", Type: field.TypeString},
 		{Name: "name", Type: field.TypeString},
 		{Name: "degree_of_danger", Type: field.TypeInt},
@@ -20,6 +202,13 @@ var (
 		Name:       "diseases",
 		Columns:    DiseasesColumns,
 		PrimaryKey: []*schema.Column{DiseasesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "disease_organization_id",
+				Unique:  false,
+				Columns: []*schema.Column{DiseasesColumns[1]},
+			},
+		},
 	}
 	// DoctorsColumns holds the columns for the "doctors" table.
 	DoctorsColumns = []*schema.Column{
@@ -28,6 +217,8 @@ var (
 		{Name: "surname", Type: field.TypeString},
 		{Name: "speciality", Type: field.TypeString},
 		{Name: "role", Type: field.TypeString},
+		{Name: "language", Type: field.TypeString, Nullable: true},
+		{Name: "on_call", Type: field.TypeBool, Default: false},
 	}
 	// DoctorsTable holds the schema information for the "doctors" table.
 	DoctorsTable = &schema.Table{
@@ -35,9 +226,52 @@ var (
 		Columns:    DoctorsColumns,
 		PrimaryKey: []*schema.Column{DoctorsColumns[0]},
 	}
+	// JobStatesColumns holds the columns for the "job_states" table.
+	JobStatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "last_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_success_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// JobStatesTable holds the schema information for the "job_states" table.
+	JobStatesTable = &schema.Table{
+		Name:       "job_states",
+		Columns:    JobStatesColumns,
+		PrimaryKey: []*schema.Column{JobStatesColumns[0]},
+	}
+	// NotificationPreferencesColumns holds the columns for the "notification_preferences" table.
+	NotificationPreferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "doctor_id", Type: field.TypeInt, Unique: true},
+		{Name: "min_severity", Type: field.TypeString},
+		{Name: "muted_kinds", Type: field.TypeJSON, Nullable: true},
+		{Name: "quiet_start", Type: field.TypeInt, Nullable: true},
+		{Name: "quiet_end", Type: field.TypeInt, Nullable: true},
+	}
+	// NotificationPreferencesTable holds the schema information for the "notification_preferences" table.
+	NotificationPreferencesTable = &schema.Table{
+		Name:       "notification_preferences",
+		Columns:    NotificationPreferencesColumns,
+		PrimaryKey: []*schema.Column{NotificationPreferencesColumns[0]},
+	}
+	// OrganizationsColumns holds the columns for the "organizations" table.
+	OrganizationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+	}
+	// OrganizationsTable holds the schema information for the "organizations" table.
+	OrganizationsTable = &schema.Table{
+		Name:       "organizations",
+		Columns:    OrganizationsColumns,
+		PrimaryKey: []*schema.Column{OrganizationsColumns[0]},
+	}
 	// PatientsColumns holds the columns for the "patients" table.
 	PatientsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
 		{Name: "surname", Type: field.TypeString},
 		{Name: "name", Type: field.TypeString},
 		{Name: "patronymic", Type: field.TypeString},
@@ -55,32 +289,201 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "patients_diseases_has",
-				Columns:    []*schema.Column{PatientsColumns[7]},
+				Columns:    []*schema.Column{PatientsColumns[8]},
 				RefColumns: []*schema.Column{DiseasesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "patients_rooms_contains",
-				Columns:    []*schema.Column{PatientsColumns[8]},
+				Columns:    []*schema.Column{PatientsColumns[9]},
 				RefColumns: []*schema.Column{RoomsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "patient_organization_id",
+				Unique:  false,
+				Columns: []*schema.Column{PatientsColumns[1]},
+			},
+		},
 	}
 	// RoomsColumns holds the columns for the "rooms" table.
 	RoomsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "number", Type: field.TypeInt, Unique: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+		{Name: "number", Type: field.TypeInt},
 		{Name: "floor", Type: field.TypeInt},
 		{Name: "number_beds", Type: field.TypeInt},
 		{Name: "number_patients", Type: field.TypeInt},
 		{Name: "type_room", Type: field.TypeString},
+		{Name: "department_id", Type: field.TypeInt, Nullable: true},
 	}
 	// RoomsTable holds the schema information for the "rooms" table.
 	RoomsTable = &schema.Table{
 		Name:       "rooms",
 		Columns:    RoomsColumns,
 		PrimaryKey: []*schema.Column{RoomsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "rooms_departments_rooms",
+				Columns:    []*schema.Column{RoomsColumns[7]},
+				RefColumns: []*schema.Column{DepartmentsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "room_organization_id",
+				Unique:  false,
+				Columns: []*schema.Column{RoomsColumns[1]},
+			},
+			{
+				Name:    "room_organization_id_number",
+				Unique:  true,
+				Columns: []*schema.Column{RoomsColumns[1], RoomsColumns[2]},
+			},
+		},
+	}
+	// SessionsColumns holds the columns for the "sessions" table.
+	SessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "session_id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_seen_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "department_id", Type: field.TypeInt, Nullable: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+		{Name: "doctor_id", Type: field.TypeInt},
+	}
+	// SessionsTable holds the schema information for the "sessions" table.
+	SessionsTable = &schema.Table{
+		Name:       "sessions",
+		Columns:    SessionsColumns,
+		PrimaryKey: []*schema.Column{SessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sessions_doctors_sessions",
+				Columns:    []*schema.Column{SessionsColumns[8]},
+				RefColumns: []*schema.Column{DoctorsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// VitalsColumns holds the columns for the "vitals" table.
+	VitalsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+		{Name: "patient_id", Type: field.TypeInt},
+		{Name: "author_id", Type: field.TypeInt, Nullable: true},
+		{Name: "pulse", Type: field.TypeInt},
+		{Name: "systolic", Type: field.TypeInt},
+		{Name: "diastolic", Type: field.TypeInt},
+		{Name: "temperature", Type: field.TypeFloat64},
+		{Name: "saturation", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// VitalsTable holds the schema information for the "vitals" table.
+	VitalsTable = &schema.Table{
+		Name:       "vitals",
+		Columns:    VitalsColumns,
+		PrimaryKey: []*schema.Column{VitalsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "vital_organization_id",
+				Unique:  false,
+				Columns: []*schema.Column{VitalsColumns[1]},
+			},
+			{
+				Name:    "vital_patient_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{VitalsColumns[2], VitalsColumns[9]},
+			},
+		},
+	}
+	// WardChatsColumns holds the columns for the "ward_chats" table.
+	WardChatsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+		{Name: "chat_id", Type: field.TypeInt64, Unique: true},
+		{Name: "title", Type: field.TypeString, Nullable: true},
+		{Name: "department_id", Type: field.TypeInt, Nullable: true},
+		{Name: "rooms", Type: field.TypeJSON, Nullable: true},
+		{Name: "language", Type: field.TypeString, Nullable: true},
+		{Name: "created_by", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// WardChatsTable holds the schema information for the "ward_chats" table.
+	WardChatsTable = &schema.Table{
+		Name:       "ward_chats",
+		Columns:    WardChatsColumns,
+		PrimaryKey: []*schema.Column{WardChatsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "wardchat_organization_id",
+				Unique:  false,
+				Columns: []*schema.Column{WardChatsColumns[1]},
+			},
+		},
+	}
+	// WardNoticesColumns holds the columns for the "ward_notices" table.
+	WardNoticesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+		{Name: "chat_id", Type: field.TypeInt64},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "severity", Type: field.TypeString},
+		{Name: "patient_id", Type: field.TypeInt},
+		{Name: "params", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeString},
+		{Name: "sent_at", Type: field.TypeTime, Nullable: true},
+		{Name: "claimed_until", Type: field.TypeTime, Nullable: true},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// WardNoticesTable holds the schema information for the "ward_notices" table.
+	WardNoticesTable = &schema.Table{
+		Name:       "ward_notices",
+		Columns:    WardNoticesColumns,
+		PrimaryKey: []*schema.Column{WardNoticesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "wardnotice_organization_id",
+				Unique:  false,
+				Columns: []*schema.Column{WardNoticesColumns[1]},
+			},
+			{
+				Name:    "wardnotice_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WardNoticesColumns[7], WardNoticesColumns[11]},
+			},
+		},
+	}
+	// DepartmentDoctorColumns holds the columns for the "department_doctor" table.
+	DepartmentDoctorColumns = []*schema.Column{
+		{Name: "department_id", Type: field.TypeInt},
+		{Name: "doctor_id", Type: field.TypeInt},
+	}
+	// DepartmentDoctorTable holds the schema information for the "department_doctor" table.
+	DepartmentDoctorTable = &schema.Table{
+		Name:       "department_doctor",
+		Columns:    DepartmentDoctorColumns,
+		PrimaryKey: []*schema.Column{DepartmentDoctorColumns[0], DepartmentDoctorColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "department_doctor_department_id",
+				Columns:    []*schema.Column{DepartmentDoctorColumns[0]},
+				RefColumns: []*schema.Column{DepartmentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "department_doctor_doctor_id",
+				Columns:    []*schema.Column{DepartmentDoctorColumns[1]},
+				RefColumns: []*schema.Column{DoctorsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
 	}
 	// DoctorPatientColumns holds the columns for the "doctor_patient" table.
 	DoctorPatientColumns = []*schema.Column{
@@ -109,17 +512,36 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AccessLogsTable,
+		AlertsTable,
+		AuditLogsTable,
+		ConfirmTokensTable,
+		ConversationsTable,
+		DepartmentsTable,
 		DiseasesTable,
 		DoctorsTable,
+		JobStatesTable,
+		NotificationPreferencesTable,
+		OrganizationsTable,
 		PatientsTable,
 		RoomsTable,
+		SessionsTable,
+		VitalsTable,
+		WardChatsTable,
+		WardNoticesTable,
+		DepartmentDoctorTable,
 		DoctorPatientTable,
 	}
 )
 
 func init() {
+	DepartmentsTable.ForeignKeys[0].RefTable = OrganizationsTable
 	PatientsTable.ForeignKeys[0].RefTable = DiseasesTable
 	PatientsTable.ForeignKeys[1].RefTable = RoomsTable
+	RoomsTable.ForeignKeys[0].RefTable = DepartmentsTable
+	SessionsTable.ForeignKeys[0].RefTable = DoctorsTable
+	DepartmentDoctorTable.ForeignKeys[0].RefTable = DepartmentsTable
+	DepartmentDoctorTable.ForeignKeys[1].RefTable = DoctorsTable
 	DoctorPatientTable.ForeignKeys[0].RefTable = DoctorsTable
 	DoctorPatientTable.ForeignKeys[1].RefTable = PatientsTable
 }
@@ -6,12 +6,27 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"hospital/internal/models/audit"
+	"hospital/internal/modules/db/ent/accesslog"
+	"hospital/internal/modules/db/ent/alert"
+	"hospital/internal/modules/db/ent/auditlog"
+	"hospital/internal/modules/db/ent/confirmtoken"
+	"hospital/internal/modules/db/ent/conversation"
+	"hospital/internal/modules/db/ent/department"
 	"hospital/internal/modules/db/ent/disease"
 	"hospital/internal/modules/db/ent/doctor"
+	"hospital/internal/modules/db/ent/jobstate"
+	"hospital/internal/modules/db/ent/notificationpreference"
+	"hospital/internal/modules/db/ent/organization"
 	"hospital/internal/modules/db/ent/patient"
 	"hospital/internal/modules/db/ent/predicate"
 	"hospital/internal/modules/db/ent/room"
+	"hospital/internal/modules/db/ent/session"
+	"hospital/internal/modules/db/ent/vital"
+	"hospital/internal/modules/db/ent/wardchat"
+	"hospital/internal/modules/db/ent/wardnotice"
 	"sync"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -26,42 +41,58 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeDisease = "Disease"
-	TypeDoctor  = "Doctor"
-	TypePatient = "Patient"
-	TypeRoom    = "Room"
+	TypeAccessLog              = "AccessLog"
+	TypeAlert                  = "Alert"
+	TypeAuditLog               = "AuditLog"
+	TypeConfirmToken           = "ConfirmToken"
+	TypeConversation           = "Conversation"
+	TypeDepartment             = "Department"
+	TypeDisease                = "Disease"
+	TypeDoctor                 = "Doctor"
+	TypeJobState               = "JobState"
+	TypeNotificationPreference = "NotificationPreference"
+	TypeOrganization           = "Organization"
+	TypePatient                = "Patient"
+	TypeRoom                   = "Room"
+	TypeSession                = "Session"
+	TypeVital                  = "Vital"
+	TypeWardChat               = "WardChat"
+	TypeWardNotice             = "WardNotice"
 )
 
-// DiseaseMutation represents an operation that mutates the Disease nodes in the graph.
-type DiseaseMutation struct {
+// AccessLogMutation represents an operation that mutates the AccessLog nodes in the graph.
+type AccessLogMutation struct {
 	config
 	op                Op
 	typ               string
 	id                *int
-	threat            *string
-	name              *string
-	degreeOfDanger    *int
-	adddegreeOfDanger *int
+	organizationId    *int
+	addorganizationId *int
+	actorId           *int
+	addactorId        *int
+	sessionId         *string
+	patientId         *int
+	addpatientId      *int
+	action            *string
+	purpose           *string
+	createdAt         *time.Time
 	clearedFields     map[string]struct{}
-	has               map[int]struct{}
-	removedhas        map[int]struct{}
-	clearedhas        bool
 	done              bool
-	oldValue          func(context.Context) (*Disease, error)
-	predicates        []predicate.Disease
+	oldValue          func(context.Context) (*AccessLog, error)
+	predicates        []predicate.AccessLog
 }
 
-var _ ent.Mutation = (*DiseaseMutation)(nil)
+var _ ent.Mutation = (*AccessLogMutation)(nil)
 
-// diseaseOption allows management of the mutation configuration using functional options.
-type diseaseOption func(*DiseaseMutation)
+// accesslogOption allows management of the mutation configuration using functional options.
+type accesslogOption func(*AccessLogMutation)
 
-// newDiseaseMutation creates new mutation for the Disease entity.
-func newDiseaseMutation(c config, op Op, opts ...diseaseOption) *DiseaseMutation {
-	m := &DiseaseMutation{
+// newAccessLogMutation creates new mutation for the AccessLog entity.
+func newAccessLogMutation(c config, op Op, opts ...accesslogOption) *AccessLogMutation {
+	m := &AccessLogMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDisease,
+		typ:           TypeAccessLog,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -70,20 +101,20 @@ func newDiseaseMutation(c config, op Op, opts ...diseaseOption) *DiseaseMutation
 	return m
 }
 
-// withDiseaseID sets the ID field of the mutation.
-func withDiseaseID(id int) diseaseOption {
-	return func(m *DiseaseMutation) {
+// withAccessLogID sets the ID field of the mutation.
+func withAccessLogID(id int) accesslogOption {
+	return func(m *AccessLogMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Disease
+			value *AccessLog
 		)
-		m.oldValue = func(ctx context.Context) (*Disease, error) {
+		m.oldValue = func(ctx context.Context) (*AccessLog, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Disease.Get(ctx, id)
+					value, err = m.Client().AccessLog.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -92,10 +123,10 @@ func withDiseaseID(id int) diseaseOption {
 	}
 }
 
-// withDisease sets the old Disease of the mutation.
-func withDisease(node *Disease) diseaseOption {
-	return func(m *DiseaseMutation) {
-		m.oldValue = func(context.Context) (*Disease, error) {
+// withAccessLog sets the old AccessLog of the mutation.
+func withAccessLog(node *AccessLog) accesslogOption {
+	return func(m *AccessLogMutation) {
+		m.oldValue = func(context.Context) (*AccessLog, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -104,7 +135,7 @@ func withDisease(node *Disease) diseaseOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DiseaseMutation) Client() *Client {
+func (m AccessLogMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -112,7 +143,7 @@ func (m DiseaseMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DiseaseMutation) Tx() (*Tx, error) {
+func (m AccessLogMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -123,7 +154,7 @@ func (m DiseaseMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DiseaseMutation) ID() (id int, exists bool) {
+func (m *AccessLogMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -134,7 +165,7 @@ func (m *DiseaseMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DiseaseMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AccessLogMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -143,203 +174,374 @@ func (m *DiseaseMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Disease.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AccessLog.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetThreat sets the "threat" field.
-func (m *DiseaseMutation) SetThreat(s string) {
-	m.threat = &s
+// SetOrganizationId sets the "organizationId" field.
+func (m *AccessLogMutation) SetOrganizationId(i int) {
+	m.organizationId = &i
+	m.addorganizationId = nil
 }
 
-// Threat returns the value of the "threat" field in the mutation.
-func (m *DiseaseMutation) Threat() (r string, exists bool) {
-	v := m.threat
+// OrganizationId returns the value of the "organizationId" field in the mutation.
+func (m *AccessLogMutation) OrganizationId() (r int, exists bool) {
+	v := m.organizationId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldThreat returns the old "threat" field's value of the Disease entity.
-// If the Disease object wasn't provided to the builder, the object is fetched from the database.
+// OldOrganizationId returns the old "organizationId" field's value of the AccessLog entity.
+// If the AccessLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiseaseMutation) OldThreat(ctx context.Context) (v string, err error) {
+func (m *AccessLogMutation) OldOrganizationId(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldThreat is only allowed on UpdateOne operations")
+		return v, errors.New("OldOrganizationId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldThreat requires an ID field in the mutation")
+		return v, errors.New("OldOrganizationId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldThreat: %w", err)
+		return v, fmt.Errorf("querying old value for OldOrganizationId: %w", err)
 	}
-	return oldValue.Threat, nil
+	return oldValue.OrganizationId, nil
 }
 
-// ResetThreat resets all changes to the "threat" field.
-func (m *DiseaseMutation) ResetThreat() {
-	m.threat = nil
+// AddOrganizationId adds i to the "organizationId" field.
+func (m *AccessLogMutation) AddOrganizationId(i int) {
+	if m.addorganizationId != nil {
+		*m.addorganizationId += i
+	} else {
+		m.addorganizationId = &i
+	}
 }
 
-// SetName sets the "name" field.
-func (m *DiseaseMutation) SetName(s string) {
-	m.name = &s
+// AddedOrganizationId returns the value that was added to the "organizationId" field in this mutation.
+func (m *AccessLogMutation) AddedOrganizationId() (r int, exists bool) {
+	v := m.addorganizationId
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *DiseaseMutation) Name() (r string, exists bool) {
-	v := m.name
+// ClearOrganizationId clears the value of the "organizationId" field.
+func (m *AccessLogMutation) ClearOrganizationId() {
+	m.organizationId = nil
+	m.addorganizationId = nil
+	m.clearedFields[accesslog.FieldOrganizationId] = struct{}{}
+}
+
+// OrganizationIdCleared returns if the "organizationId" field was cleared in this mutation.
+func (m *AccessLogMutation) OrganizationIdCleared() bool {
+	_, ok := m.clearedFields[accesslog.FieldOrganizationId]
+	return ok
+}
+
+// ResetOrganizationId resets all changes to the "organizationId" field.
+func (m *AccessLogMutation) ResetOrganizationId() {
+	m.organizationId = nil
+	m.addorganizationId = nil
+	delete(m.clearedFields, accesslog.FieldOrganizationId)
+}
+
+// SetActorId sets the "actorId" field.
+func (m *AccessLogMutation) SetActorId(i int) {
+	m.actorId = &i
+	m.addactorId = nil
+}
+
+// ActorId returns the value of the "actorId" field in the mutation.
+func (m *AccessLogMutation) ActorId() (r int, exists bool) {
+	v := m.actorId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Disease entity.
-// If the Disease object wasn't provided to the builder, the object is fetched from the database.
+// OldActorId returns the old "actorId" field's value of the AccessLog entity.
+// If the AccessLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiseaseMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *AccessLogMutation) OldActorId(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldActorId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldActorId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldActorId: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.ActorId, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *DiseaseMutation) ResetName() {
-	m.name = nil
+// AddActorId adds i to the "actorId" field.
+func (m *AccessLogMutation) AddActorId(i int) {
+	if m.addactorId != nil {
+		*m.addactorId += i
+	} else {
+		m.addactorId = &i
+	}
 }
 
-// SetDegreeOfDanger sets the "degreeOfDanger" field.
-func (m *DiseaseMutation) SetDegreeOfDanger(i int) {
-	m.degreeOfDanger = &i
-	m.adddegreeOfDanger = nil
+// AddedActorId returns the value that was added to the "actorId" field in this mutation.
+func (m *AccessLogMutation) AddedActorId() (r int, exists bool) {
+	v := m.addactorId
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// DegreeOfDanger returns the value of the "degreeOfDanger" field in the mutation.
-func (m *DiseaseMutation) DegreeOfDanger() (r int, exists bool) {
-	v := m.degreeOfDanger
+// ClearActorId clears the value of the "actorId" field.
+func (m *AccessLogMutation) ClearActorId() {
+	m.actorId = nil
+	m.addactorId = nil
+	m.clearedFields[accesslog.FieldActorId] = struct{}{}
+}
+
+// ActorIdCleared returns if the "actorId" field was cleared in this mutation.
+func (m *AccessLogMutation) ActorIdCleared() bool {
+	_, ok := m.clearedFields[accesslog.FieldActorId]
+	return ok
+}
+
+// ResetActorId resets all changes to the "actorId" field.
+func (m *AccessLogMutation) ResetActorId() {
+	m.actorId = nil
+	m.addactorId = nil
+	delete(m.clearedFields, accesslog.FieldActorId)
+}
+
+// SetSessionId sets the "sessionId" field.
+func (m *AccessLogMutation) SetSessionId(s string) {
+	m.sessionId = &s
+}
+
+// SessionId returns the value of the "sessionId" field in the mutation.
+func (m *AccessLogMutation) SessionId() (r string, exists bool) {
+	v := m.sessionId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDegreeOfDanger returns the old "degreeOfDanger" field's value of the Disease entity.
-// If the Disease object wasn't provided to the builder, the object is fetched from the database.
+// OldSessionId returns the old "sessionId" field's value of the AccessLog entity.
+// If the AccessLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiseaseMutation) OldDegreeOfDanger(ctx context.Context) (v int, err error) {
+func (m *AccessLogMutation) OldSessionId(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDegreeOfDanger is only allowed on UpdateOne operations")
+		return v, errors.New("OldSessionId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDegreeOfDanger requires an ID field in the mutation")
+		return v, errors.New("OldSessionId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDegreeOfDanger: %w", err)
+		return v, fmt.Errorf("querying old value for OldSessionId: %w", err)
 	}
-	return oldValue.DegreeOfDanger, nil
+	return oldValue.SessionId, nil
 }
 
-// AddDegreeOfDanger adds i to the "degreeOfDanger" field.
-func (m *DiseaseMutation) AddDegreeOfDanger(i int) {
-	if m.adddegreeOfDanger != nil {
-		*m.adddegreeOfDanger += i
+// ClearSessionId clears the value of the "sessionId" field.
+func (m *AccessLogMutation) ClearSessionId() {
+	m.sessionId = nil
+	m.clearedFields[accesslog.FieldSessionId] = struct{}{}
+}
+
+// SessionIdCleared returns if the "sessionId" field was cleared in this mutation.
+func (m *AccessLogMutation) SessionIdCleared() bool {
+	_, ok := m.clearedFields[accesslog.FieldSessionId]
+	return ok
+}
+
+// ResetSessionId resets all changes to the "sessionId" field.
+func (m *AccessLogMutation) ResetSessionId() {
+	m.sessionId = nil
+	delete(m.clearedFields, accesslog.FieldSessionId)
+}
+
+// SetPatientId sets the "patientId" field.
+func (m *AccessLogMutation) SetPatientId(i int) {
+	m.patientId = &i
+	m.addpatientId = nil
+}
+
+// PatientId returns the value of the "patientId" field in the mutation.
+func (m *AccessLogMutation) PatientId() (r int, exists bool) {
+	v := m.patientId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPatientId returns the old "patientId" field's value of the AccessLog entity.
+// If the AccessLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessLogMutation) OldPatientId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPatientId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPatientId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPatientId: %w", err)
+	}
+	return oldValue.PatientId, nil
+}
+
+// AddPatientId adds i to the "patientId" field.
+func (m *AccessLogMutation) AddPatientId(i int) {
+	if m.addpatientId != nil {
+		*m.addpatientId += i
 	} else {
-		m.adddegreeOfDanger = &i
+		m.addpatientId = &i
 	}
 }
 
-// AddedDegreeOfDanger returns the value that was added to the "degreeOfDanger" field in this mutation.
-func (m *DiseaseMutation) AddedDegreeOfDanger() (r int, exists bool) {
-	v := m.adddegreeOfDanger
+// AddedPatientId returns the value that was added to the "patientId" field in this mutation.
+func (m *AccessLogMutation) AddedPatientId() (r int, exists bool) {
+	v := m.addpatientId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetDegreeOfDanger resets all changes to the "degreeOfDanger" field.
-func (m *DiseaseMutation) ResetDegreeOfDanger() {
-	m.degreeOfDanger = nil
-	m.adddegreeOfDanger = nil
+// ResetPatientId resets all changes to the "patientId" field.
+func (m *AccessLogMutation) ResetPatientId() {
+	m.patientId = nil
+	m.addpatientId = nil
 }
 
-// AddHaIDs adds the "has" edge to the Patient entity by ids.
-func (m *DiseaseMutation) AddHaIDs(ids ...int) {
-	if m.has == nil {
-		m.has = make(map[int]struct{})
+// SetAction sets the "action" field.
+func (m *AccessLogMutation) SetAction(s string) {
+	m.action = &s
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *AccessLogMutation) Action() (r string, exists bool) {
+	v := m.action
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.has[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the AccessLog entity.
+// If the AccessLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessLogMutation) OldAction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
 	}
+	return oldValue.Action, nil
 }
 
-// ClearHas clears the "has" edge to the Patient entity.
-func (m *DiseaseMutation) ClearHas() {
-	m.clearedhas = true
+// ResetAction resets all changes to the "action" field.
+func (m *AccessLogMutation) ResetAction() {
+	m.action = nil
 }
 
-// HasCleared reports if the "has" edge to the Patient entity was cleared.
-func (m *DiseaseMutation) HasCleared() bool {
-	return m.clearedhas
+// SetPurpose sets the "purpose" field.
+func (m *AccessLogMutation) SetPurpose(s string) {
+	m.purpose = &s
 }
 
-// RemoveHaIDs removes the "has" edge to the Patient entity by IDs.
-func (m *DiseaseMutation) RemoveHaIDs(ids ...int) {
-	if m.removedhas == nil {
-		m.removedhas = make(map[int]struct{})
+// Purpose returns the value of the "purpose" field in the mutation.
+func (m *AccessLogMutation) Purpose() (r string, exists bool) {
+	v := m.purpose
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.has, ids[i])
-		m.removedhas[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldPurpose returns the old "purpose" field's value of the AccessLog entity.
+// If the AccessLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessLogMutation) OldPurpose(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPurpose is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPurpose requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPurpose: %w", err)
+	}
+	return oldValue.Purpose, nil
 }
 
-// RemovedHas returns the removed IDs of the "has" edge to the Patient entity.
-func (m *DiseaseMutation) RemovedHasIDs() (ids []int) {
-	for id := range m.removedhas {
-		ids = append(ids, id)
+// ResetPurpose resets all changes to the "purpose" field.
+func (m *AccessLogMutation) ResetPurpose() {
+	m.purpose = nil
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (m *AccessLogMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
+}
+
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *AccessLogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// HasIDs returns the "has" edge IDs in the mutation.
-func (m *DiseaseMutation) HasIDs() (ids []int) {
-	for id := range m.has {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "createdAt" field's value of the AccessLog entity.
+// If the AccessLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetHas resets all changes to the "has" edge.
-func (m *DiseaseMutation) ResetHas() {
-	m.has = nil
-	m.clearedhas = false
-	m.removedhas = nil
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *AccessLogMutation) ResetCreatedAt() {
+	m.createdAt = nil
 }
 
-// Where appends a list predicates to the DiseaseMutation builder.
-func (m *DiseaseMutation) Where(ps ...predicate.Disease) {
+// Where appends a list predicates to the AccessLogMutation builder.
+func (m *AccessLogMutation) Where(ps ...predicate.AccessLog) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DiseaseMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AccessLogMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DiseaseMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Disease, len(ps))
+func (m *AccessLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AccessLog, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -347,33 +549,45 @@ func (m *DiseaseMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DiseaseMutation) Op() Op {
+func (m *AccessLogMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DiseaseMutation) SetOp(op Op) {
+func (m *AccessLogMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Disease).
-func (m *DiseaseMutation) Type() string {
+// Type returns the node type of this mutation (AccessLog).
+func (m *AccessLogMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DiseaseMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.threat != nil {
-		fields = append(fields, disease.FieldThreat)
+func (m *AccessLogMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.organizationId != nil {
+		fields = append(fields, accesslog.FieldOrganizationId)
 	}
-	if m.name != nil {
-		fields = append(fields, disease.FieldName)
+	if m.actorId != nil {
+		fields = append(fields, accesslog.FieldActorId)
 	}
-	if m.degreeOfDanger != nil {
-		fields = append(fields, disease.FieldDegreeOfDanger)
+	if m.sessionId != nil {
+		fields = append(fields, accesslog.FieldSessionId)
+	}
+	if m.patientId != nil {
+		fields = append(fields, accesslog.FieldPatientId)
+	}
+	if m.action != nil {
+		fields = append(fields, accesslog.FieldAction)
+	}
+	if m.purpose != nil {
+		fields = append(fields, accesslog.FieldPurpose)
+	}
+	if m.createdAt != nil {
+		fields = append(fields, accesslog.FieldCreatedAt)
 	}
 	return fields
 }
@@ -381,14 +595,22 @@ func (m *DiseaseMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DiseaseMutation) Field(name string) (ent.Value, bool) {
+func (m *AccessLogMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case disease.FieldThreat:
-		return m.Threat()
-	case disease.FieldName:
-		return m.Name()
-	case disease.FieldDegreeOfDanger:
-		return m.DegreeOfDanger()
+	case accesslog.FieldOrganizationId:
+		return m.OrganizationId()
+	case accesslog.FieldActorId:
+		return m.ActorId()
+	case accesslog.FieldSessionId:
+		return m.SessionId()
+	case accesslog.FieldPatientId:
+		return m.PatientId()
+	case accesslog.FieldAction:
+		return m.Action()
+	case accesslog.FieldPurpose:
+		return m.Purpose()
+	case accesslog.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -396,54 +618,96 @@ func (m *DiseaseMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DiseaseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AccessLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case disease.FieldThreat:
-		return m.OldThreat(ctx)
-	case disease.FieldName:
-		return m.OldName(ctx)
-	case disease.FieldDegreeOfDanger:
-		return m.OldDegreeOfDanger(ctx)
-	}
-	return nil, fmt.Errorf("unknown Disease field %s", name)
+	case accesslog.FieldOrganizationId:
+		return m.OldOrganizationId(ctx)
+	case accesslog.FieldActorId:
+		return m.OldActorId(ctx)
+	case accesslog.FieldSessionId:
+		return m.OldSessionId(ctx)
+	case accesslog.FieldPatientId:
+		return m.OldPatientId(ctx)
+	case accesslog.FieldAction:
+		return m.OldAction(ctx)
+	case accesslog.FieldPurpose:
+		return m.OldPurpose(ctx)
+	case accesslog.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown AccessLog field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DiseaseMutation) SetField(name string, value ent.Value) error {
+func (m *AccessLogMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case disease.FieldThreat:
-		v, ok := value.(string)
+	case accesslog.FieldOrganizationId:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetThreat(v)
+		m.SetOrganizationId(v)
 		return nil
-	case disease.FieldName:
+	case accesslog.FieldActorId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActorId(v)
+		return nil
+	case accesslog.FieldSessionId:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetSessionId(v)
 		return nil
-	case disease.FieldDegreeOfDanger:
+	case accesslog.FieldPatientId:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDegreeOfDanger(v)
+		m.SetPatientId(v)
+		return nil
+	case accesslog.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case accesslog.FieldPurpose:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPurpose(v)
+		return nil
+	case accesslog.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Disease field %s", name)
+	return fmt.Errorf("unknown AccessLog field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DiseaseMutation) AddedFields() []string {
+func (m *AccessLogMutation) AddedFields() []string {
 	var fields []string
-	if m.adddegreeOfDanger != nil {
-		fields = append(fields, disease.FieldDegreeOfDanger)
+	if m.addorganizationId != nil {
+		fields = append(fields, accesslog.FieldOrganizationId)
+	}
+	if m.addactorId != nil {
+		fields = append(fields, accesslog.FieldActorId)
+	}
+	if m.addpatientId != nil {
+		fields = append(fields, accesslog.FieldPatientId)
 	}
 	return fields
 }
@@ -451,10 +715,14 @@ func (m *DiseaseMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DiseaseMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AccessLogMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case disease.FieldDegreeOfDanger:
-		return m.AddedDegreeOfDanger()
+	case accesslog.FieldOrganizationId:
+		return m.AddedOrganizationId()
+	case accesslog.FieldActorId:
+		return m.AddedActorId()
+	case accesslog.FieldPatientId:
+		return m.AddedPatientId()
 	}
 	return nil, false
 }
@@ -462,169 +730,196 @@ func (m *DiseaseMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DiseaseMutation) AddField(name string, value ent.Value) error {
+func (m *AccessLogMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case disease.FieldDegreeOfDanger:
+	case accesslog.FieldOrganizationId:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddDegreeOfDanger(v)
+		m.AddOrganizationId(v)
+		return nil
+	case accesslog.FieldActorId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddActorId(v)
+		return nil
+	case accesslog.FieldPatientId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPatientId(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Disease numeric field %s", name)
+	return fmt.Errorf("unknown AccessLog numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DiseaseMutation) ClearedFields() []string {
-	return nil
+func (m *AccessLogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(accesslog.FieldOrganizationId) {
+		fields = append(fields, accesslog.FieldOrganizationId)
+	}
+	if m.FieldCleared(accesslog.FieldActorId) {
+		fields = append(fields, accesslog.FieldActorId)
+	}
+	if m.FieldCleared(accesslog.FieldSessionId) {
+		fields = append(fields, accesslog.FieldSessionId)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DiseaseMutation) FieldCleared(name string) bool {
+func (m *AccessLogMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DiseaseMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Disease nullable field %s", name)
+func (m *AccessLogMutation) ClearField(name string) error {
+	switch name {
+	case accesslog.FieldOrganizationId:
+		m.ClearOrganizationId()
+		return nil
+	case accesslog.FieldActorId:
+		m.ClearActorId()
+		return nil
+	case accesslog.FieldSessionId:
+		m.ClearSessionId()
+		return nil
+	}
+	return fmt.Errorf("unknown AccessLog nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DiseaseMutation) ResetField(name string) error {
+func (m *AccessLogMutation) ResetField(name string) error {
 	switch name {
-	case disease.FieldThreat:
-		m.ResetThreat()
+	case accesslog.FieldOrganizationId:
+		m.ResetOrganizationId()
 		return nil
-	case disease.FieldName:
-		m.ResetName()
+	case accesslog.FieldActorId:
+		m.ResetActorId()
 		return nil
-	case disease.FieldDegreeOfDanger:
-		m.ResetDegreeOfDanger()
+	case accesslog.FieldSessionId:
+		m.ResetSessionId()
+		return nil
+	case accesslog.FieldPatientId:
+		m.ResetPatientId()
+		return nil
+	case accesslog.FieldAction:
+		m.ResetAction()
+		return nil
+	case accesslog.FieldPurpose:
+		m.ResetPurpose()
+		return nil
+	case accesslog.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Disease field %s", name)
+	return fmt.Errorf("unknown AccessLog field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DiseaseMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.has != nil {
-		edges = append(edges, disease.EdgeHas)
-	}
+func (m *AccessLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DiseaseMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case disease.EdgeHas:
-		ids := make([]ent.Value, 0, len(m.has))
-		for id := range m.has {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *AccessLogMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DiseaseMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedhas != nil {
-		edges = append(edges, disease.EdgeHas)
-	}
+func (m *AccessLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DiseaseMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case disease.EdgeHas:
-		ids := make([]ent.Value, 0, len(m.removedhas))
-		for id := range m.removedhas {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *AccessLogMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DiseaseMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedhas {
-		edges = append(edges, disease.EdgeHas)
-	}
+func (m *AccessLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DiseaseMutation) EdgeCleared(name string) bool {
-	switch name {
-	case disease.EdgeHas:
-		return m.clearedhas
-	}
+func (m *AccessLogMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DiseaseMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Disease unique edge %s", name)
+func (m *AccessLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AccessLog unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DiseaseMutation) ResetEdge(name string) error {
-	switch name {
-	case disease.EdgeHas:
-		m.ResetHas()
-		return nil
-	}
-	return fmt.Errorf("unknown Disease edge %s", name)
+func (m *AccessLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AccessLog edge %s", name)
 }
 
-// DoctorMutation represents an operation that mutates the Doctor nodes in the graph.
-type DoctorMutation struct {
+// AlertMutation represents an operation that mutates the Alert nodes in the graph.
+type AlertMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	tokenId       *string
-	surname       *string
-	speciality    *string
-	role          *string
-	clearedFields map[string]struct{}
-	treats        map[int]struct{}
-	removedtreats map[int]struct{}
-	clearedtreats bool
-	done          bool
-	oldValue      func(context.Context) (*Doctor, error)
-	predicates    []predicate.Doctor
-}
-
-var _ ent.Mutation = (*DoctorMutation)(nil)
-
-// doctorOption allows management of the mutation configuration using functional options.
-type doctorOption func(*DoctorMutation)
-
-// newDoctorMutation creates new mutation for the Doctor entity.
-func newDoctorMutation(c config, op Op, opts ...doctorOption) *DoctorMutation {
-	m := &DoctorMutation{
+	op                 Op
+	typ                string
+	id                 *int
+	organizationId     *int
+	addorganizationId  *int
+	kind               *string
+	severity           *string
+	patientId          *int
+	addpatientId       *int
+	doctorId           *int
+	adddoctorId        *int
+	params             *map[string]string
+	status             *string
+	deliverAfter       *time.Time
+	sentAt             *time.Time
+	claimedUntil       *time.Time
+	attempts           *int
+	addattempts        *int
+	ackedAt            *time.Time
+	ackedBy            *int
+	addackedBy         *int
+	parentId           *int
+	addparentId        *int
+	escalationLevel    *int
+	addescalationLevel *int
+	createdAt          *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*Alert, error)
+	predicates         []predicate.Alert
+}
+
+var _ ent.Mutation = (*AlertMutation)(nil)
+
+// alertOption allows management of the mutation configuration using functional options.
+type alertOption func(*AlertMutation)
+
+// newAlertMutation creates new mutation for the Alert entity.
+func newAlertMutation(c config, op Op, opts ...alertOption) *AlertMutation {
+	m := &AlertMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDoctor,
+		typ:           TypeAlert,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -633,20 +928,20 @@ func newDoctorMutation(c config, op Op, opts ...doctorOption) *DoctorMutation {
 	return m
 }
 
-// withDoctorID sets the ID field of the mutation.
-func withDoctorID(id int) doctorOption {
-	return func(m *DoctorMutation) {
+// withAlertID sets the ID field of the mutation.
+func withAlertID(id int) alertOption {
+	return func(m *AlertMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Doctor
+			value *Alert
 		)
-		m.oldValue = func(ctx context.Context) (*Doctor, error) {
+		m.oldValue = func(ctx context.Context) (*Alert, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Doctor.Get(ctx, id)
+					value, err = m.Client().Alert.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -655,10 +950,10 @@ func withDoctorID(id int) doctorOption {
 	}
 }
 
-// withDoctor sets the old Doctor of the mutation.
-func withDoctor(node *Doctor) doctorOption {
-	return func(m *DoctorMutation) {
-		m.oldValue = func(context.Context) (*Doctor, error) {
+// withAlert sets the old Alert of the mutation.
+func withAlert(node *Alert) alertOption {
+	return func(m *AlertMutation) {
+		m.oldValue = func(context.Context) (*Alert, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -667,7 +962,7 @@ func withDoctor(node *Doctor) doctorOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DoctorMutation) Client() *Client {
+func (m AlertMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -675,7 +970,7 @@ func (m DoctorMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DoctorMutation) Tx() (*Tx, error) {
+func (m AlertMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -686,7 +981,7 @@ func (m DoctorMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DoctorMutation) ID() (id int, exists bool) {
+func (m *AlertMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -697,7 +992,7 @@ func (m *DoctorMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DoctorMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AlertMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -706,219 +1001,11122 @@ func (m *DoctorMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Doctor.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Alert.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTokenId sets the "tokenId" field.
-func (m *DoctorMutation) SetTokenId(s string) {
-	m.tokenId = &s
+// SetOrganizationId sets the "organizationId" field.
+func (m *AlertMutation) SetOrganizationId(i int) {
+	m.organizationId = &i
+	m.addorganizationId = nil
 }
 
-// TokenId returns the value of the "tokenId" field in the mutation.
-func (m *DoctorMutation) TokenId() (r string, exists bool) {
-	v := m.tokenId
+// OrganizationId returns the value of the "organizationId" field in the mutation.
+func (m *AlertMutation) OrganizationId() (r int, exists bool) {
+	v := m.organizationId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTokenId returns the old "tokenId" field's value of the Doctor entity.
-// If the Doctor object wasn't provided to the builder, the object is fetched from the database.
+// OldOrganizationId returns the old "organizationId" field's value of the Alert entity.
+// If the Alert object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DoctorMutation) OldTokenId(ctx context.Context) (v string, err error) {
+func (m *AlertMutation) OldOrganizationId(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTokenId is only allowed on UpdateOne operations")
+		return v, errors.New("OldOrganizationId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTokenId requires an ID field in the mutation")
+		return v, errors.New("OldOrganizationId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTokenId: %w", err)
+		return v, fmt.Errorf("querying old value for OldOrganizationId: %w", err)
 	}
-	return oldValue.TokenId, nil
+	return oldValue.OrganizationId, nil
 }
 
-// ResetTokenId resets all changes to the "tokenId" field.
-func (m *DoctorMutation) ResetTokenId() {
-	m.tokenId = nil
+// AddOrganizationId adds i to the "organizationId" field.
+func (m *AlertMutation) AddOrganizationId(i int) {
+	if m.addorganizationId != nil {
+		*m.addorganizationId += i
+	} else {
+		m.addorganizationId = &i
+	}
 }
 
-// SetSurname sets the "surname" field.
-func (m *DoctorMutation) SetSurname(s string) {
-	m.surname = &s
+// AddedOrganizationId returns the value that was added to the "organizationId" field in this mutation.
+func (m *AlertMutation) AddedOrganizationId() (r int, exists bool) {
+	v := m.addorganizationId
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Surname returns the value of the "surname" field in the mutation.
-func (m *DoctorMutation) Surname() (r string, exists bool) {
-	v := m.surname
+// ClearOrganizationId clears the value of the "organizationId" field.
+func (m *AlertMutation) ClearOrganizationId() {
+	m.organizationId = nil
+	m.addorganizationId = nil
+	m.clearedFields[alert.FieldOrganizationId] = struct{}{}
+}
+
+// OrganizationIdCleared returns if the "organizationId" field was cleared in this mutation.
+func (m *AlertMutation) OrganizationIdCleared() bool {
+	_, ok := m.clearedFields[alert.FieldOrganizationId]
+	return ok
+}
+
+// ResetOrganizationId resets all changes to the "organizationId" field.
+func (m *AlertMutation) ResetOrganizationId() {
+	m.organizationId = nil
+	m.addorganizationId = nil
+	delete(m.clearedFields, alert.FieldOrganizationId)
+}
+
+// SetKind sets the "kind" field.
+func (m *AlertMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *AlertMutation) Kind() (r string, exists bool) {
+	v := m.kind
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSurname returns the old "surname" field's value of the Doctor entity.
-// If the Doctor object wasn't provided to the builder, the object is fetched from the database.
+// OldKind returns the old "kind" field's value of the Alert entity.
+// If the Alert object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DoctorMutation) OldSurname(ctx context.Context) (v string, err error) {
+func (m *AlertMutation) OldKind(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSurname is only allowed on UpdateOne operations")
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSurname requires an ID field in the mutation")
+		return v, errors.New("OldKind requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSurname: %w", err)
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
 	}
-	return oldValue.Surname, nil
+	return oldValue.Kind, nil
 }
 
-// ResetSurname resets all changes to the "surname" field.
-func (m *DoctorMutation) ResetSurname() {
-	m.surname = nil
+// ResetKind resets all changes to the "kind" field.
+func (m *AlertMutation) ResetKind() {
+	m.kind = nil
 }
 
-// SetSpeciality sets the "speciality" field.
-func (m *DoctorMutation) SetSpeciality(s string) {
-	m.speciality = &s
+// SetSeverity sets the "severity" field.
+func (m *AlertMutation) SetSeverity(s string) {
+	m.severity = &s
 }
 
-// Speciality returns the value of the "speciality" field in the mutation.
-func (m *DoctorMutation) Speciality() (r string, exists bool) {
-	v := m.speciality
+// Severity returns the value of the "severity" field in the mutation.
+func (m *AlertMutation) Severity() (r string, exists bool) {
+	v := m.severity
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSpeciality returns the old "speciality" field's value of the Doctor entity.
-// If the Doctor object wasn't provided to the builder, the object is fetched from the database.
+// OldSeverity returns the old "severity" field's value of the Alert entity.
+// If the Alert object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DoctorMutation) OldSpeciality(ctx context.Context) (v string, err error) {
+func (m *AlertMutation) OldSeverity(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSpeciality is only allowed on UpdateOne operations")
+		return v, errors.New("OldSeverity is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSpeciality requires an ID field in the mutation")
+		return v, errors.New("OldSeverity requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSpeciality: %w", err)
+		return v, fmt.Errorf("querying old value for OldSeverity: %w", err)
 	}
-	return oldValue.Speciality, nil
+	return oldValue.Severity, nil
 }
 
-// ResetSpeciality resets all changes to the "speciality" field.
-func (m *DoctorMutation) ResetSpeciality() {
-	m.speciality = nil
+// ResetSeverity resets all changes to the "severity" field.
+func (m *AlertMutation) ResetSeverity() {
+	m.severity = nil
 }
 
-// SetRole sets the "role" field.
-func (m *DoctorMutation) SetRole(s string) {
-	m.role = &s
+// SetPatientId sets the "patientId" field.
+func (m *AlertMutation) SetPatientId(i int) {
+	m.patientId = &i
+	m.addpatientId = nil
 }
 
-// Role returns the value of the "role" field in the mutation.
-func (m *DoctorMutation) Role() (r string, exists bool) {
-	v := m.role
+// PatientId returns the value of the "patientId" field in the mutation.
+func (m *AlertMutation) PatientId() (r int, exists bool) {
+	v := m.patientId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRole returns the old "role" field's value of the Doctor entity.
-// If the Doctor object wasn't provided to the builder, the object is fetched from the database.
+// OldPatientId returns the old "patientId" field's value of the Alert entity.
+// If the Alert object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DoctorMutation) OldRole(ctx context.Context) (v string, err error) {
+func (m *AlertMutation) OldPatientId(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+		return v, errors.New("OldPatientId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRole requires an ID field in the mutation")
+		return v, errors.New("OldPatientId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+		return v, fmt.Errorf("querying old value for OldPatientId: %w", err)
 	}
-	return oldValue.Role, nil
+	return oldValue.PatientId, nil
 }
 
-// ResetRole resets all changes to the "role" field.
-func (m *DoctorMutation) ResetRole() {
-	m.role = nil
+// AddPatientId adds i to the "patientId" field.
+func (m *AlertMutation) AddPatientId(i int) {
+	if m.addpatientId != nil {
+		*m.addpatientId += i
+	} else {
+		m.addpatientId = &i
+	}
 }
 
-// AddTreatIDs adds the "treats" edge to the Patient entity by ids.
-func (m *DoctorMutation) AddTreatIDs(ids ...int) {
-	if m.treats == nil {
-		m.treats = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.treats[ids[i]] = struct{}{}
+// AddedPatientId returns the value that was added to the "patientId" field in this mutation.
+func (m *AlertMutation) AddedPatientId() (r int, exists bool) {
+	v := m.addpatientId
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearTreats clears the "treats" edge to the Patient entity.
-func (m *DoctorMutation) ClearTreats() {
-	m.clearedtreats = true
+// ResetPatientId resets all changes to the "patientId" field.
+func (m *AlertMutation) ResetPatientId() {
+	m.patientId = nil
+	m.addpatientId = nil
 }
 
-// TreatsCleared reports if the "treats" edge to the Patient entity was cleared.
+// SetDoctorId sets the "doctorId" field.
+func (m *AlertMutation) SetDoctorId(i int) {
+	m.doctorId = &i
+	m.adddoctorId = nil
+}
+
+// DoctorId returns the value of the "doctorId" field in the mutation.
+func (m *AlertMutation) DoctorId() (r int, exists bool) {
+	v := m.doctorId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDoctorId returns the old "doctorId" field's value of the Alert entity.
+// If the Alert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertMutation) OldDoctorId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDoctorId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDoctorId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDoctorId: %w", err)
+	}
+	return oldValue.DoctorId, nil
+}
+
+// AddDoctorId adds i to the "doctorId" field.
+func (m *AlertMutation) AddDoctorId(i int) {
+	if m.adddoctorId != nil {
+		*m.adddoctorId += i
+	} else {
+		m.adddoctorId = &i
+	}
+}
+
+// AddedDoctorId returns the value that was added to the "doctorId" field in this mutation.
+func (m *AlertMutation) AddedDoctorId() (r int, exists bool) {
+	v := m.adddoctorId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDoctorId resets all changes to the "doctorId" field.
+func (m *AlertMutation) ResetDoctorId() {
+	m.doctorId = nil
+	m.adddoctorId = nil
+}
+
+// SetParams sets the "params" field.
+func (m *AlertMutation) SetParams(value map[string]string) {
+	m.params = &value
+}
+
+// Params returns the value of the "params" field in the mutation.
+func (m *AlertMutation) Params() (r map[string]string, exists bool) {
+	v := m.params
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParams returns the old "params" field's value of the Alert entity.
+// If the Alert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertMutation) OldParams(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParams is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParams requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParams: %w", err)
+	}
+	return oldValue.Params, nil
+}
+
+// ClearParams clears the value of the "params" field.
+func (m *AlertMutation) ClearParams() {
+	m.params = nil
+	m.clearedFields[alert.FieldParams] = struct{}{}
+}
+
+// ParamsCleared returns if the "params" field was cleared in this mutation.
+func (m *AlertMutation) ParamsCleared() bool {
+	_, ok := m.clearedFields[alert.FieldParams]
+	return ok
+}
+
+// ResetParams resets all changes to the "params" field.
+func (m *AlertMutation) ResetParams() {
+	m.params = nil
+	delete(m.clearedFields, alert.FieldParams)
+}
+
+// SetStatus sets the "status" field.
+func (m *AlertMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *AlertMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Alert entity.
+// If the Alert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *AlertMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetDeliverAfter sets the "deliverAfter" field.
+func (m *AlertMutation) SetDeliverAfter(t time.Time) {
+	m.deliverAfter = &t
+}
+
+// DeliverAfter returns the value of the "deliverAfter" field in the mutation.
+func (m *AlertMutation) DeliverAfter() (r time.Time, exists bool) {
+	v := m.deliverAfter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeliverAfter returns the old "deliverAfter" field's value of the Alert entity.
+// If the Alert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertMutation) OldDeliverAfter(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliverAfter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliverAfter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliverAfter: %w", err)
+	}
+	return oldValue.DeliverAfter, nil
+}
+
+// ClearDeliverAfter clears the value of the "deliverAfter" field.
+func (m *AlertMutation) ClearDeliverAfter() {
+	m.deliverAfter = nil
+	m.clearedFields[alert.FieldDeliverAfter] = struct{}{}
+}
+
+// DeliverAfterCleared returns if the "deliverAfter" field was cleared in this mutation.
+func (m *AlertMutation) DeliverAfterCleared() bool {
+	_, ok := m.clearedFields[alert.FieldDeliverAfter]
+	return ok
+}
+
+// ResetDeliverAfter resets all changes to the "deliverAfter" field.
+func (m *AlertMutation) ResetDeliverAfter() {
+	m.deliverAfter = nil
+	delete(m.clearedFields, alert.FieldDeliverAfter)
+}
+
+// SetSentAt sets the "sentAt" field.
+func (m *AlertMutation) SetSentAt(t time.Time) {
+	m.sentAt = &t
+}
+
+// SentAt returns the value of the "sentAt" field in the mutation.
+func (m *AlertMutation) SentAt() (r time.Time, exists bool) {
+	v := m.sentAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSentAt returns the old "sentAt" field's value of the Alert entity.
+// If the Alert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertMutation) OldSentAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSentAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSentAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSentAt: %w", err)
+	}
+	return oldValue.SentAt, nil
+}
+
+// ClearSentAt clears the value of the "sentAt" field.
+func (m *AlertMutation) ClearSentAt() {
+	m.sentAt = nil
+	m.clearedFields[alert.FieldSentAt] = struct{}{}
+}
+
+// SentAtCleared returns if the "sentAt" field was cleared in this mutation.
+func (m *AlertMutation) SentAtCleared() bool {
+	_, ok := m.clearedFields[alert.FieldSentAt]
+	return ok
+}
+
+// ResetSentAt resets all changes to the "sentAt" field.
+func (m *AlertMutation) ResetSentAt() {
+	m.sentAt = nil
+	delete(m.clearedFields, alert.FieldSentAt)
+}
+
+// SetClaimedUntil sets the "claimedUntil" field.
+func (m *AlertMutation) SetClaimedUntil(t time.Time) {
+	m.claimedUntil = &t
+}
+
+// ClaimedUntil returns the value of the "claimedUntil" field in the mutation.
+func (m *AlertMutation) ClaimedUntil() (r time.Time, exists bool) {
+	v := m.claimedUntil
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimedUntil returns the old "claimedUntil" field's value of the Alert entity.
+// If the Alert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertMutation) OldClaimedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimedUntil: %w", err)
+	}
+	return oldValue.ClaimedUntil, nil
+}
+
+// ClearClaimedUntil clears the value of the "claimedUntil" field.
+func (m *AlertMutation) ClearClaimedUntil() {
+	m.claimedUntil = nil
+	m.clearedFields[alert.FieldClaimedUntil] = struct{}{}
+}
+
+// ClaimedUntilCleared returns if the "claimedUntil" field was cleared in this mutation.
+func (m *AlertMutation) ClaimedUntilCleared() bool {
+	_, ok := m.clearedFields[alert.FieldClaimedUntil]
+	return ok
+}
+
+// ResetClaimedUntil resets all changes to the "claimedUntil" field.
+func (m *AlertMutation) ResetClaimedUntil() {
+	m.claimedUntil = nil
+	delete(m.clearedFields, alert.FieldClaimedUntil)
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *AlertMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *AlertMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the Alert entity.
+// If the Alert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *AlertMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *AlertMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *AlertMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetAckedAt sets the "ackedAt" field.
+func (m *AlertMutation) SetAckedAt(t time.Time) {
+	m.ackedAt = &t
+}
+
+// AckedAt returns the value of the "ackedAt" field in the mutation.
+func (m *AlertMutation) AckedAt() (r time.Time, exists bool) {
+	v := m.ackedAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAckedAt returns the old "ackedAt" field's value of the Alert entity.
+// If the Alert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertMutation) OldAckedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAckedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAckedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAckedAt: %w", err)
+	}
+	return oldValue.AckedAt, nil
+}
+
+// ClearAckedAt clears the value of the "ackedAt" field.
+func (m *AlertMutation) ClearAckedAt() {
+	m.ackedAt = nil
+	m.clearedFields[alert.FieldAckedAt] = struct{}{}
+}
+
+// AckedAtCleared returns if the "ackedAt" field was cleared in this mutation.
+func (m *AlertMutation) AckedAtCleared() bool {
+	_, ok := m.clearedFields[alert.FieldAckedAt]
+	return ok
+}
+
+// ResetAckedAt resets all changes to the "ackedAt" field.
+func (m *AlertMutation) ResetAckedAt() {
+	m.ackedAt = nil
+	delete(m.clearedFields, alert.FieldAckedAt)
+}
+
+// SetAckedBy sets the "ackedBy" field.
+func (m *AlertMutation) SetAckedBy(i int) {
+	m.ackedBy = &i
+	m.addackedBy = nil
+}
+
+// AckedBy returns the value of the "ackedBy" field in the mutation.
+func (m *AlertMutation) AckedBy() (r int, exists bool) {
+	v := m.ackedBy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAckedBy returns the old "ackedBy" field's value of the Alert entity.
+// If the Alert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertMutation) OldAckedBy(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAckedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAckedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAckedBy: %w", err)
+	}
+	return oldValue.AckedBy, nil
+}
+
+// AddAckedBy adds i to the "ackedBy" field.
+func (m *AlertMutation) AddAckedBy(i int) {
+	if m.addackedBy != nil {
+		*m.addackedBy += i
+	} else {
+		m.addackedBy = &i
+	}
+}
+
+// AddedAckedBy returns the value that was added to the "ackedBy" field in this mutation.
+func (m *AlertMutation) AddedAckedBy() (r int, exists bool) {
+	v := m.addackedBy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAckedBy clears the value of the "ackedBy" field.
+func (m *AlertMutation) ClearAckedBy() {
+	m.ackedBy = nil
+	m.addackedBy = nil
+	m.clearedFields[alert.FieldAckedBy] = struct{}{}
+}
+
+// AckedByCleared returns if the "ackedBy" field was cleared in this mutation.
+func (m *AlertMutation) AckedByCleared() bool {
+	_, ok := m.clearedFields[alert.FieldAckedBy]
+	return ok
+}
+
+// ResetAckedBy resets all changes to the "ackedBy" field.
+func (m *AlertMutation) ResetAckedBy() {
+	m.ackedBy = nil
+	m.addackedBy = nil
+	delete(m.clearedFields, alert.FieldAckedBy)
+}
+
+// SetParentId sets the "parentId" field.
+func (m *AlertMutation) SetParentId(i int) {
+	m.parentId = &i
+	m.addparentId = nil
+}
+
+// ParentId returns the value of the "parentId" field in the mutation.
+func (m *AlertMutation) ParentId() (r int, exists bool) {
+	v := m.parentId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentId returns the old "parentId" field's value of the Alert entity.
+// If the Alert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertMutation) OldParentId(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentId: %w", err)
+	}
+	return oldValue.ParentId, nil
+}
+
+// AddParentId adds i to the "parentId" field.
+func (m *AlertMutation) AddParentId(i int) {
+	if m.addparentId != nil {
+		*m.addparentId += i
+	} else {
+		m.addparentId = &i
+	}
+}
+
+// AddedParentId returns the value that was added to the "parentId" field in this mutation.
+func (m *AlertMutation) AddedParentId() (r int, exists bool) {
+	v := m.addparentId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearParentId clears the value of the "parentId" field.
+func (m *AlertMutation) ClearParentId() {
+	m.parentId = nil
+	m.addparentId = nil
+	m.clearedFields[alert.FieldParentId] = struct{}{}
+}
+
+// ParentIdCleared returns if the "parentId" field was cleared in this mutation.
+func (m *AlertMutation) ParentIdCleared() bool {
+	_, ok := m.clearedFields[alert.FieldParentId]
+	return ok
+}
+
+// ResetParentId resets all changes to the "parentId" field.
+func (m *AlertMutation) ResetParentId() {
+	m.parentId = nil
+	m.addparentId = nil
+	delete(m.clearedFields, alert.FieldParentId)
+}
+
+// SetEscalationLevel sets the "escalationLevel" field.
+func (m *AlertMutation) SetEscalationLevel(i int) {
+	m.escalationLevel = &i
+	m.addescalationLevel = nil
+}
+
+// EscalationLevel returns the value of the "escalationLevel" field in the mutation.
+func (m *AlertMutation) EscalationLevel() (r int, exists bool) {
+	v := m.escalationLevel
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEscalationLevel returns the old "escalationLevel" field's value of the Alert entity.
+// If the Alert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertMutation) OldEscalationLevel(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEscalationLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEscalationLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEscalationLevel: %w", err)
+	}
+	return oldValue.EscalationLevel, nil
+}
+
+// AddEscalationLevel adds i to the "escalationLevel" field.
+func (m *AlertMutation) AddEscalationLevel(i int) {
+	if m.addescalationLevel != nil {
+		*m.addescalationLevel += i
+	} else {
+		m.addescalationLevel = &i
+	}
+}
+
+// AddedEscalationLevel returns the value that was added to the "escalationLevel" field in this mutation.
+func (m *AlertMutation) AddedEscalationLevel() (r int, exists bool) {
+	v := m.addescalationLevel
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEscalationLevel resets all changes to the "escalationLevel" field.
+func (m *AlertMutation) ResetEscalationLevel() {
+	m.escalationLevel = nil
+	m.addescalationLevel = nil
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (m *AlertMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
+}
+
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *AlertMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "createdAt" field's value of the Alert entity.
+// If the Alert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *AlertMutation) ResetCreatedAt() {
+	m.createdAt = nil
+}
+
+// Where appends a list predicates to the AlertMutation builder.
+func (m *AlertMutation) Where(ps ...predicate.Alert) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AlertMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AlertMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Alert, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AlertMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AlertMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Alert).
+func (m *AlertMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AlertMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.organizationId != nil {
+		fields = append(fields, alert.FieldOrganizationId)
+	}
+	if m.kind != nil {
+		fields = append(fields, alert.FieldKind)
+	}
+	if m.severity != nil {
+		fields = append(fields, alert.FieldSeverity)
+	}
+	if m.patientId != nil {
+		fields = append(fields, alert.FieldPatientId)
+	}
+	if m.doctorId != nil {
+		fields = append(fields, alert.FieldDoctorId)
+	}
+	if m.params != nil {
+		fields = append(fields, alert.FieldParams)
+	}
+	if m.status != nil {
+		fields = append(fields, alert.FieldStatus)
+	}
+	if m.deliverAfter != nil {
+		fields = append(fields, alert.FieldDeliverAfter)
+	}
+	if m.sentAt != nil {
+		fields = append(fields, alert.FieldSentAt)
+	}
+	if m.claimedUntil != nil {
+		fields = append(fields, alert.FieldClaimedUntil)
+	}
+	if m.attempts != nil {
+		fields = append(fields, alert.FieldAttempts)
+	}
+	if m.ackedAt != nil {
+		fields = append(fields, alert.FieldAckedAt)
+	}
+	if m.ackedBy != nil {
+		fields = append(fields, alert.FieldAckedBy)
+	}
+	if m.parentId != nil {
+		fields = append(fields, alert.FieldParentId)
+	}
+	if m.escalationLevel != nil {
+		fields = append(fields, alert.FieldEscalationLevel)
+	}
+	if m.createdAt != nil {
+		fields = append(fields, alert.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AlertMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case alert.FieldOrganizationId:
+		return m.OrganizationId()
+	case alert.FieldKind:
+		return m.Kind()
+	case alert.FieldSeverity:
+		return m.Severity()
+	case alert.FieldPatientId:
+		return m.PatientId()
+	case alert.FieldDoctorId:
+		return m.DoctorId()
+	case alert.FieldParams:
+		return m.Params()
+	case alert.FieldStatus:
+		return m.Status()
+	case alert.FieldDeliverAfter:
+		return m.DeliverAfter()
+	case alert.FieldSentAt:
+		return m.SentAt()
+	case alert.FieldClaimedUntil:
+		return m.ClaimedUntil()
+	case alert.FieldAttempts:
+		return m.Attempts()
+	case alert.FieldAckedAt:
+		return m.AckedAt()
+	case alert.FieldAckedBy:
+		return m.AckedBy()
+	case alert.FieldParentId:
+		return m.ParentId()
+	case alert.FieldEscalationLevel:
+		return m.EscalationLevel()
+	case alert.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AlertMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case alert.FieldOrganizationId:
+		return m.OldOrganizationId(ctx)
+	case alert.FieldKind:
+		return m.OldKind(ctx)
+	case alert.FieldSeverity:
+		return m.OldSeverity(ctx)
+	case alert.FieldPatientId:
+		return m.OldPatientId(ctx)
+	case alert.FieldDoctorId:
+		return m.OldDoctorId(ctx)
+	case alert.FieldParams:
+		return m.OldParams(ctx)
+	case alert.FieldStatus:
+		return m.OldStatus(ctx)
+	case alert.FieldDeliverAfter:
+		return m.OldDeliverAfter(ctx)
+	case alert.FieldSentAt:
+		return m.OldSentAt(ctx)
+	case alert.FieldClaimedUntil:
+		return m.OldClaimedUntil(ctx)
+	case alert.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case alert.FieldAckedAt:
+		return m.OldAckedAt(ctx)
+	case alert.FieldAckedBy:
+		return m.OldAckedBy(ctx)
+	case alert.FieldParentId:
+		return m.OldParentId(ctx)
+	case alert.FieldEscalationLevel:
+		return m.OldEscalationLevel(ctx)
+	case alert.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Alert field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AlertMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case alert.FieldOrganizationId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationId(v)
+		return nil
+	case alert.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case alert.FieldSeverity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeverity(v)
+		return nil
+	case alert.FieldPatientId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPatientId(v)
+		return nil
+	case alert.FieldDoctorId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDoctorId(v)
+		return nil
+	case alert.FieldParams:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParams(v)
+		return nil
+	case alert.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case alert.FieldDeliverAfter:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliverAfter(v)
+		return nil
+	case alert.FieldSentAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSentAt(v)
+		return nil
+	case alert.FieldClaimedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimedUntil(v)
+		return nil
+	case alert.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case alert.FieldAckedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAckedAt(v)
+		return nil
+	case alert.FieldAckedBy:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAckedBy(v)
+		return nil
+	case alert.FieldParentId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentId(v)
+		return nil
+	case alert.FieldEscalationLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEscalationLevel(v)
+		return nil
+	case alert.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Alert field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AlertMutation) AddedFields() []string {
+	var fields []string
+	if m.addorganizationId != nil {
+		fields = append(fields, alert.FieldOrganizationId)
+	}
+	if m.addpatientId != nil {
+		fields = append(fields, alert.FieldPatientId)
+	}
+	if m.adddoctorId != nil {
+		fields = append(fields, alert.FieldDoctorId)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, alert.FieldAttempts)
+	}
+	if m.addackedBy != nil {
+		fields = append(fields, alert.FieldAckedBy)
+	}
+	if m.addparentId != nil {
+		fields = append(fields, alert.FieldParentId)
+	}
+	if m.addescalationLevel != nil {
+		fields = append(fields, alert.FieldEscalationLevel)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AlertMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case alert.FieldOrganizationId:
+		return m.AddedOrganizationId()
+	case alert.FieldPatientId:
+		return m.AddedPatientId()
+	case alert.FieldDoctorId:
+		return m.AddedDoctorId()
+	case alert.FieldAttempts:
+		return m.AddedAttempts()
+	case alert.FieldAckedBy:
+		return m.AddedAckedBy()
+	case alert.FieldParentId:
+		return m.AddedParentId()
+	case alert.FieldEscalationLevel:
+		return m.AddedEscalationLevel()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AlertMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case alert.FieldOrganizationId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOrganizationId(v)
+		return nil
+	case alert.FieldPatientId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPatientId(v)
+		return nil
+	case alert.FieldDoctorId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDoctorId(v)
+		return nil
+	case alert.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case alert.FieldAckedBy:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAckedBy(v)
+		return nil
+	case alert.FieldParentId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddParentId(v)
+		return nil
+	case alert.FieldEscalationLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEscalationLevel(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Alert numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AlertMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(alert.FieldOrganizationId) {
+		fields = append(fields, alert.FieldOrganizationId)
+	}
+	if m.FieldCleared(alert.FieldParams) {
+		fields = append(fields, alert.FieldParams)
+	}
+	if m.FieldCleared(alert.FieldDeliverAfter) {
+		fields = append(fields, alert.FieldDeliverAfter)
+	}
+	if m.FieldCleared(alert.FieldSentAt) {
+		fields = append(fields, alert.FieldSentAt)
+	}
+	if m.FieldCleared(alert.FieldClaimedUntil) {
+		fields = append(fields, alert.FieldClaimedUntil)
+	}
+	if m.FieldCleared(alert.FieldAckedAt) {
+		fields = append(fields, alert.FieldAckedAt)
+	}
+	if m.FieldCleared(alert.FieldAckedBy) {
+		fields = append(fields, alert.FieldAckedBy)
+	}
+	if m.FieldCleared(alert.FieldParentId) {
+		fields = append(fields, alert.FieldParentId)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AlertMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AlertMutation) ClearField(name string) error {
+	switch name {
+	case alert.FieldOrganizationId:
+		m.ClearOrganizationId()
+		return nil
+	case alert.FieldParams:
+		m.ClearParams()
+		return nil
+	case alert.FieldDeliverAfter:
+		m.ClearDeliverAfter()
+		return nil
+	case alert.FieldSentAt:
+		m.ClearSentAt()
+		return nil
+	case alert.FieldClaimedUntil:
+		m.ClearClaimedUntil()
+		return nil
+	case alert.FieldAckedAt:
+		m.ClearAckedAt()
+		return nil
+	case alert.FieldAckedBy:
+		m.ClearAckedBy()
+		return nil
+	case alert.FieldParentId:
+		m.ClearParentId()
+		return nil
+	}
+	return fmt.Errorf("unknown Alert nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AlertMutation) ResetField(name string) error {
+	switch name {
+	case alert.FieldOrganizationId:
+		m.ResetOrganizationId()
+		return nil
+	case alert.FieldKind:
+		m.ResetKind()
+		return nil
+	case alert.FieldSeverity:
+		m.ResetSeverity()
+		return nil
+	case alert.FieldPatientId:
+		m.ResetPatientId()
+		return nil
+	case alert.FieldDoctorId:
+		m.ResetDoctorId()
+		return nil
+	case alert.FieldParams:
+		m.ResetParams()
+		return nil
+	case alert.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case alert.FieldDeliverAfter:
+		m.ResetDeliverAfter()
+		return nil
+	case alert.FieldSentAt:
+		m.ResetSentAt()
+		return nil
+	case alert.FieldClaimedUntil:
+		m.ResetClaimedUntil()
+		return nil
+	case alert.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case alert.FieldAckedAt:
+		m.ResetAckedAt()
+		return nil
+	case alert.FieldAckedBy:
+		m.ResetAckedBy()
+		return nil
+	case alert.FieldParentId:
+		m.ResetParentId()
+		return nil
+	case alert.FieldEscalationLevel:
+		m.ResetEscalationLevel()
+		return nil
+	case alert.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Alert field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AlertMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AlertMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AlertMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AlertMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AlertMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AlertMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AlertMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Alert unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AlertMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Alert edge %s", name)
+}
+
+// AuditLogMutation represents an operation that mutates the AuditLog nodes in the graph.
+type AuditLogMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	organizationId    *int
+	addorganizationId *int
+	actorId           *int
+	addactorId        *int
+	sessionId         *string
+	entity            *string
+	entityId          *int
+	addentityId       *int
+	action            *string
+	changes           *audit.Changes
+	createdAt         *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*AuditLog, error)
+	predicates        []predicate.AuditLog
+}
+
+var _ ent.Mutation = (*AuditLogMutation)(nil)
+
+// auditlogOption allows management of the mutation configuration using functional options.
+type auditlogOption func(*AuditLogMutation)
+
+// newAuditLogMutation creates new mutation for the AuditLog entity.
+func newAuditLogMutation(c config, op Op, opts ...auditlogOption) *AuditLogMutation {
+	m := &AuditLogMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAuditLog,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAuditLogID sets the ID field of the mutation.
+func withAuditLogID(id int) auditlogOption {
+	return func(m *AuditLogMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AuditLog
+		)
+		m.oldValue = func(ctx context.Context) (*AuditLog, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AuditLog.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAuditLog sets the old AuditLog of the mutation.
+func withAuditLog(node *AuditLog) auditlogOption {
+	return func(m *AuditLogMutation) {
+		m.oldValue = func(context.Context) (*AuditLog, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AuditLogMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AuditLogMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AuditLogMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AuditLogMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().AuditLog.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (m *AuditLogMutation) SetOrganizationId(i int) {
+	m.organizationId = &i
+	m.addorganizationId = nil
+}
+
+// OrganizationId returns the value of the "organizationId" field in the mutation.
+func (m *AuditLogMutation) OrganizationId() (r int, exists bool) {
+	v := m.organizationId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationId returns the old "organizationId" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldOrganizationId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrganizationId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrganizationId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationId: %w", err)
+	}
+	return oldValue.OrganizationId, nil
+}
+
+// AddOrganizationId adds i to the "organizationId" field.
+func (m *AuditLogMutation) AddOrganizationId(i int) {
+	if m.addorganizationId != nil {
+		*m.addorganizationId += i
+	} else {
+		m.addorganizationId = &i
+	}
+}
+
+// AddedOrganizationId returns the value that was added to the "organizationId" field in this mutation.
+func (m *AuditLogMutation) AddedOrganizationId() (r int, exists bool) {
+	v := m.addorganizationId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOrganizationId clears the value of the "organizationId" field.
+func (m *AuditLogMutation) ClearOrganizationId() {
+	m.organizationId = nil
+	m.addorganizationId = nil
+	m.clearedFields[auditlog.FieldOrganizationId] = struct{}{}
+}
+
+// OrganizationIdCleared returns if the "organizationId" field was cleared in this mutation.
+func (m *AuditLogMutation) OrganizationIdCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldOrganizationId]
+	return ok
+}
+
+// ResetOrganizationId resets all changes to the "organizationId" field.
+func (m *AuditLogMutation) ResetOrganizationId() {
+	m.organizationId = nil
+	m.addorganizationId = nil
+	delete(m.clearedFields, auditlog.FieldOrganizationId)
+}
+
+// SetActorId sets the "actorId" field.
+func (m *AuditLogMutation) SetActorId(i int) {
+	m.actorId = &i
+	m.addactorId = nil
+}
+
+// ActorId returns the value of the "actorId" field in the mutation.
+func (m *AuditLogMutation) ActorId() (r int, exists bool) {
+	v := m.actorId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActorId returns the old "actorId" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldActorId(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActorId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActorId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActorId: %w", err)
+	}
+	return oldValue.ActorId, nil
+}
+
+// AddActorId adds i to the "actorId" field.
+func (m *AuditLogMutation) AddActorId(i int) {
+	if m.addactorId != nil {
+		*m.addactorId += i
+	} else {
+		m.addactorId = &i
+	}
+}
+
+// AddedActorId returns the value that was added to the "actorId" field in this mutation.
+func (m *AuditLogMutation) AddedActorId() (r int, exists bool) {
+	v := m.addactorId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearActorId clears the value of the "actorId" field.
+func (m *AuditLogMutation) ClearActorId() {
+	m.actorId = nil
+	m.addactorId = nil
+	m.clearedFields[auditlog.FieldActorId] = struct{}{}
+}
+
+// ActorIdCleared returns if the "actorId" field was cleared in this mutation.
+func (m *AuditLogMutation) ActorIdCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldActorId]
+	return ok
+}
+
+// ResetActorId resets all changes to the "actorId" field.
+func (m *AuditLogMutation) ResetActorId() {
+	m.actorId = nil
+	m.addactorId = nil
+	delete(m.clearedFields, auditlog.FieldActorId)
+}
+
+// SetSessionId sets the "sessionId" field.
+func (m *AuditLogMutation) SetSessionId(s string) {
+	m.sessionId = &s
+}
+
+// SessionId returns the value of the "sessionId" field in the mutation.
+func (m *AuditLogMutation) SessionId() (r string, exists bool) {
+	v := m.sessionId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionId returns the old "sessionId" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldSessionId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionId: %w", err)
+	}
+	return oldValue.SessionId, nil
+}
+
+// ClearSessionId clears the value of the "sessionId" field.
+func (m *AuditLogMutation) ClearSessionId() {
+	m.sessionId = nil
+	m.clearedFields[auditlog.FieldSessionId] = struct{}{}
+}
+
+// SessionIdCleared returns if the "sessionId" field was cleared in this mutation.
+func (m *AuditLogMutation) SessionIdCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldSessionId]
+	return ok
+}
+
+// ResetSessionId resets all changes to the "sessionId" field.
+func (m *AuditLogMutation) ResetSessionId() {
+	m.sessionId = nil
+	delete(m.clearedFields, auditlog.FieldSessionId)
+}
+
+// SetEntity sets the "entity" field.
+func (m *AuditLogMutation) SetEntity(s string) {
+	m.entity = &s
+}
+
+// Entity returns the value of the "entity" field in the mutation.
+func (m *AuditLogMutation) Entity() (r string, exists bool) {
+	v := m.entity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntity returns the old "entity" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldEntity(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntity: %w", err)
+	}
+	return oldValue.Entity, nil
+}
+
+// ResetEntity resets all changes to the "entity" field.
+func (m *AuditLogMutation) ResetEntity() {
+	m.entity = nil
+}
+
+// SetEntityId sets the "entityId" field.
+func (m *AuditLogMutation) SetEntityId(i int) {
+	m.entityId = &i
+	m.addentityId = nil
+}
+
+// EntityId returns the value of the "entityId" field in the mutation.
+func (m *AuditLogMutation) EntityId() (r int, exists bool) {
+	v := m.entityId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntityId returns the old "entityId" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldEntityId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntityId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntityId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntityId: %w", err)
+	}
+	return oldValue.EntityId, nil
+}
+
+// AddEntityId adds i to the "entityId" field.
+func (m *AuditLogMutation) AddEntityId(i int) {
+	if m.addentityId != nil {
+		*m.addentityId += i
+	} else {
+		m.addentityId = &i
+	}
+}
+
+// AddedEntityId returns the value that was added to the "entityId" field in this mutation.
+func (m *AuditLogMutation) AddedEntityId() (r int, exists bool) {
+	v := m.addentityId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEntityId resets all changes to the "entityId" field.
+func (m *AuditLogMutation) ResetEntityId() {
+	m.entityId = nil
+	m.addentityId = nil
+}
+
+// SetAction sets the "action" field.
+func (m *AuditLogMutation) SetAction(s string) {
+	m.action = &s
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *AuditLogMutation) Action() (r string, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldAction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *AuditLogMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetChanges sets the "changes" field.
+func (m *AuditLogMutation) SetChanges(a audit.Changes) {
+	m.changes = &a
+}
+
+// Changes returns the value of the "changes" field in the mutation.
+func (m *AuditLogMutation) Changes() (r audit.Changes, exists bool) {
+	v := m.changes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChanges returns the old "changes" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldChanges(ctx context.Context) (v audit.Changes, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChanges is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChanges requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChanges: %w", err)
+	}
+	return oldValue.Changes, nil
+}
+
+// ResetChanges resets all changes to the "changes" field.
+func (m *AuditLogMutation) ResetChanges() {
+	m.changes = nil
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (m *AuditLogMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
+}
+
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *AuditLogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "createdAt" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *AuditLogMutation) ResetCreatedAt() {
+	m.createdAt = nil
+}
+
+// Where appends a list predicates to the AuditLogMutation builder.
+func (m *AuditLogMutation) Where(ps ...predicate.AuditLog) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AuditLogMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuditLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditLog, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AuditLogMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AuditLogMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AuditLog).
+func (m *AuditLogMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuditLogMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.organizationId != nil {
+		fields = append(fields, auditlog.FieldOrganizationId)
+	}
+	if m.actorId != nil {
+		fields = append(fields, auditlog.FieldActorId)
+	}
+	if m.sessionId != nil {
+		fields = append(fields, auditlog.FieldSessionId)
+	}
+	if m.entity != nil {
+		fields = append(fields, auditlog.FieldEntity)
+	}
+	if m.entityId != nil {
+		fields = append(fields, auditlog.FieldEntityId)
+	}
+	if m.action != nil {
+		fields = append(fields, auditlog.FieldAction)
+	}
+	if m.changes != nil {
+		fields = append(fields, auditlog.FieldChanges)
+	}
+	if m.createdAt != nil {
+		fields = append(fields, auditlog.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuditLogMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case auditlog.FieldOrganizationId:
+		return m.OrganizationId()
+	case auditlog.FieldActorId:
+		return m.ActorId()
+	case auditlog.FieldSessionId:
+		return m.SessionId()
+	case auditlog.FieldEntity:
+		return m.Entity()
+	case auditlog.FieldEntityId:
+		return m.EntityId()
+	case auditlog.FieldAction:
+		return m.Action()
+	case auditlog.FieldChanges:
+		return m.Changes()
+	case auditlog.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuditLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case auditlog.FieldOrganizationId:
+		return m.OldOrganizationId(ctx)
+	case auditlog.FieldActorId:
+		return m.OldActorId(ctx)
+	case auditlog.FieldSessionId:
+		return m.OldSessionId(ctx)
+	case auditlog.FieldEntity:
+		return m.OldEntity(ctx)
+	case auditlog.FieldEntityId:
+		return m.OldEntityId(ctx)
+	case auditlog.FieldAction:
+		return m.OldAction(ctx)
+	case auditlog.FieldChanges:
+		return m.OldChanges(ctx)
+	case auditlog.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuditLog field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditLogMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case auditlog.FieldOrganizationId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationId(v)
+		return nil
+	case auditlog.FieldActorId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActorId(v)
+		return nil
+	case auditlog.FieldSessionId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionId(v)
+		return nil
+	case auditlog.FieldEntity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntity(v)
+		return nil
+	case auditlog.FieldEntityId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntityId(v)
+		return nil
+	case auditlog.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case auditlog.FieldChanges:
+		v, ok := value.(audit.Changes)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChanges(v)
+		return nil
+	case auditlog.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuditLogMutation) AddedFields() []string {
+	var fields []string
+	if m.addorganizationId != nil {
+		fields = append(fields, auditlog.FieldOrganizationId)
+	}
+	if m.addactorId != nil {
+		fields = append(fields, auditlog.FieldActorId)
+	}
+	if m.addentityId != nil {
+		fields = append(fields, auditlog.FieldEntityId)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuditLogMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case auditlog.FieldOrganizationId:
+		return m.AddedOrganizationId()
+	case auditlog.FieldActorId:
+		return m.AddedActorId()
+	case auditlog.FieldEntityId:
+		return m.AddedEntityId()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditLogMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case auditlog.FieldOrganizationId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOrganizationId(v)
+		return nil
+	case auditlog.FieldActorId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddActorId(v)
+		return nil
+	case auditlog.FieldEntityId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEntityId(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuditLogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(auditlog.FieldOrganizationId) {
+		fields = append(fields, auditlog.FieldOrganizationId)
+	}
+	if m.FieldCleared(auditlog.FieldActorId) {
+		fields = append(fields, auditlog.FieldActorId)
+	}
+	if m.FieldCleared(auditlog.FieldSessionId) {
+		fields = append(fields, auditlog.FieldSessionId)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuditLogMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuditLogMutation) ClearField(name string) error {
+	switch name {
+	case auditlog.FieldOrganizationId:
+		m.ClearOrganizationId()
+		return nil
+	case auditlog.FieldActorId:
+		m.ClearActorId()
+		return nil
+	case auditlog.FieldSessionId:
+		m.ClearSessionId()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuditLogMutation) ResetField(name string) error {
+	switch name {
+	case auditlog.FieldOrganizationId:
+		m.ResetOrganizationId()
+		return nil
+	case auditlog.FieldActorId:
+		m.ResetActorId()
+		return nil
+	case auditlog.FieldSessionId:
+		m.ResetSessionId()
+		return nil
+	case auditlog.FieldEntity:
+		m.ResetEntity()
+		return nil
+	case auditlog.FieldEntityId:
+		m.ResetEntityId()
+		return nil
+	case auditlog.FieldAction:
+		m.ResetAction()
+		return nil
+	case auditlog.FieldChanges:
+		m.ResetChanges()
+		return nil
+	case auditlog.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuditLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuditLogMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuditLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuditLogMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuditLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuditLogMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuditLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuditLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog edge %s", name)
+}
+
+// ConfirmTokenMutation represents an operation that mutates the ConfirmToken nodes in the graph.
+type ConfirmTokenMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	key           *string
+	expiresAt     *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ConfirmToken, error)
+	predicates    []predicate.ConfirmToken
+}
+
+var _ ent.Mutation = (*ConfirmTokenMutation)(nil)
+
+// confirmtokenOption allows management of the mutation configuration using functional options.
+type confirmtokenOption func(*ConfirmTokenMutation)
+
+// newConfirmTokenMutation creates new mutation for the ConfirmToken entity.
+func newConfirmTokenMutation(c config, op Op, opts ...confirmtokenOption) *ConfirmTokenMutation {
+	m := &ConfirmTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeConfirmToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withConfirmTokenID sets the ID field of the mutation.
+func withConfirmTokenID(id int) confirmtokenOption {
+	return func(m *ConfirmTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ConfirmToken
+		)
+		m.oldValue = func(ctx context.Context) (*ConfirmToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ConfirmToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withConfirmToken sets the old ConfirmToken of the mutation.
+func withConfirmToken(node *ConfirmToken) confirmtokenOption {
+	return func(m *ConfirmTokenMutation) {
+		m.oldValue = func(context.Context) (*ConfirmToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ConfirmTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ConfirmTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ConfirmTokenMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ConfirmTokenMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ConfirmToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKey sets the "key" field.
+func (m *ConfirmTokenMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *ConfirmTokenMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the ConfirmToken entity.
+// If the ConfirmToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfirmTokenMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *ConfirmTokenMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetExpiresAt sets the "expiresAt" field.
+func (m *ConfirmTokenMutation) SetExpiresAt(t time.Time) {
+	m.expiresAt = &t
+}
+
+// ExpiresAt returns the value of the "expiresAt" field in the mutation.
+func (m *ConfirmTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expiresAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expiresAt" field's value of the ConfirmToken entity.
+// If the ConfirmToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfirmTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expiresAt" field.
+func (m *ConfirmTokenMutation) ResetExpiresAt() {
+	m.expiresAt = nil
+}
+
+// Where appends a list predicates to the ConfirmTokenMutation builder.
+func (m *ConfirmTokenMutation) Where(ps ...predicate.ConfirmToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ConfirmTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ConfirmTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ConfirmToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ConfirmTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ConfirmTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ConfirmToken).
+func (m *ConfirmTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ConfirmTokenMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.key != nil {
+		fields = append(fields, confirmtoken.FieldKey)
+	}
+	if m.expiresAt != nil {
+		fields = append(fields, confirmtoken.FieldExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ConfirmTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case confirmtoken.FieldKey:
+		return m.Key()
+	case confirmtoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ConfirmTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case confirmtoken.FieldKey:
+		return m.OldKey(ctx)
+	case confirmtoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ConfirmToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConfirmTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case confirmtoken.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case confirmtoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ConfirmToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ConfirmTokenMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ConfirmTokenMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConfirmTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ConfirmToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ConfirmTokenMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ConfirmTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ConfirmTokenMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ConfirmToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ConfirmTokenMutation) ResetField(name string) error {
+	switch name {
+	case confirmtoken.FieldKey:
+		m.ResetKey()
+		return nil
+	case confirmtoken.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ConfirmToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ConfirmTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ConfirmTokenMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ConfirmTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ConfirmTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ConfirmTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ConfirmTokenMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ConfirmTokenMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ConfirmToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ConfirmTokenMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ConfirmToken edge %s", name)
+}
+
+// ConversationMutation represents an operation that mutates the Conversation nodes in the graph.
+type ConversationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	chatId        *int64
+	addchatId     *int64
+	userId        *int64
+	adduserId     *int64
+	dialog        *string
+	state         *string
+	values        *map[string]string
+	history       *[]string
+	appendhistory []string
+	suspended     *bool
+	updatedAt     *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Conversation, error)
+	predicates    []predicate.Conversation
+}
+
+var _ ent.Mutation = (*ConversationMutation)(nil)
+
+// conversationOption allows management of the mutation configuration using functional options.
+type conversationOption func(*ConversationMutation)
+
+// newConversationMutation creates new mutation for the Conversation entity.
+func newConversationMutation(c config, op Op, opts ...conversationOption) *ConversationMutation {
+	m := &ConversationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeConversation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withConversationID sets the ID field of the mutation.
+func withConversationID(id int) conversationOption {
+	return func(m *ConversationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Conversation
+		)
+		m.oldValue = func(ctx context.Context) (*Conversation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Conversation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withConversation sets the old Conversation of the mutation.
+func withConversation(node *Conversation) conversationOption {
+	return func(m *ConversationMutation) {
+		m.oldValue = func(context.Context) (*Conversation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ConversationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ConversationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ConversationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ConversationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Conversation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetChatId sets the "chatId" field.
+func (m *ConversationMutation) SetChatId(i int64) {
+	m.chatId = &i
+	m.addchatId = nil
+}
+
+// ChatId returns the value of the "chatId" field in the mutation.
+func (m *ConversationMutation) ChatId() (r int64, exists bool) {
+	v := m.chatId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatId returns the old "chatId" field's value of the Conversation entity.
+// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationMutation) OldChatId(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatId: %w", err)
+	}
+	return oldValue.ChatId, nil
+}
+
+// AddChatId adds i to the "chatId" field.
+func (m *ConversationMutation) AddChatId(i int64) {
+	if m.addchatId != nil {
+		*m.addchatId += i
+	} else {
+		m.addchatId = &i
+	}
+}
+
+// AddedChatId returns the value that was added to the "chatId" field in this mutation.
+func (m *ConversationMutation) AddedChatId() (r int64, exists bool) {
+	v := m.addchatId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChatId resets all changes to the "chatId" field.
+func (m *ConversationMutation) ResetChatId() {
+	m.chatId = nil
+	m.addchatId = nil
+}
+
+// SetUserId sets the "userId" field.
+func (m *ConversationMutation) SetUserId(i int64) {
+	m.userId = &i
+	m.adduserId = nil
+}
+
+// UserId returns the value of the "userId" field in the mutation.
+func (m *ConversationMutation) UserId() (r int64, exists bool) {
+	v := m.userId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserId returns the old "userId" field's value of the Conversation entity.
+// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationMutation) OldUserId(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
+	}
+	return oldValue.UserId, nil
+}
+
+// AddUserId adds i to the "userId" field.
+func (m *ConversationMutation) AddUserId(i int64) {
+	if m.adduserId != nil {
+		*m.adduserId += i
+	} else {
+		m.adduserId = &i
+	}
+}
+
+// AddedUserId returns the value that was added to the "userId" field in this mutation.
+func (m *ConversationMutation) AddedUserId() (r int64, exists bool) {
+	v := m.adduserId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserId resets all changes to the "userId" field.
+func (m *ConversationMutation) ResetUserId() {
+	m.userId = nil
+	m.adduserId = nil
+}
+
+// SetDialog sets the "dialog" field.
+func (m *ConversationMutation) SetDialog(s string) {
+	m.dialog = &s
+}
+
+// Dialog returns the value of the "dialog" field in the mutation.
+func (m *ConversationMutation) Dialog() (r string, exists bool) {
+	v := m.dialog
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDialog returns the old "dialog" field's value of the Conversation entity.
+// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationMutation) OldDialog(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDialog is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDialog requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDialog: %w", err)
+	}
+	return oldValue.Dialog, nil
+}
+
+// ResetDialog resets all changes to the "dialog" field.
+func (m *ConversationMutation) ResetDialog() {
+	m.dialog = nil
+}
+
+// SetState sets the "state" field.
+func (m *ConversationMutation) SetState(s string) {
+	m.state = &s
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *ConversationMutation) State() (r string, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the Conversation entity.
+// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationMutation) OldState(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *ConversationMutation) ResetState() {
+	m.state = nil
+}
+
+// SetValues sets the "values" field.
+func (m *ConversationMutation) SetValues(value map[string]string) {
+	m.values = &value
+}
+
+// Values returns the value of the "values" field in the mutation.
+func (m *ConversationMutation) Values() (r map[string]string, exists bool) {
+	v := m.values
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValues returns the old "values" field's value of the Conversation entity.
+// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationMutation) OldValues(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValues is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValues requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValues: %w", err)
+	}
+	return oldValue.Values, nil
+}
+
+// ResetValues resets all changes to the "values" field.
+func (m *ConversationMutation) ResetValues() {
+	m.values = nil
+}
+
+// SetHistory sets the "history" field.
+func (m *ConversationMutation) SetHistory(s []string) {
+	m.history = &s
+	m.appendhistory = nil
+}
+
+// History returns the value of the "history" field in the mutation.
+func (m *ConversationMutation) History() (r []string, exists bool) {
+	v := m.history
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHistory returns the old "history" field's value of the Conversation entity.
+// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationMutation) OldHistory(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHistory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHistory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHistory: %w", err)
+	}
+	return oldValue.History, nil
+}
+
+// AppendHistory adds s to the "history" field.
+func (m *ConversationMutation) AppendHistory(s []string) {
+	m.appendhistory = append(m.appendhistory, s...)
+}
+
+// AppendedHistory returns the list of values that were appended to the "history" field in this mutation.
+func (m *ConversationMutation) AppendedHistory() ([]string, bool) {
+	if len(m.appendhistory) == 0 {
+		return nil, false
+	}
+	return m.appendhistory, true
+}
+
+// ClearHistory clears the value of the "history" field.
+func (m *ConversationMutation) ClearHistory() {
+	m.history = nil
+	m.appendhistory = nil
+	m.clearedFields[conversation.FieldHistory] = struct{}{}
+}
+
+// HistoryCleared returns if the "history" field was cleared in this mutation.
+func (m *ConversationMutation) HistoryCleared() bool {
+	_, ok := m.clearedFields[conversation.FieldHistory]
+	return ok
+}
+
+// ResetHistory resets all changes to the "history" field.
+func (m *ConversationMutation) ResetHistory() {
+	m.history = nil
+	m.appendhistory = nil
+	delete(m.clearedFields, conversation.FieldHistory)
+}
+
+// SetSuspended sets the "suspended" field.
+func (m *ConversationMutation) SetSuspended(b bool) {
+	m.suspended = &b
+}
+
+// Suspended returns the value of the "suspended" field in the mutation.
+func (m *ConversationMutation) Suspended() (r bool, exists bool) {
+	v := m.suspended
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuspended returns the old "suspended" field's value of the Conversation entity.
+// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationMutation) OldSuspended(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuspended is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuspended requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuspended: %w", err)
+	}
+	return oldValue.Suspended, nil
+}
+
+// ResetSuspended resets all changes to the "suspended" field.
+func (m *ConversationMutation) ResetSuspended() {
+	m.suspended = nil
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (m *ConversationMutation) SetUpdatedAt(t time.Time) {
+	m.updatedAt = &t
+}
+
+// UpdatedAt returns the value of the "updatedAt" field in the mutation.
+func (m *ConversationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updatedAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updatedAt" field's value of the Conversation entity.
+// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updatedAt" field.
+func (m *ConversationMutation) ResetUpdatedAt() {
+	m.updatedAt = nil
+}
+
+// Where appends a list predicates to the ConversationMutation builder.
+func (m *ConversationMutation) Where(ps ...predicate.Conversation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ConversationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ConversationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Conversation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ConversationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ConversationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Conversation).
+func (m *ConversationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ConversationMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.chatId != nil {
+		fields = append(fields, conversation.FieldChatId)
+	}
+	if m.userId != nil {
+		fields = append(fields, conversation.FieldUserId)
+	}
+	if m.dialog != nil {
+		fields = append(fields, conversation.FieldDialog)
+	}
+	if m.state != nil {
+		fields = append(fields, conversation.FieldState)
+	}
+	if m.values != nil {
+		fields = append(fields, conversation.FieldValues)
+	}
+	if m.history != nil {
+		fields = append(fields, conversation.FieldHistory)
+	}
+	if m.suspended != nil {
+		fields = append(fields, conversation.FieldSuspended)
+	}
+	if m.updatedAt != nil {
+		fields = append(fields, conversation.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ConversationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case conversation.FieldChatId:
+		return m.ChatId()
+	case conversation.FieldUserId:
+		return m.UserId()
+	case conversation.FieldDialog:
+		return m.Dialog()
+	case conversation.FieldState:
+		return m.State()
+	case conversation.FieldValues:
+		return m.Values()
+	case conversation.FieldHistory:
+		return m.History()
+	case conversation.FieldSuspended:
+		return m.Suspended()
+	case conversation.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ConversationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case conversation.FieldChatId:
+		return m.OldChatId(ctx)
+	case conversation.FieldUserId:
+		return m.OldUserId(ctx)
+	case conversation.FieldDialog:
+		return m.OldDialog(ctx)
+	case conversation.FieldState:
+		return m.OldState(ctx)
+	case conversation.FieldValues:
+		return m.OldValues(ctx)
+	case conversation.FieldHistory:
+		return m.OldHistory(ctx)
+	case conversation.FieldSuspended:
+		return m.OldSuspended(ctx)
+	case conversation.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Conversation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConversationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case conversation.FieldChatId:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatId(v)
+		return nil
+	case conversation.FieldUserId:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserId(v)
+		return nil
+	case conversation.FieldDialog:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDialog(v)
+		return nil
+	case conversation.FieldState:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case conversation.FieldValues:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValues(v)
+		return nil
+	case conversation.FieldHistory:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHistory(v)
+		return nil
+	case conversation.FieldSuspended:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuspended(v)
+		return nil
+	case conversation.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Conversation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ConversationMutation) AddedFields() []string {
+	var fields []string
+	if m.addchatId != nil {
+		fields = append(fields, conversation.FieldChatId)
+	}
+	if m.adduserId != nil {
+		fields = append(fields, conversation.FieldUserId)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ConversationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case conversation.FieldChatId:
+		return m.AddedChatId()
+	case conversation.FieldUserId:
+		return m.AddedUserId()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConversationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case conversation.FieldChatId:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChatId(v)
+		return nil
+	case conversation.FieldUserId:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserId(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Conversation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ConversationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(conversation.FieldHistory) {
+		fields = append(fields, conversation.FieldHistory)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ConversationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ConversationMutation) ClearField(name string) error {
+	switch name {
+	case conversation.FieldHistory:
+		m.ClearHistory()
+		return nil
+	}
+	return fmt.Errorf("unknown Conversation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ConversationMutation) ResetField(name string) error {
+	switch name {
+	case conversation.FieldChatId:
+		m.ResetChatId()
+		return nil
+	case conversation.FieldUserId:
+		m.ResetUserId()
+		return nil
+	case conversation.FieldDialog:
+		m.ResetDialog()
+		return nil
+	case conversation.FieldState:
+		m.ResetState()
+		return nil
+	case conversation.FieldValues:
+		m.ResetValues()
+		return nil
+	case conversation.FieldHistory:
+		m.ResetHistory()
+		return nil
+	case conversation.FieldSuspended:
+		m.ResetSuspended()
+		return nil
+	case conversation.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Conversation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ConversationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ConversationMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ConversationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ConversationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ConversationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ConversationMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ConversationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Conversation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ConversationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Conversation edge %s", name)
+}
+
+// DepartmentMutation represents an operation that mutates the Department nodes in the graph.
+type DepartmentMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	name                *string
+	clearedFields       map[string]struct{}
+	organization        *int
+	clearedorganization bool
+	rooms               map[int]struct{}
+	removedrooms        map[int]struct{}
+	clearedrooms        bool
+	doctors             map[int]struct{}
+	removeddoctors      map[int]struct{}
+	cleareddoctors      bool
+	done                bool
+	oldValue            func(context.Context) (*Department, error)
+	predicates          []predicate.Department
+}
+
+var _ ent.Mutation = (*DepartmentMutation)(nil)
+
+// departmentOption allows management of the mutation configuration using functional options.
+type departmentOption func(*DepartmentMutation)
+
+// newDepartmentMutation creates new mutation for the Department entity.
+func newDepartmentMutation(c config, op Op, opts ...departmentOption) *DepartmentMutation {
+	m := &DepartmentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDepartment,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDepartmentID sets the ID field of the mutation.
+func withDepartmentID(id int) departmentOption {
+	return func(m *DepartmentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Department
+		)
+		m.oldValue = func(ctx context.Context) (*Department, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Department.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDepartment sets the old Department of the mutation.
+func withDepartment(node *Department) departmentOption {
+	return func(m *DepartmentMutation) {
+		m.oldValue = func(context.Context) (*Department, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DepartmentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DepartmentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DepartmentMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DepartmentMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Department.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (m *DepartmentMutation) SetOrganizationId(i int) {
+	m.organization = &i
+}
+
+// OrganizationId returns the value of the "organizationId" field in the mutation.
+func (m *DepartmentMutation) OrganizationId() (r int, exists bool) {
+	v := m.organization
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationId returns the old "organizationId" field's value of the Department entity.
+// If the Department object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepartmentMutation) OldOrganizationId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrganizationId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrganizationId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationId: %w", err)
+	}
+	return oldValue.OrganizationId, nil
+}
+
+// ClearOrganizationId clears the value of the "organizationId" field.
+func (m *DepartmentMutation) ClearOrganizationId() {
+	m.organization = nil
+	m.clearedFields[department.FieldOrganizationId] = struct{}{}
+}
+
+// OrganizationIdCleared returns if the "organizationId" field was cleared in this mutation.
+func (m *DepartmentMutation) OrganizationIdCleared() bool {
+	_, ok := m.clearedFields[department.FieldOrganizationId]
+	return ok
+}
+
+// ResetOrganizationId resets all changes to the "organizationId" field.
+func (m *DepartmentMutation) ResetOrganizationId() {
+	m.organization = nil
+	delete(m.clearedFields, department.FieldOrganizationId)
+}
+
+// SetName sets the "name" field.
+func (m *DepartmentMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *DepartmentMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Department entity.
+// If the Department object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepartmentMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *DepartmentMutation) ResetName() {
+	m.name = nil
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by id.
+func (m *DepartmentMutation) SetOrganizationID(id int) {
+	m.organization = &id
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *DepartmentMutation) ClearOrganization() {
+	m.clearedorganization = true
+}
+
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *DepartmentMutation) OrganizationCleared() bool {
+	return m.OrganizationIdCleared() || m.clearedorganization
+}
+
+// OrganizationID returns the "organization" edge ID in the mutation.
+func (m *DepartmentMutation) OrganizationID() (id int, exists bool) {
+	if m.organization != nil {
+		return *m.organization, true
+	}
+	return
+}
+
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *DepartmentMutation) OrganizationIDs() (ids []int) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *DepartmentMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
+}
+
+// AddRoomIDs adds the "rooms" edge to the Room entity by ids.
+func (m *DepartmentMutation) AddRoomIDs(ids ...int) {
+	if m.rooms == nil {
+		m.rooms = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.rooms[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRooms clears the "rooms" edge to the Room entity.
+func (m *DepartmentMutation) ClearRooms() {
+	m.clearedrooms = true
+}
+
+// RoomsCleared reports if the "rooms" edge to the Room entity was cleared.
+func (m *DepartmentMutation) RoomsCleared() bool {
+	return m.clearedrooms
+}
+
+// RemoveRoomIDs removes the "rooms" edge to the Room entity by IDs.
+func (m *DepartmentMutation) RemoveRoomIDs(ids ...int) {
+	if m.removedrooms == nil {
+		m.removedrooms = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.rooms, ids[i])
+		m.removedrooms[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRooms returns the removed IDs of the "rooms" edge to the Room entity.
+func (m *DepartmentMutation) RemovedRoomsIDs() (ids []int) {
+	for id := range m.removedrooms {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RoomsIDs returns the "rooms" edge IDs in the mutation.
+func (m *DepartmentMutation) RoomsIDs() (ids []int) {
+	for id := range m.rooms {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRooms resets all changes to the "rooms" edge.
+func (m *DepartmentMutation) ResetRooms() {
+	m.rooms = nil
+	m.clearedrooms = false
+	m.removedrooms = nil
+}
+
+// AddDoctorIDs adds the "doctors" edge to the Doctor entity by ids.
+func (m *DepartmentMutation) AddDoctorIDs(ids ...int) {
+	if m.doctors == nil {
+		m.doctors = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.doctors[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDoctors clears the "doctors" edge to the Doctor entity.
+func (m *DepartmentMutation) ClearDoctors() {
+	m.cleareddoctors = true
+}
+
+// DoctorsCleared reports if the "doctors" edge to the Doctor entity was cleared.
+func (m *DepartmentMutation) DoctorsCleared() bool {
+	return m.cleareddoctors
+}
+
+// RemoveDoctorIDs removes the "doctors" edge to the Doctor entity by IDs.
+func (m *DepartmentMutation) RemoveDoctorIDs(ids ...int) {
+	if m.removeddoctors == nil {
+		m.removeddoctors = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.doctors, ids[i])
+		m.removeddoctors[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDoctors returns the removed IDs of the "doctors" edge to the Doctor entity.
+func (m *DepartmentMutation) RemovedDoctorsIDs() (ids []int) {
+	for id := range m.removeddoctors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DoctorsIDs returns the "doctors" edge IDs in the mutation.
+func (m *DepartmentMutation) DoctorsIDs() (ids []int) {
+	for id := range m.doctors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDoctors resets all changes to the "doctors" edge.
+func (m *DepartmentMutation) ResetDoctors() {
+	m.doctors = nil
+	m.cleareddoctors = false
+	m.removeddoctors = nil
+}
+
+// Where appends a list predicates to the DepartmentMutation builder.
+func (m *DepartmentMutation) Where(ps ...predicate.Department) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DepartmentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DepartmentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Department, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DepartmentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DepartmentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Department).
+func (m *DepartmentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DepartmentMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.organization != nil {
+		fields = append(fields, department.FieldOrganizationId)
+	}
+	if m.name != nil {
+		fields = append(fields, department.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DepartmentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case department.FieldOrganizationId:
+		return m.OrganizationId()
+	case department.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DepartmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case department.FieldOrganizationId:
+		return m.OldOrganizationId(ctx)
+	case department.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown Department field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DepartmentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case department.FieldOrganizationId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationId(v)
+		return nil
+	case department.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Department field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DepartmentMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DepartmentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DepartmentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Department numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DepartmentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(department.FieldOrganizationId) {
+		fields = append(fields, department.FieldOrganizationId)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DepartmentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DepartmentMutation) ClearField(name string) error {
+	switch name {
+	case department.FieldOrganizationId:
+		m.ClearOrganizationId()
+		return nil
+	}
+	return fmt.Errorf("unknown Department nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DepartmentMutation) ResetField(name string) error {
+	switch name {
+	case department.FieldOrganizationId:
+		m.ResetOrganizationId()
+		return nil
+	case department.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown Department field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DepartmentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.organization != nil {
+		edges = append(edges, department.EdgeOrganization)
+	}
+	if m.rooms != nil {
+		edges = append(edges, department.EdgeRooms)
+	}
+	if m.doctors != nil {
+		edges = append(edges, department.EdgeDoctors)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DepartmentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case department.EdgeOrganization:
+		if id := m.organization; id != nil {
+			return []ent.Value{*id}
+		}
+	case department.EdgeRooms:
+		ids := make([]ent.Value, 0, len(m.rooms))
+		for id := range m.rooms {
+			ids = append(ids, id)
+		}
+		return ids
+	case department.EdgeDoctors:
+		ids := make([]ent.Value, 0, len(m.doctors))
+		for id := range m.doctors {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DepartmentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedrooms != nil {
+		edges = append(edges, department.EdgeRooms)
+	}
+	if m.removeddoctors != nil {
+		edges = append(edges, department.EdgeDoctors)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DepartmentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case department.EdgeRooms:
+		ids := make([]ent.Value, 0, len(m.removedrooms))
+		for id := range m.removedrooms {
+			ids = append(ids, id)
+		}
+		return ids
+	case department.EdgeDoctors:
+		ids := make([]ent.Value, 0, len(m.removeddoctors))
+		for id := range m.removeddoctors {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DepartmentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedorganization {
+		edges = append(edges, department.EdgeOrganization)
+	}
+	if m.clearedrooms {
+		edges = append(edges, department.EdgeRooms)
+	}
+	if m.cleareddoctors {
+		edges = append(edges, department.EdgeDoctors)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DepartmentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case department.EdgeOrganization:
+		return m.clearedorganization
+	case department.EdgeRooms:
+		return m.clearedrooms
+	case department.EdgeDoctors:
+		return m.cleareddoctors
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DepartmentMutation) ClearEdge(name string) error {
+	switch name {
+	case department.EdgeOrganization:
+		m.ClearOrganization()
+		return nil
+	}
+	return fmt.Errorf("unknown Department unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DepartmentMutation) ResetEdge(name string) error {
+	switch name {
+	case department.EdgeOrganization:
+		m.ResetOrganization()
+		return nil
+	case department.EdgeRooms:
+		m.ResetRooms()
+		return nil
+	case department.EdgeDoctors:
+		m.ResetDoctors()
+		return nil
+	}
+	return fmt.Errorf("unknown Department edge %s", name)
+}
+
+// DiseaseMutation represents an operation that mutates the Disease nodes in the graph.
+type DiseaseMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	organizationId    *int
+	addorganizationId *int
+	threat            *string
+	name              *string
+	degreeOfDanger    *int
+	adddegreeOfDanger *int
+	clearedFields     map[string]struct{}
+	has               map[int]struct{}
+	removedhas        map[int]struct{}
+	clearedhas        bool
+	done              bool
+	oldValue          func(context.Context) (*Disease, error)
+	predicates        []predicate.Disease
+}
+
+var _ ent.Mutation = (*DiseaseMutation)(nil)
+
+// diseaseOption allows management of the mutation configuration using functional options.
+type diseaseOption func(*DiseaseMutation)
+
+// newDiseaseMutation creates new mutation for the Disease entity.
+func newDiseaseMutation(c config, op Op, opts ...diseaseOption) *DiseaseMutation {
+	m := &DiseaseMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDisease,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDiseaseID sets the ID field of the mutation.
+func withDiseaseID(id int) diseaseOption {
+	return func(m *DiseaseMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Disease
+		)
+		m.oldValue = func(ctx context.Context) (*Disease, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Disease.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDisease sets the old Disease of the mutation.
+func withDisease(node *Disease) diseaseOption {
+	return func(m *DiseaseMutation) {
+		m.oldValue = func(context.Context) (*Disease, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DiseaseMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DiseaseMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DiseaseMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DiseaseMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Disease.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (m *DiseaseMutation) SetOrganizationId(i int) {
+	m.organizationId = &i
+	m.addorganizationId = nil
+}
+
+// OrganizationId returns the value of the "organizationId" field in the mutation.
+func (m *DiseaseMutation) OrganizationId() (r int, exists bool) {
+	v := m.organizationId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationId returns the old "organizationId" field's value of the Disease entity.
+// If the Disease object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiseaseMutation) OldOrganizationId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrganizationId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrganizationId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationId: %w", err)
+	}
+	return oldValue.OrganizationId, nil
+}
+
+// AddOrganizationId adds i to the "organizationId" field.
+func (m *DiseaseMutation) AddOrganizationId(i int) {
+	if m.addorganizationId != nil {
+		*m.addorganizationId += i
+	} else {
+		m.addorganizationId = &i
+	}
+}
+
+// AddedOrganizationId returns the value that was added to the "organizationId" field in this mutation.
+func (m *DiseaseMutation) AddedOrganizationId() (r int, exists bool) {
+	v := m.addorganizationId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOrganizationId clears the value of the "organizationId" field.
+func (m *DiseaseMutation) ClearOrganizationId() {
+	m.organizationId = nil
+	m.addorganizationId = nil
+	m.clearedFields[disease.FieldOrganizationId] = struct{}{}
+}
+
+// OrganizationIdCleared returns if the "organizationId" field was cleared in this mutation.
+func (m *DiseaseMutation) OrganizationIdCleared() bool {
+	_, ok := m.clearedFields[disease.FieldOrganizationId]
+	return ok
+}
+
+// ResetOrganizationId resets all changes to the "organizationId" field.
+func (m *DiseaseMutation) ResetOrganizationId() {
+	m.organizationId = nil
+	m.addorganizationId = nil
+	delete(m.clearedFields, disease.FieldOrganizationId)
+}
+
+// SetThreat sets the "threat" field.
+func (m *DiseaseMutation) SetThreat(s string) {
+	m.threat = &s
+}
+
+// Threat returns the value of the "threat" field in the mutation.
+func (m *DiseaseMutation) Threat() (r string, exists bool) {
+	v := m.threat
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThreat returns the old "threat" field's value of the Disease entity.
+// If the Disease object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiseaseMutation) OldThreat(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThreat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThreat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThreat: %w", err)
+	}
+	return oldValue.Threat, nil
+}
+
+// ResetThreat resets all changes to the "threat" field.
+func (m *DiseaseMutation) ResetThreat() {
+	m.threat = nil
+}
+
+// SetName sets the "name" field.
+func (m *DiseaseMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *DiseaseMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Disease entity.
+// If the Disease object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiseaseMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *DiseaseMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDegreeOfDanger sets the "degreeOfDanger" field.
+func (m *DiseaseMutation) SetDegreeOfDanger(i int) {
+	m.degreeOfDanger = &i
+	m.adddegreeOfDanger = nil
+}
+
+// DegreeOfDanger returns the value of the "degreeOfDanger" field in the mutation.
+func (m *DiseaseMutation) DegreeOfDanger() (r int, exists bool) {
+	v := m.degreeOfDanger
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDegreeOfDanger returns the old "degreeOfDanger" field's value of the Disease entity.
+// If the Disease object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiseaseMutation) OldDegreeOfDanger(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDegreeOfDanger is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDegreeOfDanger requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDegreeOfDanger: %w", err)
+	}
+	return oldValue.DegreeOfDanger, nil
+}
+
+// AddDegreeOfDanger adds i to the "degreeOfDanger" field.
+func (m *DiseaseMutation) AddDegreeOfDanger(i int) {
+	if m.adddegreeOfDanger != nil {
+		*m.adddegreeOfDanger += i
+	} else {
+		m.adddegreeOfDanger = &i
+	}
+}
+
+// AddedDegreeOfDanger returns the value that was added to the "degreeOfDanger" field in this mutation.
+func (m *DiseaseMutation) AddedDegreeOfDanger() (r int, exists bool) {
+	v := m.adddegreeOfDanger
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDegreeOfDanger resets all changes to the "degreeOfDanger" field.
+func (m *DiseaseMutation) ResetDegreeOfDanger() {
+	m.degreeOfDanger = nil
+	m.adddegreeOfDanger = nil
+}
+
+// AddHaIDs adds the "has" edge to the Patient entity by ids.
+func (m *DiseaseMutation) AddHaIDs(ids ...int) {
+	if m.has == nil {
+		m.has = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.has[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHas clears the "has" edge to the Patient entity.
+func (m *DiseaseMutation) ClearHas() {
+	m.clearedhas = true
+}
+
+// HasCleared reports if the "has" edge to the Patient entity was cleared.
+func (m *DiseaseMutation) HasCleared() bool {
+	return m.clearedhas
+}
+
+// RemoveHaIDs removes the "has" edge to the Patient entity by IDs.
+func (m *DiseaseMutation) RemoveHaIDs(ids ...int) {
+	if m.removedhas == nil {
+		m.removedhas = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.has, ids[i])
+		m.removedhas[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHas returns the removed IDs of the "has" edge to the Patient entity.
+func (m *DiseaseMutation) RemovedHasIDs() (ids []int) {
+	for id := range m.removedhas {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HasIDs returns the "has" edge IDs in the mutation.
+func (m *DiseaseMutation) HasIDs() (ids []int) {
+	for id := range m.has {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHas resets all changes to the "has" edge.
+func (m *DiseaseMutation) ResetHas() {
+	m.has = nil
+	m.clearedhas = false
+	m.removedhas = nil
+}
+
+// Where appends a list predicates to the DiseaseMutation builder.
+func (m *DiseaseMutation) Where(ps ...predicate.Disease) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DiseaseMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DiseaseMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Disease, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DiseaseMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DiseaseMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Disease).
+func (m *DiseaseMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DiseaseMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.organizationId != nil {
+		fields = append(fields, disease.FieldOrganizationId)
+	}
+	if m.threat != nil {
+		fields = append(fields, disease.FieldThreat)
+	}
+	if m.name != nil {
+		fields = append(fields, disease.FieldName)
+	}
+	if m.degreeOfDanger != nil {
+		fields = append(fields, disease.FieldDegreeOfDanger)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DiseaseMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case disease.FieldOrganizationId:
+		return m.OrganizationId()
+	case disease.FieldThreat:
+		return m.Threat()
+	case disease.FieldName:
+		return m.Name()
+	case disease.FieldDegreeOfDanger:
+		return m.DegreeOfDanger()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DiseaseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case disease.FieldOrganizationId:
+		return m.OldOrganizationId(ctx)
+	case disease.FieldThreat:
+		return m.OldThreat(ctx)
+	case disease.FieldName:
+		return m.OldName(ctx)
+	case disease.FieldDegreeOfDanger:
+		return m.OldDegreeOfDanger(ctx)
+	}
+	return nil, fmt.Errorf("unknown Disease field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DiseaseMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case disease.FieldOrganizationId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationId(v)
+		return nil
+	case disease.FieldThreat:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThreat(v)
+		return nil
+	case disease.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case disease.FieldDegreeOfDanger:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDegreeOfDanger(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Disease field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DiseaseMutation) AddedFields() []string {
+	var fields []string
+	if m.addorganizationId != nil {
+		fields = append(fields, disease.FieldOrganizationId)
+	}
+	if m.adddegreeOfDanger != nil {
+		fields = append(fields, disease.FieldDegreeOfDanger)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DiseaseMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case disease.FieldOrganizationId:
+		return m.AddedOrganizationId()
+	case disease.FieldDegreeOfDanger:
+		return m.AddedDegreeOfDanger()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DiseaseMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case disease.FieldOrganizationId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOrganizationId(v)
+		return nil
+	case disease.FieldDegreeOfDanger:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDegreeOfDanger(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Disease numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DiseaseMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(disease.FieldOrganizationId) {
+		fields = append(fields, disease.FieldOrganizationId)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DiseaseMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DiseaseMutation) ClearField(name string) error {
+	switch name {
+	case disease.FieldOrganizationId:
+		m.ClearOrganizationId()
+		return nil
+	}
+	return fmt.Errorf("unknown Disease nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DiseaseMutation) ResetField(name string) error {
+	switch name {
+	case disease.FieldOrganizationId:
+		m.ResetOrganizationId()
+		return nil
+	case disease.FieldThreat:
+		m.ResetThreat()
+		return nil
+	case disease.FieldName:
+		m.ResetName()
+		return nil
+	case disease.FieldDegreeOfDanger:
+		m.ResetDegreeOfDanger()
+		return nil
+	}
+	return fmt.Errorf("unknown Disease field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DiseaseMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.has != nil {
+		edges = append(edges, disease.EdgeHas)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DiseaseMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case disease.EdgeHas:
+		ids := make([]ent.Value, 0, len(m.has))
+		for id := range m.has {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DiseaseMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedhas != nil {
+		edges = append(edges, disease.EdgeHas)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DiseaseMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case disease.EdgeHas:
+		ids := make([]ent.Value, 0, len(m.removedhas))
+		for id := range m.removedhas {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DiseaseMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedhas {
+		edges = append(edges, disease.EdgeHas)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DiseaseMutation) EdgeCleared(name string) bool {
+	switch name {
+	case disease.EdgeHas:
+		return m.clearedhas
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DiseaseMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Disease unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DiseaseMutation) ResetEdge(name string) error {
+	switch name {
+	case disease.EdgeHas:
+		m.ResetHas()
+		return nil
+	}
+	return fmt.Errorf("unknown Disease edge %s", name)
+}
+
+// DoctorMutation represents an operation that mutates the Doctor nodes in the graph.
+type DoctorMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	tokenId            *string
+	surname            *string
+	speciality         *string
+	role               *string
+	language           *string
+	onCall             *bool
+	clearedFields      map[string]struct{}
+	treats             map[int]struct{}
+	removedtreats      map[int]struct{}
+	clearedtreats      bool
+	sessions           map[int]struct{}
+	removedsessions    map[int]struct{}
+	clearedsessions    bool
+	departments        map[int]struct{}
+	removeddepartments map[int]struct{}
+	cleareddepartments bool
+	done               bool
+	oldValue           func(context.Context) (*Doctor, error)
+	predicates         []predicate.Doctor
+}
+
+var _ ent.Mutation = (*DoctorMutation)(nil)
+
+// doctorOption allows management of the mutation configuration using functional options.
+type doctorOption func(*DoctorMutation)
+
+// newDoctorMutation creates new mutation for the Doctor entity.
+func newDoctorMutation(c config, op Op, opts ...doctorOption) *DoctorMutation {
+	m := &DoctorMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDoctor,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDoctorID sets the ID field of the mutation.
+func withDoctorID(id int) doctorOption {
+	return func(m *DoctorMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Doctor
+		)
+		m.oldValue = func(ctx context.Context) (*Doctor, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Doctor.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDoctor sets the old Doctor of the mutation.
+func withDoctor(node *Doctor) doctorOption {
+	return func(m *DoctorMutation) {
+		m.oldValue = func(context.Context) (*Doctor, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DoctorMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DoctorMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DoctorMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DoctorMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Doctor.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTokenId sets the "tokenId" field.
+func (m *DoctorMutation) SetTokenId(s string) {
+	m.tokenId = &s
+}
+
+// TokenId returns the value of the "tokenId" field in the mutation.
+func (m *DoctorMutation) TokenId() (r string, exists bool) {
+	v := m.tokenId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenId returns the old "tokenId" field's value of the Doctor entity.
+// If the Doctor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DoctorMutation) OldTokenId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenId: %w", err)
+	}
+	return oldValue.TokenId, nil
+}
+
+// ResetTokenId resets all changes to the "tokenId" field.
+func (m *DoctorMutation) ResetTokenId() {
+	m.tokenId = nil
+}
+
+// SetSurname sets the "surname" field.
+func (m *DoctorMutation) SetSurname(s string) {
+	m.surname = &s
+}
+
+// Surname returns the value of the "surname" field in the mutation.
+func (m *DoctorMutation) Surname() (r string, exists bool) {
+	v := m.surname
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSurname returns the old "surname" field's value of the Doctor entity.
+// If the Doctor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DoctorMutation) OldSurname(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSurname is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSurname requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSurname: %w", err)
+	}
+	return oldValue.Surname, nil
+}
+
+// ResetSurname resets all changes to the "surname" field.
+func (m *DoctorMutation) ResetSurname() {
+	m.surname = nil
+}
+
+// SetSpeciality sets the "speciality" field.
+func (m *DoctorMutation) SetSpeciality(s string) {
+	m.speciality = &s
+}
+
+// Speciality returns the value of the "speciality" field in the mutation.
+func (m *DoctorMutation) Speciality() (r string, exists bool) {
+	v := m.speciality
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpeciality returns the old "speciality" field's value of the Doctor entity.
+// If the Doctor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DoctorMutation) OldSpeciality(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpeciality is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpeciality requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpeciality: %w", err)
+	}
+	return oldValue.Speciality, nil
+}
+
+// ResetSpeciality resets all changes to the "speciality" field.
+func (m *DoctorMutation) ResetSpeciality() {
+	m.speciality = nil
+}
+
+// SetRole sets the "role" field.
+func (m *DoctorMutation) SetRole(s string) {
+	m.role = &s
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *DoctorMutation) Role() (r string, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the Doctor entity.
+// If the Doctor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DoctorMutation) OldRole(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *DoctorMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetLanguage sets the "language" field.
+func (m *DoctorMutation) SetLanguage(s string) {
+	m.language = &s
+}
+
+// Language returns the value of the "language" field in the mutation.
+func (m *DoctorMutation) Language() (r string, exists bool) {
+	v := m.language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLanguage returns the old "language" field's value of the Doctor entity.
+// If the Doctor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DoctorMutation) OldLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
+	}
+	return oldValue.Language, nil
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (m *DoctorMutation) ClearLanguage() {
+	m.language = nil
+	m.clearedFields[doctor.FieldLanguage] = struct{}{}
+}
+
+// LanguageCleared returns if the "language" field was cleared in this mutation.
+func (m *DoctorMutation) LanguageCleared() bool {
+	_, ok := m.clearedFields[doctor.FieldLanguage]
+	return ok
+}
+
+// ResetLanguage resets all changes to the "language" field.
+func (m *DoctorMutation) ResetLanguage() {
+	m.language = nil
+	delete(m.clearedFields, doctor.FieldLanguage)
+}
+
+// SetOnCall sets the "onCall" field.
+func (m *DoctorMutation) SetOnCall(b bool) {
+	m.onCall = &b
+}
+
+// OnCall returns the value of the "onCall" field in the mutation.
+func (m *DoctorMutation) OnCall() (r bool, exists bool) {
+	v := m.onCall
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOnCall returns the old "onCall" field's value of the Doctor entity.
+// If the Doctor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DoctorMutation) OldOnCall(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOnCall is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOnCall requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOnCall: %w", err)
+	}
+	return oldValue.OnCall, nil
+}
+
+// ResetOnCall resets all changes to the "onCall" field.
+func (m *DoctorMutation) ResetOnCall() {
+	m.onCall = nil
+}
+
+// AddTreatIDs adds the "treats" edge to the Patient entity by ids.
+func (m *DoctorMutation) AddTreatIDs(ids ...int) {
+	if m.treats == nil {
+		m.treats = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.treats[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTreats clears the "treats" edge to the Patient entity.
+func (m *DoctorMutation) ClearTreats() {
+	m.clearedtreats = true
+}
+
+// TreatsCleared reports if the "treats" edge to the Patient entity was cleared.
 func (m *DoctorMutation) TreatsCleared() bool {
 	return m.clearedtreats
 }
 
-// RemoveTreatIDs removes the "treats" edge to the Patient entity by IDs.
-func (m *DoctorMutation) RemoveTreatIDs(ids ...int) {
-	if m.removedtreats == nil {
-		m.removedtreats = make(map[int]struct{})
+// RemoveTreatIDs removes the "treats" edge to the Patient entity by IDs.
+func (m *DoctorMutation) RemoveTreatIDs(ids ...int) {
+	if m.removedtreats == nil {
+		m.removedtreats = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.treats, ids[i])
+		m.removedtreats[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTreats returns the removed IDs of the "treats" edge to the Patient entity.
+func (m *DoctorMutation) RemovedTreatsIDs() (ids []int) {
+	for id := range m.removedtreats {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TreatsIDs returns the "treats" edge IDs in the mutation.
+func (m *DoctorMutation) TreatsIDs() (ids []int) {
+	for id := range m.treats {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTreats resets all changes to the "treats" edge.
+func (m *DoctorMutation) ResetTreats() {
+	m.treats = nil
+	m.clearedtreats = false
+	m.removedtreats = nil
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by ids.
+func (m *DoctorMutation) AddSessionIDs(ids ...int) {
+	if m.sessions == nil {
+		m.sessions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.sessions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSessions clears the "sessions" edge to the Session entity.
+func (m *DoctorMutation) ClearSessions() {
+	m.clearedsessions = true
+}
+
+// SessionsCleared reports if the "sessions" edge to the Session entity was cleared.
+func (m *DoctorMutation) SessionsCleared() bool {
+	return m.clearedsessions
+}
+
+// RemoveSessionIDs removes the "sessions" edge to the Session entity by IDs.
+func (m *DoctorMutation) RemoveSessionIDs(ids ...int) {
+	if m.removedsessions == nil {
+		m.removedsessions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.sessions, ids[i])
+		m.removedsessions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSessions returns the removed IDs of the "sessions" edge to the Session entity.
+func (m *DoctorMutation) RemovedSessionsIDs() (ids []int) {
+	for id := range m.removedsessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SessionsIDs returns the "sessions" edge IDs in the mutation.
+func (m *DoctorMutation) SessionsIDs() (ids []int) {
+	for id := range m.sessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSessions resets all changes to the "sessions" edge.
+func (m *DoctorMutation) ResetSessions() {
+	m.sessions = nil
+	m.clearedsessions = false
+	m.removedsessions = nil
+}
+
+// AddDepartmentIDs adds the "departments" edge to the Department entity by ids.
+func (m *DoctorMutation) AddDepartmentIDs(ids ...int) {
+	if m.departments == nil {
+		m.departments = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.departments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDepartments clears the "departments" edge to the Department entity.
+func (m *DoctorMutation) ClearDepartments() {
+	m.cleareddepartments = true
+}
+
+// DepartmentsCleared reports if the "departments" edge to the Department entity was cleared.
+func (m *DoctorMutation) DepartmentsCleared() bool {
+	return m.cleareddepartments
+}
+
+// RemoveDepartmentIDs removes the "departments" edge to the Department entity by IDs.
+func (m *DoctorMutation) RemoveDepartmentIDs(ids ...int) {
+	if m.removeddepartments == nil {
+		m.removeddepartments = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.departments, ids[i])
+		m.removeddepartments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDepartments returns the removed IDs of the "departments" edge to the Department entity.
+func (m *DoctorMutation) RemovedDepartmentsIDs() (ids []int) {
+	for id := range m.removeddepartments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DepartmentsIDs returns the "departments" edge IDs in the mutation.
+func (m *DoctorMutation) DepartmentsIDs() (ids []int) {
+	for id := range m.departments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDepartments resets all changes to the "departments" edge.
+func (m *DoctorMutation) ResetDepartments() {
+	m.departments = nil
+	m.cleareddepartments = false
+	m.removeddepartments = nil
+}
+
+// Where appends a list predicates to the DoctorMutation builder.
+func (m *DoctorMutation) Where(ps ...predicate.Doctor) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DoctorMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DoctorMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Doctor, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DoctorMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DoctorMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Doctor).
+func (m *DoctorMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DoctorMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.tokenId != nil {
+		fields = append(fields, doctor.FieldTokenId)
+	}
+	if m.surname != nil {
+		fields = append(fields, doctor.FieldSurname)
+	}
+	if m.speciality != nil {
+		fields = append(fields, doctor.FieldSpeciality)
+	}
+	if m.role != nil {
+		fields = append(fields, doctor.FieldRole)
+	}
+	if m.language != nil {
+		fields = append(fields, doctor.FieldLanguage)
+	}
+	if m.onCall != nil {
+		fields = append(fields, doctor.FieldOnCall)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DoctorMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case doctor.FieldTokenId:
+		return m.TokenId()
+	case doctor.FieldSurname:
+		return m.Surname()
+	case doctor.FieldSpeciality:
+		return m.Speciality()
+	case doctor.FieldRole:
+		return m.Role()
+	case doctor.FieldLanguage:
+		return m.Language()
+	case doctor.FieldOnCall:
+		return m.OnCall()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DoctorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case doctor.FieldTokenId:
+		return m.OldTokenId(ctx)
+	case doctor.FieldSurname:
+		return m.OldSurname(ctx)
+	case doctor.FieldSpeciality:
+		return m.OldSpeciality(ctx)
+	case doctor.FieldRole:
+		return m.OldRole(ctx)
+	case doctor.FieldLanguage:
+		return m.OldLanguage(ctx)
+	case doctor.FieldOnCall:
+		return m.OldOnCall(ctx)
+	}
+	return nil, fmt.Errorf("unknown Doctor field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DoctorMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case doctor.FieldTokenId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenId(v)
+		return nil
+	case doctor.FieldSurname:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSurname(v)
+		return nil
+	case doctor.FieldSpeciality:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpeciality(v)
+		return nil
+	case doctor.FieldRole:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case doctor.FieldLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLanguage(v)
+		return nil
+	case doctor.FieldOnCall:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOnCall(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Doctor field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DoctorMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DoctorMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DoctorMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Doctor numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DoctorMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(doctor.FieldLanguage) {
+		fields = append(fields, doctor.FieldLanguage)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DoctorMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DoctorMutation) ClearField(name string) error {
+	switch name {
+	case doctor.FieldLanguage:
+		m.ClearLanguage()
+		return nil
+	}
+	return fmt.Errorf("unknown Doctor nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DoctorMutation) ResetField(name string) error {
+	switch name {
+	case doctor.FieldTokenId:
+		m.ResetTokenId()
+		return nil
+	case doctor.FieldSurname:
+		m.ResetSurname()
+		return nil
+	case doctor.FieldSpeciality:
+		m.ResetSpeciality()
+		return nil
+	case doctor.FieldRole:
+		m.ResetRole()
+		return nil
+	case doctor.FieldLanguage:
+		m.ResetLanguage()
+		return nil
+	case doctor.FieldOnCall:
+		m.ResetOnCall()
+		return nil
+	}
+	return fmt.Errorf("unknown Doctor field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DoctorMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.treats != nil {
+		edges = append(edges, doctor.EdgeTreats)
+	}
+	if m.sessions != nil {
+		edges = append(edges, doctor.EdgeSessions)
+	}
+	if m.departments != nil {
+		edges = append(edges, doctor.EdgeDepartments)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DoctorMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case doctor.EdgeTreats:
+		ids := make([]ent.Value, 0, len(m.treats))
+		for id := range m.treats {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.sessions))
+		for id := range m.sessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeDepartments:
+		ids := make([]ent.Value, 0, len(m.departments))
+		for id := range m.departments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DoctorMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedtreats != nil {
+		edges = append(edges, doctor.EdgeTreats)
+	}
+	if m.removedsessions != nil {
+		edges = append(edges, doctor.EdgeSessions)
+	}
+	if m.removeddepartments != nil {
+		edges = append(edges, doctor.EdgeDepartments)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DoctorMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case doctor.EdgeTreats:
+		ids := make([]ent.Value, 0, len(m.removedtreats))
+		for id := range m.removedtreats {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.removedsessions))
+		for id := range m.removedsessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeDepartments:
+		ids := make([]ent.Value, 0, len(m.removeddepartments))
+		for id := range m.removeddepartments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DoctorMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedtreats {
+		edges = append(edges, doctor.EdgeTreats)
+	}
+	if m.clearedsessions {
+		edges = append(edges, doctor.EdgeSessions)
+	}
+	if m.cleareddepartments {
+		edges = append(edges, doctor.EdgeDepartments)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DoctorMutation) EdgeCleared(name string) bool {
+	switch name {
+	case doctor.EdgeTreats:
+		return m.clearedtreats
+	case doctor.EdgeSessions:
+		return m.clearedsessions
+	case doctor.EdgeDepartments:
+		return m.cleareddepartments
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DoctorMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Doctor unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DoctorMutation) ResetEdge(name string) error {
+	switch name {
+	case doctor.EdgeTreats:
+		m.ResetTreats()
+		return nil
+	case doctor.EdgeSessions:
+		m.ResetSessions()
+		return nil
+	case doctor.EdgeDepartments:
+		m.ResetDepartments()
+		return nil
+	}
+	return fmt.Errorf("unknown Doctor edge %s", name)
+}
+
+// JobStateMutation represents an operation that mutates the JobState nodes in the graph.
+type JobStateMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	lastRunAt     *time.Time
+	lastSuccessAt *time.Time
+	lastError     *string
+	attempts      *int
+	addattempts   *int
+	updatedAt     *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*JobState, error)
+	predicates    []predicate.JobState
+}
+
+var _ ent.Mutation = (*JobStateMutation)(nil)
+
+// jobstateOption allows management of the mutation configuration using functional options.
+type jobstateOption func(*JobStateMutation)
+
+// newJobStateMutation creates new mutation for the JobState entity.
+func newJobStateMutation(c config, op Op, opts ...jobstateOption) *JobStateMutation {
+	m := &JobStateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeJobState,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withJobStateID sets the ID field of the mutation.
+func withJobStateID(id int) jobstateOption {
+	return func(m *JobStateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *JobState
+		)
+		m.oldValue = func(ctx context.Context) (*JobState, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().JobState.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withJobState sets the old JobState of the mutation.
+func withJobState(node *JobState) jobstateOption {
+	return func(m *JobStateMutation) {
+		m.oldValue = func(context.Context) (*JobState, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m JobStateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m JobStateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *JobStateMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *JobStateMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().JobState.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *JobStateMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *JobStateMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the JobState entity.
+// If the JobState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobStateMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *JobStateMutation) ResetName() {
+	m.name = nil
+}
+
+// SetLastRunAt sets the "lastRunAt" field.
+func (m *JobStateMutation) SetLastRunAt(t time.Time) {
+	m.lastRunAt = &t
+}
+
+// LastRunAt returns the value of the "lastRunAt" field in the mutation.
+func (m *JobStateMutation) LastRunAt() (r time.Time, exists bool) {
+	v := m.lastRunAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastRunAt returns the old "lastRunAt" field's value of the JobState entity.
+// If the JobState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobStateMutation) OldLastRunAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastRunAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastRunAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastRunAt: %w", err)
+	}
+	return oldValue.LastRunAt, nil
+}
+
+// ClearLastRunAt clears the value of the "lastRunAt" field.
+func (m *JobStateMutation) ClearLastRunAt() {
+	m.lastRunAt = nil
+	m.clearedFields[jobstate.FieldLastRunAt] = struct{}{}
+}
+
+// LastRunAtCleared returns if the "lastRunAt" field was cleared in this mutation.
+func (m *JobStateMutation) LastRunAtCleared() bool {
+	_, ok := m.clearedFields[jobstate.FieldLastRunAt]
+	return ok
+}
+
+// ResetLastRunAt resets all changes to the "lastRunAt" field.
+func (m *JobStateMutation) ResetLastRunAt() {
+	m.lastRunAt = nil
+	delete(m.clearedFields, jobstate.FieldLastRunAt)
+}
+
+// SetLastSuccessAt sets the "lastSuccessAt" field.
+func (m *JobStateMutation) SetLastSuccessAt(t time.Time) {
+	m.lastSuccessAt = &t
+}
+
+// LastSuccessAt returns the value of the "lastSuccessAt" field in the mutation.
+func (m *JobStateMutation) LastSuccessAt() (r time.Time, exists bool) {
+	v := m.lastSuccessAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSuccessAt returns the old "lastSuccessAt" field's value of the JobState entity.
+// If the JobState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobStateMutation) OldLastSuccessAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSuccessAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSuccessAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSuccessAt: %w", err)
+	}
+	return oldValue.LastSuccessAt, nil
+}
+
+// ClearLastSuccessAt clears the value of the "lastSuccessAt" field.
+func (m *JobStateMutation) ClearLastSuccessAt() {
+	m.lastSuccessAt = nil
+	m.clearedFields[jobstate.FieldLastSuccessAt] = struct{}{}
+}
+
+// LastSuccessAtCleared returns if the "lastSuccessAt" field was cleared in this mutation.
+func (m *JobStateMutation) LastSuccessAtCleared() bool {
+	_, ok := m.clearedFields[jobstate.FieldLastSuccessAt]
+	return ok
+}
+
+// ResetLastSuccessAt resets all changes to the "lastSuccessAt" field.
+func (m *JobStateMutation) ResetLastSuccessAt() {
+	m.lastSuccessAt = nil
+	delete(m.clearedFields, jobstate.FieldLastSuccessAt)
+}
+
+// SetLastError sets the "lastError" field.
+func (m *JobStateMutation) SetLastError(s string) {
+	m.lastError = &s
+}
+
+// LastError returns the value of the "lastError" field in the mutation.
+func (m *JobStateMutation) LastError() (r string, exists bool) {
+	v := m.lastError
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "lastError" field's value of the JobState entity.
+// If the JobState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobStateMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "lastError" field.
+func (m *JobStateMutation) ClearLastError() {
+	m.lastError = nil
+	m.clearedFields[jobstate.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "lastError" field was cleared in this mutation.
+func (m *JobStateMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[jobstate.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "lastError" field.
+func (m *JobStateMutation) ResetLastError() {
+	m.lastError = nil
+	delete(m.clearedFields, jobstate.FieldLastError)
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *JobStateMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *JobStateMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the JobState entity.
+// If the JobState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobStateMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *JobStateMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *JobStateMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *JobStateMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (m *JobStateMutation) SetUpdatedAt(t time.Time) {
+	m.updatedAt = &t
+}
+
+// UpdatedAt returns the value of the "updatedAt" field in the mutation.
+func (m *JobStateMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updatedAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updatedAt" field's value of the JobState entity.
+// If the JobState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobStateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updatedAt" field.
+func (m *JobStateMutation) ResetUpdatedAt() {
+	m.updatedAt = nil
+}
+
+// Where appends a list predicates to the JobStateMutation builder.
+func (m *JobStateMutation) Where(ps ...predicate.JobState) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JobStateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JobStateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.JobState, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JobStateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JobStateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (JobState).
+func (m *JobStateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JobStateMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.name != nil {
+		fields = append(fields, jobstate.FieldName)
+	}
+	if m.lastRunAt != nil {
+		fields = append(fields, jobstate.FieldLastRunAt)
+	}
+	if m.lastSuccessAt != nil {
+		fields = append(fields, jobstate.FieldLastSuccessAt)
+	}
+	if m.lastError != nil {
+		fields = append(fields, jobstate.FieldLastError)
+	}
+	if m.attempts != nil {
+		fields = append(fields, jobstate.FieldAttempts)
+	}
+	if m.updatedAt != nil {
+		fields = append(fields, jobstate.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JobStateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case jobstate.FieldName:
+		return m.Name()
+	case jobstate.FieldLastRunAt:
+		return m.LastRunAt()
+	case jobstate.FieldLastSuccessAt:
+		return m.LastSuccessAt()
+	case jobstate.FieldLastError:
+		return m.LastError()
+	case jobstate.FieldAttempts:
+		return m.Attempts()
+	case jobstate.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JobStateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case jobstate.FieldName:
+		return m.OldName(ctx)
+	case jobstate.FieldLastRunAt:
+		return m.OldLastRunAt(ctx)
+	case jobstate.FieldLastSuccessAt:
+		return m.OldLastSuccessAt(ctx)
+	case jobstate.FieldLastError:
+		return m.OldLastError(ctx)
+	case jobstate.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case jobstate.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown JobState field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobStateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case jobstate.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case jobstate.FieldLastRunAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastRunAt(v)
+		return nil
+	case jobstate.FieldLastSuccessAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSuccessAt(v)
+		return nil
+	case jobstate.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case jobstate.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case jobstate.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown JobState field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JobStateMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, jobstate.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JobStateMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case jobstate.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobStateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case jobstate.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown JobState numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JobStateMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(jobstate.FieldLastRunAt) {
+		fields = append(fields, jobstate.FieldLastRunAt)
+	}
+	if m.FieldCleared(jobstate.FieldLastSuccessAt) {
+		fields = append(fields, jobstate.FieldLastSuccessAt)
+	}
+	if m.FieldCleared(jobstate.FieldLastError) {
+		fields = append(fields, jobstate.FieldLastError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JobStateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JobStateMutation) ClearField(name string) error {
+	switch name {
+	case jobstate.FieldLastRunAt:
+		m.ClearLastRunAt()
+		return nil
+	case jobstate.FieldLastSuccessAt:
+		m.ClearLastSuccessAt()
+		return nil
+	case jobstate.FieldLastError:
+		m.ClearLastError()
+		return nil
+	}
+	return fmt.Errorf("unknown JobState nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JobStateMutation) ResetField(name string) error {
+	switch name {
+	case jobstate.FieldName:
+		m.ResetName()
+		return nil
+	case jobstate.FieldLastRunAt:
+		m.ResetLastRunAt()
+		return nil
+	case jobstate.FieldLastSuccessAt:
+		m.ResetLastSuccessAt()
+		return nil
+	case jobstate.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case jobstate.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case jobstate.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown JobState field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JobStateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JobStateMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JobStateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JobStateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JobStateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JobStateMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JobStateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown JobState unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JobStateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown JobState edge %s", name)
+}
+
+// NotificationPreferenceMutation represents an operation that mutates the NotificationPreference nodes in the graph.
+type NotificationPreferenceMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	doctorId         *int
+	adddoctorId      *int
+	minSeverity      *string
+	mutedKinds       *[]string
+	appendmutedKinds []string
+	quietStart       *int
+	addquietStart    *int
+	quietEnd         *int
+	addquietEnd      *int
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*NotificationPreference, error)
+	predicates       []predicate.NotificationPreference
+}
+
+var _ ent.Mutation = (*NotificationPreferenceMutation)(nil)
+
+// notificationpreferenceOption allows management of the mutation configuration using functional options.
+type notificationpreferenceOption func(*NotificationPreferenceMutation)
+
+// newNotificationPreferenceMutation creates new mutation for the NotificationPreference entity.
+func newNotificationPreferenceMutation(c config, op Op, opts ...notificationpreferenceOption) *NotificationPreferenceMutation {
+	m := &NotificationPreferenceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNotificationPreference,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNotificationPreferenceID sets the ID field of the mutation.
+func withNotificationPreferenceID(id int) notificationpreferenceOption {
+	return func(m *NotificationPreferenceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NotificationPreference
+		)
+		m.oldValue = func(ctx context.Context) (*NotificationPreference, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NotificationPreference.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNotificationPreference sets the old NotificationPreference of the mutation.
+func withNotificationPreference(node *NotificationPreference) notificationpreferenceOption {
+	return func(m *NotificationPreferenceMutation) {
+		m.oldValue = func(context.Context) (*NotificationPreference, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NotificationPreferenceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NotificationPreferenceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NotificationPreferenceMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NotificationPreferenceMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NotificationPreference.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDoctorId sets the "doctorId" field.
+func (m *NotificationPreferenceMutation) SetDoctorId(i int) {
+	m.doctorId = &i
+	m.adddoctorId = nil
+}
+
+// DoctorId returns the value of the "doctorId" field in the mutation.
+func (m *NotificationPreferenceMutation) DoctorId() (r int, exists bool) {
+	v := m.doctorId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDoctorId returns the old "doctorId" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationPreferenceMutation) OldDoctorId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDoctorId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDoctorId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDoctorId: %w", err)
+	}
+	return oldValue.DoctorId, nil
+}
+
+// AddDoctorId adds i to the "doctorId" field.
+func (m *NotificationPreferenceMutation) AddDoctorId(i int) {
+	if m.adddoctorId != nil {
+		*m.adddoctorId += i
+	} else {
+		m.adddoctorId = &i
+	}
+}
+
+// AddedDoctorId returns the value that was added to the "doctorId" field in this mutation.
+func (m *NotificationPreferenceMutation) AddedDoctorId() (r int, exists bool) {
+	v := m.adddoctorId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDoctorId resets all changes to the "doctorId" field.
+func (m *NotificationPreferenceMutation) ResetDoctorId() {
+	m.doctorId = nil
+	m.adddoctorId = nil
+}
+
+// SetMinSeverity sets the "minSeverity" field.
+func (m *NotificationPreferenceMutation) SetMinSeverity(s string) {
+	m.minSeverity = &s
+}
+
+// MinSeverity returns the value of the "minSeverity" field in the mutation.
+func (m *NotificationPreferenceMutation) MinSeverity() (r string, exists bool) {
+	v := m.minSeverity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMinSeverity returns the old "minSeverity" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationPreferenceMutation) OldMinSeverity(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMinSeverity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMinSeverity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMinSeverity: %w", err)
+	}
+	return oldValue.MinSeverity, nil
+}
+
+// ResetMinSeverity resets all changes to the "minSeverity" field.
+func (m *NotificationPreferenceMutation) ResetMinSeverity() {
+	m.minSeverity = nil
+}
+
+// SetMutedKinds sets the "mutedKinds" field.
+func (m *NotificationPreferenceMutation) SetMutedKinds(s []string) {
+	m.mutedKinds = &s
+	m.appendmutedKinds = nil
+}
+
+// MutedKinds returns the value of the "mutedKinds" field in the mutation.
+func (m *NotificationPreferenceMutation) MutedKinds() (r []string, exists bool) {
+	v := m.mutedKinds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMutedKinds returns the old "mutedKinds" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationPreferenceMutation) OldMutedKinds(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMutedKinds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMutedKinds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMutedKinds: %w", err)
+	}
+	return oldValue.MutedKinds, nil
+}
+
+// AppendMutedKinds adds s to the "mutedKinds" field.
+func (m *NotificationPreferenceMutation) AppendMutedKinds(s []string) {
+	m.appendmutedKinds = append(m.appendmutedKinds, s...)
+}
+
+// AppendedMutedKinds returns the list of values that were appended to the "mutedKinds" field in this mutation.
+func (m *NotificationPreferenceMutation) AppendedMutedKinds() ([]string, bool) {
+	if len(m.appendmutedKinds) == 0 {
+		return nil, false
+	}
+	return m.appendmutedKinds, true
+}
+
+// ClearMutedKinds clears the value of the "mutedKinds" field.
+func (m *NotificationPreferenceMutation) ClearMutedKinds() {
+	m.mutedKinds = nil
+	m.appendmutedKinds = nil
+	m.clearedFields[notificationpreference.FieldMutedKinds] = struct{}{}
+}
+
+// MutedKindsCleared returns if the "mutedKinds" field was cleared in this mutation.
+func (m *NotificationPreferenceMutation) MutedKindsCleared() bool {
+	_, ok := m.clearedFields[notificationpreference.FieldMutedKinds]
+	return ok
+}
+
+// ResetMutedKinds resets all changes to the "mutedKinds" field.
+func (m *NotificationPreferenceMutation) ResetMutedKinds() {
+	m.mutedKinds = nil
+	m.appendmutedKinds = nil
+	delete(m.clearedFields, notificationpreference.FieldMutedKinds)
+}
+
+// SetQuietStart sets the "quietStart" field.
+func (m *NotificationPreferenceMutation) SetQuietStart(i int) {
+	m.quietStart = &i
+	m.addquietStart = nil
+}
+
+// QuietStart returns the value of the "quietStart" field in the mutation.
+func (m *NotificationPreferenceMutation) QuietStart() (r int, exists bool) {
+	v := m.quietStart
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuietStart returns the old "quietStart" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationPreferenceMutation) OldQuietStart(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuietStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuietStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuietStart: %w", err)
+	}
+	return oldValue.QuietStart, nil
+}
+
+// AddQuietStart adds i to the "quietStart" field.
+func (m *NotificationPreferenceMutation) AddQuietStart(i int) {
+	if m.addquietStart != nil {
+		*m.addquietStart += i
+	} else {
+		m.addquietStart = &i
+	}
+}
+
+// AddedQuietStart returns the value that was added to the "quietStart" field in this mutation.
+func (m *NotificationPreferenceMutation) AddedQuietStart() (r int, exists bool) {
+	v := m.addquietStart
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearQuietStart clears the value of the "quietStart" field.
+func (m *NotificationPreferenceMutation) ClearQuietStart() {
+	m.quietStart = nil
+	m.addquietStart = nil
+	m.clearedFields[notificationpreference.FieldQuietStart] = struct{}{}
+}
+
+// QuietStartCleared returns if the "quietStart" field was cleared in this mutation.
+func (m *NotificationPreferenceMutation) QuietStartCleared() bool {
+	_, ok := m.clearedFields[notificationpreference.FieldQuietStart]
+	return ok
+}
+
+// ResetQuietStart resets all changes to the "quietStart" field.
+func (m *NotificationPreferenceMutation) ResetQuietStart() {
+	m.quietStart = nil
+	m.addquietStart = nil
+	delete(m.clearedFields, notificationpreference.FieldQuietStart)
+}
+
+// SetQuietEnd sets the "quietEnd" field.
+func (m *NotificationPreferenceMutation) SetQuietEnd(i int) {
+	m.quietEnd = &i
+	m.addquietEnd = nil
+}
+
+// QuietEnd returns the value of the "quietEnd" field in the mutation.
+func (m *NotificationPreferenceMutation) QuietEnd() (r int, exists bool) {
+	v := m.quietEnd
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuietEnd returns the old "quietEnd" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationPreferenceMutation) OldQuietEnd(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuietEnd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuietEnd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuietEnd: %w", err)
+	}
+	return oldValue.QuietEnd, nil
+}
+
+// AddQuietEnd adds i to the "quietEnd" field.
+func (m *NotificationPreferenceMutation) AddQuietEnd(i int) {
+	if m.addquietEnd != nil {
+		*m.addquietEnd += i
+	} else {
+		m.addquietEnd = &i
+	}
+}
+
+// AddedQuietEnd returns the value that was added to the "quietEnd" field in this mutation.
+func (m *NotificationPreferenceMutation) AddedQuietEnd() (r int, exists bool) {
+	v := m.addquietEnd
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearQuietEnd clears the value of the "quietEnd" field.
+func (m *NotificationPreferenceMutation) ClearQuietEnd() {
+	m.quietEnd = nil
+	m.addquietEnd = nil
+	m.clearedFields[notificationpreference.FieldQuietEnd] = struct{}{}
+}
+
+// QuietEndCleared returns if the "quietEnd" field was cleared in this mutation.
+func (m *NotificationPreferenceMutation) QuietEndCleared() bool {
+	_, ok := m.clearedFields[notificationpreference.FieldQuietEnd]
+	return ok
+}
+
+// ResetQuietEnd resets all changes to the "quietEnd" field.
+func (m *NotificationPreferenceMutation) ResetQuietEnd() {
+	m.quietEnd = nil
+	m.addquietEnd = nil
+	delete(m.clearedFields, notificationpreference.FieldQuietEnd)
+}
+
+// Where appends a list predicates to the NotificationPreferenceMutation builder.
+func (m *NotificationPreferenceMutation) Where(ps ...predicate.NotificationPreference) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NotificationPreferenceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NotificationPreferenceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NotificationPreference, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NotificationPreferenceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NotificationPreferenceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NotificationPreference).
+func (m *NotificationPreferenceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NotificationPreferenceMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.doctorId != nil {
+		fields = append(fields, notificationpreference.FieldDoctorId)
+	}
+	if m.minSeverity != nil {
+		fields = append(fields, notificationpreference.FieldMinSeverity)
+	}
+	if m.mutedKinds != nil {
+		fields = append(fields, notificationpreference.FieldMutedKinds)
+	}
+	if m.quietStart != nil {
+		fields = append(fields, notificationpreference.FieldQuietStart)
+	}
+	if m.quietEnd != nil {
+		fields = append(fields, notificationpreference.FieldQuietEnd)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NotificationPreferenceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case notificationpreference.FieldDoctorId:
+		return m.DoctorId()
+	case notificationpreference.FieldMinSeverity:
+		return m.MinSeverity()
+	case notificationpreference.FieldMutedKinds:
+		return m.MutedKinds()
+	case notificationpreference.FieldQuietStart:
+		return m.QuietStart()
+	case notificationpreference.FieldQuietEnd:
+		return m.QuietEnd()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NotificationPreferenceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notificationpreference.FieldDoctorId:
+		return m.OldDoctorId(ctx)
+	case notificationpreference.FieldMinSeverity:
+		return m.OldMinSeverity(ctx)
+	case notificationpreference.FieldMutedKinds:
+		return m.OldMutedKinds(ctx)
+	case notificationpreference.FieldQuietStart:
+		return m.OldQuietStart(ctx)
+	case notificationpreference.FieldQuietEnd:
+		return m.OldQuietEnd(ctx)
+	}
+	return nil, fmt.Errorf("unknown NotificationPreference field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationPreferenceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notificationpreference.FieldDoctorId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDoctorId(v)
+		return nil
+	case notificationpreference.FieldMinSeverity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMinSeverity(v)
+		return nil
+	case notificationpreference.FieldMutedKinds:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMutedKinds(v)
+		return nil
+	case notificationpreference.FieldQuietStart:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuietStart(v)
+		return nil
+	case notificationpreference.FieldQuietEnd:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuietEnd(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationPreference field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NotificationPreferenceMutation) AddedFields() []string {
+	var fields []string
+	if m.adddoctorId != nil {
+		fields = append(fields, notificationpreference.FieldDoctorId)
+	}
+	if m.addquietStart != nil {
+		fields = append(fields, notificationpreference.FieldQuietStart)
+	}
+	if m.addquietEnd != nil {
+		fields = append(fields, notificationpreference.FieldQuietEnd)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NotificationPreferenceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case notificationpreference.FieldDoctorId:
+		return m.AddedDoctorId()
+	case notificationpreference.FieldQuietStart:
+		return m.AddedQuietStart()
+	case notificationpreference.FieldQuietEnd:
+		return m.AddedQuietEnd()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationPreferenceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case notificationpreference.FieldDoctorId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDoctorId(v)
+		return nil
+	case notificationpreference.FieldQuietStart:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuietStart(v)
+		return nil
+	case notificationpreference.FieldQuietEnd:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuietEnd(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationPreference numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NotificationPreferenceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(notificationpreference.FieldMutedKinds) {
+		fields = append(fields, notificationpreference.FieldMutedKinds)
+	}
+	if m.FieldCleared(notificationpreference.FieldQuietStart) {
+		fields = append(fields, notificationpreference.FieldQuietStart)
+	}
+	if m.FieldCleared(notificationpreference.FieldQuietEnd) {
+		fields = append(fields, notificationpreference.FieldQuietEnd)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NotificationPreferenceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NotificationPreferenceMutation) ClearField(name string) error {
+	switch name {
+	case notificationpreference.FieldMutedKinds:
+		m.ClearMutedKinds()
+		return nil
+	case notificationpreference.FieldQuietStart:
+		m.ClearQuietStart()
+		return nil
+	case notificationpreference.FieldQuietEnd:
+		m.ClearQuietEnd()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationPreference nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NotificationPreferenceMutation) ResetField(name string) error {
+	switch name {
+	case notificationpreference.FieldDoctorId:
+		m.ResetDoctorId()
+		return nil
+	case notificationpreference.FieldMinSeverity:
+		m.ResetMinSeverity()
+		return nil
+	case notificationpreference.FieldMutedKinds:
+		m.ResetMutedKinds()
+		return nil
+	case notificationpreference.FieldQuietStart:
+		m.ResetQuietStart()
+		return nil
+	case notificationpreference.FieldQuietEnd:
+		m.ResetQuietEnd()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationPreference field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NotificationPreferenceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NotificationPreferenceMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NotificationPreferenceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NotificationPreferenceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NotificationPreferenceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NotificationPreferenceMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NotificationPreferenceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown NotificationPreference unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NotificationPreferenceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown NotificationPreference edge %s", name)
+}
+
+// OrganizationMutation represents an operation that mutates the Organization nodes in the graph.
+type OrganizationMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	name               *string
+	clearedFields      map[string]struct{}
+	departments        map[int]struct{}
+	removeddepartments map[int]struct{}
+	cleareddepartments bool
+	done               bool
+	oldValue           func(context.Context) (*Organization, error)
+	predicates         []predicate.Organization
+}
+
+var _ ent.Mutation = (*OrganizationMutation)(nil)
+
+// organizationOption allows management of the mutation configuration using functional options.
+type organizationOption func(*OrganizationMutation)
+
+// newOrganizationMutation creates new mutation for the Organization entity.
+func newOrganizationMutation(c config, op Op, opts ...organizationOption) *OrganizationMutation {
+	m := &OrganizationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOrganization,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOrganizationID sets the ID field of the mutation.
+func withOrganizationID(id int) organizationOption {
+	return func(m *OrganizationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Organization
+		)
+		m.oldValue = func(ctx context.Context) (*Organization, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Organization.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOrganization sets the old Organization of the mutation.
+func withOrganization(node *Organization) organizationOption {
+	return func(m *OrganizationMutation) {
+		m.oldValue = func(context.Context) (*Organization, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OrganizationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OrganizationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OrganizationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OrganizationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Organization.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *OrganizationMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *OrganizationMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *OrganizationMutation) ResetName() {
+	m.name = nil
+}
+
+// AddDepartmentIDs adds the "departments" edge to the Department entity by ids.
+func (m *OrganizationMutation) AddDepartmentIDs(ids ...int) {
+	if m.departments == nil {
+		m.departments = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.departments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDepartments clears the "departments" edge to the Department entity.
+func (m *OrganizationMutation) ClearDepartments() {
+	m.cleareddepartments = true
+}
+
+// DepartmentsCleared reports if the "departments" edge to the Department entity was cleared.
+func (m *OrganizationMutation) DepartmentsCleared() bool {
+	return m.cleareddepartments
+}
+
+// RemoveDepartmentIDs removes the "departments" edge to the Department entity by IDs.
+func (m *OrganizationMutation) RemoveDepartmentIDs(ids ...int) {
+	if m.removeddepartments == nil {
+		m.removeddepartments = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.departments, ids[i])
+		m.removeddepartments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDepartments returns the removed IDs of the "departments" edge to the Department entity.
+func (m *OrganizationMutation) RemovedDepartmentsIDs() (ids []int) {
+	for id := range m.removeddepartments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DepartmentsIDs returns the "departments" edge IDs in the mutation.
+func (m *OrganizationMutation) DepartmentsIDs() (ids []int) {
+	for id := range m.departments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDepartments resets all changes to the "departments" edge.
+func (m *OrganizationMutation) ResetDepartments() {
+	m.departments = nil
+	m.cleareddepartments = false
+	m.removeddepartments = nil
+}
+
+// Where appends a list predicates to the OrganizationMutation builder.
+func (m *OrganizationMutation) Where(ps ...predicate.Organization) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OrganizationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OrganizationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Organization, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OrganizationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OrganizationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Organization).
+func (m *OrganizationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OrganizationMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.name != nil {
+		fields = append(fields, organization.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OrganizationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case organization.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OrganizationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case organization.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown Organization field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrganizationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case organization.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Organization field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OrganizationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OrganizationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrganizationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Organization numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OrganizationMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OrganizationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OrganizationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Organization nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OrganizationMutation) ResetField(name string) error {
+	switch name {
+	case organization.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown Organization field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OrganizationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.departments != nil {
+		edges = append(edges, organization.EdgeDepartments)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OrganizationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case organization.EdgeDepartments:
+		ids := make([]ent.Value, 0, len(m.departments))
+		for id := range m.departments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OrganizationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removeddepartments != nil {
+		edges = append(edges, organization.EdgeDepartments)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OrganizationMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case organization.EdgeDepartments:
+		ids := make([]ent.Value, 0, len(m.removeddepartments))
+		for id := range m.removeddepartments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OrganizationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddepartments {
+		edges = append(edges, organization.EdgeDepartments)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OrganizationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case organization.EdgeDepartments:
+		return m.cleareddepartments
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OrganizationMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Organization unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OrganizationMutation) ResetEdge(name string) error {
+	switch name {
+	case organization.EdgeDepartments:
+		m.ResetDepartments()
+		return nil
+	}
+	return fmt.Errorf("unknown Organization edge %s", name)
+}
+
+// PatientMutation represents an operation that mutates the Patient nodes in the graph.
+type PatientMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	organizationId    *int
+	addorganizationId *int
+	surname           *string
+	name              *string
+	patronymic        *string
+	height            *int
+	addheight         *int
+	weight            *float64
+	addweight         *float64
+	degreeOfDanger    *int
+	adddegreeOfDanger *int
+	clearedFields     map[string]struct{}
+	repo              *int
+	clearedrepo       bool
+	doctor            map[int]struct{}
+	removeddoctor     map[int]struct{}
+	cleareddoctor     bool
+	ills              *int
+	clearedills       bool
+	done              bool
+	oldValue          func(context.Context) (*Patient, error)
+	predicates        []predicate.Patient
+}
+
+var _ ent.Mutation = (*PatientMutation)(nil)
+
+// patientOption allows management of the mutation configuration using functional options.
+type patientOption func(*PatientMutation)
+
+// newPatientMutation creates new mutation for the Patient entity.
+func newPatientMutation(c config, op Op, opts ...patientOption) *PatientMutation {
+	m := &PatientMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePatient,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPatientID sets the ID field of the mutation.
+func withPatientID(id int) patientOption {
+	return func(m *PatientMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Patient
+		)
+		m.oldValue = func(ctx context.Context) (*Patient, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Patient.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPatient sets the old Patient of the mutation.
+func withPatient(node *Patient) patientOption {
+	return func(m *PatientMutation) {
+		m.oldValue = func(context.Context) (*Patient, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PatientMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PatientMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PatientMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PatientMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Patient.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (m *PatientMutation) SetOrganizationId(i int) {
+	m.organizationId = &i
+	m.addorganizationId = nil
+}
+
+// OrganizationId returns the value of the "organizationId" field in the mutation.
+func (m *PatientMutation) OrganizationId() (r int, exists bool) {
+	v := m.organizationId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationId returns the old "organizationId" field's value of the Patient entity.
+// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PatientMutation) OldOrganizationId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrganizationId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrganizationId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationId: %w", err)
+	}
+	return oldValue.OrganizationId, nil
+}
+
+// AddOrganizationId adds i to the "organizationId" field.
+func (m *PatientMutation) AddOrganizationId(i int) {
+	if m.addorganizationId != nil {
+		*m.addorganizationId += i
+	} else {
+		m.addorganizationId = &i
+	}
+}
+
+// AddedOrganizationId returns the value that was added to the "organizationId" field in this mutation.
+func (m *PatientMutation) AddedOrganizationId() (r int, exists bool) {
+	v := m.addorganizationId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOrganizationId clears the value of the "organizationId" field.
+func (m *PatientMutation) ClearOrganizationId() {
+	m.organizationId = nil
+	m.addorganizationId = nil
+	m.clearedFields[patient.FieldOrganizationId] = struct{}{}
+}
+
+// OrganizationIdCleared returns if the "organizationId" field was cleared in this mutation.
+func (m *PatientMutation) OrganizationIdCleared() bool {
+	_, ok := m.clearedFields[patient.FieldOrganizationId]
+	return ok
+}
+
+// ResetOrganizationId resets all changes to the "organizationId" field.
+func (m *PatientMutation) ResetOrganizationId() {
+	m.organizationId = nil
+	m.addorganizationId = nil
+	delete(m.clearedFields, patient.FieldOrganizationId)
+}
+
+// SetSurname sets the "surname" field.
+func (m *PatientMutation) SetSurname(s string) {
+	m.surname = &s
+}
+
+// Surname returns the value of the "surname" field in the mutation.
+func (m *PatientMutation) Surname() (r string, exists bool) {
+	v := m.surname
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSurname returns the old "surname" field's value of the Patient entity.
+// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PatientMutation) OldSurname(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSurname is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSurname requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSurname: %w", err)
+	}
+	return oldValue.Surname, nil
+}
+
+// ResetSurname resets all changes to the "surname" field.
+func (m *PatientMutation) ResetSurname() {
+	m.surname = nil
+}
+
+// SetName sets the "name" field.
+func (m *PatientMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PatientMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Patient entity.
+// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PatientMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PatientMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPatronymic sets the "patronymic" field.
+func (m *PatientMutation) SetPatronymic(s string) {
+	m.patronymic = &s
+}
+
+// Patronymic returns the value of the "patronymic" field in the mutation.
+func (m *PatientMutation) Patronymic() (r string, exists bool) {
+	v := m.patronymic
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPatronymic returns the old "patronymic" field's value of the Patient entity.
+// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PatientMutation) OldPatronymic(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPatronymic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPatronymic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPatronymic: %w", err)
+	}
+	return oldValue.Patronymic, nil
+}
+
+// ResetPatronymic resets all changes to the "patronymic" field.
+func (m *PatientMutation) ResetPatronymic() {
+	m.patronymic = nil
+}
+
+// SetHeight sets the "height" field.
+func (m *PatientMutation) SetHeight(i int) {
+	m.height = &i
+	m.addheight = nil
+}
+
+// Height returns the value of the "height" field in the mutation.
+func (m *PatientMutation) Height() (r int, exists bool) {
+	v := m.height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeight returns the old "height" field's value of the Patient entity.
+// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PatientMutation) OldHeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
+	}
+	return oldValue.Height, nil
+}
+
+// AddHeight adds i to the "height" field.
+func (m *PatientMutation) AddHeight(i int) {
+	if m.addheight != nil {
+		*m.addheight += i
+	} else {
+		m.addheight = &i
+	}
+}
+
+// AddedHeight returns the value that was added to the "height" field in this mutation.
+func (m *PatientMutation) AddedHeight() (r int, exists bool) {
+	v := m.addheight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHeight resets all changes to the "height" field.
+func (m *PatientMutation) ResetHeight() {
+	m.height = nil
+	m.addheight = nil
+}
+
+// SetWeight sets the "weight" field.
+func (m *PatientMutation) SetWeight(f float64) {
+	m.weight = &f
+	m.addweight = nil
+}
+
+// Weight returns the value of the "weight" field in the mutation.
+func (m *PatientMutation) Weight() (r float64, exists bool) {
+	v := m.weight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWeight returns the old "weight" field's value of the Patient entity.
+// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PatientMutation) OldWeight(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWeight: %w", err)
+	}
+	return oldValue.Weight, nil
+}
+
+// AddWeight adds f to the "weight" field.
+func (m *PatientMutation) AddWeight(f float64) {
+	if m.addweight != nil {
+		*m.addweight += f
+	} else {
+		m.addweight = &f
+	}
+}
+
+// AddedWeight returns the value that was added to the "weight" field in this mutation.
+func (m *PatientMutation) AddedWeight() (r float64, exists bool) {
+	v := m.addweight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWeight resets all changes to the "weight" field.
+func (m *PatientMutation) ResetWeight() {
+	m.weight = nil
+	m.addweight = nil
+}
+
+// SetRoomNumber sets the "roomNumber" field.
+func (m *PatientMutation) SetRoomNumber(i int) {
+	m.repo = &i
+}
+
+// RoomNumber returns the value of the "roomNumber" field in the mutation.
+func (m *PatientMutation) RoomNumber() (r int, exists bool) {
+	v := m.repo
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoomNumber returns the old "roomNumber" field's value of the Patient entity.
+// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PatientMutation) OldRoomNumber(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoomNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoomNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoomNumber: %w", err)
+	}
+	return oldValue.RoomNumber, nil
+}
+
+// ResetRoomNumber resets all changes to the "roomNumber" field.
+func (m *PatientMutation) ResetRoomNumber() {
+	m.repo = nil
+}
+
+// SetDegreeOfDanger sets the "degreeOfDanger" field.
+func (m *PatientMutation) SetDegreeOfDanger(i int) {
+	m.degreeOfDanger = &i
+	m.adddegreeOfDanger = nil
+}
+
+// DegreeOfDanger returns the value of the "degreeOfDanger" field in the mutation.
+func (m *PatientMutation) DegreeOfDanger() (r int, exists bool) {
+	v := m.degreeOfDanger
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDegreeOfDanger returns the old "degreeOfDanger" field's value of the Patient entity.
+// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PatientMutation) OldDegreeOfDanger(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDegreeOfDanger is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDegreeOfDanger requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDegreeOfDanger: %w", err)
+	}
+	return oldValue.DegreeOfDanger, nil
+}
+
+// AddDegreeOfDanger adds i to the "degreeOfDanger" field.
+func (m *PatientMutation) AddDegreeOfDanger(i int) {
+	if m.adddegreeOfDanger != nil {
+		*m.adddegreeOfDanger += i
+	} else {
+		m.adddegreeOfDanger = &i
+	}
+}
+
+// AddedDegreeOfDanger returns the value that was added to the "degreeOfDanger" field in this mutation.
+func (m *PatientMutation) AddedDegreeOfDanger() (r int, exists bool) {
+	v := m.adddegreeOfDanger
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDegreeOfDanger resets all changes to the "degreeOfDanger" field.
+func (m *PatientMutation) ResetDegreeOfDanger() {
+	m.degreeOfDanger = nil
+	m.adddegreeOfDanger = nil
+}
+
+// SetRepoID sets the "repo" edge to the Room entity by id.
+func (m *PatientMutation) SetRepoID(id int) {
+	m.repo = &id
+}
+
+// ClearRepo clears the "repo" edge to the Room entity.
+func (m *PatientMutation) ClearRepo() {
+	m.clearedrepo = true
+}
+
+// RepoCleared reports if the "repo" edge to the Room entity was cleared.
+func (m *PatientMutation) RepoCleared() bool {
+	return m.clearedrepo
+}
+
+// RepoID returns the "repo" edge ID in the mutation.
+func (m *PatientMutation) RepoID() (id int, exists bool) {
+	if m.repo != nil {
+		return *m.repo, true
+	}
+	return
+}
+
+// RepoIDs returns the "repo" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RepoID instead. It exists only for internal usage by the builders.
+func (m *PatientMutation) RepoIDs() (ids []int) {
+	if id := m.repo; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRepo resets all changes to the "repo" edge.
+func (m *PatientMutation) ResetRepo() {
+	m.repo = nil
+	m.clearedrepo = false
+}
+
+// AddDoctorIDs adds the "doctor" edge to the Doctor entity by ids.
+func (m *PatientMutation) AddDoctorIDs(ids ...int) {
+	if m.doctor == nil {
+		m.doctor = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.doctor[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDoctor clears the "doctor" edge to the Doctor entity.
+func (m *PatientMutation) ClearDoctor() {
+	m.cleareddoctor = true
+}
+
+// DoctorCleared reports if the "doctor" edge to the Doctor entity was cleared.
+func (m *PatientMutation) DoctorCleared() bool {
+	return m.cleareddoctor
+}
+
+// RemoveDoctorIDs removes the "doctor" edge to the Doctor entity by IDs.
+func (m *PatientMutation) RemoveDoctorIDs(ids ...int) {
+	if m.removeddoctor == nil {
+		m.removeddoctor = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.doctor, ids[i])
+		m.removeddoctor[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDoctor returns the removed IDs of the "doctor" edge to the Doctor entity.
+func (m *PatientMutation) RemovedDoctorIDs() (ids []int) {
+	for id := range m.removeddoctor {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DoctorIDs returns the "doctor" edge IDs in the mutation.
+func (m *PatientMutation) DoctorIDs() (ids []int) {
+	for id := range m.doctor {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDoctor resets all changes to the "doctor" edge.
+func (m *PatientMutation) ResetDoctor() {
+	m.doctor = nil
+	m.cleareddoctor = false
+	m.removeddoctor = nil
+}
+
+// SetIllsID sets the "ills" edge to the Disease entity by id.
+func (m *PatientMutation) SetIllsID(id int) {
+	m.ills = &id
+}
+
+// ClearIlls clears the "ills" edge to the Disease entity.
+func (m *PatientMutation) ClearIlls() {
+	m.clearedills = true
+}
+
+// IllsCleared reports if the "ills" edge to the Disease entity was cleared.
+func (m *PatientMutation) IllsCleared() bool {
+	return m.clearedills
+}
+
+// IllsID returns the "ills" edge ID in the mutation.
+func (m *PatientMutation) IllsID() (id int, exists bool) {
+	if m.ills != nil {
+		return *m.ills, true
+	}
+	return
+}
+
+// IllsIDs returns the "ills" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// IllsID instead. It exists only for internal usage by the builders.
+func (m *PatientMutation) IllsIDs() (ids []int) {
+	if id := m.ills; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetIlls resets all changes to the "ills" edge.
+func (m *PatientMutation) ResetIlls() {
+	m.ills = nil
+	m.clearedills = false
+}
+
+// Where appends a list predicates to the PatientMutation builder.
+func (m *PatientMutation) Where(ps ...predicate.Patient) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PatientMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PatientMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Patient, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PatientMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PatientMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Patient).
+func (m *PatientMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PatientMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.organizationId != nil {
+		fields = append(fields, patient.FieldOrganizationId)
+	}
+	if m.surname != nil {
+		fields = append(fields, patient.FieldSurname)
+	}
+	if m.name != nil {
+		fields = append(fields, patient.FieldName)
+	}
+	if m.patronymic != nil {
+		fields = append(fields, patient.FieldPatronymic)
+	}
+	if m.height != nil {
+		fields = append(fields, patient.FieldHeight)
+	}
+	if m.weight != nil {
+		fields = append(fields, patient.FieldWeight)
+	}
+	if m.repo != nil {
+		fields = append(fields, patient.FieldRoomNumber)
+	}
+	if m.degreeOfDanger != nil {
+		fields = append(fields, patient.FieldDegreeOfDanger)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PatientMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case patient.FieldOrganizationId:
+		return m.OrganizationId()
+	case patient.FieldSurname:
+		return m.Surname()
+	case patient.FieldName:
+		return m.Name()
+	case patient.FieldPatronymic:
+		return m.Patronymic()
+	case patient.FieldHeight:
+		return m.Height()
+	case patient.FieldWeight:
+		return m.Weight()
+	case patient.FieldRoomNumber:
+		return m.RoomNumber()
+	case patient.FieldDegreeOfDanger:
+		return m.DegreeOfDanger()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PatientMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case patient.FieldOrganizationId:
+		return m.OldOrganizationId(ctx)
+	case patient.FieldSurname:
+		return m.OldSurname(ctx)
+	case patient.FieldName:
+		return m.OldName(ctx)
+	case patient.FieldPatronymic:
+		return m.OldPatronymic(ctx)
+	case patient.FieldHeight:
+		return m.OldHeight(ctx)
+	case patient.FieldWeight:
+		return m.OldWeight(ctx)
+	case patient.FieldRoomNumber:
+		return m.OldRoomNumber(ctx)
+	case patient.FieldDegreeOfDanger:
+		return m.OldDegreeOfDanger(ctx)
+	}
+	return nil, fmt.Errorf("unknown Patient field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PatientMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case patient.FieldOrganizationId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationId(v)
+		return nil
+	case patient.FieldSurname:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSurname(v)
+		return nil
+	case patient.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case patient.FieldPatronymic:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPatronymic(v)
+		return nil
+	case patient.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeight(v)
+		return nil
+	case patient.FieldWeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWeight(v)
+		return nil
+	case patient.FieldRoomNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoomNumber(v)
+		return nil
+	case patient.FieldDegreeOfDanger:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDegreeOfDanger(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Patient field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PatientMutation) AddedFields() []string {
+	var fields []string
+	if m.addorganizationId != nil {
+		fields = append(fields, patient.FieldOrganizationId)
+	}
+	if m.addheight != nil {
+		fields = append(fields, patient.FieldHeight)
+	}
+	if m.addweight != nil {
+		fields = append(fields, patient.FieldWeight)
+	}
+	if m.adddegreeOfDanger != nil {
+		fields = append(fields, patient.FieldDegreeOfDanger)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PatientMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case patient.FieldOrganizationId:
+		return m.AddedOrganizationId()
+	case patient.FieldHeight:
+		return m.AddedHeight()
+	case patient.FieldWeight:
+		return m.AddedWeight()
+	case patient.FieldDegreeOfDanger:
+		return m.AddedDegreeOfDanger()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PatientMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case patient.FieldOrganizationId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOrganizationId(v)
+		return nil
+	case patient.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeight(v)
+		return nil
+	case patient.FieldWeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWeight(v)
+		return nil
+	case patient.FieldDegreeOfDanger:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDegreeOfDanger(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Patient numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PatientMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(patient.FieldOrganizationId) {
+		fields = append(fields, patient.FieldOrganizationId)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PatientMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PatientMutation) ClearField(name string) error {
+	switch name {
+	case patient.FieldOrganizationId:
+		m.ClearOrganizationId()
+		return nil
+	}
+	return fmt.Errorf("unknown Patient nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PatientMutation) ResetField(name string) error {
+	switch name {
+	case patient.FieldOrganizationId:
+		m.ResetOrganizationId()
+		return nil
+	case patient.FieldSurname:
+		m.ResetSurname()
+		return nil
+	case patient.FieldName:
+		m.ResetName()
+		return nil
+	case patient.FieldPatronymic:
+		m.ResetPatronymic()
+		return nil
+	case patient.FieldHeight:
+		m.ResetHeight()
+		return nil
+	case patient.FieldWeight:
+		m.ResetWeight()
+		return nil
+	case patient.FieldRoomNumber:
+		m.ResetRoomNumber()
+		return nil
+	case patient.FieldDegreeOfDanger:
+		m.ResetDegreeOfDanger()
+		return nil
+	}
+	return fmt.Errorf("unknown Patient field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PatientMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.repo != nil {
+		edges = append(edges, patient.EdgeRepo)
+	}
+	if m.doctor != nil {
+		edges = append(edges, patient.EdgeDoctor)
+	}
+	if m.ills != nil {
+		edges = append(edges, patient.EdgeIlls)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PatientMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case patient.EdgeRepo:
+		if id := m.repo; id != nil {
+			return []ent.Value{*id}
+		}
+	case patient.EdgeDoctor:
+		ids := make([]ent.Value, 0, len(m.doctor))
+		for id := range m.doctor {
+			ids = append(ids, id)
+		}
+		return ids
+	case patient.EdgeIlls:
+		if id := m.ills; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PatientMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removeddoctor != nil {
+		edges = append(edges, patient.EdgeDoctor)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PatientMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case patient.EdgeDoctor:
+		ids := make([]ent.Value, 0, len(m.removeddoctor))
+		for id := range m.removeddoctor {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PatientMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedrepo {
+		edges = append(edges, patient.EdgeRepo)
+	}
+	if m.cleareddoctor {
+		edges = append(edges, patient.EdgeDoctor)
+	}
+	if m.clearedills {
+		edges = append(edges, patient.EdgeIlls)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PatientMutation) EdgeCleared(name string) bool {
+	switch name {
+	case patient.EdgeRepo:
+		return m.clearedrepo
+	case patient.EdgeDoctor:
+		return m.cleareddoctor
+	case patient.EdgeIlls:
+		return m.clearedills
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PatientMutation) ClearEdge(name string) error {
+	switch name {
+	case patient.EdgeRepo:
+		m.ClearRepo()
+		return nil
+	case patient.EdgeIlls:
+		m.ClearIlls()
+		return nil
+	}
+	return fmt.Errorf("unknown Patient unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PatientMutation) ResetEdge(name string) error {
+	switch name {
+	case patient.EdgeRepo:
+		m.ResetRepo()
+		return nil
+	case patient.EdgeDoctor:
+		m.ResetDoctor()
+		return nil
+	case patient.EdgeIlls:
+		m.ResetIlls()
+		return nil
+	}
+	return fmt.Errorf("unknown Patient edge %s", name)
+}
+
+// RoomMutation represents an operation that mutates the Room nodes in the graph.
+type RoomMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	organizationId    *int
+	addorganizationId *int
+	number            *int
+	addnumber         *int
+	floor             *int
+	addfloor          *int
+	numberBeds        *int
+	addnumberBeds     *int
+	numberPatients    *int
+	addnumberPatients *int
+	typeRoom          *string
+	clearedFields     map[string]struct{}
+	contains          map[int]struct{}
+	removedcontains   map[int]struct{}
+	clearedcontains   bool
+	department        *int
+	cleareddepartment bool
+	done              bool
+	oldValue          func(context.Context) (*Room, error)
+	predicates        []predicate.Room
+}
+
+var _ ent.Mutation = (*RoomMutation)(nil)
+
+// roomOption allows management of the mutation configuration using functional options.
+type roomOption func(*RoomMutation)
+
+// newRoomMutation creates new mutation for the Room entity.
+func newRoomMutation(c config, op Op, opts ...roomOption) *RoomMutation {
+	m := &RoomMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRoom,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoomID sets the ID field of the mutation.
+func withRoomID(id int) roomOption {
+	return func(m *RoomMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Room
+		)
+		m.oldValue = func(ctx context.Context) (*Room, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Room.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRoom sets the old Room of the mutation.
+func withRoom(node *Room) roomOption {
+	return func(m *RoomMutation) {
+		m.oldValue = func(context.Context) (*Room, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoomMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoomMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoomMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoomMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Room.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (m *RoomMutation) SetOrganizationId(i int) {
+	m.organizationId = &i
+	m.addorganizationId = nil
+}
+
+// OrganizationId returns the value of the "organizationId" field in the mutation.
+func (m *RoomMutation) OrganizationId() (r int, exists bool) {
+	v := m.organizationId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationId returns the old "organizationId" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldOrganizationId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrganizationId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrganizationId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationId: %w", err)
+	}
+	return oldValue.OrganizationId, nil
+}
+
+// AddOrganizationId adds i to the "organizationId" field.
+func (m *RoomMutation) AddOrganizationId(i int) {
+	if m.addorganizationId != nil {
+		*m.addorganizationId += i
+	} else {
+		m.addorganizationId = &i
+	}
+}
+
+// AddedOrganizationId returns the value that was added to the "organizationId" field in this mutation.
+func (m *RoomMutation) AddedOrganizationId() (r int, exists bool) {
+	v := m.addorganizationId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOrganizationId clears the value of the "organizationId" field.
+func (m *RoomMutation) ClearOrganizationId() {
+	m.organizationId = nil
+	m.addorganizationId = nil
+	m.clearedFields[room.FieldOrganizationId] = struct{}{}
+}
+
+// OrganizationIdCleared returns if the "organizationId" field was cleared in this mutation.
+func (m *RoomMutation) OrganizationIdCleared() bool {
+	_, ok := m.clearedFields[room.FieldOrganizationId]
+	return ok
+}
+
+// ResetOrganizationId resets all changes to the "organizationId" field.
+func (m *RoomMutation) ResetOrganizationId() {
+	m.organizationId = nil
+	m.addorganizationId = nil
+	delete(m.clearedFields, room.FieldOrganizationId)
+}
+
+// SetNumber sets the "number" field.
+func (m *RoomMutation) SetNumber(i int) {
+	m.number = &i
+	m.addnumber = nil
+}
+
+// Number returns the value of the "number" field in the mutation.
+func (m *RoomMutation) Number() (r int, exists bool) {
+	v := m.number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNumber returns the old "number" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldNumber(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNumber: %w", err)
+	}
+	return oldValue.Number, nil
+}
+
+// AddNumber adds i to the "number" field.
+func (m *RoomMutation) AddNumber(i int) {
+	if m.addnumber != nil {
+		*m.addnumber += i
+	} else {
+		m.addnumber = &i
+	}
+}
+
+// AddedNumber returns the value that was added to the "number" field in this mutation.
+func (m *RoomMutation) AddedNumber() (r int, exists bool) {
+	v := m.addnumber
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNumber resets all changes to the "number" field.
+func (m *RoomMutation) ResetNumber() {
+	m.number = nil
+	m.addnumber = nil
+}
+
+// SetFloor sets the "floor" field.
+func (m *RoomMutation) SetFloor(i int) {
+	m.floor = &i
+	m.addfloor = nil
+}
+
+// Floor returns the value of the "floor" field in the mutation.
+func (m *RoomMutation) Floor() (r int, exists bool) {
+	v := m.floor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFloor returns the old "floor" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldFloor(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFloor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFloor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFloor: %w", err)
+	}
+	return oldValue.Floor, nil
+}
+
+// AddFloor adds i to the "floor" field.
+func (m *RoomMutation) AddFloor(i int) {
+	if m.addfloor != nil {
+		*m.addfloor += i
+	} else {
+		m.addfloor = &i
+	}
+}
+
+// AddedFloor returns the value that was added to the "floor" field in this mutation.
+func (m *RoomMutation) AddedFloor() (r int, exists bool) {
+	v := m.addfloor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFloor resets all changes to the "floor" field.
+func (m *RoomMutation) ResetFloor() {
+	m.floor = nil
+	m.addfloor = nil
+}
+
+// SetNumberBeds sets the "numberBeds" field.
+func (m *RoomMutation) SetNumberBeds(i int) {
+	m.numberBeds = &i
+	m.addnumberBeds = nil
+}
+
+// NumberBeds returns the value of the "numberBeds" field in the mutation.
+func (m *RoomMutation) NumberBeds() (r int, exists bool) {
+	v := m.numberBeds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNumberBeds returns the old "numberBeds" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldNumberBeds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNumberBeds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNumberBeds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNumberBeds: %w", err)
+	}
+	return oldValue.NumberBeds, nil
+}
+
+// AddNumberBeds adds i to the "numberBeds" field.
+func (m *RoomMutation) AddNumberBeds(i int) {
+	if m.addnumberBeds != nil {
+		*m.addnumberBeds += i
+	} else {
+		m.addnumberBeds = &i
+	}
+}
+
+// AddedNumberBeds returns the value that was added to the "numberBeds" field in this mutation.
+func (m *RoomMutation) AddedNumberBeds() (r int, exists bool) {
+	v := m.addnumberBeds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNumberBeds resets all changes to the "numberBeds" field.
+func (m *RoomMutation) ResetNumberBeds() {
+	m.numberBeds = nil
+	m.addnumberBeds = nil
+}
+
+// SetNumberPatients sets the "numberPatients" field.
+func (m *RoomMutation) SetNumberPatients(i int) {
+	m.numberPatients = &i
+	m.addnumberPatients = nil
+}
+
+// NumberPatients returns the value of the "numberPatients" field in the mutation.
+func (m *RoomMutation) NumberPatients() (r int, exists bool) {
+	v := m.numberPatients
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNumberPatients returns the old "numberPatients" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldNumberPatients(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNumberPatients is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNumberPatients requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNumberPatients: %w", err)
+	}
+	return oldValue.NumberPatients, nil
+}
+
+// AddNumberPatients adds i to the "numberPatients" field.
+func (m *RoomMutation) AddNumberPatients(i int) {
+	if m.addnumberPatients != nil {
+		*m.addnumberPatients += i
+	} else {
+		m.addnumberPatients = &i
+	}
+}
+
+// AddedNumberPatients returns the value that was added to the "numberPatients" field in this mutation.
+func (m *RoomMutation) AddedNumberPatients() (r int, exists bool) {
+	v := m.addnumberPatients
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNumberPatients resets all changes to the "numberPatients" field.
+func (m *RoomMutation) ResetNumberPatients() {
+	m.numberPatients = nil
+	m.addnumberPatients = nil
+}
+
+// SetTypeRoom sets the "typeRoom" field.
+func (m *RoomMutation) SetTypeRoom(s string) {
+	m.typeRoom = &s
+}
+
+// TypeRoom returns the value of the "typeRoom" field in the mutation.
+func (m *RoomMutation) TypeRoom() (r string, exists bool) {
+	v := m.typeRoom
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTypeRoom returns the old "typeRoom" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldTypeRoom(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTypeRoom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTypeRoom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTypeRoom: %w", err)
+	}
+	return oldValue.TypeRoom, nil
+}
+
+// ResetTypeRoom resets all changes to the "typeRoom" field.
+func (m *RoomMutation) ResetTypeRoom() {
+	m.typeRoom = nil
+}
+
+// SetDepartmentId sets the "departmentId" field.
+func (m *RoomMutation) SetDepartmentId(i int) {
+	m.department = &i
+}
+
+// DepartmentId returns the value of the "departmentId" field in the mutation.
+func (m *RoomMutation) DepartmentId() (r int, exists bool) {
+	v := m.department
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDepartmentId returns the old "departmentId" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldDepartmentId(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDepartmentId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDepartmentId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDepartmentId: %w", err)
+	}
+	return oldValue.DepartmentId, nil
+}
+
+// ClearDepartmentId clears the value of the "departmentId" field.
+func (m *RoomMutation) ClearDepartmentId() {
+	m.department = nil
+	m.clearedFields[room.FieldDepartmentId] = struct{}{}
+}
+
+// DepartmentIdCleared returns if the "departmentId" field was cleared in this mutation.
+func (m *RoomMutation) DepartmentIdCleared() bool {
+	_, ok := m.clearedFields[room.FieldDepartmentId]
+	return ok
+}
+
+// ResetDepartmentId resets all changes to the "departmentId" field.
+func (m *RoomMutation) ResetDepartmentId() {
+	m.department = nil
+	delete(m.clearedFields, room.FieldDepartmentId)
+}
+
+// AddContainIDs adds the "contains" edge to the Patient entity by ids.
+func (m *RoomMutation) AddContainIDs(ids ...int) {
+	if m.contains == nil {
+		m.contains = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.contains[ids[i]] = struct{}{}
+	}
+}
+
+// ClearContains clears the "contains" edge to the Patient entity.
+func (m *RoomMutation) ClearContains() {
+	m.clearedcontains = true
+}
+
+// ContainsCleared reports if the "contains" edge to the Patient entity was cleared.
+func (m *RoomMutation) ContainsCleared() bool {
+	return m.clearedcontains
+}
+
+// RemoveContainIDs removes the "contains" edge to the Patient entity by IDs.
+func (m *RoomMutation) RemoveContainIDs(ids ...int) {
+	if m.removedcontains == nil {
+		m.removedcontains = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.contains, ids[i])
+		m.removedcontains[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedContains returns the removed IDs of the "contains" edge to the Patient entity.
+func (m *RoomMutation) RemovedContainsIDs() (ids []int) {
+	for id := range m.removedcontains {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ContainsIDs returns the "contains" edge IDs in the mutation.
+func (m *RoomMutation) ContainsIDs() (ids []int) {
+	for id := range m.contains {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetContains resets all changes to the "contains" edge.
+func (m *RoomMutation) ResetContains() {
+	m.contains = nil
+	m.clearedcontains = false
+	m.removedcontains = nil
+}
+
+// SetDepartmentID sets the "department" edge to the Department entity by id.
+func (m *RoomMutation) SetDepartmentID(id int) {
+	m.department = &id
+}
+
+// ClearDepartment clears the "department" edge to the Department entity.
+func (m *RoomMutation) ClearDepartment() {
+	m.cleareddepartment = true
+}
+
+// DepartmentCleared reports if the "department" edge to the Department entity was cleared.
+func (m *RoomMutation) DepartmentCleared() bool {
+	return m.DepartmentIdCleared() || m.cleareddepartment
+}
+
+// DepartmentID returns the "department" edge ID in the mutation.
+func (m *RoomMutation) DepartmentID() (id int, exists bool) {
+	if m.department != nil {
+		return *m.department, true
+	}
+	return
+}
+
+// DepartmentIDs returns the "department" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DepartmentID instead. It exists only for internal usage by the builders.
+func (m *RoomMutation) DepartmentIDs() (ids []int) {
+	if id := m.department; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDepartment resets all changes to the "department" edge.
+func (m *RoomMutation) ResetDepartment() {
+	m.department = nil
+	m.cleareddepartment = false
+}
+
+// Where appends a list predicates to the RoomMutation builder.
+func (m *RoomMutation) Where(ps ...predicate.Room) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoomMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoomMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Room, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoomMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoomMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Room).
+func (m *RoomMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoomMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.organizationId != nil {
+		fields = append(fields, room.FieldOrganizationId)
+	}
+	if m.number != nil {
+		fields = append(fields, room.FieldNumber)
+	}
+	if m.floor != nil {
+		fields = append(fields, room.FieldFloor)
+	}
+	if m.numberBeds != nil {
+		fields = append(fields, room.FieldNumberBeds)
+	}
+	if m.numberPatients != nil {
+		fields = append(fields, room.FieldNumberPatients)
+	}
+	if m.typeRoom != nil {
+		fields = append(fields, room.FieldTypeRoom)
+	}
+	if m.department != nil {
+		fields = append(fields, room.FieldDepartmentId)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoomMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case room.FieldOrganizationId:
+		return m.OrganizationId()
+	case room.FieldNumber:
+		return m.Number()
+	case room.FieldFloor:
+		return m.Floor()
+	case room.FieldNumberBeds:
+		return m.NumberBeds()
+	case room.FieldNumberPatients:
+		return m.NumberPatients()
+	case room.FieldTypeRoom:
+		return m.TypeRoom()
+	case room.FieldDepartmentId:
+		return m.DepartmentId()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoomMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case room.FieldOrganizationId:
+		return m.OldOrganizationId(ctx)
+	case room.FieldNumber:
+		return m.OldNumber(ctx)
+	case room.FieldFloor:
+		return m.OldFloor(ctx)
+	case room.FieldNumberBeds:
+		return m.OldNumberBeds(ctx)
+	case room.FieldNumberPatients:
+		return m.OldNumberPatients(ctx)
+	case room.FieldTypeRoom:
+		return m.OldTypeRoom(ctx)
+	case room.FieldDepartmentId:
+		return m.OldDepartmentId(ctx)
+	}
+	return nil, fmt.Errorf("unknown Room field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoomMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case room.FieldOrganizationId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationId(v)
+		return nil
+	case room.FieldNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNumber(v)
+		return nil
+	case room.FieldFloor:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFloor(v)
+		return nil
+	case room.FieldNumberBeds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNumberBeds(v)
+		return nil
+	case room.FieldNumberPatients:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNumberPatients(v)
+		return nil
+	case room.FieldTypeRoom:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTypeRoom(v)
+		return nil
+	case room.FieldDepartmentId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDepartmentId(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Room field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoomMutation) AddedFields() []string {
+	var fields []string
+	if m.addorganizationId != nil {
+		fields = append(fields, room.FieldOrganizationId)
+	}
+	if m.addnumber != nil {
+		fields = append(fields, room.FieldNumber)
+	}
+	if m.addfloor != nil {
+		fields = append(fields, room.FieldFloor)
+	}
+	if m.addnumberBeds != nil {
+		fields = append(fields, room.FieldNumberBeds)
+	}
+	if m.addnumberPatients != nil {
+		fields = append(fields, room.FieldNumberPatients)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoomMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case room.FieldOrganizationId:
+		return m.AddedOrganizationId()
+	case room.FieldNumber:
+		return m.AddedNumber()
+	case room.FieldFloor:
+		return m.AddedFloor()
+	case room.FieldNumberBeds:
+		return m.AddedNumberBeds()
+	case room.FieldNumberPatients:
+		return m.AddedNumberPatients()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoomMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case room.FieldOrganizationId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOrganizationId(v)
+		return nil
+	case room.FieldNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNumber(v)
+		return nil
+	case room.FieldFloor:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFloor(v)
+		return nil
+	case room.FieldNumberBeds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNumberBeds(v)
+		return nil
+	case room.FieldNumberPatients:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNumberPatients(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Room numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoomMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(room.FieldOrganizationId) {
+		fields = append(fields, room.FieldOrganizationId)
+	}
+	if m.FieldCleared(room.FieldDepartmentId) {
+		fields = append(fields, room.FieldDepartmentId)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoomMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoomMutation) ClearField(name string) error {
+	switch name {
+	case room.FieldOrganizationId:
+		m.ClearOrganizationId()
+		return nil
+	case room.FieldDepartmentId:
+		m.ClearDepartmentId()
+		return nil
+	}
+	return fmt.Errorf("unknown Room nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoomMutation) ResetField(name string) error {
+	switch name {
+	case room.FieldOrganizationId:
+		m.ResetOrganizationId()
+		return nil
+	case room.FieldNumber:
+		m.ResetNumber()
+		return nil
+	case room.FieldFloor:
+		m.ResetFloor()
+		return nil
+	case room.FieldNumberBeds:
+		m.ResetNumberBeds()
+		return nil
+	case room.FieldNumberPatients:
+		m.ResetNumberPatients()
+		return nil
+	case room.FieldTypeRoom:
+		m.ResetTypeRoom()
+		return nil
+	case room.FieldDepartmentId:
+		m.ResetDepartmentId()
+		return nil
+	}
+	return fmt.Errorf("unknown Room field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoomMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.contains != nil {
+		edges = append(edges, room.EdgeContains)
+	}
+	if m.department != nil {
+		edges = append(edges, room.EdgeDepartment)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoomMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case room.EdgeContains:
+		ids := make([]ent.Value, 0, len(m.contains))
+		for id := range m.contains {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeDepartment:
+		if id := m.department; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoomMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedcontains != nil {
+		edges = append(edges, room.EdgeContains)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoomMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case room.EdgeContains:
+		ids := make([]ent.Value, 0, len(m.removedcontains))
+		for id := range m.removedcontains {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoomMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedcontains {
+		edges = append(edges, room.EdgeContains)
+	}
+	if m.cleareddepartment {
+		edges = append(edges, room.EdgeDepartment)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoomMutation) EdgeCleared(name string) bool {
+	switch name {
+	case room.EdgeContains:
+		return m.clearedcontains
+	case room.EdgeDepartment:
+		return m.cleareddepartment
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoomMutation) ClearEdge(name string) error {
+	switch name {
+	case room.EdgeDepartment:
+		m.ClearDepartment()
+		return nil
+	}
+	return fmt.Errorf("unknown Room unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoomMutation) ResetEdge(name string) error {
+	switch name {
+	case room.EdgeContains:
+		m.ResetContains()
+		return nil
+	case room.EdgeDepartment:
+		m.ResetDepartment()
+		return nil
+	}
+	return fmt.Errorf("unknown Room edge %s", name)
+}
+
+// SessionMutation represents an operation that mutates the Session nodes in the graph.
+type SessionMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	sessionId         *string
+	createdAt         *time.Time
+	lastSeenAt        *time.Time
+	expiresAt         *time.Time
+	revokedAt         *time.Time
+	departmentId      *int
+	adddepartmentId   *int
+	organizationId    *int
+	addorganizationId *int
+	clearedFields     map[string]struct{}
+	doctor            *int
+	cleareddoctor     bool
+	done              bool
+	oldValue          func(context.Context) (*Session, error)
+	predicates        []predicate.Session
+}
+
+var _ ent.Mutation = (*SessionMutation)(nil)
+
+// sessionOption allows management of the mutation configuration using functional options.
+type sessionOption func(*SessionMutation)
+
+// newSessionMutation creates new mutation for the Session entity.
+func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation {
+	m := &SessionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSession,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSessionID sets the ID field of the mutation.
+func withSessionID(id int) sessionOption {
+	return func(m *SessionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Session
+		)
+		m.oldValue = func(ctx context.Context) (*Session, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Session.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSession sets the old Session of the mutation.
+func withSession(node *Session) sessionOption {
+	return func(m *SessionMutation) {
+		m.oldValue = func(context.Context) (*Session, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SessionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SessionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SessionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SessionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Session.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSessionId sets the "sessionId" field.
+func (m *SessionMutation) SetSessionId(s string) {
+	m.sessionId = &s
+}
+
+// SessionId returns the value of the "sessionId" field in the mutation.
+func (m *SessionMutation) SessionId() (r string, exists bool) {
+	v := m.sessionId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionId returns the old "sessionId" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldSessionId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionId: %w", err)
+	}
+	return oldValue.SessionId, nil
+}
+
+// ResetSessionId resets all changes to the "sessionId" field.
+func (m *SessionMutation) ResetSessionId() {
+	m.sessionId = nil
+}
+
+// SetDoctorId sets the "doctorId" field.
+func (m *SessionMutation) SetDoctorId(i int) {
+	m.doctor = &i
+}
+
+// DoctorId returns the value of the "doctorId" field in the mutation.
+func (m *SessionMutation) DoctorId() (r int, exists bool) {
+	v := m.doctor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDoctorId returns the old "doctorId" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldDoctorId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDoctorId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDoctorId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDoctorId: %w", err)
+	}
+	return oldValue.DoctorId, nil
+}
+
+// ResetDoctorId resets all changes to the "doctorId" field.
+func (m *SessionMutation) ResetDoctorId() {
+	m.doctor = nil
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (m *SessionMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
+}
+
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *SessionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "createdAt" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *SessionMutation) ResetCreatedAt() {
+	m.createdAt = nil
+}
+
+// SetLastSeenAt sets the "lastSeenAt" field.
+func (m *SessionMutation) SetLastSeenAt(t time.Time) {
+	m.lastSeenAt = &t
+}
+
+// LastSeenAt returns the value of the "lastSeenAt" field in the mutation.
+func (m *SessionMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.lastSeenAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeenAt returns the old "lastSeenAt" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldLastSeenAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	}
+	return oldValue.LastSeenAt, nil
+}
+
+// ResetLastSeenAt resets all changes to the "lastSeenAt" field.
+func (m *SessionMutation) ResetLastSeenAt() {
+	m.lastSeenAt = nil
+}
+
+// SetExpiresAt sets the "expiresAt" field.
+func (m *SessionMutation) SetExpiresAt(t time.Time) {
+	m.expiresAt = &t
+}
+
+// ExpiresAt returns the value of the "expiresAt" field in the mutation.
+func (m *SessionMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expiresAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expiresAt" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expiresAt" field.
+func (m *SessionMutation) ResetExpiresAt() {
+	m.expiresAt = nil
+}
+
+// SetRevokedAt sets the "revokedAt" field.
+func (m *SessionMutation) SetRevokedAt(t time.Time) {
+	m.revokedAt = &t
+}
+
+// RevokedAt returns the value of the "revokedAt" field in the mutation.
+func (m *SessionMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revokedAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revokedAt" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revokedAt" field.
+func (m *SessionMutation) ClearRevokedAt() {
+	m.revokedAt = nil
+	m.clearedFields[session.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revokedAt" field was cleared in this mutation.
+func (m *SessionMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[session.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revokedAt" field.
+func (m *SessionMutation) ResetRevokedAt() {
+	m.revokedAt = nil
+	delete(m.clearedFields, session.FieldRevokedAt)
+}
+
+// SetDepartmentId sets the "departmentId" field.
+func (m *SessionMutation) SetDepartmentId(i int) {
+	m.departmentId = &i
+	m.adddepartmentId = nil
+}
+
+// DepartmentId returns the value of the "departmentId" field in the mutation.
+func (m *SessionMutation) DepartmentId() (r int, exists bool) {
+	v := m.departmentId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDepartmentId returns the old "departmentId" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldDepartmentId(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDepartmentId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDepartmentId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDepartmentId: %w", err)
+	}
+	return oldValue.DepartmentId, nil
+}
+
+// AddDepartmentId adds i to the "departmentId" field.
+func (m *SessionMutation) AddDepartmentId(i int) {
+	if m.adddepartmentId != nil {
+		*m.adddepartmentId += i
+	} else {
+		m.adddepartmentId = &i
+	}
+}
+
+// AddedDepartmentId returns the value that was added to the "departmentId" field in this mutation.
+func (m *SessionMutation) AddedDepartmentId() (r int, exists bool) {
+	v := m.adddepartmentId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDepartmentId clears the value of the "departmentId" field.
+func (m *SessionMutation) ClearDepartmentId() {
+	m.departmentId = nil
+	m.adddepartmentId = nil
+	m.clearedFields[session.FieldDepartmentId] = struct{}{}
+}
+
+// DepartmentIdCleared returns if the "departmentId" field was cleared in this mutation.
+func (m *SessionMutation) DepartmentIdCleared() bool {
+	_, ok := m.clearedFields[session.FieldDepartmentId]
+	return ok
+}
+
+// ResetDepartmentId resets all changes to the "departmentId" field.
+func (m *SessionMutation) ResetDepartmentId() {
+	m.departmentId = nil
+	m.adddepartmentId = nil
+	delete(m.clearedFields, session.FieldDepartmentId)
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (m *SessionMutation) SetOrganizationId(i int) {
+	m.organizationId = &i
+	m.addorganizationId = nil
+}
+
+// OrganizationId returns the value of the "organizationId" field in the mutation.
+func (m *SessionMutation) OrganizationId() (r int, exists bool) {
+	v := m.organizationId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationId returns the old "organizationId" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldOrganizationId(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrganizationId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrganizationId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationId: %w", err)
+	}
+	return oldValue.OrganizationId, nil
+}
+
+// AddOrganizationId adds i to the "organizationId" field.
+func (m *SessionMutation) AddOrganizationId(i int) {
+	if m.addorganizationId != nil {
+		*m.addorganizationId += i
+	} else {
+		m.addorganizationId = &i
+	}
+}
+
+// AddedOrganizationId returns the value that was added to the "organizationId" field in this mutation.
+func (m *SessionMutation) AddedOrganizationId() (r int, exists bool) {
+	v := m.addorganizationId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOrganizationId clears the value of the "organizationId" field.
+func (m *SessionMutation) ClearOrganizationId() {
+	m.organizationId = nil
+	m.addorganizationId = nil
+	m.clearedFields[session.FieldOrganizationId] = struct{}{}
+}
+
+// OrganizationIdCleared returns if the "organizationId" field was cleared in this mutation.
+func (m *SessionMutation) OrganizationIdCleared() bool {
+	_, ok := m.clearedFields[session.FieldOrganizationId]
+	return ok
+}
+
+// ResetOrganizationId resets all changes to the "organizationId" field.
+func (m *SessionMutation) ResetOrganizationId() {
+	m.organizationId = nil
+	m.addorganizationId = nil
+	delete(m.clearedFields, session.FieldOrganizationId)
+}
+
+// SetDoctorID sets the "doctor" edge to the Doctor entity by id.
+func (m *SessionMutation) SetDoctorID(id int) {
+	m.doctor = &id
+}
+
+// ClearDoctor clears the "doctor" edge to the Doctor entity.
+func (m *SessionMutation) ClearDoctor() {
+	m.cleareddoctor = true
+}
+
+// DoctorCleared reports if the "doctor" edge to the Doctor entity was cleared.
+func (m *SessionMutation) DoctorCleared() bool {
+	return m.cleareddoctor
+}
+
+// DoctorID returns the "doctor" edge ID in the mutation.
+func (m *SessionMutation) DoctorID() (id int, exists bool) {
+	if m.doctor != nil {
+		return *m.doctor, true
+	}
+	return
+}
+
+// DoctorIDs returns the "doctor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DoctorID instead. It exists only for internal usage by the builders.
+func (m *SessionMutation) DoctorIDs() (ids []int) {
+	if id := m.doctor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDoctor resets all changes to the "doctor" edge.
+func (m *SessionMutation) ResetDoctor() {
+	m.doctor = nil
+	m.cleareddoctor = false
+}
+
+// Where appends a list predicates to the SessionMutation builder.
+func (m *SessionMutation) Where(ps ...predicate.Session) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SessionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Session, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SessionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SessionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Session).
+func (m *SessionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SessionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.sessionId != nil {
+		fields = append(fields, session.FieldSessionId)
+	}
+	if m.doctor != nil {
+		fields = append(fields, session.FieldDoctorId)
+	}
+	if m.createdAt != nil {
+		fields = append(fields, session.FieldCreatedAt)
+	}
+	if m.lastSeenAt != nil {
+		fields = append(fields, session.FieldLastSeenAt)
+	}
+	if m.expiresAt != nil {
+		fields = append(fields, session.FieldExpiresAt)
+	}
+	if m.revokedAt != nil {
+		fields = append(fields, session.FieldRevokedAt)
+	}
+	if m.departmentId != nil {
+		fields = append(fields, session.FieldDepartmentId)
+	}
+	if m.organizationId != nil {
+		fields = append(fields, session.FieldOrganizationId)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SessionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case session.FieldSessionId:
+		return m.SessionId()
+	case session.FieldDoctorId:
+		return m.DoctorId()
+	case session.FieldCreatedAt:
+		return m.CreatedAt()
+	case session.FieldLastSeenAt:
+		return m.LastSeenAt()
+	case session.FieldExpiresAt:
+		return m.ExpiresAt()
+	case session.FieldRevokedAt:
+		return m.RevokedAt()
+	case session.FieldDepartmentId:
+		return m.DepartmentId()
+	case session.FieldOrganizationId:
+		return m.OrganizationId()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case session.FieldSessionId:
+		return m.OldSessionId(ctx)
+	case session.FieldDoctorId:
+		return m.OldDoctorId(ctx)
+	case session.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case session.FieldLastSeenAt:
+		return m.OldLastSeenAt(ctx)
+	case session.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case session.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case session.FieldDepartmentId:
+		return m.OldDepartmentId(ctx)
+	case session.FieldOrganizationId:
+		return m.OldOrganizationId(ctx)
+	}
+	return nil, fmt.Errorf("unknown Session field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case session.FieldSessionId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionId(v)
+		return nil
+	case session.FieldDoctorId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDoctorId(v)
+		return nil
+	case session.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case session.FieldLastSeenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeenAt(v)
+		return nil
+	case session.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case session.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	case session.FieldDepartmentId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDepartmentId(v)
+		return nil
+	case session.FieldOrganizationId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationId(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Session field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SessionMutation) AddedFields() []string {
+	var fields []string
+	if m.adddepartmentId != nil {
+		fields = append(fields, session.FieldDepartmentId)
+	}
+	if m.addorganizationId != nil {
+		fields = append(fields, session.FieldOrganizationId)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SessionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case session.FieldDepartmentId:
+		return m.AddedDepartmentId()
+	case session.FieldOrganizationId:
+		return m.AddedOrganizationId()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case session.FieldDepartmentId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDepartmentId(v)
+		return nil
+	case session.FieldOrganizationId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOrganizationId(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Session numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SessionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(session.FieldRevokedAt) {
+		fields = append(fields, session.FieldRevokedAt)
+	}
+	if m.FieldCleared(session.FieldDepartmentId) {
+		fields = append(fields, session.FieldDepartmentId)
+	}
+	if m.FieldCleared(session.FieldOrganizationId) {
+		fields = append(fields, session.FieldOrganizationId)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SessionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SessionMutation) ClearField(name string) error {
+	switch name {
+	case session.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	case session.FieldDepartmentId:
+		m.ClearDepartmentId()
+		return nil
+	case session.FieldOrganizationId:
+		m.ClearOrganizationId()
+		return nil
+	}
+	return fmt.Errorf("unknown Session nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SessionMutation) ResetField(name string) error {
+	switch name {
+	case session.FieldSessionId:
+		m.ResetSessionId()
+		return nil
+	case session.FieldDoctorId:
+		m.ResetDoctorId()
+		return nil
+	case session.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case session.FieldLastSeenAt:
+		m.ResetLastSeenAt()
+		return nil
+	case session.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case session.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case session.FieldDepartmentId:
+		m.ResetDepartmentId()
+		return nil
+	case session.FieldOrganizationId:
+		m.ResetOrganizationId()
+		return nil
+	}
+	return fmt.Errorf("unknown Session field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.doctor != nil {
+		edges = append(edges, session.EdgeDoctor)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SessionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case session.EdgeDoctor:
+		if id := m.doctor; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SessionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddoctor {
+		edges = append(edges, session.EdgeDoctor)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SessionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case session.EdgeDoctor:
+		return m.cleareddoctor
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SessionMutation) ClearEdge(name string) error {
+	switch name {
+	case session.EdgeDoctor:
+		m.ClearDoctor()
+		return nil
+	}
+	return fmt.Errorf("unknown Session unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SessionMutation) ResetEdge(name string) error {
+	switch name {
+	case session.EdgeDoctor:
+		m.ResetDoctor()
+		return nil
+	}
+	return fmt.Errorf("unknown Session edge %s", name)
+}
+
+// VitalMutation represents an operation that mutates the Vital nodes in the graph.
+type VitalMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	organizationId    *int
+	addorganizationId *int
+	patientId         *int
+	addpatientId      *int
+	authorId          *int
+	addauthorId       *int
+	pulse             *int
+	addpulse          *int
+	systolic          *int
+	addsystolic       *int
+	diastolic         *int
+	adddiastolic      *int
+	temperature       *float64
+	addtemperature    *float64
+	saturation        *int
+	addsaturation     *int
+	createdAt         *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*Vital, error)
+	predicates        []predicate.Vital
+}
+
+var _ ent.Mutation = (*VitalMutation)(nil)
+
+// vitalOption allows management of the mutation configuration using functional options.
+type vitalOption func(*VitalMutation)
+
+// newVitalMutation creates new mutation for the Vital entity.
+func newVitalMutation(c config, op Op, opts ...vitalOption) *VitalMutation {
+	m := &VitalMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeVital,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withVitalID sets the ID field of the mutation.
+func withVitalID(id int) vitalOption {
+	return func(m *VitalMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Vital
+		)
+		m.oldValue = func(ctx context.Context) (*Vital, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Vital.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withVital sets the old Vital of the mutation.
+func withVital(node *Vital) vitalOption {
+	return func(m *VitalMutation) {
+		m.oldValue = func(context.Context) (*Vital, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m VitalMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m VitalMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *VitalMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *VitalMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Vital.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (m *VitalMutation) SetOrganizationId(i int) {
+	m.organizationId = &i
+	m.addorganizationId = nil
+}
+
+// OrganizationId returns the value of the "organizationId" field in the mutation.
+func (m *VitalMutation) OrganizationId() (r int, exists bool) {
+	v := m.organizationId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationId returns the old "organizationId" field's value of the Vital entity.
+// If the Vital object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VitalMutation) OldOrganizationId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrganizationId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrganizationId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationId: %w", err)
+	}
+	return oldValue.OrganizationId, nil
+}
+
+// AddOrganizationId adds i to the "organizationId" field.
+func (m *VitalMutation) AddOrganizationId(i int) {
+	if m.addorganizationId != nil {
+		*m.addorganizationId += i
+	} else {
+		m.addorganizationId = &i
+	}
+}
+
+// AddedOrganizationId returns the value that was added to the "organizationId" field in this mutation.
+func (m *VitalMutation) AddedOrganizationId() (r int, exists bool) {
+	v := m.addorganizationId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOrganizationId clears the value of the "organizationId" field.
+func (m *VitalMutation) ClearOrganizationId() {
+	m.organizationId = nil
+	m.addorganizationId = nil
+	m.clearedFields[vital.FieldOrganizationId] = struct{}{}
+}
+
+// OrganizationIdCleared returns if the "organizationId" field was cleared in this mutation.
+func (m *VitalMutation) OrganizationIdCleared() bool {
+	_, ok := m.clearedFields[vital.FieldOrganizationId]
+	return ok
+}
+
+// ResetOrganizationId resets all changes to the "organizationId" field.
+func (m *VitalMutation) ResetOrganizationId() {
+	m.organizationId = nil
+	m.addorganizationId = nil
+	delete(m.clearedFields, vital.FieldOrganizationId)
+}
+
+// SetPatientId sets the "patientId" field.
+func (m *VitalMutation) SetPatientId(i int) {
+	m.patientId = &i
+	m.addpatientId = nil
+}
+
+// PatientId returns the value of the "patientId" field in the mutation.
+func (m *VitalMutation) PatientId() (r int, exists bool) {
+	v := m.patientId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPatientId returns the old "patientId" field's value of the Vital entity.
+// If the Vital object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VitalMutation) OldPatientId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPatientId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPatientId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPatientId: %w", err)
+	}
+	return oldValue.PatientId, nil
+}
+
+// AddPatientId adds i to the "patientId" field.
+func (m *VitalMutation) AddPatientId(i int) {
+	if m.addpatientId != nil {
+		*m.addpatientId += i
+	} else {
+		m.addpatientId = &i
+	}
+}
+
+// AddedPatientId returns the value that was added to the "patientId" field in this mutation.
+func (m *VitalMutation) AddedPatientId() (r int, exists bool) {
+	v := m.addpatientId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPatientId resets all changes to the "patientId" field.
+func (m *VitalMutation) ResetPatientId() {
+	m.patientId = nil
+	m.addpatientId = nil
+}
+
+// SetAuthorId sets the "authorId" field.
+func (m *VitalMutation) SetAuthorId(i int) {
+	m.authorId = &i
+	m.addauthorId = nil
+}
+
+// AuthorId returns the value of the "authorId" field in the mutation.
+func (m *VitalMutation) AuthorId() (r int, exists bool) {
+	v := m.authorId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthorId returns the old "authorId" field's value of the Vital entity.
+// If the Vital object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VitalMutation) OldAuthorId(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthorId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthorId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthorId: %w", err)
+	}
+	return oldValue.AuthorId, nil
+}
+
+// AddAuthorId adds i to the "authorId" field.
+func (m *VitalMutation) AddAuthorId(i int) {
+	if m.addauthorId != nil {
+		*m.addauthorId += i
+	} else {
+		m.addauthorId = &i
+	}
+}
+
+// AddedAuthorId returns the value that was added to the "authorId" field in this mutation.
+func (m *VitalMutation) AddedAuthorId() (r int, exists bool) {
+	v := m.addauthorId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAuthorId clears the value of the "authorId" field.
+func (m *VitalMutation) ClearAuthorId() {
+	m.authorId = nil
+	m.addauthorId = nil
+	m.clearedFields[vital.FieldAuthorId] = struct{}{}
+}
+
+// AuthorIdCleared returns if the "authorId" field was cleared in this mutation.
+func (m *VitalMutation) AuthorIdCleared() bool {
+	_, ok := m.clearedFields[vital.FieldAuthorId]
+	return ok
+}
+
+// ResetAuthorId resets all changes to the "authorId" field.
+func (m *VitalMutation) ResetAuthorId() {
+	m.authorId = nil
+	m.addauthorId = nil
+	delete(m.clearedFields, vital.FieldAuthorId)
+}
+
+// SetPulse sets the "pulse" field.
+func (m *VitalMutation) SetPulse(i int) {
+	m.pulse = &i
+	m.addpulse = nil
+}
+
+// Pulse returns the value of the "pulse" field in the mutation.
+func (m *VitalMutation) Pulse() (r int, exists bool) {
+	v := m.pulse
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPulse returns the old "pulse" field's value of the Vital entity.
+// If the Vital object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VitalMutation) OldPulse(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPulse is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPulse requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPulse: %w", err)
+	}
+	return oldValue.Pulse, nil
+}
+
+// AddPulse adds i to the "pulse" field.
+func (m *VitalMutation) AddPulse(i int) {
+	if m.addpulse != nil {
+		*m.addpulse += i
+	} else {
+		m.addpulse = &i
+	}
+}
+
+// AddedPulse returns the value that was added to the "pulse" field in this mutation.
+func (m *VitalMutation) AddedPulse() (r int, exists bool) {
+	v := m.addpulse
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPulse resets all changes to the "pulse" field.
+func (m *VitalMutation) ResetPulse() {
+	m.pulse = nil
+	m.addpulse = nil
+}
+
+// SetSystolic sets the "systolic" field.
+func (m *VitalMutation) SetSystolic(i int) {
+	m.systolic = &i
+	m.addsystolic = nil
+}
+
+// Systolic returns the value of the "systolic" field in the mutation.
+func (m *VitalMutation) Systolic() (r int, exists bool) {
+	v := m.systolic
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSystolic returns the old "systolic" field's value of the Vital entity.
+// If the Vital object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VitalMutation) OldSystolic(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSystolic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSystolic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSystolic: %w", err)
+	}
+	return oldValue.Systolic, nil
+}
+
+// AddSystolic adds i to the "systolic" field.
+func (m *VitalMutation) AddSystolic(i int) {
+	if m.addsystolic != nil {
+		*m.addsystolic += i
+	} else {
+		m.addsystolic = &i
+	}
+}
+
+// AddedSystolic returns the value that was added to the "systolic" field in this mutation.
+func (m *VitalMutation) AddedSystolic() (r int, exists bool) {
+	v := m.addsystolic
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSystolic resets all changes to the "systolic" field.
+func (m *VitalMutation) ResetSystolic() {
+	m.systolic = nil
+	m.addsystolic = nil
+}
+
+// SetDiastolic sets the "diastolic" field.
+func (m *VitalMutation) SetDiastolic(i int) {
+	m.diastolic = &i
+	m.adddiastolic = nil
+}
+
+// Diastolic returns the value of the "diastolic" field in the mutation.
+func (m *VitalMutation) Diastolic() (r int, exists bool) {
+	v := m.diastolic
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiastolic returns the old "diastolic" field's value of the Vital entity.
+// If the Vital object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VitalMutation) OldDiastolic(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiastolic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiastolic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiastolic: %w", err)
+	}
+	return oldValue.Diastolic, nil
+}
+
+// AddDiastolic adds i to the "diastolic" field.
+func (m *VitalMutation) AddDiastolic(i int) {
+	if m.adddiastolic != nil {
+		*m.adddiastolic += i
+	} else {
+		m.adddiastolic = &i
 	}
-	for i := range ids {
-		delete(m.treats, ids[i])
-		m.removedtreats[ids[i]] = struct{}{}
+}
+
+// AddedDiastolic returns the value that was added to the "diastolic" field in this mutation.
+func (m *VitalMutation) AddedDiastolic() (r int, exists bool) {
+	v := m.adddiastolic
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedTreats returns the removed IDs of the "treats" edge to the Patient entity.
-func (m *DoctorMutation) RemovedTreatsIDs() (ids []int) {
-	for id := range m.removedtreats {
-		ids = append(ids, id)
+// ResetDiastolic resets all changes to the "diastolic" field.
+func (m *VitalMutation) ResetDiastolic() {
+	m.diastolic = nil
+	m.adddiastolic = nil
+}
+
+// SetTemperature sets the "temperature" field.
+func (m *VitalMutation) SetTemperature(f float64) {
+	m.temperature = &f
+	m.addtemperature = nil
+}
+
+// Temperature returns the value of the "temperature" field in the mutation.
+func (m *VitalMutation) Temperature() (r float64, exists bool) {
+	v := m.temperature
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// TreatsIDs returns the "treats" edge IDs in the mutation.
-func (m *DoctorMutation) TreatsIDs() (ids []int) {
-	for id := range m.treats {
-		ids = append(ids, id)
+// OldTemperature returns the old "temperature" field's value of the Vital entity.
+// If the Vital object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VitalMutation) OldTemperature(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTemperature is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTemperature requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTemperature: %w", err)
+	}
+	return oldValue.Temperature, nil
 }
 
-// ResetTreats resets all changes to the "treats" edge.
-func (m *DoctorMutation) ResetTreats() {
-	m.treats = nil
-	m.clearedtreats = false
-	m.removedtreats = nil
+// AddTemperature adds f to the "temperature" field.
+func (m *VitalMutation) AddTemperature(f float64) {
+	if m.addtemperature != nil {
+		*m.addtemperature += f
+	} else {
+		m.addtemperature = &f
+	}
 }
 
-// Where appends a list predicates to the DoctorMutation builder.
-func (m *DoctorMutation) Where(ps ...predicate.Doctor) {
+// AddedTemperature returns the value that was added to the "temperature" field in this mutation.
+func (m *VitalMutation) AddedTemperature() (r float64, exists bool) {
+	v := m.addtemperature
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTemperature resets all changes to the "temperature" field.
+func (m *VitalMutation) ResetTemperature() {
+	m.temperature = nil
+	m.addtemperature = nil
+}
+
+// SetSaturation sets the "saturation" field.
+func (m *VitalMutation) SetSaturation(i int) {
+	m.saturation = &i
+	m.addsaturation = nil
+}
+
+// Saturation returns the value of the "saturation" field in the mutation.
+func (m *VitalMutation) Saturation() (r int, exists bool) {
+	v := m.saturation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSaturation returns the old "saturation" field's value of the Vital entity.
+// If the Vital object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VitalMutation) OldSaturation(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSaturation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSaturation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSaturation: %w", err)
+	}
+	return oldValue.Saturation, nil
+}
+
+// AddSaturation adds i to the "saturation" field.
+func (m *VitalMutation) AddSaturation(i int) {
+	if m.addsaturation != nil {
+		*m.addsaturation += i
+	} else {
+		m.addsaturation = &i
+	}
+}
+
+// AddedSaturation returns the value that was added to the "saturation" field in this mutation.
+func (m *VitalMutation) AddedSaturation() (r int, exists bool) {
+	v := m.addsaturation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSaturation resets all changes to the "saturation" field.
+func (m *VitalMutation) ResetSaturation() {
+	m.saturation = nil
+	m.addsaturation = nil
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (m *VitalMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
+}
+
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *VitalMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "createdAt" field's value of the Vital entity.
+// If the Vital object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VitalMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *VitalMutation) ResetCreatedAt() {
+	m.createdAt = nil
+}
+
+// Where appends a list predicates to the VitalMutation builder.
+func (m *VitalMutation) Where(ps ...predicate.Vital) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DoctorMutation builder. Using this method,
+// WhereP appends storage-level predicates to the VitalMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DoctorMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Doctor, len(ps))
+func (m *VitalMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Vital, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -926,36 +12124,51 @@ func (m *DoctorMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DoctorMutation) Op() Op {
+func (m *VitalMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DoctorMutation) SetOp(op Op) {
+func (m *VitalMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Doctor).
-func (m *DoctorMutation) Type() string {
+// Type returns the node type of this mutation (Vital).
+func (m *VitalMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DoctorMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.tokenId != nil {
-		fields = append(fields, doctor.FieldTokenId)
+func (m *VitalMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.organizationId != nil {
+		fields = append(fields, vital.FieldOrganizationId)
 	}
-	if m.surname != nil {
-		fields = append(fields, doctor.FieldSurname)
+	if m.patientId != nil {
+		fields = append(fields, vital.FieldPatientId)
 	}
-	if m.speciality != nil {
-		fields = append(fields, doctor.FieldSpeciality)
+	if m.authorId != nil {
+		fields = append(fields, vital.FieldAuthorId)
 	}
-	if m.role != nil {
-		fields = append(fields, doctor.FieldRole)
+	if m.pulse != nil {
+		fields = append(fields, vital.FieldPulse)
+	}
+	if m.systolic != nil {
+		fields = append(fields, vital.FieldSystolic)
+	}
+	if m.diastolic != nil {
+		fields = append(fields, vital.FieldDiastolic)
+	}
+	if m.temperature != nil {
+		fields = append(fields, vital.FieldTemperature)
+	}
+	if m.saturation != nil {
+		fields = append(fields, vital.FieldSaturation)
+	}
+	if m.createdAt != nil {
+		fields = append(fields, vital.FieldCreatedAt)
 	}
 	return fields
 }
@@ -963,16 +12176,26 @@ func (m *DoctorMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DoctorMutation) Field(name string) (ent.Value, bool) {
+func (m *VitalMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case doctor.FieldTokenId:
-		return m.TokenId()
-	case doctor.FieldSurname:
-		return m.Surname()
-	case doctor.FieldSpeciality:
-		return m.Speciality()
-	case doctor.FieldRole:
-		return m.Role()
+	case vital.FieldOrganizationId:
+		return m.OrganizationId()
+	case vital.FieldPatientId:
+		return m.PatientId()
+	case vital.FieldAuthorId:
+		return m.AuthorId()
+	case vital.FieldPulse:
+		return m.Pulse()
+	case vital.FieldSystolic:
+		return m.Systolic()
+	case vital.FieldDiastolic:
+		return m.Diastolic()
+	case vital.FieldTemperature:
+		return m.Temperature()
+	case vital.FieldSaturation:
+		return m.Saturation()
+	case vital.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -980,241 +12203,376 @@ func (m *DoctorMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DoctorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *VitalMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case doctor.FieldTokenId:
-		return m.OldTokenId(ctx)
-	case doctor.FieldSurname:
-		return m.OldSurname(ctx)
-	case doctor.FieldSpeciality:
-		return m.OldSpeciality(ctx)
-	case doctor.FieldRole:
-		return m.OldRole(ctx)
-	}
-	return nil, fmt.Errorf("unknown Doctor field %s", name)
+	case vital.FieldOrganizationId:
+		return m.OldOrganizationId(ctx)
+	case vital.FieldPatientId:
+		return m.OldPatientId(ctx)
+	case vital.FieldAuthorId:
+		return m.OldAuthorId(ctx)
+	case vital.FieldPulse:
+		return m.OldPulse(ctx)
+	case vital.FieldSystolic:
+		return m.OldSystolic(ctx)
+	case vital.FieldDiastolic:
+		return m.OldDiastolic(ctx)
+	case vital.FieldTemperature:
+		return m.OldTemperature(ctx)
+	case vital.FieldSaturation:
+		return m.OldSaturation(ctx)
+	case vital.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Vital field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DoctorMutation) SetField(name string, value ent.Value) error {
+func (m *VitalMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case doctor.FieldTokenId:
-		v, ok := value.(string)
+	case vital.FieldOrganizationId:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTokenId(v)
+		m.SetOrganizationId(v)
 		return nil
-	case doctor.FieldSurname:
-		v, ok := value.(string)
+	case vital.FieldPatientId:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSurname(v)
+		m.SetPatientId(v)
 		return nil
-	case doctor.FieldSpeciality:
-		v, ok := value.(string)
+	case vital.FieldAuthorId:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSpeciality(v)
+		m.SetAuthorId(v)
 		return nil
-	case doctor.FieldRole:
-		v, ok := value.(string)
+	case vital.FieldPulse:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPulse(v)
+		return nil
+	case vital.FieldSystolic:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSystolic(v)
+		return nil
+	case vital.FieldDiastolic:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiastolic(v)
+		return nil
+	case vital.FieldTemperature:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTemperature(v)
+		return nil
+	case vital.FieldSaturation:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSaturation(v)
+		return nil
+	case vital.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Vital field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *VitalMutation) AddedFields() []string {
+	var fields []string
+	if m.addorganizationId != nil {
+		fields = append(fields, vital.FieldOrganizationId)
+	}
+	if m.addpatientId != nil {
+		fields = append(fields, vital.FieldPatientId)
+	}
+	if m.addauthorId != nil {
+		fields = append(fields, vital.FieldAuthorId)
+	}
+	if m.addpulse != nil {
+		fields = append(fields, vital.FieldPulse)
+	}
+	if m.addsystolic != nil {
+		fields = append(fields, vital.FieldSystolic)
+	}
+	if m.adddiastolic != nil {
+		fields = append(fields, vital.FieldDiastolic)
+	}
+	if m.addtemperature != nil {
+		fields = append(fields, vital.FieldTemperature)
+	}
+	if m.addsaturation != nil {
+		fields = append(fields, vital.FieldSaturation)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *VitalMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case vital.FieldOrganizationId:
+		return m.AddedOrganizationId()
+	case vital.FieldPatientId:
+		return m.AddedPatientId()
+	case vital.FieldAuthorId:
+		return m.AddedAuthorId()
+	case vital.FieldPulse:
+		return m.AddedPulse()
+	case vital.FieldSystolic:
+		return m.AddedSystolic()
+	case vital.FieldDiastolic:
+		return m.AddedDiastolic()
+	case vital.FieldTemperature:
+		return m.AddedTemperature()
+	case vital.FieldSaturation:
+		return m.AddedSaturation()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VitalMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case vital.FieldOrganizationId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOrganizationId(v)
+		return nil
+	case vital.FieldPatientId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPatientId(v)
+		return nil
+	case vital.FieldAuthorId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAuthorId(v)
+		return nil
+	case vital.FieldPulse:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPulse(v)
+		return nil
+	case vital.FieldSystolic:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSystolic(v)
+		return nil
+	case vital.FieldDiastolic:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDiastolic(v)
+		return nil
+	case vital.FieldTemperature:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTemperature(v)
+		return nil
+	case vital.FieldSaturation:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRole(v)
+		m.AddSaturation(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Doctor field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *DoctorMutation) AddedFields() []string {
-	return nil
-}
-
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *DoctorMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
-}
-
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *DoctorMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Doctor numeric field %s", name)
+	return fmt.Errorf("unknown Vital numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DoctorMutation) ClearedFields() []string {
-	return nil
+func (m *VitalMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(vital.FieldOrganizationId) {
+		fields = append(fields, vital.FieldOrganizationId)
+	}
+	if m.FieldCleared(vital.FieldAuthorId) {
+		fields = append(fields, vital.FieldAuthorId)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DoctorMutation) FieldCleared(name string) bool {
+func (m *VitalMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DoctorMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Doctor nullable field %s", name)
+func (m *VitalMutation) ClearField(name string) error {
+	switch name {
+	case vital.FieldOrganizationId:
+		m.ClearOrganizationId()
+		return nil
+	case vital.FieldAuthorId:
+		m.ClearAuthorId()
+		return nil
+	}
+	return fmt.Errorf("unknown Vital nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DoctorMutation) ResetField(name string) error {
+func (m *VitalMutation) ResetField(name string) error {
 	switch name {
-	case doctor.FieldTokenId:
-		m.ResetTokenId()
+	case vital.FieldOrganizationId:
+		m.ResetOrganizationId()
 		return nil
-	case doctor.FieldSurname:
-		m.ResetSurname()
+	case vital.FieldPatientId:
+		m.ResetPatientId()
 		return nil
-	case doctor.FieldSpeciality:
-		m.ResetSpeciality()
+	case vital.FieldAuthorId:
+		m.ResetAuthorId()
 		return nil
-	case doctor.FieldRole:
-		m.ResetRole()
+	case vital.FieldPulse:
+		m.ResetPulse()
+		return nil
+	case vital.FieldSystolic:
+		m.ResetSystolic()
+		return nil
+	case vital.FieldDiastolic:
+		m.ResetDiastolic()
+		return nil
+	case vital.FieldTemperature:
+		m.ResetTemperature()
+		return nil
+	case vital.FieldSaturation:
+		m.ResetSaturation()
+		return nil
+	case vital.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Doctor field %s", name)
+	return fmt.Errorf("unknown Vital field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DoctorMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.treats != nil {
-		edges = append(edges, doctor.EdgeTreats)
-	}
+func (m *VitalMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DoctorMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case doctor.EdgeTreats:
-		ids := make([]ent.Value, 0, len(m.treats))
-		for id := range m.treats {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *VitalMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DoctorMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedtreats != nil {
-		edges = append(edges, doctor.EdgeTreats)
-	}
+func (m *VitalMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DoctorMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case doctor.EdgeTreats:
-		ids := make([]ent.Value, 0, len(m.removedtreats))
-		for id := range m.removedtreats {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *VitalMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DoctorMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedtreats {
-		edges = append(edges, doctor.EdgeTreats)
-	}
+func (m *VitalMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DoctorMutation) EdgeCleared(name string) bool {
-	switch name {
-	case doctor.EdgeTreats:
-		return m.clearedtreats
-	}
+func (m *VitalMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DoctorMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Doctor unique edge %s", name)
+func (m *VitalMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Vital unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DoctorMutation) ResetEdge(name string) error {
-	switch name {
-	case doctor.EdgeTreats:
-		m.ResetTreats()
-		return nil
-	}
-	return fmt.Errorf("unknown Doctor edge %s", name)
+func (m *VitalMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Vital edge %s", name)
 }
 
-// PatientMutation represents an operation that mutates the Patient nodes in the graph.
-type PatientMutation struct {
+// WardChatMutation represents an operation that mutates the WardChat nodes in the graph.
+type WardChatMutation struct {
 	config
 	op                Op
 	typ               string
 	id                *int
-	surname           *string
-	name              *string
-	patronymic        *string
-	height            *int
-	addheight         *int
-	weight            *float64
-	addweight         *float64
-	degreeOfDanger    *int
-	adddegreeOfDanger *int
+	organizationId    *int
+	addorganizationId *int
+	chatId            *int64
+	addchatId         *int64
+	title             *string
+	departmentId      *int
+	adddepartmentId   *int
+	rooms             *[]int
+	appendrooms       []int
+	language          *string
+	createdBy         *int
+	addcreatedBy      *int
+	createdAt         *time.Time
 	clearedFields     map[string]struct{}
-	repo              *int
-	clearedrepo       bool
-	doctor            map[int]struct{}
-	removeddoctor     map[int]struct{}
-	cleareddoctor     bool
-	ills              *int
-	clearedills       bool
 	done              bool
-	oldValue          func(context.Context) (*Patient, error)
-	predicates        []predicate.Patient
+	oldValue          func(context.Context) (*WardChat, error)
+	predicates        []predicate.WardChat
 }
 
-var _ ent.Mutation = (*PatientMutation)(nil)
+var _ ent.Mutation = (*WardChatMutation)(nil)
 
-// patientOption allows management of the mutation configuration using functional options.
-type patientOption func(*PatientMutation)
+// wardchatOption allows management of the mutation configuration using functional options.
+type wardchatOption func(*WardChatMutation)
 
-// newPatientMutation creates new mutation for the Patient entity.
-func newPatientMutation(c config, op Op, opts ...patientOption) *PatientMutation {
-	m := &PatientMutation{
+// newWardChatMutation creates new mutation for the WardChat entity.
+func newWardChatMutation(c config, op Op, opts ...wardchatOption) *WardChatMutation {
+	m := &WardChatMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePatient,
+		typ:           TypeWardChat,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1223,20 +12581,20 @@ func newPatientMutation(c config, op Op, opts ...patientOption) *PatientMutation
 	return m
 }
 
-// withPatientID sets the ID field of the mutation.
-func withPatientID(id int) patientOption {
-	return func(m *PatientMutation) {
+// withWardChatID sets the ID field of the mutation.
+func withWardChatID(id int) wardchatOption {
+	return func(m *WardChatMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Patient
+			value *WardChat
 		)
-		m.oldValue = func(ctx context.Context) (*Patient, error) {
+		m.oldValue = func(ctx context.Context) (*WardChat, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Patient.Get(ctx, id)
+					value, err = m.Client().WardChat.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1245,10 +12603,10 @@ func withPatientID(id int) patientOption {
 	}
 }
 
-// withPatient sets the old Patient of the mutation.
-func withPatient(node *Patient) patientOption {
-	return func(m *PatientMutation) {
-		m.oldValue = func(context.Context) (*Patient, error) {
+// withWardChat sets the old WardChat of the mutation.
+func withWardChat(node *WardChat) wardchatOption {
+	return func(m *WardChatMutation) {
+		m.oldValue = func(context.Context) (*WardChat, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1257,7 +12615,7 @@ func withPatient(node *Patient) patientOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PatientMutation) Client() *Client {
+func (m WardChatMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1265,7 +12623,7 @@ func (m PatientMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PatientMutation) Tx() (*Tx, error) {
+func (m WardChatMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1276,7 +12634,7 @@ func (m PatientMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PatientMutation) ID() (id int, exists bool) {
+func (m *WardChatMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1287,474 +12645,481 @@ func (m *PatientMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PatientMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *WardChatMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Patient.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetSurname sets the "surname" field.
-func (m *PatientMutation) SetSurname(s string) {
-	m.surname = &s
-}
-
-// Surname returns the value of the "surname" field in the mutation.
-func (m *PatientMutation) Surname() (r string, exists bool) {
-	v := m.surname
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSurname returns the old "surname" field's value of the Patient entity.
-// If the Patient object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PatientMutation) OldSurname(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSurname is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSurname requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSurname: %w", err)
-	}
-	return oldValue.Surname, nil
-}
-
-// ResetSurname resets all changes to the "surname" field.
-func (m *PatientMutation) ResetSurname() {
-	m.surname = nil
-}
-
-// SetName sets the "name" field.
-func (m *PatientMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *PatientMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the Patient entity.
-// If the Patient object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PatientMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *PatientMutation) ResetName() {
-	m.name = nil
-}
-
-// SetPatronymic sets the "patronymic" field.
-func (m *PatientMutation) SetPatronymic(s string) {
-	m.patronymic = &s
-}
-
-// Patronymic returns the value of the "patronymic" field in the mutation.
-func (m *PatientMutation) Patronymic() (r string, exists bool) {
-	v := m.patronymic
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldPatronymic returns the old "patronymic" field's value of the Patient entity.
-// If the Patient object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PatientMutation) OldPatronymic(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPatronymic is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPatronymic requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPatronymic: %w", err)
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WardChat.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.Patronymic, nil
-}
-
-// ResetPatronymic resets all changes to the "patronymic" field.
-func (m *PatientMutation) ResetPatronymic() {
-	m.patronymic = nil
 }
 
-// SetHeight sets the "height" field.
-func (m *PatientMutation) SetHeight(i int) {
-	m.height = &i
-	m.addheight = nil
+// SetOrganizationId sets the "organizationId" field.
+func (m *WardChatMutation) SetOrganizationId(i int) {
+	m.organizationId = &i
+	m.addorganizationId = nil
 }
 
-// Height returns the value of the "height" field in the mutation.
-func (m *PatientMutation) Height() (r int, exists bool) {
-	v := m.height
+// OrganizationId returns the value of the "organizationId" field in the mutation.
+func (m *WardChatMutation) OrganizationId() (r int, exists bool) {
+	v := m.organizationId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHeight returns the old "height" field's value of the Patient entity.
-// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// OldOrganizationId returns the old "organizationId" field's value of the WardChat entity.
+// If the WardChat object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PatientMutation) OldHeight(ctx context.Context) (v int, err error) {
+func (m *WardChatMutation) OldOrganizationId(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
+		return v, errors.New("OldOrganizationId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHeight requires an ID field in the mutation")
+		return v, errors.New("OldOrganizationId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
+		return v, fmt.Errorf("querying old value for OldOrganizationId: %w", err)
 	}
-	return oldValue.Height, nil
+	return oldValue.OrganizationId, nil
 }
 
-// AddHeight adds i to the "height" field.
-func (m *PatientMutation) AddHeight(i int) {
-	if m.addheight != nil {
-		*m.addheight += i
+// AddOrganizationId adds i to the "organizationId" field.
+func (m *WardChatMutation) AddOrganizationId(i int) {
+	if m.addorganizationId != nil {
+		*m.addorganizationId += i
 	} else {
-		m.addheight = &i
+		m.addorganizationId = &i
 	}
 }
 
-// AddedHeight returns the value that was added to the "height" field in this mutation.
-func (m *PatientMutation) AddedHeight() (r int, exists bool) {
-	v := m.addheight
+// AddedOrganizationId returns the value that was added to the "organizationId" field in this mutation.
+func (m *WardChatMutation) AddedOrganizationId() (r int, exists bool) {
+	v := m.addorganizationId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetHeight resets all changes to the "height" field.
-func (m *PatientMutation) ResetHeight() {
-	m.height = nil
-	m.addheight = nil
+// ClearOrganizationId clears the value of the "organizationId" field.
+func (m *WardChatMutation) ClearOrganizationId() {
+	m.organizationId = nil
+	m.addorganizationId = nil
+	m.clearedFields[wardchat.FieldOrganizationId] = struct{}{}
 }
 
-// SetWeight sets the "weight" field.
-func (m *PatientMutation) SetWeight(f float64) {
-	m.weight = &f
-	m.addweight = nil
+// OrganizationIdCleared returns if the "organizationId" field was cleared in this mutation.
+func (m *WardChatMutation) OrganizationIdCleared() bool {
+	_, ok := m.clearedFields[wardchat.FieldOrganizationId]
+	return ok
 }
 
-// Weight returns the value of the "weight" field in the mutation.
-func (m *PatientMutation) Weight() (r float64, exists bool) {
-	v := m.weight
+// ResetOrganizationId resets all changes to the "organizationId" field.
+func (m *WardChatMutation) ResetOrganizationId() {
+	m.organizationId = nil
+	m.addorganizationId = nil
+	delete(m.clearedFields, wardchat.FieldOrganizationId)
+}
+
+// SetChatId sets the "chatId" field.
+func (m *WardChatMutation) SetChatId(i int64) {
+	m.chatId = &i
+	m.addchatId = nil
+}
+
+// ChatId returns the value of the "chatId" field in the mutation.
+func (m *WardChatMutation) ChatId() (r int64, exists bool) {
+	v := m.chatId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWeight returns the old "weight" field's value of the Patient entity.
-// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// OldChatId returns the old "chatId" field's value of the WardChat entity.
+// If the WardChat object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PatientMutation) OldWeight(ctx context.Context) (v float64, err error) {
+func (m *WardChatMutation) OldChatId(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWeight is only allowed on UpdateOne operations")
+		return v, errors.New("OldChatId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWeight requires an ID field in the mutation")
+		return v, errors.New("OldChatId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWeight: %w", err)
+		return v, fmt.Errorf("querying old value for OldChatId: %w", err)
 	}
-	return oldValue.Weight, nil
+	return oldValue.ChatId, nil
 }
 
-// AddWeight adds f to the "weight" field.
-func (m *PatientMutation) AddWeight(f float64) {
-	if m.addweight != nil {
-		*m.addweight += f
+// AddChatId adds i to the "chatId" field.
+func (m *WardChatMutation) AddChatId(i int64) {
+	if m.addchatId != nil {
+		*m.addchatId += i
 	} else {
-		m.addweight = &f
+		m.addchatId = &i
 	}
 }
 
-// AddedWeight returns the value that was added to the "weight" field in this mutation.
-func (m *PatientMutation) AddedWeight() (r float64, exists bool) {
-	v := m.addweight
+// AddedChatId returns the value that was added to the "chatId" field in this mutation.
+func (m *WardChatMutation) AddedChatId() (r int64, exists bool) {
+	v := m.addchatId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetWeight resets all changes to the "weight" field.
-func (m *PatientMutation) ResetWeight() {
-	m.weight = nil
-	m.addweight = nil
+// ResetChatId resets all changes to the "chatId" field.
+func (m *WardChatMutation) ResetChatId() {
+	m.chatId = nil
+	m.addchatId = nil
 }
 
-// SetRoomNumber sets the "roomNumber" field.
-func (m *PatientMutation) SetRoomNumber(i int) {
-	m.repo = &i
+// SetTitle sets the "title" field.
+func (m *WardChatMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// RoomNumber returns the value of the "roomNumber" field in the mutation.
-func (m *PatientMutation) RoomNumber() (r int, exists bool) {
-	v := m.repo
+// Title returns the value of the "title" field in the mutation.
+func (m *WardChatMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRoomNumber returns the old "roomNumber" field's value of the Patient entity.
-// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the WardChat entity.
+// If the WardChat object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PatientMutation) OldRoomNumber(ctx context.Context) (v int, err error) {
+func (m *WardChatMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRoomNumber is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRoomNumber requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRoomNumber: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return oldValue.RoomNumber, nil
+	return oldValue.Title, nil
 }
 
-// ResetRoomNumber resets all changes to the "roomNumber" field.
-func (m *PatientMutation) ResetRoomNumber() {
-	m.repo = nil
+// ClearTitle clears the value of the "title" field.
+func (m *WardChatMutation) ClearTitle() {
+	m.title = nil
+	m.clearedFields[wardchat.FieldTitle] = struct{}{}
 }
 
-// SetDegreeOfDanger sets the "degreeOfDanger" field.
-func (m *PatientMutation) SetDegreeOfDanger(i int) {
-	m.degreeOfDanger = &i
-	m.adddegreeOfDanger = nil
+// TitleCleared returns if the "title" field was cleared in this mutation.
+func (m *WardChatMutation) TitleCleared() bool {
+	_, ok := m.clearedFields[wardchat.FieldTitle]
+	return ok
 }
 
-// DegreeOfDanger returns the value of the "degreeOfDanger" field in the mutation.
-func (m *PatientMutation) DegreeOfDanger() (r int, exists bool) {
-	v := m.degreeOfDanger
+// ResetTitle resets all changes to the "title" field.
+func (m *WardChatMutation) ResetTitle() {
+	m.title = nil
+	delete(m.clearedFields, wardchat.FieldTitle)
+}
+
+// SetDepartmentId sets the "departmentId" field.
+func (m *WardChatMutation) SetDepartmentId(i int) {
+	m.departmentId = &i
+	m.adddepartmentId = nil
+}
+
+// DepartmentId returns the value of the "departmentId" field in the mutation.
+func (m *WardChatMutation) DepartmentId() (r int, exists bool) {
+	v := m.departmentId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDegreeOfDanger returns the old "degreeOfDanger" field's value of the Patient entity.
-// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// OldDepartmentId returns the old "departmentId" field's value of the WardChat entity.
+// If the WardChat object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PatientMutation) OldDegreeOfDanger(ctx context.Context) (v int, err error) {
+func (m *WardChatMutation) OldDepartmentId(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDegreeOfDanger is only allowed on UpdateOne operations")
+		return v, errors.New("OldDepartmentId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDegreeOfDanger requires an ID field in the mutation")
+		return v, errors.New("OldDepartmentId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDegreeOfDanger: %w", err)
+		return v, fmt.Errorf("querying old value for OldDepartmentId: %w", err)
 	}
-	return oldValue.DegreeOfDanger, nil
+	return oldValue.DepartmentId, nil
 }
 
-// AddDegreeOfDanger adds i to the "degreeOfDanger" field.
-func (m *PatientMutation) AddDegreeOfDanger(i int) {
-	if m.adddegreeOfDanger != nil {
-		*m.adddegreeOfDanger += i
+// AddDepartmentId adds i to the "departmentId" field.
+func (m *WardChatMutation) AddDepartmentId(i int) {
+	if m.adddepartmentId != nil {
+		*m.adddepartmentId += i
 	} else {
-		m.adddegreeOfDanger = &i
+		m.adddepartmentId = &i
 	}
 }
 
-// AddedDegreeOfDanger returns the value that was added to the "degreeOfDanger" field in this mutation.
-func (m *PatientMutation) AddedDegreeOfDanger() (r int, exists bool) {
-	v := m.adddegreeOfDanger
+// AddedDepartmentId returns the value that was added to the "departmentId" field in this mutation.
+func (m *WardChatMutation) AddedDepartmentId() (r int, exists bool) {
+	v := m.adddepartmentId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetDegreeOfDanger resets all changes to the "degreeOfDanger" field.
-func (m *PatientMutation) ResetDegreeOfDanger() {
-	m.degreeOfDanger = nil
-	m.adddegreeOfDanger = nil
+// ClearDepartmentId clears the value of the "departmentId" field.
+func (m *WardChatMutation) ClearDepartmentId() {
+	m.departmentId = nil
+	m.adddepartmentId = nil
+	m.clearedFields[wardchat.FieldDepartmentId] = struct{}{}
 }
 
-// SetRepoID sets the "repo" edge to the Room entity by id.
-func (m *PatientMutation) SetRepoID(id int) {
-	m.repo = &id
+// DepartmentIdCleared returns if the "departmentId" field was cleared in this mutation.
+func (m *WardChatMutation) DepartmentIdCleared() bool {
+	_, ok := m.clearedFields[wardchat.FieldDepartmentId]
+	return ok
 }
 
-// ClearRepo clears the "repo" edge to the Room entity.
-func (m *PatientMutation) ClearRepo() {
-	m.clearedrepo = true
+// ResetDepartmentId resets all changes to the "departmentId" field.
+func (m *WardChatMutation) ResetDepartmentId() {
+	m.departmentId = nil
+	m.adddepartmentId = nil
+	delete(m.clearedFields, wardchat.FieldDepartmentId)
 }
 
-// RepoCleared reports if the "repo" edge to the Room entity was cleared.
-func (m *PatientMutation) RepoCleared() bool {
-	return m.clearedrepo
+// SetRooms sets the "rooms" field.
+func (m *WardChatMutation) SetRooms(i []int) {
+	m.rooms = &i
+	m.appendrooms = nil
 }
 
-// RepoID returns the "repo" edge ID in the mutation.
-func (m *PatientMutation) RepoID() (id int, exists bool) {
-	if m.repo != nil {
-		return *m.repo, true
+// Rooms returns the value of the "rooms" field in the mutation.
+func (m *WardChatMutation) Rooms() (r []int, exists bool) {
+	v := m.rooms
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// RepoIDs returns the "repo" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RepoID instead. It exists only for internal usage by the builders.
-func (m *PatientMutation) RepoIDs() (ids []int) {
-	if id := m.repo; id != nil {
-		ids = append(ids, *id)
+// OldRooms returns the old "rooms" field's value of the WardChat entity.
+// If the WardChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WardChatMutation) OldRooms(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRooms is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRooms requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRooms: %w", err)
+	}
+	return oldValue.Rooms, nil
 }
 
-// ResetRepo resets all changes to the "repo" edge.
-func (m *PatientMutation) ResetRepo() {
-	m.repo = nil
-	m.clearedrepo = false
+// AppendRooms adds i to the "rooms" field.
+func (m *WardChatMutation) AppendRooms(i []int) {
+	m.appendrooms = append(m.appendrooms, i...)
 }
 
-// AddDoctorIDs adds the "doctor" edge to the Doctor entity by ids.
-func (m *PatientMutation) AddDoctorIDs(ids ...int) {
-	if m.doctor == nil {
-		m.doctor = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.doctor[ids[i]] = struct{}{}
+// AppendedRooms returns the list of values that were appended to the "rooms" field in this mutation.
+func (m *WardChatMutation) AppendedRooms() ([]int, bool) {
+	if len(m.appendrooms) == 0 {
+		return nil, false
 	}
+	return m.appendrooms, true
 }
 
-// ClearDoctor clears the "doctor" edge to the Doctor entity.
-func (m *PatientMutation) ClearDoctor() {
-	m.cleareddoctor = true
+// ClearRooms clears the value of the "rooms" field.
+func (m *WardChatMutation) ClearRooms() {
+	m.rooms = nil
+	m.appendrooms = nil
+	m.clearedFields[wardchat.FieldRooms] = struct{}{}
 }
 
-// DoctorCleared reports if the "doctor" edge to the Doctor entity was cleared.
-func (m *PatientMutation) DoctorCleared() bool {
-	return m.cleareddoctor
+// RoomsCleared returns if the "rooms" field was cleared in this mutation.
+func (m *WardChatMutation) RoomsCleared() bool {
+	_, ok := m.clearedFields[wardchat.FieldRooms]
+	return ok
 }
 
-// RemoveDoctorIDs removes the "doctor" edge to the Doctor entity by IDs.
-func (m *PatientMutation) RemoveDoctorIDs(ids ...int) {
-	if m.removeddoctor == nil {
-		m.removeddoctor = make(map[int]struct{})
+// ResetRooms resets all changes to the "rooms" field.
+func (m *WardChatMutation) ResetRooms() {
+	m.rooms = nil
+	m.appendrooms = nil
+	delete(m.clearedFields, wardchat.FieldRooms)
+}
+
+// SetLanguage sets the "language" field.
+func (m *WardChatMutation) SetLanguage(s string) {
+	m.language = &s
+}
+
+// Language returns the value of the "language" field in the mutation.
+func (m *WardChatMutation) Language() (r string, exists bool) {
+	v := m.language
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.doctor, ids[i])
-		m.removeddoctor[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldLanguage returns the old "language" field's value of the WardChat entity.
+// If the WardChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WardChatMutation) OldLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
 	}
+	return oldValue.Language, nil
 }
 
-// RemovedDoctor returns the removed IDs of the "doctor" edge to the Doctor entity.
-func (m *PatientMutation) RemovedDoctorIDs() (ids []int) {
-	for id := range m.removeddoctor {
-		ids = append(ids, id)
+// ClearLanguage clears the value of the "language" field.
+func (m *WardChatMutation) ClearLanguage() {
+	m.language = nil
+	m.clearedFields[wardchat.FieldLanguage] = struct{}{}
+}
+
+// LanguageCleared returns if the "language" field was cleared in this mutation.
+func (m *WardChatMutation) LanguageCleared() bool {
+	_, ok := m.clearedFields[wardchat.FieldLanguage]
+	return ok
+}
+
+// ResetLanguage resets all changes to the "language" field.
+func (m *WardChatMutation) ResetLanguage() {
+	m.language = nil
+	delete(m.clearedFields, wardchat.FieldLanguage)
+}
+
+// SetCreatedBy sets the "createdBy" field.
+func (m *WardChatMutation) SetCreatedBy(i int) {
+	m.createdBy = &i
+	m.addcreatedBy = nil
+}
+
+// CreatedBy returns the value of the "createdBy" field in the mutation.
+func (m *WardChatMutation) CreatedBy() (r int, exists bool) {
+	v := m.createdBy
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// DoctorIDs returns the "doctor" edge IDs in the mutation.
-func (m *PatientMutation) DoctorIDs() (ids []int) {
-	for id := range m.doctor {
-		ids = append(ids, id)
+// OldCreatedBy returns the old "createdBy" field's value of the WardChat entity.
+// If the WardChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WardChatMutation) OldCreatedBy(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
 }
 
-// ResetDoctor resets all changes to the "doctor" edge.
-func (m *PatientMutation) ResetDoctor() {
-	m.doctor = nil
-	m.cleareddoctor = false
-	m.removeddoctor = nil
+// AddCreatedBy adds i to the "createdBy" field.
+func (m *WardChatMutation) AddCreatedBy(i int) {
+	if m.addcreatedBy != nil {
+		*m.addcreatedBy += i
+	} else {
+		m.addcreatedBy = &i
+	}
 }
 
-// SetIllsID sets the "ills" edge to the Disease entity by id.
-func (m *PatientMutation) SetIllsID(id int) {
-	m.ills = &id
+// AddedCreatedBy returns the value that was added to the "createdBy" field in this mutation.
+func (m *WardChatMutation) AddedCreatedBy() (r int, exists bool) {
+	v := m.addcreatedBy
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearIlls clears the "ills" edge to the Disease entity.
-func (m *PatientMutation) ClearIlls() {
-	m.clearedills = true
+// ResetCreatedBy resets all changes to the "createdBy" field.
+func (m *WardChatMutation) ResetCreatedBy() {
+	m.createdBy = nil
+	m.addcreatedBy = nil
 }
 
-// IllsCleared reports if the "ills" edge to the Disease entity was cleared.
-func (m *PatientMutation) IllsCleared() bool {
-	return m.clearedills
+// SetCreatedAt sets the "createdAt" field.
+func (m *WardChatMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
 }
 
-// IllsID returns the "ills" edge ID in the mutation.
-func (m *PatientMutation) IllsID() (id int, exists bool) {
-	if m.ills != nil {
-		return *m.ills, true
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *WardChatMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// IllsIDs returns the "ills" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// IllsID instead. It exists only for internal usage by the builders.
-func (m *PatientMutation) IllsIDs() (ids []int) {
-	if id := m.ills; id != nil {
-		ids = append(ids, *id)
+// OldCreatedAt returns the old "createdAt" field's value of the WardChat entity.
+// If the WardChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WardChatMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetIlls resets all changes to the "ills" edge.
-func (m *PatientMutation) ResetIlls() {
-	m.ills = nil
-	m.clearedills = false
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *WardChatMutation) ResetCreatedAt() {
+	m.createdAt = nil
 }
 
-// Where appends a list predicates to the PatientMutation builder.
-func (m *PatientMutation) Where(ps ...predicate.Patient) {
+// Where appends a list predicates to the WardChatMutation builder.
+func (m *WardChatMutation) Where(ps ...predicate.WardChat) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PatientMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WardChatMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PatientMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Patient, len(ps))
+func (m *WardChatMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WardChat, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1762,45 +13127,48 @@ func (m *PatientMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PatientMutation) Op() Op {
+func (m *WardChatMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PatientMutation) SetOp(op Op) {
+func (m *WardChatMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Patient).
-func (m *PatientMutation) Type() string {
+// Type returns the node type of this mutation (WardChat).
+func (m *WardChatMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PatientMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.surname != nil {
-		fields = append(fields, patient.FieldSurname)
+func (m *WardChatMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.organizationId != nil {
+		fields = append(fields, wardchat.FieldOrganizationId)
 	}
-	if m.name != nil {
-		fields = append(fields, patient.FieldName)
+	if m.chatId != nil {
+		fields = append(fields, wardchat.FieldChatId)
 	}
-	if m.patronymic != nil {
-		fields = append(fields, patient.FieldPatronymic)
+	if m.title != nil {
+		fields = append(fields, wardchat.FieldTitle)
 	}
-	if m.height != nil {
-		fields = append(fields, patient.FieldHeight)
+	if m.departmentId != nil {
+		fields = append(fields, wardchat.FieldDepartmentId)
 	}
-	if m.weight != nil {
-		fields = append(fields, patient.FieldWeight)
+	if m.rooms != nil {
+		fields = append(fields, wardchat.FieldRooms)
 	}
-	if m.repo != nil {
-		fields = append(fields, patient.FieldRoomNumber)
+	if m.language != nil {
+		fields = append(fields, wardchat.FieldLanguage)
 	}
-	if m.degreeOfDanger != nil {
-		fields = append(fields, patient.FieldDegreeOfDanger)
+	if m.createdBy != nil {
+		fields = append(fields, wardchat.FieldCreatedBy)
+	}
+	if m.createdAt != nil {
+		fields = append(fields, wardchat.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1808,22 +13176,24 @@ func (m *PatientMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PatientMutation) Field(name string) (ent.Value, bool) {
+func (m *WardChatMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case patient.FieldSurname:
-		return m.Surname()
-	case patient.FieldName:
-		return m.Name()
-	case patient.FieldPatronymic:
-		return m.Patronymic()
-	case patient.FieldHeight:
-		return m.Height()
-	case patient.FieldWeight:
-		return m.Weight()
-	case patient.FieldRoomNumber:
-		return m.RoomNumber()
-	case patient.FieldDegreeOfDanger:
-		return m.DegreeOfDanger()
+	case wardchat.FieldOrganizationId:
+		return m.OrganizationId()
+	case wardchat.FieldChatId:
+		return m.ChatId()
+	case wardchat.FieldTitle:
+		return m.Title()
+	case wardchat.FieldDepartmentId:
+		return m.DepartmentId()
+	case wardchat.FieldRooms:
+		return m.Rooms()
+	case wardchat.FieldLanguage:
+		return m.Language()
+	case wardchat.FieldCreatedBy:
+		return m.CreatedBy()
+	case wardchat.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -1831,96 +13201,108 @@ func (m *PatientMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PatientMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WardChatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case patient.FieldSurname:
-		return m.OldSurname(ctx)
-	case patient.FieldName:
-		return m.OldName(ctx)
-	case patient.FieldPatronymic:
-		return m.OldPatronymic(ctx)
-	case patient.FieldHeight:
-		return m.OldHeight(ctx)
-	case patient.FieldWeight:
-		return m.OldWeight(ctx)
-	case patient.FieldRoomNumber:
-		return m.OldRoomNumber(ctx)
-	case patient.FieldDegreeOfDanger:
-		return m.OldDegreeOfDanger(ctx)
-	}
-	return nil, fmt.Errorf("unknown Patient field %s", name)
+	case wardchat.FieldOrganizationId:
+		return m.OldOrganizationId(ctx)
+	case wardchat.FieldChatId:
+		return m.OldChatId(ctx)
+	case wardchat.FieldTitle:
+		return m.OldTitle(ctx)
+	case wardchat.FieldDepartmentId:
+		return m.OldDepartmentId(ctx)
+	case wardchat.FieldRooms:
+		return m.OldRooms(ctx)
+	case wardchat.FieldLanguage:
+		return m.OldLanguage(ctx)
+	case wardchat.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case wardchat.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown WardChat field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PatientMutation) SetField(name string, value ent.Value) error {
+func (m *WardChatMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case patient.FieldSurname:
-		v, ok := value.(string)
+	case wardchat.FieldOrganizationId:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSurname(v)
+		m.SetOrganizationId(v)
 		return nil
-	case patient.FieldName:
-		v, ok := value.(string)
+	case wardchat.FieldChatId:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetChatId(v)
 		return nil
-	case patient.FieldPatronymic:
+	case wardchat.FieldTitle:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPatronymic(v)
+		m.SetTitle(v)
 		return nil
-	case patient.FieldHeight:
+	case wardchat.FieldDepartmentId:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHeight(v)
+		m.SetDepartmentId(v)
 		return nil
-	case patient.FieldWeight:
-		v, ok := value.(float64)
+	case wardchat.FieldRooms:
+		v, ok := value.([]int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetWeight(v)
+		m.SetRooms(v)
 		return nil
-	case patient.FieldRoomNumber:
-		v, ok := value.(int)
+	case wardchat.FieldLanguage:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRoomNumber(v)
+		m.SetLanguage(v)
 		return nil
-	case patient.FieldDegreeOfDanger:
+	case wardchat.FieldCreatedBy:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDegreeOfDanger(v)
+		m.SetCreatedBy(v)
+		return nil
+	case wardchat.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Patient field %s", name)
+	return fmt.Errorf("unknown WardChat field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PatientMutation) AddedFields() []string {
+func (m *WardChatMutation) AddedFields() []string {
 	var fields []string
-	if m.addheight != nil {
-		fields = append(fields, patient.FieldHeight)
+	if m.addorganizationId != nil {
+		fields = append(fields, wardchat.FieldOrganizationId)
 	}
-	if m.addweight != nil {
-		fields = append(fields, patient.FieldWeight)
+	if m.addchatId != nil {
+		fields = append(fields, wardchat.FieldChatId)
 	}
-	if m.adddegreeOfDanger != nil {
-		fields = append(fields, patient.FieldDegreeOfDanger)
+	if m.adddepartmentId != nil {
+		fields = append(fields, wardchat.FieldDepartmentId)
+	}
+	if m.addcreatedBy != nil {
+		fields = append(fields, wardchat.FieldCreatedBy)
 	}
 	return fields
 }
@@ -1928,14 +13310,16 @@ func (m *PatientMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PatientMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WardChatMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case patient.FieldHeight:
-		return m.AddedHeight()
-	case patient.FieldWeight:
-		return m.AddedWeight()
-	case patient.FieldDegreeOfDanger:
-		return m.AddedDegreeOfDanger()
+	case wardchat.FieldOrganizationId:
+		return m.AddedOrganizationId()
+	case wardchat.FieldChatId:
+		return m.AddedChatId()
+	case wardchat.FieldDepartmentId:
+		return m.AddedDepartmentId()
+	case wardchat.FieldCreatedBy:
+		return m.AddedCreatedBy()
 	}
 	return nil, false
 }
@@ -1943,236 +13327,210 @@ func (m *PatientMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PatientMutation) AddField(name string, value ent.Value) error {
+func (m *WardChatMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case patient.FieldHeight:
+	case wardchat.FieldOrganizationId:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddHeight(v)
+		m.AddOrganizationId(v)
 		return nil
-	case patient.FieldWeight:
-		v, ok := value.(float64)
+	case wardchat.FieldChatId:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddWeight(v)
+		m.AddChatId(v)
 		return nil
-	case patient.FieldDegreeOfDanger:
+	case wardchat.FieldDepartmentId:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddDegreeOfDanger(v)
+		m.AddDepartmentId(v)
+		return nil
+	case wardchat.FieldCreatedBy:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedBy(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Patient numeric field %s", name)
+	return fmt.Errorf("unknown WardChat numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PatientMutation) ClearedFields() []string {
-	return nil
+func (m *WardChatMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(wardchat.FieldOrganizationId) {
+		fields = append(fields, wardchat.FieldOrganizationId)
+	}
+	if m.FieldCleared(wardchat.FieldTitle) {
+		fields = append(fields, wardchat.FieldTitle)
+	}
+	if m.FieldCleared(wardchat.FieldDepartmentId) {
+		fields = append(fields, wardchat.FieldDepartmentId)
+	}
+	if m.FieldCleared(wardchat.FieldRooms) {
+		fields = append(fields, wardchat.FieldRooms)
+	}
+	if m.FieldCleared(wardchat.FieldLanguage) {
+		fields = append(fields, wardchat.FieldLanguage)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PatientMutation) FieldCleared(name string) bool {
+func (m *WardChatMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PatientMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Patient nullable field %s", name)
+func (m *WardChatMutation) ClearField(name string) error {
+	switch name {
+	case wardchat.FieldOrganizationId:
+		m.ClearOrganizationId()
+		return nil
+	case wardchat.FieldTitle:
+		m.ClearTitle()
+		return nil
+	case wardchat.FieldDepartmentId:
+		m.ClearDepartmentId()
+		return nil
+	case wardchat.FieldRooms:
+		m.ClearRooms()
+		return nil
+	case wardchat.FieldLanguage:
+		m.ClearLanguage()
+		return nil
+	}
+	return fmt.Errorf("unknown WardChat nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PatientMutation) ResetField(name string) error {
+func (m *WardChatMutation) ResetField(name string) error {
 	switch name {
-	case patient.FieldSurname:
-		m.ResetSurname()
+	case wardchat.FieldOrganizationId:
+		m.ResetOrganizationId()
 		return nil
-	case patient.FieldName:
-		m.ResetName()
+	case wardchat.FieldChatId:
+		m.ResetChatId()
 		return nil
-	case patient.FieldPatronymic:
-		m.ResetPatronymic()
+	case wardchat.FieldTitle:
+		m.ResetTitle()
 		return nil
-	case patient.FieldHeight:
-		m.ResetHeight()
+	case wardchat.FieldDepartmentId:
+		m.ResetDepartmentId()
 		return nil
-	case patient.FieldWeight:
-		m.ResetWeight()
+	case wardchat.FieldRooms:
+		m.ResetRooms()
 		return nil
-	case patient.FieldRoomNumber:
-		m.ResetRoomNumber()
+	case wardchat.FieldLanguage:
+		m.ResetLanguage()
 		return nil
-	case patient.FieldDegreeOfDanger:
-		m.ResetDegreeOfDanger()
+	case wardchat.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case wardchat.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Patient field %s", name)
+	return fmt.Errorf("unknown WardChat field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PatientMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.repo != nil {
-		edges = append(edges, patient.EdgeRepo)
-	}
-	if m.doctor != nil {
-		edges = append(edges, patient.EdgeDoctor)
-	}
-	if m.ills != nil {
-		edges = append(edges, patient.EdgeIlls)
-	}
+func (m *WardChatMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PatientMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case patient.EdgeRepo:
-		if id := m.repo; id != nil {
-			return []ent.Value{*id}
-		}
-	case patient.EdgeDoctor:
-		ids := make([]ent.Value, 0, len(m.doctor))
-		for id := range m.doctor {
-			ids = append(ids, id)
-		}
-		return ids
-	case patient.EdgeIlls:
-		if id := m.ills; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *WardChatMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PatientMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removeddoctor != nil {
-		edges = append(edges, patient.EdgeDoctor)
-	}
+func (m *WardChatMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PatientMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case patient.EdgeDoctor:
-		ids := make([]ent.Value, 0, len(m.removeddoctor))
-		for id := range m.removeddoctor {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *WardChatMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PatientMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedrepo {
-		edges = append(edges, patient.EdgeRepo)
-	}
-	if m.cleareddoctor {
-		edges = append(edges, patient.EdgeDoctor)
-	}
-	if m.clearedills {
-		edges = append(edges, patient.EdgeIlls)
-	}
+func (m *WardChatMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PatientMutation) EdgeCleared(name string) bool {
-	switch name {
-	case patient.EdgeRepo:
-		return m.clearedrepo
-	case patient.EdgeDoctor:
-		return m.cleareddoctor
-	case patient.EdgeIlls:
-		return m.clearedills
-	}
+func (m *WardChatMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PatientMutation) ClearEdge(name string) error {
-	switch name {
-	case patient.EdgeRepo:
-		m.ClearRepo()
-		return nil
-	case patient.EdgeIlls:
-		m.ClearIlls()
-		return nil
-	}
-	return fmt.Errorf("unknown Patient unique edge %s", name)
+func (m *WardChatMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WardChat unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PatientMutation) ResetEdge(name string) error {
-	switch name {
-	case patient.EdgeRepo:
-		m.ResetRepo()
-		return nil
-	case patient.EdgeDoctor:
-		m.ResetDoctor()
-		return nil
-	case patient.EdgeIlls:
-		m.ResetIlls()
-		return nil
-	}
-	return fmt.Errorf("unknown Patient edge %s", name)
+func (m *WardChatMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WardChat edge %s", name)
 }
 
-// RoomMutation represents an operation that mutates the Room nodes in the graph.
-type RoomMutation struct {
+// WardNoticeMutation represents an operation that mutates the WardNotice nodes in the graph.
+type WardNoticeMutation struct {
 	config
 	op                Op
 	typ               string
 	id                *int
-	number            *int
-	addnumber         *int
-	floor             *int
-	addfloor          *int
-	numberBeds        *int
-	addnumberBeds     *int
-	numberPatients    *int
-	addnumberPatients *int
-	typeRoom          *string
+	organizationId    *int
+	addorganizationId *int
+	chatId            *int64
+	addchatId         *int64
+	kind              *string
+	severity          *string
+	patientId         *int
+	addpatientId      *int
+	params            *map[string]string
+	status            *string
+	sentAt            *time.Time
+	claimedUntil      *time.Time
+	attempts          *int
+	addattempts       *int
+	createdAt         *time.Time
 	clearedFields     map[string]struct{}
-	contains          map[int]struct{}
-	removedcontains   map[int]struct{}
-	clearedcontains   bool
 	done              bool
-	oldValue          func(context.Context) (*Room, error)
-	predicates        []predicate.Room
+	oldValue          func(context.Context) (*WardNotice, error)
+	predicates        []predicate.WardNotice
 }
 
-var _ ent.Mutation = (*RoomMutation)(nil)
+var _ ent.Mutation = (*WardNoticeMutation)(nil)
 
-// roomOption allows management of the mutation configuration using functional options.
-type roomOption func(*RoomMutation)
+// wardnoticeOption allows management of the mutation configuration using functional options.
+type wardnoticeOption func(*WardNoticeMutation)
 
-// newRoomMutation creates new mutation for the Room entity.
-func newRoomMutation(c config, op Op, opts ...roomOption) *RoomMutation {
-	m := &RoomMutation{
+// newWardNoticeMutation creates new mutation for the WardNotice entity.
+func newWardNoticeMutation(c config, op Op, opts ...wardnoticeOption) *WardNoticeMutation {
+	m := &WardNoticeMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRoom,
+		typ:           TypeWardNotice,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2181,20 +13539,20 @@ func newRoomMutation(c config, op Op, opts ...roomOption) *RoomMutation {
 	return m
 }
 
-// withRoomID sets the ID field of the mutation.
-func withRoomID(id int) roomOption {
-	return func(m *RoomMutation) {
+// withWardNoticeID sets the ID field of the mutation.
+func withWardNoticeID(id int) wardnoticeOption {
+	return func(m *WardNoticeMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Room
+			value *WardNotice
 		)
-		m.oldValue = func(ctx context.Context) (*Room, error) {
+		m.oldValue = func(ctx context.Context) (*WardNotice, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Room.Get(ctx, id)
+					value, err = m.Client().WardNotice.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2203,10 +13561,10 @@ func withRoomID(id int) roomOption {
 	}
 }
 
-// withRoom sets the old Room of the mutation.
-func withRoom(node *Room) roomOption {
-	return func(m *RoomMutation) {
-		m.oldValue = func(context.Context) (*Room, error) {
+// withWardNotice sets the old WardNotice of the mutation.
+func withWardNotice(node *WardNotice) wardnoticeOption {
+	return func(m *WardNoticeMutation) {
+		m.oldValue = func(context.Context) (*WardNotice, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2215,7 +13573,7 @@ func withRoom(node *Room) roomOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RoomMutation) Client() *Client {
+func (m WardNoticeMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2223,7 +13581,7 @@ func (m RoomMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RoomMutation) Tx() (*Tx, error) {
+func (m WardNoticeMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2234,7 +13592,7 @@ func (m RoomMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RoomMutation) ID() (id int, exists bool) {
+func (m *WardNoticeMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2245,7 +13603,7 @@ func (m *RoomMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RoomMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *WardNoticeMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2254,335 +13612,550 @@ func (m *RoomMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Room.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WardNotice.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetNumber sets the "number" field.
-func (m *RoomMutation) SetNumber(i int) {
-	m.number = &i
-	m.addnumber = nil
+// SetOrganizationId sets the "organizationId" field.
+func (m *WardNoticeMutation) SetOrganizationId(i int) {
+	m.organizationId = &i
+	m.addorganizationId = nil
 }
 
-// Number returns the value of the "number" field in the mutation.
-func (m *RoomMutation) Number() (r int, exists bool) {
-	v := m.number
+// OrganizationId returns the value of the "organizationId" field in the mutation.
+func (m *WardNoticeMutation) OrganizationId() (r int, exists bool) {
+	v := m.organizationId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNumber returns the old "number" field's value of the Room entity.
-// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// OldOrganizationId returns the old "organizationId" field's value of the WardNotice entity.
+// If the WardNotice object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMutation) OldNumber(ctx context.Context) (v int, err error) {
+func (m *WardNoticeMutation) OldOrganizationId(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNumber is only allowed on UpdateOne operations")
+		return v, errors.New("OldOrganizationId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNumber requires an ID field in the mutation")
+		return v, errors.New("OldOrganizationId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNumber: %w", err)
+		return v, fmt.Errorf("querying old value for OldOrganizationId: %w", err)
 	}
-	return oldValue.Number, nil
+	return oldValue.OrganizationId, nil
 }
 
-// AddNumber adds i to the "number" field.
-func (m *RoomMutation) AddNumber(i int) {
-	if m.addnumber != nil {
-		*m.addnumber += i
+// AddOrganizationId adds i to the "organizationId" field.
+func (m *WardNoticeMutation) AddOrganizationId(i int) {
+	if m.addorganizationId != nil {
+		*m.addorganizationId += i
 	} else {
-		m.addnumber = &i
+		m.addorganizationId = &i
 	}
 }
 
-// AddedNumber returns the value that was added to the "number" field in this mutation.
-func (m *RoomMutation) AddedNumber() (r int, exists bool) {
-	v := m.addnumber
+// AddedOrganizationId returns the value that was added to the "organizationId" field in this mutation.
+func (m *WardNoticeMutation) AddedOrganizationId() (r int, exists bool) {
+	v := m.addorganizationId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetNumber resets all changes to the "number" field.
-func (m *RoomMutation) ResetNumber() {
-	m.number = nil
-	m.addnumber = nil
+// ClearOrganizationId clears the value of the "organizationId" field.
+func (m *WardNoticeMutation) ClearOrganizationId() {
+	m.organizationId = nil
+	m.addorganizationId = nil
+	m.clearedFields[wardnotice.FieldOrganizationId] = struct{}{}
 }
 
-// SetFloor sets the "floor" field.
-func (m *RoomMutation) SetFloor(i int) {
-	m.floor = &i
-	m.addfloor = nil
+// OrganizationIdCleared returns if the "organizationId" field was cleared in this mutation.
+func (m *WardNoticeMutation) OrganizationIdCleared() bool {
+	_, ok := m.clearedFields[wardnotice.FieldOrganizationId]
+	return ok
 }
 
-// Floor returns the value of the "floor" field in the mutation.
-func (m *RoomMutation) Floor() (r int, exists bool) {
-	v := m.floor
+// ResetOrganizationId resets all changes to the "organizationId" field.
+func (m *WardNoticeMutation) ResetOrganizationId() {
+	m.organizationId = nil
+	m.addorganizationId = nil
+	delete(m.clearedFields, wardnotice.FieldOrganizationId)
+}
+
+// SetChatId sets the "chatId" field.
+func (m *WardNoticeMutation) SetChatId(i int64) {
+	m.chatId = &i
+	m.addchatId = nil
+}
+
+// ChatId returns the value of the "chatId" field in the mutation.
+func (m *WardNoticeMutation) ChatId() (r int64, exists bool) {
+	v := m.chatId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFloor returns the old "floor" field's value of the Room entity.
-// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// OldChatId returns the old "chatId" field's value of the WardNotice entity.
+// If the WardNotice object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMutation) OldFloor(ctx context.Context) (v int, err error) {
+func (m *WardNoticeMutation) OldChatId(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFloor is only allowed on UpdateOne operations")
+		return v, errors.New("OldChatId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFloor requires an ID field in the mutation")
+		return v, errors.New("OldChatId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFloor: %w", err)
+		return v, fmt.Errorf("querying old value for OldChatId: %w", err)
 	}
-	return oldValue.Floor, nil
+	return oldValue.ChatId, nil
 }
 
-// AddFloor adds i to the "floor" field.
-func (m *RoomMutation) AddFloor(i int) {
-	if m.addfloor != nil {
-		*m.addfloor += i
+// AddChatId adds i to the "chatId" field.
+func (m *WardNoticeMutation) AddChatId(i int64) {
+	if m.addchatId != nil {
+		*m.addchatId += i
 	} else {
-		m.addfloor = &i
+		m.addchatId = &i
 	}
 }
 
-// AddedFloor returns the value that was added to the "floor" field in this mutation.
-func (m *RoomMutation) AddedFloor() (r int, exists bool) {
-	v := m.addfloor
+// AddedChatId returns the value that was added to the "chatId" field in this mutation.
+func (m *WardNoticeMutation) AddedChatId() (r int64, exists bool) {
+	v := m.addchatId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetFloor resets all changes to the "floor" field.
-func (m *RoomMutation) ResetFloor() {
-	m.floor = nil
-	m.addfloor = nil
+// ResetChatId resets all changes to the "chatId" field.
+func (m *WardNoticeMutation) ResetChatId() {
+	m.chatId = nil
+	m.addchatId = nil
 }
 
-// SetNumberBeds sets the "numberBeds" field.
-func (m *RoomMutation) SetNumberBeds(i int) {
-	m.numberBeds = &i
-	m.addnumberBeds = nil
+// SetKind sets the "kind" field.
+func (m *WardNoticeMutation) SetKind(s string) {
+	m.kind = &s
 }
 
-// NumberBeds returns the value of the "numberBeds" field in the mutation.
-func (m *RoomMutation) NumberBeds() (r int, exists bool) {
-	v := m.numberBeds
+// Kind returns the value of the "kind" field in the mutation.
+func (m *WardNoticeMutation) Kind() (r string, exists bool) {
+	v := m.kind
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNumberBeds returns the old "numberBeds" field's value of the Room entity.
-// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// OldKind returns the old "kind" field's value of the WardNotice entity.
+// If the WardNotice object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMutation) OldNumberBeds(ctx context.Context) (v int, err error) {
+func (m *WardNoticeMutation) OldKind(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNumberBeds is only allowed on UpdateOne operations")
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNumberBeds requires an ID field in the mutation")
+		return v, errors.New("OldKind requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNumberBeds: %w", err)
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
 	}
-	return oldValue.NumberBeds, nil
+	return oldValue.Kind, nil
 }
 
-// AddNumberBeds adds i to the "numberBeds" field.
-func (m *RoomMutation) AddNumberBeds(i int) {
-	if m.addnumberBeds != nil {
-		*m.addnumberBeds += i
+// ResetKind resets all changes to the "kind" field.
+func (m *WardNoticeMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetSeverity sets the "severity" field.
+func (m *WardNoticeMutation) SetSeverity(s string) {
+	m.severity = &s
+}
+
+// Severity returns the value of the "severity" field in the mutation.
+func (m *WardNoticeMutation) Severity() (r string, exists bool) {
+	v := m.severity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeverity returns the old "severity" field's value of the WardNotice entity.
+// If the WardNotice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WardNoticeMutation) OldSeverity(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeverity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeverity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeverity: %w", err)
+	}
+	return oldValue.Severity, nil
+}
+
+// ResetSeverity resets all changes to the "severity" field.
+func (m *WardNoticeMutation) ResetSeverity() {
+	m.severity = nil
+}
+
+// SetPatientId sets the "patientId" field.
+func (m *WardNoticeMutation) SetPatientId(i int) {
+	m.patientId = &i
+	m.addpatientId = nil
+}
+
+// PatientId returns the value of the "patientId" field in the mutation.
+func (m *WardNoticeMutation) PatientId() (r int, exists bool) {
+	v := m.patientId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPatientId returns the old "patientId" field's value of the WardNotice entity.
+// If the WardNotice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WardNoticeMutation) OldPatientId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPatientId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPatientId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPatientId: %w", err)
+	}
+	return oldValue.PatientId, nil
+}
+
+// AddPatientId adds i to the "patientId" field.
+func (m *WardNoticeMutation) AddPatientId(i int) {
+	if m.addpatientId != nil {
+		*m.addpatientId += i
 	} else {
-		m.addnumberBeds = &i
+		m.addpatientId = &i
 	}
 }
 
-// AddedNumberBeds returns the value that was added to the "numberBeds" field in this mutation.
-func (m *RoomMutation) AddedNumberBeds() (r int, exists bool) {
-	v := m.addnumberBeds
+// AddedPatientId returns the value that was added to the "patientId" field in this mutation.
+func (m *WardNoticeMutation) AddedPatientId() (r int, exists bool) {
+	v := m.addpatientId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetNumberBeds resets all changes to the "numberBeds" field.
-func (m *RoomMutation) ResetNumberBeds() {
-	m.numberBeds = nil
-	m.addnumberBeds = nil
+// ResetPatientId resets all changes to the "patientId" field.
+func (m *WardNoticeMutation) ResetPatientId() {
+	m.patientId = nil
+	m.addpatientId = nil
 }
 
-// SetNumberPatients sets the "numberPatients" field.
-func (m *RoomMutation) SetNumberPatients(i int) {
-	m.numberPatients = &i
-	m.addnumberPatients = nil
+// SetParams sets the "params" field.
+func (m *WardNoticeMutation) SetParams(value map[string]string) {
+	m.params = &value
 }
 
-// NumberPatients returns the value of the "numberPatients" field in the mutation.
-func (m *RoomMutation) NumberPatients() (r int, exists bool) {
-	v := m.numberPatients
+// Params returns the value of the "params" field in the mutation.
+func (m *WardNoticeMutation) Params() (r map[string]string, exists bool) {
+	v := m.params
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNumberPatients returns the old "numberPatients" field's value of the Room entity.
-// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// OldParams returns the old "params" field's value of the WardNotice entity.
+// If the WardNotice object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMutation) OldNumberPatients(ctx context.Context) (v int, err error) {
+func (m *WardNoticeMutation) OldParams(ctx context.Context) (v map[string]string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNumberPatients is only allowed on UpdateOne operations")
+		return v, errors.New("OldParams is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNumberPatients requires an ID field in the mutation")
+		return v, errors.New("OldParams requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNumberPatients: %w", err)
+		return v, fmt.Errorf("querying old value for OldParams: %w", err)
 	}
-	return oldValue.NumberPatients, nil
+	return oldValue.Params, nil
 }
 
-// AddNumberPatients adds i to the "numberPatients" field.
-func (m *RoomMutation) AddNumberPatients(i int) {
-	if m.addnumberPatients != nil {
-		*m.addnumberPatients += i
-	} else {
-		m.addnumberPatients = &i
+// ClearParams clears the value of the "params" field.
+func (m *WardNoticeMutation) ClearParams() {
+	m.params = nil
+	m.clearedFields[wardnotice.FieldParams] = struct{}{}
+}
+
+// ParamsCleared returns if the "params" field was cleared in this mutation.
+func (m *WardNoticeMutation) ParamsCleared() bool {
+	_, ok := m.clearedFields[wardnotice.FieldParams]
+	return ok
+}
+
+// ResetParams resets all changes to the "params" field.
+func (m *WardNoticeMutation) ResetParams() {
+	m.params = nil
+	delete(m.clearedFields, wardnotice.FieldParams)
+}
+
+// SetStatus sets the "status" field.
+func (m *WardNoticeMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *WardNoticeMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// AddedNumberPatients returns the value that was added to the "numberPatients" field in this mutation.
-func (m *RoomMutation) AddedNumberPatients() (r int, exists bool) {
-	v := m.addnumberPatients
+// OldStatus returns the old "status" field's value of the WardNotice entity.
+// If the WardNotice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WardNoticeMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *WardNoticeMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetSentAt sets the "sentAt" field.
+func (m *WardNoticeMutation) SetSentAt(t time.Time) {
+	m.sentAt = &t
+}
+
+// SentAt returns the value of the "sentAt" field in the mutation.
+func (m *WardNoticeMutation) SentAt() (r time.Time, exists bool) {
+	v := m.sentAt
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetNumberPatients resets all changes to the "numberPatients" field.
-func (m *RoomMutation) ResetNumberPatients() {
-	m.numberPatients = nil
-	m.addnumberPatients = nil
+// OldSentAt returns the old "sentAt" field's value of the WardNotice entity.
+// If the WardNotice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WardNoticeMutation) OldSentAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSentAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSentAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSentAt: %w", err)
+	}
+	return oldValue.SentAt, nil
 }
 
-// SetTypeRoom sets the "typeRoom" field.
-func (m *RoomMutation) SetTypeRoom(s string) {
-	m.typeRoom = &s
+// ClearSentAt clears the value of the "sentAt" field.
+func (m *WardNoticeMutation) ClearSentAt() {
+	m.sentAt = nil
+	m.clearedFields[wardnotice.FieldSentAt] = struct{}{}
 }
 
-// TypeRoom returns the value of the "typeRoom" field in the mutation.
-func (m *RoomMutation) TypeRoom() (r string, exists bool) {
-	v := m.typeRoom
+// SentAtCleared returns if the "sentAt" field was cleared in this mutation.
+func (m *WardNoticeMutation) SentAtCleared() bool {
+	_, ok := m.clearedFields[wardnotice.FieldSentAt]
+	return ok
+}
+
+// ResetSentAt resets all changes to the "sentAt" field.
+func (m *WardNoticeMutation) ResetSentAt() {
+	m.sentAt = nil
+	delete(m.clearedFields, wardnotice.FieldSentAt)
+}
+
+// SetClaimedUntil sets the "claimedUntil" field.
+func (m *WardNoticeMutation) SetClaimedUntil(t time.Time) {
+	m.claimedUntil = &t
+}
+
+// ClaimedUntil returns the value of the "claimedUntil" field in the mutation.
+func (m *WardNoticeMutation) ClaimedUntil() (r time.Time, exists bool) {
+	v := m.claimedUntil
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTypeRoom returns the old "typeRoom" field's value of the Room entity.
-// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimedUntil returns the old "claimedUntil" field's value of the WardNotice entity.
+// If the WardNotice object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMutation) OldTypeRoom(ctx context.Context) (v string, err error) {
+func (m *WardNoticeMutation) OldClaimedUntil(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTypeRoom is only allowed on UpdateOne operations")
+		return v, errors.New("OldClaimedUntil is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTypeRoom requires an ID field in the mutation")
+		return v, errors.New("OldClaimedUntil requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTypeRoom: %w", err)
+		return v, fmt.Errorf("querying old value for OldClaimedUntil: %w", err)
 	}
-	return oldValue.TypeRoom, nil
+	return oldValue.ClaimedUntil, nil
 }
 
-// ResetTypeRoom resets all changes to the "typeRoom" field.
-func (m *RoomMutation) ResetTypeRoom() {
-	m.typeRoom = nil
+// ClearClaimedUntil clears the value of the "claimedUntil" field.
+func (m *WardNoticeMutation) ClearClaimedUntil() {
+	m.claimedUntil = nil
+	m.clearedFields[wardnotice.FieldClaimedUntil] = struct{}{}
 }
 
-// AddContainIDs adds the "contains" edge to the Patient entity by ids.
-func (m *RoomMutation) AddContainIDs(ids ...int) {
-	if m.contains == nil {
-		m.contains = make(map[int]struct{})
+// ClaimedUntilCleared returns if the "claimedUntil" field was cleared in this mutation.
+func (m *WardNoticeMutation) ClaimedUntilCleared() bool {
+	_, ok := m.clearedFields[wardnotice.FieldClaimedUntil]
+	return ok
+}
+
+// ResetClaimedUntil resets all changes to the "claimedUntil" field.
+func (m *WardNoticeMutation) ResetClaimedUntil() {
+	m.claimedUntil = nil
+	delete(m.clearedFields, wardnotice.FieldClaimedUntil)
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *WardNoticeMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *WardNoticeMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the WardNotice entity.
+// If the WardNotice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WardNoticeMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	for i := range ids {
-		m.contains[ids[i]] = struct{}{}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *WardNoticeMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
 	}
 }
 
-// ClearContains clears the "contains" edge to the Patient entity.
-func (m *RoomMutation) ClearContains() {
-	m.clearedcontains = true
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *WardNoticeMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ContainsCleared reports if the "contains" edge to the Patient entity was cleared.
-func (m *RoomMutation) ContainsCleared() bool {
-	return m.clearedcontains
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *WardNoticeMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
 }
 
-// RemoveContainIDs removes the "contains" edge to the Patient entity by IDs.
-func (m *RoomMutation) RemoveContainIDs(ids ...int) {
-	if m.removedcontains == nil {
-		m.removedcontains = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.contains, ids[i])
-		m.removedcontains[ids[i]] = struct{}{}
-	}
+// SetCreatedAt sets the "createdAt" field.
+func (m *WardNoticeMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
 }
 
-// RemovedContains returns the removed IDs of the "contains" edge to the Patient entity.
-func (m *RoomMutation) RemovedContainsIDs() (ids []int) {
-	for id := range m.removedcontains {
-		ids = append(ids, id)
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *WardNoticeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ContainsIDs returns the "contains" edge IDs in the mutation.
-func (m *RoomMutation) ContainsIDs() (ids []int) {
-	for id := range m.contains {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "createdAt" field's value of the WardNotice entity.
+// If the WardNotice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WardNoticeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetContains resets all changes to the "contains" edge.
-func (m *RoomMutation) ResetContains() {
-	m.contains = nil
-	m.clearedcontains = false
-	m.removedcontains = nil
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *WardNoticeMutation) ResetCreatedAt() {
+	m.createdAt = nil
 }
 
-// Where appends a list predicates to the RoomMutation builder.
-func (m *RoomMutation) Where(ps ...predicate.Room) {
+// Where appends a list predicates to the WardNoticeMutation builder.
+func (m *WardNoticeMutation) Where(ps ...predicate.WardNotice) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RoomMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WardNoticeMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RoomMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Room, len(ps))
+func (m *WardNoticeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WardNotice, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2590,39 +14163,57 @@ func (m *RoomMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RoomMutation) Op() Op {
+func (m *WardNoticeMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RoomMutation) SetOp(op Op) {
+func (m *WardNoticeMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Room).
-func (m *RoomMutation) Type() string {
+// Type returns the node type of this mutation (WardNotice).
+func (m *WardNoticeMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RoomMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.number != nil {
-		fields = append(fields, room.FieldNumber)
+func (m *WardNoticeMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.organizationId != nil {
+		fields = append(fields, wardnotice.FieldOrganizationId)
 	}
-	if m.floor != nil {
-		fields = append(fields, room.FieldFloor)
+	if m.chatId != nil {
+		fields = append(fields, wardnotice.FieldChatId)
 	}
-	if m.numberBeds != nil {
-		fields = append(fields, room.FieldNumberBeds)
+	if m.kind != nil {
+		fields = append(fields, wardnotice.FieldKind)
 	}
-	if m.numberPatients != nil {
-		fields = append(fields, room.FieldNumberPatients)
+	if m.severity != nil {
+		fields = append(fields, wardnotice.FieldSeverity)
 	}
-	if m.typeRoom != nil {
-		fields = append(fields, room.FieldTypeRoom)
+	if m.patientId != nil {
+		fields = append(fields, wardnotice.FieldPatientId)
+	}
+	if m.params != nil {
+		fields = append(fields, wardnotice.FieldParams)
+	}
+	if m.status != nil {
+		fields = append(fields, wardnotice.FieldStatus)
+	}
+	if m.sentAt != nil {
+		fields = append(fields, wardnotice.FieldSentAt)
+	}
+	if m.claimedUntil != nil {
+		fields = append(fields, wardnotice.FieldClaimedUntil)
+	}
+	if m.attempts != nil {
+		fields = append(fields, wardnotice.FieldAttempts)
+	}
+	if m.createdAt != nil {
+		fields = append(fields, wardnotice.FieldCreatedAt)
 	}
 	return fields
 }
@@ -2630,18 +14221,30 @@ func (m *RoomMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RoomMutation) Field(name string) (ent.Value, bool) {
+func (m *WardNoticeMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case room.FieldNumber:
-		return m.Number()
-	case room.FieldFloor:
-		return m.Floor()
-	case room.FieldNumberBeds:
-		return m.NumberBeds()
-	case room.FieldNumberPatients:
-		return m.NumberPatients()
-	case room.FieldTypeRoom:
-		return m.TypeRoom()
+	case wardnotice.FieldOrganizationId:
+		return m.OrganizationId()
+	case wardnotice.FieldChatId:
+		return m.ChatId()
+	case wardnotice.FieldKind:
+		return m.Kind()
+	case wardnotice.FieldSeverity:
+		return m.Severity()
+	case wardnotice.FieldPatientId:
+		return m.PatientId()
+	case wardnotice.FieldParams:
+		return m.Params()
+	case wardnotice.FieldStatus:
+		return m.Status()
+	case wardnotice.FieldSentAt:
+		return m.SentAt()
+	case wardnotice.FieldClaimedUntil:
+		return m.ClaimedUntil()
+	case wardnotice.FieldAttempts:
+		return m.Attempts()
+	case wardnotice.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -2649,81 +14252,135 @@ func (m *RoomMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RoomMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WardNoticeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case room.FieldNumber:
-		return m.OldNumber(ctx)
-	case room.FieldFloor:
-		return m.OldFloor(ctx)
-	case room.FieldNumberBeds:
-		return m.OldNumberBeds(ctx)
-	case room.FieldNumberPatients:
-		return m.OldNumberPatients(ctx)
-	case room.FieldTypeRoom:
-		return m.OldTypeRoom(ctx)
-	}
-	return nil, fmt.Errorf("unknown Room field %s", name)
+	case wardnotice.FieldOrganizationId:
+		return m.OldOrganizationId(ctx)
+	case wardnotice.FieldChatId:
+		return m.OldChatId(ctx)
+	case wardnotice.FieldKind:
+		return m.OldKind(ctx)
+	case wardnotice.FieldSeverity:
+		return m.OldSeverity(ctx)
+	case wardnotice.FieldPatientId:
+		return m.OldPatientId(ctx)
+	case wardnotice.FieldParams:
+		return m.OldParams(ctx)
+	case wardnotice.FieldStatus:
+		return m.OldStatus(ctx)
+	case wardnotice.FieldSentAt:
+		return m.OldSentAt(ctx)
+	case wardnotice.FieldClaimedUntil:
+		return m.OldClaimedUntil(ctx)
+	case wardnotice.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case wardnotice.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown WardNotice field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoomMutation) SetField(name string, value ent.Value) error {
+func (m *WardNoticeMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case room.FieldNumber:
+	case wardnotice.FieldOrganizationId:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNumber(v)
+		m.SetOrganizationId(v)
 		return nil
-	case room.FieldFloor:
-		v, ok := value.(int)
+	case wardnotice.FieldChatId:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFloor(v)
+		m.SetChatId(v)
 		return nil
-	case room.FieldNumberBeds:
-		v, ok := value.(int)
+	case wardnotice.FieldKind:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNumberBeds(v)
+		m.SetKind(v)
 		return nil
-	case room.FieldNumberPatients:
+	case wardnotice.FieldSeverity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeverity(v)
+		return nil
+	case wardnotice.FieldPatientId:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNumberPatients(v)
+		m.SetPatientId(v)
 		return nil
-	case room.FieldTypeRoom:
+	case wardnotice.FieldParams:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParams(v)
+		return nil
+	case wardnotice.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTypeRoom(v)
+		m.SetStatus(v)
+		return nil
+	case wardnotice.FieldSentAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSentAt(v)
+		return nil
+	case wardnotice.FieldClaimedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimedUntil(v)
+		return nil
+	case wardnotice.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case wardnotice.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Room field %s", name)
+	return fmt.Errorf("unknown WardNotice field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RoomMutation) AddedFields() []string {
+func (m *WardNoticeMutation) AddedFields() []string {
 	var fields []string
-	if m.addnumber != nil {
-		fields = append(fields, room.FieldNumber)
+	if m.addorganizationId != nil {
+		fields = append(fields, wardnotice.FieldOrganizationId)
 	}
-	if m.addfloor != nil {
-		fields = append(fields, room.FieldFloor)
+	if m.addchatId != nil {
+		fields = append(fields, wardnotice.FieldChatId)
 	}
-	if m.addnumberBeds != nil {
-		fields = append(fields, room.FieldNumberBeds)
+	if m.addpatientId != nil {
+		fields = append(fields, wardnotice.FieldPatientId)
 	}
-	if m.addnumberPatients != nil {
-		fields = append(fields, room.FieldNumberPatients)
+	if m.addattempts != nil {
+		fields = append(fields, wardnotice.FieldAttempts)
 	}
 	return fields
 }
@@ -2731,16 +14388,16 @@ func (m *RoomMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RoomMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WardNoticeMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case room.FieldNumber:
-		return m.AddedNumber()
-	case room.FieldFloor:
-		return m.AddedFloor()
-	case room.FieldNumberBeds:
-		return m.AddedNumberBeds()
-	case room.FieldNumberPatients:
-		return m.AddedNumberPatients()
+	case wardnotice.FieldOrganizationId:
+		return m.AddedOrganizationId()
+	case wardnotice.FieldChatId:
+		return m.AddedChatId()
+	case wardnotice.FieldPatientId:
+		return m.AddedPatientId()
+	case wardnotice.FieldAttempts:
+		return m.AddedAttempts()
 	}
 	return nil, false
 }
@@ -2748,162 +14405,171 @@ func (m *RoomMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoomMutation) AddField(name string, value ent.Value) error {
+func (m *WardNoticeMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case room.FieldNumber:
+	case wardnotice.FieldOrganizationId:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddNumber(v)
+		m.AddOrganizationId(v)
 		return nil
-	case room.FieldFloor:
-		v, ok := value.(int)
+	case wardnotice.FieldChatId:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddFloor(v)
+		m.AddChatId(v)
 		return nil
-	case room.FieldNumberBeds:
+	case wardnotice.FieldPatientId:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddNumberBeds(v)
+		m.AddPatientId(v)
 		return nil
-	case room.FieldNumberPatients:
+	case wardnotice.FieldAttempts:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddNumberPatients(v)
+		m.AddAttempts(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Room numeric field %s", name)
+	return fmt.Errorf("unknown WardNotice numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RoomMutation) ClearedFields() []string {
-	return nil
+func (m *WardNoticeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(wardnotice.FieldOrganizationId) {
+		fields = append(fields, wardnotice.FieldOrganizationId)
+	}
+	if m.FieldCleared(wardnotice.FieldParams) {
+		fields = append(fields, wardnotice.FieldParams)
+	}
+	if m.FieldCleared(wardnotice.FieldSentAt) {
+		fields = append(fields, wardnotice.FieldSentAt)
+	}
+	if m.FieldCleared(wardnotice.FieldClaimedUntil) {
+		fields = append(fields, wardnotice.FieldClaimedUntil)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RoomMutation) FieldCleared(name string) bool {
+func (m *WardNoticeMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RoomMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Room nullable field %s", name)
+func (m *WardNoticeMutation) ClearField(name string) error {
+	switch name {
+	case wardnotice.FieldOrganizationId:
+		m.ClearOrganizationId()
+		return nil
+	case wardnotice.FieldParams:
+		m.ClearParams()
+		return nil
+	case wardnotice.FieldSentAt:
+		m.ClearSentAt()
+		return nil
+	case wardnotice.FieldClaimedUntil:
+		m.ClearClaimedUntil()
+		return nil
+	}
+	return fmt.Errorf("unknown WardNotice nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RoomMutation) ResetField(name string) error {
+func (m *WardNoticeMutation) ResetField(name string) error {
 	switch name {
-	case room.FieldNumber:
-		m.ResetNumber()
+	case wardnotice.FieldOrganizationId:
+		m.ResetOrganizationId()
 		return nil
-	case room.FieldFloor:
-		m.ResetFloor()
+	case wardnotice.FieldChatId:
+		m.ResetChatId()
 		return nil
-	case room.FieldNumberBeds:
-		m.ResetNumberBeds()
+	case wardnotice.FieldKind:
+		m.ResetKind()
 		return nil
-	case room.FieldNumberPatients:
-		m.ResetNumberPatients()
+	case wardnotice.FieldSeverity:
+		m.ResetSeverity()
 		return nil
-	case room.FieldTypeRoom:
-		m.ResetTypeRoom()
+	case wardnotice.FieldPatientId:
+		m.ResetPatientId()
+		return nil
+	case wardnotice.FieldParams:
+		m.ResetParams()
+		return nil
+	case wardnotice.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case wardnotice.FieldSentAt:
+		m.ResetSentAt()
+		return nil
+	case wardnotice.FieldClaimedUntil:
+		m.ResetClaimedUntil()
+		return nil
+	case wardnotice.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case wardnotice.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Room field %s", name)
+	return fmt.Errorf("unknown WardNotice field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RoomMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.contains != nil {
-		edges = append(edges, room.EdgeContains)
-	}
+func (m *WardNoticeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RoomMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case room.EdgeContains:
-		ids := make([]ent.Value, 0, len(m.contains))
-		for id := range m.contains {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *WardNoticeMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RoomMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedcontains != nil {
-		edges = append(edges, room.EdgeContains)
-	}
+func (m *WardNoticeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RoomMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case room.EdgeContains:
-		ids := make([]ent.Value, 0, len(m.removedcontains))
-		for id := range m.removedcontains {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *WardNoticeMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RoomMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedcontains {
-		edges = append(edges, room.EdgeContains)
-	}
+func (m *WardNoticeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RoomMutation) EdgeCleared(name string) bool {
-	switch name {
-	case room.EdgeContains:
-		return m.clearedcontains
-	}
+func (m *WardNoticeMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RoomMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Room unique edge %s", name)
+func (m *WardNoticeMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WardNotice unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RoomMutation) ResetEdge(name string) error {
-	switch name {
-	case room.EdgeContains:
-		m.ResetContains()
-		return nil
-	}
-	return fmt.Errorf("unknown Room edge %s", name)
+func (m *WardNoticeMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WardNotice edge %s", name)
 }
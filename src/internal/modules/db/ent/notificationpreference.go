@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"hospital/internal/modules/db/ent/notificationpreference"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// NotificationPreference is the model entity for the NotificationPreference schema.
+type NotificationPreference struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// DoctorId holds the value of the "doctorId" field.
+	DoctorId int `json:"doctorId,omitempty"`
+	// MinSeverity holds the value of the "minSeverity" field.
+	MinSeverity string `json:"minSeverity,omitempty"`
+	// MutedKinds holds the value of the "mutedKinds" field.
+	MutedKinds []string `json:"mutedKinds,omitempty"`
+	// QuietStart holds the value of the "quietStart" field.
+	QuietStart *int `json:"quietStart,omitempty"`
+	// QuietEnd holds the value of the "quietEnd" field.
+	QuietEnd     *int `json:"quietEnd,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NotificationPreference) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case notificationpreference.FieldMutedKinds:
+			values[i] = new([]byte)
+		case notificationpreference.FieldID, notificationpreference.FieldDoctorId, notificationpreference.FieldQuietStart, notificationpreference.FieldQuietEnd:
+			values[i] = new(sql.NullInt64)
+		case notificationpreference.FieldMinSeverity:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NotificationPreference fields.
+func (np *NotificationPreference) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case notificationpreference.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			np.ID = int(value.Int64)
+		case notificationpreference.FieldDoctorId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field doctorId", values[i])
+			} else if value.Valid {
+				np.DoctorId = int(value.Int64)
+			}
+		case notificationpreference.FieldMinSeverity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field minSeverity", values[i])
+			} else if value.Valid {
+				np.MinSeverity = value.String
+			}
+		case notificationpreference.FieldMutedKinds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field mutedKinds", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &np.MutedKinds); err != nil {
+					return fmt.Errorf("unmarshal field mutedKinds: %w", err)
+				}
+			}
+		case notificationpreference.FieldQuietStart:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quietStart", values[i])
+			} else if value.Valid {
+				np.QuietStart = new(int)
+				*np.QuietStart = int(value.Int64)
+			}
+		case notificationpreference.FieldQuietEnd:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quietEnd", values[i])
+			} else if value.Valid {
+				np.QuietEnd = new(int)
+				*np.QuietEnd = int(value.Int64)
+			}
+		default:
+			np.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NotificationPreference.
+// This includes values selected through modifiers, order, etc.
+func (np *NotificationPreference) Value(name string) (ent.Value, error) {
+	return np.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this NotificationPreference.
+// Note that you need to call NotificationPreference.Unwrap() before calling this method if this NotificationPreference
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (np *NotificationPreference) Update() *NotificationPreferenceUpdateOne {
+	return NewNotificationPreferenceClient(np.config).UpdateOne(np)
+}
+
+// Unwrap unwraps the NotificationPreference entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (np *NotificationPreference) Unwrap() *NotificationPreference {
+	_tx, ok := np.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NotificationPreference is not a transactional entity")
+	}
+	np.config.driver = _tx.drv
+	return np
+}
+
+// String implements the fmt.Stringer.
+func (np *NotificationPreference) String() string {
+	var builder strings.Builder
+	builder.WriteString("NotificationPreference(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", np.ID))
+	builder.WriteString("doctorId=")
+	builder.WriteString(fmt.Sprintf("%v", np.DoctorId))
+	builder.WriteString(", ")
+	builder.WriteString("minSeverity=")
+	builder.WriteString(np.MinSeverity)
+	builder.WriteString(", ")
+	builder.WriteString("mutedKinds=")
+	builder.WriteString(fmt.Sprintf("%v", np.MutedKinds))
+	builder.WriteString(", ")
+	if v := np.QuietStart; v != nil {
+		builder.WriteString("quietStart=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := np.QuietEnd; v != nil {
+		builder.WriteString("quietEnd=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NotificationPreferences is a parsable slice of NotificationPreference.
+type NotificationPreferences []*NotificationPreference
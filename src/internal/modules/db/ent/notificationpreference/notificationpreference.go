@@ -0,0 +1,74 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notificationpreference
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the notificationpreference type in the database.
+	Label = "notification_preference"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldDoctorId holds the string denoting the doctorid field in the database.
+	FieldDoctorId = "doctor_id"
+	// FieldMinSeverity holds the string denoting the minseverity field in the database.
+	FieldMinSeverity = "min_severity"
+	// FieldMutedKinds holds the string denoting the mutedkinds field in the database.
+	FieldMutedKinds = "muted_kinds"
+	// FieldQuietStart holds the string denoting the quietstart field in the database.
+	FieldQuietStart = "quiet_start"
+	// FieldQuietEnd holds the string denoting the quietend field in the database.
+	FieldQuietEnd = "quiet_end"
+	// Table holds the table name of the notificationpreference in the database.
+	Table = "notification_preferences"
+)
+
+// Columns holds all SQL columns for notificationpreference fields.
+var Columns = []string{
+	FieldID,
+	FieldDoctorId,
+	FieldMinSeverity,
+	FieldMutedKinds,
+	FieldQuietStart,
+	FieldQuietEnd,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Order defines the ordering method for the NotificationPreference queries.
+type Order func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByDoctorId orders the results by the doctorId field.
+func ByDoctorId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldDoctorId, opts...).ToFunc()
+}
+
+// ByMinSeverity orders the results by the minSeverity field.
+func ByMinSeverity(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldMinSeverity, opts...).ToFunc()
+}
+
+// ByQuietStart orders the results by the quietStart field.
+func ByQuietStart(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldQuietStart, opts...).ToFunc()
+}
+
+// ByQuietEnd orders the results by the quietEnd field.
+func ByQuietEnd(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldQuietEnd, opts...).ToFunc()
+}
@@ -0,0 +1,321 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notificationpreference
+
+import (
+	"hospital/internal/modules/db/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLTE(FieldID, id))
+}
+
+// DoctorId applies equality check predicate on the "doctorId" field. It's identical to DoctorIdEQ.
+func DoctorId(v int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldDoctorId, v))
+}
+
+// MinSeverity applies equality check predicate on the "minSeverity" field. It's identical to MinSeverityEQ.
+func MinSeverity(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldMinSeverity, v))
+}
+
+// QuietStart applies equality check predicate on the "quietStart" field. It's identical to QuietStartEQ.
+func QuietStart(v int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldQuietStart, v))
+}
+
+// QuietEnd applies equality check predicate on the "quietEnd" field. It's identical to QuietEndEQ.
+func QuietEnd(v int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldQuietEnd, v))
+}
+
+// DoctorIdEQ applies the EQ predicate on the "doctorId" field.
+func DoctorIdEQ(v int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldDoctorId, v))
+}
+
+// DoctorIdNEQ applies the NEQ predicate on the "doctorId" field.
+func DoctorIdNEQ(v int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNEQ(FieldDoctorId, v))
+}
+
+// DoctorIdIn applies the In predicate on the "doctorId" field.
+func DoctorIdIn(vs ...int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldIn(FieldDoctorId, vs...))
+}
+
+// DoctorIdNotIn applies the NotIn predicate on the "doctorId" field.
+func DoctorIdNotIn(vs ...int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNotIn(FieldDoctorId, vs...))
+}
+
+// DoctorIdGT applies the GT predicate on the "doctorId" field.
+func DoctorIdGT(v int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGT(FieldDoctorId, v))
+}
+
+// DoctorIdGTE applies the GTE predicate on the "doctorId" field.
+func DoctorIdGTE(v int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGTE(FieldDoctorId, v))
+}
+
+// DoctorIdLT applies the LT predicate on the "doctorId" field.
+func DoctorIdLT(v int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLT(FieldDoctorId, v))
+}
+
+// DoctorIdLTE applies the LTE predicate on the "doctorId" field.
+func DoctorIdLTE(v int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLTE(FieldDoctorId, v))
+}
+
+// MinSeverityEQ applies the EQ predicate on the "minSeverity" field.
+func MinSeverityEQ(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldMinSeverity, v))
+}
+
+// MinSeverityNEQ applies the NEQ predicate on the "minSeverity" field.
+func MinSeverityNEQ(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNEQ(FieldMinSeverity, v))
+}
+
+// MinSeverityIn applies the In predicate on the "minSeverity" field.
+func MinSeverityIn(vs ...string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldIn(FieldMinSeverity, vs...))
+}
+
+// MinSeverityNotIn applies the NotIn predicate on the "minSeverity" field.
+func MinSeverityNotIn(vs ...string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNotIn(FieldMinSeverity, vs...))
+}
+
+// MinSeverityGT applies the GT predicate on the "minSeverity" field.
+func MinSeverityGT(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGT(FieldMinSeverity, v))
+}
+
+// MinSeverityGTE applies the GTE predicate on the "minSeverity" field.
+func MinSeverityGTE(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGTE(FieldMinSeverity, v))
+}
+
+// MinSeverityLT applies the LT predicate on the "minSeverity" field.
+func MinSeverityLT(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLT(FieldMinSeverity, v))
+}
+
+// MinSeverityLTE applies the LTE predicate on the "minSeverity" field.
+func MinSeverityLTE(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLTE(FieldMinSeverity, v))
+}
+
+// MinSeverityContains applies the Contains predicate on the "minSeverity" field.
+func MinSeverityContains(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldContains(FieldMinSeverity, v))
+}
+
+// MinSeverityHasPrefix applies the HasPrefix predicate on the "minSeverity" field.
+func MinSeverityHasPrefix(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldHasPrefix(FieldMinSeverity, v))
+}
+
+// MinSeverityHasSuffix applies the HasSuffix predicate on the "minSeverity" field.
+func MinSeverityHasSuffix(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldHasSuffix(FieldMinSeverity, v))
+}
+
+// MinSeverityEqualFold applies the EqualFold predicate on the "minSeverity" field.
+func MinSeverityEqualFold(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEqualFold(FieldMinSeverity, v))
+}
+
+// MinSeverityContainsFold applies the ContainsFold predicate on the "minSeverity" field.
+func MinSeverityContainsFold(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldContainsFold(FieldMinSeverity, v))
+}
+
+// MutedKindsIsNil applies the IsNil predicate on the "mutedKinds" field.
+func MutedKindsIsNil() predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldIsNull(FieldMutedKinds))
+}
+
+// MutedKindsNotNil applies the NotNil predicate on the "mutedKinds" field.
+func MutedKindsNotNil() predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNotNull(FieldMutedKinds))
+}
+
+// QuietStartEQ applies the EQ predicate on the "quietStart" field.
+func QuietStartEQ(v int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldQuietStart, v))
+}
+
+// QuietStartNEQ applies the NEQ predicate on the "quietStart" field.
+func QuietStartNEQ(v int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNEQ(FieldQuietStart, v))
+}
+
+// QuietStartIn applies the In predicate on the "quietStart" field.
+func QuietStartIn(vs ...int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldIn(FieldQuietStart, vs...))
+}
+
+// QuietStartNotIn applies the NotIn predicate on the "quietStart" field.
+func QuietStartNotIn(vs ...int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNotIn(FieldQuietStart, vs...))
+}
+
+// QuietStartGT applies the GT predicate on the "quietStart" field.
+func QuietStartGT(v int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGT(FieldQuietStart, v))
+}
+
+// QuietStartGTE applies the GTE predicate on the "quietStart" field.
+func QuietStartGTE(v int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGTE(FieldQuietStart, v))
+}
+
+// QuietStartLT applies the LT predicate on the "quietStart" field.
+func QuietStartLT(v int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLT(FieldQuietStart, v))
+}
+
+// QuietStartLTE applies the LTE predicate on the "quietStart" field.
+func QuietStartLTE(v int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLTE(FieldQuietStart, v))
+}
+
+// QuietStartIsNil applies the IsNil predicate on the "quietStart" field.
+func QuietStartIsNil() predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldIsNull(FieldQuietStart))
+}
+
+// QuietStartNotNil applies the NotNil predicate on the "quietStart" field.
+func QuietStartNotNil() predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNotNull(FieldQuietStart))
+}
+
+// QuietEndEQ applies the EQ predicate on the "quietEnd" field.
+func QuietEndEQ(v int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldQuietEnd, v))
+}
+
+// QuietEndNEQ applies the NEQ predicate on the "quietEnd" field.
+func QuietEndNEQ(v int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNEQ(FieldQuietEnd, v))
+}
+
+// QuietEndIn applies the In predicate on the "quietEnd" field.
+func QuietEndIn(vs ...int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldIn(FieldQuietEnd, vs...))
+}
+
+// QuietEndNotIn applies the NotIn predicate on the "quietEnd" field.
+func QuietEndNotIn(vs ...int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNotIn(FieldQuietEnd, vs...))
+}
+
+// QuietEndGT applies the GT predicate on the "quietEnd" field.
+func QuietEndGT(v int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGT(FieldQuietEnd, v))
+}
+
+// QuietEndGTE applies the GTE predicate on the "quietEnd" field.
+func QuietEndGTE(v int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGTE(FieldQuietEnd, v))
+}
+
+// QuietEndLT applies the LT predicate on the "quietEnd" field.
+func QuietEndLT(v int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLT(FieldQuietEnd, v))
+}
+
+// QuietEndLTE applies the LTE predicate on the "quietEnd" field.
+func QuietEndLTE(v int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLTE(FieldQuietEnd, v))
+}
+
+// QuietEndIsNil applies the IsNil predicate on the "quietEnd" field.
+func QuietEndIsNil() predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldIsNull(FieldQuietEnd))
+}
+
+// QuietEndNotNil applies the NotNil predicate on the "quietEnd" field.
+func QuietEndNotNil() predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNotNull(FieldQuietEnd))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NotificationPreference) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NotificationPreference) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NotificationPreference) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,238 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hospital/internal/modules/db/ent/notificationpreference"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// NotificationPreferenceCreate is the builder for creating a NotificationPreference entity.
+type NotificationPreferenceCreate struct {
+	config
+	mutation *NotificationPreferenceMutation
+	hooks    []Hook
+}
+
+// SetDoctorId sets the "doctorId" field.
+func (npc *NotificationPreferenceCreate) SetDoctorId(i int) *NotificationPreferenceCreate {
+	npc.mutation.SetDoctorId(i)
+	return npc
+}
+
+// SetMinSeverity sets the "minSeverity" field.
+func (npc *NotificationPreferenceCreate) SetMinSeverity(s string) *NotificationPreferenceCreate {
+	npc.mutation.SetMinSeverity(s)
+	return npc
+}
+
+// SetMutedKinds sets the "mutedKinds" field.
+func (npc *NotificationPreferenceCreate) SetMutedKinds(s []string) *NotificationPreferenceCreate {
+	npc.mutation.SetMutedKinds(s)
+	return npc
+}
+
+// SetQuietStart sets the "quietStart" field.
+func (npc *NotificationPreferenceCreate) SetQuietStart(i int) *NotificationPreferenceCreate {
+	npc.mutation.SetQuietStart(i)
+	return npc
+}
+
+// SetNillableQuietStart sets the "quietStart" field if the given value is not nil.
+func (npc *NotificationPreferenceCreate) SetNillableQuietStart(i *int) *NotificationPreferenceCreate {
+	if i != nil {
+		npc.SetQuietStart(*i)
+	}
+	return npc
+}
+
+// SetQuietEnd sets the "quietEnd" field.
+func (npc *NotificationPreferenceCreate) SetQuietEnd(i int) *NotificationPreferenceCreate {
+	npc.mutation.SetQuietEnd(i)
+	return npc
+}
+
+// SetNillableQuietEnd sets the "quietEnd" field if the given value is not nil.
+func (npc *NotificationPreferenceCreate) SetNillableQuietEnd(i *int) *NotificationPreferenceCreate {
+	if i != nil {
+		npc.SetQuietEnd(*i)
+	}
+	return npc
+}
+
+// Mutation returns the NotificationPreferenceMutation object of the builder.
+func (npc *NotificationPreferenceCreate) Mutation() *NotificationPreferenceMutation {
+	return npc.mutation
+}
+
+// Save creates the NotificationPreference in the database.
+func (npc *NotificationPreferenceCreate) Save(ctx context.Context) (*NotificationPreference, error) {
+	return withHooks[*NotificationPreference, NotificationPreferenceMutation](ctx, npc.sqlSave, npc.mutation, npc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (npc *NotificationPreferenceCreate) SaveX(ctx context.Context) *NotificationPreference {
+	v, err := npc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (npc *NotificationPreferenceCreate) Exec(ctx context.Context) error {
+	_, err := npc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npc *NotificationPreferenceCreate) ExecX(ctx context.Context) {
+	if err := npc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (npc *NotificationPreferenceCreate) check() error {
+	if _, ok := npc.mutation.DoctorId(); !ok {
+		return &ValidationError{Name: "doctorId", err: errors.New(`ent: missing required field "NotificationPreference.doctorId"`)}
+	}
+	if _, ok := npc.mutation.MinSeverity(); !ok {
+		return &ValidationError{Name: "minSeverity", err: errors.New(`ent: missing required field "NotificationPreference.minSeverity"`)}
+	}
+	return nil
+}
+
+func (npc *NotificationPreferenceCreate) sqlSave(ctx context.Context) (*NotificationPreference, error) {
+	if err := npc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := npc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, npc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	npc.mutation.id = &_node.ID
+	npc.mutation.done = true
+	return _node, nil
+}
+
+func (npc *NotificationPreferenceCreate) createSpec() (*NotificationPreference, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NotificationPreference{config: npc.config}
+		_spec = sqlgraph.NewCreateSpec(notificationpreference.Table, sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeInt))
+	)
+	if value, ok := npc.mutation.DoctorId(); ok {
+		_spec.SetField(notificationpreference.FieldDoctorId, field.TypeInt, value)
+		_node.DoctorId = value
+	}
+	if value, ok := npc.mutation.MinSeverity(); ok {
+		_spec.SetField(notificationpreference.FieldMinSeverity, field.TypeString, value)
+		_node.MinSeverity = value
+	}
+	if value, ok := npc.mutation.MutedKinds(); ok {
+		_spec.SetField(notificationpreference.FieldMutedKinds, field.TypeJSON, value)
+		_node.MutedKinds = value
+	}
+	if value, ok := npc.mutation.QuietStart(); ok {
+		_spec.SetField(notificationpreference.FieldQuietStart, field.TypeInt, value)
+		_node.QuietStart = &value
+	}
+	if value, ok := npc.mutation.QuietEnd(); ok {
+		_spec.SetField(notificationpreference.FieldQuietEnd, field.TypeInt, value)
+		_node.QuietEnd = &value
+	}
+	return _node, _spec
+}
+
+// NotificationPreferenceCreateBulk is the builder for creating many NotificationPreference entities in bulk.
+type NotificationPreferenceCreateBulk struct {
+	config
+	builders []*NotificationPreferenceCreate
+}
+
+// Save creates the NotificationPreference entities in the database.
+func (npcb *NotificationPreferenceCreateBulk) Save(ctx context.Context) ([]*NotificationPreference, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(npcb.builders))
+	nodes := make([]*NotificationPreference, len(npcb.builders))
+	mutators := make([]Mutator, len(npcb.builders))
+	for i := range npcb.builders {
+		func(i int, root context.Context) {
+			builder := npcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationPreferenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, npcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, npcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, npcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (npcb *NotificationPreferenceCreateBulk) SaveX(ctx context.Context) []*NotificationPreference {
+	v, err := npcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (npcb *NotificationPreferenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := npcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npcb *NotificationPreferenceCreateBulk) ExecX(ctx context.Context) {
+	if err := npcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
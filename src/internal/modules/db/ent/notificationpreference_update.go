@@ -0,0 +1,434 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hospital/internal/modules/db/ent/notificationpreference"
+	"hospital/internal/modules/db/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// NotificationPreferenceUpdate is the builder for updating NotificationPreference entities.
+type NotificationPreferenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationPreferenceMutation
+}
+
+// Where appends a list predicates to the NotificationPreferenceUpdate builder.
+func (npu *NotificationPreferenceUpdate) Where(ps ...predicate.NotificationPreference) *NotificationPreferenceUpdate {
+	npu.mutation.Where(ps...)
+	return npu
+}
+
+// SetDoctorId sets the "doctorId" field.
+func (npu *NotificationPreferenceUpdate) SetDoctorId(i int) *NotificationPreferenceUpdate {
+	npu.mutation.ResetDoctorId()
+	npu.mutation.SetDoctorId(i)
+	return npu
+}
+
+// AddDoctorId adds i to the "doctorId" field.
+func (npu *NotificationPreferenceUpdate) AddDoctorId(i int) *NotificationPreferenceUpdate {
+	npu.mutation.AddDoctorId(i)
+	return npu
+}
+
+// SetMinSeverity sets the "minSeverity" field.
+func (npu *NotificationPreferenceUpdate) SetMinSeverity(s string) *NotificationPreferenceUpdate {
+	npu.mutation.SetMinSeverity(s)
+	return npu
+}
+
+// SetMutedKinds sets the "mutedKinds" field.
+func (npu *NotificationPreferenceUpdate) SetMutedKinds(s []string) *NotificationPreferenceUpdate {
+	npu.mutation.SetMutedKinds(s)
+	return npu
+}
+
+// AppendMutedKinds appends s to the "mutedKinds" field.
+func (npu *NotificationPreferenceUpdate) AppendMutedKinds(s []string) *NotificationPreferenceUpdate {
+	npu.mutation.AppendMutedKinds(s)
+	return npu
+}
+
+// ClearMutedKinds clears the value of the "mutedKinds" field.
+func (npu *NotificationPreferenceUpdate) ClearMutedKinds() *NotificationPreferenceUpdate {
+	npu.mutation.ClearMutedKinds()
+	return npu
+}
+
+// SetQuietStart sets the "quietStart" field.
+func (npu *NotificationPreferenceUpdate) SetQuietStart(i int) *NotificationPreferenceUpdate {
+	npu.mutation.ResetQuietStart()
+	npu.mutation.SetQuietStart(i)
+	return npu
+}
+
+// SetNillableQuietStart sets the "quietStart" field if the given value is not nil.
+func (npu *NotificationPreferenceUpdate) SetNillableQuietStart(i *int) *NotificationPreferenceUpdate {
+	if i != nil {
+		npu.SetQuietStart(*i)
+	}
+	return npu
+}
+
+// AddQuietStart adds i to the "quietStart" field.
+func (npu *NotificationPreferenceUpdate) AddQuietStart(i int) *NotificationPreferenceUpdate {
+	npu.mutation.AddQuietStart(i)
+	return npu
+}
+
+// ClearQuietStart clears the value of the "quietStart" field.
+func (npu *NotificationPreferenceUpdate) ClearQuietStart() *NotificationPreferenceUpdate {
+	npu.mutation.ClearQuietStart()
+	return npu
+}
+
+// SetQuietEnd sets the "quietEnd" field.
+func (npu *NotificationPreferenceUpdate) SetQuietEnd(i int) *NotificationPreferenceUpdate {
+	npu.mutation.ResetQuietEnd()
+	npu.mutation.SetQuietEnd(i)
+	return npu
+}
+
+// SetNillableQuietEnd sets the "quietEnd" field if the given value is not nil.
+func (npu *NotificationPreferenceUpdate) SetNillableQuietEnd(i *int) *NotificationPreferenceUpdate {
+	if i != nil {
+		npu.SetQuietEnd(*i)
+	}
+	return npu
+}
+
+// AddQuietEnd adds i to the "quietEnd" field.
+func (npu *NotificationPreferenceUpdate) AddQuietEnd(i int) *NotificationPreferenceUpdate {
+	npu.mutation.AddQuietEnd(i)
+	return npu
+}
+
+// ClearQuietEnd clears the value of the "quietEnd" field.
+func (npu *NotificationPreferenceUpdate) ClearQuietEnd() *NotificationPreferenceUpdate {
+	npu.mutation.ClearQuietEnd()
+	return npu
+}
+
+// Mutation returns the NotificationPreferenceMutation object of the builder.
+func (npu *NotificationPreferenceUpdate) Mutation() *NotificationPreferenceMutation {
+	return npu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (npu *NotificationPreferenceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks[int, NotificationPreferenceMutation](ctx, npu.sqlSave, npu.mutation, npu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (npu *NotificationPreferenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := npu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (npu *NotificationPreferenceUpdate) Exec(ctx context.Context) error {
+	_, err := npu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npu *NotificationPreferenceUpdate) ExecX(ctx context.Context) {
+	if err := npu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (npu *NotificationPreferenceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(notificationpreference.Table, notificationpreference.Columns, sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeInt))
+	if ps := npu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := npu.mutation.DoctorId(); ok {
+		_spec.SetField(notificationpreference.FieldDoctorId, field.TypeInt, value)
+	}
+	if value, ok := npu.mutation.AddedDoctorId(); ok {
+		_spec.AddField(notificationpreference.FieldDoctorId, field.TypeInt, value)
+	}
+	if value, ok := npu.mutation.MinSeverity(); ok {
+		_spec.SetField(notificationpreference.FieldMinSeverity, field.TypeString, value)
+	}
+	if value, ok := npu.mutation.MutedKinds(); ok {
+		_spec.SetField(notificationpreference.FieldMutedKinds, field.TypeJSON, value)
+	}
+	if value, ok := npu.mutation.AppendedMutedKinds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, notificationpreference.FieldMutedKinds, value)
+		})
+	}
+	if npu.mutation.MutedKindsCleared() {
+		_spec.ClearField(notificationpreference.FieldMutedKinds, field.TypeJSON)
+	}
+	if value, ok := npu.mutation.QuietStart(); ok {
+		_spec.SetField(notificationpreference.FieldQuietStart, field.TypeInt, value)
+	}
+	if value, ok := npu.mutation.AddedQuietStart(); ok {
+		_spec.AddField(notificationpreference.FieldQuietStart, field.TypeInt, value)
+	}
+	if npu.mutation.QuietStartCleared() {
+		_spec.ClearField(notificationpreference.FieldQuietStart, field.TypeInt)
+	}
+	if value, ok := npu.mutation.QuietEnd(); ok {
+		_spec.SetField(notificationpreference.FieldQuietEnd, field.TypeInt, value)
+	}
+	if value, ok := npu.mutation.AddedQuietEnd(); ok {
+		_spec.AddField(notificationpreference.FieldQuietEnd, field.TypeInt, value)
+	}
+	if npu.mutation.QuietEndCleared() {
+		_spec.ClearField(notificationpreference.FieldQuietEnd, field.TypeInt)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, npu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	npu.mutation.done = true
+	return n, nil
+}
+
+// NotificationPreferenceUpdateOne is the builder for updating a single NotificationPreference entity.
+type NotificationPreferenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationPreferenceMutation
+}
+
+// SetDoctorId sets the "doctorId" field.
+func (npuo *NotificationPreferenceUpdateOne) SetDoctorId(i int) *NotificationPreferenceUpdateOne {
+	npuo.mutation.ResetDoctorId()
+	npuo.mutation.SetDoctorId(i)
+	return npuo
+}
+
+// AddDoctorId adds i to the "doctorId" field.
+func (npuo *NotificationPreferenceUpdateOne) AddDoctorId(i int) *NotificationPreferenceUpdateOne {
+	npuo.mutation.AddDoctorId(i)
+	return npuo
+}
+
+// SetMinSeverity sets the "minSeverity" field.
+func (npuo *NotificationPreferenceUpdateOne) SetMinSeverity(s string) *NotificationPreferenceUpdateOne {
+	npuo.mutation.SetMinSeverity(s)
+	return npuo
+}
+
+// SetMutedKinds sets the "mutedKinds" field.
+func (npuo *NotificationPreferenceUpdateOne) SetMutedKinds(s []string) *NotificationPreferenceUpdateOne {
+	npuo.mutation.SetMutedKinds(s)
+	return npuo
+}
+
+// AppendMutedKinds appends s to the "mutedKinds" field.
+func (npuo *NotificationPreferenceUpdateOne) AppendMutedKinds(s []string) *NotificationPreferenceUpdateOne {
+	npuo.mutation.AppendMutedKinds(s)
+	return npuo
+}
+
+// ClearMutedKinds clears the value of the "mutedKinds" field.
+func (npuo *NotificationPreferenceUpdateOne) ClearMutedKinds() *NotificationPreferenceUpdateOne {
+	npuo.mutation.ClearMutedKinds()
+	return npuo
+}
+
+// SetQuietStart sets the "quietStart" field.
+func (npuo *NotificationPreferenceUpdateOne) SetQuietStart(i int) *NotificationPreferenceUpdateOne {
+	npuo.mutation.ResetQuietStart()
+	npuo.mutation.SetQuietStart(i)
+	return npuo
+}
+
+// SetNillableQuietStart sets the "quietStart" field if the given value is not nil.
+func (npuo *NotificationPreferenceUpdateOne) SetNillableQuietStart(i *int) *NotificationPreferenceUpdateOne {
+	if i != nil {
+		npuo.SetQuietStart(*i)
+	}
+	return npuo
+}
+
+// AddQuietStart adds i to the "quietStart" field.
+func (npuo *NotificationPreferenceUpdateOne) AddQuietStart(i int) *NotificationPreferenceUpdateOne {
+	npuo.mutation.AddQuietStart(i)
+	return npuo
+}
+
+// ClearQuietStart clears the value of the "quietStart" field.
+func (npuo *NotificationPreferenceUpdateOne) ClearQuietStart() *NotificationPreferenceUpdateOne {
+	npuo.mutation.ClearQuietStart()
+	return npuo
+}
+
+// SetQuietEnd sets the "quietEnd" field.
+func (npuo *NotificationPreferenceUpdateOne) SetQuietEnd(i int) *NotificationPreferenceUpdateOne {
+	npuo.mutation.ResetQuietEnd()
+	npuo.mutation.SetQuietEnd(i)
+	return npuo
+}
+
+// SetNillableQuietEnd sets the "quietEnd" field if the given value is not nil.
+func (npuo *NotificationPreferenceUpdateOne) SetNillableQuietEnd(i *int) *NotificationPreferenceUpdateOne {
+	if i != nil {
+		npuo.SetQuietEnd(*i)
+	}
+	return npuo
+}
+
+// AddQuietEnd adds i to the "quietEnd" field.
+func (npuo *NotificationPreferenceUpdateOne) AddQuietEnd(i int) *NotificationPreferenceUpdateOne {
+	npuo.mutation.AddQuietEnd(i)
+	return npuo
+}
+
+// ClearQuietEnd clears the value of the "quietEnd" field.
+func (npuo *NotificationPreferenceUpdateOne) ClearQuietEnd() *NotificationPreferenceUpdateOne {
+	npuo.mutation.ClearQuietEnd()
+	return npuo
+}
+
+// Mutation returns the NotificationPreferenceMutation object of the builder.
+func (npuo *NotificationPreferenceUpdateOne) Mutation() *NotificationPreferenceMutation {
+	return npuo.mutation
+}
+
+// Where appends a list predicates to the NotificationPreferenceUpdate builder.
+func (npuo *NotificationPreferenceUpdateOne) Where(ps ...predicate.NotificationPreference) *NotificationPreferenceUpdateOne {
+	npuo.mutation.Where(ps...)
+	return npuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (npuo *NotificationPreferenceUpdateOne) Select(field string, fields ...string) *NotificationPreferenceUpdateOne {
+	npuo.fields = append([]string{field}, fields...)
+	return npuo
+}
+
+// Save executes the query and returns the updated NotificationPreference entity.
+func (npuo *NotificationPreferenceUpdateOne) Save(ctx context.Context) (*NotificationPreference, error) {
+	return withHooks[*NotificationPreference, NotificationPreferenceMutation](ctx, npuo.sqlSave, npuo.mutation, npuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (npuo *NotificationPreferenceUpdateOne) SaveX(ctx context.Context) *NotificationPreference {
+	node, err := npuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (npuo *NotificationPreferenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := npuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npuo *NotificationPreferenceUpdateOne) ExecX(ctx context.Context) {
+	if err := npuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (npuo *NotificationPreferenceUpdateOne) sqlSave(ctx context.Context) (_node *NotificationPreference, err error) {
+	_spec := sqlgraph.NewUpdateSpec(notificationpreference.Table, notificationpreference.Columns, sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeInt))
+	id, ok := npuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NotificationPreference.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := npuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationpreference.FieldID)
+		for _, f := range fields {
+			if !notificationpreference.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notificationpreference.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := npuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := npuo.mutation.DoctorId(); ok {
+		_spec.SetField(notificationpreference.FieldDoctorId, field.TypeInt, value)
+	}
+	if value, ok := npuo.mutation.AddedDoctorId(); ok {
+		_spec.AddField(notificationpreference.FieldDoctorId, field.TypeInt, value)
+	}
+	if value, ok := npuo.mutation.MinSeverity(); ok {
+		_spec.SetField(notificationpreference.FieldMinSeverity, field.TypeString, value)
+	}
+	if value, ok := npuo.mutation.MutedKinds(); ok {
+		_spec.SetField(notificationpreference.FieldMutedKinds, field.TypeJSON, value)
+	}
+	if value, ok := npuo.mutation.AppendedMutedKinds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, notificationpreference.FieldMutedKinds, value)
+		})
+	}
+	if npuo.mutation.MutedKindsCleared() {
+		_spec.ClearField(notificationpreference.FieldMutedKinds, field.TypeJSON)
+	}
+	if value, ok := npuo.mutation.QuietStart(); ok {
+		_spec.SetField(notificationpreference.FieldQuietStart, field.TypeInt, value)
+	}
+	if value, ok := npuo.mutation.AddedQuietStart(); ok {
+		_spec.AddField(notificationpreference.FieldQuietStart, field.TypeInt, value)
+	}
+	if npuo.mutation.QuietStartCleared() {
+		_spec.ClearField(notificationpreference.FieldQuietStart, field.TypeInt)
+	}
+	if value, ok := npuo.mutation.QuietEnd(); ok {
+		_spec.SetField(notificationpreference.FieldQuietEnd, field.TypeInt, value)
+	}
+	if value, ok := npuo.mutation.AddedQuietEnd(); ok {
+		_spec.AddField(notificationpreference.FieldQuietEnd, field.TypeInt, value)
+	}
+	if npuo.mutation.QuietEndCleared() {
+		_spec.ClearField(notificationpreference.FieldQuietEnd, field.TypeInt)
+	}
+	_node = &NotificationPreference{config: npuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, npuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	npuo.mutation.done = true
+	return _node, nil
+}
@@ -18,6 +18,8 @@ type Patient struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// OrganizationId holds the value of the "organizationId" field.
+	OrganizationId int `json:"organizationId,omitempty"`
 	// Surname holds the value of the "surname" field.
 	Surname string `json:"surname,omitempty"`
 	// Name holds the value of the "name" field.
@@ -94,7 +96,7 @@ func (*Patient) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case patient.FieldWeight:
 			values[i] = new(sql.NullFloat64)
-		case patient.FieldID, patient.FieldHeight, patient.FieldRoomNumber, patient.FieldDegreeOfDanger:
+		case patient.FieldID, patient.FieldOrganizationId, patient.FieldHeight, patient.FieldRoomNumber, patient.FieldDegreeOfDanger:
 			values[i] = new(sql.NullInt64)
 		case patient.FieldSurname, patient.FieldName, patient.FieldPatronymic:
 			values[i] = new(sql.NullString)
@@ -121,6 +123,12 @@ func (pa *Patient) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			pa.ID = int(value.Int64)
+		case patient.FieldOrganizationId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field organizationId", values[i])
+			} else if value.Valid {
+				pa.OrganizationId = int(value.Int64)
+			}
 		case patient.FieldSurname:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field surname", values[i])
@@ -221,6 +229,9 @@ func (pa *Patient) String() string {
 	var builder strings.Builder
 	builder.WriteString("Patient(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", pa.ID))
+	builder.WriteString("organizationId=")
+	builder.WriteString(fmt.Sprintf("%v", pa.OrganizationId))
+	builder.WriteString(", ")
 	builder.WriteString("surname=")
 	builder.WriteString(pa.Surname)
 	builder.WriteString(", ")
@@ -3,6 +3,7 @@
 package patient
 
 import (
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -12,6 +13,8 @@ const (
 	Label = "patient"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldOrganizationId holds the string denoting the organizationid field in the database.
+	FieldOrganizationId = "organization_id"
 	// FieldSurname holds the string denoting the surname field in the database.
 	FieldSurname = "surname"
 	// FieldName holds the string denoting the name field in the database.
@@ -58,6 +61,7 @@ const (
 // Columns holds all SQL columns for patient fields.
 var Columns = []string{
 	FieldID,
+	FieldOrganizationId,
 	FieldSurname,
 	FieldName,
 	FieldPatronymic,
@@ -94,6 +98,16 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "hospital/internal/modules/db/ent/runtime"
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+)
+
 // Order defines the ordering method for the Patient queries.
 type Order func(*sql.Selector)
 
@@ -102,6 +116,11 @@ func ByID(opts ...sql.OrderTermOption) Order {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByOrganizationId orders the results by the organizationId field.
+func ByOrganizationId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldOrganizationId, opts...).ToFunc()
+}
+
 // BySurname orders the results by the surname field.
 func BySurname(opts ...sql.OrderTermOption) Order {
 	return sql.OrderByField(FieldSurname, opts...).ToFunc()
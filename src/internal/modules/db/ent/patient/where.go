@@ -54,6 +54,11 @@ func IDLTE(id int) predicate.Patient {
 	return predicate.Patient(sql.FieldLTE(FieldID, id))
 }
 
+// OrganizationId applies equality check predicate on the "organizationId" field. It's identical to OrganizationIdEQ.
+func OrganizationId(v int) predicate.Patient {
+	return predicate.Patient(sql.FieldEQ(FieldOrganizationId, v))
+}
+
 // Surname applies equality check predicate on the "surname" field. It's identical to SurnameEQ.
 func Surname(v string) predicate.Patient {
 	return predicate.Patient(sql.FieldEQ(FieldSurname, v))
@@ -89,6 +94,56 @@ func DegreeOfDanger(v int) predicate.Patient {
 	return predicate.Patient(sql.FieldEQ(FieldDegreeOfDanger, v))
 }
 
+// OrganizationIdEQ applies the EQ predicate on the "organizationId" field.
+func OrganizationIdEQ(v int) predicate.Patient {
+	return predicate.Patient(sql.FieldEQ(FieldOrganizationId, v))
+}
+
+// OrganizationIdNEQ applies the NEQ predicate on the "organizationId" field.
+func OrganizationIdNEQ(v int) predicate.Patient {
+	return predicate.Patient(sql.FieldNEQ(FieldOrganizationId, v))
+}
+
+// OrganizationIdIn applies the In predicate on the "organizationId" field.
+func OrganizationIdIn(vs ...int) predicate.Patient {
+	return predicate.Patient(sql.FieldIn(FieldOrganizationId, vs...))
+}
+
+// OrganizationIdNotIn applies the NotIn predicate on the "organizationId" field.
+func OrganizationIdNotIn(vs ...int) predicate.Patient {
+	return predicate.Patient(sql.FieldNotIn(FieldOrganizationId, vs...))
+}
+
+// OrganizationIdGT applies the GT predicate on the "organizationId" field.
+func OrganizationIdGT(v int) predicate.Patient {
+	return predicate.Patient(sql.FieldGT(FieldOrganizationId, v))
+}
+
+// OrganizationIdGTE applies the GTE predicate on the "organizationId" field.
+func OrganizationIdGTE(v int) predicate.Patient {
+	return predicate.Patient(sql.FieldGTE(FieldOrganizationId, v))
+}
+
+// OrganizationIdLT applies the LT predicate on the "organizationId" field.
+func OrganizationIdLT(v int) predicate.Patient {
+	return predicate.Patient(sql.FieldLT(FieldOrganizationId, v))
+}
+
+// OrganizationIdLTE applies the LTE predicate on the "organizationId" field.
+func OrganizationIdLTE(v int) predicate.Patient {
+	return predicate.Patient(sql.FieldLTE(FieldOrganizationId, v))
+}
+
+// OrganizationIdIsNil applies the IsNil predicate on the "organizationId" field.
+func OrganizationIdIsNil() predicate.Patient {
+	return predicate.Patient(sql.FieldIsNull(FieldOrganizationId))
+}
+
+// OrganizationIdNotNil applies the NotNil predicate on the "organizationId" field.
+func OrganizationIdNotNil() predicate.Patient {
+	return predicate.Patient(sql.FieldNotNull(FieldOrganizationId))
+}
+
 // SurnameEQ applies the EQ predicate on the "surname" field.
 func SurnameEQ(v string) predicate.Patient {
 	return predicate.Patient(sql.FieldEQ(FieldSurname, v))
@@ -22,6 +22,20 @@ type PatientCreate struct {
 	hooks    []Hook
 }
 
+// SetOrganizationId sets the "organizationId" field.
+func (pc *PatientCreate) SetOrganizationId(i int) *PatientCreate {
+	pc.mutation.SetOrganizationId(i)
+	return pc
+}
+
+// SetNillableOrganizationId sets the "organizationId" field if the given value is not nil.
+func (pc *PatientCreate) SetNillableOrganizationId(i *int) *PatientCreate {
+	if i != nil {
+		pc.SetOrganizationId(*i)
+	}
+	return pc
+}
+
 // SetSurname sets the "surname" field.
 func (pc *PatientCreate) SetSurname(s string) *PatientCreate {
 	pc.mutation.SetSurname(s)
@@ -193,6 +207,10 @@ func (pc *PatientCreate) createSpec() (*Patient, *sqlgraph.CreateSpec) {
 		_node = &Patient{config: pc.config}
 		_spec = sqlgraph.NewCreateSpec(patient.Table, sqlgraph.NewFieldSpec(patient.FieldID, field.TypeInt))
 	)
+	if value, ok := pc.mutation.OrganizationId(); ok {
+		_spec.SetField(patient.FieldOrganizationId, field.TypeInt, value)
+		_node.OrganizationId = value
+	}
 	if value, ok := pc.mutation.Surname(); ok {
 		_spec.SetField(patient.FieldSurname, field.TypeString, value)
 		_node.Surname = value
@@ -5,6 +5,7 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"hospital/internal/modules/db/ent/disease"
 	"hospital/internal/modules/db/ent/doctor"
@@ -371,12 +372,12 @@ func (pq *PatientQuery) WithIlls(opts ...func(*DiseaseQuery)) *PatientQuery {
 // Example:
 //
 //	var v []struct {
-//		Surname string `json:"surname,omitempty"`
+//		OrganizationId int `json:"organizationId,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Patient.Query().
-//		GroupBy(patient.FieldSurname).
+//		GroupBy(patient.FieldOrganizationId).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (pq *PatientQuery) GroupBy(field string, fields ...string) *PatientGroupBy {
@@ -394,11 +395,11 @@ func (pq *PatientQuery) GroupBy(field string, fields ...string) *PatientGroupBy
 // Example:
 //
 //	var v []struct {
-//		Surname string `json:"surname,omitempty"`
+//		OrganizationId int `json:"organizationId,omitempty"`
 //	}
 //
 //	client.Patient.Query().
-//		Select(patient.FieldSurname).
+//		Select(patient.FieldOrganizationId).
 //		Scan(ctx, &v)
 func (pq *PatientQuery) Select(fields ...string) *PatientSelect {
 	pq.ctx.Fields = append(pq.ctx.Fields, fields...)
@@ -436,6 +437,12 @@ func (pq *PatientQuery) prepareQuery(ctx context.Context) error {
 		}
 		pq.sql = prev
 	}
+	if patient.Policy == nil {
+		return errors.New("ent: uninitialized patient.Policy (forgotten import ent/runtime?)")
+	}
+	if err := patient.Policy.EvalQuery(ctx, pq); err != nil {
+		return err
+	}
 	return nil
 }
 
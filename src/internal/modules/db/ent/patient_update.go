@@ -223,6 +223,9 @@ func (pu *PatientUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if pu.mutation.OrganizationIdCleared() {
+		_spec.ClearField(patient.FieldOrganizationId, field.TypeInt)
+	}
 	if value, ok := pu.mutation.Surname(); ok {
 		_spec.SetField(patient.FieldSurname, field.TypeString, value)
 	}
@@ -596,6 +599,9 @@ func (puo *PatientUpdateOne) sqlSave(ctx context.Context) (_node *Patient, err e
 			}
 		}
 	}
+	if puo.mutation.OrganizationIdCleared() {
+		_spec.ClearField(patient.FieldOrganizationId, field.TypeInt)
+	}
 	if value, ok := puo.mutation.Surname(); ok {
 		_spec.SetField(patient.FieldSurname, field.TypeString, value)
 	}
@@ -6,14 +6,53 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AccessLog is the predicate function for accesslog builders.
+type AccessLog func(*sql.Selector)
+
+// Alert is the predicate function for alert builders.
+type Alert func(*sql.Selector)
+
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
+// ConfirmToken is the predicate function for confirmtoken builders.
+type ConfirmToken func(*sql.Selector)
+
+// Conversation is the predicate function for conversation builders.
+type Conversation func(*sql.Selector)
+
+// Department is the predicate function for department builders.
+type Department func(*sql.Selector)
+
 // Disease is the predicate function for disease builders.
 type Disease func(*sql.Selector)
 
 // Doctor is the predicate function for doctor builders.
 type Doctor func(*sql.Selector)
 
+// JobState is the predicate function for jobstate builders.
+type JobState func(*sql.Selector)
+
+// NotificationPreference is the predicate function for notificationpreference builders.
+type NotificationPreference func(*sql.Selector)
+
+// Organization is the predicate function for organization builders.
+type Organization func(*sql.Selector)
+
 // Patient is the predicate function for patient builders.
 type Patient func(*sql.Selector)
 
 // Room is the predicate function for room builders.
 type Room func(*sql.Selector)
+
+// Session is the predicate function for session builders.
+type Session func(*sql.Selector)
+
+// Vital is the predicate function for vital builders.
+type Vital func(*sql.Selector)
+
+// WardChat is the predicate function for wardchat builders.
+type WardChat func(*sql.Selector)
+
+// WardNotice is the predicate function for wardnotice builders.
+type WardNotice func(*sql.Selector)
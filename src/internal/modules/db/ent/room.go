@@ -4,6 +4,7 @@ package ent
 
 import (
 	"fmt"
+	"hospital/internal/modules/db/ent/department"
 	"hospital/internal/modules/db/ent/room"
 	"strings"
 
@@ -16,6 +17,8 @@ type Room struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// OrganizationId holds the value of the "organizationId" field.
+	OrganizationId int `json:"organizationId,omitempty"`
 	// Number holds the value of the "number" field.
 	Number int `json:"number,omitempty"`
 	// Floor holds the value of the "floor" field.
@@ -26,6 +29,8 @@ type Room struct {
 	NumberPatients int `json:"numberPatients,omitempty"`
 	// TypeRoom holds the value of the "typeRoom" field.
 	TypeRoom string `json:"typeRoom,omitempty"`
+	// DepartmentId holds the value of the "departmentId" field.
+	DepartmentId *int `json:"departmentId,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the RoomQuery when eager-loading is set.
 	Edges        RoomEdges `json:"edges"`
@@ -36,9 +41,11 @@ type Room struct {
 type RoomEdges struct {
 	// Contains holds the value of the contains edge.
 	Contains []*Patient `json:"contains,omitempty"`
+	// Department holds the value of the department edge.
+	Department *Department `json:"department,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // ContainsOrErr returns the Contains value or an error if the edge
@@ -50,12 +57,25 @@ func (e RoomEdges) ContainsOrErr() ([]*Patient, error) {
 	return nil, &NotLoadedError{edge: "contains"}
 }
 
+// DepartmentOrErr returns the Department value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RoomEdges) DepartmentOrErr() (*Department, error) {
+	if e.loadedTypes[1] {
+		if e.Department == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: department.Label}
+		}
+		return e.Department, nil
+	}
+	return nil, &NotLoadedError{edge: "department"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Room) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case room.FieldID, room.FieldNumber, room.FieldFloor, room.FieldNumberBeds, room.FieldNumberPatients:
+		case room.FieldID, room.FieldOrganizationId, room.FieldNumber, room.FieldFloor, room.FieldNumberBeds, room.FieldNumberPatients, room.FieldDepartmentId:
 			values[i] = new(sql.NullInt64)
 		case room.FieldTypeRoom:
 			values[i] = new(sql.NullString)
@@ -80,6 +100,12 @@ func (r *Room) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			r.ID = int(value.Int64)
+		case room.FieldOrganizationId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field organizationId", values[i])
+			} else if value.Valid {
+				r.OrganizationId = int(value.Int64)
+			}
 		case room.FieldNumber:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field number", values[i])
@@ -110,6 +136,13 @@ func (r *Room) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				r.TypeRoom = value.String
 			}
+		case room.FieldDepartmentId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field departmentId", values[i])
+			} else if value.Valid {
+				r.DepartmentId = new(int)
+				*r.DepartmentId = int(value.Int64)
+			}
 		default:
 			r.selectValues.Set(columns[i], values[i])
 		}
@@ -128,6 +161,11 @@ func (r *Room) QueryContains() *PatientQuery {
 	return NewRoomClient(r.config).QueryContains(r)
 }
 
+// QueryDepartment queries the "department" edge of the Room entity.
+func (r *Room) QueryDepartment() *DepartmentQuery {
+	return NewRoomClient(r.config).QueryDepartment(r)
+}
+
 // Update returns a builder for updating this Room.
 // Note that you need to call Room.Unwrap() before calling this method if this Room
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -151,6 +189,9 @@ func (r *Room) String() string {
 	var builder strings.Builder
 	builder.WriteString("Room(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("organizationId=")
+	builder.WriteString(fmt.Sprintf("%v", r.OrganizationId))
+	builder.WriteString(", ")
 	builder.WriteString("number=")
 	builder.WriteString(fmt.Sprintf("%v", r.Number))
 	builder.WriteString(", ")
@@ -165,6 +206,11 @@ func (r *Room) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("typeRoom=")
 	builder.WriteString(r.TypeRoom)
+	builder.WriteString(", ")
+	if v := r.DepartmentId; v != nil {
+		builder.WriteString("departmentId=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
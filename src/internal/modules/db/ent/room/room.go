@@ -3,6 +3,7 @@
 package room
 
 import (
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -12,6 +13,8 @@ const (
 	Label = "room"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldOrganizationId holds the string denoting the organizationid field in the database.
+	FieldOrganizationId = "organization_id"
 	// FieldNumber holds the string denoting the number field in the database.
 	FieldNumber = "number"
 	// FieldFloor holds the string denoting the floor field in the database.
@@ -22,8 +25,12 @@ const (
 	FieldNumberPatients = "number_patients"
 	// FieldTypeRoom holds the string denoting the typeroom field in the database.
 	FieldTypeRoom = "type_room"
+	// FieldDepartmentId holds the string denoting the departmentid field in the database.
+	FieldDepartmentId = "department_id"
 	// EdgeContains holds the string denoting the contains edge name in mutations.
 	EdgeContains = "contains"
+	// EdgeDepartment holds the string denoting the department edge name in mutations.
+	EdgeDepartment = "department"
 	// Table holds the table name of the room in the database.
 	Table = "rooms"
 	// ContainsTable is the table that holds the contains relation/edge.
@@ -33,16 +40,25 @@ const (
 	ContainsInverseTable = "patients"
 	// ContainsColumn is the table column denoting the contains relation/edge.
 	ContainsColumn = "room_number"
+	// DepartmentTable is the table that holds the department relation/edge.
+	DepartmentTable = "rooms"
+	// DepartmentInverseTable is the table name for the Department entity.
+	// It exists in this package in order to avoid circular dependency with the "department" package.
+	DepartmentInverseTable = "departments"
+	// DepartmentColumn is the table column denoting the department relation/edge.
+	DepartmentColumn = "department_id"
 )
 
 // Columns holds all SQL columns for room fields.
 var Columns = []string{
 	FieldID,
+	FieldOrganizationId,
 	FieldNumber,
 	FieldFloor,
 	FieldNumberBeds,
 	FieldNumberPatients,
 	FieldTypeRoom,
+	FieldDepartmentId,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -55,6 +71,16 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "hospital/internal/modules/db/ent/runtime"
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+)
+
 // Order defines the ordering method for the Room queries.
 type Order func(*sql.Selector)
 
@@ -63,6 +89,11 @@ func ByID(opts ...sql.OrderTermOption) Order {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByOrganizationId orders the results by the organizationId field.
+func ByOrganizationId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldOrganizationId, opts...).ToFunc()
+}
+
 // ByNumber orders the results by the number field.
 func ByNumber(opts ...sql.OrderTermOption) Order {
 	return sql.OrderByField(FieldNumber, opts...).ToFunc()
@@ -88,6 +119,11 @@ func ByTypeRoom(opts ...sql.OrderTermOption) Order {
 	return sql.OrderByField(FieldTypeRoom, opts...).ToFunc()
 }
 
+// ByDepartmentId orders the results by the departmentId field.
+func ByDepartmentId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldDepartmentId, opts...).ToFunc()
+}
+
 // ByContainsCount orders the results by contains count.
 func ByContainsCount(opts ...sql.OrderTermOption) Order {
 	return func(s *sql.Selector) {
@@ -101,6 +137,13 @@ func ByContains(term sql.OrderTerm, terms ...sql.OrderTerm) Order {
 		sqlgraph.OrderByNeighborTerms(s, newContainsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByDepartmentField orders the results by department field.
+func ByDepartmentField(field string, opts ...sql.OrderTermOption) Order {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDepartmentStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newContainsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -108,3 +151,10 @@ func newContainsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ContainsTable, ContainsColumn),
 	)
 }
+func newDepartmentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DepartmentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, DepartmentTable, DepartmentColumn),
+	)
+}
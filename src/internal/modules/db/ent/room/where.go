@@ -54,6 +54,11 @@ func IDLTE(id int) predicate.Room {
 	return predicate.Room(sql.FieldLTE(FieldID, id))
 }
 
+// OrganizationId applies equality check predicate on the "organizationId" field. It's identical to OrganizationIdEQ.
+func OrganizationId(v int) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldOrganizationId, v))
+}
+
 // Number applies equality check predicate on the "number" field. It's identical to NumberEQ.
 func Number(v int) predicate.Room {
 	return predicate.Room(sql.FieldEQ(FieldNumber, v))
@@ -79,6 +84,61 @@ func TypeRoom(v string) predicate.Room {
 	return predicate.Room(sql.FieldEQ(FieldTypeRoom, v))
 }
 
+// DepartmentId applies equality check predicate on the "departmentId" field. It's identical to DepartmentIdEQ.
+func DepartmentId(v int) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldDepartmentId, v))
+}
+
+// OrganizationIdEQ applies the EQ predicate on the "organizationId" field.
+func OrganizationIdEQ(v int) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldOrganizationId, v))
+}
+
+// OrganizationIdNEQ applies the NEQ predicate on the "organizationId" field.
+func OrganizationIdNEQ(v int) predicate.Room {
+	return predicate.Room(sql.FieldNEQ(FieldOrganizationId, v))
+}
+
+// OrganizationIdIn applies the In predicate on the "organizationId" field.
+func OrganizationIdIn(vs ...int) predicate.Room {
+	return predicate.Room(sql.FieldIn(FieldOrganizationId, vs...))
+}
+
+// OrganizationIdNotIn applies the NotIn predicate on the "organizationId" field.
+func OrganizationIdNotIn(vs ...int) predicate.Room {
+	return predicate.Room(sql.FieldNotIn(FieldOrganizationId, vs...))
+}
+
+// OrganizationIdGT applies the GT predicate on the "organizationId" field.
+func OrganizationIdGT(v int) predicate.Room {
+	return predicate.Room(sql.FieldGT(FieldOrganizationId, v))
+}
+
+// OrganizationIdGTE applies the GTE predicate on the "organizationId" field.
+func OrganizationIdGTE(v int) predicate.Room {
+	return predicate.Room(sql.FieldGTE(FieldOrganizationId, v))
+}
+
+// OrganizationIdLT applies the LT predicate on the "organizationId" field.
+func OrganizationIdLT(v int) predicate.Room {
+	return predicate.Room(sql.FieldLT(FieldOrganizationId, v))
+}
+
+// OrganizationIdLTE applies the LTE predicate on the "organizationId" field.
+func OrganizationIdLTE(v int) predicate.Room {
+	return predicate.Room(sql.FieldLTE(FieldOrganizationId, v))
+}
+
+// OrganizationIdIsNil applies the IsNil predicate on the "organizationId" field.
+func OrganizationIdIsNil() predicate.Room {
+	return predicate.Room(sql.FieldIsNull(FieldOrganizationId))
+}
+
+// OrganizationIdNotNil applies the NotNil predicate on the "organizationId" field.
+func OrganizationIdNotNil() predicate.Room {
+	return predicate.Room(sql.FieldNotNull(FieldOrganizationId))
+}
+
 // NumberEQ applies the EQ predicate on the "number" field.
 func NumberEQ(v int) predicate.Room {
 	return predicate.Room(sql.FieldEQ(FieldNumber, v))
@@ -304,6 +364,36 @@ func TypeRoomContainsFold(v string) predicate.Room {
 	return predicate.Room(sql.FieldContainsFold(FieldTypeRoom, v))
 }
 
+// DepartmentIdEQ applies the EQ predicate on the "departmentId" field.
+func DepartmentIdEQ(v int) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldDepartmentId, v))
+}
+
+// DepartmentIdNEQ applies the NEQ predicate on the "departmentId" field.
+func DepartmentIdNEQ(v int) predicate.Room {
+	return predicate.Room(sql.FieldNEQ(FieldDepartmentId, v))
+}
+
+// DepartmentIdIn applies the In predicate on the "departmentId" field.
+func DepartmentIdIn(vs ...int) predicate.Room {
+	return predicate.Room(sql.FieldIn(FieldDepartmentId, vs...))
+}
+
+// DepartmentIdNotIn applies the NotIn predicate on the "departmentId" field.
+func DepartmentIdNotIn(vs ...int) predicate.Room {
+	return predicate.Room(sql.FieldNotIn(FieldDepartmentId, vs...))
+}
+
+// DepartmentIdIsNil applies the IsNil predicate on the "departmentId" field.
+func DepartmentIdIsNil() predicate.Room {
+	return predicate.Room(sql.FieldIsNull(FieldDepartmentId))
+}
+
+// DepartmentIdNotNil applies the NotNil predicate on the "departmentId" field.
+func DepartmentIdNotNil() predicate.Room {
+	return predicate.Room(sql.FieldNotNull(FieldDepartmentId))
+}
+
 // HasContains applies the HasEdge predicate on the "contains" edge.
 func HasContains() predicate.Room {
 	return predicate.Room(func(s *sql.Selector) {
@@ -327,6 +417,29 @@ func HasContainsWith(preds ...predicate.Patient) predicate.Room {
 	})
 }
 
+// HasDepartment applies the HasEdge predicate on the "department" edge.
+func HasDepartment() predicate.Room {
+	return predicate.Room(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DepartmentTable, DepartmentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDepartmentWith applies the HasEdge predicate on the "department" edge with a given conditions (other predicates).
+func HasDepartmentWith(preds ...predicate.Department) predicate.Room {
+	return predicate.Room(func(s *sql.Selector) {
+		step := newDepartmentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Room) predicate.Room {
 	return predicate.Room(func(s *sql.Selector) {
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"hospital/internal/modules/db/ent/department"
 	"hospital/internal/modules/db/ent/patient"
 	"hospital/internal/modules/db/ent/room"
 
@@ -20,6 +21,20 @@ type RoomCreate struct {
 	hooks    []Hook
 }
 
+// SetOrganizationId sets the "organizationId" field.
+func (rc *RoomCreate) SetOrganizationId(i int) *RoomCreate {
+	rc.mutation.SetOrganizationId(i)
+	return rc
+}
+
+// SetNillableOrganizationId sets the "organizationId" field if the given value is not nil.
+func (rc *RoomCreate) SetNillableOrganizationId(i *int) *RoomCreate {
+	if i != nil {
+		rc.SetOrganizationId(*i)
+	}
+	return rc
+}
+
 // SetNumber sets the "number" field.
 func (rc *RoomCreate) SetNumber(i int) *RoomCreate {
 	rc.mutation.SetNumber(i)
@@ -50,6 +65,20 @@ func (rc *RoomCreate) SetTypeRoom(s string) *RoomCreate {
 	return rc
 }
 
+// SetDepartmentId sets the "departmentId" field.
+func (rc *RoomCreate) SetDepartmentId(i int) *RoomCreate {
+	rc.mutation.SetDepartmentId(i)
+	return rc
+}
+
+// SetNillableDepartmentId sets the "departmentId" field if the given value is not nil.
+func (rc *RoomCreate) SetNillableDepartmentId(i *int) *RoomCreate {
+	if i != nil {
+		rc.SetDepartmentId(*i)
+	}
+	return rc
+}
+
 // AddContainIDs adds the "contains" edge to the Patient entity by IDs.
 func (rc *RoomCreate) AddContainIDs(ids ...int) *RoomCreate {
 	rc.mutation.AddContainIDs(ids...)
@@ -65,6 +94,25 @@ func (rc *RoomCreate) AddContains(p ...*Patient) *RoomCreate {
 	return rc.AddContainIDs(ids...)
 }
 
+// SetDepartmentID sets the "department" edge to the Department entity by ID.
+func (rc *RoomCreate) SetDepartmentID(id int) *RoomCreate {
+	rc.mutation.SetDepartmentID(id)
+	return rc
+}
+
+// SetNillableDepartmentID sets the "department" edge to the Department entity by ID if the given value is not nil.
+func (rc *RoomCreate) SetNillableDepartmentID(id *int) *RoomCreate {
+	if id != nil {
+		rc = rc.SetDepartmentID(*id)
+	}
+	return rc
+}
+
+// SetDepartment sets the "department" edge to the Department entity.
+func (rc *RoomCreate) SetDepartment(d *Department) *RoomCreate {
+	return rc.SetDepartmentID(d.ID)
+}
+
 // Mutation returns the RoomMutation object of the builder.
 func (rc *RoomCreate) Mutation() *RoomMutation {
 	return rc.mutation
@@ -140,6 +188,10 @@ func (rc *RoomCreate) createSpec() (*Room, *sqlgraph.CreateSpec) {
 		_node = &Room{config: rc.config}
 		_spec = sqlgraph.NewCreateSpec(room.Table, sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt))
 	)
+	if value, ok := rc.mutation.OrganizationId(); ok {
+		_spec.SetField(room.FieldOrganizationId, field.TypeInt, value)
+		_node.OrganizationId = value
+	}
 	if value, ok := rc.mutation.Number(); ok {
 		_spec.SetField(room.FieldNumber, field.TypeInt, value)
 		_node.Number = value
@@ -176,6 +228,23 @@ func (rc *RoomCreate) createSpec() (*Room, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := rc.mutation.DepartmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   room.DepartmentTable,
+			Columns: []string{room.DepartmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DepartmentId = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -5,7 +5,9 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
+	"hospital/internal/modules/db/ent/department"
 	"hospital/internal/modules/db/ent/patient"
 	"hospital/internal/modules/db/ent/predicate"
 	"hospital/internal/modules/db/ent/room"
@@ -19,11 +21,12 @@ import (
 // RoomQuery is the builder for querying Room entities.
 type RoomQuery struct {
 	config
-	ctx          *QueryContext
-	order        []room.Order
-	inters       []Interceptor
-	predicates   []predicate.Room
-	withContains *PatientQuery
+	ctx            *QueryContext
+	order          []room.Order
+	inters         []Interceptor
+	predicates     []predicate.Room
+	withContains   *PatientQuery
+	withDepartment *DepartmentQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -82,6 +85,28 @@ func (rq *RoomQuery) QueryContains() *PatientQuery {
 	return query
 }
 
+// QueryDepartment chains the current query on the "department" edge.
+func (rq *RoomQuery) QueryDepartment() *DepartmentQuery {
+	query := (&DepartmentClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(room.Table, room.FieldID, selector),
+			sqlgraph.To(department.Table, department.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, room.DepartmentTable, room.DepartmentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Room entity from the query.
 // Returns a *NotFoundError when no Room was found.
 func (rq *RoomQuery) First(ctx context.Context) (*Room, error) {
@@ -269,12 +294,13 @@ func (rq *RoomQuery) Clone() *RoomQuery {
 		return nil
 	}
 	return &RoomQuery{
-		config:       rq.config,
-		ctx:          rq.ctx.Clone(),
-		order:        append([]room.Order{}, rq.order...),
-		inters:       append([]Interceptor{}, rq.inters...),
-		predicates:   append([]predicate.Room{}, rq.predicates...),
-		withContains: rq.withContains.Clone(),
+		config:         rq.config,
+		ctx:            rq.ctx.Clone(),
+		order:          append([]room.Order{}, rq.order...),
+		inters:         append([]Interceptor{}, rq.inters...),
+		predicates:     append([]predicate.Room{}, rq.predicates...),
+		withContains:   rq.withContains.Clone(),
+		withDepartment: rq.withDepartment.Clone(),
 		// clone intermediate query.
 		sql:  rq.sql.Clone(),
 		path: rq.path,
@@ -292,18 +318,29 @@ func (rq *RoomQuery) WithContains(opts ...func(*PatientQuery)) *RoomQuery {
 	return rq
 }
 
+// WithDepartment tells the query-builder to eager-load the nodes that are connected to
+// the "department" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *RoomQuery) WithDepartment(opts ...func(*DepartmentQuery)) *RoomQuery {
+	query := (&DepartmentClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withDepartment = query
+	return rq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Number int `json:"number,omitempty"`
+//		OrganizationId int `json:"organizationId,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Room.Query().
-//		GroupBy(room.FieldNumber).
+//		GroupBy(room.FieldOrganizationId).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (rq *RoomQuery) GroupBy(field string, fields ...string) *RoomGroupBy {
@@ -321,11 +358,11 @@ func (rq *RoomQuery) GroupBy(field string, fields ...string) *RoomGroupBy {
 // Example:
 //
 //	var v []struct {
-//		Number int `json:"number,omitempty"`
+//		OrganizationId int `json:"organizationId,omitempty"`
 //	}
 //
 //	client.Room.Query().
-//		Select(room.FieldNumber).
+//		Select(room.FieldOrganizationId).
 //		Scan(ctx, &v)
 func (rq *RoomQuery) Select(fields ...string) *RoomSelect {
 	rq.ctx.Fields = append(rq.ctx.Fields, fields...)
@@ -363,6 +400,12 @@ func (rq *RoomQuery) prepareQuery(ctx context.Context) error {
 		}
 		rq.sql = prev
 	}
+	if room.Policy == nil {
+		return errors.New("ent: uninitialized room.Policy (forgotten import ent/runtime?)")
+	}
+	if err := room.Policy.EvalQuery(ctx, rq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -370,8 +413,9 @@ func (rq *RoomQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Room, e
 	var (
 		nodes       = []*Room{}
 		_spec       = rq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			rq.withContains != nil,
+			rq.withDepartment != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -399,6 +443,12 @@ func (rq *RoomQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Room, e
 			return nil, err
 		}
 	}
+	if query := rq.withDepartment; query != nil {
+		if err := rq.loadDepartment(ctx, query, nodes, nil,
+			func(n *Room, e *Department) { n.Edges.Department = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -430,6 +480,38 @@ func (rq *RoomQuery) loadContains(ctx context.Context, query *PatientQuery, node
 	}
 	return nil
 }
+func (rq *RoomQuery) loadDepartment(ctx context.Context, query *DepartmentQuery, nodes []*Room, init func(*Room), assign func(*Room, *Department)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Room)
+	for i := range nodes {
+		if nodes[i].DepartmentId == nil {
+			continue
+		}
+		fk := *nodes[i].DepartmentId
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(department.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "departmentId" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (rq *RoomQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := rq.querySpec()
@@ -456,6 +538,9 @@ func (rq *RoomQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if rq.withDepartment != nil {
+			_spec.Node.AddColumnOnce(room.FieldDepartmentId)
+		}
 	}
 	if ps := rq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"hospital/internal/modules/db/ent/department"
 	"hospital/internal/modules/db/ent/patient"
 	"hospital/internal/modules/db/ent/predicate"
 	"hospital/internal/modules/db/ent/room"
@@ -86,6 +87,26 @@ func (ru *RoomUpdate) SetTypeRoom(s string) *RoomUpdate {
 	return ru
 }
 
+// SetDepartmentId sets the "departmentId" field.
+func (ru *RoomUpdate) SetDepartmentId(i int) *RoomUpdate {
+	ru.mutation.SetDepartmentId(i)
+	return ru
+}
+
+// SetNillableDepartmentId sets the "departmentId" field if the given value is not nil.
+func (ru *RoomUpdate) SetNillableDepartmentId(i *int) *RoomUpdate {
+	if i != nil {
+		ru.SetDepartmentId(*i)
+	}
+	return ru
+}
+
+// ClearDepartmentId clears the value of the "departmentId" field.
+func (ru *RoomUpdate) ClearDepartmentId() *RoomUpdate {
+	ru.mutation.ClearDepartmentId()
+	return ru
+}
+
 // AddContainIDs adds the "contains" edge to the Patient entity by IDs.
 func (ru *RoomUpdate) AddContainIDs(ids ...int) *RoomUpdate {
 	ru.mutation.AddContainIDs(ids...)
@@ -101,6 +122,25 @@ func (ru *RoomUpdate) AddContains(p ...*Patient) *RoomUpdate {
 	return ru.AddContainIDs(ids...)
 }
 
+// SetDepartmentID sets the "department" edge to the Department entity by ID.
+func (ru *RoomUpdate) SetDepartmentID(id int) *RoomUpdate {
+	ru.mutation.SetDepartmentID(id)
+	return ru
+}
+
+// SetNillableDepartmentID sets the "department" edge to the Department entity by ID if the given value is not nil.
+func (ru *RoomUpdate) SetNillableDepartmentID(id *int) *RoomUpdate {
+	if id != nil {
+		ru = ru.SetDepartmentID(*id)
+	}
+	return ru
+}
+
+// SetDepartment sets the "department" edge to the Department entity.
+func (ru *RoomUpdate) SetDepartment(d *Department) *RoomUpdate {
+	return ru.SetDepartmentID(d.ID)
+}
+
 // Mutation returns the RoomMutation object of the builder.
 func (ru *RoomUpdate) Mutation() *RoomMutation {
 	return ru.mutation
@@ -127,6 +167,12 @@ func (ru *RoomUpdate) RemoveContains(p ...*Patient) *RoomUpdate {
 	return ru.RemoveContainIDs(ids...)
 }
 
+// ClearDepartment clears the "department" edge to the Department entity.
+func (ru *RoomUpdate) ClearDepartment() *RoomUpdate {
+	ru.mutation.ClearDepartment()
+	return ru
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ru *RoomUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks[int, RoomMutation](ctx, ru.sqlSave, ru.mutation, ru.hooks)
@@ -163,6 +209,9 @@ func (ru *RoomUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if ru.mutation.OrganizationIdCleared() {
+		_spec.ClearField(room.FieldOrganizationId, field.TypeInt)
+	}
 	if value, ok := ru.mutation.Number(); ok {
 		_spec.SetField(room.FieldNumber, field.TypeInt, value)
 	}
@@ -235,6 +284,35 @@ func (ru *RoomUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ru.mutation.DepartmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   room.DepartmentTable,
+			Columns: []string{room.DepartmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.DepartmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   room.DepartmentTable,
+			Columns: []string{room.DepartmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{room.Label}
@@ -313,6 +391,26 @@ func (ruo *RoomUpdateOne) SetTypeRoom(s string) *RoomUpdateOne {
 	return ruo
 }
 
+// SetDepartmentId sets the "departmentId" field.
+func (ruo *RoomUpdateOne) SetDepartmentId(i int) *RoomUpdateOne {
+	ruo.mutation.SetDepartmentId(i)
+	return ruo
+}
+
+// SetNillableDepartmentId sets the "departmentId" field if the given value is not nil.
+func (ruo *RoomUpdateOne) SetNillableDepartmentId(i *int) *RoomUpdateOne {
+	if i != nil {
+		ruo.SetDepartmentId(*i)
+	}
+	return ruo
+}
+
+// ClearDepartmentId clears the value of the "departmentId" field.
+func (ruo *RoomUpdateOne) ClearDepartmentId() *RoomUpdateOne {
+	ruo.mutation.ClearDepartmentId()
+	return ruo
+}
+
 // AddContainIDs adds the "contains" edge to the Patient entity by IDs.
 func (ruo *RoomUpdateOne) AddContainIDs(ids ...int) *RoomUpdateOne {
 	ruo.mutation.AddContainIDs(ids...)
@@ -328,6 +426,25 @@ func (ruo *RoomUpdateOne) AddContains(p ...*Patient) *RoomUpdateOne {
 	return ruo.AddContainIDs(ids...)
 }
 
+// SetDepartmentID sets the "department" edge to the Department entity by ID.
+func (ruo *RoomUpdateOne) SetDepartmentID(id int) *RoomUpdateOne {
+	ruo.mutation.SetDepartmentID(id)
+	return ruo
+}
+
+// SetNillableDepartmentID sets the "department" edge to the Department entity by ID if the given value is not nil.
+func (ruo *RoomUpdateOne) SetNillableDepartmentID(id *int) *RoomUpdateOne {
+	if id != nil {
+		ruo = ruo.SetDepartmentID(*id)
+	}
+	return ruo
+}
+
+// SetDepartment sets the "department" edge to the Department entity.
+func (ruo *RoomUpdateOne) SetDepartment(d *Department) *RoomUpdateOne {
+	return ruo.SetDepartmentID(d.ID)
+}
+
 // Mutation returns the RoomMutation object of the builder.
 func (ruo *RoomUpdateOne) Mutation() *RoomMutation {
 	return ruo.mutation
@@ -354,6 +471,12 @@ func (ruo *RoomUpdateOne) RemoveContains(p ...*Patient) *RoomUpdateOne {
 	return ruo.RemoveContainIDs(ids...)
 }
 
+// ClearDepartment clears the "department" edge to the Department entity.
+func (ruo *RoomUpdateOne) ClearDepartment() *RoomUpdateOne {
+	ruo.mutation.ClearDepartment()
+	return ruo
+}
+
 // Where appends a list predicates to the RoomUpdate builder.
 func (ruo *RoomUpdateOne) Where(ps ...predicate.Room) *RoomUpdateOne {
 	ruo.mutation.Where(ps...)
@@ -420,6 +543,9 @@ func (ruo *RoomUpdateOne) sqlSave(ctx context.Context) (_node *Room, err error)
 			}
 		}
 	}
+	if ruo.mutation.OrganizationIdCleared() {
+		_spec.ClearField(room.FieldOrganizationId, field.TypeInt)
+	}
 	if value, ok := ruo.mutation.Number(); ok {
 		_spec.SetField(room.FieldNumber, field.TypeInt, value)
 	}
@@ -492,6 +618,35 @@ func (ruo *RoomUpdateOne) sqlSave(ctx context.Context) (_node *Room, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ruo.mutation.DepartmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   room.DepartmentTable,
+			Columns: []string{room.DepartmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.DepartmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   room.DepartmentTable,
+			Columns: []string{room.DepartmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Room{config: ruo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
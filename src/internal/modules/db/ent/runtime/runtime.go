@@ -2,7 +2,274 @@
 
 package runtime
 
-// The schema-stitching logic is generated in hospital/internal/modules/db/ent/runtime.go
+import (
+	"context"
+	"hospital/internal/modules/db/ent/accesslog"
+	"hospital/internal/modules/db/ent/alert"
+	"hospital/internal/modules/db/ent/auditlog"
+	"hospital/internal/modules/db/ent/conversation"
+	"hospital/internal/modules/db/ent/department"
+	"hospital/internal/modules/db/ent/disease"
+	"hospital/internal/modules/db/ent/doctor"
+	"hospital/internal/modules/db/ent/jobstate"
+	"hospital/internal/modules/db/ent/organization"
+	"hospital/internal/modules/db/ent/patient"
+	"hospital/internal/modules/db/ent/room"
+	"hospital/internal/modules/db/ent/session"
+	"hospital/internal/modules/db/ent/vital"
+	"hospital/internal/modules/db/ent/wardchat"
+	"hospital/internal/modules/db/ent/wardnotice"
+	"hospital/internal/modules/db/schema"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/privacy"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	accesslogMixin := schema.AccessLog{}.Mixin()
+	accesslog.Policy = privacy.NewPolicies(accesslogMixin[0], schema.AccessLog{})
+	accesslog.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := accesslog.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	accesslogMixinHooks0 := accesslogMixin[0].Hooks()
+	accesslogHooks := schema.AccessLog{}.Hooks()
+
+	accesslog.Hooks[1] = accesslogMixinHooks0[0]
+
+	accesslog.Hooks[2] = accesslogHooks[0]
+	accesslogFields := schema.AccessLog{}.Fields()
+	_ = accesslogFields
+	// accesslogDescCreatedAt is the schema descriptor for createdAt field.
+	accesslogDescCreatedAt := accesslogFields[5].Descriptor()
+	// accesslog.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	accesslog.DefaultCreatedAt = accesslogDescCreatedAt.Default.(func() time.Time)
+	alertMixin := schema.Alert{}.Mixin()
+	alert.Policy = privacy.NewPolicies(alertMixin[0], schema.Alert{})
+	alert.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := alert.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	alertMixinHooks0 := alertMixin[0].Hooks()
+
+	alert.Hooks[1] = alertMixinHooks0[0]
+	alertFields := schema.Alert{}.Fields()
+	_ = alertFields
+	// alertDescAttempts is the schema descriptor for attempts field.
+	alertDescAttempts := alertFields[9].Descriptor()
+	// alert.DefaultAttempts holds the default value on creation for the attempts field.
+	alert.DefaultAttempts = alertDescAttempts.Default.(int)
+	// alertDescEscalationLevel is the schema descriptor for escalationLevel field.
+	alertDescEscalationLevel := alertFields[13].Descriptor()
+	// alert.DefaultEscalationLevel holds the default value on creation for the escalationLevel field.
+	alert.DefaultEscalationLevel = alertDescEscalationLevel.Default.(int)
+	// alertDescCreatedAt is the schema descriptor for createdAt field.
+	alertDescCreatedAt := alertFields[14].Descriptor()
+	// alert.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	alert.DefaultCreatedAt = alertDescCreatedAt.Default.(func() time.Time)
+	auditlogMixin := schema.AuditLog{}.Mixin()
+	auditlog.Policy = privacy.NewPolicies(auditlogMixin[0], schema.AuditLog{})
+	auditlog.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := auditlog.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	auditlogMixinHooks0 := auditlogMixin[0].Hooks()
+	auditlogHooks := schema.AuditLog{}.Hooks()
+
+	auditlog.Hooks[1] = auditlogMixinHooks0[0]
+
+	auditlog.Hooks[2] = auditlogHooks[0]
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescCreatedAt is the schema descriptor for createdAt field.
+	auditlogDescCreatedAt := auditlogFields[6].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	conversationFields := schema.Conversation{}.Fields()
+	_ = conversationFields
+	// conversationDescSuspended is the schema descriptor for suspended field.
+	conversationDescSuspended := conversationFields[6].Descriptor()
+	// conversation.DefaultSuspended holds the default value on creation for the suspended field.
+	conversation.DefaultSuspended = conversationDescSuspended.Default.(bool)
+	// conversationDescUpdatedAt is the schema descriptor for updatedAt field.
+	conversationDescUpdatedAt := conversationFields[7].Descriptor()
+	// conversation.DefaultUpdatedAt holds the default value on creation for the updatedAt field.
+	conversation.DefaultUpdatedAt = conversationDescUpdatedAt.Default.(func() time.Time)
+	departmentMixin := schema.Department{}.Mixin()
+	department.Policy = privacy.NewPolicies(departmentMixin[0], schema.Department{})
+	department.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := department.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	departmentMixinHooks0 := departmentMixin[0].Hooks()
+
+	department.Hooks[1] = departmentMixinHooks0[0]
+	diseaseMixin := schema.Disease{}.Mixin()
+	disease.Policy = privacy.NewPolicies(diseaseMixin[0], schema.Disease{})
+	disease.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := disease.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	diseaseMixinHooks0 := diseaseMixin[0].Hooks()
+
+	disease.Hooks[1] = diseaseMixinHooks0[0]
+	doctor.Policy = privacy.NewPolicies(schema.Doctor{})
+	doctor.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := doctor.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	doctorFields := schema.Doctor{}.Fields()
+	_ = doctorFields
+	// doctorDescOnCall is the schema descriptor for onCall field.
+	doctorDescOnCall := doctorFields[5].Descriptor()
+	// doctor.DefaultOnCall holds the default value on creation for the onCall field.
+	doctor.DefaultOnCall = doctorDescOnCall.Default.(bool)
+	jobstateFields := schema.JobState{}.Fields()
+	_ = jobstateFields
+	// jobstateDescAttempts is the schema descriptor for attempts field.
+	jobstateDescAttempts := jobstateFields[4].Descriptor()
+	// jobstate.DefaultAttempts holds the default value on creation for the attempts field.
+	jobstate.DefaultAttempts = jobstateDescAttempts.Default.(int)
+	// jobstateDescUpdatedAt is the schema descriptor for updatedAt field.
+	jobstateDescUpdatedAt := jobstateFields[5].Descriptor()
+	// jobstate.DefaultUpdatedAt holds the default value on creation for the updatedAt field.
+	jobstate.DefaultUpdatedAt = jobstateDescUpdatedAt.Default.(func() time.Time)
+	// jobstate.UpdateDefaultUpdatedAt holds the default value on update for the updatedAt field.
+	jobstate.UpdateDefaultUpdatedAt = jobstateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	organization.Policy = privacy.NewPolicies(schema.Organization{})
+	organization.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := organization.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	patientMixin := schema.Patient{}.Mixin()
+	patient.Policy = privacy.NewPolicies(patientMixin[0], schema.Patient{})
+	patient.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := patient.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	patientMixinHooks0 := patientMixin[0].Hooks()
+
+	patient.Hooks[1] = patientMixinHooks0[0]
+	roomMixin := schema.Room{}.Mixin()
+	room.Policy = privacy.NewPolicies(roomMixin[0], schema.Room{})
+	room.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := room.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	roomMixinHooks0 := roomMixin[0].Hooks()
+
+	room.Hooks[1] = roomMixinHooks0[0]
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescCreatedAt is the schema descriptor for createdAt field.
+	sessionDescCreatedAt := sessionFields[2].Descriptor()
+	// session.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
+	vitalMixin := schema.Vital{}.Mixin()
+	vital.Policy = privacy.NewPolicies(vitalMixin[0], schema.Vital{})
+	vital.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := vital.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	vitalMixinHooks0 := vitalMixin[0].Hooks()
+	vitalHooks := schema.Vital{}.Hooks()
+
+	vital.Hooks[1] = vitalMixinHooks0[0]
+
+	vital.Hooks[2] = vitalHooks[0]
+	vitalFields := schema.Vital{}.Fields()
+	_ = vitalFields
+	// vitalDescCreatedAt is the schema descriptor for createdAt field.
+	vitalDescCreatedAt := vitalFields[7].Descriptor()
+	// vital.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	vital.DefaultCreatedAt = vitalDescCreatedAt.Default.(func() time.Time)
+	wardchatMixin := schema.WardChat{}.Mixin()
+	wardchat.Policy = privacy.NewPolicies(wardchatMixin[0], schema.WardChat{})
+	wardchat.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := wardchat.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	wardchatMixinHooks0 := wardchatMixin[0].Hooks()
+
+	wardchat.Hooks[1] = wardchatMixinHooks0[0]
+	wardchatFields := schema.WardChat{}.Fields()
+	_ = wardchatFields
+	// wardchatDescCreatedAt is the schema descriptor for createdAt field.
+	wardchatDescCreatedAt := wardchatFields[6].Descriptor()
+	// wardchat.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	wardchat.DefaultCreatedAt = wardchatDescCreatedAt.Default.(func() time.Time)
+	wardnoticeMixin := schema.WardNotice{}.Mixin()
+	wardnotice.Policy = privacy.NewPolicies(wardnoticeMixin[0], schema.WardNotice{})
+	wardnotice.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := wardnotice.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	wardnoticeMixinHooks0 := wardnoticeMixin[0].Hooks()
+
+	wardnotice.Hooks[1] = wardnoticeMixinHooks0[0]
+	wardnoticeFields := schema.WardNotice{}.Fields()
+	_ = wardnoticeFields
+	// wardnoticeDescAttempts is the schema descriptor for attempts field.
+	wardnoticeDescAttempts := wardnoticeFields[8].Descriptor()
+	// wardnotice.DefaultAttempts holds the default value on creation for the attempts field.
+	wardnotice.DefaultAttempts = wardnoticeDescAttempts.Default.(int)
+	// wardnoticeDescCreatedAt is the schema descriptor for createdAt field.
+	wardnoticeDescCreatedAt := wardnoticeFields[9].Descriptor()
+	// wardnotice.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	wardnotice.DefaultCreatedAt = wardnoticeDescCreatedAt.Default.(func() time.Time)
+}
 
 const (
 	Version = "v0.12.0"                                         // Version of ent codegen.
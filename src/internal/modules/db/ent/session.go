@@ -0,0 +1,223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"hospital/internal/modules/db/ent/doctor"
+	"hospital/internal/modules/db/ent/session"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Session is the model entity for the Session schema.
+type Session struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// SessionId holds the value of the "sessionId" field.
+	SessionId string `json:"sessionId,omitempty"`
+	// DoctorId holds the value of the "doctorId" field.
+	DoctorId int `json:"doctorId,omitempty"`
+	// CreatedAt holds the value of the "createdAt" field.
+	CreatedAt time.Time `json:"createdAt,omitempty"`
+	// LastSeenAt holds the value of the "lastSeenAt" field.
+	LastSeenAt time.Time `json:"lastSeenAt,omitempty"`
+	// ExpiresAt holds the value of the "expiresAt" field.
+	ExpiresAt time.Time `json:"expiresAt,omitempty"`
+	// RevokedAt holds the value of the "revokedAt" field.
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+	// DepartmentId holds the value of the "departmentId" field.
+	DepartmentId *int `json:"departmentId,omitempty"`
+	// OrganizationId holds the value of the "organizationId" field.
+	OrganizationId *int `json:"organizationId,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SessionQuery when eager-loading is set.
+	Edges        SessionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SessionEdges holds the relations/edges for other nodes in the graph.
+type SessionEdges struct {
+	// Doctor holds the value of the doctor edge.
+	Doctor *Doctor `json:"doctor,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// DoctorOrErr returns the Doctor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SessionEdges) DoctorOrErr() (*Doctor, error) {
+	if e.loadedTypes[0] {
+		if e.Doctor == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: doctor.Label}
+		}
+		return e.Doctor, nil
+	}
+	return nil, &NotLoadedError{edge: "doctor"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Session) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case session.FieldID, session.FieldDoctorId, session.FieldDepartmentId, session.FieldOrganizationId:
+			values[i] = new(sql.NullInt64)
+		case session.FieldSessionId:
+			values[i] = new(sql.NullString)
+		case session.FieldCreatedAt, session.FieldLastSeenAt, session.FieldExpiresAt, session.FieldRevokedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Session fields.
+func (s *Session) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case session.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			s.ID = int(value.Int64)
+		case session.FieldSessionId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sessionId", values[i])
+			} else if value.Valid {
+				s.SessionId = value.String
+			}
+		case session.FieldDoctorId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field doctorId", values[i])
+			} else if value.Valid {
+				s.DoctorId = int(value.Int64)
+			}
+		case session.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field createdAt", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		case session.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field lastSeenAt", values[i])
+			} else if value.Valid {
+				s.LastSeenAt = value.Time
+			}
+		case session.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expiresAt", values[i])
+			} else if value.Valid {
+				s.ExpiresAt = value.Time
+			}
+		case session.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revokedAt", values[i])
+			} else if value.Valid {
+				s.RevokedAt = new(time.Time)
+				*s.RevokedAt = value.Time
+			}
+		case session.FieldDepartmentId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field departmentId", values[i])
+			} else if value.Valid {
+				s.DepartmentId = new(int)
+				*s.DepartmentId = int(value.Int64)
+			}
+		case session.FieldOrganizationId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field organizationId", values[i])
+			} else if value.Valid {
+				s.OrganizationId = new(int)
+				*s.OrganizationId = int(value.Int64)
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Session.
+// This includes values selected through modifiers, order, etc.
+func (s *Session) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryDoctor queries the "doctor" edge of the Session entity.
+func (s *Session) QueryDoctor() *DoctorQuery {
+	return NewSessionClient(s.config).QueryDoctor(s)
+}
+
+// Update returns a builder for updating this Session.
+// Note that you need to call Session.Unwrap() before calling this method if this Session
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Session) Update() *SessionUpdateOne {
+	return NewSessionClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Session entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Session) Unwrap() *Session {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Session is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Session) String() string {
+	var builder strings.Builder
+	builder.WriteString("Session(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("sessionId=")
+	builder.WriteString(s.SessionId)
+	builder.WriteString(", ")
+	builder.WriteString("doctorId=")
+	builder.WriteString(fmt.Sprintf("%v", s.DoctorId))
+	builder.WriteString(", ")
+	builder.WriteString("createdAt=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("lastSeenAt=")
+	builder.WriteString(s.LastSeenAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("expiresAt=")
+	builder.WriteString(s.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := s.RevokedAt; v != nil {
+		builder.WriteString("revokedAt=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := s.DepartmentId; v != nil {
+		builder.WriteString("departmentId=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := s.OrganizationId; v != nil {
+		builder.WriteString("organizationId=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Sessions is a parsable slice of Session.
+type Sessions []*Session
@@ -0,0 +1,134 @@
+// Code generated by ent, DO NOT EDIT.
+
+package session
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the session type in the database.
+	Label = "session"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSessionId holds the string denoting the sessionid field in the database.
+	FieldSessionId = "session_id"
+	// FieldDoctorId holds the string denoting the doctorid field in the database.
+	FieldDoctorId = "doctor_id"
+	// FieldCreatedAt holds the string denoting the createdat field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldLastSeenAt holds the string denoting the lastseenat field in the database.
+	FieldLastSeenAt = "last_seen_at"
+	// FieldExpiresAt holds the string denoting the expiresat field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldRevokedAt holds the string denoting the revokedat field in the database.
+	FieldRevokedAt = "revoked_at"
+	// FieldDepartmentId holds the string denoting the departmentid field in the database.
+	FieldDepartmentId = "department_id"
+	// FieldOrganizationId holds the string denoting the organizationid field in the database.
+	FieldOrganizationId = "organization_id"
+	// EdgeDoctor holds the string denoting the doctor edge name in mutations.
+	EdgeDoctor = "doctor"
+	// Table holds the table name of the session in the database.
+	Table = "sessions"
+	// DoctorTable is the table that holds the doctor relation/edge.
+	DoctorTable = "sessions"
+	// DoctorInverseTable is the table name for the Doctor entity.
+	// It exists in this package in order to avoid circular dependency with the "doctor" package.
+	DoctorInverseTable = "doctors"
+	// DoctorColumn is the table column denoting the doctor relation/edge.
+	DoctorColumn = "doctor_id"
+)
+
+// Columns holds all SQL columns for session fields.
+var Columns = []string{
+	FieldID,
+	FieldSessionId,
+	FieldDoctorId,
+	FieldCreatedAt,
+	FieldLastSeenAt,
+	FieldExpiresAt,
+	FieldRevokedAt,
+	FieldDepartmentId,
+	FieldOrganizationId,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "createdAt" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Order defines the ordering method for the Session queries.
+type Order func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySessionId orders the results by the sessionId field.
+func BySessionId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldSessionId, opts...).ToFunc()
+}
+
+// ByDoctorId orders the results by the doctorId field.
+func ByDoctorId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldDoctorId, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the createdAt field.
+func ByCreatedAt(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByLastSeenAt orders the results by the lastSeenAt field.
+func ByLastSeenAt(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldLastSeenAt, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expiresAt field.
+func ByExpiresAt(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByRevokedAt orders the results by the revokedAt field.
+func ByRevokedAt(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldRevokedAt, opts...).ToFunc()
+}
+
+// ByDepartmentId orders the results by the departmentId field.
+func ByDepartmentId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldDepartmentId, opts...).ToFunc()
+}
+
+// ByOrganizationId orders the results by the organizationId field.
+func ByOrganizationId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldOrganizationId, opts...).ToFunc()
+}
+
+// ByDoctorField orders the results by doctor field.
+func ByDoctorField(field string, opts ...sql.OrderTermOption) Order {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDoctorStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newDoctorStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DoctorInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, DoctorTable, DoctorColumn),
+	)
+}
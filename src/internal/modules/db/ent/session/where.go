@@ -0,0 +1,506 @@
+// Code generated by ent, DO NOT EDIT.
+
+package session
+
+import (
+	"hospital/internal/modules/db/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Session {
+	return predicate.Session(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Session {
+	return predicate.Session(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Session {
+	return predicate.Session(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Session {
+	return predicate.Session(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Session {
+	return predicate.Session(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Session {
+	return predicate.Session(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Session {
+	return predicate.Session(sql.FieldLTE(FieldID, id))
+}
+
+// SessionId applies equality check predicate on the "sessionId" field. It's identical to SessionIdEQ.
+func SessionId(v string) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldSessionId, v))
+}
+
+// DoctorId applies equality check predicate on the "doctorId" field. It's identical to DoctorIdEQ.
+func DoctorId(v int) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldDoctorId, v))
+}
+
+// CreatedAt applies equality check predicate on the "createdAt" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// LastSeenAt applies equality check predicate on the "lastSeenAt" field. It's identical to LastSeenAtEQ.
+func LastSeenAt(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expiresAt" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// RevokedAt applies equality check predicate on the "revokedAt" field. It's identical to RevokedAtEQ.
+func RevokedAt(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// DepartmentId applies equality check predicate on the "departmentId" field. It's identical to DepartmentIdEQ.
+func DepartmentId(v int) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldDepartmentId, v))
+}
+
+// OrganizationId applies equality check predicate on the "organizationId" field. It's identical to OrganizationIdEQ.
+func OrganizationId(v int) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldOrganizationId, v))
+}
+
+// SessionIdEQ applies the EQ predicate on the "sessionId" field.
+func SessionIdEQ(v string) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldSessionId, v))
+}
+
+// SessionIdNEQ applies the NEQ predicate on the "sessionId" field.
+func SessionIdNEQ(v string) predicate.Session {
+	return predicate.Session(sql.FieldNEQ(FieldSessionId, v))
+}
+
+// SessionIdIn applies the In predicate on the "sessionId" field.
+func SessionIdIn(vs ...string) predicate.Session {
+	return predicate.Session(sql.FieldIn(FieldSessionId, vs...))
+}
+
+// SessionIdNotIn applies the NotIn predicate on the "sessionId" field.
+func SessionIdNotIn(vs ...string) predicate.Session {
+	return predicate.Session(sql.FieldNotIn(FieldSessionId, vs...))
+}
+
+// SessionIdGT applies the GT predicate on the "sessionId" field.
+func SessionIdGT(v string) predicate.Session {
+	return predicate.Session(sql.FieldGT(FieldSessionId, v))
+}
+
+// SessionIdGTE applies the GTE predicate on the "sessionId" field.
+func SessionIdGTE(v string) predicate.Session {
+	return predicate.Session(sql.FieldGTE(FieldSessionId, v))
+}
+
+// SessionIdLT applies the LT predicate on the "sessionId" field.
+func SessionIdLT(v string) predicate.Session {
+	return predicate.Session(sql.FieldLT(FieldSessionId, v))
+}
+
+// SessionIdLTE applies the LTE predicate on the "sessionId" field.
+func SessionIdLTE(v string) predicate.Session {
+	return predicate.Session(sql.FieldLTE(FieldSessionId, v))
+}
+
+// SessionIdContains applies the Contains predicate on the "sessionId" field.
+func SessionIdContains(v string) predicate.Session {
+	return predicate.Session(sql.FieldContains(FieldSessionId, v))
+}
+
+// SessionIdHasPrefix applies the HasPrefix predicate on the "sessionId" field.
+func SessionIdHasPrefix(v string) predicate.Session {
+	return predicate.Session(sql.FieldHasPrefix(FieldSessionId, v))
+}
+
+// SessionIdHasSuffix applies the HasSuffix predicate on the "sessionId" field.
+func SessionIdHasSuffix(v string) predicate.Session {
+	return predicate.Session(sql.FieldHasSuffix(FieldSessionId, v))
+}
+
+// SessionIdEqualFold applies the EqualFold predicate on the "sessionId" field.
+func SessionIdEqualFold(v string) predicate.Session {
+	return predicate.Session(sql.FieldEqualFold(FieldSessionId, v))
+}
+
+// SessionIdContainsFold applies the ContainsFold predicate on the "sessionId" field.
+func SessionIdContainsFold(v string) predicate.Session {
+	return predicate.Session(sql.FieldContainsFold(FieldSessionId, v))
+}
+
+// DoctorIdEQ applies the EQ predicate on the "doctorId" field.
+func DoctorIdEQ(v int) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldDoctorId, v))
+}
+
+// DoctorIdNEQ applies the NEQ predicate on the "doctorId" field.
+func DoctorIdNEQ(v int) predicate.Session {
+	return predicate.Session(sql.FieldNEQ(FieldDoctorId, v))
+}
+
+// DoctorIdIn applies the In predicate on the "doctorId" field.
+func DoctorIdIn(vs ...int) predicate.Session {
+	return predicate.Session(sql.FieldIn(FieldDoctorId, vs...))
+}
+
+// DoctorIdNotIn applies the NotIn predicate on the "doctorId" field.
+func DoctorIdNotIn(vs ...int) predicate.Session {
+	return predicate.Session(sql.FieldNotIn(FieldDoctorId, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "createdAt" field.
+func CreatedAtEQ(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "createdAt" field.
+func CreatedAtNEQ(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "createdAt" field.
+func CreatedAtIn(vs ...time.Time) predicate.Session {
+	return predicate.Session(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "createdAt" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Session {
+	return predicate.Session(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "createdAt" field.
+func CreatedAtGT(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "createdAt" field.
+func CreatedAtGTE(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "createdAt" field.
+func CreatedAtLT(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "createdAt" field.
+func CreatedAtLTE(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// LastSeenAtEQ applies the EQ predicate on the "lastSeenAt" field.
+func LastSeenAtEQ(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtNEQ applies the NEQ predicate on the "lastSeenAt" field.
+func LastSeenAtNEQ(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldNEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIn applies the In predicate on the "lastSeenAt" field.
+func LastSeenAtIn(vs ...time.Time) predicate.Session {
+	return predicate.Session(sql.FieldIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtNotIn applies the NotIn predicate on the "lastSeenAt" field.
+func LastSeenAtNotIn(vs ...time.Time) predicate.Session {
+	return predicate.Session(sql.FieldNotIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtGT applies the GT predicate on the "lastSeenAt" field.
+func LastSeenAtGT(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldGT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtGTE applies the GTE predicate on the "lastSeenAt" field.
+func LastSeenAtGTE(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldGTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLT applies the LT predicate on the "lastSeenAt" field.
+func LastSeenAtLT(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldLT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLTE applies the LTE predicate on the "lastSeenAt" field.
+func LastSeenAtLTE(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldLTE(FieldLastSeenAt, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expiresAt" field.
+func ExpiresAtEQ(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expiresAt" field.
+func ExpiresAtNEQ(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expiresAt" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Session {
+	return predicate.Session(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expiresAt" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Session {
+	return predicate.Session(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expiresAt" field.
+func ExpiresAtGT(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expiresAt" field.
+func ExpiresAtGTE(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expiresAt" field.
+func ExpiresAtLT(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expiresAt" field.
+func ExpiresAtLTE(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// RevokedAtEQ applies the EQ predicate on the "revokedAt" field.
+func RevokedAtEQ(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtNEQ applies the NEQ predicate on the "revokedAt" field.
+func RevokedAtNEQ(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldNEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtIn applies the In predicate on the "revokedAt" field.
+func RevokedAtIn(vs ...time.Time) predicate.Session {
+	return predicate.Session(sql.FieldIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtNotIn applies the NotIn predicate on the "revokedAt" field.
+func RevokedAtNotIn(vs ...time.Time) predicate.Session {
+	return predicate.Session(sql.FieldNotIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtGT applies the GT predicate on the "revokedAt" field.
+func RevokedAtGT(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldGT(FieldRevokedAt, v))
+}
+
+// RevokedAtGTE applies the GTE predicate on the "revokedAt" field.
+func RevokedAtGTE(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldGTE(FieldRevokedAt, v))
+}
+
+// RevokedAtLT applies the LT predicate on the "revokedAt" field.
+func RevokedAtLT(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldLT(FieldRevokedAt, v))
+}
+
+// RevokedAtLTE applies the LTE predicate on the "revokedAt" field.
+func RevokedAtLTE(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldLTE(FieldRevokedAt, v))
+}
+
+// RevokedAtIsNil applies the IsNil predicate on the "revokedAt" field.
+func RevokedAtIsNil() predicate.Session {
+	return predicate.Session(sql.FieldIsNull(FieldRevokedAt))
+}
+
+// RevokedAtNotNil applies the NotNil predicate on the "revokedAt" field.
+func RevokedAtNotNil() predicate.Session {
+	return predicate.Session(sql.FieldNotNull(FieldRevokedAt))
+}
+
+// DepartmentIdEQ applies the EQ predicate on the "departmentId" field.
+func DepartmentIdEQ(v int) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldDepartmentId, v))
+}
+
+// DepartmentIdNEQ applies the NEQ predicate on the "departmentId" field.
+func DepartmentIdNEQ(v int) predicate.Session {
+	return predicate.Session(sql.FieldNEQ(FieldDepartmentId, v))
+}
+
+// DepartmentIdIn applies the In predicate on the "departmentId" field.
+func DepartmentIdIn(vs ...int) predicate.Session {
+	return predicate.Session(sql.FieldIn(FieldDepartmentId, vs...))
+}
+
+// DepartmentIdNotIn applies the NotIn predicate on the "departmentId" field.
+func DepartmentIdNotIn(vs ...int) predicate.Session {
+	return predicate.Session(sql.FieldNotIn(FieldDepartmentId, vs...))
+}
+
+// DepartmentIdGT applies the GT predicate on the "departmentId" field.
+func DepartmentIdGT(v int) predicate.Session {
+	return predicate.Session(sql.FieldGT(FieldDepartmentId, v))
+}
+
+// DepartmentIdGTE applies the GTE predicate on the "departmentId" field.
+func DepartmentIdGTE(v int) predicate.Session {
+	return predicate.Session(sql.FieldGTE(FieldDepartmentId, v))
+}
+
+// DepartmentIdLT applies the LT predicate on the "departmentId" field.
+func DepartmentIdLT(v int) predicate.Session {
+	return predicate.Session(sql.FieldLT(FieldDepartmentId, v))
+}
+
+// DepartmentIdLTE applies the LTE predicate on the "departmentId" field.
+func DepartmentIdLTE(v int) predicate.Session {
+	return predicate.Session(sql.FieldLTE(FieldDepartmentId, v))
+}
+
+// DepartmentIdIsNil applies the IsNil predicate on the "departmentId" field.
+func DepartmentIdIsNil() predicate.Session {
+	return predicate.Session(sql.FieldIsNull(FieldDepartmentId))
+}
+
+// DepartmentIdNotNil applies the NotNil predicate on the "departmentId" field.
+func DepartmentIdNotNil() predicate.Session {
+	return predicate.Session(sql.FieldNotNull(FieldDepartmentId))
+}
+
+// OrganizationIdEQ applies the EQ predicate on the "organizationId" field.
+func OrganizationIdEQ(v int) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldOrganizationId, v))
+}
+
+// OrganizationIdNEQ applies the NEQ predicate on the "organizationId" field.
+func OrganizationIdNEQ(v int) predicate.Session {
+	return predicate.Session(sql.FieldNEQ(FieldOrganizationId, v))
+}
+
+// OrganizationIdIn applies the In predicate on the "organizationId" field.
+func OrganizationIdIn(vs ...int) predicate.Session {
+	return predicate.Session(sql.FieldIn(FieldOrganizationId, vs...))
+}
+
+// OrganizationIdNotIn applies the NotIn predicate on the "organizationId" field.
+func OrganizationIdNotIn(vs ...int) predicate.Session {
+	return predicate.Session(sql.FieldNotIn(FieldOrganizationId, vs...))
+}
+
+// OrganizationIdGT applies the GT predicate on the "organizationId" field.
+func OrganizationIdGT(v int) predicate.Session {
+	return predicate.Session(sql.FieldGT(FieldOrganizationId, v))
+}
+
+// OrganizationIdGTE applies the GTE predicate on the "organizationId" field.
+func OrganizationIdGTE(v int) predicate.Session {
+	return predicate.Session(sql.FieldGTE(FieldOrganizationId, v))
+}
+
+// OrganizationIdLT applies the LT predicate on the "organizationId" field.
+func OrganizationIdLT(v int) predicate.Session {
+	return predicate.Session(sql.FieldLT(FieldOrganizationId, v))
+}
+
+// OrganizationIdLTE applies the LTE predicate on the "organizationId" field.
+func OrganizationIdLTE(v int) predicate.Session {
+	return predicate.Session(sql.FieldLTE(FieldOrganizationId, v))
+}
+
+// OrganizationIdIsNil applies the IsNil predicate on the "organizationId" field.
+func OrganizationIdIsNil() predicate.Session {
+	return predicate.Session(sql.FieldIsNull(FieldOrganizationId))
+}
+
+// OrganizationIdNotNil applies the NotNil predicate on the "organizationId" field.
+func OrganizationIdNotNil() predicate.Session {
+	return predicate.Session(sql.FieldNotNull(FieldOrganizationId))
+}
+
+// HasDoctor applies the HasEdge predicate on the "doctor" edge.
+func HasDoctor() predicate.Session {
+	return predicate.Session(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DoctorTable, DoctorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDoctorWith applies the HasEdge predicate on the "doctor" edge with a given conditions (other predicates).
+func HasDoctorWith(preds ...predicate.Doctor) predicate.Session {
+	return predicate.Session(func(s *sql.Selector) {
+		step := newDoctorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Session) predicate.Session {
+	return predicate.Session(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Session) predicate.Session {
+	return predicate.Session(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Session) predicate.Session {
+	return predicate.Session(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,535 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hospital/internal/modules/db/ent/doctor"
+	"hospital/internal/modules/db/ent/predicate"
+	"hospital/internal/modules/db/ent/session"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SessionUpdate is the builder for updating Session entities.
+type SessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SessionMutation
+}
+
+// Where appends a list predicates to the SessionUpdate builder.
+func (su *SessionUpdate) Where(ps ...predicate.Session) *SessionUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetDoctorId sets the "doctorId" field.
+func (su *SessionUpdate) SetDoctorId(i int) *SessionUpdate {
+	su.mutation.SetDoctorId(i)
+	return su
+}
+
+// SetLastSeenAt sets the "lastSeenAt" field.
+func (su *SessionUpdate) SetLastSeenAt(t time.Time) *SessionUpdate {
+	su.mutation.SetLastSeenAt(t)
+	return su
+}
+
+// SetExpiresAt sets the "expiresAt" field.
+func (su *SessionUpdate) SetExpiresAt(t time.Time) *SessionUpdate {
+	su.mutation.SetExpiresAt(t)
+	return su
+}
+
+// SetRevokedAt sets the "revokedAt" field.
+func (su *SessionUpdate) SetRevokedAt(t time.Time) *SessionUpdate {
+	su.mutation.SetRevokedAt(t)
+	return su
+}
+
+// SetNillableRevokedAt sets the "revokedAt" field if the given value is not nil.
+func (su *SessionUpdate) SetNillableRevokedAt(t *time.Time) *SessionUpdate {
+	if t != nil {
+		su.SetRevokedAt(*t)
+	}
+	return su
+}
+
+// ClearRevokedAt clears the value of the "revokedAt" field.
+func (su *SessionUpdate) ClearRevokedAt() *SessionUpdate {
+	su.mutation.ClearRevokedAt()
+	return su
+}
+
+// SetDepartmentId sets the "departmentId" field.
+func (su *SessionUpdate) SetDepartmentId(i int) *SessionUpdate {
+	su.mutation.ResetDepartmentId()
+	su.mutation.SetDepartmentId(i)
+	return su
+}
+
+// SetNillableDepartmentId sets the "departmentId" field if the given value is not nil.
+func (su *SessionUpdate) SetNillableDepartmentId(i *int) *SessionUpdate {
+	if i != nil {
+		su.SetDepartmentId(*i)
+	}
+	return su
+}
+
+// AddDepartmentId adds i to the "departmentId" field.
+func (su *SessionUpdate) AddDepartmentId(i int) *SessionUpdate {
+	su.mutation.AddDepartmentId(i)
+	return su
+}
+
+// ClearDepartmentId clears the value of the "departmentId" field.
+func (su *SessionUpdate) ClearDepartmentId() *SessionUpdate {
+	su.mutation.ClearDepartmentId()
+	return su
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (su *SessionUpdate) SetOrganizationId(i int) *SessionUpdate {
+	su.mutation.ResetOrganizationId()
+	su.mutation.SetOrganizationId(i)
+	return su
+}
+
+// SetNillableOrganizationId sets the "organizationId" field if the given value is not nil.
+func (su *SessionUpdate) SetNillableOrganizationId(i *int) *SessionUpdate {
+	if i != nil {
+		su.SetOrganizationId(*i)
+	}
+	return su
+}
+
+// AddOrganizationId adds i to the "organizationId" field.
+func (su *SessionUpdate) AddOrganizationId(i int) *SessionUpdate {
+	su.mutation.AddOrganizationId(i)
+	return su
+}
+
+// ClearOrganizationId clears the value of the "organizationId" field.
+func (su *SessionUpdate) ClearOrganizationId() *SessionUpdate {
+	su.mutation.ClearOrganizationId()
+	return su
+}
+
+// SetDoctorID sets the "doctor" edge to the Doctor entity by ID.
+func (su *SessionUpdate) SetDoctorID(id int) *SessionUpdate {
+	su.mutation.SetDoctorID(id)
+	return su
+}
+
+// SetDoctor sets the "doctor" edge to the Doctor entity.
+func (su *SessionUpdate) SetDoctor(d *Doctor) *SessionUpdate {
+	return su.SetDoctorID(d.ID)
+}
+
+// Mutation returns the SessionMutation object of the builder.
+func (su *SessionUpdate) Mutation() *SessionMutation {
+	return su.mutation
+}
+
+// ClearDoctor clears the "doctor" edge to the Doctor entity.
+func (su *SessionUpdate) ClearDoctor() *SessionUpdate {
+	su.mutation.ClearDoctor()
+	return su
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *SessionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks[int, SessionMutation](ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *SessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *SessionUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *SessionUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *SessionUpdate) check() error {
+	if _, ok := su.mutation.DoctorID(); su.mutation.DoctorCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Session.doctor"`)
+	}
+	return nil
+}
+
+func (su *SessionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(session.Table, session.Columns, sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.LastSeenAt(); ok {
+		_spec.SetField(session.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.ExpiresAt(); ok {
+		_spec.SetField(session.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.RevokedAt(); ok {
+		_spec.SetField(session.FieldRevokedAt, field.TypeTime, value)
+	}
+	if su.mutation.RevokedAtCleared() {
+		_spec.ClearField(session.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.DepartmentId(); ok {
+		_spec.SetField(session.FieldDepartmentId, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedDepartmentId(); ok {
+		_spec.AddField(session.FieldDepartmentId, field.TypeInt, value)
+	}
+	if su.mutation.DepartmentIdCleared() {
+		_spec.ClearField(session.FieldDepartmentId, field.TypeInt)
+	}
+	if value, ok := su.mutation.OrganizationId(); ok {
+		_spec.SetField(session.FieldOrganizationId, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedOrganizationId(); ok {
+		_spec.AddField(session.FieldOrganizationId, field.TypeInt, value)
+	}
+	if su.mutation.OrganizationIdCleared() {
+		_spec.ClearField(session.FieldOrganizationId, field.TypeInt)
+	}
+	if su.mutation.DoctorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   session.DoctorTable,
+			Columns: []string{session.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.DoctorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   session.DoctorTable,
+			Columns: []string{session.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{session.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// SessionUpdateOne is the builder for updating a single Session entity.
+type SessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SessionMutation
+}
+
+// SetDoctorId sets the "doctorId" field.
+func (suo *SessionUpdateOne) SetDoctorId(i int) *SessionUpdateOne {
+	suo.mutation.SetDoctorId(i)
+	return suo
+}
+
+// SetLastSeenAt sets the "lastSeenAt" field.
+func (suo *SessionUpdateOne) SetLastSeenAt(t time.Time) *SessionUpdateOne {
+	suo.mutation.SetLastSeenAt(t)
+	return suo
+}
+
+// SetExpiresAt sets the "expiresAt" field.
+func (suo *SessionUpdateOne) SetExpiresAt(t time.Time) *SessionUpdateOne {
+	suo.mutation.SetExpiresAt(t)
+	return suo
+}
+
+// SetRevokedAt sets the "revokedAt" field.
+func (suo *SessionUpdateOne) SetRevokedAt(t time.Time) *SessionUpdateOne {
+	suo.mutation.SetRevokedAt(t)
+	return suo
+}
+
+// SetNillableRevokedAt sets the "revokedAt" field if the given value is not nil.
+func (suo *SessionUpdateOne) SetNillableRevokedAt(t *time.Time) *SessionUpdateOne {
+	if t != nil {
+		suo.SetRevokedAt(*t)
+	}
+	return suo
+}
+
+// ClearRevokedAt clears the value of the "revokedAt" field.
+func (suo *SessionUpdateOne) ClearRevokedAt() *SessionUpdateOne {
+	suo.mutation.ClearRevokedAt()
+	return suo
+}
+
+// SetDepartmentId sets the "departmentId" field.
+func (suo *SessionUpdateOne) SetDepartmentId(i int) *SessionUpdateOne {
+	suo.mutation.ResetDepartmentId()
+	suo.mutation.SetDepartmentId(i)
+	return suo
+}
+
+// SetNillableDepartmentId sets the "departmentId" field if the given value is not nil.
+func (suo *SessionUpdateOne) SetNillableDepartmentId(i *int) *SessionUpdateOne {
+	if i != nil {
+		suo.SetDepartmentId(*i)
+	}
+	return suo
+}
+
+// AddDepartmentId adds i to the "departmentId" field.
+func (suo *SessionUpdateOne) AddDepartmentId(i int) *SessionUpdateOne {
+	suo.mutation.AddDepartmentId(i)
+	return suo
+}
+
+// ClearDepartmentId clears the value of the "departmentId" field.
+func (suo *SessionUpdateOne) ClearDepartmentId() *SessionUpdateOne {
+	suo.mutation.ClearDepartmentId()
+	return suo
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (suo *SessionUpdateOne) SetOrganizationId(i int) *SessionUpdateOne {
+	suo.mutation.ResetOrganizationId()
+	suo.mutation.SetOrganizationId(i)
+	return suo
+}
+
+// SetNillableOrganizationId sets the "organizationId" field if the given value is not nil.
+func (suo *SessionUpdateOne) SetNillableOrganizationId(i *int) *SessionUpdateOne {
+	if i != nil {
+		suo.SetOrganizationId(*i)
+	}
+	return suo
+}
+
+// AddOrganizationId adds i to the "organizationId" field.
+func (suo *SessionUpdateOne) AddOrganizationId(i int) *SessionUpdateOne {
+	suo.mutation.AddOrganizationId(i)
+	return suo
+}
+
+// ClearOrganizationId clears the value of the "organizationId" field.
+func (suo *SessionUpdateOne) ClearOrganizationId() *SessionUpdateOne {
+	suo.mutation.ClearOrganizationId()
+	return suo
+}
+
+// SetDoctorID sets the "doctor" edge to the Doctor entity by ID.
+func (suo *SessionUpdateOne) SetDoctorID(id int) *SessionUpdateOne {
+	suo.mutation.SetDoctorID(id)
+	return suo
+}
+
+// SetDoctor sets the "doctor" edge to the Doctor entity.
+func (suo *SessionUpdateOne) SetDoctor(d *Doctor) *SessionUpdateOne {
+	return suo.SetDoctorID(d.ID)
+}
+
+// Mutation returns the SessionMutation object of the builder.
+func (suo *SessionUpdateOne) Mutation() *SessionMutation {
+	return suo.mutation
+}
+
+// ClearDoctor clears the "doctor" edge to the Doctor entity.
+func (suo *SessionUpdateOne) ClearDoctor() *SessionUpdateOne {
+	suo.mutation.ClearDoctor()
+	return suo
+}
+
+// Where appends a list predicates to the SessionUpdate builder.
+func (suo *SessionUpdateOne) Where(ps ...predicate.Session) *SessionUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *SessionUpdateOne) Select(field string, fields ...string) *SessionUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Session entity.
+func (suo *SessionUpdateOne) Save(ctx context.Context) (*Session, error) {
+	return withHooks[*Session, SessionMutation](ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *SessionUpdateOne) SaveX(ctx context.Context) *Session {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *SessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *SessionUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *SessionUpdateOne) check() error {
+	if _, ok := suo.mutation.DoctorID(); suo.mutation.DoctorCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Session.doctor"`)
+	}
+	return nil
+}
+
+func (suo *SessionUpdateOne) sqlSave(ctx context.Context) (_node *Session, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(session.Table, session.Columns, sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Session.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, session.FieldID)
+		for _, f := range fields {
+			if !session.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != session.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.LastSeenAt(); ok {
+		_spec.SetField(session.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.ExpiresAt(); ok {
+		_spec.SetField(session.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.RevokedAt(); ok {
+		_spec.SetField(session.FieldRevokedAt, field.TypeTime, value)
+	}
+	if suo.mutation.RevokedAtCleared() {
+		_spec.ClearField(session.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.DepartmentId(); ok {
+		_spec.SetField(session.FieldDepartmentId, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedDepartmentId(); ok {
+		_spec.AddField(session.FieldDepartmentId, field.TypeInt, value)
+	}
+	if suo.mutation.DepartmentIdCleared() {
+		_spec.ClearField(session.FieldDepartmentId, field.TypeInt)
+	}
+	if value, ok := suo.mutation.OrganizationId(); ok {
+		_spec.SetField(session.FieldOrganizationId, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedOrganizationId(); ok {
+		_spec.AddField(session.FieldOrganizationId, field.TypeInt, value)
+	}
+	if suo.mutation.OrganizationIdCleared() {
+		_spec.ClearField(session.FieldOrganizationId, field.TypeInt)
+	}
+	if suo.mutation.DoctorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   session.DoctorTable,
+			Columns: []string{session.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.DoctorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   session.DoctorTable,
+			Columns: []string{session.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Session{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{session.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
@@ -12,14 +12,40 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AccessLog is the client for interacting with the AccessLog builders.
+	AccessLog *AccessLogClient
+	// Alert is the client for interacting with the Alert builders.
+	Alert *AlertClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// ConfirmToken is the client for interacting with the ConfirmToken builders.
+	ConfirmToken *ConfirmTokenClient
+	// Conversation is the client for interacting with the Conversation builders.
+	Conversation *ConversationClient
+	// Department is the client for interacting with the Department builders.
+	Department *DepartmentClient
 	// Disease is the client for interacting with the Disease builders.
 	Disease *DiseaseClient
 	// Doctor is the client for interacting with the Doctor builders.
 	Doctor *DoctorClient
+	// JobState is the client for interacting with the JobState builders.
+	JobState *JobStateClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
+	// Organization is the client for interacting with the Organization builders.
+	Organization *OrganizationClient
 	// Patient is the client for interacting with the Patient builders.
 	Patient *PatientClient
 	// Room is the client for interacting with the Room builders.
 	Room *RoomClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
+	// Vital is the client for interacting with the Vital builders.
+	Vital *VitalClient
+	// WardChat is the client for interacting with the WardChat builders.
+	WardChat *WardChatClient
+	// WardNotice is the client for interacting with the WardNotice builders.
+	WardNotice *WardNoticeClient
 
 	// lazily loaded.
 	client     *Client
@@ -151,10 +177,23 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AccessLog = NewAccessLogClient(tx.config)
+	tx.Alert = NewAlertClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.ConfirmToken = NewConfirmTokenClient(tx.config)
+	tx.Conversation = NewConversationClient(tx.config)
+	tx.Department = NewDepartmentClient(tx.config)
 	tx.Disease = NewDiseaseClient(tx.config)
 	tx.Doctor = NewDoctorClient(tx.config)
+	tx.JobState = NewJobStateClient(tx.config)
+	tx.NotificationPreference = NewNotificationPreferenceClient(tx.config)
+	tx.Organization = NewOrganizationClient(tx.config)
 	tx.Patient = NewPatientClient(tx.config)
 	tx.Room = NewRoomClient(tx.config)
+	tx.Session = NewSessionClient(tx.config)
+	tx.Vital = NewVitalClient(tx.config)
+	tx.WardChat = NewWardChatClient(tx.config)
+	tx.WardNotice = NewWardNoticeClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -164,7 +203,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Disease.QueryXXX(), the query will be executed
+// applies a query, for example: AccessLog.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
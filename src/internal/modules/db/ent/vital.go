@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"hospital/internal/modules/db/ent/vital"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Vital is the model entity for the Vital schema.
+type Vital struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// OrganizationId holds the value of the "organizationId" field.
+	OrganizationId int `json:"organizationId,omitempty"`
+	// PatientId holds the value of the "patientId" field.
+	PatientId int `json:"patientId,omitempty"`
+	// AuthorId holds the value of the "authorId" field.
+	AuthorId *int `json:"authorId,omitempty"`
+	// Pulse holds the value of the "pulse" field.
+	Pulse int `json:"pulse,omitempty"`
+	// Systolic holds the value of the "systolic" field.
+	Systolic int `json:"systolic,omitempty"`
+	// Diastolic holds the value of the "diastolic" field.
+	Diastolic int `json:"diastolic,omitempty"`
+	// Temperature holds the value of the "temperature" field.
+	Temperature float64 `json:"temperature,omitempty"`
+	// Saturation holds the value of the "saturation" field.
+	Saturation int `json:"saturation,omitempty"`
+	// CreatedAt holds the value of the "createdAt" field.
+	CreatedAt    time.Time `json:"createdAt,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Vital) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case vital.FieldTemperature:
+			values[i] = new(sql.NullFloat64)
+		case vital.FieldID, vital.FieldOrganizationId, vital.FieldPatientId, vital.FieldAuthorId, vital.FieldPulse, vital.FieldSystolic, vital.FieldDiastolic, vital.FieldSaturation:
+			values[i] = new(sql.NullInt64)
+		case vital.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Vital fields.
+func (v *Vital) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case vital.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			v.ID = int(value.Int64)
+		case vital.FieldOrganizationId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field organizationId", values[i])
+			} else if value.Valid {
+				v.OrganizationId = int(value.Int64)
+			}
+		case vital.FieldPatientId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field patientId", values[i])
+			} else if value.Valid {
+				v.PatientId = int(value.Int64)
+			}
+		case vital.FieldAuthorId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field authorId", values[i])
+			} else if value.Valid {
+				v.AuthorId = new(int)
+				*v.AuthorId = int(value.Int64)
+			}
+		case vital.FieldPulse:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field pulse", values[i])
+			} else if value.Valid {
+				v.Pulse = int(value.Int64)
+			}
+		case vital.FieldSystolic:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field systolic", values[i])
+			} else if value.Valid {
+				v.Systolic = int(value.Int64)
+			}
+		case vital.FieldDiastolic:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field diastolic", values[i])
+			} else if value.Valid {
+				v.Diastolic = int(value.Int64)
+			}
+		case vital.FieldTemperature:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field temperature", values[i])
+			} else if value.Valid {
+				v.Temperature = value.Float64
+			}
+		case vital.FieldSaturation:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field saturation", values[i])
+			} else if value.Valid {
+				v.Saturation = int(value.Int64)
+			}
+		case vital.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field createdAt", values[i])
+			} else if value.Valid {
+				v.CreatedAt = value.Time
+			}
+		default:
+			v.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Vital.
+// This includes values selected through modifiers, order, etc.
+func (v *Vital) Value(name string) (ent.Value, error) {
+	return v.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Vital.
+// Note that you need to call Vital.Unwrap() before calling this method if this Vital
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (v *Vital) Update() *VitalUpdateOne {
+	return NewVitalClient(v.config).UpdateOne(v)
+}
+
+// Unwrap unwraps the Vital entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (v *Vital) Unwrap() *Vital {
+	_tx, ok := v.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Vital is not a transactional entity")
+	}
+	v.config.driver = _tx.drv
+	return v
+}
+
+// String implements the fmt.Stringer.
+func (v *Vital) String() string {
+	var builder strings.Builder
+	builder.WriteString("Vital(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", v.ID))
+	builder.WriteString("organizationId=")
+	builder.WriteString(fmt.Sprintf("%v", v.OrganizationId))
+	builder.WriteString(", ")
+	builder.WriteString("patientId=")
+	builder.WriteString(fmt.Sprintf("%v", v.PatientId))
+	builder.WriteString(", ")
+	if v := v.AuthorId; v != nil {
+		builder.WriteString("authorId=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("pulse=")
+	builder.WriteString(fmt.Sprintf("%v", v.Pulse))
+	builder.WriteString(", ")
+	builder.WriteString("systolic=")
+	builder.WriteString(fmt.Sprintf("%v", v.Systolic))
+	builder.WriteString(", ")
+	builder.WriteString("diastolic=")
+	builder.WriteString(fmt.Sprintf("%v", v.Diastolic))
+	builder.WriteString(", ")
+	builder.WriteString("temperature=")
+	builder.WriteString(fmt.Sprintf("%v", v.Temperature))
+	builder.WriteString(", ")
+	builder.WriteString("saturation=")
+	builder.WriteString(fmt.Sprintf("%v", v.Saturation))
+	builder.WriteString(", ")
+	builder.WriteString("createdAt=")
+	builder.WriteString(v.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Vitals is a parsable slice of Vital.
+type Vitals []*Vital
@@ -0,0 +1,126 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vital
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the vital type in the database.
+	Label = "vital"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOrganizationId holds the string denoting the organizationid field in the database.
+	FieldOrganizationId = "organization_id"
+	// FieldPatientId holds the string denoting the patientid field in the database.
+	FieldPatientId = "patient_id"
+	// FieldAuthorId holds the string denoting the authorid field in the database.
+	FieldAuthorId = "author_id"
+	// FieldPulse holds the string denoting the pulse field in the database.
+	FieldPulse = "pulse"
+	// FieldSystolic holds the string denoting the systolic field in the database.
+	FieldSystolic = "systolic"
+	// FieldDiastolic holds the string denoting the diastolic field in the database.
+	FieldDiastolic = "diastolic"
+	// FieldTemperature holds the string denoting the temperature field in the database.
+	FieldTemperature = "temperature"
+	// FieldSaturation holds the string denoting the saturation field in the database.
+	FieldSaturation = "saturation"
+	// FieldCreatedAt holds the string denoting the createdat field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the vital in the database.
+	Table = "vitals"
+)
+
+// Columns holds all SQL columns for vital fields.
+var Columns = []string{
+	FieldID,
+	FieldOrganizationId,
+	FieldPatientId,
+	FieldAuthorId,
+	FieldPulse,
+	FieldSystolic,
+	FieldDiastolic,
+	FieldTemperature,
+	FieldSaturation,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "hospital/internal/modules/db/ent/runtime"
+var (
+	Hooks  [3]ent.Hook
+	Policy ent.Policy
+	// DefaultCreatedAt holds the default value on creation for the "createdAt" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Order defines the ordering method for the Vital queries.
+type Order func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByOrganizationId orders the results by the organizationId field.
+func ByOrganizationId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldOrganizationId, opts...).ToFunc()
+}
+
+// ByPatientId orders the results by the patientId field.
+func ByPatientId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldPatientId, opts...).ToFunc()
+}
+
+// ByAuthorId orders the results by the authorId field.
+func ByAuthorId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldAuthorId, opts...).ToFunc()
+}
+
+// ByPulse orders the results by the pulse field.
+func ByPulse(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldPulse, opts...).ToFunc()
+}
+
+// BySystolic orders the results by the systolic field.
+func BySystolic(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldSystolic, opts...).ToFunc()
+}
+
+// ByDiastolic orders the results by the diastolic field.
+func ByDiastolic(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldDiastolic, opts...).ToFunc()
+}
+
+// ByTemperature orders the results by the temperature field.
+func ByTemperature(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldTemperature, opts...).ToFunc()
+}
+
+// BySaturation orders the results by the saturation field.
+func BySaturation(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldSaturation, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the createdAt field.
+func ByCreatedAt(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
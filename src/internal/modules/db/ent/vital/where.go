@@ -0,0 +1,512 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vital
+
+import (
+	"hospital/internal/modules/db/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Vital {
+	return predicate.Vital(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Vital {
+	return predicate.Vital(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Vital {
+	return predicate.Vital(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Vital {
+	return predicate.Vital(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Vital {
+	return predicate.Vital(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Vital {
+	return predicate.Vital(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Vital {
+	return predicate.Vital(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Vital {
+	return predicate.Vital(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Vital {
+	return predicate.Vital(sql.FieldLTE(FieldID, id))
+}
+
+// OrganizationId applies equality check predicate on the "organizationId" field. It's identical to OrganizationIdEQ.
+func OrganizationId(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldEQ(FieldOrganizationId, v))
+}
+
+// PatientId applies equality check predicate on the "patientId" field. It's identical to PatientIdEQ.
+func PatientId(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldEQ(FieldPatientId, v))
+}
+
+// AuthorId applies equality check predicate on the "authorId" field. It's identical to AuthorIdEQ.
+func AuthorId(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldEQ(FieldAuthorId, v))
+}
+
+// Pulse applies equality check predicate on the "pulse" field. It's identical to PulseEQ.
+func Pulse(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldEQ(FieldPulse, v))
+}
+
+// Systolic applies equality check predicate on the "systolic" field. It's identical to SystolicEQ.
+func Systolic(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldEQ(FieldSystolic, v))
+}
+
+// Diastolic applies equality check predicate on the "diastolic" field. It's identical to DiastolicEQ.
+func Diastolic(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldEQ(FieldDiastolic, v))
+}
+
+// Temperature applies equality check predicate on the "temperature" field. It's identical to TemperatureEQ.
+func Temperature(v float64) predicate.Vital {
+	return predicate.Vital(sql.FieldEQ(FieldTemperature, v))
+}
+
+// Saturation applies equality check predicate on the "saturation" field. It's identical to SaturationEQ.
+func Saturation(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldEQ(FieldSaturation, v))
+}
+
+// CreatedAt applies equality check predicate on the "createdAt" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Vital {
+	return predicate.Vital(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// OrganizationIdEQ applies the EQ predicate on the "organizationId" field.
+func OrganizationIdEQ(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldEQ(FieldOrganizationId, v))
+}
+
+// OrganizationIdNEQ applies the NEQ predicate on the "organizationId" field.
+func OrganizationIdNEQ(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldNEQ(FieldOrganizationId, v))
+}
+
+// OrganizationIdIn applies the In predicate on the "organizationId" field.
+func OrganizationIdIn(vs ...int) predicate.Vital {
+	return predicate.Vital(sql.FieldIn(FieldOrganizationId, vs...))
+}
+
+// OrganizationIdNotIn applies the NotIn predicate on the "organizationId" field.
+func OrganizationIdNotIn(vs ...int) predicate.Vital {
+	return predicate.Vital(sql.FieldNotIn(FieldOrganizationId, vs...))
+}
+
+// OrganizationIdGT applies the GT predicate on the "organizationId" field.
+func OrganizationIdGT(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldGT(FieldOrganizationId, v))
+}
+
+// OrganizationIdGTE applies the GTE predicate on the "organizationId" field.
+func OrganizationIdGTE(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldGTE(FieldOrganizationId, v))
+}
+
+// OrganizationIdLT applies the LT predicate on the "organizationId" field.
+func OrganizationIdLT(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldLT(FieldOrganizationId, v))
+}
+
+// OrganizationIdLTE applies the LTE predicate on the "organizationId" field.
+func OrganizationIdLTE(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldLTE(FieldOrganizationId, v))
+}
+
+// OrganizationIdIsNil applies the IsNil predicate on the "organizationId" field.
+func OrganizationIdIsNil() predicate.Vital {
+	return predicate.Vital(sql.FieldIsNull(FieldOrganizationId))
+}
+
+// OrganizationIdNotNil applies the NotNil predicate on the "organizationId" field.
+func OrganizationIdNotNil() predicate.Vital {
+	return predicate.Vital(sql.FieldNotNull(FieldOrganizationId))
+}
+
+// PatientIdEQ applies the EQ predicate on the "patientId" field.
+func PatientIdEQ(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldEQ(FieldPatientId, v))
+}
+
+// PatientIdNEQ applies the NEQ predicate on the "patientId" field.
+func PatientIdNEQ(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldNEQ(FieldPatientId, v))
+}
+
+// PatientIdIn applies the In predicate on the "patientId" field.
+func PatientIdIn(vs ...int) predicate.Vital {
+	return predicate.Vital(sql.FieldIn(FieldPatientId, vs...))
+}
+
+// PatientIdNotIn applies the NotIn predicate on the "patientId" field.
+func PatientIdNotIn(vs ...int) predicate.Vital {
+	return predicate.Vital(sql.FieldNotIn(FieldPatientId, vs...))
+}
+
+// PatientIdGT applies the GT predicate on the "patientId" field.
+func PatientIdGT(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldGT(FieldPatientId, v))
+}
+
+// PatientIdGTE applies the GTE predicate on the "patientId" field.
+func PatientIdGTE(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldGTE(FieldPatientId, v))
+}
+
+// PatientIdLT applies the LT predicate on the "patientId" field.
+func PatientIdLT(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldLT(FieldPatientId, v))
+}
+
+// PatientIdLTE applies the LTE predicate on the "patientId" field.
+func PatientIdLTE(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldLTE(FieldPatientId, v))
+}
+
+// AuthorIdEQ applies the EQ predicate on the "authorId" field.
+func AuthorIdEQ(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldEQ(FieldAuthorId, v))
+}
+
+// AuthorIdNEQ applies the NEQ predicate on the "authorId" field.
+func AuthorIdNEQ(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldNEQ(FieldAuthorId, v))
+}
+
+// AuthorIdIn applies the In predicate on the "authorId" field.
+func AuthorIdIn(vs ...int) predicate.Vital {
+	return predicate.Vital(sql.FieldIn(FieldAuthorId, vs...))
+}
+
+// AuthorIdNotIn applies the NotIn predicate on the "authorId" field.
+func AuthorIdNotIn(vs ...int) predicate.Vital {
+	return predicate.Vital(sql.FieldNotIn(FieldAuthorId, vs...))
+}
+
+// AuthorIdGT applies the GT predicate on the "authorId" field.
+func AuthorIdGT(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldGT(FieldAuthorId, v))
+}
+
+// AuthorIdGTE applies the GTE predicate on the "authorId" field.
+func AuthorIdGTE(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldGTE(FieldAuthorId, v))
+}
+
+// AuthorIdLT applies the LT predicate on the "authorId" field.
+func AuthorIdLT(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldLT(FieldAuthorId, v))
+}
+
+// AuthorIdLTE applies the LTE predicate on the "authorId" field.
+func AuthorIdLTE(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldLTE(FieldAuthorId, v))
+}
+
+// AuthorIdIsNil applies the IsNil predicate on the "authorId" field.
+func AuthorIdIsNil() predicate.Vital {
+	return predicate.Vital(sql.FieldIsNull(FieldAuthorId))
+}
+
+// AuthorIdNotNil applies the NotNil predicate on the "authorId" field.
+func AuthorIdNotNil() predicate.Vital {
+	return predicate.Vital(sql.FieldNotNull(FieldAuthorId))
+}
+
+// PulseEQ applies the EQ predicate on the "pulse" field.
+func PulseEQ(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldEQ(FieldPulse, v))
+}
+
+// PulseNEQ applies the NEQ predicate on the "pulse" field.
+func PulseNEQ(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldNEQ(FieldPulse, v))
+}
+
+// PulseIn applies the In predicate on the "pulse" field.
+func PulseIn(vs ...int) predicate.Vital {
+	return predicate.Vital(sql.FieldIn(FieldPulse, vs...))
+}
+
+// PulseNotIn applies the NotIn predicate on the "pulse" field.
+func PulseNotIn(vs ...int) predicate.Vital {
+	return predicate.Vital(sql.FieldNotIn(FieldPulse, vs...))
+}
+
+// PulseGT applies the GT predicate on the "pulse" field.
+func PulseGT(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldGT(FieldPulse, v))
+}
+
+// PulseGTE applies the GTE predicate on the "pulse" field.
+func PulseGTE(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldGTE(FieldPulse, v))
+}
+
+// PulseLT applies the LT predicate on the "pulse" field.
+func PulseLT(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldLT(FieldPulse, v))
+}
+
+// PulseLTE applies the LTE predicate on the "pulse" field.
+func PulseLTE(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldLTE(FieldPulse, v))
+}
+
+// SystolicEQ applies the EQ predicate on the "systolic" field.
+func SystolicEQ(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldEQ(FieldSystolic, v))
+}
+
+// SystolicNEQ applies the NEQ predicate on the "systolic" field.
+func SystolicNEQ(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldNEQ(FieldSystolic, v))
+}
+
+// SystolicIn applies the In predicate on the "systolic" field.
+func SystolicIn(vs ...int) predicate.Vital {
+	return predicate.Vital(sql.FieldIn(FieldSystolic, vs...))
+}
+
+// SystolicNotIn applies the NotIn predicate on the "systolic" field.
+func SystolicNotIn(vs ...int) predicate.Vital {
+	return predicate.Vital(sql.FieldNotIn(FieldSystolic, vs...))
+}
+
+// SystolicGT applies the GT predicate on the "systolic" field.
+func SystolicGT(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldGT(FieldSystolic, v))
+}
+
+// SystolicGTE applies the GTE predicate on the "systolic" field.
+func SystolicGTE(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldGTE(FieldSystolic, v))
+}
+
+// SystolicLT applies the LT predicate on the "systolic" field.
+func SystolicLT(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldLT(FieldSystolic, v))
+}
+
+// SystolicLTE applies the LTE predicate on the "systolic" field.
+func SystolicLTE(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldLTE(FieldSystolic, v))
+}
+
+// DiastolicEQ applies the EQ predicate on the "diastolic" field.
+func DiastolicEQ(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldEQ(FieldDiastolic, v))
+}
+
+// DiastolicNEQ applies the NEQ predicate on the "diastolic" field.
+func DiastolicNEQ(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldNEQ(FieldDiastolic, v))
+}
+
+// DiastolicIn applies the In predicate on the "diastolic" field.
+func DiastolicIn(vs ...int) predicate.Vital {
+	return predicate.Vital(sql.FieldIn(FieldDiastolic, vs...))
+}
+
+// DiastolicNotIn applies the NotIn predicate on the "diastolic" field.
+func DiastolicNotIn(vs ...int) predicate.Vital {
+	return predicate.Vital(sql.FieldNotIn(FieldDiastolic, vs...))
+}
+
+// DiastolicGT applies the GT predicate on the "diastolic" field.
+func DiastolicGT(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldGT(FieldDiastolic, v))
+}
+
+// DiastolicGTE applies the GTE predicate on the "diastolic" field.
+func DiastolicGTE(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldGTE(FieldDiastolic, v))
+}
+
+// DiastolicLT applies the LT predicate on the "diastolic" field.
+func DiastolicLT(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldLT(FieldDiastolic, v))
+}
+
+// DiastolicLTE applies the LTE predicate on the "diastolic" field.
+func DiastolicLTE(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldLTE(FieldDiastolic, v))
+}
+
+// TemperatureEQ applies the EQ predicate on the "temperature" field.
+func TemperatureEQ(v float64) predicate.Vital {
+	return predicate.Vital(sql.FieldEQ(FieldTemperature, v))
+}
+
+// TemperatureNEQ applies the NEQ predicate on the "temperature" field.
+func TemperatureNEQ(v float64) predicate.Vital {
+	return predicate.Vital(sql.FieldNEQ(FieldTemperature, v))
+}
+
+// TemperatureIn applies the In predicate on the "temperature" field.
+func TemperatureIn(vs ...float64) predicate.Vital {
+	return predicate.Vital(sql.FieldIn(FieldTemperature, vs...))
+}
+
+// TemperatureNotIn applies the NotIn predicate on the "temperature" field.
+func TemperatureNotIn(vs ...float64) predicate.Vital {
+	return predicate.Vital(sql.FieldNotIn(FieldTemperature, vs...))
+}
+
+// TemperatureGT applies the GT predicate on the "temperature" field.
+func TemperatureGT(v float64) predicate.Vital {
+	return predicate.Vital(sql.FieldGT(FieldTemperature, v))
+}
+
+// TemperatureGTE applies the GTE predicate on the "temperature" field.
+func TemperatureGTE(v float64) predicate.Vital {
+	return predicate.Vital(sql.FieldGTE(FieldTemperature, v))
+}
+
+// TemperatureLT applies the LT predicate on the "temperature" field.
+func TemperatureLT(v float64) predicate.Vital {
+	return predicate.Vital(sql.FieldLT(FieldTemperature, v))
+}
+
+// TemperatureLTE applies the LTE predicate on the "temperature" field.
+func TemperatureLTE(v float64) predicate.Vital {
+	return predicate.Vital(sql.FieldLTE(FieldTemperature, v))
+}
+
+// SaturationEQ applies the EQ predicate on the "saturation" field.
+func SaturationEQ(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldEQ(FieldSaturation, v))
+}
+
+// SaturationNEQ applies the NEQ predicate on the "saturation" field.
+func SaturationNEQ(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldNEQ(FieldSaturation, v))
+}
+
+// SaturationIn applies the In predicate on the "saturation" field.
+func SaturationIn(vs ...int) predicate.Vital {
+	return predicate.Vital(sql.FieldIn(FieldSaturation, vs...))
+}
+
+// SaturationNotIn applies the NotIn predicate on the "saturation" field.
+func SaturationNotIn(vs ...int) predicate.Vital {
+	return predicate.Vital(sql.FieldNotIn(FieldSaturation, vs...))
+}
+
+// SaturationGT applies the GT predicate on the "saturation" field.
+func SaturationGT(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldGT(FieldSaturation, v))
+}
+
+// SaturationGTE applies the GTE predicate on the "saturation" field.
+func SaturationGTE(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldGTE(FieldSaturation, v))
+}
+
+// SaturationLT applies the LT predicate on the "saturation" field.
+func SaturationLT(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldLT(FieldSaturation, v))
+}
+
+// SaturationLTE applies the LTE predicate on the "saturation" field.
+func SaturationLTE(v int) predicate.Vital {
+	return predicate.Vital(sql.FieldLTE(FieldSaturation, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "createdAt" field.
+func CreatedAtEQ(v time.Time) predicate.Vital {
+	return predicate.Vital(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "createdAt" field.
+func CreatedAtNEQ(v time.Time) predicate.Vital {
+	return predicate.Vital(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "createdAt" field.
+func CreatedAtIn(vs ...time.Time) predicate.Vital {
+	return predicate.Vital(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "createdAt" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Vital {
+	return predicate.Vital(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "createdAt" field.
+func CreatedAtGT(v time.Time) predicate.Vital {
+	return predicate.Vital(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "createdAt" field.
+func CreatedAtGTE(v time.Time) predicate.Vital {
+	return predicate.Vital(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "createdAt" field.
+func CreatedAtLT(v time.Time) predicate.Vital {
+	return predicate.Vital(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "createdAt" field.
+func CreatedAtLTE(v time.Time) predicate.Vital {
+	return predicate.Vital(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Vital) predicate.Vital {
+	return predicate.Vital(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Vital) predicate.Vital {
+	return predicate.Vital(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Vital) predicate.Vital {
+	return predicate.Vital(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,318 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hospital/internal/modules/db/ent/vital"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// VitalCreate is the builder for creating a Vital entity.
+type VitalCreate struct {
+	config
+	mutation *VitalMutation
+	hooks    []Hook
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (vc *VitalCreate) SetOrganizationId(i int) *VitalCreate {
+	vc.mutation.SetOrganizationId(i)
+	return vc
+}
+
+// SetNillableOrganizationId sets the "organizationId" field if the given value is not nil.
+func (vc *VitalCreate) SetNillableOrganizationId(i *int) *VitalCreate {
+	if i != nil {
+		vc.SetOrganizationId(*i)
+	}
+	return vc
+}
+
+// SetPatientId sets the "patientId" field.
+func (vc *VitalCreate) SetPatientId(i int) *VitalCreate {
+	vc.mutation.SetPatientId(i)
+	return vc
+}
+
+// SetAuthorId sets the "authorId" field.
+func (vc *VitalCreate) SetAuthorId(i int) *VitalCreate {
+	vc.mutation.SetAuthorId(i)
+	return vc
+}
+
+// SetNillableAuthorId sets the "authorId" field if the given value is not nil.
+func (vc *VitalCreate) SetNillableAuthorId(i *int) *VitalCreate {
+	if i != nil {
+		vc.SetAuthorId(*i)
+	}
+	return vc
+}
+
+// SetPulse sets the "pulse" field.
+func (vc *VitalCreate) SetPulse(i int) *VitalCreate {
+	vc.mutation.SetPulse(i)
+	return vc
+}
+
+// SetSystolic sets the "systolic" field.
+func (vc *VitalCreate) SetSystolic(i int) *VitalCreate {
+	vc.mutation.SetSystolic(i)
+	return vc
+}
+
+// SetDiastolic sets the "diastolic" field.
+func (vc *VitalCreate) SetDiastolic(i int) *VitalCreate {
+	vc.mutation.SetDiastolic(i)
+	return vc
+}
+
+// SetTemperature sets the "temperature" field.
+func (vc *VitalCreate) SetTemperature(f float64) *VitalCreate {
+	vc.mutation.SetTemperature(f)
+	return vc
+}
+
+// SetSaturation sets the "saturation" field.
+func (vc *VitalCreate) SetSaturation(i int) *VitalCreate {
+	vc.mutation.SetSaturation(i)
+	return vc
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (vc *VitalCreate) SetCreatedAt(t time.Time) *VitalCreate {
+	vc.mutation.SetCreatedAt(t)
+	return vc
+}
+
+// SetNillableCreatedAt sets the "createdAt" field if the given value is not nil.
+func (vc *VitalCreate) SetNillableCreatedAt(t *time.Time) *VitalCreate {
+	if t != nil {
+		vc.SetCreatedAt(*t)
+	}
+	return vc
+}
+
+// Mutation returns the VitalMutation object of the builder.
+func (vc *VitalCreate) Mutation() *VitalMutation {
+	return vc.mutation
+}
+
+// Save creates the Vital in the database.
+func (vc *VitalCreate) Save(ctx context.Context) (*Vital, error) {
+	if err := vc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks[*Vital, VitalMutation](ctx, vc.sqlSave, vc.mutation, vc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (vc *VitalCreate) SaveX(ctx context.Context) *Vital {
+	v, err := vc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vc *VitalCreate) Exec(ctx context.Context) error {
+	_, err := vc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vc *VitalCreate) ExecX(ctx context.Context) {
+	if err := vc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vc *VitalCreate) defaults() error {
+	if _, ok := vc.mutation.CreatedAt(); !ok {
+		if vital.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized vital.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := vital.DefaultCreatedAt()
+		vc.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vc *VitalCreate) check() error {
+	if _, ok := vc.mutation.PatientId(); !ok {
+		return &ValidationError{Name: "patientId", err: errors.New(`ent: missing required field "Vital.patientId"`)}
+	}
+	if _, ok := vc.mutation.Pulse(); !ok {
+		return &ValidationError{Name: "pulse", err: errors.New(`ent: missing required field "Vital.pulse"`)}
+	}
+	if _, ok := vc.mutation.Systolic(); !ok {
+		return &ValidationError{Name: "systolic", err: errors.New(`ent: missing required field "Vital.systolic"`)}
+	}
+	if _, ok := vc.mutation.Diastolic(); !ok {
+		return &ValidationError{Name: "diastolic", err: errors.New(`ent: missing required field "Vital.diastolic"`)}
+	}
+	if _, ok := vc.mutation.Temperature(); !ok {
+		return &ValidationError{Name: "temperature", err: errors.New(`ent: missing required field "Vital.temperature"`)}
+	}
+	if _, ok := vc.mutation.Saturation(); !ok {
+		return &ValidationError{Name: "saturation", err: errors.New(`ent: missing required field "Vital.saturation"`)}
+	}
+	if _, ok := vc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "createdAt", err: errors.New(`ent: missing required field "Vital.createdAt"`)}
+	}
+	return nil
+}
+
+func (vc *VitalCreate) sqlSave(ctx context.Context) (*Vital, error) {
+	if err := vc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := vc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, vc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	vc.mutation.id = &_node.ID
+	vc.mutation.done = true
+	return _node, nil
+}
+
+func (vc *VitalCreate) createSpec() (*Vital, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Vital{config: vc.config}
+		_spec = sqlgraph.NewCreateSpec(vital.Table, sqlgraph.NewFieldSpec(vital.FieldID, field.TypeInt))
+	)
+	if value, ok := vc.mutation.OrganizationId(); ok {
+		_spec.SetField(vital.FieldOrganizationId, field.TypeInt, value)
+		_node.OrganizationId = value
+	}
+	if value, ok := vc.mutation.PatientId(); ok {
+		_spec.SetField(vital.FieldPatientId, field.TypeInt, value)
+		_node.PatientId = value
+	}
+	if value, ok := vc.mutation.AuthorId(); ok {
+		_spec.SetField(vital.FieldAuthorId, field.TypeInt, value)
+		_node.AuthorId = &value
+	}
+	if value, ok := vc.mutation.Pulse(); ok {
+		_spec.SetField(vital.FieldPulse, field.TypeInt, value)
+		_node.Pulse = value
+	}
+	if value, ok := vc.mutation.Systolic(); ok {
+		_spec.SetField(vital.FieldSystolic, field.TypeInt, value)
+		_node.Systolic = value
+	}
+	if value, ok := vc.mutation.Diastolic(); ok {
+		_spec.SetField(vital.FieldDiastolic, field.TypeInt, value)
+		_node.Diastolic = value
+	}
+	if value, ok := vc.mutation.Temperature(); ok {
+		_spec.SetField(vital.FieldTemperature, field.TypeFloat64, value)
+		_node.Temperature = value
+	}
+	if value, ok := vc.mutation.Saturation(); ok {
+		_spec.SetField(vital.FieldSaturation, field.TypeInt, value)
+		_node.Saturation = value
+	}
+	if value, ok := vc.mutation.CreatedAt(); ok {
+		_spec.SetField(vital.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// VitalCreateBulk is the builder for creating many Vital entities in bulk.
+type VitalCreateBulk struct {
+	config
+	builders []*VitalCreate
+}
+
+// Save creates the Vital entities in the database.
+func (vcb *VitalCreateBulk) Save(ctx context.Context) ([]*Vital, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(vcb.builders))
+	nodes := make([]*Vital, len(vcb.builders))
+	mutators := make([]Mutator, len(vcb.builders))
+	for i := range vcb.builders {
+		func(i int, root context.Context) {
+			builder := vcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VitalMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, vcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, vcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, vcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vcb *VitalCreateBulk) SaveX(ctx context.Context) []*Vital {
+	v, err := vcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vcb *VitalCreateBulk) Exec(ctx context.Context) error {
+	_, err := vcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vcb *VitalCreateBulk) ExecX(ctx context.Context) {
+	if err := vcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"hospital/internal/modules/db/ent/predicate"
+	"hospital/internal/modules/db/ent/vital"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// VitalDelete is the builder for deleting a Vital entity.
+type VitalDelete struct {
+	config
+	hooks    []Hook
+	mutation *VitalMutation
+}
+
+// Where appends a list predicates to the VitalDelete builder.
+func (vd *VitalDelete) Where(ps ...predicate.Vital) *VitalDelete {
+	vd.mutation.Where(ps...)
+	return vd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (vd *VitalDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, VitalMutation](ctx, vd.sqlExec, vd.mutation, vd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vd *VitalDelete) ExecX(ctx context.Context) int {
+	n, err := vd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (vd *VitalDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(vital.Table, sqlgraph.NewFieldSpec(vital.FieldID, field.TypeInt))
+	if ps := vd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, vd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	vd.mutation.done = true
+	return affected, err
+}
+
+// VitalDeleteOne is the builder for deleting a single Vital entity.
+type VitalDeleteOne struct {
+	vd *VitalDelete
+}
+
+// Where appends a list predicates to the VitalDelete builder.
+func (vdo *VitalDeleteOne) Where(ps ...predicate.Vital) *VitalDeleteOne {
+	vdo.vd.mutation.Where(ps...)
+	return vdo
+}
+
+// Exec executes the deletion query.
+func (vdo *VitalDeleteOne) Exec(ctx context.Context) error {
+	n, err := vdo.vd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{vital.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vdo *VitalDeleteOne) ExecX(ctx context.Context) {
+	if err := vdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
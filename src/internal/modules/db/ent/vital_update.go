@@ -0,0 +1,187 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hospital/internal/modules/db/ent/predicate"
+	"hospital/internal/modules/db/ent/vital"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// VitalUpdate is the builder for updating Vital entities.
+type VitalUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VitalMutation
+}
+
+// Where appends a list predicates to the VitalUpdate builder.
+func (vu *VitalUpdate) Where(ps ...predicate.Vital) *VitalUpdate {
+	vu.mutation.Where(ps...)
+	return vu
+}
+
+// Mutation returns the VitalMutation object of the builder.
+func (vu *VitalUpdate) Mutation() *VitalMutation {
+	return vu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (vu *VitalUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks[int, VitalMutation](ctx, vu.sqlSave, vu.mutation, vu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vu *VitalUpdate) SaveX(ctx context.Context) int {
+	affected, err := vu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (vu *VitalUpdate) Exec(ctx context.Context) error {
+	_, err := vu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vu *VitalUpdate) ExecX(ctx context.Context) {
+	if err := vu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (vu *VitalUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(vital.Table, vital.Columns, sqlgraph.NewFieldSpec(vital.FieldID, field.TypeInt))
+	if ps := vu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if vu.mutation.OrganizationIdCleared() {
+		_spec.ClearField(vital.FieldOrganizationId, field.TypeInt)
+	}
+	if vu.mutation.AuthorIdCleared() {
+		_spec.ClearField(vital.FieldAuthorId, field.TypeInt)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, vu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vital.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	vu.mutation.done = true
+	return n, nil
+}
+
+// VitalUpdateOne is the builder for updating a single Vital entity.
+type VitalUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VitalMutation
+}
+
+// Mutation returns the VitalMutation object of the builder.
+func (vuo *VitalUpdateOne) Mutation() *VitalMutation {
+	return vuo.mutation
+}
+
+// Where appends a list predicates to the VitalUpdate builder.
+func (vuo *VitalUpdateOne) Where(ps ...predicate.Vital) *VitalUpdateOne {
+	vuo.mutation.Where(ps...)
+	return vuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (vuo *VitalUpdateOne) Select(field string, fields ...string) *VitalUpdateOne {
+	vuo.fields = append([]string{field}, fields...)
+	return vuo
+}
+
+// Save executes the query and returns the updated Vital entity.
+func (vuo *VitalUpdateOne) Save(ctx context.Context) (*Vital, error) {
+	return withHooks[*Vital, VitalMutation](ctx, vuo.sqlSave, vuo.mutation, vuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vuo *VitalUpdateOne) SaveX(ctx context.Context) *Vital {
+	node, err := vuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (vuo *VitalUpdateOne) Exec(ctx context.Context) error {
+	_, err := vuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vuo *VitalUpdateOne) ExecX(ctx context.Context) {
+	if err := vuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (vuo *VitalUpdateOne) sqlSave(ctx context.Context) (_node *Vital, err error) {
+	_spec := sqlgraph.NewUpdateSpec(vital.Table, vital.Columns, sqlgraph.NewFieldSpec(vital.FieldID, field.TypeInt))
+	id, ok := vuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Vital.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := vuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vital.FieldID)
+		for _, f := range fields {
+			if !vital.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != vital.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := vuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if vuo.mutation.OrganizationIdCleared() {
+		_spec.ClearField(vital.FieldOrganizationId, field.TypeInt)
+	}
+	if vuo.mutation.AuthorIdCleared() {
+		_spec.ClearField(vital.FieldAuthorId, field.TypeInt)
+	}
+	_node = &Vital{config: vuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, vuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vital.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	vuo.mutation.done = true
+	return _node, nil
+}
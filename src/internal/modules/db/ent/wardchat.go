@@ -0,0 +1,191 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"hospital/internal/modules/db/ent/wardchat"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// WardChat is the model entity for the WardChat schema.
+type WardChat struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// OrganizationId holds the value of the "organizationId" field.
+	OrganizationId int `json:"organizationId,omitempty"`
+	// ChatId holds the value of the "chatId" field.
+	ChatId int64 `json:"chatId,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// DepartmentId holds the value of the "departmentId" field.
+	DepartmentId *int `json:"departmentId,omitempty"`
+	// Rooms holds the value of the "rooms" field.
+	Rooms []int `json:"rooms,omitempty"`
+	// Language holds the value of the "language" field.
+	Language string `json:"language,omitempty"`
+	// CreatedBy holds the value of the "createdBy" field.
+	CreatedBy int `json:"createdBy,omitempty"`
+	// CreatedAt holds the value of the "createdAt" field.
+	CreatedAt    time.Time `json:"createdAt,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WardChat) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case wardchat.FieldRooms:
+			values[i] = new([]byte)
+		case wardchat.FieldID, wardchat.FieldOrganizationId, wardchat.FieldChatId, wardchat.FieldDepartmentId, wardchat.FieldCreatedBy:
+			values[i] = new(sql.NullInt64)
+		case wardchat.FieldTitle, wardchat.FieldLanguage:
+			values[i] = new(sql.NullString)
+		case wardchat.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WardChat fields.
+func (wc *WardChat) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case wardchat.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			wc.ID = int(value.Int64)
+		case wardchat.FieldOrganizationId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field organizationId", values[i])
+			} else if value.Valid {
+				wc.OrganizationId = int(value.Int64)
+			}
+		case wardchat.FieldChatId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field chatId", values[i])
+			} else if value.Valid {
+				wc.ChatId = value.Int64
+			}
+		case wardchat.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				wc.Title = value.String
+			}
+		case wardchat.FieldDepartmentId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field departmentId", values[i])
+			} else if value.Valid {
+				wc.DepartmentId = new(int)
+				*wc.DepartmentId = int(value.Int64)
+			}
+		case wardchat.FieldRooms:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rooms", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &wc.Rooms); err != nil {
+					return fmt.Errorf("unmarshal field rooms: %w", err)
+				}
+			}
+		case wardchat.FieldLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field language", values[i])
+			} else if value.Valid {
+				wc.Language = value.String
+			}
+		case wardchat.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field createdBy", values[i])
+			} else if value.Valid {
+				wc.CreatedBy = int(value.Int64)
+			}
+		case wardchat.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field createdAt", values[i])
+			} else if value.Valid {
+				wc.CreatedAt = value.Time
+			}
+		default:
+			wc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WardChat.
+// This includes values selected through modifiers, order, etc.
+func (wc *WardChat) Value(name string) (ent.Value, error) {
+	return wc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WardChat.
+// Note that you need to call WardChat.Unwrap() before calling this method if this WardChat
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wc *WardChat) Update() *WardChatUpdateOne {
+	return NewWardChatClient(wc.config).UpdateOne(wc)
+}
+
+// Unwrap unwraps the WardChat entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wc *WardChat) Unwrap() *WardChat {
+	_tx, ok := wc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WardChat is not a transactional entity")
+	}
+	wc.config.driver = _tx.drv
+	return wc
+}
+
+// String implements the fmt.Stringer.
+func (wc *WardChat) String() string {
+	var builder strings.Builder
+	builder.WriteString("WardChat(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wc.ID))
+	builder.WriteString("organizationId=")
+	builder.WriteString(fmt.Sprintf("%v", wc.OrganizationId))
+	builder.WriteString(", ")
+	builder.WriteString("chatId=")
+	builder.WriteString(fmt.Sprintf("%v", wc.ChatId))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(wc.Title)
+	builder.WriteString(", ")
+	if v := wc.DepartmentId; v != nil {
+		builder.WriteString("departmentId=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("rooms=")
+	builder.WriteString(fmt.Sprintf("%v", wc.Rooms))
+	builder.WriteString(", ")
+	builder.WriteString("language=")
+	builder.WriteString(wc.Language)
+	builder.WriteString(", ")
+	builder.WriteString("createdBy=")
+	builder.WriteString(fmt.Sprintf("%v", wc.CreatedBy))
+	builder.WriteString(", ")
+	builder.WriteString("createdAt=")
+	builder.WriteString(wc.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WardChats is a parsable slice of WardChat.
+type WardChats []*WardChat
@@ -0,0 +1,113 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wardchat
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the wardchat type in the database.
+	Label = "ward_chat"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOrganizationId holds the string denoting the organizationid field in the database.
+	FieldOrganizationId = "organization_id"
+	// FieldChatId holds the string denoting the chatid field in the database.
+	FieldChatId = "chat_id"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldDepartmentId holds the string denoting the departmentid field in the database.
+	FieldDepartmentId = "department_id"
+	// FieldRooms holds the string denoting the rooms field in the database.
+	FieldRooms = "rooms"
+	// FieldLanguage holds the string denoting the language field in the database.
+	FieldLanguage = "language"
+	// FieldCreatedBy holds the string denoting the createdby field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldCreatedAt holds the string denoting the createdat field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the wardchat in the database.
+	Table = "ward_chats"
+)
+
+// Columns holds all SQL columns for wardchat fields.
+var Columns = []string{
+	FieldID,
+	FieldOrganizationId,
+	FieldChatId,
+	FieldTitle,
+	FieldDepartmentId,
+	FieldRooms,
+	FieldLanguage,
+	FieldCreatedBy,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "hospital/internal/modules/db/ent/runtime"
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+	// DefaultCreatedAt holds the default value on creation for the "createdAt" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Order defines the ordering method for the WardChat queries.
+type Order func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByOrganizationId orders the results by the organizationId field.
+func ByOrganizationId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldOrganizationId, opts...).ToFunc()
+}
+
+// ByChatId orders the results by the chatId field.
+func ByChatId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldChatId, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByDepartmentId orders the results by the departmentId field.
+func ByDepartmentId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldDepartmentId, opts...).ToFunc()
+}
+
+// ByLanguage orders the results by the language field.
+func ByLanguage(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldLanguage, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the createdBy field.
+func ByCreatedBy(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the createdAt field.
+func ByCreatedAt(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,502 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wardchat
+
+import (
+	"hospital/internal/modules/db/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldLTE(FieldID, id))
+}
+
+// OrganizationId applies equality check predicate on the "organizationId" field. It's identical to OrganizationIdEQ.
+func OrganizationId(v int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldEQ(FieldOrganizationId, v))
+}
+
+// ChatId applies equality check predicate on the "chatId" field. It's identical to ChatIdEQ.
+func ChatId(v int64) predicate.WardChat {
+	return predicate.WardChat(sql.FieldEQ(FieldChatId, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldEQ(FieldTitle, v))
+}
+
+// DepartmentId applies equality check predicate on the "departmentId" field. It's identical to DepartmentIdEQ.
+func DepartmentId(v int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldEQ(FieldDepartmentId, v))
+}
+
+// Language applies equality check predicate on the "language" field. It's identical to LanguageEQ.
+func Language(v string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldEQ(FieldLanguage, v))
+}
+
+// CreatedBy applies equality check predicate on the "createdBy" field. It's identical to CreatedByEQ.
+func CreatedBy(v int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "createdAt" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WardChat {
+	return predicate.WardChat(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// OrganizationIdEQ applies the EQ predicate on the "organizationId" field.
+func OrganizationIdEQ(v int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldEQ(FieldOrganizationId, v))
+}
+
+// OrganizationIdNEQ applies the NEQ predicate on the "organizationId" field.
+func OrganizationIdNEQ(v int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldNEQ(FieldOrganizationId, v))
+}
+
+// OrganizationIdIn applies the In predicate on the "organizationId" field.
+func OrganizationIdIn(vs ...int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldIn(FieldOrganizationId, vs...))
+}
+
+// OrganizationIdNotIn applies the NotIn predicate on the "organizationId" field.
+func OrganizationIdNotIn(vs ...int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldNotIn(FieldOrganizationId, vs...))
+}
+
+// OrganizationIdGT applies the GT predicate on the "organizationId" field.
+func OrganizationIdGT(v int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldGT(FieldOrganizationId, v))
+}
+
+// OrganizationIdGTE applies the GTE predicate on the "organizationId" field.
+func OrganizationIdGTE(v int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldGTE(FieldOrganizationId, v))
+}
+
+// OrganizationIdLT applies the LT predicate on the "organizationId" field.
+func OrganizationIdLT(v int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldLT(FieldOrganizationId, v))
+}
+
+// OrganizationIdLTE applies the LTE predicate on the "organizationId" field.
+func OrganizationIdLTE(v int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldLTE(FieldOrganizationId, v))
+}
+
+// OrganizationIdIsNil applies the IsNil predicate on the "organizationId" field.
+func OrganizationIdIsNil() predicate.WardChat {
+	return predicate.WardChat(sql.FieldIsNull(FieldOrganizationId))
+}
+
+// OrganizationIdNotNil applies the NotNil predicate on the "organizationId" field.
+func OrganizationIdNotNil() predicate.WardChat {
+	return predicate.WardChat(sql.FieldNotNull(FieldOrganizationId))
+}
+
+// ChatIdEQ applies the EQ predicate on the "chatId" field.
+func ChatIdEQ(v int64) predicate.WardChat {
+	return predicate.WardChat(sql.FieldEQ(FieldChatId, v))
+}
+
+// ChatIdNEQ applies the NEQ predicate on the "chatId" field.
+func ChatIdNEQ(v int64) predicate.WardChat {
+	return predicate.WardChat(sql.FieldNEQ(FieldChatId, v))
+}
+
+// ChatIdIn applies the In predicate on the "chatId" field.
+func ChatIdIn(vs ...int64) predicate.WardChat {
+	return predicate.WardChat(sql.FieldIn(FieldChatId, vs...))
+}
+
+// ChatIdNotIn applies the NotIn predicate on the "chatId" field.
+func ChatIdNotIn(vs ...int64) predicate.WardChat {
+	return predicate.WardChat(sql.FieldNotIn(FieldChatId, vs...))
+}
+
+// ChatIdGT applies the GT predicate on the "chatId" field.
+func ChatIdGT(v int64) predicate.WardChat {
+	return predicate.WardChat(sql.FieldGT(FieldChatId, v))
+}
+
+// ChatIdGTE applies the GTE predicate on the "chatId" field.
+func ChatIdGTE(v int64) predicate.WardChat {
+	return predicate.WardChat(sql.FieldGTE(FieldChatId, v))
+}
+
+// ChatIdLT applies the LT predicate on the "chatId" field.
+func ChatIdLT(v int64) predicate.WardChat {
+	return predicate.WardChat(sql.FieldLT(FieldChatId, v))
+}
+
+// ChatIdLTE applies the LTE predicate on the "chatId" field.
+func ChatIdLTE(v int64) predicate.WardChat {
+	return predicate.WardChat(sql.FieldLTE(FieldChatId, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleIsNil applies the IsNil predicate on the "title" field.
+func TitleIsNil() predicate.WardChat {
+	return predicate.WardChat(sql.FieldIsNull(FieldTitle))
+}
+
+// TitleNotNil applies the NotNil predicate on the "title" field.
+func TitleNotNil() predicate.WardChat {
+	return predicate.WardChat(sql.FieldNotNull(FieldTitle))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// DepartmentIdEQ applies the EQ predicate on the "departmentId" field.
+func DepartmentIdEQ(v int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldEQ(FieldDepartmentId, v))
+}
+
+// DepartmentIdNEQ applies the NEQ predicate on the "departmentId" field.
+func DepartmentIdNEQ(v int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldNEQ(FieldDepartmentId, v))
+}
+
+// DepartmentIdIn applies the In predicate on the "departmentId" field.
+func DepartmentIdIn(vs ...int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldIn(FieldDepartmentId, vs...))
+}
+
+// DepartmentIdNotIn applies the NotIn predicate on the "departmentId" field.
+func DepartmentIdNotIn(vs ...int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldNotIn(FieldDepartmentId, vs...))
+}
+
+// DepartmentIdGT applies the GT predicate on the "departmentId" field.
+func DepartmentIdGT(v int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldGT(FieldDepartmentId, v))
+}
+
+// DepartmentIdGTE applies the GTE predicate on the "departmentId" field.
+func DepartmentIdGTE(v int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldGTE(FieldDepartmentId, v))
+}
+
+// DepartmentIdLT applies the LT predicate on the "departmentId" field.
+func DepartmentIdLT(v int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldLT(FieldDepartmentId, v))
+}
+
+// DepartmentIdLTE applies the LTE predicate on the "departmentId" field.
+func DepartmentIdLTE(v int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldLTE(FieldDepartmentId, v))
+}
+
+// DepartmentIdIsNil applies the IsNil predicate on the "departmentId" field.
+func DepartmentIdIsNil() predicate.WardChat {
+	return predicate.WardChat(sql.FieldIsNull(FieldDepartmentId))
+}
+
+// DepartmentIdNotNil applies the NotNil predicate on the "departmentId" field.
+func DepartmentIdNotNil() predicate.WardChat {
+	return predicate.WardChat(sql.FieldNotNull(FieldDepartmentId))
+}
+
+// RoomsIsNil applies the IsNil predicate on the "rooms" field.
+func RoomsIsNil() predicate.WardChat {
+	return predicate.WardChat(sql.FieldIsNull(FieldRooms))
+}
+
+// RoomsNotNil applies the NotNil predicate on the "rooms" field.
+func RoomsNotNil() predicate.WardChat {
+	return predicate.WardChat(sql.FieldNotNull(FieldRooms))
+}
+
+// LanguageEQ applies the EQ predicate on the "language" field.
+func LanguageEQ(v string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldEQ(FieldLanguage, v))
+}
+
+// LanguageNEQ applies the NEQ predicate on the "language" field.
+func LanguageNEQ(v string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldNEQ(FieldLanguage, v))
+}
+
+// LanguageIn applies the In predicate on the "language" field.
+func LanguageIn(vs ...string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldIn(FieldLanguage, vs...))
+}
+
+// LanguageNotIn applies the NotIn predicate on the "language" field.
+func LanguageNotIn(vs ...string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldNotIn(FieldLanguage, vs...))
+}
+
+// LanguageGT applies the GT predicate on the "language" field.
+func LanguageGT(v string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldGT(FieldLanguage, v))
+}
+
+// LanguageGTE applies the GTE predicate on the "language" field.
+func LanguageGTE(v string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldGTE(FieldLanguage, v))
+}
+
+// LanguageLT applies the LT predicate on the "language" field.
+func LanguageLT(v string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldLT(FieldLanguage, v))
+}
+
+// LanguageLTE applies the LTE predicate on the "language" field.
+func LanguageLTE(v string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldLTE(FieldLanguage, v))
+}
+
+// LanguageContains applies the Contains predicate on the "language" field.
+func LanguageContains(v string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldContains(FieldLanguage, v))
+}
+
+// LanguageHasPrefix applies the HasPrefix predicate on the "language" field.
+func LanguageHasPrefix(v string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldHasPrefix(FieldLanguage, v))
+}
+
+// LanguageHasSuffix applies the HasSuffix predicate on the "language" field.
+func LanguageHasSuffix(v string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldHasSuffix(FieldLanguage, v))
+}
+
+// LanguageIsNil applies the IsNil predicate on the "language" field.
+func LanguageIsNil() predicate.WardChat {
+	return predicate.WardChat(sql.FieldIsNull(FieldLanguage))
+}
+
+// LanguageNotNil applies the NotNil predicate on the "language" field.
+func LanguageNotNil() predicate.WardChat {
+	return predicate.WardChat(sql.FieldNotNull(FieldLanguage))
+}
+
+// LanguageEqualFold applies the EqualFold predicate on the "language" field.
+func LanguageEqualFold(v string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldEqualFold(FieldLanguage, v))
+}
+
+// LanguageContainsFold applies the ContainsFold predicate on the "language" field.
+func LanguageContainsFold(v string) predicate.WardChat {
+	return predicate.WardChat(sql.FieldContainsFold(FieldLanguage, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "createdBy" field.
+func CreatedByEQ(v int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "createdBy" field.
+func CreatedByNEQ(v int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "createdBy" field.
+func CreatedByIn(vs ...int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "createdBy" field.
+func CreatedByNotIn(vs ...int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "createdBy" field.
+func CreatedByGT(v int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "createdBy" field.
+func CreatedByGTE(v int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "createdBy" field.
+func CreatedByLT(v int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "createdBy" field.
+func CreatedByLTE(v int) predicate.WardChat {
+	return predicate.WardChat(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "createdAt" field.
+func CreatedAtEQ(v time.Time) predicate.WardChat {
+	return predicate.WardChat(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "createdAt" field.
+func CreatedAtNEQ(v time.Time) predicate.WardChat {
+	return predicate.WardChat(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "createdAt" field.
+func CreatedAtIn(vs ...time.Time) predicate.WardChat {
+	return predicate.WardChat(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "createdAt" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WardChat {
+	return predicate.WardChat(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "createdAt" field.
+func CreatedAtGT(v time.Time) predicate.WardChat {
+	return predicate.WardChat(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "createdAt" field.
+func CreatedAtGTE(v time.Time) predicate.WardChat {
+	return predicate.WardChat(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "createdAt" field.
+func CreatedAtLT(v time.Time) predicate.WardChat {
+	return predicate.WardChat(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "createdAt" field.
+func CreatedAtLTE(v time.Time) predicate.WardChat {
+	return predicate.WardChat(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WardChat) predicate.WardChat {
+	return predicate.WardChat(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WardChat) predicate.WardChat {
+	return predicate.WardChat(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WardChat) predicate.WardChat {
+	return predicate.WardChat(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,312 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hospital/internal/modules/db/ent/wardchat"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WardChatCreate is the builder for creating a WardChat entity.
+type WardChatCreate struct {
+	config
+	mutation *WardChatMutation
+	hooks    []Hook
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (wcc *WardChatCreate) SetOrganizationId(i int) *WardChatCreate {
+	wcc.mutation.SetOrganizationId(i)
+	return wcc
+}
+
+// SetNillableOrganizationId sets the "organizationId" field if the given value is not nil.
+func (wcc *WardChatCreate) SetNillableOrganizationId(i *int) *WardChatCreate {
+	if i != nil {
+		wcc.SetOrganizationId(*i)
+	}
+	return wcc
+}
+
+// SetChatId sets the "chatId" field.
+func (wcc *WardChatCreate) SetChatId(i int64) *WardChatCreate {
+	wcc.mutation.SetChatId(i)
+	return wcc
+}
+
+// SetTitle sets the "title" field.
+func (wcc *WardChatCreate) SetTitle(s string) *WardChatCreate {
+	wcc.mutation.SetTitle(s)
+	return wcc
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (wcc *WardChatCreate) SetNillableTitle(s *string) *WardChatCreate {
+	if s != nil {
+		wcc.SetTitle(*s)
+	}
+	return wcc
+}
+
+// SetDepartmentId sets the "departmentId" field.
+func (wcc *WardChatCreate) SetDepartmentId(i int) *WardChatCreate {
+	wcc.mutation.SetDepartmentId(i)
+	return wcc
+}
+
+// SetNillableDepartmentId sets the "departmentId" field if the given value is not nil.
+func (wcc *WardChatCreate) SetNillableDepartmentId(i *int) *WardChatCreate {
+	if i != nil {
+		wcc.SetDepartmentId(*i)
+	}
+	return wcc
+}
+
+// SetRooms sets the "rooms" field.
+func (wcc *WardChatCreate) SetRooms(i []int) *WardChatCreate {
+	wcc.mutation.SetRooms(i)
+	return wcc
+}
+
+// SetLanguage sets the "language" field.
+func (wcc *WardChatCreate) SetLanguage(s string) *WardChatCreate {
+	wcc.mutation.SetLanguage(s)
+	return wcc
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (wcc *WardChatCreate) SetNillableLanguage(s *string) *WardChatCreate {
+	if s != nil {
+		wcc.SetLanguage(*s)
+	}
+	return wcc
+}
+
+// SetCreatedBy sets the "createdBy" field.
+func (wcc *WardChatCreate) SetCreatedBy(i int) *WardChatCreate {
+	wcc.mutation.SetCreatedBy(i)
+	return wcc
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (wcc *WardChatCreate) SetCreatedAt(t time.Time) *WardChatCreate {
+	wcc.mutation.SetCreatedAt(t)
+	return wcc
+}
+
+// SetNillableCreatedAt sets the "createdAt" field if the given value is not nil.
+func (wcc *WardChatCreate) SetNillableCreatedAt(t *time.Time) *WardChatCreate {
+	if t != nil {
+		wcc.SetCreatedAt(*t)
+	}
+	return wcc
+}
+
+// Mutation returns the WardChatMutation object of the builder.
+func (wcc *WardChatCreate) Mutation() *WardChatMutation {
+	return wcc.mutation
+}
+
+// Save creates the WardChat in the database.
+func (wcc *WardChatCreate) Save(ctx context.Context) (*WardChat, error) {
+	if err := wcc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks[*WardChat, WardChatMutation](ctx, wcc.sqlSave, wcc.mutation, wcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wcc *WardChatCreate) SaveX(ctx context.Context) *WardChat {
+	v, err := wcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wcc *WardChatCreate) Exec(ctx context.Context) error {
+	_, err := wcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcc *WardChatCreate) ExecX(ctx context.Context) {
+	if err := wcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wcc *WardChatCreate) defaults() error {
+	if _, ok := wcc.mutation.CreatedAt(); !ok {
+		if wardchat.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized wardchat.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := wardchat.DefaultCreatedAt()
+		wcc.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wcc *WardChatCreate) check() error {
+	if _, ok := wcc.mutation.ChatId(); !ok {
+		return &ValidationError{Name: "chatId", err: errors.New(`ent: missing required field "WardChat.chatId"`)}
+	}
+	if _, ok := wcc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "createdBy", err: errors.New(`ent: missing required field "WardChat.createdBy"`)}
+	}
+	if _, ok := wcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "createdAt", err: errors.New(`ent: missing required field "WardChat.createdAt"`)}
+	}
+	return nil
+}
+
+func (wcc *WardChatCreate) sqlSave(ctx context.Context) (*WardChat, error) {
+	if err := wcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	wcc.mutation.id = &_node.ID
+	wcc.mutation.done = true
+	return _node, nil
+}
+
+func (wcc *WardChatCreate) createSpec() (*WardChat, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WardChat{config: wcc.config}
+		_spec = sqlgraph.NewCreateSpec(wardchat.Table, sqlgraph.NewFieldSpec(wardchat.FieldID, field.TypeInt))
+	)
+	if value, ok := wcc.mutation.OrganizationId(); ok {
+		_spec.SetField(wardchat.FieldOrganizationId, field.TypeInt, value)
+		_node.OrganizationId = value
+	}
+	if value, ok := wcc.mutation.ChatId(); ok {
+		_spec.SetField(wardchat.FieldChatId, field.TypeInt64, value)
+		_node.ChatId = value
+	}
+	if value, ok := wcc.mutation.Title(); ok {
+		_spec.SetField(wardchat.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := wcc.mutation.DepartmentId(); ok {
+		_spec.SetField(wardchat.FieldDepartmentId, field.TypeInt, value)
+		_node.DepartmentId = &value
+	}
+	if value, ok := wcc.mutation.Rooms(); ok {
+		_spec.SetField(wardchat.FieldRooms, field.TypeJSON, value)
+		_node.Rooms = value
+	}
+	if value, ok := wcc.mutation.Language(); ok {
+		_spec.SetField(wardchat.FieldLanguage, field.TypeString, value)
+		_node.Language = value
+	}
+	if value, ok := wcc.mutation.CreatedBy(); ok {
+		_spec.SetField(wardchat.FieldCreatedBy, field.TypeInt, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := wcc.mutation.CreatedAt(); ok {
+		_spec.SetField(wardchat.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// WardChatCreateBulk is the builder for creating many WardChat entities in bulk.
+type WardChatCreateBulk struct {
+	config
+	builders []*WardChatCreate
+}
+
+// Save creates the WardChat entities in the database.
+func (wccb *WardChatCreateBulk) Save(ctx context.Context) ([]*WardChat, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(wccb.builders))
+	nodes := make([]*WardChat, len(wccb.builders))
+	mutators := make([]Mutator, len(wccb.builders))
+	for i := range wccb.builders {
+		func(i int, root context.Context) {
+			builder := wccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WardChatMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wccb *WardChatCreateBulk) SaveX(ctx context.Context) []*WardChat {
+	v, err := wccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wccb *WardChatCreateBulk) Exec(ctx context.Context) error {
+	_, err := wccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wccb *WardChatCreateBulk) ExecX(ctx context.Context) {
+	if err := wccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
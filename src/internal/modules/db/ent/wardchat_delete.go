@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"hospital/internal/modules/db/ent/predicate"
+	"hospital/internal/modules/db/ent/wardchat"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WardChatDelete is the builder for deleting a WardChat entity.
+type WardChatDelete struct {
+	config
+	hooks    []Hook
+	mutation *WardChatMutation
+}
+
+// Where appends a list predicates to the WardChatDelete builder.
+func (wcd *WardChatDelete) Where(ps ...predicate.WardChat) *WardChatDelete {
+	wcd.mutation.Where(ps...)
+	return wcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wcd *WardChatDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, WardChatMutation](ctx, wcd.sqlExec, wcd.mutation, wcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcd *WardChatDelete) ExecX(ctx context.Context) int {
+	n, err := wcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wcd *WardChatDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(wardchat.Table, sqlgraph.NewFieldSpec(wardchat.FieldID, field.TypeInt))
+	if ps := wcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	wcd.mutation.done = true
+	return affected, err
+}
+
+// WardChatDeleteOne is the builder for deleting a single WardChat entity.
+type WardChatDeleteOne struct {
+	wcd *WardChatDelete
+}
+
+// Where appends a list predicates to the WardChatDelete builder.
+func (wcdo *WardChatDeleteOne) Where(ps ...predicate.WardChat) *WardChatDeleteOne {
+	wcdo.wcd.mutation.Where(ps...)
+	return wcdo
+}
+
+// Exec executes the deletion query.
+func (wcdo *WardChatDeleteOne) Exec(ctx context.Context) error {
+	n, err := wcdo.wcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{wardchat.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcdo *WardChatDeleteOne) ExecX(ctx context.Context) {
+	if err := wcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
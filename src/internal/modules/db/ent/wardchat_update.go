@@ -0,0 +1,454 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hospital/internal/modules/db/ent/predicate"
+	"hospital/internal/modules/db/ent/wardchat"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// WardChatUpdate is the builder for updating WardChat entities.
+type WardChatUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WardChatMutation
+}
+
+// Where appends a list predicates to the WardChatUpdate builder.
+func (wcu *WardChatUpdate) Where(ps ...predicate.WardChat) *WardChatUpdate {
+	wcu.mutation.Where(ps...)
+	return wcu
+}
+
+// SetTitle sets the "title" field.
+func (wcu *WardChatUpdate) SetTitle(s string) *WardChatUpdate {
+	wcu.mutation.SetTitle(s)
+	return wcu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (wcu *WardChatUpdate) SetNillableTitle(s *string) *WardChatUpdate {
+	if s != nil {
+		wcu.SetTitle(*s)
+	}
+	return wcu
+}
+
+// ClearTitle clears the value of the "title" field.
+func (wcu *WardChatUpdate) ClearTitle() *WardChatUpdate {
+	wcu.mutation.ClearTitle()
+	return wcu
+}
+
+// SetDepartmentId sets the "departmentId" field.
+func (wcu *WardChatUpdate) SetDepartmentId(i int) *WardChatUpdate {
+	wcu.mutation.ResetDepartmentId()
+	wcu.mutation.SetDepartmentId(i)
+	return wcu
+}
+
+// SetNillableDepartmentId sets the "departmentId" field if the given value is not nil.
+func (wcu *WardChatUpdate) SetNillableDepartmentId(i *int) *WardChatUpdate {
+	if i != nil {
+		wcu.SetDepartmentId(*i)
+	}
+	return wcu
+}
+
+// AddDepartmentId adds i to the "departmentId" field.
+func (wcu *WardChatUpdate) AddDepartmentId(i int) *WardChatUpdate {
+	wcu.mutation.AddDepartmentId(i)
+	return wcu
+}
+
+// ClearDepartmentId clears the value of the "departmentId" field.
+func (wcu *WardChatUpdate) ClearDepartmentId() *WardChatUpdate {
+	wcu.mutation.ClearDepartmentId()
+	return wcu
+}
+
+// SetRooms sets the "rooms" field.
+func (wcu *WardChatUpdate) SetRooms(i []int) *WardChatUpdate {
+	wcu.mutation.SetRooms(i)
+	return wcu
+}
+
+// AppendRooms appends i to the "rooms" field.
+func (wcu *WardChatUpdate) AppendRooms(i []int) *WardChatUpdate {
+	wcu.mutation.AppendRooms(i)
+	return wcu
+}
+
+// ClearRooms clears the value of the "rooms" field.
+func (wcu *WardChatUpdate) ClearRooms() *WardChatUpdate {
+	wcu.mutation.ClearRooms()
+	return wcu
+}
+
+// SetLanguage sets the "language" field.
+func (wcu *WardChatUpdate) SetLanguage(s string) *WardChatUpdate {
+	wcu.mutation.SetLanguage(s)
+	return wcu
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (wcu *WardChatUpdate) SetNillableLanguage(s *string) *WardChatUpdate {
+	if s != nil {
+		wcu.SetLanguage(*s)
+	}
+	return wcu
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (wcu *WardChatUpdate) ClearLanguage() *WardChatUpdate {
+	wcu.mutation.ClearLanguage()
+	return wcu
+}
+
+// SetCreatedBy sets the "createdBy" field.
+func (wcu *WardChatUpdate) SetCreatedBy(i int) *WardChatUpdate {
+	wcu.mutation.ResetCreatedBy()
+	wcu.mutation.SetCreatedBy(i)
+	return wcu
+}
+
+// AddCreatedBy adds i to the "createdBy" field.
+func (wcu *WardChatUpdate) AddCreatedBy(i int) *WardChatUpdate {
+	wcu.mutation.AddCreatedBy(i)
+	return wcu
+}
+
+// Mutation returns the WardChatMutation object of the builder.
+func (wcu *WardChatUpdate) Mutation() *WardChatMutation {
+	return wcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wcu *WardChatUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks[int, WardChatMutation](ctx, wcu.sqlSave, wcu.mutation, wcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wcu *WardChatUpdate) SaveX(ctx context.Context) int {
+	affected, err := wcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wcu *WardChatUpdate) Exec(ctx context.Context) error {
+	_, err := wcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcu *WardChatUpdate) ExecX(ctx context.Context) {
+	if err := wcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wcu *WardChatUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(wardchat.Table, wardchat.Columns, sqlgraph.NewFieldSpec(wardchat.FieldID, field.TypeInt))
+	if ps := wcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if wcu.mutation.OrganizationIdCleared() {
+		_spec.ClearField(wardchat.FieldOrganizationId, field.TypeInt)
+	}
+	if value, ok := wcu.mutation.Title(); ok {
+		_spec.SetField(wardchat.FieldTitle, field.TypeString, value)
+	}
+	if wcu.mutation.TitleCleared() {
+		_spec.ClearField(wardchat.FieldTitle, field.TypeString)
+	}
+	if value, ok := wcu.mutation.DepartmentId(); ok {
+		_spec.SetField(wardchat.FieldDepartmentId, field.TypeInt, value)
+	}
+	if value, ok := wcu.mutation.AddedDepartmentId(); ok {
+		_spec.AddField(wardchat.FieldDepartmentId, field.TypeInt, value)
+	}
+	if wcu.mutation.DepartmentIdCleared() {
+		_spec.ClearField(wardchat.FieldDepartmentId, field.TypeInt)
+	}
+	if value, ok := wcu.mutation.Rooms(); ok {
+		_spec.SetField(wardchat.FieldRooms, field.TypeJSON, value)
+	}
+	if value, ok := wcu.mutation.AppendedRooms(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, wardchat.FieldRooms, value)
+		})
+	}
+	if wcu.mutation.RoomsCleared() {
+		_spec.ClearField(wardchat.FieldRooms, field.TypeJSON)
+	}
+	if value, ok := wcu.mutation.Language(); ok {
+		_spec.SetField(wardchat.FieldLanguage, field.TypeString, value)
+	}
+	if wcu.mutation.LanguageCleared() {
+		_spec.ClearField(wardchat.FieldLanguage, field.TypeString)
+	}
+	if value, ok := wcu.mutation.CreatedBy(); ok {
+		_spec.SetField(wardchat.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := wcu.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(wardchat.FieldCreatedBy, field.TypeInt, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wardchat.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wcu.mutation.done = true
+	return n, nil
+}
+
+// WardChatUpdateOne is the builder for updating a single WardChat entity.
+type WardChatUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WardChatMutation
+}
+
+// SetTitle sets the "title" field.
+func (wcuo *WardChatUpdateOne) SetTitle(s string) *WardChatUpdateOne {
+	wcuo.mutation.SetTitle(s)
+	return wcuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (wcuo *WardChatUpdateOne) SetNillableTitle(s *string) *WardChatUpdateOne {
+	if s != nil {
+		wcuo.SetTitle(*s)
+	}
+	return wcuo
+}
+
+// ClearTitle clears the value of the "title" field.
+func (wcuo *WardChatUpdateOne) ClearTitle() *WardChatUpdateOne {
+	wcuo.mutation.ClearTitle()
+	return wcuo
+}
+
+// SetDepartmentId sets the "departmentId" field.
+func (wcuo *WardChatUpdateOne) SetDepartmentId(i int) *WardChatUpdateOne {
+	wcuo.mutation.ResetDepartmentId()
+	wcuo.mutation.SetDepartmentId(i)
+	return wcuo
+}
+
+// SetNillableDepartmentId sets the "departmentId" field if the given value is not nil.
+func (wcuo *WardChatUpdateOne) SetNillableDepartmentId(i *int) *WardChatUpdateOne {
+	if i != nil {
+		wcuo.SetDepartmentId(*i)
+	}
+	return wcuo
+}
+
+// AddDepartmentId adds i to the "departmentId" field.
+func (wcuo *WardChatUpdateOne) AddDepartmentId(i int) *WardChatUpdateOne {
+	wcuo.mutation.AddDepartmentId(i)
+	return wcuo
+}
+
+// ClearDepartmentId clears the value of the "departmentId" field.
+func (wcuo *WardChatUpdateOne) ClearDepartmentId() *WardChatUpdateOne {
+	wcuo.mutation.ClearDepartmentId()
+	return wcuo
+}
+
+// SetRooms sets the "rooms" field.
+func (wcuo *WardChatUpdateOne) SetRooms(i []int) *WardChatUpdateOne {
+	wcuo.mutation.SetRooms(i)
+	return wcuo
+}
+
+// AppendRooms appends i to the "rooms" field.
+func (wcuo *WardChatUpdateOne) AppendRooms(i []int) *WardChatUpdateOne {
+	wcuo.mutation.AppendRooms(i)
+	return wcuo
+}
+
+// ClearRooms clears the value of the "rooms" field.
+func (wcuo *WardChatUpdateOne) ClearRooms() *WardChatUpdateOne {
+	wcuo.mutation.ClearRooms()
+	return wcuo
+}
+
+// SetLanguage sets the "language" field.
+func (wcuo *WardChatUpdateOne) SetLanguage(s string) *WardChatUpdateOne {
+	wcuo.mutation.SetLanguage(s)
+	return wcuo
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (wcuo *WardChatUpdateOne) SetNillableLanguage(s *string) *WardChatUpdateOne {
+	if s != nil {
+		wcuo.SetLanguage(*s)
+	}
+	return wcuo
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (wcuo *WardChatUpdateOne) ClearLanguage() *WardChatUpdateOne {
+	wcuo.mutation.ClearLanguage()
+	return wcuo
+}
+
+// SetCreatedBy sets the "createdBy" field.
+func (wcuo *WardChatUpdateOne) SetCreatedBy(i int) *WardChatUpdateOne {
+	wcuo.mutation.ResetCreatedBy()
+	wcuo.mutation.SetCreatedBy(i)
+	return wcuo
+}
+
+// AddCreatedBy adds i to the "createdBy" field.
+func (wcuo *WardChatUpdateOne) AddCreatedBy(i int) *WardChatUpdateOne {
+	wcuo.mutation.AddCreatedBy(i)
+	return wcuo
+}
+
+// Mutation returns the WardChatMutation object of the builder.
+func (wcuo *WardChatUpdateOne) Mutation() *WardChatMutation {
+	return wcuo.mutation
+}
+
+// Where appends a list predicates to the WardChatUpdate builder.
+func (wcuo *WardChatUpdateOne) Where(ps ...predicate.WardChat) *WardChatUpdateOne {
+	wcuo.mutation.Where(ps...)
+	return wcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wcuo *WardChatUpdateOne) Select(field string, fields ...string) *WardChatUpdateOne {
+	wcuo.fields = append([]string{field}, fields...)
+	return wcuo
+}
+
+// Save executes the query and returns the updated WardChat entity.
+func (wcuo *WardChatUpdateOne) Save(ctx context.Context) (*WardChat, error) {
+	return withHooks[*WardChat, WardChatMutation](ctx, wcuo.sqlSave, wcuo.mutation, wcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wcuo *WardChatUpdateOne) SaveX(ctx context.Context) *WardChat {
+	node, err := wcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wcuo *WardChatUpdateOne) Exec(ctx context.Context) error {
+	_, err := wcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcuo *WardChatUpdateOne) ExecX(ctx context.Context) {
+	if err := wcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wcuo *WardChatUpdateOne) sqlSave(ctx context.Context) (_node *WardChat, err error) {
+	_spec := sqlgraph.NewUpdateSpec(wardchat.Table, wardchat.Columns, sqlgraph.NewFieldSpec(wardchat.FieldID, field.TypeInt))
+	id, ok := wcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WardChat.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wardchat.FieldID)
+		for _, f := range fields {
+			if !wardchat.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != wardchat.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if wcuo.mutation.OrganizationIdCleared() {
+		_spec.ClearField(wardchat.FieldOrganizationId, field.TypeInt)
+	}
+	if value, ok := wcuo.mutation.Title(); ok {
+		_spec.SetField(wardchat.FieldTitle, field.TypeString, value)
+	}
+	if wcuo.mutation.TitleCleared() {
+		_spec.ClearField(wardchat.FieldTitle, field.TypeString)
+	}
+	if value, ok := wcuo.mutation.DepartmentId(); ok {
+		_spec.SetField(wardchat.FieldDepartmentId, field.TypeInt, value)
+	}
+	if value, ok := wcuo.mutation.AddedDepartmentId(); ok {
+		_spec.AddField(wardchat.FieldDepartmentId, field.TypeInt, value)
+	}
+	if wcuo.mutation.DepartmentIdCleared() {
+		_spec.ClearField(wardchat.FieldDepartmentId, field.TypeInt)
+	}
+	if value, ok := wcuo.mutation.Rooms(); ok {
+		_spec.SetField(wardchat.FieldRooms, field.TypeJSON, value)
+	}
+	if value, ok := wcuo.mutation.AppendedRooms(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, wardchat.FieldRooms, value)
+		})
+	}
+	if wcuo.mutation.RoomsCleared() {
+		_spec.ClearField(wardchat.FieldRooms, field.TypeJSON)
+	}
+	if value, ok := wcuo.mutation.Language(); ok {
+		_spec.SetField(wardchat.FieldLanguage, field.TypeString, value)
+	}
+	if wcuo.mutation.LanguageCleared() {
+		_spec.ClearField(wardchat.FieldLanguage, field.TypeString)
+	}
+	if value, ok := wcuo.mutation.CreatedBy(); ok {
+		_spec.SetField(wardchat.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := wcuo.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(wardchat.FieldCreatedBy, field.TypeInt, value)
+	}
+	_node = &WardChat{config: wcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wardchat.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wcuo.mutation.done = true
+	return _node, nil
+}
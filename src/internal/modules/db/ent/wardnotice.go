@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"hospital/internal/modules/db/ent/wardnotice"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// WardNotice is the model entity for the WardNotice schema.
+type WardNotice struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// OrganizationId holds the value of the "organizationId" field.
+	OrganizationId int `json:"organizationId,omitempty"`
+	// ChatId holds the value of the "chatId" field.
+	ChatId int64 `json:"chatId,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// Severity holds the value of the "severity" field.
+	Severity string `json:"severity,omitempty"`
+	// PatientId holds the value of the "patientId" field.
+	PatientId int `json:"patientId,omitempty"`
+	// Params holds the value of the "params" field.
+	Params map[string]string `json:"params,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// SentAt holds the value of the "sentAt" field.
+	SentAt *time.Time `json:"sentAt,omitempty"`
+	// ClaimedUntil holds the value of the "claimedUntil" field.
+	ClaimedUntil *time.Time `json:"claimedUntil,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// CreatedAt holds the value of the "createdAt" field.
+	CreatedAt    time.Time `json:"createdAt,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WardNotice) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case wardnotice.FieldParams:
+			values[i] = new([]byte)
+		case wardnotice.FieldID, wardnotice.FieldOrganizationId, wardnotice.FieldChatId, wardnotice.FieldPatientId, wardnotice.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case wardnotice.FieldKind, wardnotice.FieldSeverity, wardnotice.FieldStatus:
+			values[i] = new(sql.NullString)
+		case wardnotice.FieldSentAt, wardnotice.FieldClaimedUntil, wardnotice.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WardNotice fields.
+func (wn *WardNotice) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case wardnotice.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			wn.ID = int(value.Int64)
+		case wardnotice.FieldOrganizationId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field organizationId", values[i])
+			} else if value.Valid {
+				wn.OrganizationId = int(value.Int64)
+			}
+		case wardnotice.FieldChatId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field chatId", values[i])
+			} else if value.Valid {
+				wn.ChatId = value.Int64
+			}
+		case wardnotice.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				wn.Kind = value.String
+			}
+		case wardnotice.FieldSeverity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field severity", values[i])
+			} else if value.Valid {
+				wn.Severity = value.String
+			}
+		case wardnotice.FieldPatientId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field patientId", values[i])
+			} else if value.Valid {
+				wn.PatientId = int(value.Int64)
+			}
+		case wardnotice.FieldParams:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field params", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &wn.Params); err != nil {
+					return fmt.Errorf("unmarshal field params: %w", err)
+				}
+			}
+		case wardnotice.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				wn.Status = value.String
+			}
+		case wardnotice.FieldSentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sentAt", values[i])
+			} else if value.Valid {
+				wn.SentAt = new(time.Time)
+				*wn.SentAt = value.Time
+			}
+		case wardnotice.FieldClaimedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field claimedUntil", values[i])
+			} else if value.Valid {
+				wn.ClaimedUntil = new(time.Time)
+				*wn.ClaimedUntil = value.Time
+			}
+		case wardnotice.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				wn.Attempts = int(value.Int64)
+			}
+		case wardnotice.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field createdAt", values[i])
+			} else if value.Valid {
+				wn.CreatedAt = value.Time
+			}
+		default:
+			wn.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WardNotice.
+// This includes values selected through modifiers, order, etc.
+func (wn *WardNotice) Value(name string) (ent.Value, error) {
+	return wn.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WardNotice.
+// Note that you need to call WardNotice.Unwrap() before calling this method if this WardNotice
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wn *WardNotice) Update() *WardNoticeUpdateOne {
+	return NewWardNoticeClient(wn.config).UpdateOne(wn)
+}
+
+// Unwrap unwraps the WardNotice entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wn *WardNotice) Unwrap() *WardNotice {
+	_tx, ok := wn.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WardNotice is not a transactional entity")
+	}
+	wn.config.driver = _tx.drv
+	return wn
+}
+
+// String implements the fmt.Stringer.
+func (wn *WardNotice) String() string {
+	var builder strings.Builder
+	builder.WriteString("WardNotice(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wn.ID))
+	builder.WriteString("organizationId=")
+	builder.WriteString(fmt.Sprintf("%v", wn.OrganizationId))
+	builder.WriteString(", ")
+	builder.WriteString("chatId=")
+	builder.WriteString(fmt.Sprintf("%v", wn.ChatId))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(wn.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("severity=")
+	builder.WriteString(wn.Severity)
+	builder.WriteString(", ")
+	builder.WriteString("patientId=")
+	builder.WriteString(fmt.Sprintf("%v", wn.PatientId))
+	builder.WriteString(", ")
+	builder.WriteString("params=")
+	builder.WriteString(fmt.Sprintf("%v", wn.Params))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(wn.Status)
+	builder.WriteString(", ")
+	if v := wn.SentAt; v != nil {
+		builder.WriteString("sentAt=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := wn.ClaimedUntil; v != nil {
+		builder.WriteString("claimedUntil=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", wn.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("createdAt=")
+	builder.WriteString(wn.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WardNotices is a parsable slice of WardNotice.
+type WardNotices []*WardNotice
@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wardnotice
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the wardnotice type in the database.
+	Label = "ward_notice"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOrganizationId holds the string denoting the organizationid field in the database.
+	FieldOrganizationId = "organization_id"
+	// FieldChatId holds the string denoting the chatid field in the database.
+	FieldChatId = "chat_id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldSeverity holds the string denoting the severity field in the database.
+	FieldSeverity = "severity"
+	// FieldPatientId holds the string denoting the patientid field in the database.
+	FieldPatientId = "patient_id"
+	// FieldParams holds the string denoting the params field in the database.
+	FieldParams = "params"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldSentAt holds the string denoting the sentat field in the database.
+	FieldSentAt = "sent_at"
+	// FieldClaimedUntil holds the string denoting the claimeduntil field in the database.
+	FieldClaimedUntil = "claimed_until"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldCreatedAt holds the string denoting the createdat field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the wardnotice in the database.
+	Table = "ward_notices"
+)
+
+// Columns holds all SQL columns for wardnotice fields.
+var Columns = []string{
+	FieldID,
+	FieldOrganizationId,
+	FieldChatId,
+	FieldKind,
+	FieldSeverity,
+	FieldPatientId,
+	FieldParams,
+	FieldStatus,
+	FieldSentAt,
+	FieldClaimedUntil,
+	FieldAttempts,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "hospital/internal/modules/db/ent/runtime"
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// DefaultCreatedAt holds the default value on creation for the "createdAt" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Order defines the ordering method for the WardNotice queries.
+type Order func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByOrganizationId orders the results by the organizationId field.
+func ByOrganizationId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldOrganizationId, opts...).ToFunc()
+}
+
+// ByChatId orders the results by the chatId field.
+func ByChatId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldChatId, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// BySeverity orders the results by the severity field.
+func BySeverity(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldSeverity, opts...).ToFunc()
+}
+
+// ByPatientId orders the results by the patientId field.
+func ByPatientId(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldPatientId, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// BySentAt orders the results by the sentAt field.
+func BySentAt(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldSentAt, opts...).ToFunc()
+}
+
+// ByClaimedUntil orders the results by the claimedUntil field.
+func ByClaimedUntil(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldClaimedUntil, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the createdAt field.
+func ByCreatedAt(opts ...sql.OrderTermOption) Order {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
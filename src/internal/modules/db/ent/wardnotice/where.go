@@ -0,0 +1,652 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wardnotice
+
+import (
+	"hospital/internal/modules/db/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldLTE(FieldID, id))
+}
+
+// OrganizationId applies equality check predicate on the "organizationId" field. It's identical to OrganizationIdEQ.
+func OrganizationId(v int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldEQ(FieldOrganizationId, v))
+}
+
+// ChatId applies equality check predicate on the "chatId" field. It's identical to ChatIdEQ.
+func ChatId(v int64) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldEQ(FieldChatId, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldEQ(FieldKind, v))
+}
+
+// Severity applies equality check predicate on the "severity" field. It's identical to SeverityEQ.
+func Severity(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldEQ(FieldSeverity, v))
+}
+
+// PatientId applies equality check predicate on the "patientId" field. It's identical to PatientIdEQ.
+func PatientId(v int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldEQ(FieldPatientId, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldEQ(FieldStatus, v))
+}
+
+// SentAt applies equality check predicate on the "sentAt" field. It's identical to SentAtEQ.
+func SentAt(v time.Time) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldEQ(FieldSentAt, v))
+}
+
+// ClaimedUntil applies equality check predicate on the "claimedUntil" field. It's identical to ClaimedUntilEQ.
+func ClaimedUntil(v time.Time) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldEQ(FieldClaimedUntil, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldEQ(FieldAttempts, v))
+}
+
+// CreatedAt applies equality check predicate on the "createdAt" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// OrganizationIdEQ applies the EQ predicate on the "organizationId" field.
+func OrganizationIdEQ(v int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldEQ(FieldOrganizationId, v))
+}
+
+// OrganizationIdNEQ applies the NEQ predicate on the "organizationId" field.
+func OrganizationIdNEQ(v int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldNEQ(FieldOrganizationId, v))
+}
+
+// OrganizationIdIn applies the In predicate on the "organizationId" field.
+func OrganizationIdIn(vs ...int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldIn(FieldOrganizationId, vs...))
+}
+
+// OrganizationIdNotIn applies the NotIn predicate on the "organizationId" field.
+func OrganizationIdNotIn(vs ...int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldNotIn(FieldOrganizationId, vs...))
+}
+
+// OrganizationIdGT applies the GT predicate on the "organizationId" field.
+func OrganizationIdGT(v int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldGT(FieldOrganizationId, v))
+}
+
+// OrganizationIdGTE applies the GTE predicate on the "organizationId" field.
+func OrganizationIdGTE(v int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldGTE(FieldOrganizationId, v))
+}
+
+// OrganizationIdLT applies the LT predicate on the "organizationId" field.
+func OrganizationIdLT(v int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldLT(FieldOrganizationId, v))
+}
+
+// OrganizationIdLTE applies the LTE predicate on the "organizationId" field.
+func OrganizationIdLTE(v int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldLTE(FieldOrganizationId, v))
+}
+
+// OrganizationIdIsNil applies the IsNil predicate on the "organizationId" field.
+func OrganizationIdIsNil() predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldIsNull(FieldOrganizationId))
+}
+
+// OrganizationIdNotNil applies the NotNil predicate on the "organizationId" field.
+func OrganizationIdNotNil() predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldNotNull(FieldOrganizationId))
+}
+
+// ChatIdEQ applies the EQ predicate on the "chatId" field.
+func ChatIdEQ(v int64) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldEQ(FieldChatId, v))
+}
+
+// ChatIdNEQ applies the NEQ predicate on the "chatId" field.
+func ChatIdNEQ(v int64) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldNEQ(FieldChatId, v))
+}
+
+// ChatIdIn applies the In predicate on the "chatId" field.
+func ChatIdIn(vs ...int64) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldIn(FieldChatId, vs...))
+}
+
+// ChatIdNotIn applies the NotIn predicate on the "chatId" field.
+func ChatIdNotIn(vs ...int64) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldNotIn(FieldChatId, vs...))
+}
+
+// ChatIdGT applies the GT predicate on the "chatId" field.
+func ChatIdGT(v int64) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldGT(FieldChatId, v))
+}
+
+// ChatIdGTE applies the GTE predicate on the "chatId" field.
+func ChatIdGTE(v int64) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldGTE(FieldChatId, v))
+}
+
+// ChatIdLT applies the LT predicate on the "chatId" field.
+func ChatIdLT(v int64) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldLT(FieldChatId, v))
+}
+
+// ChatIdLTE applies the LTE predicate on the "chatId" field.
+func ChatIdLTE(v int64) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldLTE(FieldChatId, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldContainsFold(FieldKind, v))
+}
+
+// SeverityEQ applies the EQ predicate on the "severity" field.
+func SeverityEQ(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldEQ(FieldSeverity, v))
+}
+
+// SeverityNEQ applies the NEQ predicate on the "severity" field.
+func SeverityNEQ(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldNEQ(FieldSeverity, v))
+}
+
+// SeverityIn applies the In predicate on the "severity" field.
+func SeverityIn(vs ...string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldIn(FieldSeverity, vs...))
+}
+
+// SeverityNotIn applies the NotIn predicate on the "severity" field.
+func SeverityNotIn(vs ...string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldNotIn(FieldSeverity, vs...))
+}
+
+// SeverityGT applies the GT predicate on the "severity" field.
+func SeverityGT(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldGT(FieldSeverity, v))
+}
+
+// SeverityGTE applies the GTE predicate on the "severity" field.
+func SeverityGTE(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldGTE(FieldSeverity, v))
+}
+
+// SeverityLT applies the LT predicate on the "severity" field.
+func SeverityLT(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldLT(FieldSeverity, v))
+}
+
+// SeverityLTE applies the LTE predicate on the "severity" field.
+func SeverityLTE(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldLTE(FieldSeverity, v))
+}
+
+// SeverityContains applies the Contains predicate on the "severity" field.
+func SeverityContains(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldContains(FieldSeverity, v))
+}
+
+// SeverityHasPrefix applies the HasPrefix predicate on the "severity" field.
+func SeverityHasPrefix(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldHasPrefix(FieldSeverity, v))
+}
+
+// SeverityHasSuffix applies the HasSuffix predicate on the "severity" field.
+func SeverityHasSuffix(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldHasSuffix(FieldSeverity, v))
+}
+
+// SeverityEqualFold applies the EqualFold predicate on the "severity" field.
+func SeverityEqualFold(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldEqualFold(FieldSeverity, v))
+}
+
+// SeverityContainsFold applies the ContainsFold predicate on the "severity" field.
+func SeverityContainsFold(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldContainsFold(FieldSeverity, v))
+}
+
+// PatientIdEQ applies the EQ predicate on the "patientId" field.
+func PatientIdEQ(v int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldEQ(FieldPatientId, v))
+}
+
+// PatientIdNEQ applies the NEQ predicate on the "patientId" field.
+func PatientIdNEQ(v int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldNEQ(FieldPatientId, v))
+}
+
+// PatientIdIn applies the In predicate on the "patientId" field.
+func PatientIdIn(vs ...int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldIn(FieldPatientId, vs...))
+}
+
+// PatientIdNotIn applies the NotIn predicate on the "patientId" field.
+func PatientIdNotIn(vs ...int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldNotIn(FieldPatientId, vs...))
+}
+
+// PatientIdGT applies the GT predicate on the "patientId" field.
+func PatientIdGT(v int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldGT(FieldPatientId, v))
+}
+
+// PatientIdGTE applies the GTE predicate on the "patientId" field.
+func PatientIdGTE(v int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldGTE(FieldPatientId, v))
+}
+
+// PatientIdLT applies the LT predicate on the "patientId" field.
+func PatientIdLT(v int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldLT(FieldPatientId, v))
+}
+
+// PatientIdLTE applies the LTE predicate on the "patientId" field.
+func PatientIdLTE(v int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldLTE(FieldPatientId, v))
+}
+
+// ParamsIsNil applies the IsNil predicate on the "params" field.
+func ParamsIsNil() predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldIsNull(FieldParams))
+}
+
+// ParamsNotNil applies the NotNil predicate on the "params" field.
+func ParamsNotNil() predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldNotNull(FieldParams))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// SentAtEQ applies the EQ predicate on the "sentAt" field.
+func SentAtEQ(v time.Time) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldEQ(FieldSentAt, v))
+}
+
+// SentAtNEQ applies the NEQ predicate on the "sentAt" field.
+func SentAtNEQ(v time.Time) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldNEQ(FieldSentAt, v))
+}
+
+// SentAtIn applies the In predicate on the "sentAt" field.
+func SentAtIn(vs ...time.Time) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldIn(FieldSentAt, vs...))
+}
+
+// SentAtNotIn applies the NotIn predicate on the "sentAt" field.
+func SentAtNotIn(vs ...time.Time) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldNotIn(FieldSentAt, vs...))
+}
+
+// SentAtGT applies the GT predicate on the "sentAt" field.
+func SentAtGT(v time.Time) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldGT(FieldSentAt, v))
+}
+
+// SentAtGTE applies the GTE predicate on the "sentAt" field.
+func SentAtGTE(v time.Time) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldGTE(FieldSentAt, v))
+}
+
+// SentAtLT applies the LT predicate on the "sentAt" field.
+func SentAtLT(v time.Time) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldLT(FieldSentAt, v))
+}
+
+// SentAtLTE applies the LTE predicate on the "sentAt" field.
+func SentAtLTE(v time.Time) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldLTE(FieldSentAt, v))
+}
+
+// SentAtIsNil applies the IsNil predicate on the "sentAt" field.
+func SentAtIsNil() predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldIsNull(FieldSentAt))
+}
+
+// SentAtNotNil applies the NotNil predicate on the "sentAt" field.
+func SentAtNotNil() predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldNotNull(FieldSentAt))
+}
+
+// ClaimedUntilEQ applies the EQ predicate on the "claimedUntil" field.
+func ClaimedUntilEQ(v time.Time) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldEQ(FieldClaimedUntil, v))
+}
+
+// ClaimedUntilNEQ applies the NEQ predicate on the "claimedUntil" field.
+func ClaimedUntilNEQ(v time.Time) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldNEQ(FieldClaimedUntil, v))
+}
+
+// ClaimedUntilIn applies the In predicate on the "claimedUntil" field.
+func ClaimedUntilIn(vs ...time.Time) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldIn(FieldClaimedUntil, vs...))
+}
+
+// ClaimedUntilNotIn applies the NotIn predicate on the "claimedUntil" field.
+func ClaimedUntilNotIn(vs ...time.Time) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldNotIn(FieldClaimedUntil, vs...))
+}
+
+// ClaimedUntilGT applies the GT predicate on the "claimedUntil" field.
+func ClaimedUntilGT(v time.Time) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldGT(FieldClaimedUntil, v))
+}
+
+// ClaimedUntilGTE applies the GTE predicate on the "claimedUntil" field.
+func ClaimedUntilGTE(v time.Time) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldGTE(FieldClaimedUntil, v))
+}
+
+// ClaimedUntilLT applies the LT predicate on the "claimedUntil" field.
+func ClaimedUntilLT(v time.Time) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldLT(FieldClaimedUntil, v))
+}
+
+// ClaimedUntilLTE applies the LTE predicate on the "claimedUntil" field.
+func ClaimedUntilLTE(v time.Time) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldLTE(FieldClaimedUntil, v))
+}
+
+// ClaimedUntilIsNil applies the IsNil predicate on the "claimedUntil" field.
+func ClaimedUntilIsNil() predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldIsNull(FieldClaimedUntil))
+}
+
+// ClaimedUntilNotNil applies the NotNil predicate on the "claimedUntil" field.
+func ClaimedUntilNotNil() predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldNotNull(FieldClaimedUntil))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldLTE(FieldAttempts, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "createdAt" field.
+func CreatedAtEQ(v time.Time) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "createdAt" field.
+func CreatedAtNEQ(v time.Time) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "createdAt" field.
+func CreatedAtIn(vs ...time.Time) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "createdAt" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "createdAt" field.
+func CreatedAtGT(v time.Time) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "createdAt" field.
+func CreatedAtGTE(v time.Time) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "createdAt" field.
+func CreatedAtLT(v time.Time) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "createdAt" field.
+func CreatedAtLTE(v time.Time) predicate.WardNotice {
+	return predicate.WardNotice(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WardNotice) predicate.WardNotice {
+	return predicate.WardNotice(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WardNotice) predicate.WardNotice {
+	return predicate.WardNotice(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WardNotice) predicate.WardNotice {
+	return predicate.WardNotice(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
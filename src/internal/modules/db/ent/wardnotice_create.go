@@ -0,0 +1,358 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hospital/internal/modules/db/ent/wardnotice"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WardNoticeCreate is the builder for creating a WardNotice entity.
+type WardNoticeCreate struct {
+	config
+	mutation *WardNoticeMutation
+	hooks    []Hook
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (wnc *WardNoticeCreate) SetOrganizationId(i int) *WardNoticeCreate {
+	wnc.mutation.SetOrganizationId(i)
+	return wnc
+}
+
+// SetNillableOrganizationId sets the "organizationId" field if the given value is not nil.
+func (wnc *WardNoticeCreate) SetNillableOrganizationId(i *int) *WardNoticeCreate {
+	if i != nil {
+		wnc.SetOrganizationId(*i)
+	}
+	return wnc
+}
+
+// SetChatId sets the "chatId" field.
+func (wnc *WardNoticeCreate) SetChatId(i int64) *WardNoticeCreate {
+	wnc.mutation.SetChatId(i)
+	return wnc
+}
+
+// SetKind sets the "kind" field.
+func (wnc *WardNoticeCreate) SetKind(s string) *WardNoticeCreate {
+	wnc.mutation.SetKind(s)
+	return wnc
+}
+
+// SetSeverity sets the "severity" field.
+func (wnc *WardNoticeCreate) SetSeverity(s string) *WardNoticeCreate {
+	wnc.mutation.SetSeverity(s)
+	return wnc
+}
+
+// SetPatientId sets the "patientId" field.
+func (wnc *WardNoticeCreate) SetPatientId(i int) *WardNoticeCreate {
+	wnc.mutation.SetPatientId(i)
+	return wnc
+}
+
+// SetParams sets the "params" field.
+func (wnc *WardNoticeCreate) SetParams(m map[string]string) *WardNoticeCreate {
+	wnc.mutation.SetParams(m)
+	return wnc
+}
+
+// SetStatus sets the "status" field.
+func (wnc *WardNoticeCreate) SetStatus(s string) *WardNoticeCreate {
+	wnc.mutation.SetStatus(s)
+	return wnc
+}
+
+// SetSentAt sets the "sentAt" field.
+func (wnc *WardNoticeCreate) SetSentAt(t time.Time) *WardNoticeCreate {
+	wnc.mutation.SetSentAt(t)
+	return wnc
+}
+
+// SetNillableSentAt sets the "sentAt" field if the given value is not nil.
+func (wnc *WardNoticeCreate) SetNillableSentAt(t *time.Time) *WardNoticeCreate {
+	if t != nil {
+		wnc.SetSentAt(*t)
+	}
+	return wnc
+}
+
+// SetClaimedUntil sets the "claimedUntil" field.
+func (wnc *WardNoticeCreate) SetClaimedUntil(t time.Time) *WardNoticeCreate {
+	wnc.mutation.SetClaimedUntil(t)
+	return wnc
+}
+
+// SetNillableClaimedUntil sets the "claimedUntil" field if the given value is not nil.
+func (wnc *WardNoticeCreate) SetNillableClaimedUntil(t *time.Time) *WardNoticeCreate {
+	if t != nil {
+		wnc.SetClaimedUntil(*t)
+	}
+	return wnc
+}
+
+// SetAttempts sets the "attempts" field.
+func (wnc *WardNoticeCreate) SetAttempts(i int) *WardNoticeCreate {
+	wnc.mutation.SetAttempts(i)
+	return wnc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wnc *WardNoticeCreate) SetNillableAttempts(i *int) *WardNoticeCreate {
+	if i != nil {
+		wnc.SetAttempts(*i)
+	}
+	return wnc
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (wnc *WardNoticeCreate) SetCreatedAt(t time.Time) *WardNoticeCreate {
+	wnc.mutation.SetCreatedAt(t)
+	return wnc
+}
+
+// SetNillableCreatedAt sets the "createdAt" field if the given value is not nil.
+func (wnc *WardNoticeCreate) SetNillableCreatedAt(t *time.Time) *WardNoticeCreate {
+	if t != nil {
+		wnc.SetCreatedAt(*t)
+	}
+	return wnc
+}
+
+// Mutation returns the WardNoticeMutation object of the builder.
+func (wnc *WardNoticeCreate) Mutation() *WardNoticeMutation {
+	return wnc.mutation
+}
+
+// Save creates the WardNotice in the database.
+func (wnc *WardNoticeCreate) Save(ctx context.Context) (*WardNotice, error) {
+	if err := wnc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks[*WardNotice, WardNoticeMutation](ctx, wnc.sqlSave, wnc.mutation, wnc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wnc *WardNoticeCreate) SaveX(ctx context.Context) *WardNotice {
+	v, err := wnc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wnc *WardNoticeCreate) Exec(ctx context.Context) error {
+	_, err := wnc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wnc *WardNoticeCreate) ExecX(ctx context.Context) {
+	if err := wnc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wnc *WardNoticeCreate) defaults() error {
+	if _, ok := wnc.mutation.Attempts(); !ok {
+		v := wardnotice.DefaultAttempts
+		wnc.mutation.SetAttempts(v)
+	}
+	if _, ok := wnc.mutation.CreatedAt(); !ok {
+		if wardnotice.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized wardnotice.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := wardnotice.DefaultCreatedAt()
+		wnc.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wnc *WardNoticeCreate) check() error {
+	if _, ok := wnc.mutation.ChatId(); !ok {
+		return &ValidationError{Name: "chatId", err: errors.New(`ent: missing required field "WardNotice.chatId"`)}
+	}
+	if _, ok := wnc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "WardNotice.kind"`)}
+	}
+	if _, ok := wnc.mutation.Severity(); !ok {
+		return &ValidationError{Name: "severity", err: errors.New(`ent: missing required field "WardNotice.severity"`)}
+	}
+	if _, ok := wnc.mutation.PatientId(); !ok {
+		return &ValidationError{Name: "patientId", err: errors.New(`ent: missing required field "WardNotice.patientId"`)}
+	}
+	if _, ok := wnc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "WardNotice.status"`)}
+	}
+	if _, ok := wnc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "WardNotice.attempts"`)}
+	}
+	if _, ok := wnc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "createdAt", err: errors.New(`ent: missing required field "WardNotice.createdAt"`)}
+	}
+	return nil
+}
+
+func (wnc *WardNoticeCreate) sqlSave(ctx context.Context) (*WardNotice, error) {
+	if err := wnc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wnc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wnc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	wnc.mutation.id = &_node.ID
+	wnc.mutation.done = true
+	return _node, nil
+}
+
+func (wnc *WardNoticeCreate) createSpec() (*WardNotice, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WardNotice{config: wnc.config}
+		_spec = sqlgraph.NewCreateSpec(wardnotice.Table, sqlgraph.NewFieldSpec(wardnotice.FieldID, field.TypeInt))
+	)
+	if value, ok := wnc.mutation.OrganizationId(); ok {
+		_spec.SetField(wardnotice.FieldOrganizationId, field.TypeInt, value)
+		_node.OrganizationId = value
+	}
+	if value, ok := wnc.mutation.ChatId(); ok {
+		_spec.SetField(wardnotice.FieldChatId, field.TypeInt64, value)
+		_node.ChatId = value
+	}
+	if value, ok := wnc.mutation.Kind(); ok {
+		_spec.SetField(wardnotice.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := wnc.mutation.Severity(); ok {
+		_spec.SetField(wardnotice.FieldSeverity, field.TypeString, value)
+		_node.Severity = value
+	}
+	if value, ok := wnc.mutation.PatientId(); ok {
+		_spec.SetField(wardnotice.FieldPatientId, field.TypeInt, value)
+		_node.PatientId = value
+	}
+	if value, ok := wnc.mutation.Params(); ok {
+		_spec.SetField(wardnotice.FieldParams, field.TypeJSON, value)
+		_node.Params = value
+	}
+	if value, ok := wnc.mutation.Status(); ok {
+		_spec.SetField(wardnotice.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := wnc.mutation.SentAt(); ok {
+		_spec.SetField(wardnotice.FieldSentAt, field.TypeTime, value)
+		_node.SentAt = &value
+	}
+	if value, ok := wnc.mutation.ClaimedUntil(); ok {
+		_spec.SetField(wardnotice.FieldClaimedUntil, field.TypeTime, value)
+		_node.ClaimedUntil = &value
+	}
+	if value, ok := wnc.mutation.Attempts(); ok {
+		_spec.SetField(wardnotice.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := wnc.mutation.CreatedAt(); ok {
+		_spec.SetField(wardnotice.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// WardNoticeCreateBulk is the builder for creating many WardNotice entities in bulk.
+type WardNoticeCreateBulk struct {
+	config
+	builders []*WardNoticeCreate
+}
+
+// Save creates the WardNotice entities in the database.
+func (wncb *WardNoticeCreateBulk) Save(ctx context.Context) ([]*WardNotice, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(wncb.builders))
+	nodes := make([]*WardNotice, len(wncb.builders))
+	mutators := make([]Mutator, len(wncb.builders))
+	for i := range wncb.builders {
+		func(i int, root context.Context) {
+			builder := wncb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WardNoticeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wncb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wncb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wncb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wncb *WardNoticeCreateBulk) SaveX(ctx context.Context) []*WardNotice {
+	v, err := wncb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wncb *WardNoticeCreateBulk) Exec(ctx context.Context) error {
+	_, err := wncb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wncb *WardNoticeCreateBulk) ExecX(ctx context.Context) {
+	if err := wncb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"hospital/internal/modules/db/ent/predicate"
+	"hospital/internal/modules/db/ent/wardnotice"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WardNoticeDelete is the builder for deleting a WardNotice entity.
+type WardNoticeDelete struct {
+	config
+	hooks    []Hook
+	mutation *WardNoticeMutation
+}
+
+// Where appends a list predicates to the WardNoticeDelete builder.
+func (wnd *WardNoticeDelete) Where(ps ...predicate.WardNotice) *WardNoticeDelete {
+	wnd.mutation.Where(ps...)
+	return wnd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wnd *WardNoticeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, WardNoticeMutation](ctx, wnd.sqlExec, wnd.mutation, wnd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wnd *WardNoticeDelete) ExecX(ctx context.Context) int {
+	n, err := wnd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wnd *WardNoticeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(wardnotice.Table, sqlgraph.NewFieldSpec(wardnotice.FieldID, field.TypeInt))
+	if ps := wnd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wnd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	wnd.mutation.done = true
+	return affected, err
+}
+
+// WardNoticeDeleteOne is the builder for deleting a single WardNotice entity.
+type WardNoticeDeleteOne struct {
+	wnd *WardNoticeDelete
+}
+
+// Where appends a list predicates to the WardNoticeDelete builder.
+func (wndo *WardNoticeDeleteOne) Where(ps ...predicate.WardNotice) *WardNoticeDeleteOne {
+	wndo.wnd.mutation.Where(ps...)
+	return wndo
+}
+
+// Exec executes the deletion query.
+func (wndo *WardNoticeDeleteOne) Exec(ctx context.Context) error {
+	n, err := wndo.wnd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{wardnotice.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wndo *WardNoticeDeleteOne) ExecX(ctx context.Context) {
+	if err := wndo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,364 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hospital/internal/modules/db/ent/predicate"
+	"hospital/internal/modules/db/ent/wardnotice"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WardNoticeUpdate is the builder for updating WardNotice entities.
+type WardNoticeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WardNoticeMutation
+}
+
+// Where appends a list predicates to the WardNoticeUpdate builder.
+func (wnu *WardNoticeUpdate) Where(ps ...predicate.WardNotice) *WardNoticeUpdate {
+	wnu.mutation.Where(ps...)
+	return wnu
+}
+
+// SetStatus sets the "status" field.
+func (wnu *WardNoticeUpdate) SetStatus(s string) *WardNoticeUpdate {
+	wnu.mutation.SetStatus(s)
+	return wnu
+}
+
+// SetSentAt sets the "sentAt" field.
+func (wnu *WardNoticeUpdate) SetSentAt(t time.Time) *WardNoticeUpdate {
+	wnu.mutation.SetSentAt(t)
+	return wnu
+}
+
+// SetNillableSentAt sets the "sentAt" field if the given value is not nil.
+func (wnu *WardNoticeUpdate) SetNillableSentAt(t *time.Time) *WardNoticeUpdate {
+	if t != nil {
+		wnu.SetSentAt(*t)
+	}
+	return wnu
+}
+
+// ClearSentAt clears the value of the "sentAt" field.
+func (wnu *WardNoticeUpdate) ClearSentAt() *WardNoticeUpdate {
+	wnu.mutation.ClearSentAt()
+	return wnu
+}
+
+// SetClaimedUntil sets the "claimedUntil" field.
+func (wnu *WardNoticeUpdate) SetClaimedUntil(t time.Time) *WardNoticeUpdate {
+	wnu.mutation.SetClaimedUntil(t)
+	return wnu
+}
+
+// SetNillableClaimedUntil sets the "claimedUntil" field if the given value is not nil.
+func (wnu *WardNoticeUpdate) SetNillableClaimedUntil(t *time.Time) *WardNoticeUpdate {
+	if t != nil {
+		wnu.SetClaimedUntil(*t)
+	}
+	return wnu
+}
+
+// ClearClaimedUntil clears the value of the "claimedUntil" field.
+func (wnu *WardNoticeUpdate) ClearClaimedUntil() *WardNoticeUpdate {
+	wnu.mutation.ClearClaimedUntil()
+	return wnu
+}
+
+// SetAttempts sets the "attempts" field.
+func (wnu *WardNoticeUpdate) SetAttempts(i int) *WardNoticeUpdate {
+	wnu.mutation.ResetAttempts()
+	wnu.mutation.SetAttempts(i)
+	return wnu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wnu *WardNoticeUpdate) SetNillableAttempts(i *int) *WardNoticeUpdate {
+	if i != nil {
+		wnu.SetAttempts(*i)
+	}
+	return wnu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (wnu *WardNoticeUpdate) AddAttempts(i int) *WardNoticeUpdate {
+	wnu.mutation.AddAttempts(i)
+	return wnu
+}
+
+// Mutation returns the WardNoticeMutation object of the builder.
+func (wnu *WardNoticeUpdate) Mutation() *WardNoticeMutation {
+	return wnu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wnu *WardNoticeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks[int, WardNoticeMutation](ctx, wnu.sqlSave, wnu.mutation, wnu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wnu *WardNoticeUpdate) SaveX(ctx context.Context) int {
+	affected, err := wnu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wnu *WardNoticeUpdate) Exec(ctx context.Context) error {
+	_, err := wnu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wnu *WardNoticeUpdate) ExecX(ctx context.Context) {
+	if err := wnu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wnu *WardNoticeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(wardnotice.Table, wardnotice.Columns, sqlgraph.NewFieldSpec(wardnotice.FieldID, field.TypeInt))
+	if ps := wnu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if wnu.mutation.OrganizationIdCleared() {
+		_spec.ClearField(wardnotice.FieldOrganizationId, field.TypeInt)
+	}
+	if wnu.mutation.ParamsCleared() {
+		_spec.ClearField(wardnotice.FieldParams, field.TypeJSON)
+	}
+	if value, ok := wnu.mutation.Status(); ok {
+		_spec.SetField(wardnotice.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := wnu.mutation.SentAt(); ok {
+		_spec.SetField(wardnotice.FieldSentAt, field.TypeTime, value)
+	}
+	if wnu.mutation.SentAtCleared() {
+		_spec.ClearField(wardnotice.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := wnu.mutation.ClaimedUntil(); ok {
+		_spec.SetField(wardnotice.FieldClaimedUntil, field.TypeTime, value)
+	}
+	if wnu.mutation.ClaimedUntilCleared() {
+		_spec.ClearField(wardnotice.FieldClaimedUntil, field.TypeTime)
+	}
+	if value, ok := wnu.mutation.Attempts(); ok {
+		_spec.SetField(wardnotice.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wnu.mutation.AddedAttempts(); ok {
+		_spec.AddField(wardnotice.FieldAttempts, field.TypeInt, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wnu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wardnotice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wnu.mutation.done = true
+	return n, nil
+}
+
+// WardNoticeUpdateOne is the builder for updating a single WardNotice entity.
+type WardNoticeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WardNoticeMutation
+}
+
+// SetStatus sets the "status" field.
+func (wnuo *WardNoticeUpdateOne) SetStatus(s string) *WardNoticeUpdateOne {
+	wnuo.mutation.SetStatus(s)
+	return wnuo
+}
+
+// SetSentAt sets the "sentAt" field.
+func (wnuo *WardNoticeUpdateOne) SetSentAt(t time.Time) *WardNoticeUpdateOne {
+	wnuo.mutation.SetSentAt(t)
+	return wnuo
+}
+
+// SetNillableSentAt sets the "sentAt" field if the given value is not nil.
+func (wnuo *WardNoticeUpdateOne) SetNillableSentAt(t *time.Time) *WardNoticeUpdateOne {
+	if t != nil {
+		wnuo.SetSentAt(*t)
+	}
+	return wnuo
+}
+
+// ClearSentAt clears the value of the "sentAt" field.
+func (wnuo *WardNoticeUpdateOne) ClearSentAt() *WardNoticeUpdateOne {
+	wnuo.mutation.ClearSentAt()
+	return wnuo
+}
+
+// SetClaimedUntil sets the "claimedUntil" field.
+func (wnuo *WardNoticeUpdateOne) SetClaimedUntil(t time.Time) *WardNoticeUpdateOne {
+	wnuo.mutation.SetClaimedUntil(t)
+	return wnuo
+}
+
+// SetNillableClaimedUntil sets the "claimedUntil" field if the given value is not nil.
+func (wnuo *WardNoticeUpdateOne) SetNillableClaimedUntil(t *time.Time) *WardNoticeUpdateOne {
+	if t != nil {
+		wnuo.SetClaimedUntil(*t)
+	}
+	return wnuo
+}
+
+// ClearClaimedUntil clears the value of the "claimedUntil" field.
+func (wnuo *WardNoticeUpdateOne) ClearClaimedUntil() *WardNoticeUpdateOne {
+	wnuo.mutation.ClearClaimedUntil()
+	return wnuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (wnuo *WardNoticeUpdateOne) SetAttempts(i int) *WardNoticeUpdateOne {
+	wnuo.mutation.ResetAttempts()
+	wnuo.mutation.SetAttempts(i)
+	return wnuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wnuo *WardNoticeUpdateOne) SetNillableAttempts(i *int) *WardNoticeUpdateOne {
+	if i != nil {
+		wnuo.SetAttempts(*i)
+	}
+	return wnuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (wnuo *WardNoticeUpdateOne) AddAttempts(i int) *WardNoticeUpdateOne {
+	wnuo.mutation.AddAttempts(i)
+	return wnuo
+}
+
+// Mutation returns the WardNoticeMutation object of the builder.
+func (wnuo *WardNoticeUpdateOne) Mutation() *WardNoticeMutation {
+	return wnuo.mutation
+}
+
+// Where appends a list predicates to the WardNoticeUpdate builder.
+func (wnuo *WardNoticeUpdateOne) Where(ps ...predicate.WardNotice) *WardNoticeUpdateOne {
+	wnuo.mutation.Where(ps...)
+	return wnuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wnuo *WardNoticeUpdateOne) Select(field string, fields ...string) *WardNoticeUpdateOne {
+	wnuo.fields = append([]string{field}, fields...)
+	return wnuo
+}
+
+// Save executes the query and returns the updated WardNotice entity.
+func (wnuo *WardNoticeUpdateOne) Save(ctx context.Context) (*WardNotice, error) {
+	return withHooks[*WardNotice, WardNoticeMutation](ctx, wnuo.sqlSave, wnuo.mutation, wnuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wnuo *WardNoticeUpdateOne) SaveX(ctx context.Context) *WardNotice {
+	node, err := wnuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wnuo *WardNoticeUpdateOne) Exec(ctx context.Context) error {
+	_, err := wnuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wnuo *WardNoticeUpdateOne) ExecX(ctx context.Context) {
+	if err := wnuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wnuo *WardNoticeUpdateOne) sqlSave(ctx context.Context) (_node *WardNotice, err error) {
+	_spec := sqlgraph.NewUpdateSpec(wardnotice.Table, wardnotice.Columns, sqlgraph.NewFieldSpec(wardnotice.FieldID, field.TypeInt))
+	id, ok := wnuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WardNotice.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wnuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wardnotice.FieldID)
+		for _, f := range fields {
+			if !wardnotice.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != wardnotice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wnuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if wnuo.mutation.OrganizationIdCleared() {
+		_spec.ClearField(wardnotice.FieldOrganizationId, field.TypeInt)
+	}
+	if wnuo.mutation.ParamsCleared() {
+		_spec.ClearField(wardnotice.FieldParams, field.TypeJSON)
+	}
+	if value, ok := wnuo.mutation.Status(); ok {
+		_spec.SetField(wardnotice.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := wnuo.mutation.SentAt(); ok {
+		_spec.SetField(wardnotice.FieldSentAt, field.TypeTime, value)
+	}
+	if wnuo.mutation.SentAtCleared() {
+		_spec.ClearField(wardnotice.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := wnuo.mutation.ClaimedUntil(); ok {
+		_spec.SetField(wardnotice.FieldClaimedUntil, field.TypeTime, value)
+	}
+	if wnuo.mutation.ClaimedUntilCleared() {
+		_spec.ClearField(wardnotice.FieldClaimedUntil, field.TypeTime)
+	}
+	if value, ok := wnuo.mutation.Attempts(); ok {
+		_spec.SetField(wardnotice.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wnuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(wardnotice.FieldAttempts, field.TypeInt, value)
+	}
+	_node = &WardNotice{config: wnuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wnuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wardnotice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wnuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,139 @@
+package rule
+
+import (
+	"context"
+	"entgo.io/ent/dialect/sql"
+	"hospital/internal/models/role"
+	"hospital/internal/models/session"
+	"hospital/internal/modules/db/ent"
+	"hospital/internal/modules/db/ent/doctor"
+	"hospital/internal/modules/db/ent/patient"
+	"hospital/internal/modules/db/ent/predicate"
+	"hospital/internal/modules/db/ent/privacy"
+	"hospital/internal/modules/db/ent/room"
+	"hospital/internal/modules/db/ent/vital"
+)
+
+// DenyIfNoViewer запрещает запросы без сессии пользователя.
+// Системные вызовы проходят через privacy.DecisionContext и сюда не попадают.
+func DenyIfNoViewer() privacy.QueryMutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		if _, ok := session.GetSessionFromCtx(ctx); !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		return privacy.Skip
+	})
+}
+
+// AllowIfAdmin разрешает администраторам и главврачу доступ ко всем записям
+func AllowIfAdmin() privacy.QueryMutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		s, _ := session.GetSessionFromCtx(ctx)
+		if role.IsAdmin(s.Role) {
+			return privacy.Allow
+		}
+		return privacy.Skip
+	})
+}
+
+// AllowIfRole разрешает изменения пользователям с указанной ролью
+func AllowIfRole(r string) privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, _ ent.Mutation) error {
+		s, _ := session.GetSessionFromCtx(ctx)
+		if s.Role == r {
+			return privacy.Allow
+		}
+		return privacy.Skip
+	})
+}
+
+// FilterPatients оставляет в выборке пациентов отделения пользователя и закрепленных за ним
+func FilterPatients() privacy.QueryRule {
+	return privacy.PatientQueryRuleFunc(func(ctx context.Context, q *ent.PatientQuery) error {
+		s, _ := session.GetSessionFromCtx(ctx)
+		q.Where(visiblePatients(s))
+		return privacy.Allow
+	})
+}
+
+// FilterPatientMutations ограничивает изменения пациентами, которых пользователь видит,
+// а новых пациентов разрешает размещать только в палатах своего отделения
+func FilterPatientMutations() privacy.MutationRule {
+	return privacy.PatientMutationRuleFunc(func(ctx context.Context, m *ent.PatientMutation) error {
+		s, _ := session.GetSessionFromCtx(ctx)
+		if !m.Op().Is(ent.OpCreate) {
+			m.Where(visiblePatients(s))
+			return privacy.Allow
+		}
+
+		roomId, ok := m.RoomNumber()
+		if !ok {
+			return privacy.Skip
+		}
+		exist, err := m.Client().Room.Query().
+			Where(room.IDEQ(roomId), room.DepartmentIdEQ(s.DepartmentId)).
+			Exist(ctx)
+		if err != nil {
+			return privacy.Denyf("checking patient room: %v", err)
+		}
+		if !exist {
+			return privacy.Denyf("room %d is out of viewer department", roomId)
+		}
+		return privacy.Allow
+	})
+}
+
+// FilterRooms оставляет в выборке палаты отделения пользователя
+func FilterRooms() privacy.QueryRule {
+	return privacy.RoomQueryRuleFunc(func(ctx context.Context, q *ent.RoomQuery) error {
+		s, _ := session.GetSessionFromCtx(ctx)
+		q.Where(room.DepartmentIdEQ(s.DepartmentId))
+		return privacy.Allow
+	})
+}
+
+// FilterVitals оставляет в выборке замеры пациентов, которых пользователь видит
+func FilterVitals() privacy.QueryRule {
+	return privacy.VitalQueryRuleFunc(func(ctx context.Context, q *ent.VitalQuery) error {
+		s, _ := session.GetSessionFromCtx(ctx)
+		q.Where(vitalsOf(visiblePatients(s)))
+		return privacy.Allow
+	})
+}
+
+// FilterVitalMutations разрешает вносить замеры только пациентам, которых пользователь видит.
+// Пациент читается с правами пользователя, поэтому видимость решают правила пациентов.
+func FilterVitalMutations() privacy.MutationRule {
+	return privacy.VitalMutationRuleFunc(func(ctx context.Context, m *ent.VitalMutation) error {
+		patientId, ok := m.PatientId()
+		if !ok {
+			return privacy.Skip
+		}
+		exist, err := m.Client().Patient.Query().Where(patient.IDEQ(patientId)).Exist(ctx)
+		if err != nil {
+			return privacy.Denyf("checking vital patient: %v", err)
+		}
+		if !exist {
+			return privacy.Denyf("patient %d is out of viewer reach", patientId)
+		}
+		return privacy.Allow
+	})
+}
+
+// vitalsOf - замеры пациентов, которых выбирает p. У замера нет связи с пациентом
+// в схеме, поэтому пациенты выбираются подзапросом.
+func vitalsOf(p predicate.Patient) predicate.Vital {
+	return predicate.Vital(func(s *sql.Selector) {
+		t := sql.Table(patient.Table)
+		patients := sql.Select(t.C(patient.FieldID)).From(t)
+		p(patients)
+		s.Where(sql.In(s.C(vital.FieldPatientId), patients))
+	})
+}
+
+func visiblePatients(s session.Session) predicate.Patient {
+	return patient.Or(
+		patient.HasDoctorWith(doctor.IDEQ(s.UserId)),
+		patient.HasRepoWith(room.DepartmentIdEQ(s.DepartmentId)),
+	)
+}
@@ -0,0 +1,435 @@
+package rule_test
+
+import (
+	"context"
+	"errors"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"hospital/internal/models/role"
+	"hospital/internal/models/session"
+	"hospital/internal/modules/config"
+	"hospital/internal/modules/db"
+	"hospital/internal/modules/db/ent"
+	"hospital/internal/modules/db/ent/privacy"
+	"hospital/internal/modules/logger"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// fixture - две клиники: в клинике A два отделения, в клинике B одно.
+// Врач doctor работает в первом отделении клиники A, admin - администратор клиники A.
+type fixture struct {
+	orgA, orgB     int
+	deptA1, deptA2 int
+	roomA1, roomA2 int
+	roomB          int
+	doctor, admin  int
+	doctorB        int
+	// patientA1 лежит в отделении врача, patientA2 - в другом отделении, но закреплен за врачом,
+	// patientA3 - в другом отделении, patientB - в другой клинике
+	patientA1, patientA2, patientA3, patientB int
+}
+
+// Пользователи, от имени которых выполняются запросы
+func (f *fixture) doctorCtx() context.Context {
+	return session.SetSessionToCtx(context.Background(), session.Session{
+		UserId: f.doctor, Role: role.Doctor, DepartmentId: f.deptA1, OrganizationId: f.orgA,
+	})
+}
+
+func (f *fixture) adminCtx() context.Context {
+	return session.SetSessionToCtx(context.Background(), session.Session{
+		UserId: f.admin, Role: role.Admin, OrganizationId: f.orgA,
+	})
+}
+
+func globalAdminCtx() context.Context {
+	return session.SetSessionToCtx(context.Background(), session.Session{UserId: 1, Role: role.Admin})
+}
+
+func newTestClient(t *testing.T) *ent.Client {
+	log, levelog, err := logger.NewLogger()
+	if err != nil {
+		t.Fatalf("failed to init logger: %v", err)
+	}
+	cfg, err := config.NewConfig(log, levelog)
+	if err != nil {
+		t.Fatalf("failed to init config: %v", err)
+	}
+	client, err := db.NewDBClient(cfg, log)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	if err = client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	if err = db.TruncateAll(client); err != nil {
+		t.Fatalf("failed to truncate all: %v", err)
+	}
+	return client
+}
+
+func newFixture(t *testing.T, client *ent.Client) *fixture {
+	ctx := db.SystemContext(context.Background())
+	check := func(err error) {
+		if err != nil {
+			t.Fatalf("failed to create fixture: %v", err)
+		}
+	}
+	f := &fixture{}
+
+	orgA, err := client.Organization.Create().SetName("Клиника A").Save(ctx)
+	check(err)
+	orgB, err := client.Organization.Create().SetName("Клиника B").Save(ctx)
+	check(err)
+	f.orgA, f.orgB = orgA.ID, orgB.ID
+
+	doctor, err := client.Doctor.Create().SetTokenId("1001").SetSurname("Смирнов").SetSpeciality("Хирург").SetRole(role.Doctor).Save(ctx)
+	check(err)
+	admin, err := client.Doctor.Create().SetTokenId("1002").SetSurname("Волков").SetSpeciality("Главврач").SetRole(role.Admin).Save(ctx)
+	check(err)
+	doctorB, err := client.Doctor.Create().SetTokenId("1003").SetSurname("Кузнецов").SetSpeciality("Терапевт").SetRole(role.Doctor).Save(ctx)
+	check(err)
+	f.doctor, f.admin, f.doctorB = doctor.ID, admin.ID, doctorB.ID
+
+	deptA1, err := client.Department.Create().SetOrganizationId(f.orgA).SetName("Хирургия").AddDoctorIDs(f.doctor, f.admin).Save(ctx)
+	check(err)
+	deptA2, err := client.Department.Create().SetOrganizationId(f.orgA).SetName("Терапия").Save(ctx)
+	check(err)
+	deptB, err := client.Department.Create().SetOrganizationId(f.orgB).SetName("Хирургия").AddDoctorIDs(f.doctorB).Save(ctx)
+	check(err)
+	f.deptA1, f.deptA2 = deptA1.ID, deptA2.ID
+
+	newRoom := func(org, dept, number int) int {
+		r, err := client.Room.Create().
+			SetOrganizationId(org).
+			SetDepartmentId(dept).
+			SetNumber(number).
+			SetFloor(1).
+			SetNumberBeds(4).
+			SetNumberPatients(0).
+			SetTypeRoom("Обычная").
+			Save(ctx)
+		check(err)
+		return r.ID
+	}
+	// Номер 101 есть в обеих клиниках: номера палат уникальны только в пределах клиники
+	f.roomA1 = newRoom(f.orgA, f.deptA1, 101)
+	f.roomA2 = newRoom(f.orgA, f.deptA2, 102)
+	f.roomB = newRoom(f.orgB, deptB.ID, 101)
+
+	newPatient := func(org, roomId int, surname string, doctors ...int) int {
+		p, err := client.Patient.Create().
+			SetOrganizationId(org).
+			SetSurname(surname).
+			SetName("Иван").
+			SetPatronymic("Иванович").
+			SetHeight(180).
+			SetWeight(80).
+			SetDegreeOfDanger(1).
+			SetRoomNumber(roomId).
+			AddDoctorIDs(doctors...).
+			Save(ctx)
+		check(err)
+		return p.ID
+	}
+	f.patientA1 = newPatient(f.orgA, f.roomA1, "Иванов")
+	f.patientA2 = newPatient(f.orgA, f.roomA2, "Петров", f.doctor)
+	f.patientA3 = newPatient(f.orgA, f.roomA2, "Сидоров")
+	f.patientB = newPatient(f.orgB, f.roomB, "Орлов")
+
+	return f
+}
+
+func sorted(ids []int) []int {
+	sort.Ints(ids)
+	return ids
+}
+
+func TestRules_Query(t *testing.T) {
+	client := newTestClient(t)
+	f := newFixture(t, client)
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		query    func(ctx context.Context) ([]int, error)
+		want     []int
+		wantDeny bool
+	}{
+		{
+			name:  "Doctor sees patients of his department and his own",
+			ctx:   f.doctorCtx(),
+			query: client.Patient.Query().IDs,
+			want:  []int{f.patientA1, f.patientA2},
+		},
+		{
+			name:  "Doctor sees rooms of his department",
+			ctx:   f.doctorCtx(),
+			query: client.Room.Query().IDs,
+			want:  []int{f.roomA1},
+		},
+		{
+			name:  "Doctor sees doctors of his clinic",
+			ctx:   f.doctorCtx(),
+			query: client.Doctor.Query().IDs,
+			want:  []int{f.doctor, f.admin},
+		},
+		{
+			name:  "Clinic admin sees every patient of the clinic",
+			ctx:   f.adminCtx(),
+			query: client.Patient.Query().IDs,
+			want:  []int{f.patientA1, f.patientA2, f.patientA3},
+		},
+		{
+			name:  "Clinic admin sees every room of the clinic",
+			ctx:   f.adminCtx(),
+			query: client.Room.Query().IDs,
+			want:  []int{f.roomA1, f.roomA2},
+		},
+		{
+			name:  "Admin without a clinic sees all clinics",
+			ctx:   globalAdminCtx(),
+			query: client.Patient.Query().IDs,
+			want:  []int{f.patientA1, f.patientA2, f.patientA3, f.patientB},
+		},
+		{
+			name:  "Admin without a clinic sees every doctor",
+			ctx:   globalAdminCtx(),
+			query: client.Doctor.Query().IDs,
+			want:  []int{f.doctor, f.admin, f.doctorB},
+		},
+		{
+			name:     "Patients are hidden without a viewer",
+			ctx:      context.Background(),
+			query:    client.Patient.Query().IDs,
+			wantDeny: true,
+		},
+		{
+			name:     "Rooms are hidden without a viewer",
+			ctx:      context.Background(),
+			query:    client.Room.Query().IDs,
+			wantDeny: true,
+		},
+		{
+			name:  "Doctors are visible without a viewer for login",
+			ctx:   context.Background(),
+			query: client.Doctor.Query().IDs,
+			want:  []int{f.doctor, f.admin, f.doctorB},
+		},
+	}
+
+	for _, tt := range tests {
+		runner.Run(t, tt.name, func(t provider.T) {
+			got, err := tt.query(tt.ctx)
+			if tt.wantDeny {
+				if !errors.Is(err, privacy.Deny) {
+					t.Errorf("query error = %v, want privacy deny", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("query error = %v", err)
+			}
+			if !reflect.DeepEqual(sorted(got), sorted(tt.want)) {
+				t.Errorf("query got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRules_Mutation(t *testing.T) {
+	client := newTestClient(t)
+	f := newFixture(t, client)
+
+	newPatient := func(roomId int) *ent.PatientCreate {
+		return client.Patient.Create().
+			SetSurname("Новиков").
+			SetName("Петр").
+			SetPatronymic("Петрович").
+			SetHeight(175).
+			SetWeight(70).
+			SetDegreeOfDanger(1).
+			SetRoomNumber(roomId)
+	}
+	newVital := func(patientId int) *ent.VitalCreate {
+		return client.Vital.Create().
+			SetPatientId(patientId).
+			SetPulse(70).
+			SetSystolic(120).
+			SetDiastolic(80).
+			SetTemperature(36.6).
+			SetSaturation(98)
+	}
+	newRoom := func(number int) *ent.RoomCreate {
+		return client.Room.Create().
+			SetNumber(number).
+			SetFloor(2).
+			SetNumberBeds(2).
+			SetNumberPatients(0).
+			SetTypeRoom("Обычная")
+	}
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		exec  func(ctx context.Context) error
+		check func(err error) bool
+	}{
+		{
+			name: "Doctor admits a patient to his department within his clinic",
+			ctx:  f.doctorCtx(),
+			exec: func(ctx context.Context) error {
+				p, err := newPatient(f.roomA1).Save(ctx)
+				if err == nil && p.OrganizationId != f.orgA {
+					return errors.New("patient is created outside the doctor clinic")
+				}
+				return err
+			},
+			check: func(err error) bool { return err == nil },
+		},
+		{
+			name:  "Doctor cannot admit a patient to another department",
+			ctx:   f.doctorCtx(),
+			exec:  func(ctx context.Context) error { return newPatient(f.roomA2).Exec(ctx) },
+			check: func(err error) bool { return errors.Is(err, privacy.Deny) },
+		},
+		{
+			name: "Doctor cannot change a patient of another clinic",
+			ctx:  f.doctorCtx(),
+			exec: func(ctx context.Context) error {
+				return client.Patient.UpdateOneID(f.patientB).SetWeight(90).Exec(ctx)
+			},
+			check: ent.IsNotFound,
+		},
+		{
+			name:  "Doctor cannot delete rooms",
+			ctx:   f.doctorCtx(),
+			exec:  func(ctx context.Context) error { return client.Room.DeleteOneID(f.roomA1).Exec(ctx) },
+			check: func(err error) bool { return errors.Is(err, privacy.Deny) },
+		},
+		{
+			name: "Clinic admin cannot change a room of another clinic",
+			ctx:  f.adminCtx(),
+			exec: func(ctx context.Context) error {
+				return client.Room.UpdateOneID(f.roomB).SetNumberBeds(6).Exec(ctx)
+			},
+			check: ent.IsNotFound,
+		},
+		{
+			name:  "Room number is unique within a clinic",
+			ctx:   f.adminCtx(),
+			exec:  func(ctx context.Context) error { return newRoom(102).Exec(ctx) },
+			check: ent.IsConstraintError,
+		},
+		{
+			name: "Clinic admin creates a room in his clinic",
+			ctx:  f.adminCtx(),
+			exec: func(ctx context.Context) error {
+				r, err := newRoom(103).Save(ctx)
+				if err == nil && r.OrganizationId != f.orgA {
+					return errors.New("room is created outside the admin clinic")
+				}
+				return err
+			},
+			check: func(err error) bool { return err == nil },
+		},
+		{
+			name: "Admin without a clinic changes any clinic",
+			ctx:  globalAdminCtx(),
+			exec: func(ctx context.Context) error {
+				return client.Patient.UpdateOneID(f.patientB).SetWeight(90).Exec(ctx)
+			},
+			check: func(err error) bool { return err == nil },
+		},
+		{
+			name: "Doctor changes his own language",
+			ctx:  f.doctorCtx(),
+			exec: func(ctx context.Context) error {
+				return client.Doctor.UpdateOneID(f.doctor).SetLanguage("en").Exec(ctx)
+			},
+			check: func(err error) bool { return err == nil },
+		},
+		{
+			name: "Doctor cannot change another doctor",
+			ctx:  f.doctorCtx(),
+			exec: func(ctx context.Context) error {
+				return client.Doctor.UpdateOneID(f.admin).SetOnCall(true).Exec(ctx)
+			},
+			check: ent.IsNotFound,
+		},
+		{
+			name: "Doctor cannot change his own role",
+			ctx:  f.doctorCtx(),
+			exec: func(ctx context.Context) error {
+				return client.Doctor.UpdateOneID(f.doctor).SetRole(role.Admin).Exec(ctx)
+			},
+			check: func(err error) bool { return errors.Is(err, privacy.Deny) },
+		},
+		{
+			name: "Clinic admin changes the role of a clinic doctor",
+			ctx:  f.adminCtx(),
+			exec: func(ctx context.Context) error {
+				return client.Doctor.UpdateOneID(f.doctor).SetRole(role.Nurse).Exec(ctx)
+			},
+			check: func(err error) bool { return err == nil },
+		},
+		{
+			name: "Clinic admin cannot change the role of another clinic doctor",
+			ctx:  f.adminCtx(),
+			exec: func(ctx context.Context) error {
+				return client.Doctor.UpdateOneID(f.doctorB).SetRole(role.Nurse).Exec(ctx)
+			},
+			check: ent.IsNotFound,
+		},
+		{
+			name: "Clinic admin cannot change the language of a clinic doctor",
+			ctx:  f.adminCtx(),
+			exec: func(ctx context.Context) error {
+				return client.Doctor.UpdateOneID(f.doctor).SetLanguage("en").Exec(ctx)
+			},
+			check: ent.IsNotFound,
+		},
+		{
+			name: "Admin cannot sign up",
+			ctx:  context.Background(),
+			exec: func(ctx context.Context) error {
+				return client.Doctor.Create().SetTokenId("1004").SetSurname("Орлов").SetSpeciality("Терапевт").SetRole(role.Admin).Exec(ctx)
+			},
+			check: func(err error) bool { return errors.Is(err, privacy.Deny) },
+		},
+		{
+			name:  "Doctor records vitals of a visible patient",
+			ctx:   f.doctorCtx(),
+			exec:  func(ctx context.Context) error { return newVital(f.patientA2).Exec(ctx) },
+			check: func(err error) bool { return err == nil },
+		},
+		{
+			name:  "Doctor cannot record vitals of a patient of another department",
+			ctx:   f.doctorCtx(),
+			exec:  func(ctx context.Context) error { return newVital(f.patientA3).Exec(ctx) },
+			check: func(err error) bool { return errors.Is(err, privacy.Deny) },
+		},
+		{
+			name:  "Clinic admin cannot record vitals of another clinic patient",
+			ctx:   f.adminCtx(),
+			exec:  func(ctx context.Context) error { return newVital(f.patientB).Exec(ctx) },
+			check: func(err error) bool { return errors.Is(err, privacy.Deny) },
+		},
+		{
+			name:  "Mutations are denied without a viewer",
+			ctx:   context.Background(),
+			exec:  func(ctx context.Context) error { return newRoom(104).Exec(ctx) },
+			check: func(err error) bool { return errors.Is(err, privacy.Deny) },
+		},
+	}
+
+	for _, tt := range tests {
+		runner.Run(t, tt.name, func(t provider.T) {
+			if err := tt.exec(tt.ctx); !tt.check(err) {
+				t.Errorf("mutation error = %v", err)
+			}
+		})
+	}
+}
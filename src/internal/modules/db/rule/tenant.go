@@ -0,0 +1,108 @@
+package rule
+
+import (
+	"context"
+	"entgo.io/ent/entql"
+	"hospital/internal/models/role"
+	"hospital/internal/models/session"
+	"hospital/internal/modules/db/ent"
+	"hospital/internal/modules/db/ent/department"
+	"hospital/internal/modules/db/ent/doctor"
+	"hospital/internal/modules/db/ent/privacy"
+)
+
+// SetTenant проставляет новым записям организацию пользователя.
+// Записи, созданные без организации в сессии (системой или администратором без клиники),
+// остаются без нее.
+func SetTenant() ent.Hook {
+	type tenantSetter interface {
+		SetOrganizationId(int)
+	}
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			s, ok := session.GetSessionFromCtx(ctx)
+			if ts, isSetter := m.(tenantSetter); ok && isSetter && s.OrganizationId != 0 && m.Op().Is(ent.OpCreate) {
+				ts.SetOrganizationId(s.OrganizationId)
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// FilterTenant ограничивает запросы и изменения организацией пользователя.
+// Администратор без организации управляет клиниками и видит данные всех организаций.
+func FilterTenant() privacy.QueryMutationRule {
+	type tenantFilter interface {
+		WhereOrganizationId(entql.IntP)
+	}
+	return privacy.FilterFunc(func(ctx context.Context, f privacy.Filter) error {
+		s, ok := session.GetSessionFromCtx(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		if s.OrganizationId == 0 {
+			if role.IsAdmin(s.Role) {
+				return privacy.Skip
+			}
+			return privacy.Denyf("viewer has no organization")
+		}
+		tf, ok := f.(tenantFilter)
+		if !ok {
+			return privacy.Denyf("unexpected filter type %T", f)
+		}
+		tf.WhereOrganizationId(entql.IntEQ(s.OrganizationId))
+		return privacy.Skip
+	})
+}
+
+// FilterDoctors оставляет в выборке врачей клиники пользователя и его самого.
+// Запросы без сессии нужны для входа в систему и регистрации.
+func FilterDoctors() privacy.QueryRule {
+	return privacy.DoctorQueryRuleFunc(func(ctx context.Context, q *ent.DoctorQuery) error {
+		s, ok := session.GetSessionFromCtx(ctx)
+		if !ok || s.OrganizationId == 0 && role.IsAdmin(s.Role) {
+			return privacy.Allow
+		}
+		q.Where(doctor.Or(
+			doctor.IDEQ(s.UserId),
+			doctor.HasDepartmentsWith(department.OrganizationIdEQ(s.OrganizationId)),
+		))
+		return privacy.Allow
+	})
+}
+
+// FilterDoctorMutations разрешает врачу менять и удалять только свою запись, а роль -
+// только администратору клиники у врачей этой клиники. Без системного контекста врача
+// можно создать лишь с ролью, доступной при регистрации.
+func FilterDoctorMutations() privacy.MutationRule {
+	return privacy.DoctorMutationRuleFunc(func(ctx context.Context, m *ent.DoctorMutation) error {
+		if m.Op().Is(ent.OpCreate) {
+			if r, _ := m.Role(); r == role.Doctor || r == role.Nurse {
+				return privacy.Allow
+			}
+			return privacy.Denyf("doctor role is assigned by clinic admin")
+		}
+
+		s, ok := session.GetSessionFromCtx(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		clinicAdmin := role.IsAdmin(s.Role) && s.OrganizationId != 0
+		inClinic := doctor.HasDepartmentsWith(department.OrganizationIdEQ(s.OrganizationId))
+
+		if _, changed := m.Role(); changed {
+			// Роль меняется отдельно от остальных полей: их врач меняет себе сам
+			if !clinicAdmin || len(m.Fields()) > 1 {
+				return privacy.Denyf("doctor role is changed only by clinic admin")
+			}
+			m.Where(inClinic)
+			return privacy.Allow
+		}
+		if m.Op().Is(ent.OpDelete|ent.OpDeleteOne) && clinicAdmin {
+			m.Where(doctor.Or(doctor.IDEQ(s.UserId), inClinic))
+			return privacy.Allow
+		}
+		m.Where(doctor.IDEQ(s.UserId))
+		return privacy.Allow
+	})
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	gen "hospital/internal/modules/db/ent"
+	"hospital/internal/modules/db/ent/hook"
+	"time"
+)
+
+// AccessLog holds the schema definition for the AccessLog entity.
+// Каждая запись - факт просмотра данных одного пациента.
+type AccessLog struct {
+	ent.Schema
+}
+
+// Mixin of the AccessLog.
+func (AccessLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the AccessLog.
+func (AccessLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("actorId").Optional().Nillable().Immutable(),
+		field.String("sessionId").Optional().Immutable(),
+		field.Int("patientId").Immutable(),
+		field.String("action").Immutable(),
+		field.String("purpose").Immutable(),
+		field.Time("createdAt").Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the AccessLog.
+func (AccessLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("patientId"),
+		index.Fields("actorId", "createdAt"),
+	}
+}
+
+// Hooks of the AccessLog.
+func (AccessLog) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.Reject(gen.OpUpdate | gen.OpUpdateOne | gen.OpDelete | gen.OpDeleteOne),
+	}
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// Alert holds the schema definition for the Alert entity.
+// Уведомление одному врачу о событии с пациентом; бот доставляет его в чат врача.
+type Alert struct {
+	ent.Schema
+}
+
+// Mixin of the Alert.
+func (Alert) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the Alert.
+func (Alert) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("kind").Immutable(),
+		field.String("severity").Immutable(),
+		field.Int("patientId").Immutable(),
+		field.Int("doctorId").Immutable(),
+		// Значения для текста уведомления: фамилия пациента, показатели и т.п.
+		field.JSON("params", map[string]string{}).Optional().Immutable(),
+		field.String("status"),
+		// Отложенные на время тишины уведомления доставляются не раньше deliverAfter
+		field.Time("deliverAfter").Optional().Nillable(),
+		field.Time("sentAt").Optional().Nillable(),
+		// Уведомление в состоянии sending забрал на отправку экземпляр бота; если до claimedUntil
+		// оно не отправлено, бот считается упавшим и уведомление выдается снова
+		field.Time("claimedUntil").Optional().Nillable(),
+		field.Int("attempts").Default(0),
+		// Подтверждение получения: кто и когда нажал кнопку в уведомлении
+		field.Time("ackedAt").Optional().Nillable(),
+		field.Int("ackedBy").Optional().Nillable(),
+		// parentId - исходное уведомление, если это его эскалация;
+		// escalationLevel - сколько шагов эскалации исходного уведомления уже пройдено
+		field.Int("parentId").Optional().Nillable().Immutable(),
+		field.Int("escalationLevel").Default(0),
+		field.Time("createdAt").Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the Alert.
+func (Alert) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "deliverAfter"),
+		index.Fields("doctorId", "createdAt"),
+		index.Fields("parentId"),
+	}
+}
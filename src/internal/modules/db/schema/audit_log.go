@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"hospital/internal/models/audit"
+	gen "hospital/internal/modules/db/ent"
+	"hospital/internal/modules/db/ent/hook"
+	"time"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity.
+// Журнал только дополняется: все поля неизменяемы, а изменение и удаление записей запрещено хуком.
+type AuditLog struct {
+	ent.Schema
+}
+
+// Mixin of the AuditLog.
+func (AuditLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("actorId").Optional().Nillable().Immutable(),
+		field.String("sessionId").Optional().Immutable(),
+		field.String("entity").Immutable(),
+		field.Int("entityId").Immutable(),
+		field.String("action").Immutable(),
+		field.JSON("changes", audit.Changes{}).Immutable(),
+		field.Time("createdAt").Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the AuditLog.
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("entity", "entityId"),
+	}
+}
+
+// Hooks of the AuditLog.
+func (AuditLog) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.Reject(gen.OpUpdate | gen.OpUpdateOne | gen.OpDelete | gen.OpDeleteOne),
+	}
+}
@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ConfirmToken holds the schema definition for the ConfirmToken entity.
+// Использованные кнопки подтверждения общие для всех экземпляров бота и переживают
+// перезапуск: подтверждение или отмена срабатывают только один раз.
+type ConfirmToken struct {
+	ent.Schema
+}
+
+// Fields of the ConfirmToken.
+func (ConfirmToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").Unique().Immutable(),
+		// expiresAt - после этого времени кнопка недействительна и запись можно удалить
+		field.Time("expiresAt").Immutable(),
+	}
+}
+
+// Edges of the ConfirmToken.
+func (ConfirmToken) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ConfirmToken.
+func (ConfirmToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expiresAt"),
+	}
+}
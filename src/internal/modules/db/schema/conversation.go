@@ -0,0 +1,27 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// Conversation holds the schema definition for the Conversation entity.
+// Незавершенный диалог бота в чате; сохраняется на каждом шаге, чтобы пережить перезапуск.
+type Conversation struct {
+	ent.Schema
+}
+
+// Fields of the Conversation.
+func (Conversation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("chatId").Unique(),
+		field.Int64("userId"),
+		field.String("dialog"),
+		field.String("state"),
+		field.JSON("values", map[string]string{}),
+		field.Strings("history").Optional(),
+		field.Bool("suspended").Default(false),
+		field.Time("updatedAt").Default(time.Now),
+	}
+}
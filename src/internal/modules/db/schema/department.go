@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"hospital/internal/modules/db/ent/privacy"
+	"hospital/internal/modules/db/rule"
+)
+
+// Department holds the schema definition for the Department entity.
+type Department struct {
+	ent.Schema
+}
+
+// Mixin of the Department.
+func (Department) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the Department.
+func (Department) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+	}
+}
+
+// Edges of the Department.
+func (Department) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("departments").
+			Field("organizationId").
+			Immutable().
+			Unique(),
+		edge.To("rooms", Room.Type),
+		edge.To("doctors", Doctor.Type).StorageKey(
+			edge.Table("department_doctor"), edge.Columns("department_id", "doctor_id"),
+		),
+	}
+}
+
+// Indexes of the Department.
+func (Department) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organizationId", "name").Unique(),
+	}
+}
+
+// Policy of the Department.
+func (Department) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"hospital/internal/models/role"
+	"hospital/internal/modules/db/ent/privacy"
+	"hospital/internal/modules/db/rule"
 )
 
 // Disease holds the schema definition for the Disease entity.
@@ -11,6 +14,13 @@ type Disease struct {
 	ent.Schema
 }
 
+// Mixin of the Disease.
+func (Disease) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 // Fields of the Disease.
 func (Disease) Fields() []ent.Field {
 	return []ent.Field{
@@ -26,3 +36,19 @@ func (Disease) Edges() []ent.Edge {
 		edge.To("has", Patient.Type),
 	}
 }
+
+// Policy of the Disease.
+func (Disease) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.AllowIfRole(role.Doctor),
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			privacy.AlwaysAllowRule(),
+		},
+	}
+}
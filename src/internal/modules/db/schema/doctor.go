@@ -2,8 +2,11 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"hospital/internal/modules/db/ent/privacy"
+	"hospital/internal/modules/db/rule"
 )
 
 //
@@ -18,6 +21,10 @@ func (Doctor) Fields() []ent.Field {
 		field.String("surname"),
 		field.String("speciality"),
 		field.String("role"),
+		// Язык интерфейса бота; пустой - язык из настроек Telegram
+		field.String("language").Optional(),
+		// Дежурный врач получает неподтвержденные критические уведомления коллег
+		field.Bool("onCall").Default(false),
 	}
 }
 
@@ -26,5 +33,22 @@ func (Doctor) Edges() []ent.Edge {
 		edge.To("treats", Patient.Type).StorageKey(
 			edge.Table("doctor_patient"), edge.Columns("doctor_id", "patient_id"),
 		),
+		// Сессии удаляются вместе с врачом
+		edge.To("sessions", Session.Type).
+			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+		edge.From("departments", Department.Type).
+			Ref("doctors"),
+	}
+}
+
+// Policy of the Doctor.
+func (Doctor) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.FilterDoctorMutations(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.FilterDoctors(),
+		},
 	}
 }
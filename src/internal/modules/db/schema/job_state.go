@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// JobState holds the schema definition for the JobState entity.
+// Состояние фоновой задачи общее для всех экземпляров приложения.
+type JobState struct {
+	ent.Schema
+}
+
+// Fields of the JobState.
+func (JobState) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Unique().Immutable(),
+		// lastRunAt - плановое время последнего запуска, по нему считается следующий
+		field.Time("lastRunAt").Optional().Nillable(),
+		field.Time("lastSuccessAt").Optional().Nillable(),
+		field.String("lastError").Optional(),
+		// attempts - сколько попыток понадобилось последнему запуску
+		field.Int("attempts").Default(0),
+		field.Time("updatedAt").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the JobState.
+func (JobState) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,24 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// NotificationPreference holds the schema definition for the NotificationPreference entity.
+// Настройки уведомлений врача; без записи действуют настройки по умолчанию.
+type NotificationPreference struct {
+	ent.Schema
+}
+
+// Fields of the NotificationPreference.
+func (NotificationPreference) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("doctorId").Unique(),
+		field.String("minSeverity"),
+		field.Strings("mutedKinds").Optional(),
+		// Время тишины в минутах от полуночи; конец может быть раньше начала - тишина через полночь
+		field.Int("quietStart").Optional().Nillable(),
+		field.Int("quietEnd").Optional().Nillable(),
+	}
+}
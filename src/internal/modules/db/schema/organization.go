@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"hospital/internal/modules/db/ent/privacy"
+	"hospital/internal/modules/db/rule"
+)
+
+// Organization holds the schema definition for the Organization entity.
+// Организация (клиника) - граница данных: пациенты, палаты и журналы разных клиник не пересекаются.
+type Organization struct {
+	ent.Schema
+}
+
+// Fields of the Organization.
+func (Organization) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Unique(),
+	}
+}
+
+// Edges of the Organization.
+func (Organization) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("departments", Department.Type),
+	}
+}
+
+// Policy of the Organization.
+func (Organization) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			privacy.AlwaysAllowRule(),
+		},
+	}
+}
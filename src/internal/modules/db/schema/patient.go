@@ -4,6 +4,8 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"hospital/internal/modules/db/ent/privacy"
+	"hospital/internal/modules/db/rule"
 )
 
 // Patient holds the schema definition for the Patient entity.
@@ -11,6 +13,13 @@ type Patient struct {
 	ent.Schema
 }
 
+// Mixin of the Patient.
+func (Patient) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 // Fields of the Patient.
 func (Patient) Fields() []ent.Field {
 	return []ent.Field{
@@ -39,3 +48,19 @@ func (Patient) Edges() []ent.Edge {
 			Unique(),
 	}
 }
+
+// Policy of the Patient.
+func (Patient) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.FilterPatientMutations(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.FilterPatients(),
+		},
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"hospital/internal/modules/db/ent/privacy"
+	"hospital/internal/modules/db/rule"
 )
 
 // Room holds the schema definition for the Room entity.
@@ -11,14 +14,22 @@ type Room struct {
 	ent.Schema
 }
 
+// Mixin of the Room.
+func (Room) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 // Fields of the Room.
 func (Room) Fields() []ent.Field {
 	return []ent.Field{
-		field.Int("number").Unique(),
+		field.Int("number"),
 		field.Int("floor"),
 		field.Int("numberBeds"),
 		field.Int("numberPatients"),
 		field.String("typeRoom"),
+		field.Int("departmentId").Optional().Nillable(),
 	}
 }
 
@@ -26,5 +37,33 @@ func (Room) Fields() []ent.Field {
 func (Room) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("contains", Patient.Type),
+		edge.From("department", Department.Type).
+			Ref("rooms").
+			Field("departmentId").
+			Unique(),
+	}
+}
+
+// Indexes of the Room.
+func (Room) Indexes() []ent.Index {
+	return []ent.Index{
+		// Номера палат уникальны в пределах клиники
+		index.Fields("organizationId", "number").Unique(),
+	}
+}
+
+// Policy of the Room.
+func (Room) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.FilterRooms(),
+		},
 	}
 }
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// Session holds the schema definition for the Session entity.
+type Session struct {
+	ent.Schema
+}
+
+// Fields of the Session.
+func (Session) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("sessionId").Unique().Immutable(),
+		field.Int("doctorId"),
+		field.Time("createdAt").Default(time.Now).Immutable(),
+		field.Time("lastSeenAt"),
+		field.Time("expiresAt"),
+		field.Time("revokedAt").Optional().Nillable(),
+		// Отделение и клиника, в которых сейчас работает врач
+		field.Int("departmentId").Optional().Nillable(),
+		field.Int("organizationId").Optional().Nillable(),
+	}
+}
+
+// Edges of the Session.
+func (Session) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("doctor", Doctor.Type).
+			Ref("sessions").
+			Field("doctorId").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"hospital/internal/modules/db/ent/privacy"
+	"hospital/internal/modules/db/rule"
+)
+
+// TenantMixin привязывает записи к организации (клинике).
+// Организация проставляется из сессии при создании, а все запросы и изменения
+// ограничиваются организацией пользователя.
+type TenantMixin struct {
+	mixin.Schema
+}
+
+// Fields of the TenantMixin.
+func (TenantMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("organizationId").Optional().Immutable(),
+	}
+}
+
+// Indexes of the TenantMixin.
+func (TenantMixin) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organizationId"),
+	}
+}
+
+// Hooks of the TenantMixin.
+func (TenantMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		rule.SetTenant(),
+	}
+}
+
+// Policy of the TenantMixin.
+func (TenantMixin) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.FilterTenant(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.FilterTenant(),
+		},
+	}
+}
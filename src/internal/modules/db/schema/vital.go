@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	gen "hospital/internal/modules/db/ent"
+	"hospital/internal/modules/db/ent/hook"
+	"hospital/internal/modules/db/ent/privacy"
+	"hospital/internal/modules/db/rule"
+	"time"
+)
+
+// Vital holds the schema definition for the Vital entity.
+// Каждая запись - один замер показателей жизнедеятельности пациента.
+type Vital struct {
+	ent.Schema
+}
+
+// Mixin of the Vital.
+func (Vital) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the Vital.
+func (Vital) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("patientId").Immutable(),
+		field.Int("authorId").Optional().Nillable().Immutable(),
+		field.Int("pulse").Immutable(),
+		field.Int("systolic").Immutable(),
+		field.Int("diastolic").Immutable(),
+		field.Float("temperature").Immutable(),
+		field.Int("saturation").Immutable(),
+		field.Time("createdAt").Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the Vital.
+func (Vital) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("patientId", "createdAt"),
+	}
+}
+
+// Hooks of the Vital.
+func (Vital) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.Reject(gen.OpUpdate | gen.OpUpdateOne | gen.OpDelete | gen.OpDeleteOne),
+	}
+}
+
+// Policy of the Vital. Замеры видны и вносятся так же, как карточки их пациентов.
+func (Vital) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.FilterVitalMutations(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.FilterVitals(),
+		},
+	}
+}
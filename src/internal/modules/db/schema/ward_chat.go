@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// WardChat holds the schema definition for the WardChat entity.
+// Групповой чат бригады: в него приходят уведомления о пациентах отделения или палат.
+type WardChat struct {
+	ent.Schema
+}
+
+// Mixin of the WardChat.
+func (WardChat) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the WardChat.
+func (WardChat) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("chatId").Unique().Immutable(),
+		field.String("title").Optional(),
+		// Чат получает уведомления о пациентах всех палат отделения departmentId
+		// и палат с номерами rooms
+		field.Int("departmentId").Optional().Nillable(),
+		field.Ints("rooms").Optional(),
+		// Язык уведомлений в чате - язык того, кто привязал чат
+		field.String("language").Optional(),
+		field.Int("createdBy"),
+		field.Time("createdAt").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the WardChat.
+func (WardChat) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// WardNotice holds the schema definition for the WardNotice entity.
+// Уведомление о событии с пациентом для группового чата бригады; бот доставляет его в чат.
+type WardNotice struct {
+	ent.Schema
+}
+
+// Mixin of the WardNotice.
+func (WardNotice) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the WardNotice.
+func (WardNotice) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("chatId").Immutable(),
+		field.String("kind").Immutable(),
+		field.String("severity").Immutable(),
+		field.Int("patientId").Immutable(),
+		field.JSON("params", map[string]string{}).Optional().Immutable(),
+		field.String("status"),
+		field.Time("sentAt").Optional().Nillable(),
+		// Срок, до которого уведомление в состоянии sending не выдается другим экземплярам бота
+		field.Time("claimedUntil").Optional().Nillable(),
+		field.Int("attempts").Default(0),
+		field.Time("createdAt").Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the WardNotice.
+func (WardNotice) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "createdAt"),
+	}
+}
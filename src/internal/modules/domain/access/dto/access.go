@@ -0,0 +1,38 @@
+package dto
+
+import "time"
+
+const (
+	ActionGet    = "get"
+	ActionList   = "list"
+	ActionSearch = "search"
+)
+
+type AccessRecord struct {
+	Id        int
+	ActorId   *int
+	SessionId string
+	PatientId int
+	Action    string
+	Purpose   string
+	CreatedAt time.Time
+}
+
+type AccessRecords []*AccessRecord
+
+type CreateAccessRecord struct {
+	ActorId   *int
+	SessionId string
+	PatientId int
+	Action    string
+	Purpose   string
+}
+
+// Anomaly - врач, просмотревший много пациентов, которых он не лечит
+type Anomaly struct {
+	DoctorId  int
+	Patients  int
+	Untreated int
+}
+
+type Anomalies []*Anomaly
@@ -0,0 +1,125 @@
+package repo
+
+import (
+	"context"
+	"entgo.io/ent/dialect/sql"
+	"fmt"
+	"hospital/internal/modules/db"
+	"hospital/internal/modules/db/ent"
+	"hospital/internal/modules/db/ent/accesslog"
+	"hospital/internal/modules/db/ent/doctor"
+	"hospital/internal/modules/domain/access/dto"
+	"time"
+)
+
+type AccessRepo struct {
+	client *ent.Client
+}
+
+func NewAccessRepo(client *ent.Client) *AccessRepo {
+	return &AccessRepo{
+		client: client,
+	}
+}
+
+func (r *AccessRepo) Create(ctx context.Context, dtms ...*dto.CreateAccessRecord) error {
+	builders := make([]*ent.AccessLogCreate, len(dtms))
+	for i, dtm := range dtms {
+		builders[i] = r.client.AccessLog.Create().
+			SetNillableActorId(dtm.ActorId).
+			SetSessionId(dtm.SessionId).
+			SetPatientId(dtm.PatientId).
+			SetAction(dtm.Action).
+			SetPurpose(dtm.Purpose)
+	}
+
+	// Журнал пишет система от имени пользователя: записи попадают в его клинику,
+	// даже если сам пользователь не может их читать
+	_, err := r.client.AccessLog.CreateBulk(builders...).Save(db.SystemContext(ctx))
+	if err != nil {
+		return db.WrapError(err)
+	}
+
+	return nil
+}
+
+func (r *AccessRepo) ListByPatient(ctx context.Context, patientId int) (dto.AccessRecords, error) {
+	Records, err := r.client.AccessLog.Query().
+		Where(accesslog.PatientIdEQ(patientId)).
+		Order(accesslog.ByCreatedAt(sql.OrderDesc()), accesslog.ByID(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToAccessRecordDTOs(Records), nil
+}
+
+// CountCardViews считает по каждому врачу, сколько разных пациентов он открыл (просмотр
+// карточки) начиная с since и сколько из них за ним не закреплено. Списки и поиск
+// не учитываются: в них пациенты попадают без выбора врача.
+func (r *AccessRepo) CountCardViews(ctx context.Context, since time.Time) (dto.Anomalies, error) {
+	var rows []struct {
+		ActorId   int `json:"actor_id"`
+		Patients  int `json:"patients"`
+		Untreated int `json:"untreated"`
+	}
+	err := r.client.AccessLog.Query().
+		Where(
+			accesslog.CreatedAtGTE(since),
+			accesslog.ActorIdNotNil(),
+			accesslog.ActionEQ(dto.ActionGet),
+		).
+		GroupBy(accesslog.FieldActorId).
+		Aggregate(
+			func(s *sql.Selector) string {
+				return sql.As(fmt.Sprintf("COUNT(DISTINCT %s)", s.C(accesslog.FieldPatientId)), "patients")
+			},
+			func(s *sql.Selector) string {
+				treats := sql.Dialect(s.Dialect()).Table(doctor.TreatsTable)
+				return sql.As(fmt.Sprintf("COUNT(DISTINCT %s) FILTER (WHERE NOT EXISTS (SELECT 1 FROM %s WHERE %s = %s AND %s = %s))",
+					s.C(accesslog.FieldPatientId),
+					s.Builder.Quote(doctor.TreatsTable),
+					treats.C(doctor.TreatsPrimaryKey[0]), s.C(accesslog.FieldActorId),
+					treats.C(doctor.TreatsPrimaryKey[1]), s.C(accesslog.FieldPatientId),
+				), "untreated")
+			},
+		).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	res := make(dto.Anomalies, len(rows))
+	for i, row := range rows {
+		res[i] = &dto.Anomaly{DoctorId: row.ActorId, Patients: row.Patients, Untreated: row.Untreated}
+	}
+
+	return res, nil
+}
+
+func ToAccessRecordDTO(model *ent.AccessLog) *dto.AccessRecord {
+	if model == nil {
+		return nil
+	}
+	return &dto.AccessRecord{
+		Id:        model.ID,
+		ActorId:   model.ActorId,
+		SessionId: model.SessionId,
+		PatientId: model.PatientId,
+		Action:    model.Action,
+		Purpose:   model.Purpose,
+		CreatedAt: model.CreatedAt,
+	}
+}
+
+func ToAccessRecordDTOs(models ent.AccessLogs) dto.AccessRecords {
+	if models == nil {
+		return nil
+	}
+	dtms := make(dto.AccessRecords, len(models))
+	for i := range models {
+		dtms[i] = ToAccessRecordDTO(models[i])
+	}
+	return dtms
+}
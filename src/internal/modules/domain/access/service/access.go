@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"hospital/internal/models/access"
+	"hospital/internal/models/errors"
+	"hospital/internal/models/session"
+	"hospital/internal/modules/config"
+	"hospital/internal/modules/domain/access/dto"
+	doctor_dto "hospital/internal/modules/domain/doctor/dto"
+	"sort"
+	"time"
+)
+
+//go:generate mockgen -destination mock_test.go -package service . IAccessRepo,IDoctorRepo
+
+type IAccessRepo interface {
+	Create(ctx context.Context, dtms ...*dto.CreateAccessRecord) error
+	ListByPatient(ctx context.Context, patientId int) (dto.AccessRecords, error)
+	CountCardViews(ctx context.Context, since time.Time) (dto.Anomalies, error)
+}
+
+type IDoctorRepo interface {
+	GetById(ctx context.Context, id int) (*doctor_dto.Doctor, error)
+}
+
+type AccessService struct {
+	repo      IAccessRepo
+	doctors   IDoctorRepo
+	threshold int
+	window    time.Duration
+	now       func() time.Time
+}
+
+func NewAccessService(repo IAccessRepo, doctors IDoctorRepo, config config.Config) *AccessService {
+	return &AccessService{
+		repo:      repo,
+		doctors:   doctors,
+		threshold: config.AccessAnomalyThreshold,
+		window:    config.AccessAnomalyWindow,
+		now:       time.Now,
+	}
+}
+
+// Record фиксирует просмотр данных пациентов текущим пользователем
+func (r *AccessService) Record(ctx context.Context, action string, patientIds []int) error {
+	if len(patientIds) == 0 {
+		return nil
+	}
+
+	var actorId *int
+	var sessionId string
+	if s, ok := session.GetSessionFromCtx(ctx); ok {
+		actorId = &s.UserId
+		sessionId = s.SessionID
+	}
+	purpose := access.GetPurposeFromCtx(ctx)
+
+	records := make([]*dto.CreateAccessRecord, len(patientIds))
+	for i, id := range patientIds {
+		records[i] = &dto.CreateAccessRecord{
+			ActorId:   actorId,
+			SessionId: sessionId,
+			PatientId: id,
+			Action:    action,
+			Purpose:   purpose,
+		}
+	}
+
+	return r.repo.Create(ctx, records...)
+}
+
+// Report - кто и когда смотрел данные пациента
+func (r *AccessService) Report(ctx context.Context, patientId int) (dto.AccessRecords, error) {
+	if err := r.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	return r.repo.ListByPatient(ctx, patientId)
+}
+
+// Anomalies находит врачей, которые за окно наблюдения открыли карточки
+// не меньше порогового числа пациентов, которых они не лечат
+func (r *AccessService) Anomalies(ctx context.Context) (dto.Anomalies, error) {
+	if err := r.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	counts, err := r.repo.CountCardViews(ctx, r.now().Add(-r.window))
+	if err != nil {
+		return nil, err
+	}
+
+	var res dto.Anomalies
+	for _, c := range counts {
+		if c.Untreated >= r.threshold {
+			res = append(res, c)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Untreated != res[j].Untreated {
+			return res[i].Untreated > res[j].Untreated
+		}
+		return res[i].DoctorId < res[j].DoctorId
+	})
+
+	return res, nil
+}
+
+func (r *AccessService) checkAdmin(ctx context.Context) error {
+	s, ok := session.GetSessionFromCtx(ctx)
+	if !ok {
+		return errors.ErrUnauthorized
+	}
+
+	doctor, err := r.doctors.GetById(ctx, s.UserId)
+	if err != nil {
+		return err
+	}
+	if !doctor.IsAdmin() {
+		return errors.ErrAccessDenied
+	}
+
+	return nil
+}
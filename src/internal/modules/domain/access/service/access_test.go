@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"hospital/internal/models/access"
+	err_c "hospital/internal/models/errors"
+	"hospital/internal/models/session"
+	"hospital/internal/modules/domain/access/dto"
+	doctor_dto "hospital/internal/modules/domain/doctor/dto"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAccessService_Record(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockIAccessRepo(ctrl)
+
+	ctx := session.SetSessionToCtx(context.Background(), session.Session{SessionID: "s1", UserId: 4})
+	ctx = access.SetPurposeToCtx(ctx, access.PurposeTreatment)
+	actorId := 4
+
+	mockRepo.EXPECT().Create(gomock.Any(),
+		&dto.CreateAccessRecord{ActorId: &actorId, SessionId: "s1", PatientId: 1, Action: dto.ActionList, Purpose: access.PurposeTreatment},
+		&dto.CreateAccessRecord{ActorId: &actorId, SessionId: "s1", PatientId: 2, Action: dto.ActionList, Purpose: access.PurposeTreatment},
+	).Return(nil)
+
+	runner.Run(t, "Every returned patient is recorded", func(t provider.T) {
+		r := &AccessService{repo: mockRepo}
+		if err := r.Record(ctx, dto.ActionList, []int{1, 2}); err != nil {
+			t.Errorf("Record() error = %v", err)
+		}
+	})
+
+	runner.Run(t, "Empty result is not recorded", func(t provider.T) {
+		r := &AccessService{repo: mockRepo}
+		if err := r.Record(ctx, dto.ActionSearch, nil); err != nil {
+			t.Errorf("Record() error = %v", err)
+		}
+	})
+}
+
+func TestAccessService_Anomalies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockIAccessRepo(ctrl)
+	mockDoctor := NewMockIDoctorRepo(ctrl)
+
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	adminCtx := session.SetSessionToCtx(context.Background(), session.Session{SessionID: "a", UserId: 1})
+	curious, attending := 2, 3
+
+	mockDoctor.EXPECT().GetById(gomock.Any(), 1).Return(&doctor_dto.Doctor{Id: 1, Role: doctor_dto.RoleAdmin}, nil)
+	mockRepo.EXPECT().CountCardViews(gomock.Any(), now.Add(-24*time.Hour)).Return(dto.Anomalies{
+		{DoctorId: attending, Patients: 4, Untreated: 0},
+		{DoctorId: curious, Patients: 4, Untreated: 3},
+	}, nil)
+
+	runner.Run(t, "Doctor opening patients they don't treat is flagged", func(t provider.T) {
+		r := &AccessService{
+			repo:      mockRepo,
+			doctors:   mockDoctor,
+			threshold: 3,
+			window:    24 * time.Hour,
+			now:       func() time.Time { return now },
+		}
+		got, err := r.Anomalies(adminCtx)
+		if err != nil {
+			t.Fatalf("Anomalies() error = %v", err)
+		}
+		want := dto.Anomalies{{DoctorId: curious, Patients: 4, Untreated: 3}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Anomalies() got = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestAccessService_Report(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockIAccessRepo(ctrl)
+	mockDoctor := NewMockIDoctorRepo(ctrl)
+
+	ctx := session.SetSessionToCtx(context.Background(), session.Session{SessionID: "b", UserId: 2})
+	mockDoctor.EXPECT().GetById(gomock.Any(), 2).Return(&doctor_dto.Doctor{Id: 2, Role: doctor_dto.RoleDoctor}, nil)
+
+	runner.Run(t, "Report is denied for non-admins", func(t provider.T) {
+		r := &AccessService{repo: mockRepo, doctors: mockDoctor}
+		if _, err := r.Report(ctx, 1); err != err_c.ErrAccessDenied {
+			t.Errorf("Report() error = %v, want %v", err, err_c.ErrAccessDenied)
+		}
+	})
+}
@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: hospital/internal/modules/domain/access/service (interfaces: IAccessRepo,IDoctorRepo)
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	dto "hospital/internal/modules/domain/access/dto"
+	dto0 "hospital/internal/modules/domain/doctor/dto"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIAccessRepo is a mock of IAccessRepo interface.
+type MockIAccessRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAccessRepoMockRecorder
+}
+
+// MockIAccessRepoMockRecorder is the mock recorder for MockIAccessRepo.
+type MockIAccessRepoMockRecorder struct {
+	mock *MockIAccessRepo
+}
+
+// NewMockIAccessRepo creates a new mock instance.
+func NewMockIAccessRepo(ctrl *gomock.Controller) *MockIAccessRepo {
+	mock := &MockIAccessRepo{ctrl: ctrl}
+	mock.recorder = &MockIAccessRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAccessRepo) EXPECT() *MockIAccessRepoMockRecorder {
+	return m.recorder
+}
+
+// CountCardViews mocks base method.
+func (m *MockIAccessRepo) CountCardViews(arg0 context.Context, arg1 time.Time) (dto.Anomalies, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCardViews", arg0, arg1)
+	ret0, _ := ret[0].(dto.Anomalies)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCardViews indicates an expected call of CountCardViews.
+func (mr *MockIAccessRepoMockRecorder) CountCardViews(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCardViews", reflect.TypeOf((*MockIAccessRepo)(nil).CountCardViews), arg0, arg1)
+}
+
+// Create mocks base method.
+func (m *MockIAccessRepo) Create(arg0 context.Context, arg1 ...*dto.CreateAccessRecord) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Create", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIAccessRepoMockRecorder) Create(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIAccessRepo)(nil).Create), varargs...)
+}
+
+// ListByPatient mocks base method.
+func (m *MockIAccessRepo) ListByPatient(arg0 context.Context, arg1 int) (dto.AccessRecords, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByPatient", arg0, arg1)
+	ret0, _ := ret[0].(dto.AccessRecords)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByPatient indicates an expected call of ListByPatient.
+func (mr *MockIAccessRepoMockRecorder) ListByPatient(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByPatient", reflect.TypeOf((*MockIAccessRepo)(nil).ListByPatient), arg0, arg1)
+}
+
+// MockIDoctorRepo is a mock of IDoctorRepo interface.
+type MockIDoctorRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockIDoctorRepoMockRecorder
+}
+
+// MockIDoctorRepoMockRecorder is the mock recorder for MockIDoctorRepo.
+type MockIDoctorRepoMockRecorder struct {
+	mock *MockIDoctorRepo
+}
+
+// NewMockIDoctorRepo creates a new mock instance.
+func NewMockIDoctorRepo(ctrl *gomock.Controller) *MockIDoctorRepo {
+	mock := &MockIDoctorRepo{ctrl: ctrl}
+	mock.recorder = &MockIDoctorRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIDoctorRepo) EXPECT() *MockIDoctorRepoMockRecorder {
+	return m.recorder
+}
+
+// GetById mocks base method.
+func (m *MockIDoctorRepo) GetById(arg0 context.Context, arg1 int) (*dto0.Doctor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", arg0, arg1)
+	ret0, _ := ret[0].(*dto0.Doctor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockIDoctorRepoMockRecorder) GetById(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockIDoctorRepo)(nil).GetById), arg0, arg1)
+}
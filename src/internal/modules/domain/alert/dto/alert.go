@@ -0,0 +1,265 @@
+package dto
+
+import (
+	"fmt"
+	"hospital/internal/models/validation"
+	"time"
+)
+
+// Виды событий, о которых уведомляются врачи
+const (
+	KindDangerRaised    = "danger_raised"
+	KindVitalsAbnormal  = "vitals_abnormal"
+	KindPatientAssigned = "patient_assigned"
+)
+
+// Kinds - виды событий в порядке показа пользователю
+var Kinds = []string{KindDangerRaised, KindVitalsAbnormal, KindPatientAssigned}
+
+// Важность уведомления по возрастанию
+const (
+	SeverityInfo     = "info"
+	SeverityWarning  = "warning"
+	SeverityCritical = "critical"
+)
+
+// Severities - уровни важности по возрастанию
+var Severities = []string{SeverityInfo, SeverityWarning, SeverityCritical}
+
+// Состояние доставки уведомления
+const (
+	// StatusPending - ждет отправки
+	StatusPending = "pending"
+	// StatusDeferred - отложено до конца времени тишины
+	StatusDeferred = "deferred"
+	// StatusSuppressed - не отправляется по настройкам врача
+	StatusSuppressed = "suppressed"
+	// StatusSending - забрано на отправку экземпляром бота
+	StatusSending = "sending"
+	StatusSent    = "sent"
+	StatusFailed  = "failed"
+	// StatusUnassigned - критическое событие с пациентом без лечащего врача: уведомление
+	// без получателя (DoctorId 0) сразу уходит на эскалацию
+	StatusUnassigned = "unassigned"
+)
+
+// Параметры текста уведомления
+const (
+	ParamPatient  = "patient"
+	ParamFrom     = "from"
+	ParamTo       = "to"
+	ParamAbnormal = "abnormal"
+	// ParamEscalatedFrom - врач, который не подтвердил исходное уведомление
+	ParamEscalatedFrom = "escalated_from"
+)
+
+// Кому пересылается неподтвержденное уведомление
+const (
+	TargetOnCall        = "on_call"
+	TargetHeadPhysician = "head_physician"
+)
+
+// EscalationStep - шаг эскалации: уведомление, не подтвержденное за After
+// после отправки, пересылается врачам Target
+type EscalationStep struct {
+	After  time.Duration
+	Target string
+}
+
+// Event - событие с пациентом. Без DoctorIds уведомляются лечащие врачи пациента.
+type Event struct {
+	Kind      string
+	Severity  string
+	PatientId int
+	DoctorIds []int
+	Params    map[string]string
+}
+
+type Alert struct {
+	Id             int
+	OrganizationId int
+	Kind           string
+	Severity       string
+	PatientId      int
+	DoctorId       int
+	Params         map[string]string
+	Status         string
+	DeliverAfter   *time.Time
+	SentAt         *time.Time
+	Attempts       int
+	AckedAt        *time.Time
+	AckedBy        *int
+	// ParentId - исходное уведомление, если это его эскалация
+	ParentId        *int
+	EscalationLevel int
+	CreatedAt       time.Time
+}
+
+// Acknowledged - подтвердил ли кто-нибудь получение уведомления
+func (r *Alert) Acknowledged() bool {
+	return r.AckedAt != nil
+}
+
+// AckDelay - через сколько после отправки уведомление подтвердили
+func (r *Alert) AckDelay() (time.Duration, bool) {
+	if r.AckedAt == nil || r.SentAt == nil {
+		return 0, false
+	}
+	return r.AckedAt.Sub(*r.SentAt), true
+}
+
+type Alerts []*Alert
+
+type CreateAlert struct {
+	Kind         string
+	Severity     string
+	PatientId    int
+	DoctorId     int
+	Params       map[string]string
+	Status       string
+	DeliverAfter *time.Time
+	// ParentId и OrganizationId задаются при эскалации, которую выполняет система без сессии
+	ParentId       *int
+	OrganizationId int
+}
+
+// Recipient - врач, которому доставляется уведомление
+type Recipient struct {
+	DoctorId int
+	// TokenId - id чата врача в Telegram
+	TokenId  string
+	Language string
+}
+
+// Delivery - уведомление вместе с получателем
+type Delivery struct {
+	Alert     *Alert
+	Recipient *Recipient
+}
+
+type Deliveries []*Delivery
+
+// AckReport - критические уведомления, которые не подтвердили вовсе
+// или подтвердили позже срока
+type AckReport struct {
+	Deadline       time.Duration
+	Unacknowledged Alerts
+	Late           Alerts
+}
+
+// Preference - настройки уведомлений врача
+type Preference struct {
+	DoctorId    int
+	MinSeverity string
+	MutedKinds  []string
+	// QuietStart и QuietEnd - время тишины в минутах от полуночи; nil - без тишины
+	QuietStart *int
+	QuietEnd   *int
+}
+
+// DefaultPreference - настройки врача, который их не менял: все уведомления без тишины
+func DefaultPreference(doctorId int) *Preference {
+	return &Preference{DoctorId: doctorId, MinSeverity: SeverityInfo}
+}
+
+// Wants - нужно ли врачу уведомление. Критические уведомления приходят всегда.
+func (r *Preference) Wants(kind string, severity string) bool {
+	if severity == SeverityCritical {
+		return true
+	}
+	for _, muted := range r.MutedKinds {
+		if muted == kind {
+			return false
+		}
+	}
+	return SeverityRank(severity) >= SeverityRank(r.MinSeverity)
+}
+
+// QuietUntil возвращает конец времени тишины, если момент t в него попадает.
+// Время тишины считается по часам t.
+func (r *Preference) QuietUntil(t time.Time) (time.Time, bool) {
+	if r.QuietStart == nil || r.QuietEnd == nil || *r.QuietStart == *r.QuietEnd {
+		return time.Time{}, false
+	}
+	start, end := *r.QuietStart, *r.QuietEnd
+	minute := t.Hour()*60 + t.Minute()
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+
+	switch {
+	case start < end && minute >= start && minute < end:
+		return midnight.Add(time.Duration(end) * time.Minute), true
+	case start > end && minute >= start:
+		// Тишина через полночь заканчивается на следующий день
+		return midnight.AddDate(0, 0, 1).Add(time.Duration(end) * time.Minute), true
+	case start > end && minute < end:
+		return midnight.Add(time.Duration(end) * time.Minute), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// SeverityRank - место уровня важности в Severities; неизвестный уровень - самый низкий
+func SeverityRank(severity string) int {
+	for i, s := range Severities {
+		if s == severity {
+			return i
+		}
+	}
+	return 0
+}
+
+// UpdatePreference - новые настройки уведомлений. QuietHours - время тишины
+// в виде 22:00-07:00, пустая строка отключает тишину.
+type UpdatePreference struct {
+	MinSeverity string
+	MutedKinds  []string
+	QuietHours  string
+}
+
+func (r *UpdatePreference) Validate() error {
+	var v validation.Validator
+	v.CheckCode(known(Severities, r.MinSeverity), "MinSeverity", "Важность", validation.CodeInvalid)
+	for _, kind := range r.MutedKinds {
+		v.CheckCode(known(Kinds, kind), "MutedKinds", "Виды уведомлений", validation.CodeInvalid)
+	}
+	_, _, err := ParseQuietHours(r.QuietHours)
+	v.CheckCode(err == nil, "QuietHours", "Время тишины", validation.CodeInvalid)
+	return v.Err()
+}
+
+// ParseQuietHours разбирает время тишины вида 22:00-07:00 в минуты от полуночи
+func ParseQuietHours(s string) (*int, *int, error) {
+	if s == "" {
+		return nil, nil, nil
+	}
+	var h1, m1, h2, m2 int
+	if _, err := fmt.Sscanf(s, "%d:%d-%d:%d", &h1, &m1, &h2, &m2); err != nil {
+		return nil, nil, fmt.Errorf("quiet hours %q: %w", s, err)
+	}
+	if !validClock(h1, m1) || !validClock(h2, m2) {
+		return nil, nil, fmt.Errorf("quiet hours %q: invalid time", s)
+	}
+	start, end := h1*60+m1, h2*60+m2
+	return &start, &end, nil
+}
+
+// FormatQuietHours - время тишины в виде 22:00-07:00; пустая строка - без тишины
+func (r *Preference) FormatQuietHours() string {
+	if r.QuietStart == nil || r.QuietEnd == nil {
+		return ""
+	}
+	return fmt.Sprintf("%02d:%02d-%02d:%02d", *r.QuietStart/60, *r.QuietStart%60, *r.QuietEnd/60, *r.QuietEnd%60)
+}
+
+func validClock(h int, m int) bool {
+	return h >= 0 && h < 24 && m >= 0 && m < 60
+}
+
+func known(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,32 @@
+package alert
+
+import (
+	"go.uber.org/fx"
+	"hospital/internal/modules/domain/alert/repo"
+	"hospital/internal/modules/domain/alert/service"
+	doctor_repo "hospital/internal/modules/domain/doctor/repo"
+)
+
+var (
+	Module = fx.Options(
+		service.Module,
+		repo.Module,
+
+		fx.Provide(
+			fx.Annotate(
+				func(r *repo.AlertRepo) *repo.AlertRepo { return r },
+				fx.As(new(service.IAlertRepo)),
+			),
+			fx.Annotate(
+				func(r *doctor_repo.DoctorRepo) *doctor_repo.DoctorRepo { return r },
+				fx.As(new(service.IDoctorRepo)),
+			),
+			repo.AsEventHandler(func(s *service.AlertService) repo.Raise { return s.Raise }),
+		),
+	)
+
+	Invokables = fx.Options(
+		service.Invokables,
+		repo.Invokables,
+	)
+)
@@ -0,0 +1,391 @@
+package repo
+
+import (
+	"context"
+	"entgo.io/ent/dialect/sql"
+	"fmt"
+	"hospital/internal/models/role"
+	"hospital/internal/modules/db"
+	"hospital/internal/modules/db/ent"
+	"hospital/internal/modules/db/ent/alert"
+	"hospital/internal/modules/db/ent/department"
+	"hospital/internal/modules/db/ent/doctor"
+	"hospital/internal/modules/db/ent/notificationpreference"
+	"hospital/internal/modules/db/ent/patient"
+	"hospital/internal/modules/domain/alert/dto"
+	"time"
+)
+
+type AlertRepo struct {
+	client *ent.Client
+}
+
+func NewAlertRepo(client *ent.Client) *AlertRepo {
+	return &AlertRepo{
+		client: client,
+	}
+}
+
+// Create сохраняет уведомления; внутри транзакции изменения, которое их подняло, - в ней же
+func (r *AlertRepo) Create(ctx context.Context, dtms ...*dto.CreateAlert) error {
+	return create(ctx, db.TxClient(ctx, r.client), dtms...)
+}
+
+func create(ctx context.Context, client *ent.Client, dtms ...*dto.CreateAlert) error {
+	if len(dtms) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.AlertCreate, len(dtms))
+	for i, dtm := range dtms {
+		builders[i] = client.Alert.Create().
+			SetKind(dtm.Kind).
+			SetSeverity(dtm.Severity).
+			SetPatientId(dtm.PatientId).
+			SetDoctorId(dtm.DoctorId).
+			SetParams(dtm.Params).
+			SetStatus(dtm.Status).
+			SetNillableDeliverAfter(dtm.DeliverAfter).
+			SetNillableParentId(dtm.ParentId)
+		if dtm.OrganizationId != 0 {
+			builders[i].SetOrganizationId(dtm.OrganizationId)
+		}
+	}
+
+	// Уведомления создает система по событию пользователя: они попадают в его клинику,
+	// даже если сам пользователь не может их читать
+	_, err := client.Alert.CreateBulk(builders...).Save(db.SystemContext(ctx))
+	if err != nil {
+		return db.WrapError(err)
+	}
+
+	return nil
+}
+
+// Attending возвращает id лечащих врачей пациента, в том числе из других отделений
+func (r *AlertRepo) Attending(ctx context.Context, patientId int) ([]int, error) {
+	ids, err := r.client.Doctor.Query().
+		Where(doctor.HasTreatsWith(patient.IDEQ(patientId))).
+		Order(doctor.ByID()).
+		IDs(db.SystemContext(ctx))
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ids, nil
+}
+
+// Preference возвращает настройки уведомлений врача или настройки по умолчанию
+func (r *AlertRepo) Preference(ctx context.Context, doctorId int) (*dto.Preference, error) {
+	model, err := r.client.NotificationPreference.Query().
+		Where(notificationpreference.DoctorIdEQ(doctorId)).
+		Only(db.SystemContext(ctx))
+	if ent.IsNotFound(err) {
+		return dto.DefaultPreference(doctorId), nil
+	}
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToPreferenceDTO(model), nil
+}
+
+// SetPreference сохраняет настройки уведомлений врача
+func (r *AlertRepo) SetPreference(ctx context.Context, dtm *dto.Preference) error {
+	ctx = db.SystemContext(ctx)
+	model, err := r.client.NotificationPreference.Query().
+		Where(notificationpreference.DoctorIdEQ(dtm.DoctorId)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		err = r.client.NotificationPreference.Create().
+			SetDoctorId(dtm.DoctorId).
+			SetMinSeverity(dtm.MinSeverity).
+			SetMutedKinds(dtm.MutedKinds).
+			SetNillableQuietStart(dtm.QuietStart).
+			SetNillableQuietEnd(dtm.QuietEnd).
+			Exec(ctx)
+		return db.WrapError(err)
+	}
+	if err != nil {
+		return db.WrapError(err)
+	}
+
+	update := model.Update().
+		SetMinSeverity(dtm.MinSeverity).
+		SetMutedKinds(dtm.MutedKinds)
+	if dtm.QuietStart != nil && dtm.QuietEnd != nil {
+		update.SetQuietStart(*dtm.QuietStart).SetQuietEnd(*dtm.QuietEnd)
+	} else {
+		update.ClearQuietStart().ClearQuietEnd()
+	}
+	if err = update.Exec(ctx); err != nil {
+		return db.WrapError(err)
+	}
+
+	return nil
+}
+
+// Claim забирает на отправку не больше limit уведомлений, которые пора доставить к моменту now,
+// и возвращает их вместе с чатами врачей. Уведомление забирается условным обновлением статуса,
+// поэтому одно и то же уведомление достается только одному экземпляру бота. Если бот упал,
+// не успев отправить уведомление, после until оно выдается снова.
+func (r *AlertRepo) Claim(ctx context.Context, now, until time.Time, limit int) (dto.Deliveries, error) {
+	ctx = db.SystemContext(ctx)
+	due := alert.Or(
+		alert.StatusEQ(dto.StatusPending),
+		alert.And(alert.StatusEQ(dto.StatusDeferred), alert.DeliverAfterLTE(now)),
+		alert.And(alert.StatusEQ(dto.StatusSending), alert.ClaimedUntilLTE(now)),
+	)
+	ids, err := r.client.Alert.Query().
+		Where(due).
+		Order(alert.ByCreatedAt(), alert.ByID()).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	claimed := make([]int, 0, len(ids))
+	for _, id := range ids {
+		n, err := r.client.Alert.Update().
+			Where(alert.IDEQ(id), due).
+			SetStatus(dto.StatusSending).
+			SetClaimedUntil(until).
+			Save(ctx)
+		if err != nil {
+			return nil, db.WrapError(err)
+		}
+		if n == 1 {
+			claimed = append(claimed, id)
+		}
+	}
+	if len(claimed) == 0 {
+		return nil, nil
+	}
+
+	models, err := r.client.Alert.Query().
+		Where(alert.IDIn(claimed...)).
+		Order(alert.ByCreatedAt(), alert.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	doctorIds := make([]int, 0, len(models))
+	for _, m := range models {
+		doctorIds = append(doctorIds, m.DoctorId)
+	}
+	doctors, err := r.client.Doctor.Query().Where(doctor.IDIn(doctorIds...)).All(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+	recipients := make(map[int]*dto.Recipient, len(doctors))
+	for _, d := range doctors {
+		recipients[d.ID] = &dto.Recipient{DoctorId: d.ID, TokenId: d.TokenId, Language: d.Language}
+	}
+
+	res := make(dto.Deliveries, 0, len(models))
+	for _, m := range models {
+		res = append(res, &dto.Delivery{Alert: ToAlertDTO(m), Recipient: recipients[m.DoctorId]})
+	}
+
+	return res, nil
+}
+
+func (r *AlertRepo) MarkSent(ctx context.Context, id int, at time.Time) error {
+	err := r.client.Alert.UpdateOneID(id).
+		SetStatus(dto.StatusSent).
+		SetSentAt(at).
+		ClearClaimedUntil().
+		AddAttempts(1).
+		Exec(db.SystemContext(ctx))
+	if err != nil {
+		return db.WrapError(err)
+	}
+
+	return nil
+}
+
+// MarkFailed учитывает неудачную попытку доставки и возвращает уведомление в очередь;
+// после attempts попыток уведомление больше не отправляется
+func (r *AlertRepo) MarkFailed(ctx context.Context, id int, attempts int) error {
+	ctx = db.SystemContext(ctx)
+	model, err := r.client.Alert.UpdateOneID(id).
+		AddAttempts(1).
+		ClearClaimedUntil().
+		Save(ctx)
+	if err != nil {
+		return db.WrapError(err)
+	}
+
+	status := dto.StatusPending
+	if model.Attempts >= attempts {
+		status = dto.StatusFailed
+	}
+	err = r.client.Alert.UpdateOneID(id).SetStatus(status).Exec(ctx)
+	if err != nil {
+		return db.WrapError(err)
+	}
+
+	return nil
+}
+
+func (r *AlertRepo) GetById(ctx context.Context, id int) (*dto.Alert, error) {
+	model, err := r.client.Alert.Get(db.SystemContext(ctx), id)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToAlertDTO(model), nil
+}
+
+// Acknowledge отмечает получение уведомлений врачом doctorId; уже подтвержденные не меняются
+func (r *AlertRepo) Acknowledge(ctx context.Context, ids []int, doctorId int, at time.Time) error {
+	err := r.client.Alert.Update().
+		Where(alert.IDIn(ids...), alert.AckedAtIsNil()).
+		SetAckedAt(at).
+		SetAckedBy(doctorId).
+		Exec(db.SystemContext(ctx))
+	if err != nil {
+		return db.WrapError(err)
+	}
+
+	return nil
+}
+
+// Unescalated возвращает критические уведомления, которые никто не подтвердил и которые
+// еще не прошли шаг эскалации level: отправленные до sentBefore, не доставленные
+// или подавленные настройками и созданные до sentBefore, а без получателя - созданные
+// до unassignedBefore
+func (r *AlertRepo) Unescalated(ctx context.Context, level int, sentBefore, unassignedBefore time.Time) (dto.Alerts, error) {
+	models, err := r.client.Alert.Query().
+		Where(
+			alert.SeverityEQ(dto.SeverityCritical),
+			alert.ParentIdIsNil(),
+			alert.AckedAtIsNil(),
+			alert.EscalationLevelLT(level),
+			alert.Or(
+				alert.And(alert.StatusEQ(dto.StatusSent), alert.SentAtLTE(sentBefore)),
+				alert.And(alert.StatusIn(dto.StatusFailed, dto.StatusSuppressed), alert.CreatedAtLTE(sentBefore)),
+				alert.And(alert.StatusEQ(dto.StatusUnassigned), alert.CreatedAtLTE(unassignedBefore)),
+			),
+		).
+		Order(alert.ByID()).
+		All(db.SystemContext(ctx))
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToAlertDTOs(models), nil
+}
+
+// EscalationTargets возвращает id врачей клиники уведомления, которым оно пересылается на шаге target.
+// Уведомление без клиники никому не пересылается: иначе оно ушло бы врачам всех клиник.
+func (r *AlertRepo) EscalationTargets(ctx context.Context, a *dto.Alert, target string) ([]int, error) {
+	if a.OrganizationId == 0 {
+		return nil, nil
+	}
+
+	query := r.client.Doctor.Query()
+	switch target {
+	case dto.TargetOnCall:
+		query.Where(doctor.OnCall(true))
+	case dto.TargetHeadPhysician:
+		query.Where(doctor.RoleEQ(role.HeadPhysician))
+	default:
+		return nil, fmt.Errorf("unknown escalation target %q", target)
+	}
+	query.Where(doctor.HasDepartmentsWith(department.OrganizationIdEQ(a.OrganizationId)))
+
+	ids, err := query.Order(doctor.ByID()).IDs(db.SystemContext(ctx))
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ids, nil
+}
+
+// Escalate в одной транзакции отмечает пройденный шаг эскалации уведомления id и сохраняет
+// пересланные копии. Если шаг уже отметил другой экземпляр бота, копии не создаются.
+func (r *AlertRepo) Escalate(ctx context.Context, id int, level int, dtms ...*dto.CreateAlert) error {
+	return db.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		n, err := tx.Alert.Update().
+			Where(alert.IDEQ(id), alert.EscalationLevelLT(level)).
+			SetEscalationLevel(level).
+			Save(db.SystemContext(ctx))
+		if err != nil {
+			return db.WrapError(err)
+		}
+		if n == 0 {
+			return nil
+		}
+
+		return create(ctx, tx.Client(), dtms...)
+	})
+}
+
+// ListCritical возвращает исходные критические уведомления клиники пользователя, созданные после since
+func (r *AlertRepo) ListCritical(ctx context.Context, since time.Time) (dto.Alerts, error) {
+	models, err := r.client.Alert.Query().
+		Where(
+			alert.SeverityEQ(dto.SeverityCritical),
+			alert.StatusIn(dto.StatusSent, dto.StatusFailed, dto.StatusSuppressed, dto.StatusUnassigned),
+			alert.ParentIdIsNil(),
+			alert.CreatedAtGTE(since),
+		).
+		Order(alert.ByCreatedAt(sql.OrderDesc()), alert.ByID(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToAlertDTOs(models), nil
+}
+
+func ToAlertDTO(model *ent.Alert) *dto.Alert {
+	if model == nil {
+		return nil
+	}
+	return &dto.Alert{
+		Id:              model.ID,
+		OrganizationId:  model.OrganizationId,
+		Kind:            model.Kind,
+		Severity:        model.Severity,
+		PatientId:       model.PatientId,
+		DoctorId:        model.DoctorId,
+		Params:          model.Params,
+		Status:          model.Status,
+		DeliverAfter:    model.DeliverAfter,
+		SentAt:          model.SentAt,
+		Attempts:        model.Attempts,
+		AckedAt:         model.AckedAt,
+		AckedBy:         model.AckedBy,
+		ParentId:        model.ParentId,
+		EscalationLevel: model.EscalationLevel,
+		CreatedAt:       model.CreatedAt,
+	}
+}
+
+func ToAlertDTOs(models ent.Alerts) dto.Alerts {
+	if models == nil {
+		return nil
+	}
+	dtms := make(dto.Alerts, len(models))
+	for i := range models {
+		dtms[i] = ToAlertDTO(models[i])
+	}
+	return dtms
+}
+
+func ToPreferenceDTO(model *ent.NotificationPreference) *dto.Preference {
+	if model == nil {
+		return nil
+	}
+	return &dto.Preference{
+		DoctorId:    model.DoctorId,
+		MinSeverity: model.MinSeverity,
+		MutedKinds:  model.MutedKinds,
+		QuietStart:  model.QuietStart,
+		QuietEnd:    model.QuietEnd,
+	}
+}
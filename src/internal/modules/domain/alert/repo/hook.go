@@ -0,0 +1,185 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"go.uber.org/fx"
+	"hospital/internal/modules/db"
+	"hospital/internal/modules/db/ent"
+	"hospital/internal/modules/domain/alert/dto"
+	patient_dto "hospital/internal/modules/domain/patient/dto"
+	patient_repo "hospital/internal/modules/domain/patient/repo"
+	"strconv"
+	"strings"
+)
+
+// Raise - обработчик событий с пациентами. Обработчики предоставляют сервисы уведомлений
+// через AsEventHandler; событие передается всем по очереди.
+type Raise func(ctx context.Context, event *dto.Event) error
+
+// AsEventHandler регистрирует конструктор обработчика событий в группе "events"
+func AsEventHandler(constructor any) any {
+	return fx.Annotate(constructor, fx.ResultTags(`group:"events"`))
+}
+
+type HookParams struct {
+	fx.In
+
+	Client   *ent.Client
+	Handlers []Raise `group:"events"`
+}
+
+// InvokeEventHook подключает уведомления к изменениям пациентов и новым замерам показателей
+func InvokeEventHook(p HookParams) {
+	raise := func(ctx context.Context, event *dto.Event) error {
+		for _, handle := range p.Handlers {
+			if err := handle(ctx, event); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	p.Client.Patient.Use(PatientHook(raise))
+	p.Client.Vital.Use(VitalHook(raise))
+}
+
+// PatientHook поднимает события о росте степени опасности пациента
+// и о закреплении пациента за врачом
+func PatientHook(raise Raise) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			pm, ok := m.(*ent.PatientMutation)
+			if !ok || !pm.Op().Is(ent.OpUpdateOne) {
+				return next.Mutate(ctx, m)
+			}
+
+			var before int
+			danger, dangerChanged := pm.DegreeOfDanger()
+			if dangerChanged {
+				var err error
+				before, err = pm.OldDegreeOfDanger(ctx)
+				if err != nil {
+					return nil, err
+				}
+			}
+			doctorIds := pm.DoctorIDs()
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			p, ok := v.(*ent.Patient)
+			if !ok {
+				return v, nil
+			}
+
+			var events []*dto.Event
+			if dangerChanged && danger > before {
+				severity := dto.SeverityWarning
+				if danger >= patient_dto.MaxDanger {
+					severity = dto.SeverityCritical
+				}
+				events = append(events, &dto.Event{
+					Kind:      dto.KindDangerRaised,
+					Severity:  severity,
+					PatientId: p.ID,
+					Params: map[string]string{
+						dto.ParamPatient: patientName(p),
+						dto.ParamFrom:    strconv.Itoa(before),
+						dto.ParamTo:      strconv.Itoa(danger),
+					},
+				})
+			}
+			if len(doctorIds) > 0 {
+				events = append(events, &dto.Event{
+					Kind:      dto.KindPatientAssigned,
+					Severity:  dto.SeverityInfo,
+					PatientId: p.ID,
+					DoctorIds: doctorIds,
+					Params:    map[string]string{dto.ParamPatient: patientName(p)},
+				})
+			}
+
+			ctx = withTx(ctx, pm)
+			for _, event := range events {
+				if err = raise(ctx, event); err != nil {
+					return v, fmt.Errorf("не удалось создать уведомление: %w", err)
+				}
+			}
+
+			return v, nil
+		})
+	}
+}
+
+// VitalHook поднимает событие, если в новом замере есть показатели вне нормы
+func VitalHook(raise Raise) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			vm, ok := m.(*ent.VitalMutation)
+			if !ok || !vm.Op().Is(ent.OpCreate) {
+				return next.Mutate(ctx, m)
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			model, ok := v.(*ent.Vital)
+			if !ok {
+				return v, nil
+			}
+
+			vitals := patient_repo.ToVitalsDTO(model)
+			abnormal := vitals.Abnormal()
+			if len(abnormal) == 0 {
+				return v, nil
+			}
+
+			severity := dto.SeverityWarning
+			if vitals.Critical() {
+				severity = dto.SeverityCritical
+			}
+			params := map[string]string{
+				dto.ParamAbnormal:            strings.Join(abnormal, ","),
+				patient_dto.VitalPulse:       strconv.Itoa(vitals.Pulse),
+				patient_dto.VitalSystolic:    strconv.Itoa(vitals.Systolic),
+				patient_dto.VitalDiastolic:   strconv.Itoa(vitals.Diastolic),
+				patient_dto.VitalTemperature: strconv.FormatFloat(vitals.Temperature, 'f', 1, 64),
+				patient_dto.VitalSaturation:  strconv.Itoa(vitals.Saturation),
+			}
+			// Замер вносит медсестра, которая может не видеть карточку пациента целиком
+			if p, err := vm.Client().Patient.Get(db.SystemContext(ctx), vitals.PatientId); err == nil {
+				params[dto.ParamPatient] = patientName(p)
+			}
+
+			err = raise(withTx(ctx, vm), &dto.Event{
+				Kind:      dto.KindVitalsAbnormal,
+				Severity:  severity,
+				PatientId: vitals.PatientId,
+				Params:    params,
+			})
+			if err != nil {
+				return v, fmt.Errorf("не удалось создать уведомление: %w", err)
+			}
+
+			return v, nil
+		})
+	}
+}
+
+// withTx кладет в контекст транзакцию изменения, если ее там еще нет: уведомления
+// о событии сохраняются вместе с изменением или не сохраняются вовсе
+func withTx(ctx context.Context, m interface{ Tx() (*ent.Tx, error) }) context.Context {
+	if ent.TxFromContext(ctx) != nil {
+		return ctx
+	}
+	if tx, err := m.Tx(); err == nil {
+		return ent.NewTxContext(ctx, tx)
+	}
+	return ctx
+}
+
+func patientName(p *ent.Patient) string {
+	return strings.TrimSpace(p.Surname + " " + p.Name)
+}
@@ -0,0 +1,320 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"hospital/internal/models/errors"
+	"hospital/internal/models/session"
+	"hospital/internal/modules/config"
+	"hospital/internal/modules/domain/alert/dto"
+	doctor_dto "hospital/internal/modules/domain/doctor/dto"
+	"strconv"
+	"time"
+	// Образ приложения может не содержать базу часовых поясов
+	_ "time/tzdata"
+)
+
+//go:generate mockgen -destination mock_test.go -package service . IAlertRepo,IDoctorRepo
+
+type IAlertRepo interface {
+	Create(ctx context.Context, dtms ...*dto.CreateAlert) error
+	Attending(ctx context.Context, patientId int) ([]int, error)
+	Preference(ctx context.Context, doctorId int) (*dto.Preference, error)
+	SetPreference(ctx context.Context, dtm *dto.Preference) error
+	Claim(ctx context.Context, now, until time.Time, limit int) (dto.Deliveries, error)
+	MarkSent(ctx context.Context, id int, at time.Time) error
+	MarkFailed(ctx context.Context, id int, attempts int) error
+	GetById(ctx context.Context, id int) (*dto.Alert, error)
+	Acknowledge(ctx context.Context, ids []int, doctorId int, at time.Time) error
+	Unescalated(ctx context.Context, level int, sentBefore, unassignedBefore time.Time) (dto.Alerts, error)
+	EscalationTargets(ctx context.Context, a *dto.Alert, target string) ([]int, error)
+	Escalate(ctx context.Context, id int, level int, dtms ...*dto.CreateAlert) error
+	ListCritical(ctx context.Context, since time.Time) (dto.Alerts, error)
+}
+
+type IDoctorRepo interface {
+	GetById(ctx context.Context, id int) (*doctor_dto.Doctor, error)
+}
+
+type AlertService struct {
+	repo        IAlertRepo
+	doctors     IDoctorRepo
+	location    *time.Location
+	maxAttempts int
+	// claimTimeout - через сколько забранное, но не отправленное уведомление выдается снова
+	claimTimeout time.Duration
+	escalation   []dto.EscalationStep
+	// reportWindow - за какой период показывается отчет о подтверждениях
+	reportWindow time.Duration
+	now          func() time.Time
+}
+
+func NewAlertService(repo IAlertRepo, doctors IDoctorRepo, config config.Config) (*AlertService, error) {
+	location, err := time.LoadLocation(config.AlertTimezone)
+	if err != nil {
+		return nil, fmt.Errorf("alert timezone: %w", err)
+	}
+	if config.AlertEscalateHeadAfter != 0 && config.AlertEscalateHeadAfter <= config.AlertEscalateOnCallAfter {
+		return nil, fmt.Errorf("ALERT_ESCALATE_HEAD_AFTER must be greater than ALERT_ESCALATE_ON_CALL_AFTER")
+	}
+
+	var escalation []dto.EscalationStep
+	if config.AlertEscalateOnCallAfter > 0 {
+		escalation = append(escalation, dto.EscalationStep{After: config.AlertEscalateOnCallAfter, Target: dto.TargetOnCall})
+	}
+	if config.AlertEscalateHeadAfter > 0 {
+		escalation = append(escalation, dto.EscalationStep{After: config.AlertEscalateHeadAfter, Target: dto.TargetHeadPhysician})
+	}
+
+	return &AlertService{
+		repo:         repo,
+		doctors:      doctors,
+		location:     location,
+		maxAttempts:  config.AlertMaxAttempts,
+		claimTimeout: config.AlertClaimTimeout,
+		escalation:   escalation,
+		reportWindow: config.AlertReportWindow,
+		now:          time.Now,
+	}, nil
+}
+
+// Raise создает уведомления о событии для каждого получателя с учетом его настроек:
+// ненужные уведомления сохраняются подавленными, а попавшие во время тишины откладываются.
+// Автор изменения о собственных действиях не уведомляется. Критическое событие с пациентом
+// без лечащих врачей сохраняется без получателя и пересылается по шагам эскалации.
+func (r *AlertService) Raise(ctx context.Context, event *dto.Event) error {
+	doctorIds := event.DoctorIds
+	if doctorIds == nil {
+		var err error
+		doctorIds, err = r.repo.Attending(ctx, event.PatientId)
+		if err != nil {
+			return err
+		}
+	}
+	if len(doctorIds) == 0 {
+		if event.Severity != dto.SeverityCritical {
+			return nil
+		}
+		return r.repo.Create(ctx, &dto.CreateAlert{
+			Kind:      event.Kind,
+			Severity:  event.Severity,
+			PatientId: event.PatientId,
+			Params:    event.Params,
+			Status:    dto.StatusUnassigned,
+		})
+	}
+
+	s, _ := session.GetSessionFromCtx(ctx)
+	now := r.now().In(r.location)
+	alerts := make([]*dto.CreateAlert, 0, len(doctorIds))
+	for _, doctorId := range doctorIds {
+		if doctorId == s.UserId {
+			continue
+		}
+		pref, err := r.repo.Preference(ctx, doctorId)
+		if err != nil {
+			return err
+		}
+
+		alert := &dto.CreateAlert{
+			Kind:      event.Kind,
+			Severity:  event.Severity,
+			PatientId: event.PatientId,
+			DoctorId:  doctorId,
+			Params:    event.Params,
+			Status:    dto.StatusPending,
+		}
+		if !pref.Wants(event.Kind, event.Severity) {
+			alert.Status = dto.StatusSuppressed
+		} else if until, quiet := pref.QuietUntil(now); quiet && event.Severity != dto.SeverityCritical {
+			alert.Status = dto.StatusDeferred
+			alert.DeliverAfter = &until
+		}
+		alerts = append(alerts, alert)
+	}
+	if len(alerts) == 0 {
+		return nil
+	}
+
+	return r.repo.Create(ctx, alerts...)
+}
+
+// Due забирает на отправку уведомления, которые пора доставить; каждое уведомление достается
+// одному экземпляру бота, а не отправленные за ALERT_CLAIM_TIMEOUT выдаются снова
+func (r *AlertService) Due(ctx context.Context, limit int) (dto.Deliveries, error) {
+	now := r.now()
+	return r.repo.Claim(ctx, now, now.Add(r.claimTimeout), limit)
+}
+
+func (r *AlertService) MarkSent(ctx context.Context, id int) error {
+	return r.repo.MarkSent(ctx, id, r.now())
+}
+
+// MarkFailed учитывает неудачную доставку; после ALERT_MAX_ATTEMPTS попыток уведомление снимается с доставки
+func (r *AlertService) MarkFailed(ctx context.Context, id int) error {
+	return r.repo.MarkFailed(ctx, id, r.maxAttempts)
+}
+
+// Acknowledge подтверждает получение уведомления его получателем. Подтверждение
+// пересланной копии подтверждает и исходное уведомление, и эскалация прекращается.
+func (r *AlertService) Acknowledge(ctx context.Context, id int) (*dto.Alert, error) {
+	s, ok := session.GetSessionFromCtx(ctx)
+	if !ok {
+		return nil, errors.ErrUnauthorized
+	}
+
+	alert, err := r.repo.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if alert.DoctorId != s.UserId {
+		return nil, errors.ErrAccessDenied
+	}
+
+	ids := []int{alert.Id}
+	if alert.ParentId != nil {
+		ids = append(ids, *alert.ParentId)
+	}
+	if err = r.repo.Acknowledge(ctx, ids, s.UserId, r.now()); err != nil {
+		return nil, err
+	}
+
+	return alert, nil
+}
+
+// Escalate пересылает неподтвержденные критические уведомления по шагам эскалации:
+// сначала дежурным врачам, затем главврачу. Шаг считается пройденным, даже если
+// пересылать некому, чтобы уведомление перешло к следующему шагу. Уведомление без
+// получателя ждать подтверждения некого, поэтому первый шаг для него наступает сразу.
+func (r *AlertService) Escalate(ctx context.Context) error {
+	now := r.now()
+	for i, step := range r.escalation {
+		level := i + 1
+		unassignedAfter := step.After - r.escalation[0].After
+		alerts, err := r.repo.Unescalated(ctx, level, now.Add(-step.After), now.Add(-unassignedAfter))
+		if err != nil {
+			return err
+		}
+
+		for _, alert := range alerts {
+			targets, err := r.repo.EscalationTargets(ctx, alert, step.Target)
+			if err != nil {
+				return err
+			}
+
+			copies := make([]*dto.CreateAlert, 0, len(targets))
+			for _, doctorId := range targets {
+				if doctorId == alert.DoctorId {
+					continue
+				}
+				copies = append(copies, escalated(alert, doctorId))
+			}
+			if err = r.repo.Escalate(ctx, alert.Id, level, copies...); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// escalated - копия уведомления a для врача doctorId
+func escalated(a *dto.Alert, doctorId int) *dto.CreateAlert {
+	params := make(map[string]string, len(a.Params)+1)
+	for k, v := range a.Params {
+		params[k] = v
+	}
+	if a.DoctorId != 0 {
+		params[dto.ParamEscalatedFrom] = strconv.Itoa(a.DoctorId)
+	}
+
+	parentId := a.Id
+	return &dto.CreateAlert{
+		Kind:           a.Kind,
+		Severity:       a.Severity,
+		PatientId:      a.PatientId,
+		DoctorId:       doctorId,
+		Params:         params,
+		Status:         dto.StatusPending,
+		ParentId:       &parentId,
+		OrganizationId: a.OrganizationId,
+	}
+}
+
+// AckReport - критические уведомления за последние ALERT_REPORT_WINDOW, которые не подтвердили
+// или подтвердили позже первого шага эскалации; доступен только администраторам
+func (r *AlertService) AckReport(ctx context.Context) (*dto.AckReport, error) {
+	s, ok := session.GetSessionFromCtx(ctx)
+	if !ok {
+		return nil, errors.ErrUnauthorized
+	}
+
+	doctor, err := r.doctors.GetById(ctx, s.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if !doctor.IsAdmin() {
+		return nil, errors.ErrAccessDenied
+	}
+
+	alerts, err := r.repo.ListCritical(ctx, r.now().Add(-r.reportWindow))
+	if err != nil {
+		return nil, err
+	}
+
+	report := &dto.AckReport{Deadline: r.ackDeadline()}
+	for _, alert := range alerts {
+		if !alert.Acknowledged() {
+			report.Unacknowledged = append(report.Unacknowledged, alert)
+			continue
+		}
+		if delay, ok := alert.AckDelay(); ok && report.Deadline > 0 && delay > report.Deadline {
+			report.Late = append(report.Late, alert)
+		}
+	}
+
+	return report, nil
+}
+
+// ackDeadline - срок подтверждения: после него уведомление уходит на первый шаг эскалации
+func (r *AlertService) ackDeadline() time.Duration {
+	if len(r.escalation) == 0 {
+		return 0
+	}
+	return r.escalation[0].After
+}
+
+// Preference возвращает настройки уведомлений текущего пользователя
+func (r *AlertService) Preference(ctx context.Context) (*dto.Preference, error) {
+	s, ok := session.GetSessionFromCtx(ctx)
+	if !ok {
+		return nil, errors.ErrUnauthorized
+	}
+
+	return r.repo.Preference(ctx, s.UserId)
+}
+
+// SetPreference сохраняет настройки уведомлений текущего пользователя
+func (r *AlertService) SetPreference(ctx context.Context, dtm *dto.UpdatePreference) (*dto.Preference, error) {
+	s, ok := session.GetSessionFromCtx(ctx)
+	if !ok {
+		return nil, errors.ErrUnauthorized
+	}
+	if err := dtm.Validate(); err != nil {
+		return nil, err
+	}
+
+	quietStart, quietEnd, _ := dto.ParseQuietHours(dtm.QuietHours)
+	pref := &dto.Preference{
+		DoctorId:    s.UserId,
+		MinSeverity: dtm.MinSeverity,
+		MutedKinds:  dtm.MutedKinds,
+		QuietStart:  quietStart,
+		QuietEnd:    quietEnd,
+	}
+	if err := r.repo.SetPreference(ctx, pref); err != nil {
+		return nil, err
+	}
+
+	return pref, nil
+}
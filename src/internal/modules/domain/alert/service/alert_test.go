@@ -0,0 +1,316 @@
+package service
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	err_c "hospital/internal/models/errors"
+	"hospital/internal/models/session"
+	"hospital/internal/modules/domain/alert/dto"
+	doctor_dto "hospital/internal/modules/domain/doctor/dto"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func minutes(h int, m int) *int {
+	v := h*60 + m
+	return &v
+}
+
+func TestAlertService_Raise(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockIAlertRepo(ctrl)
+
+	moscow := time.FixedZone("MSK", 3*60*60)
+	// 23:30 по Москве
+	now := time.Date(2023, 10, 1, 20, 30, 0, 0, time.UTC)
+	morning := time.Date(2023, 10, 2, 7, 0, 0, 0, moscow)
+	ctx := session.SetSessionToCtx(context.Background(), session.Session{SessionID: "s1", UserId: 1})
+
+	quiet := &dto.Preference{DoctorId: 3, MinSeverity: dto.SeverityInfo, QuietStart: minutes(22, 0), QuietEnd: minutes(7, 0)}
+	muted := &dto.Preference{DoctorId: 4, MinSeverity: dto.SeverityInfo, MutedKinds: []string{dto.KindVitalsAbnormal}}
+
+	tests := []struct {
+		name    string
+		event   *dto.Event
+		prepare func()
+	}{
+		{
+			name:  "Attending doctors are notified according to their preferences, the author is skipped",
+			event: &dto.Event{Kind: dto.KindVitalsAbnormal, Severity: dto.SeverityWarning, PatientId: 7},
+			prepare: func() {
+				mockRepo.EXPECT().Attending(gomock.Any(), 7).Return([]int{1, 2, 3, 4}, nil)
+				mockRepo.EXPECT().Preference(gomock.Any(), 2).Return(dto.DefaultPreference(2), nil)
+				mockRepo.EXPECT().Preference(gomock.Any(), 3).Return(quiet, nil)
+				mockRepo.EXPECT().Preference(gomock.Any(), 4).Return(muted, nil)
+				mockRepo.EXPECT().Create(gomock.Any(),
+					&dto.CreateAlert{Kind: dto.KindVitalsAbnormal, Severity: dto.SeverityWarning, PatientId: 7, DoctorId: 2, Status: dto.StatusPending},
+					&dto.CreateAlert{Kind: dto.KindVitalsAbnormal, Severity: dto.SeverityWarning, PatientId: 7, DoctorId: 3, Status: dto.StatusDeferred, DeliverAfter: &morning},
+					&dto.CreateAlert{Kind: dto.KindVitalsAbnormal, Severity: dto.SeverityWarning, PatientId: 7, DoctorId: 4, Status: dto.StatusSuppressed},
+				).Return(nil)
+			},
+		},
+		{
+			name:  "Critical alerts ignore quiet hours and muted kinds",
+			event: &dto.Event{Kind: dto.KindVitalsAbnormal, Severity: dto.SeverityCritical, PatientId: 7},
+			prepare: func() {
+				mockRepo.EXPECT().Attending(gomock.Any(), 7).Return([]int{3, 4}, nil)
+				mockRepo.EXPECT().Preference(gomock.Any(), 3).Return(quiet, nil)
+				mockRepo.EXPECT().Preference(gomock.Any(), 4).Return(muted, nil)
+				mockRepo.EXPECT().Create(gomock.Any(),
+					&dto.CreateAlert{Kind: dto.KindVitalsAbnormal, Severity: dto.SeverityCritical, PatientId: 7, DoctorId: 3, Status: dto.StatusPending},
+					&dto.CreateAlert{Kind: dto.KindVitalsAbnormal, Severity: dto.SeverityCritical, PatientId: 7, DoctorId: 4, Status: dto.StatusPending},
+				).Return(nil)
+			},
+		},
+		{
+			name:  "Event with recipients is not routed to attending doctors",
+			event: &dto.Event{Kind: dto.KindPatientAssigned, Severity: dto.SeverityInfo, PatientId: 7, DoctorIds: []int{5}},
+			prepare: func() {
+				mockRepo.EXPECT().Preference(gomock.Any(), 5).Return(&dto.Preference{DoctorId: 5, MinSeverity: dto.SeverityWarning}, nil)
+				mockRepo.EXPECT().Create(gomock.Any(),
+					&dto.CreateAlert{Kind: dto.KindPatientAssigned, Severity: dto.SeverityInfo, PatientId: 7, DoctorId: 5, Status: dto.StatusSuppressed},
+				).Return(nil)
+			},
+		},
+		{
+			name:  "Patient without other attending doctors produces no alerts",
+			event: &dto.Event{Kind: dto.KindDangerRaised, Severity: dto.SeverityWarning, PatientId: 8},
+			prepare: func() {
+				mockRepo.EXPECT().Attending(gomock.Any(), 8).Return([]int{1}, nil)
+			},
+		},
+		{
+			name:  "Critical event of a patient without attending doctors goes to escalation",
+			event: &dto.Event{Kind: dto.KindVitalsAbnormal, Severity: dto.SeverityCritical, PatientId: 9},
+			prepare: func() {
+				mockRepo.EXPECT().Attending(gomock.Any(), 9).Return(nil, nil)
+				mockRepo.EXPECT().Create(gomock.Any(),
+					&dto.CreateAlert{Kind: dto.KindVitalsAbnormal, Severity: dto.SeverityCritical, PatientId: 9, Status: dto.StatusUnassigned},
+				).Return(nil)
+			},
+		},
+		{
+			name:  "Warning for a patient without attending doctors produces no alerts",
+			event: &dto.Event{Kind: dto.KindDangerRaised, Severity: dto.SeverityWarning, PatientId: 9},
+			prepare: func() {
+				mockRepo.EXPECT().Attending(gomock.Any(), 9).Return(nil, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		runner.Run(t, tt.name, func(t provider.T) {
+			tt.prepare()
+			r := &AlertService{
+				repo:     mockRepo,
+				location: moscow,
+				now:      func() time.Time { return now },
+			}
+			if err := r.Raise(ctx, tt.event); err != nil {
+				t.Errorf("Raise() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestAlertService_SetPreference(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockIAlertRepo(ctrl)
+	ctx := session.SetSessionToCtx(context.Background(), session.Session{SessionID: "s1", UserId: 2})
+
+	mockRepo.EXPECT().SetPreference(gomock.Any(), &dto.Preference{
+		DoctorId:    2,
+		MinSeverity: dto.SeverityWarning,
+		MutedKinds:  []string{dto.KindPatientAssigned},
+		QuietStart:  minutes(22, 30),
+		QuietEnd:    minutes(6, 45),
+	}).Return(nil)
+
+	runner.Run(t, "Preferences are saved for the current user", func(t provider.T) {
+		r := &AlertService{repo: mockRepo}
+		_, err := r.SetPreference(ctx, &dto.UpdatePreference{
+			MinSeverity: dto.SeverityWarning,
+			MutedKinds:  []string{dto.KindPatientAssigned},
+			QuietHours:  "22:30-06:45",
+		})
+		if err != nil {
+			t.Errorf("SetPreference() error = %v", err)
+		}
+	})
+
+	runner.Run(t, "Invalid quiet hours are rejected", func(t provider.T) {
+		r := &AlertService{repo: mockRepo}
+		_, err := r.SetPreference(ctx, &dto.UpdatePreference{MinSeverity: dto.SeverityInfo, QuietHours: "25:00-07:00"})
+		if err == nil {
+			t.Errorf("SetPreference() error = nil, want validation error")
+		}
+	})
+
+	runner.Run(t, "Anonymous user has no preferences", func(t provider.T) {
+		r := &AlertService{repo: mockRepo}
+		if _, err := r.SetPreference(context.Background(), &dto.UpdatePreference{MinSeverity: dto.SeverityInfo}); err == nil {
+			t.Errorf("SetPreference() error = nil, want unauthorized")
+		}
+	})
+}
+
+func TestAlertService_Acknowledge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockIAlertRepo(ctrl)
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	ctx := session.SetSessionToCtx(context.Background(), session.Session{SessionID: "s1", UserId: 5})
+	parentId := 10
+
+	mockRepo.EXPECT().GetById(gomock.Any(), 11).Return(&dto.Alert{Id: 11, DoctorId: 5, ParentId: &parentId}, nil)
+	mockRepo.EXPECT().Acknowledge(gomock.Any(), []int{11, 10}, 5, now).Return(nil)
+	mockRepo.EXPECT().GetById(gomock.Any(), 12).Return(&dto.Alert{Id: 12, DoctorId: 6}, nil)
+
+	tests := []struct {
+		name    string
+		id      int
+		wantErr error
+	}{
+		{name: "Acknowledging an escalated copy acknowledges the original alert", id: 11},
+		{name: "Only the recipient can acknowledge an alert", id: 12, wantErr: err_c.ErrAccessDenied},
+	}
+
+	for _, tt := range tests {
+		runner.Run(t, tt.name, func(t provider.T) {
+			r := &AlertService{repo: mockRepo, now: func() time.Time { return now }}
+			if _, err := r.Acknowledge(ctx, tt.id); err != tt.wantErr {
+				t.Errorf("Acknowledge() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAlertService_Escalate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockIAlertRepo(ctrl)
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	original := &dto.Alert{
+		Id:             10,
+		OrganizationId: 2,
+		Kind:           dto.KindVitalsAbnormal,
+		Severity:       dto.SeverityCritical,
+		PatientId:      7,
+		DoctorId:       3,
+		Params:         map[string]string{dto.ParamPatient: "Doe John"},
+	}
+	parentId := 10
+	params := map[string]string{dto.ParamPatient: "Doe John", dto.ParamEscalatedFrom: "3"}
+
+	unassigned := &dto.Alert{
+		Id:             11,
+		OrganizationId: 2,
+		Kind:           dto.KindVitalsAbnormal,
+		Severity:       dto.SeverityCritical,
+		PatientId:      8,
+		Params:         map[string]string{dto.ParamPatient: "Roe Jane"},
+		Status:         dto.StatusUnassigned,
+	}
+	unassignedId := 11
+
+	mockRepo.EXPECT().Unescalated(gomock.Any(), 1, now.Add(-10*time.Minute), now).Return(dto.Alerts{original, unassigned}, nil)
+	mockRepo.EXPECT().EscalationTargets(gomock.Any(), original, dto.TargetOnCall).Return([]int{3, 4}, nil)
+	mockRepo.EXPECT().Escalate(gomock.Any(), 10, 1, &dto.CreateAlert{
+		Kind:           dto.KindVitalsAbnormal,
+		Severity:       dto.SeverityCritical,
+		PatientId:      7,
+		DoctorId:       4,
+		Params:         params,
+		Status:         dto.StatusPending,
+		ParentId:       &parentId,
+		OrganizationId: 2,
+	}).Return(nil)
+	mockRepo.EXPECT().EscalationTargets(gomock.Any(), unassigned, dto.TargetOnCall).Return([]int{4}, nil)
+	mockRepo.EXPECT().Escalate(gomock.Any(), 11, 1, &dto.CreateAlert{
+		Kind:           dto.KindVitalsAbnormal,
+		Severity:       dto.SeverityCritical,
+		PatientId:      8,
+		DoctorId:       4,
+		Params:         map[string]string{dto.ParamPatient: "Roe Jane"},
+		Status:         dto.StatusPending,
+		ParentId:       &unassignedId,
+		OrganizationId: 2,
+	}).Return(nil)
+	mockRepo.EXPECT().Unescalated(gomock.Any(), 2, now.Add(-30*time.Minute), now.Add(-20*time.Minute)).Return(dto.Alerts{original}, nil)
+	mockRepo.EXPECT().EscalationTargets(gomock.Any(), original, dto.TargetHeadPhysician).Return(nil, nil)
+	mockRepo.EXPECT().Escalate(gomock.Any(), 10, 2).Return(nil)
+
+	runner.Run(t, "Unacknowledged and unassigned alerts go to on-call doctors, then to the head physician", func(t provider.T) {
+		r := &AlertService{
+			repo: mockRepo,
+			escalation: []dto.EscalationStep{
+				{After: 10 * time.Minute, Target: dto.TargetOnCall},
+				{After: 30 * time.Minute, Target: dto.TargetHeadPhysician},
+			},
+			now: func() time.Time { return now },
+		}
+		if err := r.Escalate(context.Background()); err != nil {
+			t.Errorf("Escalate() error = %v", err)
+		}
+	})
+}
+
+func TestAlertService_AckReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockIAlertRepo(ctrl)
+	mockDoctor := NewMockIDoctorRepo(ctrl)
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	adminCtx := session.SetSessionToCtx(context.Background(), session.Session{SessionID: "a", UserId: 1})
+	doctorCtx := session.SetSessionToCtx(context.Background(), session.Session{SessionID: "d", UserId: 2})
+
+	sent := now.Add(-time.Hour)
+	inTime, late := sent.Add(5*time.Minute), sent.Add(25*time.Minute)
+	unacknowledged := &dto.Alert{Id: 1, SentAt: &sent}
+	acknowledged := &dto.Alert{Id: 2, SentAt: &sent, AckedAt: &inTime}
+	lateAcknowledged := &dto.Alert{Id: 3, SentAt: &sent, AckedAt: &late}
+
+	mockDoctor.EXPECT().GetById(gomock.Any(), 1).Return(&doctor_dto.Doctor{Id: 1, Role: doctor_dto.RoleHeadPhysician}, nil)
+	mockDoctor.EXPECT().GetById(gomock.Any(), 2).Return(&doctor_dto.Doctor{Id: 2, Role: doctor_dto.RoleDoctor}, nil)
+	mockRepo.EXPECT().ListCritical(gomock.Any(), now.Add(-24*time.Hour)).
+		Return(dto.Alerts{unacknowledged, acknowledged, lateAcknowledged}, nil)
+
+	r := &AlertService{
+		repo:         mockRepo,
+		doctors:      mockDoctor,
+		escalation:   []dto.EscalationStep{{After: 10 * time.Minute, Target: dto.TargetOnCall}},
+		reportWindow: 24 * time.Hour,
+		now:          func() time.Time { return now },
+	}
+
+	runner.Run(t, "Report splits unacknowledged and late alerts", func(t provider.T) {
+		got, err := r.AckReport(adminCtx)
+		if err != nil {
+			t.Fatalf("AckReport() error = %v", err)
+		}
+		want := &dto.AckReport{
+			Deadline:       10 * time.Minute,
+			Unacknowledged: dto.Alerts{unacknowledged},
+			Late:           dto.Alerts{lateAcknowledged},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("AckReport() got = %+v, want %+v", got, want)
+		}
+	})
+
+	runner.Run(t, "Report is for administrators only", func(t provider.T) {
+		if _, err := r.AckReport(doctorCtx); err != err_c.ErrAccessDenied {
+			t.Errorf("AckReport() error = %v, want %v", err, err_c.ErrAccessDenied)
+		}
+	})
+}
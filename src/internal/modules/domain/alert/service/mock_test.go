@@ -0,0 +1,275 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: hospital/internal/modules/domain/alert/service (interfaces: IAlertRepo,IDoctorRepo)
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	dto "hospital/internal/modules/domain/alert/dto"
+	dto0 "hospital/internal/modules/domain/doctor/dto"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIAlertRepo is a mock of IAlertRepo interface.
+type MockIAlertRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAlertRepoMockRecorder
+}
+
+// MockIAlertRepoMockRecorder is the mock recorder for MockIAlertRepo.
+type MockIAlertRepoMockRecorder struct {
+	mock *MockIAlertRepo
+}
+
+// NewMockIAlertRepo creates a new mock instance.
+func NewMockIAlertRepo(ctrl *gomock.Controller) *MockIAlertRepo {
+	mock := &MockIAlertRepo{ctrl: ctrl}
+	mock.recorder = &MockIAlertRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAlertRepo) EXPECT() *MockIAlertRepoMockRecorder {
+	return m.recorder
+}
+
+// Acknowledge mocks base method.
+func (m *MockIAlertRepo) Acknowledge(arg0 context.Context, arg1 []int, arg2 int, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Acknowledge", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Acknowledge indicates an expected call of Acknowledge.
+func (mr *MockIAlertRepoMockRecorder) Acknowledge(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Acknowledge", reflect.TypeOf((*MockIAlertRepo)(nil).Acknowledge), arg0, arg1, arg2, arg3)
+}
+
+// Attending mocks base method.
+func (m *MockIAlertRepo) Attending(arg0 context.Context, arg1 int) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Attending", arg0, arg1)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Attending indicates an expected call of Attending.
+func (mr *MockIAlertRepoMockRecorder) Attending(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attending", reflect.TypeOf((*MockIAlertRepo)(nil).Attending), arg0, arg1)
+}
+
+// Claim mocks base method.
+func (m *MockIAlertRepo) Claim(arg0 context.Context, arg1, arg2 time.Time, arg3 int) (dto.Deliveries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(dto.Deliveries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockIAlertRepoMockRecorder) Claim(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockIAlertRepo)(nil).Claim), arg0, arg1, arg2, arg3)
+}
+
+// Create mocks base method.
+func (m *MockIAlertRepo) Create(arg0 context.Context, arg1 ...*dto.CreateAlert) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Create", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIAlertRepoMockRecorder) Create(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIAlertRepo)(nil).Create), varargs...)
+}
+
+// Escalate mocks base method.
+func (m *MockIAlertRepo) Escalate(arg0 context.Context, arg1, arg2 int, arg3 ...*dto.CreateAlert) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Escalate", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Escalate indicates an expected call of Escalate.
+func (mr *MockIAlertRepoMockRecorder) Escalate(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Escalate", reflect.TypeOf((*MockIAlertRepo)(nil).Escalate), varargs...)
+}
+
+// EscalationTargets mocks base method.
+func (m *MockIAlertRepo) EscalationTargets(arg0 context.Context, arg1 *dto.Alert, arg2 string) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EscalationTargets", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EscalationTargets indicates an expected call of EscalationTargets.
+func (mr *MockIAlertRepoMockRecorder) EscalationTargets(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EscalationTargets", reflect.TypeOf((*MockIAlertRepo)(nil).EscalationTargets), arg0, arg1, arg2)
+}
+
+// GetById mocks base method.
+func (m *MockIAlertRepo) GetById(arg0 context.Context, arg1 int) (*dto.Alert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", arg0, arg1)
+	ret0, _ := ret[0].(*dto.Alert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockIAlertRepoMockRecorder) GetById(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockIAlertRepo)(nil).GetById), arg0, arg1)
+}
+
+// ListCritical mocks base method.
+func (m *MockIAlertRepo) ListCritical(arg0 context.Context, arg1 time.Time) (dto.Alerts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCritical", arg0, arg1)
+	ret0, _ := ret[0].(dto.Alerts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCritical indicates an expected call of ListCritical.
+func (mr *MockIAlertRepoMockRecorder) ListCritical(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCritical", reflect.TypeOf((*MockIAlertRepo)(nil).ListCritical), arg0, arg1)
+}
+
+// MarkFailed mocks base method.
+func (m *MockIAlertRepo) MarkFailed(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkFailed", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkFailed indicates an expected call of MarkFailed.
+func (mr *MockIAlertRepoMockRecorder) MarkFailed(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFailed", reflect.TypeOf((*MockIAlertRepo)(nil).MarkFailed), arg0, arg1, arg2)
+}
+
+// MarkSent mocks base method.
+func (m *MockIAlertRepo) MarkSent(arg0 context.Context, arg1 int, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkSent indicates an expected call of MarkSent.
+func (mr *MockIAlertRepoMockRecorder) MarkSent(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSent", reflect.TypeOf((*MockIAlertRepo)(nil).MarkSent), arg0, arg1, arg2)
+}
+
+// Preference mocks base method.
+func (m *MockIAlertRepo) Preference(arg0 context.Context, arg1 int) (*dto.Preference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Preference", arg0, arg1)
+	ret0, _ := ret[0].(*dto.Preference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Preference indicates an expected call of Preference.
+func (mr *MockIAlertRepoMockRecorder) Preference(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Preference", reflect.TypeOf((*MockIAlertRepo)(nil).Preference), arg0, arg1)
+}
+
+// SetPreference mocks base method.
+func (m *MockIAlertRepo) SetPreference(arg0 context.Context, arg1 *dto.Preference) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPreference", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPreference indicates an expected call of SetPreference.
+func (mr *MockIAlertRepoMockRecorder) SetPreference(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPreference", reflect.TypeOf((*MockIAlertRepo)(nil).SetPreference), arg0, arg1)
+}
+
+// Unescalated mocks base method.
+func (m *MockIAlertRepo) Unescalated(arg0 context.Context, arg1 int, arg2, arg3 time.Time) (dto.Alerts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unescalated", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(dto.Alerts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unescalated indicates an expected call of Unescalated.
+func (mr *MockIAlertRepoMockRecorder) Unescalated(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unescalated", reflect.TypeOf((*MockIAlertRepo)(nil).Unescalated), arg0, arg1, arg2, arg3)
+}
+
+// MockIDoctorRepo is a mock of IDoctorRepo interface.
+type MockIDoctorRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockIDoctorRepoMockRecorder
+}
+
+// MockIDoctorRepoMockRecorder is the mock recorder for MockIDoctorRepo.
+type MockIDoctorRepoMockRecorder struct {
+	mock *MockIDoctorRepo
+}
+
+// NewMockIDoctorRepo creates a new mock instance.
+func NewMockIDoctorRepo(ctrl *gomock.Controller) *MockIDoctorRepo {
+	mock := &MockIDoctorRepo{ctrl: ctrl}
+	mock.recorder = &MockIDoctorRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIDoctorRepo) EXPECT() *MockIDoctorRepoMockRecorder {
+	return m.recorder
+}
+
+// GetById mocks base method.
+func (m *MockIDoctorRepo) GetById(arg0 context.Context, arg1 int) (*dto0.Doctor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", arg0, arg1)
+	ret0, _ := ret[0].(*dto0.Doctor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockIDoctorRepoMockRecorder) GetById(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockIDoctorRepo)(nil).GetById), arg0, arg1)
+}
@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"hospital/internal/models/audit"
+	"time"
+)
+
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+type AuditRecord struct {
+	Id        int
+	ActorId   *int
+	SessionId string
+	Entity    string
+	EntityId  int
+	Action    string
+	Changes   audit.Changes
+	CreatedAt time.Time
+}
+
+type AuditRecords []*AuditRecord
+
+type CreateAuditRecord struct {
+	ActorId   *int
+	SessionId string
+	Entity    string
+	EntityId  int
+	Action    string
+	Changes   audit.Changes
+}
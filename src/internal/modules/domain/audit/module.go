@@ -0,0 +1,31 @@
+package audit
+
+import (
+	"go.uber.org/fx"
+	"hospital/internal/modules/domain/audit/repo"
+	"hospital/internal/modules/domain/audit/service"
+	doctor_repo "hospital/internal/modules/domain/doctor/repo"
+)
+
+var (
+	Module = fx.Options(
+		service.Module,
+		repo.Module,
+
+		fx.Provide(
+			fx.Annotate(
+				func(r *repo.AuditRepo) *repo.AuditRepo { return r },
+				fx.As(new(service.IAuditRepo)),
+			),
+			fx.Annotate(
+				func(r *doctor_repo.DoctorRepo) *doctor_repo.DoctorRepo { return r },
+				fx.As(new(service.IDoctorRepo)),
+			),
+		),
+	)
+
+	Invokables = fx.Options(
+		service.Invokables,
+		repo.Invokables,
+	)
+)
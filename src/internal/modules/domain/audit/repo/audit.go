@@ -0,0 +1,85 @@
+package repo
+
+import (
+	"context"
+	"entgo.io/ent/dialect/sql"
+	"hospital/internal/modules/db"
+	"hospital/internal/modules/db/ent"
+	"hospital/internal/modules/db/ent/auditlog"
+	"hospital/internal/modules/domain/audit/dto"
+)
+
+type AuditRepo struct {
+	client *ent.Client
+}
+
+func NewAuditRepo(client *ent.Client) *AuditRepo {
+	return &AuditRepo{
+		client: client,
+	}
+}
+
+func (r *AuditRepo) Create(ctx context.Context, dtms ...*dto.CreateAuditRecord) error {
+	return create(ctx, r.client, dtms...)
+}
+
+func create(ctx context.Context, client *ent.Client, dtms ...*dto.CreateAuditRecord) error {
+	builders := make([]*ent.AuditLogCreate, len(dtms))
+	for i, dtm := range dtms {
+		builders[i] = client.AuditLog.Create().
+			SetNillableActorId(dtm.ActorId).
+			SetSessionId(dtm.SessionId).
+			SetEntity(dtm.Entity).
+			SetEntityId(dtm.EntityId).
+			SetAction(dtm.Action).
+			SetChanges(dtm.Changes)
+	}
+
+	// Журнал пишет система от имени пользователя: записи попадают в его клинику,
+	// даже если сам пользователь не может их читать
+	_, err := client.AuditLog.CreateBulk(builders...).Save(db.SystemContext(ctx))
+	if err != nil {
+		return db.WrapError(err)
+	}
+
+	return nil
+}
+
+func (r *AuditRepo) ListByEntity(ctx context.Context, entity string, entityId int) (dto.AuditRecords, error) {
+	Records, err := r.client.AuditLog.Query().
+		Where(auditlog.EntityEQ(entity), auditlog.EntityIdEQ(entityId)).
+		Order(auditlog.ByCreatedAt(sql.OrderDesc()), auditlog.ByID(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToAuditRecordDTOs(Records), nil
+}
+
+func ToAuditRecordDTO(model *ent.AuditLog) *dto.AuditRecord {
+	if model == nil {
+		return nil
+	}
+	return &dto.AuditRecord{
+		Id:        model.ID,
+		ActorId:   model.ActorId,
+		SessionId: model.SessionId,
+		Entity:    model.Entity,
+		EntityId:  model.EntityId,
+		Action:    model.Action,
+		Changes:   model.Changes,
+		CreatedAt: model.CreatedAt,
+	}
+}
+
+func ToAuditRecordDTOs(models ent.AuditLogs) dto.AuditRecords {
+	if models == nil {
+		return nil
+	}
+	dtms := make(dto.AuditRecords, len(models))
+	for i := range models {
+		dtms[i] = ToAuditRecordDTO(models[i])
+	}
+	return dtms
+}
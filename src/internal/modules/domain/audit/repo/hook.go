@@ -0,0 +1,313 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"hospital/internal/models/audit"
+	"hospital/internal/models/session"
+	"hospital/internal/modules/db"
+	"hospital/internal/modules/db/ent"
+	"hospital/internal/modules/db/ent/disease"
+	"hospital/internal/modules/db/ent/doctor"
+	"hospital/internal/modules/db/ent/patient"
+	"hospital/internal/modules/db/ent/room"
+	"hospital/internal/modules/domain/audit/dto"
+	"reflect"
+)
+
+// fields - значения полей и id связанных записей по именам полей и связей в схеме
+type fields map[string]any
+
+type idsMutation interface {
+	ent.Mutation
+	ID() (int, bool)
+	IDs(ctx context.Context) ([]int, error)
+	Tx() (*ent.Tx, error)
+}
+
+type idsQuery interface {
+	IDs(ctx context.Context) ([]int, error)
+}
+
+// entity - как журнал читает записи сущности
+type entity struct {
+	// one - изменение одной записи: через OldField оно читает текущие значения ее полей
+	one func(c *ent.Client, id int) ent.Mutation
+	// fields - поля сущности; id записи в журнале хранится отдельно
+	fields []string
+	// edges - запросы связанных записей по именам связей. Связи с собственным полем
+	// (палата пациента, отделение палаты) попадают в журнал как изменение поля.
+	edges map[string]func(c *ent.Client, id int) idsQuery
+}
+
+// entities - сущности, изменения которых пишутся в журнал аудита
+var entities = map[string]entity{
+	ent.TypePatient: {
+		one:    func(c *ent.Client, id int) ent.Mutation { return c.Patient.UpdateOneID(id).Mutation() },
+		fields: patient.Columns[1:],
+		edges: map[string]func(c *ent.Client, id int) idsQuery{
+			patient.EdgeDoctor: func(c *ent.Client, id int) idsQuery {
+				return c.Patient.Query().Where(patient.IDEQ(id)).QueryDoctor()
+			},
+			patient.EdgeIlls: func(c *ent.Client, id int) idsQuery {
+				return c.Patient.Query().Where(patient.IDEQ(id)).QueryIlls()
+			},
+		},
+	},
+	ent.TypeRoom: {
+		one:    func(c *ent.Client, id int) ent.Mutation { return c.Room.UpdateOneID(id).Mutation() },
+		fields: room.Columns[1:],
+		edges: map[string]func(c *ent.Client, id int) idsQuery{
+			room.EdgeContains: func(c *ent.Client, id int) idsQuery {
+				return c.Room.Query().Where(room.IDEQ(id)).QueryContains()
+			},
+		},
+	},
+	ent.TypeDoctor: {
+		one:    func(c *ent.Client, id int) ent.Mutation { return c.Doctor.UpdateOneID(id).Mutation() },
+		fields: doctor.Columns[1:],
+		edges: map[string]func(c *ent.Client, id int) idsQuery{
+			doctor.EdgeTreats: func(c *ent.Client, id int) idsQuery {
+				return c.Doctor.Query().Where(doctor.IDEQ(id)).QueryTreats()
+			},
+			doctor.EdgeDepartments: func(c *ent.Client, id int) idsQuery {
+				return c.Doctor.Query().Where(doctor.IDEQ(id)).QueryDepartments()
+			},
+		},
+	},
+	ent.TypeDisease: {
+		one:    func(c *ent.Client, id int) ent.Mutation { return c.Disease.UpdateOneID(id).Mutation() },
+		fields: disease.Columns[1:],
+		edges: map[string]func(c *ent.Client, id int) idsQuery{
+			disease.EdgeHas: func(c *ent.Client, id int) idsQuery {
+				return c.Disease.Query().Where(disease.IDEQ(id)).QueryHas()
+			},
+		},
+	},
+}
+
+// InvokeAuditHook подключает журнал аудита к пациентам, палатам, врачам и заболеваниям.
+// Массовое создание этих сущностей через CreateBulk журналом не поддерживается.
+func InvokeAuditHook(client *ent.Client, r *AuditRepo) {
+	h := r.Hook()
+	client.Patient.Use(h)
+	client.Room.Use(h)
+	client.Doctor.Use(h)
+	client.Disease.Use(h)
+}
+
+// Hook записывает в журнал аудита каждое создание, изменение и удаление записи
+// вместе с автором (из сессии), значениями полей и связями до и после. Запись журнала
+// сохраняется в одной транзакции с изменением: изменение вне транзакции выполняется
+// заново в новой транзакции.
+func (r *AuditRepo) Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			e, ok := entities[m.Type()]
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+			im, ok := m.(idsMutation)
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+
+			client := txClient(ctx, im)
+			if client == nil {
+				var v ent.Value
+				err := db.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+					var err error
+					v, err = tx.Client().Mutate(ent.NewTxContext(ctx, tx), m)
+					return err
+				})
+				return v, err
+			}
+
+			var ids []int
+			var before map[int]fields
+			if !m.Op().Is(ent.OpCreate) {
+				var err error
+				ids, err = im.IDs(ctx)
+				if err != nil {
+					return nil, err
+				}
+				names, edges := touched(e, m)
+				before, err = snapshot(ctx, client, e, ids, names, edges)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+
+			if m.Op().Is(ent.OpCreate) {
+				id, _ := im.ID()
+				ids = []int{id}
+			}
+
+			var after map[int]fields
+			if !m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+				// Новые значения полей-счетчиков (AddX) известны только после изменения
+				_, edges := touched(e, m)
+				after, err = snapshot(ctx, client, e, ids, m.AddedFields(), edges)
+				if err != nil {
+					return v, err
+				}
+			}
+
+			records := make([]*dto.CreateAuditRecord, 0, len(ids))
+			for _, id := range ids {
+				records = append(records, newRecord(ctx, m, id, before[id], after[id]))
+			}
+			if len(records) == 0 {
+				return v, nil
+			}
+
+			if err = create(ctx, client, records...); err != nil {
+				return v, fmt.Errorf("не удалось записать журнал аудита: %w", err)
+			}
+
+			return v, nil
+		})
+	}
+}
+
+// txClient - клиент транзакции, в которой выполняется изменение, или nil, если изменение
+// выполняется вне транзакции
+func txClient(ctx context.Context, m idsMutation) *ent.Client {
+	if tx, err := m.Tx(); err == nil {
+		return tx.Client()
+	}
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+	return nil
+}
+
+// touched - поля и связи, значения которых журнал читает до и после изменения:
+// при удалении все, иначе только измененные
+func touched(e entity, m ent.Mutation) (names []string, edges []string) {
+	if m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+		for name := range e.edges {
+			edges = append(edges, name)
+		}
+		return e.fields, edges
+	}
+
+	names = append(append(m.Fields(), m.AddedFields()...), m.ClearedFields()...)
+	seen := map[string]bool{}
+	for _, list := range [][]string{m.AddedEdges(), m.RemovedEdges(), m.ClearedEdges()} {
+		for _, name := range list {
+			if _, ok := e.edges[name]; ok && !seen[name] {
+				seen[name] = true
+				edges = append(edges, name)
+			}
+		}
+	}
+	return names, edges
+}
+
+func newRecord(ctx context.Context, m ent.Mutation, id int, before, after fields) *dto.CreateAuditRecord {
+	record := &dto.CreateAuditRecord{
+		Entity:   m.Type(),
+		EntityId: id,
+		Changes:  audit.Changes{},
+	}
+	if s, ok := session.GetSessionFromCtx(ctx); ok {
+		actorId := s.UserId
+		record.ActorId = &actorId
+		record.SessionId = s.SessionID
+	}
+
+	switch {
+	case m.Op().Is(ent.OpCreate):
+		record.Action = dto.ActionCreate
+		for _, name := range m.Fields() {
+			value, _ := m.Field(name)
+			record.Changes[name] = audit.Change{After: value}
+		}
+		for name, value := range after {
+			if !isEmpty(value) {
+				record.Changes[name] = audit.Change{After: value}
+			}
+		}
+	case m.Op().Is(ent.OpUpdate | ent.OpUpdateOne):
+		record.Action = dto.ActionUpdate
+		for _, name := range m.Fields() {
+			value, _ := m.Field(name)
+			if !reflect.DeepEqual(before[name], value) {
+				record.Changes[name] = audit.Change{Before: before[name], After: value}
+			}
+		}
+		for _, name := range m.ClearedFields() {
+			record.Changes[name] = audit.Change{Before: before[name]}
+		}
+		for name, value := range after {
+			if !reflect.DeepEqual(before[name], value) {
+				record.Changes[name] = audit.Change{Before: before[name], After: value}
+			}
+		}
+	default:
+		record.Action = dto.ActionDelete
+		for name, value := range before {
+			if !isEmpty(value) {
+				record.Changes[name] = audit.Change{Before: value}
+			}
+		}
+	}
+
+	return record
+}
+
+// snapshot читает у записей ids значения полей names и id связанных записей по связям edges
+func snapshot(ctx context.Context, client *ent.Client, e entity, ids []int, names, edges []string) (map[int]fields, error) {
+	ctx = db.SystemContext(ctx)
+	res := make(map[int]fields, len(ids))
+	for _, id := range ids {
+		values := fields{}
+		old := e.one(client, id)
+		for _, name := range names {
+			value, err := old.OldField(ctx, name)
+			if err != nil {
+				return nil, db.WrapError(err)
+			}
+			values[name] = deref(value)
+		}
+		for _, name := range edges {
+			related, err := e.edges[name](client, id).IDs(ctx)
+			if err != nil {
+				return nil, db.WrapError(err)
+			}
+			if related == nil {
+				related = []int{}
+			}
+			values[name] = related
+		}
+		res[id] = values
+	}
+
+	return res, nil
+}
+
+// deref - значение необязательного поля вместо указателя на него, nil для пустого
+func deref(value any) any {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Pointer {
+		return value
+	}
+	if v.IsNil() {
+		return nil
+	}
+	return v.Elem().Interface()
+}
+
+// isEmpty - пустое значение или связь без записей
+func isEmpty(value any) bool {
+	if ids, ok := value.([]int); ok {
+		return len(ids) == 0
+	}
+	return value == nil
+}
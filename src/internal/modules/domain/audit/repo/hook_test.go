@@ -0,0 +1,127 @@
+package repo
+
+import (
+	"context"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"hospital/internal/models/audit"
+	"hospital/internal/models/session"
+	"hospital/internal/modules/db/ent"
+	"hospital/internal/modules/db/ent/patient"
+	"hospital/internal/modules/domain/audit/dto"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// deleteMutation - удаление пациента 7; у удаления нет публичного доступа к мутации
+func deleteMutation(client *ent.Client) ent.Mutation {
+	m := client.Patient.UpdateOneID(7).Mutation()
+	m.SetOp(ent.OpDeleteOne)
+	return m
+}
+
+func TestNewRecord(t *testing.T) {
+	client := ent.NewClient()
+	ctx := session.SetSessionToCtx(context.Background(), session.Session{SessionID: "s1", UserId: 3})
+	actorId := 3
+
+	for _, tt := range []struct {
+		name   string
+		m      ent.Mutation
+		before fields
+		after  fields
+		want   *dto.CreateAuditRecord
+	}{
+		{
+			name: "Create",
+			m:    client.Patient.Create().SetName("John").SetHeight(180).Mutation(),
+			want: &dto.CreateAuditRecord{
+				ActorId:   &actorId,
+				SessionId: "s1",
+				Entity:    ent.TypePatient,
+				EntityId:  7,
+				Action:    dto.ActionCreate,
+				Changes: audit.Changes{
+					patient.FieldName:   {After: "John"},
+					patient.FieldHeight: {After: 180},
+				},
+			},
+		},
+		{
+			name:   "Update keeps only changed fields",
+			m:      client.Patient.UpdateOneID(7).SetWeight(82).SetName("John").Mutation(),
+			before: fields{patient.FieldName: "John", patient.FieldWeight: 80.0},
+			want: &dto.CreateAuditRecord{
+				ActorId:   &actorId,
+				SessionId: "s1",
+				Entity:    ent.TypePatient,
+				EntityId:  7,
+				Action:    dto.ActionUpdate,
+				Changes: audit.Changes{
+					patient.FieldWeight: {Before: 80.0, After: 82.0},
+				},
+			},
+		},
+		{
+			name:   "Assigning a doctor is recorded as a change of the edge",
+			m:      client.Patient.UpdateOneID(7).AddDoctorIDs(3).Mutation(),
+			before: fields{patient.EdgeDoctor: []int{1}},
+			after:  fields{patient.EdgeDoctor: []int{1, 3}},
+			want: &dto.CreateAuditRecord{
+				ActorId:   &actorId,
+				SessionId: "s1",
+				Entity:    ent.TypePatient,
+				EntityId:  7,
+				Action:    dto.ActionUpdate,
+				Changes: audit.Changes{
+					patient.EdgeDoctor: {Before: []int{1}, After: []int{1, 3}},
+				},
+			},
+		},
+		{
+			name:   "Delete keeps all fields and linked records",
+			m:      deleteMutation(client),
+			before: fields{patient.FieldName: "John", patient.EdgeDoctor: []int{1}, patient.EdgeIlls: []int{}},
+			want: &dto.CreateAuditRecord{
+				ActorId:   &actorId,
+				SessionId: "s1",
+				Entity:    ent.TypePatient,
+				EntityId:  7,
+				Action:    dto.ActionDelete,
+				Changes: audit.Changes{
+					patient.FieldName:  {Before: "John"},
+					patient.EdgeDoctor: {Before: []int{1}},
+				},
+			},
+		},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			if got := newRecord(ctx, tt.m, 7, tt.before, tt.after); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("newRecord() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTouched(t *testing.T) {
+	client := ent.NewClient()
+	e := entities[ent.TypePatient]
+
+	runner.Run(t, "Update reads only changed fields and edges", func(t provider.T) {
+		names, edges := touched(e, client.Patient.UpdateOneID(7).SetWeight(82).SetIllsID(2).AddDoctorIDs(3).Mutation())
+		if !reflect.DeepEqual(names, []string{patient.FieldWeight}) {
+			t.Errorf("touched() names = %v", names)
+		}
+		sort.Strings(edges)
+		if !reflect.DeepEqual(edges, []string{patient.EdgeDoctor, patient.EdgeIlls}) {
+			t.Errorf("touched() edges = %v", edges)
+		}
+	})
+	runner.Run(t, "Delete reads every field", func(t provider.T) {
+		names, edges := touched(e, deleteMutation(client))
+		if !reflect.DeepEqual(names, patient.Columns[1:]) || len(edges) != len(e.edges) {
+			t.Errorf("touched() = %v, %v", names, edges)
+		}
+	})
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"hospital/internal/models/errors"
+	"hospital/internal/models/session"
+	"hospital/internal/modules/domain/audit/dto"
+	doctor_dto "hospital/internal/modules/domain/doctor/dto"
+)
+
+//go:generate mockgen -destination mock_test.go -package service . IAuditRepo,IDoctorRepo
+
+type IAuditRepo interface {
+	ListByEntity(ctx context.Context, entity string, entityId int) (dto.AuditRecords, error)
+}
+
+type IDoctorRepo interface {
+	GetById(ctx context.Context, id int) (*doctor_dto.Doctor, error)
+}
+
+type AuditService struct {
+	repo    IAuditRepo
+	doctors IDoctorRepo
+}
+
+func NewAuditService(repo IAuditRepo, doctors IDoctorRepo) *AuditService {
+	return &AuditService{
+		repo:    repo,
+		doctors: doctors,
+	}
+}
+
+// History возвращает историю изменений записи, доступна только администраторам
+func (r *AuditService) History(ctx context.Context, entity string, entityId int) (dto.AuditRecords, error) {
+	s, ok := session.GetSessionFromCtx(ctx)
+	if !ok {
+		return nil, errors.ErrUnauthorized
+	}
+
+	doctor, err := r.doctors.GetById(ctx, s.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if !doctor.IsAdmin() {
+		return nil, errors.ErrAccessDenied
+	}
+
+	return r.repo.ListByEntity(ctx, entity, entityId)
+}
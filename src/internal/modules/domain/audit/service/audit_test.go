@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	err_c "hospital/internal/models/errors"
+	"hospital/internal/models/session"
+	"hospital/internal/modules/domain/audit/dto"
+	doctor_dto "hospital/internal/modules/domain/doctor/dto"
+	"reflect"
+	"testing"
+)
+
+func TestNewAuditService(t *testing.T) {
+	mockAudit := new(MockIAuditRepo)
+	mockDoctor := new(MockIDoctorRepo)
+
+	runner.Run(t, "Simple positive test", func(t provider.T) {
+		want := &AuditService{repo: mockAudit, doctors: mockDoctor}
+		if got := NewAuditService(mockAudit, mockDoctor); !reflect.DeepEqual(got, want) {
+			t.Errorf("NewAuditService() = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestAuditService_History(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAudit := NewMockIAuditRepo(ctrl)
+	mockDoctor := NewMockIDoctorRepo(ctrl)
+
+	records := dto.AuditRecords{
+		{Id: 1, Entity: "Patient", EntityId: 5, Action: dto.ActionCreate},
+	}
+
+	adminCtx := session.SetSessionToCtx(context.Background(), session.Session{SessionID: "a", UserId: 1})
+	doctorCtx := session.SetSessionToCtx(context.Background(), session.Session{SessionID: "b", UserId: 2})
+
+	mockDoctor.EXPECT().GetById(gomock.Any(), 1).Return(&doctor_dto.Doctor{Id: 1, Role: doctor_dto.RoleHeadPhysician}, nil)
+	mockDoctor.EXPECT().GetById(gomock.Any(), 2).Return(&doctor_dto.Doctor{Id: 2, Role: doctor_dto.RoleDoctor}, nil)
+	mockAudit.EXPECT().ListByEntity(gomock.Any(), "Patient", 5).Return(records, nil)
+
+	for _, tt := range []struct {
+		name    string
+		ctx     context.Context
+		want    dto.AuditRecords
+		wantErr error
+	}{
+		{
+			name: "Admin sees history",
+			ctx:  adminCtx,
+			want: records,
+		},
+		{
+			name:    "Doctor is denied",
+			ctx:     doctorCtx,
+			wantErr: err_c.ErrAccessDenied,
+		},
+		{
+			name:    "No session",
+			ctx:     context.Background(),
+			wantErr: err_c.ErrUnauthorized,
+		},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			r := NewAuditService(mockAudit, mockDoctor)
+			got, err := r.History(tt.ctx, "Patient", 5)
+			if err != tt.wantErr {
+				t.Errorf("History() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("History() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: hospital/internal/modules/domain/audit/service (interfaces: IAuditRepo,IDoctorRepo)
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	dto "hospital/internal/modules/domain/audit/dto"
+	dto0 "hospital/internal/modules/domain/doctor/dto"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIAuditRepo is a mock of IAuditRepo interface.
+type MockIAuditRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAuditRepoMockRecorder
+}
+
+// MockIAuditRepoMockRecorder is the mock recorder for MockIAuditRepo.
+type MockIAuditRepoMockRecorder struct {
+	mock *MockIAuditRepo
+}
+
+// NewMockIAuditRepo creates a new mock instance.
+func NewMockIAuditRepo(ctrl *gomock.Controller) *MockIAuditRepo {
+	mock := &MockIAuditRepo{ctrl: ctrl}
+	mock.recorder = &MockIAuditRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAuditRepo) EXPECT() *MockIAuditRepoMockRecorder {
+	return m.recorder
+}
+
+// ListByEntity mocks base method.
+func (m *MockIAuditRepo) ListByEntity(arg0 context.Context, arg1 string, arg2 int) (dto.AuditRecords, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByEntity", arg0, arg1, arg2)
+	ret0, _ := ret[0].(dto.AuditRecords)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByEntity indicates an expected call of ListByEntity.
+func (mr *MockIAuditRepoMockRecorder) ListByEntity(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByEntity", reflect.TypeOf((*MockIAuditRepo)(nil).ListByEntity), arg0, arg1, arg2)
+}
+
+// MockIDoctorRepo is a mock of IDoctorRepo interface.
+type MockIDoctorRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockIDoctorRepoMockRecorder
+}
+
+// MockIDoctorRepoMockRecorder is the mock recorder for MockIDoctorRepo.
+type MockIDoctorRepoMockRecorder struct {
+	mock *MockIDoctorRepo
+}
+
+// NewMockIDoctorRepo creates a new mock instance.
+func NewMockIDoctorRepo(ctrl *gomock.Controller) *MockIDoctorRepo {
+	mock := &MockIDoctorRepo{ctrl: ctrl}
+	mock.recorder = &MockIDoctorRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIDoctorRepo) EXPECT() *MockIDoctorRepoMockRecorder {
+	return m.recorder
+}
+
+// GetById mocks base method.
+func (m *MockIDoctorRepo) GetById(arg0 context.Context, arg1 int) (*dto0.Doctor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", arg0, arg1)
+	ret0, _ := ret[0].(*dto0.Doctor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockIDoctorRepoMockRecorder) GetById(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockIDoctorRepo)(nil).GetById), arg0, arg1)
+}
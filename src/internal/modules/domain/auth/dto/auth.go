@@ -1,5 +1,7 @@
 package dto
 
+import "hospital/internal/models/role"
+
 // Сервис для аунтефикации в телеграмм
 
 type Auth struct {
@@ -9,6 +11,20 @@ type Auth struct {
 
 type Users []*Auth
 
+// SignUpRoles - роли, которые можно выбрать при регистрации. Главврача и администратора
+// назначает администратор клиники.
+var SignUpRoles = []string{role.Doctor, role.Nurse}
+
+// CanSignUpAs - можно ли зарегистрироваться с ролью r
+func CanSignUpAs(r string) bool {
+	for _, allowed := range SignUpRoles {
+		if allowed == r {
+			return true
+		}
+	}
+	return false
+}
+
 type NewDoctor struct {
 	TokenId    string
 	Surname    string
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"hospital/internal/models/errors"
 	"hospital/internal/modules/config"
 	"hospital/internal/modules/domain/auth/dto"
 	doctor_dto "hospital/internal/modules/domain/doctor/dto"
@@ -12,21 +13,33 @@ import (
 type IDoctorRepo interface {
 	// GetByTokenId(ctx context.Context, tokenId string) (*doctor_dto.Doctor, error)
 	Create(ctx context.Context, dtm *doctor_dto.CreateDoctor) (*doctor_dto.Doctor, error)
+	CreateAdmin(ctx context.Context, dtm *doctor_dto.CreateDoctor) (*doctor_dto.Doctor, error)
 }
 
 type AuthService struct {
 	repo    IDoctorRepo
 	tokenId string
+	admins  map[string]bool
 }
 
 func NewAuthService(repo IDoctorRepo, config config.Config) *AuthService {
+	admins := make(map[string]bool, len(config.BootstrapAdmins))
+	for _, tokenId := range config.BootstrapAdmins {
+		admins[tokenId] = true
+	}
 	return &AuthService{
 		repo:    repo,
 		tokenId: config.Secret,
+		admins:  admins,
 	}
 }
 
+// SignUp регистрирует врача или медсестру; другие роли при регистрации выбрать нельзя.
+// Врачи из BOOTSTRAP_ADMINS регистрируются администраторами без клиники.
 func (r *AuthService) SignUp(ctx context.Context, newDoctor *dto.NewDoctor) (*doctor_dto.Doctor, error) {
+	if !dto.CanSignUpAs(newDoctor.Role) {
+		return nil, errors.ErrAccessDenied
+	}
 
 	createDoctor := &doctor_dto.CreateDoctor{
 		Surname:    newDoctor.Surname,
@@ -36,7 +49,11 @@ func (r *AuthService) SignUp(ctx context.Context, newDoctor *dto.NewDoctor) (*do
 	}
 
 	// Создаем пользователя
-	createdDoctor, err := r.repo.Create(ctx, createDoctor)
+	create := r.repo.Create
+	if r.admins[newDoctor.TokenId] {
+		create = r.repo.CreateAdmin
+	}
+	createdDoctor, err := create(ctx, createDoctor)
 	if err != nil {
 		return nil, err
 	}
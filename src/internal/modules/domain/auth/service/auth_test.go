@@ -6,6 +6,8 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/ozontech/allure-go/pkg/framework/provider"
 	"github.com/ozontech/allure-go/pkg/framework/runner"
+	err_c "hospital/internal/models/errors"
+	"hospital/internal/models/role"
 	"hospital/internal/modules/config"
 	"hospital/internal/modules/domain/auth/dto"
 	doctor_dto "hospital/internal/modules/domain/doctor/dto"
@@ -45,14 +47,14 @@ func TestAuthService_SignUp(t *testing.T) {
 				Surname:    "Doe",
 				TokenId:    "1",
 				Speciality: "Doctor",
-				Role:       "Role",
+				Role:       role.Doctor,
 			},
 		},
 		want: &doctor_dto.Doctor{
 			Surname:    "Doe",
 			TokenId:    "1",
 			Speciality: "Doctor",
-			Role:       "Role",
+			Role:       role.Doctor,
 		},
 		wantErr: false,
 	}
@@ -61,7 +63,7 @@ func TestAuthService_SignUp(t *testing.T) {
 		Surname:    "Doe",
 		TokenId:    "1",
 		Speciality: "Doctor",
-		Role:       "Role",
+		Role:       role.Doctor,
 	}, nil)
 
 	// Test case 2: Error while creating doctor
@@ -82,7 +84,7 @@ func TestAuthService_SignUp(t *testing.T) {
 				Surname:    "Doe",
 				TokenId:    "1",
 				Speciality: "Doctor",
-				Role:       "Role",
+				Role:       role.Doctor,
 			},
 		},
 		want:    nil,
@@ -118,6 +120,59 @@ func TestAuthService_SignUp(t *testing.T) {
 	}
 }
 
+func TestAuthService_SignUp_Roles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockIDoctorRepo(ctrl)
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&doctor_dto.Doctor{Role: role.Nurse}, nil)
+
+	for _, tt := range []struct {
+		name    string
+		role    string
+		wantErr error
+	}{
+		{name: "Nurse signs up", role: role.Nurse},
+		{name: "Head physician cannot sign up", role: role.HeadPhysician, wantErr: err_c.ErrAccessDenied},
+		{name: "Admin cannot sign up", role: role.Admin, wantErr: err_c.ErrAccessDenied},
+		{name: "Unknown role cannot sign up", role: "Role", wantErr: err_c.ErrAccessDenied},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			r := &AuthService{repo: mockRepo}
+			_, err := r.SignUp(context.Background(), &dto.NewDoctor{TokenId: "1", Surname: "Doe", Role: tt.role})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("SignUp() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAuthService_SignUp_BootstrapAdmin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockIDoctorRepo(ctrl)
+	admin := &doctor_dto.Doctor{TokenId: "1", Role: role.Admin}
+	mockRepo.EXPECT().CreateAdmin(gomock.Any(), gomock.Any()).Return(admin, nil)
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&doctor_dto.Doctor{TokenId: "2", Role: role.Doctor}, nil)
+
+	r := NewAuthService(mockRepo, config.Config{BootstrapAdmins: []string{"1"}})
+
+	runner.Run(t, "Listed Telegram ID signs up as admin", func(t provider.T) {
+		got, err := r.SignUp(context.Background(), &dto.NewDoctor{TokenId: "1", Surname: "Doe", Role: role.Doctor})
+		if err != nil || got != admin {
+			t.Errorf("SignUp() = %v, %v, want %v", got, err, admin)
+		}
+	})
+
+	runner.Run(t, "Other Telegram ID signs up with the chosen role", func(t provider.T) {
+		got, err := r.SignUp(context.Background(), &dto.NewDoctor{TokenId: "2", Surname: "Roe", Role: role.Doctor})
+		if err != nil || got.Role != role.Doctor {
+			t.Errorf("SignUp() = %v, %v", got, err)
+		}
+	})
+}
+
 func TestNewAuthService(t *testing.T) {
 	type args struct {
 		repo   IDoctorRepo
@@ -136,7 +191,8 @@ func TestNewAuthService(t *testing.T) {
 				repo: mockDoctor,
 			},
 			want: &AuthService{
-				repo: mockDoctor,
+				repo:   mockDoctor,
+				admins: map[string]bool{},
 			},
 		},
 	}
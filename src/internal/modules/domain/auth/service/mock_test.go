@@ -49,3 +49,18 @@ func (mr *MockIDoctorRepoMockRecorder) Create(arg0, arg1 interface{}) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIDoctorRepo)(nil).Create), arg0, arg1)
 }
+
+// CreateAdmin mocks base method.
+func (m *MockIDoctorRepo) CreateAdmin(arg0 context.Context, arg1 *dto.CreateDoctor) (*dto.Doctor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAdmin", arg0, arg1)
+	ret0, _ := ret[0].(*dto.Doctor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAdmin indicates an expected call of CreateAdmin.
+func (mr *MockIDoctorRepoMockRecorder) CreateAdmin(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAdmin", reflect.TypeOf((*MockIDoctorRepo)(nil).CreateAdmin), arg0, arg1)
+}
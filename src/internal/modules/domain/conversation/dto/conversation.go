@@ -0,0 +1,21 @@
+package dto
+
+import "time"
+
+// Values - ответы пользователя по именам шагов диалога
+type Values map[string]string
+
+// Conversation - состояние диалога бота в одном чате
+type Conversation struct {
+	ChatId  int64
+	UserId  int64
+	Dialog  string
+	State   string
+	Values  Values
+	History []string
+	// Suspended - диалог прерван перезапуском бота и ждет подтверждения продолжения
+	Suspended bool
+	UpdatedAt time.Time
+}
+
+type Conversations []*Conversation
@@ -0,0 +1,16 @@
+package conversation
+
+import (
+	"go.uber.org/fx"
+	"hospital/internal/modules/domain/conversation/repo"
+)
+
+var (
+	Module = fx.Options(
+		repo.Module,
+	)
+
+	Invokables = fx.Options(
+		repo.Invokables,
+	)
+)
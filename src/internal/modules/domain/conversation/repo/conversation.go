@@ -0,0 +1,117 @@
+package repo
+
+import (
+	"context"
+	"hospital/internal/modules/db"
+	"hospital/internal/modules/db/ent"
+	"hospital/internal/modules/db/ent/conversation"
+	"hospital/internal/modules/domain/conversation/dto"
+)
+
+type ConversationRepo struct {
+	client *ent.Client
+}
+
+func NewConversationRepo(client *ent.Client) *ConversationRepo {
+	return &ConversationRepo{
+		client: client,
+	}
+}
+
+func (r *ConversationRepo) Get(ctx context.Context, chatId int64) (*dto.Conversation, error) {
+	Conversation, err := r.client.Conversation.Query().
+		Where(conversation.ChatIdEQ(chatId)).
+		Only(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToConversationDTO(Conversation), nil
+}
+
+func (r *ConversationRepo) List(ctx context.Context) (dto.Conversations, error) {
+	Conversations, err := r.client.Conversation.Query().All(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToConversationDTOs(Conversations), nil
+}
+
+// Save создает или заменяет состояние диалога в чате
+func (r *ConversationRepo) Save(ctx context.Context, dtm *dto.Conversation) error {
+	n, err := r.client.Conversation.Update().
+		Where(conversation.ChatIdEQ(dtm.ChatId)).
+		SetUserId(dtm.UserId).
+		SetDialog(dtm.Dialog).
+		SetState(dtm.State).
+		SetValues(dtm.Values).
+		SetHistory(dtm.History).
+		SetSuspended(dtm.Suspended).
+		SetUpdatedAt(dtm.UpdatedAt).
+		Save(ctx)
+	if err != nil {
+		return db.WrapError(err)
+	}
+	if n > 0 {
+		return nil
+	}
+
+	err = r.client.Conversation.Create().
+		SetChatId(dtm.ChatId).
+		SetUserId(dtm.UserId).
+		SetDialog(dtm.Dialog).
+		SetState(dtm.State).
+		SetValues(dtm.Values).
+		SetHistory(dtm.History).
+		SetSuspended(dtm.Suspended).
+		SetUpdatedAt(dtm.UpdatedAt).
+		Exec(ctx)
+	if err != nil {
+		return db.WrapError(err)
+	}
+
+	return nil
+}
+
+func (r *ConversationRepo) Delete(ctx context.Context, chatId int64) error {
+	_, err := r.client.Conversation.Delete().
+		Where(conversation.ChatIdEQ(chatId)).
+		Exec(ctx)
+	if err != nil {
+		return db.WrapError(err)
+	}
+
+	return nil
+}
+
+func ToConversationDTO(model *ent.Conversation) *dto.Conversation {
+	if model == nil {
+		return nil
+	}
+	values := dto.Values(model.Values)
+	if values == nil {
+		values = dto.Values{}
+	}
+	return &dto.Conversation{
+		ChatId:    model.ChatId,
+		UserId:    model.UserId,
+		Dialog:    model.Dialog,
+		State:     model.State,
+		Values:    values,
+		History:   model.History,
+		Suspended: model.Suspended,
+		UpdatedAt: model.UpdatedAt,
+	}
+}
+
+func ToConversationDTOs(models ent.Conversations) dto.Conversations {
+	if models == nil {
+		return nil
+	}
+	dtms := make(dto.Conversations, len(models))
+	for i := range models {
+		dtms[i] = ToConversationDTO(models[i])
+	}
+	return dtms
+}
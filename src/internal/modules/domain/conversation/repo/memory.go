@@ -0,0 +1,70 @@
+package repo
+
+import (
+	"context"
+	"hospital/internal/models/errors"
+	"hospital/internal/modules/domain/conversation/dto"
+	"sync"
+)
+
+// MemoryConversationRepo - хранилище диалогов в памяти процесса, используется только в тестах.
+// Бот хранит диалоги в базе через ConversationRepo.
+type MemoryConversationRepo struct {
+	mu            sync.Mutex
+	conversations map[int64]*dto.Conversation
+}
+
+func NewMemoryConversationRepo() *MemoryConversationRepo {
+	return &MemoryConversationRepo{
+		conversations: make(map[int64]*dto.Conversation),
+	}
+}
+
+func (r *MemoryConversationRepo) Get(_ context.Context, chatId int64) (*dto.Conversation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, ok := r.conversations[chatId]
+	if !ok {
+		return nil, errors.ErrDatabaseRecordNotFound
+	}
+	return copyConversation(c), nil
+}
+
+func (r *MemoryConversationRepo) List(_ context.Context) (dto.Conversations, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := make(dto.Conversations, 0, len(r.conversations))
+	for _, c := range r.conversations {
+		res = append(res, copyConversation(c))
+	}
+	return res, nil
+}
+
+func (r *MemoryConversationRepo) Save(_ context.Context, dtm *dto.Conversation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.conversations[dtm.ChatId] = copyConversation(dtm)
+	return nil
+}
+
+func (r *MemoryConversationRepo) Delete(_ context.Context, chatId int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.conversations, chatId)
+	return nil
+}
+
+// copyConversation не дает вызывающему коду менять сохраненное состояние в обход Save
+func copyConversation(c *dto.Conversation) *dto.Conversation {
+	copied := *c
+	copied.Values = make(dto.Values, len(c.Values))
+	for k, v := range c.Values {
+		copied.Values[k] = v
+	}
+	copied.History = append([]string(nil), c.History...)
+	return &copied
+}
@@ -0,0 +1,25 @@
+package dto
+
+type Department struct {
+	Id             int
+	Name           string
+	OrganizationId int
+}
+
+type Departments []*Department
+
+type CreateDepartment struct {
+	Name           string
+	OrganizationId int
+}
+
+type Organization struct {
+	Id   int
+	Name string
+}
+
+type Organizations []*Organization
+
+type CreateOrganization struct {
+	Name string
+}
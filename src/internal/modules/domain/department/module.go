@@ -0,0 +1,26 @@
+package department
+
+import (
+	"go.uber.org/fx"
+	"hospital/internal/modules/domain/department/repo"
+	"hospital/internal/modules/domain/department/service"
+)
+
+var (
+	Module = fx.Options(
+		service.Module,
+		repo.Module,
+
+		fx.Provide(
+			fx.Annotate(
+				func(r *repo.DepartmentRepo) *repo.DepartmentRepo { return r },
+				fx.As(new(service.IDepartmentRepo)),
+			),
+		),
+	)
+
+	Invokables = fx.Options(
+		service.Invokables,
+		repo.Invokables,
+	)
+)
@@ -0,0 +1,147 @@
+package repo
+
+import (
+	"context"
+	"hospital/internal/modules/db"
+	"hospital/internal/modules/db/ent"
+	"hospital/internal/modules/db/ent/department"
+	"hospital/internal/modules/db/ent/doctor"
+	"hospital/internal/modules/domain/department/dto"
+)
+
+type DepartmentRepo struct {
+	client *ent.Client
+}
+
+func NewDepartmentRepo(client *ent.Client) *DepartmentRepo {
+	return &DepartmentRepo{
+		client: client,
+	}
+}
+
+func (r *DepartmentRepo) GetById(ctx context.Context, id int) (*dto.Department, error) {
+	Department, err := r.client.Department.Get(ctx, id)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToDepartmentDTO(Department), nil
+}
+
+func (r *DepartmentRepo) List(ctx context.Context) (dto.Departments, error) {
+	Departments, err := r.client.Department.Query().All(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToDepartmentDTOs(Departments), nil
+}
+
+// ListByDoctor возвращает все отделения врача во всех клиниках.
+// Запрос выполняется без ограничения клиникой: по нему врач входит в систему и переключает отделения.
+func (r *DepartmentRepo) ListByDoctor(ctx context.Context, doctorId int) (dto.Departments, error) {
+	Departments, err := r.client.Department.Query().
+		Where(department.HasDoctorsWith(doctor.IDEQ(doctorId))).
+		Order(ent.Asc(department.FieldID)).
+		All(db.SystemContext(ctx))
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToDepartmentDTOs(Departments), nil
+}
+
+func (r *DepartmentRepo) Create(ctx context.Context, dtm *dto.CreateDepartment) (*dto.Department, error) {
+	Department, err := r.client.Department.Create().
+		SetName(dtm.Name).
+		SetOrganizationId(dtm.OrganizationId).
+		Save(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToDepartmentDTO(Department), nil
+}
+
+func (r *DepartmentRepo) AddDoctor(ctx context.Context, id int, doctorId int) error {
+	err := r.client.Department.UpdateOneID(id).
+		AddDoctorIDs(doctorId).
+		Exec(ctx)
+	if err != nil {
+		return db.WrapError(err)
+	}
+
+	return nil
+}
+
+func (r *DepartmentRepo) GetOrganizationById(ctx context.Context, id int) (*dto.Organization, error) {
+	Organization, err := r.client.Organization.Get(ctx, id)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToOrganizationDTO(Organization), nil
+}
+
+func (r *DepartmentRepo) ListOrganizations(ctx context.Context) (dto.Organizations, error) {
+	Organizations, err := r.client.Organization.Query().All(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToOrganizationDTOs(Organizations), nil
+}
+
+func (r *DepartmentRepo) CreateOrganization(ctx context.Context, dtm *dto.CreateOrganization) (*dto.Organization, error) {
+	Organization, err := r.client.Organization.Create().
+		SetName(dtm.Name).
+		Save(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToOrganizationDTO(Organization), nil
+}
+
+func ToDepartmentDTO(model *ent.Department) *dto.Department {
+	if model == nil {
+		return nil
+	}
+	return &dto.Department{
+		Id:             model.ID,
+		Name:           model.Name,
+		OrganizationId: model.OrganizationId,
+	}
+}
+
+func ToDepartmentDTOs(models ent.Departments) dto.Departments {
+	if models == nil {
+		return nil
+	}
+	dtms := make(dto.Departments, len(models))
+	for i := range models {
+		dtms[i] = ToDepartmentDTO(models[i])
+	}
+	return dtms
+}
+
+func ToOrganizationDTO(model *ent.Organization) *dto.Organization {
+	if model == nil {
+		return nil
+	}
+	return &dto.Organization{
+		Id:   model.ID,
+		Name: model.Name,
+	}
+}
+
+func ToOrganizationDTOs(models ent.Organizations) dto.Organizations {
+	if models == nil {
+		return nil
+	}
+	dtms := make(dto.Organizations, len(models))
+	for i := range models {
+		dtms[i] = ToOrganizationDTO(models[i])
+	}
+	return dtms
+}
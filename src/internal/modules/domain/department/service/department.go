@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"hospital/internal/models/errors"
+	"hospital/internal/models/role"
+	"hospital/internal/models/session"
+	"hospital/internal/modules/domain/department/dto"
+)
+
+//go:generate mockgen -destination mock_test.go -package service . IDepartmentRepo
+
+type IDepartmentRepo interface {
+	GetById(ctx context.Context, id int) (*dto.Department, error)
+	List(ctx context.Context) (dto.Departments, error)
+	Create(ctx context.Context, dtm *dto.CreateDepartment) (*dto.Department, error)
+	AddDoctor(ctx context.Context, id int, doctorId int) error
+	GetOrganizationById(ctx context.Context, id int) (*dto.Organization, error)
+	ListOrganizations(ctx context.Context) (dto.Organizations, error)
+	CreateOrganization(ctx context.Context, dtm *dto.CreateOrganization) (*dto.Organization, error)
+}
+
+type DepartmentService struct {
+	repo IDepartmentRepo
+}
+
+func NewDepartmentService(repo IDepartmentRepo) *DepartmentService {
+	return &DepartmentService{
+		repo: repo,
+	}
+}
+
+// List возвращает отделения клиники пользователя
+func (r *DepartmentService) List(ctx context.Context) (dto.Departments, error) {
+	return r.repo.List(ctx)
+}
+
+// Create заводит новое отделение, доступно только администраторам.
+// Администратор клиники заводит отделения только в своей клинике.
+func (r *DepartmentService) Create(ctx context.Context, dtm *dto.CreateDepartment) (*dto.Department, error) {
+	s, err := checkAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.OrganizationId != 0 && s.OrganizationId != dtm.OrganizationId {
+		return nil, errors.ErrAccessDenied
+	}
+	if _, err = r.repo.GetOrganizationById(ctx, dtm.OrganizationId); err != nil {
+		return nil, err
+	}
+	return r.repo.Create(ctx, dtm)
+}
+
+// AssignDoctor добавляет врача в отделение, доступно только администраторам.
+// Врач может работать в нескольких отделениях и переключаться между ними.
+func (r *DepartmentService) AssignDoctor(ctx context.Context, doctorId int, departmentId int) (*dto.Department, error) {
+	if _, err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	department, err := r.repo.GetById(ctx, departmentId)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.repo.AddDoctor(ctx, departmentId, doctorId); err != nil {
+		return nil, err
+	}
+	return department, nil
+}
+
+func (r *DepartmentService) ListOrganizations(ctx context.Context) (dto.Organizations, error) {
+	return r.repo.ListOrganizations(ctx)
+}
+
+// CreateOrganization заводит новую клинику, доступно только администраторам без привязки к клинике
+func (r *DepartmentService) CreateOrganization(ctx context.Context, dtm *dto.CreateOrganization) (*dto.Organization, error) {
+	s, err := checkAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.OrganizationId != 0 {
+		return nil, errors.ErrAccessDenied
+	}
+	return r.repo.CreateOrganization(ctx, dtm)
+}
+
+func checkAdmin(ctx context.Context) (session.Session, error) {
+	s, ok := session.GetSessionFromCtx(ctx)
+	if !ok {
+		return s, errors.ErrUnauthorized
+	}
+	if !role.IsAdmin(s.Role) {
+		return s, errors.ErrAccessDenied
+	}
+	return s, nil
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	err_c "hospital/internal/models/errors"
+	"hospital/internal/models/role"
+	"hospital/internal/models/session"
+	"hospital/internal/modules/domain/department/dto"
+	"reflect"
+	"testing"
+)
+
+func TestNewDepartmentService(t *testing.T) {
+	mockDepartment := new(MockIDepartmentRepo)
+
+	runner.Run(t, "Simple positive test", func(t provider.T) {
+		want := &DepartmentService{repo: mockDepartment}
+		if got := NewDepartmentService(mockDepartment); !reflect.DeepEqual(got, want) {
+			t.Errorf("NewDepartmentService() = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestDepartmentService_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDepartment := NewMockIDepartmentRepo(ctrl)
+
+	globalAdminCtx := session.SetSessionToCtx(context.Background(), session.Session{UserId: 1, Role: role.Admin})
+	clinicAdminCtx := session.SetSessionToCtx(context.Background(), session.Session{UserId: 2, Role: role.Admin, OrganizationId: 1})
+	doctorCtx := session.SetSessionToCtx(context.Background(), session.Session{UserId: 3, Role: role.Doctor, OrganizationId: 1})
+
+	department := &dto.Department{Id: 3, Name: "Хирургия", OrganizationId: 2}
+
+	mockDepartment.EXPECT().GetOrganizationById(gomock.Any(), 2).Return(&dto.Organization{Id: 2}, nil)
+	mockDepartment.EXPECT().Create(gomock.Any(), &dto.CreateDepartment{Name: "Хирургия", OrganizationId: 2}).Return(department, nil)
+
+	for _, tt := range []struct {
+		name    string
+		ctx     context.Context
+		want    *dto.Department
+		wantErr error
+	}{
+		{
+			name: "Admin without clinic creates department in any clinic",
+			ctx:  globalAdminCtx,
+			want: department,
+		},
+		{
+			name:    "Clinic admin cannot create department in another clinic",
+			ctx:     clinicAdminCtx,
+			wantErr: err_c.ErrAccessDenied,
+		},
+		{
+			name:    "Doctor is denied",
+			ctx:     doctorCtx,
+			wantErr: err_c.ErrAccessDenied,
+		},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			r := NewDepartmentService(mockDepartment)
+			got, err := r.Create(tt.ctx, &dto.CreateDepartment{Name: "Хирургия", OrganizationId: 2})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Create() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Create() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDepartmentService_AssignDoctor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDepartment := NewMockIDepartmentRepo(ctrl)
+
+	adminCtx := session.SetSessionToCtx(context.Background(), session.Session{UserId: 1, Role: role.Admin})
+	doctorCtx := session.SetSessionToCtx(context.Background(), session.Session{UserId: 2, Role: role.Doctor})
+
+	department := &dto.Department{Id: 3, Name: "Хирургия", OrganizationId: 1}
+
+	mockDepartment.EXPECT().GetById(gomock.Any(), 3).Return(department, nil)
+	mockDepartment.EXPECT().GetById(gomock.Any(), 4).Return(nil, err_c.ErrDatabaseRecordNotFound)
+	mockDepartment.EXPECT().AddDoctor(gomock.Any(), 3, 5).Return(nil)
+
+	for _, tt := range []struct {
+		name         string
+		ctx          context.Context
+		departmentId int
+		want         *dto.Department
+		wantErr      error
+	}{
+		{
+			name:         "Admin assigns doctor",
+			ctx:          adminCtx,
+			departmentId: 3,
+			want:         department,
+		},
+		{
+			name:         "Unknown department",
+			ctx:          adminCtx,
+			departmentId: 4,
+			wantErr:      err_c.ErrDatabaseRecordNotFound,
+		},
+		{
+			name:         "Doctor is denied",
+			ctx:          doctorCtx,
+			departmentId: 3,
+			wantErr:      err_c.ErrAccessDenied,
+		},
+		{
+			name:         "No session",
+			ctx:          context.Background(),
+			departmentId: 3,
+			wantErr:      err_c.ErrUnauthorized,
+		},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			r := NewDepartmentService(mockDepartment)
+			got, err := r.AssignDoctor(tt.ctx, 5, tt.departmentId)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AssignDoctor() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AssignDoctor() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDepartmentService_CreateOrganization(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDepartment := NewMockIDepartmentRepo(ctrl)
+	organization := &dto.Organization{Id: 1, Name: "Городская больница"}
+	mockDepartment.EXPECT().CreateOrganization(gomock.Any(), &dto.CreateOrganization{Name: organization.Name}).Return(organization, nil)
+
+	for _, tt := range []struct {
+		name    string
+		session session.Session
+		want    *dto.Organization
+		wantErr error
+	}{
+		{
+			name:    "Admin without clinic",
+			session: session.Session{UserId: 1, Role: role.Admin},
+			want:    organization,
+		},
+		{
+			name:    "Clinic admin is denied",
+			session: session.Session{UserId: 2, Role: role.Admin, OrganizationId: 1},
+			wantErr: err_c.ErrAccessDenied,
+		},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			r := NewDepartmentService(mockDepartment)
+			ctx := session.SetSessionToCtx(context.Background(), tt.session)
+			got, err := r.CreateOrganization(ctx, &dto.CreateOrganization{Name: organization.Name})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateOrganization() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CreateOrganization() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: hospital/internal/modules/domain/department/service (interfaces: IDepartmentRepo)
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	dto "hospital/internal/modules/domain/department/dto"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIDepartmentRepo is a mock of IDepartmentRepo interface.
+type MockIDepartmentRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockIDepartmentRepoMockRecorder
+}
+
+// MockIDepartmentRepoMockRecorder is the mock recorder for MockIDepartmentRepo.
+type MockIDepartmentRepoMockRecorder struct {
+	mock *MockIDepartmentRepo
+}
+
+// NewMockIDepartmentRepo creates a new mock instance.
+func NewMockIDepartmentRepo(ctrl *gomock.Controller) *MockIDepartmentRepo {
+	mock := &MockIDepartmentRepo{ctrl: ctrl}
+	mock.recorder = &MockIDepartmentRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIDepartmentRepo) EXPECT() *MockIDepartmentRepoMockRecorder {
+	return m.recorder
+}
+
+// AddDoctor mocks base method.
+func (m *MockIDepartmentRepo) AddDoctor(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDoctor", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddDoctor indicates an expected call of AddDoctor.
+func (mr *MockIDepartmentRepoMockRecorder) AddDoctor(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDoctor", reflect.TypeOf((*MockIDepartmentRepo)(nil).AddDoctor), arg0, arg1, arg2)
+}
+
+// Create mocks base method.
+func (m *MockIDepartmentRepo) Create(arg0 context.Context, arg1 *dto.CreateDepartment) (*dto.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*dto.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIDepartmentRepoMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIDepartmentRepo)(nil).Create), arg0, arg1)
+}
+
+// CreateOrganization mocks base method.
+func (m *MockIDepartmentRepo) CreateOrganization(arg0 context.Context, arg1 *dto.CreateOrganization) (*dto.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganization", arg0, arg1)
+	ret0, _ := ret[0].(*dto.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganization indicates an expected call of CreateOrganization.
+func (mr *MockIDepartmentRepoMockRecorder) CreateOrganization(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganization", reflect.TypeOf((*MockIDepartmentRepo)(nil).CreateOrganization), arg0, arg1)
+}
+
+// GetById mocks base method.
+func (m *MockIDepartmentRepo) GetById(arg0 context.Context, arg1 int) (*dto.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", arg0, arg1)
+	ret0, _ := ret[0].(*dto.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockIDepartmentRepoMockRecorder) GetById(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockIDepartmentRepo)(nil).GetById), arg0, arg1)
+}
+
+// GetOrganizationById mocks base method.
+func (m *MockIDepartmentRepo) GetOrganizationById(arg0 context.Context, arg1 int) (*dto.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationById", arg0, arg1)
+	ret0, _ := ret[0].(*dto.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationById indicates an expected call of GetOrganizationById.
+func (mr *MockIDepartmentRepoMockRecorder) GetOrganizationById(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationById", reflect.TypeOf((*MockIDepartmentRepo)(nil).GetOrganizationById), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockIDepartmentRepo) List(arg0 context.Context) (dto.Departments, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0)
+	ret0, _ := ret[0].(dto.Departments)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIDepartmentRepoMockRecorder) List(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIDepartmentRepo)(nil).List), arg0)
+}
+
+// ListOrganizations mocks base method.
+func (m *MockIDepartmentRepo) ListOrganizations(arg0 context.Context) (dto.Organizations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizations", arg0)
+	ret0, _ := ret[0].(dto.Organizations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizations indicates an expected call of ListOrganizations.
+func (mr *MockIDepartmentRepoMockRecorder) ListOrganizations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizations", reflect.TypeOf((*MockIDepartmentRepo)(nil).ListOrganizations), arg0)
+}
@@ -0,0 +1,8 @@
+package service
+
+import "go.uber.org/fx"
+
+var (
+	Module     = fx.Provide(NewDepartmentService)
+	Invokables = fx.Invoke()
+)
@@ -1,5 +1,7 @@
 package dto
 
+import "hospital/internal/models/validation"
+
 type Disease struct {
 	Id             int
 	Name           string
@@ -20,3 +22,24 @@ type UpdateDisease struct {
 	Threat         string
 	DegreeOfDanger int
 }
+
+// Допустимые значения степени опасности заболевания
+const (
+	MinDanger = 1
+	MaxDanger = 5
+)
+
+func (r *CreateDisease) Validate() error {
+	return validateDisease(r.Name, r.DegreeOfDanger)
+}
+
+func (r *UpdateDisease) Validate() error {
+	return validateDisease(r.Name, r.DegreeOfDanger)
+}
+
+func validateDisease(name string, danger int) error {
+	var v validation.Validator
+	v.Required("Name", "Название", name)
+	v.IntRange("DegreeOfDanger", "Степень опасности", danger, MinDanger, MaxDanger)
+	return v.Err()
+}
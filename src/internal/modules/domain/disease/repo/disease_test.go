@@ -63,7 +63,7 @@ func TestDiseaseRepo_Create(t *testing.T) {
 		SetName("Kovel").
 		SetThreat("Kovel").
 		SetDegreeOfDanger(1).
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create disease: %v", err)
 	}
@@ -93,7 +93,7 @@ func TestDiseaseRepo_Create(t *testing.T) {
 
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		got, err := testCase1.repo.GetById(context.Background(), testCase1.id)
+		got, err := testCase1.repo.GetById(db.SystemContext(context.Background()), testCase1.id)
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("Create() error = %v, wantErr %v", err, testCase1.wantErr)
 			return
@@ -120,7 +120,7 @@ func TestDiseaseRepo_Create(t *testing.T) {
 
 	// Run the test case 2
 	runner.Run(t, testCase2.name, func(t provider.T) {
-		got, err := testCase2.repo.GetById(context.Background(), testCase2.id)
+		got, err := testCase2.repo.GetById(db.SystemContext(context.Background()), testCase2.id)
 		if (err != nil) != testCase2.wantErr {
 			t.Errorf("GetById() error = %v, wantErr %v", err, testCase2.wantErr)
 			return
@@ -152,7 +152,7 @@ func TestDiseaseRepo_Delete(t *testing.T) {
 		SetName("Kovel").
 		SetThreat("Kovel").
 		SetDegreeOfDanger(1).
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create disease: %v", err)
 	}
@@ -189,7 +189,7 @@ func TestDiseaseRepo_Delete(t *testing.T) {
 
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		err := testCase1.repo.Delete(context.Background(), testCase1.id)
+		err := testCase1.repo.Delete(db.SystemContext(context.Background()), testCase1.id)
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("Delete() error = %v, wantErr %v", err, testCase1.wantErr)
 			return
@@ -213,7 +213,7 @@ func TestDiseaseRepo_Delete(t *testing.T) {
 
 	// Run the test case 2
 	runner.Run(t, testCase2.name, func(t provider.T) {
-		err := testCase2.repo.Delete(context.Background(), testCase2.id)
+		err := testCase2.repo.Delete(db.SystemContext(context.Background()), testCase2.id)
 		if (err != nil) != testCase2.wantErr {
 			t.Errorf("Delete() error = %v, wantErr %v", err, testCase2.wantErr)
 			return
@@ -242,7 +242,7 @@ func TestDiseaseRepo_GetById(t *testing.T) {
 		SetName("Kovel").
 		SetThreat("Kovel").
 		SetDegreeOfDanger(1).
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create disease: %v", err)
 	}
@@ -275,7 +275,7 @@ func TestDiseaseRepo_GetById(t *testing.T) {
 
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		got, err := testCase1.repo.GetById(context.Background(), testCase1.id)
+		got, err := testCase1.repo.GetById(db.SystemContext(context.Background()), testCase1.id)
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("GetById() error = %v, wantErr %v", err, testCase1.wantErr)
 			return
@@ -302,7 +302,7 @@ func TestDiseaseRepo_GetById(t *testing.T) {
 
 	// Run the test case 2
 	runner.Run(t, testCase2.name, func(t provider.T) {
-		got, err := testCase2.repo.GetById(context.Background(), testCase2.id)
+		got, err := testCase2.repo.GetById(db.SystemContext(context.Background()), testCase2.id)
 		if (err != nil) != testCase2.wantErr {
 			t.Errorf("GetById() error = %v, wantErr %v", err, testCase2.wantErr)
 			return
@@ -334,7 +334,7 @@ func TestDiseaseRepo_List(t *testing.T) {
 		SetName("Kovel").
 		SetThreat("Kovel").
 		SetDegreeOfDanger(1).
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create disease: %v", err)
 	}
@@ -370,7 +370,7 @@ func TestDiseaseRepo_List(t *testing.T) {
 
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		got, err := testCase1.repo.List(context.Background())
+		got, err := testCase1.repo.List(db.SystemContext(context.Background()))
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("List() error = %v, wantErr %v", err, testCase1.wantErr)
 			return
@@ -403,7 +403,7 @@ func TestDiseaseRepo_Restore(t *testing.T) {
 		SetName("Kovel").
 		SetThreat("Kovel").
 		SetDegreeOfDanger(1).
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create disease: %v", err)
 	}
@@ -441,7 +441,7 @@ func TestDiseaseRepo_Restore(t *testing.T) {
 
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		got, err := testCase1.repo.Restore(context.Background(), testCase1.id)
+		got, err := testCase1.repo.Restore(db.SystemContext(context.Background()), testCase1.id)
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("Restore() error = %v, wantErr %v", err, testCase1.wantErr)
 			return
@@ -468,7 +468,7 @@ func TestDiseaseRepo_Restore(t *testing.T) {
 
 	// Run the test case 2
 	runner.Run(t, testCase2.name, func(t provider.T) {
-		got, err := testCase2.repo.Restore(context.Background(), testCase2.id)
+		got, err := testCase2.repo.Restore(db.SystemContext(context.Background()), testCase2.id)
 		if (err != nil) != testCase2.wantErr {
 			t.Errorf("Restore() error = %v, wantErr %v", err, testCase2.wantErr)
 			return
@@ -500,7 +500,7 @@ func TestDiseaseRepo_Update(t *testing.T) {
 		SetName("Kovel").
 		SetThreat("Kovel").
 		SetDegreeOfDanger(1).
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create disease: %v", err)
 	}
@@ -513,7 +513,7 @@ func TestDiseaseRepo_Update(t *testing.T) {
 		SetName("Kovel").
 		SetThreat("Kovel").
 		SetDegreeOfDanger(1).
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to update disease: %v", err)
 	}
@@ -547,7 +547,7 @@ func TestDiseaseRepo_Update(t *testing.T) {
 	}
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		got, err := testCase1.repo.Update(context.Background(), testCase1.id, &upd_disease)
+		got, err := testCase1.repo.Update(db.SystemContext(context.Background()), testCase1.id, &upd_disease)
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("Update() error = %v, wantErr %v", err, testCase1.wantErr)
 			return
@@ -574,7 +574,7 @@ func TestDiseaseRepo_Update(t *testing.T) {
 
 	// Run the test case 2
 	runner.Run(t, testCase2.name, func(t provider.T) {
-		got, err := testCase2.repo.Update(context.Background(), testCase2.id, &upd_disease)
+		got, err := testCase2.repo.Update(db.SystemContext(context.Background()), testCase2.id, &upd_disease)
 		if (err != nil) != testCase2.wantErr {
 			t.Errorf("Update() error = %v, wantErr %v", err, testCase2.wantErr)
 			return
@@ -606,7 +606,7 @@ func TestToDiseaseDTO(t *testing.T) {
 		SetName("Kovel").
 		SetThreat("Kovel").
 		SetDegreeOfDanger(1).
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create disease: %v", err)
 	}
@@ -672,7 +672,7 @@ func TestToDiseaseDTOs(t *testing.T) {
 		SetName("Kovel").
 		SetThreat("Kovel").
 		SetDegreeOfDanger(1).
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create disease: %v", err)
 	}
@@ -708,7 +708,7 @@ func TestToDiseaseDTOs(t *testing.T) {
 
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		diseases, err := client.Disease.Query().All(context.Background())
+		diseases, err := client.Disease.Query().All(db.SystemContext(context.Background()))
 		got := ToDiseaseDTOs(diseases)
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("ToDiseaseDTOs() error = %v, wantErr %v", err, testCase1.wantErr)
@@ -34,10 +34,18 @@ func (r *DiseaseService) List(ctx context.Context) (dto.Diseases, error) {
 }
 
 func (r *DiseaseService) Create(ctx context.Context, dtm *dto.CreateDisease) (*dto.Disease, error) {
+	if err := dtm.Validate(); err != nil {
+		return nil, err
+	}
+
 	return r.repo.Create(ctx, dtm)
 }
 
 func (r *DiseaseService) Update(ctx context.Context, id int, dtm *dto.UpdateDisease) (*dto.Disease, error) {
+	if err := dtm.Validate(); err != nil {
+		return nil, err
+	}
+
 	return r.repo.Update(ctx, id, dtm)
 }
 
@@ -6,6 +6,8 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/ozontech/allure-go/pkg/framework/provider"
 	"github.com/ozontech/allure-go/pkg/framework/runner"
+	model_errors "hospital/internal/models/errors"
+	"hospital/internal/models/validation"
 	"hospital/internal/modules/domain/disease/dto"
 	"reflect"
 	"testing"
@@ -523,3 +525,31 @@ func TestDiseaseService_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestDiseaseService_CreateValidation(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		disease dto.CreateDisease
+		field   string
+	}{
+		{name: "Danger below range", disease: dto.CreateDisease{Name: "Грипп", DegreeOfDanger: 0}, field: "DegreeOfDanger"},
+		{name: "Danger above range", disease: dto.CreateDisease{Name: "Грипп", DegreeOfDanger: 6}, field: "DegreeOfDanger"},
+		{name: "Empty name", disease: dto.CreateDisease{DegreeOfDanger: 3}, field: "Name"},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := &DiseaseService{repo: NewMockIDiseaseRepo(ctrl)}
+
+			_, err := r.Create(context.Background(), &tt.disease)
+			if !errors.Is(err, model_errors.ErrValidation) {
+				t.Fatalf("Create() error = %v, want validation error", err)
+			}
+			var fields validation.Errors
+			if !errors.As(err, &fields) || len(fields) != 1 || fields.Field(tt.field) == nil {
+				t.Errorf("Create() errors = %v, want only %s", err, tt.field)
+			}
+		})
+	}
+}
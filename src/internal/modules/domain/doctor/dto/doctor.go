@@ -1,11 +1,36 @@
 package dto
 
+import (
+	"hospital/internal/models/role"
+	"hospital/internal/models/validation"
+	"strings"
+)
+
+const (
+	RoleAdmin         = role.Admin
+	RoleHeadPhysician = role.HeadPhysician
+	RoleDoctor        = role.Doctor
+	RoleNurse         = role.Nurse
+)
+
+// Roles - все роли врачей
+var Roles = []string{RoleDoctor, RoleNurse, RoleHeadPhysician, RoleAdmin}
+
 type Doctor struct {
 	Id         int
 	TokenId    string
 	Surname    string
 	Speciality string
 	Role       string
+	// Language - код языка интерфейса; пустой, если врач его не выбирал
+	Language string
+	// OnCall - врач на дежурстве
+	OnCall bool
+}
+
+// IsAdmin - может ли врач управлять справочниками и смотреть журналы
+func (d *Doctor) IsAdmin() bool {
+	return role.IsAdmin(d.Role)
 }
 
 type Doctors []*Doctor
@@ -17,9 +42,27 @@ type CreateDoctor struct {
 	Role       string
 }
 
+// UpdateDoctor - профиль врача; роль меняется только через SetRole
 type UpdateDoctor struct {
 	TokenId    string
 	Surname    string
 	Speciality string
-	Role       string
+}
+
+// ValidateRole проверяет, что роль - одна из Roles
+func ValidateRole(r string) error {
+	var v validation.Validator
+	known := false
+	for _, k := range Roles {
+		known = known || k == r
+	}
+	v.CheckCode(known, "Role", "Роль", validation.CodeInvalid)
+	return v.Err()
+}
+
+// ValidateLanguage проверяет код языка: две строчные латинские буквы
+func ValidateLanguage(lang string) error {
+	var v validation.Validator
+	v.CheckCode(len(lang) == 2 && strings.Trim(lang, "abcdefghijklmnopqrstuvwxyz") == "", "Language", "Язык", validation.CodeInvalid)
+	return v.Err()
 }
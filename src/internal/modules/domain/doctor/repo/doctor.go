@@ -2,6 +2,7 @@ package repo
 
 import (
 	"context"
+	"hospital/internal/models/role"
 	"hospital/internal/modules/db"
 	"hospital/internal/modules/db/ent"
 	"hospital/internal/modules/db/ent/doctor"
@@ -59,11 +60,18 @@ func (r *DoctorRepo) Create(ctx context.Context, dtm *dto.CreateDoctor) (*dto.Do
 	return ToDoctorDTO(Doctor), nil
 }
 
+// CreateAdmin регистрирует администратора из настроек развертывания. Правила доступа
+// не дают зарегистрироваться администратором, поэтому запись создается от имени системы.
+func (r *DoctorRepo) CreateAdmin(ctx context.Context, dtm *dto.CreateDoctor) (*dto.Doctor, error) {
+	admin := *dtm
+	admin.Role = role.Admin
+	return r.Create(db.SystemContext(ctx), &admin)
+}
+
 func (r *DoctorRepo) Update(ctx context.Context, id int, dtm *dto.UpdateDoctor) (*dto.Doctor, error) {
 	Doctor, err := r.client.Doctor.UpdateOneID(id).
 		SetTokenId(dtm.TokenId).
 		SetSurname(dtm.Surname).
-		SetRole(dtm.Role).
 		SetSpeciality(dtm.Speciality).
 		Save(ctx)
 	if err != nil {
@@ -73,6 +81,39 @@ func (r *DoctorRepo) Update(ctx context.Context, id int, dtm *dto.UpdateDoctor)
 	return ToDoctorDTO(Doctor), nil
 }
 
+func (r *DoctorRepo) SetLanguage(ctx context.Context, id int, lang string) (*dto.Doctor, error) {
+	Doctor, err := r.client.Doctor.UpdateOneID(id).
+		SetLanguage(lang).
+		Save(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToDoctorDTO(Doctor), nil
+}
+
+func (r *DoctorRepo) SetRole(ctx context.Context, id int, role string) (*dto.Doctor, error) {
+	Doctor, err := r.client.Doctor.UpdateOneID(id).
+		SetRole(role).
+		Save(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToDoctorDTO(Doctor), nil
+}
+
+func (r *DoctorRepo) SetOnCall(ctx context.Context, id int, onCall bool) (*dto.Doctor, error) {
+	Doctor, err := r.client.Doctor.UpdateOneID(id).
+		SetOnCall(onCall).
+		Save(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToDoctorDTO(Doctor), nil
+}
+
 func (r *DoctorRepo) Delete(ctx context.Context, id int) error {
 	err := r.client.Doctor.DeleteOneID(id).Exec(ctx)
 	if err != nil {
@@ -101,6 +142,8 @@ func ToDoctorDTO(model *ent.Doctor) *dto.Doctor {
 		Surname:    model.Surname,
 		Speciality: model.Speciality,
 		Role:       model.Role,
+		Language:   model.Language,
+		OnCall:     model.OnCall,
 	}
 }
 
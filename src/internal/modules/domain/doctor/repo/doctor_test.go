@@ -64,7 +64,7 @@ func TestDoctorRepo_Create(t *testing.T) {
 		SetSpeciality("Doctor").
 		SetRole("Doctor").
 		SetTokenId("1").
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create doctor: %v", err)
 	}
@@ -95,7 +95,7 @@ func TestDoctorRepo_Create(t *testing.T) {
 
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		got, err := testCase1.repo.GetById(context.Background(), testCase1.id)
+		got, err := testCase1.repo.GetById(db.SystemContext(context.Background()), testCase1.id)
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("Create() error = %v, wantErr %v", err, testCase1.wantErr)
 			return
@@ -122,7 +122,7 @@ func TestDoctorRepo_Create(t *testing.T) {
 
 	// Run the test case 2
 	runner.Run(t, testCase2.name, func(t provider.T) {
-		got, err := testCase2.repo.GetById(context.Background(), testCase2.id)
+		got, err := testCase2.repo.GetById(db.SystemContext(context.Background()), testCase2.id)
 		if (err != nil) != testCase2.wantErr {
 			t.Errorf("GetById() error = %v, wantErr %v", err, testCase2.wantErr)
 			return
@@ -155,7 +155,7 @@ func TestDoctorRepo_Delete(t *testing.T) {
 		SetSpeciality("Doctor").
 		SetRole("Doctor").
 		SetTokenId("1").
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create doctor: %v", err)
 	}
@@ -193,7 +193,7 @@ func TestDoctorRepo_Delete(t *testing.T) {
 
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		err := testCase1.repo.Delete(context.Background(), testCase1.id)
+		err := testCase1.repo.Delete(db.SystemContext(context.Background()), testCase1.id)
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("Delete() error = %v, wantErr %v", err, testCase1.wantErr)
 			return
@@ -217,7 +217,7 @@ func TestDoctorRepo_Delete(t *testing.T) {
 
 	// Run the test case 2
 	runner.Run(t, testCase2.name, func(t provider.T) {
-		err := testCase2.repo.Delete(context.Background(), testCase2.id)
+		err := testCase2.repo.Delete(db.SystemContext(context.Background()), testCase2.id)
 		if (err != nil) != testCase2.wantErr {
 			t.Errorf("Delete() error = %v, wantErr %v", err, testCase2.wantErr)
 			return
@@ -247,7 +247,7 @@ func TestDoctorRepo_GetById(t *testing.T) {
 		SetSpeciality("Doctor").
 		SetRole("Doctor").
 		SetTokenId("1").
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create doctor: %v", err)
 	}
@@ -281,7 +281,7 @@ func TestDoctorRepo_GetById(t *testing.T) {
 
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		got, err := testCase1.repo.GetById(context.Background(), testCase1.id)
+		got, err := testCase1.repo.GetById(db.SystemContext(context.Background()), testCase1.id)
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("GetById() error = %v, wantErr %v", err, testCase1.wantErr)
 			return
@@ -308,7 +308,7 @@ func TestDoctorRepo_GetById(t *testing.T) {
 
 	// Run the test case 2
 	runner.Run(t, testCase2.name, func(t provider.T) {
-		got, err := testCase2.repo.GetById(context.Background(), testCase2.id)
+		got, err := testCase2.repo.GetById(db.SystemContext(context.Background()), testCase2.id)
 		if (err != nil) != testCase2.wantErr {
 			t.Errorf("GetById() error = %v, wantErr %v", err, testCase2.wantErr)
 			return
@@ -341,7 +341,7 @@ func TestDoctorRepo_List(t *testing.T) {
 		SetSpeciality("Doctor").
 		SetRole("Doctor").
 		SetTokenId("1").
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create doctor: %v", err)
 	}
@@ -378,7 +378,7 @@ func TestDoctorRepo_List(t *testing.T) {
 
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		got, err := testCase1.repo.List(context.Background())
+		got, err := testCase1.repo.List(db.SystemContext(context.Background()))
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("List() error = %v, wantErr %v", err, testCase1.wantErr)
 			return
@@ -412,7 +412,7 @@ func TestDoctorRepo_Restore(t *testing.T) {
 		SetSpeciality("Doctor").
 		SetRole("Doctor").
 		SetTokenId("1").
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create doctor: %v", err)
 	}
@@ -451,7 +451,7 @@ func TestDoctorRepo_Restore(t *testing.T) {
 
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		got, err := testCase1.repo.Restore(context.Background(), testCase1.id)
+		got, err := testCase1.repo.Restore(db.SystemContext(context.Background()), testCase1.id)
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("Restore() error = %v, wantErr %v", err, testCase1.wantErr)
 			return
@@ -478,7 +478,7 @@ func TestDoctorRepo_Restore(t *testing.T) {
 
 	// Run the test case 2
 	runner.Run(t, testCase2.name, func(t provider.T) {
-		got, err := testCase2.repo.Restore(context.Background(), testCase2.id)
+		got, err := testCase2.repo.Restore(db.SystemContext(context.Background()), testCase2.id)
 		if (err != nil) != testCase2.wantErr {
 			t.Errorf("Restore() error = %v, wantErr %v", err, testCase2.wantErr)
 			return
@@ -511,7 +511,7 @@ func TestDoctorRepo_Update(t *testing.T) {
 		SetSpeciality("Doctor").
 		SetRole("Doctor").
 		SetTokenId("1").
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create doctor: %v", err)
 	}
@@ -525,7 +525,7 @@ func TestDoctorRepo_Update(t *testing.T) {
 		SetSpeciality("Doctor").
 		SetRole("Doctor").
 		SetTokenId("1").
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to update doctor: %v", err)
 	}
@@ -556,12 +556,11 @@ func TestDoctorRepo_Update(t *testing.T) {
 	upd_doctor := dto.UpdateDoctor{
 		Surname:    "Kovel",
 		Speciality: "Doctor",
-		Role:       "Doctor",
 		TokenId:    "1",
 	}
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		got, err := testCase1.repo.Update(context.Background(), testCase1.id, &upd_doctor)
+		got, err := testCase1.repo.Update(db.SystemContext(context.Background()), testCase1.id, &upd_doctor)
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("Update() error = %v, wantErr %v", err, testCase1.wantErr)
 			return
@@ -588,7 +587,7 @@ func TestDoctorRepo_Update(t *testing.T) {
 
 	// Run the test case 2
 	runner.Run(t, testCase2.name, func(t provider.T) {
-		got, err := testCase2.repo.Update(context.Background(), testCase2.id, &upd_doctor)
+		got, err := testCase2.repo.Update(db.SystemContext(context.Background()), testCase2.id, &upd_doctor)
 		if (err != nil) != testCase2.wantErr {
 			t.Errorf("Update() error = %v, wantErr %v", err, testCase2.wantErr)
 			return
@@ -621,7 +620,7 @@ func TestToDoctorDTO(t *testing.T) {
 		SetSpeciality("Doctor").
 		SetRole("Doctor").
 		SetTokenId("1").
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create doctor: %v", err)
 	}
@@ -689,7 +688,7 @@ func TestToDoctorDTOs(t *testing.T) {
 		SetSpeciality("Doctor").
 		SetRole("Doctor").
 		SetTokenId("1").
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create doctor: %v", err)
 	}
@@ -726,7 +725,7 @@ func TestToDoctorDTOs(t *testing.T) {
 
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		doctors, err := client.Doctor.Query().All(context.Background())
+		doctors, err := client.Doctor.Query().All(db.SystemContext(context.Background()))
 		got := ToDoctorDTOs(doctors)
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("ToDoctorDTOs() error = %v, wantErr %v", err, testCase1.wantErr)
@@ -760,7 +759,7 @@ func TestDoctorRepo_GetByTokenId(t *testing.T) {
 		SetSpeciality("Doctor").
 		SetRole("Doctor").
 		SetTokenId("1").
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create doctor: %v", err)
 	}
@@ -794,7 +793,7 @@ func TestDoctorRepo_GetByTokenId(t *testing.T) {
 
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		got, err := testCase1.repo.GetByTokenId(context.Background(), testCase1.tokenId)
+		got, err := testCase1.repo.GetByTokenId(db.SystemContext(context.Background()), testCase1.tokenId)
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("GetByTokenId() error = %v, wantErr %v", err, testCase1.wantErr)
 			return
@@ -821,7 +820,7 @@ func TestDoctorRepo_GetByTokenId(t *testing.T) {
 
 	// Run the test case 2
 	runner.Run(t, testCase2.name, func(t provider.T) {
-		got, err := testCase2.repo.GetByTokenId(context.Background(), testCase2.tokenId)
+		got, err := testCase2.repo.GetByTokenId(db.SystemContext(context.Background()), testCase2.tokenId)
 		if (err != nil) != testCase2.wantErr {
 			t.Errorf("GetByTokenId() error = %v, wantErr %v", err, testCase2.wantErr)
 			return
@@ -831,3 +830,62 @@ func TestDoctorRepo_GetByTokenId(t *testing.T) {
 		}
 	})
 }
+
+func TestDoctorRepo_SetLanguage(t *testing.T) {
+	log, levelog, err := logger.NewLogger()
+	if err != nil {
+		t.Fatalf("failed to init logger: %v", err)
+	}
+	cfg, err := config.NewConfig(log, levelog)
+	if err != nil {
+		t.Fatalf("failed to init config: %v", err)
+	}
+	client, err := db.NewDBClient(cfg, log)
+	err = db.TruncateAll(client)
+	if err != nil {
+		t.Fatalf("failed to truncate all: %v", err)
+	}
+	doctor, err := client.Doctor.Create().
+		SetSurname("Kovel").
+		SetSpeciality("Doctor").
+		SetRole("Doctor").
+		SetTokenId("1").
+		Save(db.SystemContext(context.Background()))
+	if err != nil {
+		t.Fatalf("failed to create doctor: %v", err)
+	}
+
+	repo := NewDoctorRepo(client)
+
+	for _, tt := range []struct {
+		name    string
+		id      int
+		want    *dto.Doctor
+		wantErr bool
+	}{
+		{
+			name: "Successful update",
+			id:   doctor.ID,
+			want: &dto.Doctor{
+				Id:         doctor.ID,
+				Surname:    "Kovel",
+				Speciality: "Doctor",
+				Role:       "Doctor",
+				TokenId:    "1",
+				Language:   "en",
+			},
+		},
+		{name: "Non-existent doctor", id: doctor.ID + 100, wantErr: true},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			got, err := repo.SetLanguage(db.SystemContext(context.Background()), tt.id, "en")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SetLanguage() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SetLanguage() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,9 @@ package service
 
 import (
 	"context"
+	"hospital/internal/models/errors"
+	"hospital/internal/models/role"
+	"hospital/internal/models/session"
 	"hospital/internal/modules/domain/doctor/dto"
 )
 
@@ -14,6 +17,9 @@ type IDoctorRepo interface {
 	Update(ctx context.Context, id int, dtm *dto.UpdateDoctor) (*dto.Doctor, error)
 	Delete(ctx context.Context, id int) error
 	GetByTokenId(ctx context.Context, token string) (*dto.Doctor, error)
+	SetLanguage(ctx context.Context, id int, lang string) (*dto.Doctor, error)
+	SetOnCall(ctx context.Context, id int, onCall bool) (*dto.Doctor, error)
+	SetRole(ctx context.Context, id int, role string) (*dto.Doctor, error)
 }
 
 type DoctorService struct {
@@ -49,3 +55,45 @@ func (r *DoctorService) Delete(ctx context.Context, id int) error {
 func (r *DoctorService) GetByTokenId(ctx context.Context, token string) (*dto.Doctor, error) {
 	return r.repo.GetByTokenId(ctx, token)
 }
+
+// SetLanguage задает врачу язык интерфейса
+func (r *DoctorService) SetLanguage(ctx context.Context, id int, lang string) (*dto.Doctor, error) {
+	if err := dto.ValidateLanguage(lang); err != nil {
+		return nil, err
+	}
+	return r.repo.SetLanguage(ctx, id, lang)
+}
+
+// SetRole назначает врачу роль. Роли назначает администратор клиники врачам своей клиники:
+// врачи других клиник ему не видны, а администратор без клиники роли не назначает.
+// Администратора назначает только администратор; свою роль и роль тех, кто не младше,
+// поменять нельзя.
+func (r *DoctorService) SetRole(ctx context.Context, id int, newRole string) (*dto.Doctor, error) {
+	s, ok := session.GetSessionFromCtx(ctx)
+	if !ok {
+		return nil, errors.ErrUnauthorized
+	}
+	if !role.IsAdmin(s.Role) || s.OrganizationId == 0 || id == s.UserId {
+		return nil, errors.ErrAccessDenied
+	}
+	if err := dto.ValidateRole(newRole); err != nil {
+		return nil, err
+	}
+	if newRole == role.Admin && s.Role != role.Admin {
+		return nil, errors.ErrAccessDenied
+	}
+
+	doctor, err := r.repo.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if role.Rank(doctor.Role) >= role.Rank(s.Role) {
+		return nil, errors.ErrAccessDenied
+	}
+	return r.repo.SetRole(ctx, id, newRole)
+}
+
+// SetOnCall отмечает начало или конец дежурства врача
+func (r *DoctorService) SetOnCall(ctx context.Context, id int, onCall bool) (*dto.Doctor, error) {
+	return r.repo.SetOnCall(ctx, id, onCall)
+}
@@ -6,6 +6,9 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/ozontech/allure-go/pkg/framework/provider"
 	"github.com/ozontech/allure-go/pkg/framework/runner"
+	err_c "hospital/internal/models/errors"
+	"hospital/internal/models/role"
+	"hospital/internal/models/session"
 	"hospital/internal/modules/domain/doctor/dto"
 	"reflect"
 	"testing"
@@ -439,7 +442,6 @@ func TestDoctorService_Update(t *testing.T) {
 				Surname:    "Doe",
 				TokenId:    "1",
 				Speciality: "Doctor",
-				Role:       "Role",
 			},
 		},
 		want: &dto.Doctor{
@@ -472,7 +474,6 @@ func TestDoctorService_Update(t *testing.T) {
 				Surname:    "Doe",
 				TokenId:    "1",
 				Speciality: "Doctor",
-				Role:       "Role",
 			},
 		},
 		want:    nil,
@@ -500,7 +501,6 @@ func TestDoctorService_Update(t *testing.T) {
 				Surname:    "Doe",
 				TokenId:    "1",
 				Speciality: "Doctor",
-				Role:       "Role",
 			},
 		},
 		want:    nil,
@@ -625,3 +625,97 @@ func TestDoctorService_GetByTokenId(t *testing.T) {
 		})
 	}
 }
+
+func TestDoctorService_SetLanguage(t *testing.T) {
+	type args struct {
+		id   int
+		lang string
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockIDoctorRepo(ctrl)
+
+	doctor := &dto.Doctor{Id: 1, TokenId: "1", Surname: "Doe", Speciality: "Doctor", Role: "Role", Language: "en"}
+	mockRepo.EXPECT().SetLanguage(gomock.Any(), 1, "en").Return(doctor, nil)
+	mockRepo.EXPECT().SetLanguage(gomock.Any(), 2, "ru").Return(nil, errors.New("error while updating doctor"))
+
+	for _, tt := range []struct {
+		name    string
+		args    args
+		want    *dto.Doctor
+		wantErr bool
+	}{
+		{name: "Successful update", args: args{id: 1, lang: "en"}, want: doctor},
+		{name: "Error while updating doctor", args: args{id: 2, lang: "ru"}, wantErr: true},
+		{name: "Invalid language code", args: args{id: 3, lang: "english"}, wantErr: true},
+		{name: "Empty language code", args: args{id: 3, lang: ""}, wantErr: true},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			r := &DoctorService{
+				repo: mockRepo,
+			}
+			got, err := r.SetLanguage(context.Background(), tt.args.id, tt.args.lang)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SetLanguage() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SetLanguage() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDoctorService_SetRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockIDoctorRepo(ctrl)
+	adminCtx := session.SetSessionToCtx(context.Background(), session.Session{UserId: 1, Role: role.Admin, OrganizationId: 2})
+	headCtx := session.SetSessionToCtx(context.Background(), session.Session{UserId: 1, Role: role.HeadPhysician, OrganizationId: 2})
+	globalCtx := session.SetSessionToCtx(context.Background(), session.Session{UserId: 1, Role: role.Admin})
+	doctorCtx := session.SetSessionToCtx(context.Background(), session.Session{UserId: 1, Role: role.Doctor, OrganizationId: 2})
+
+	promoted := &dto.Doctor{Id: 5, Role: role.HeadPhysician}
+	mockRepo.EXPECT().GetById(gomock.Any(), 5).Return(&dto.Doctor{Id: 5, Role: role.Doctor}, nil)
+	mockRepo.EXPECT().SetRole(gomock.Any(), 5, role.HeadPhysician).Return(promoted, nil)
+	// Врач другой клиники администратору не виден
+	mockRepo.EXPECT().GetById(gomock.Any(), 6).Return(nil, err_c.ErrDatabaseRecordNotFound)
+	// Старшие и равные по роли
+	mockRepo.EXPECT().GetById(gomock.Any(), 7).Return(&dto.Doctor{Id: 7, Role: role.Admin}, nil).Times(2)
+	mockRepo.EXPECT().GetById(gomock.Any(), 8).Return(&dto.Doctor{Id: 8, Role: role.HeadPhysician}, nil)
+
+	for _, tt := range []struct {
+		name    string
+		ctx     context.Context
+		id      int
+		role    string
+		want    *dto.Doctor
+		wantErr error
+	}{
+		{name: "Admin promotes a doctor of the clinic", ctx: adminCtx, id: 5, role: role.HeadPhysician, want: promoted},
+		{name: "Doctor of another clinic is not found", ctx: adminCtx, id: 6, role: role.Nurse, wantErr: err_c.ErrDatabaseRecordNotFound},
+		{name: "Anonymous user is unauthorized", ctx: context.Background(), id: 5, role: role.Admin, wantErr: err_c.ErrUnauthorized},
+		{name: "Doctor cannot grant roles", ctx: doctorCtx, id: 5, role: role.Admin, wantErr: err_c.ErrAccessDenied},
+		{name: "Admin without clinic cannot grant roles", ctx: globalCtx, id: 5, role: role.Admin, wantErr: err_c.ErrAccessDenied},
+		{name: "Head physician cannot appoint admins", ctx: headCtx, id: 5, role: role.Admin, wantErr: err_c.ErrAccessDenied},
+		{name: "Head physician cannot demote an admin", ctx: headCtx, id: 7, role: role.Doctor, wantErr: err_c.ErrAccessDenied},
+		{name: "Admin cannot demote another admin", ctx: adminCtx, id: 7, role: role.Doctor, wantErr: err_c.ErrAccessDenied},
+		{name: "Head physician cannot change another head physician", ctx: headCtx, id: 8, role: role.Nurse, wantErr: err_c.ErrAccessDenied},
+		{name: "Own role cannot be changed", ctx: adminCtx, id: 1, role: role.Doctor, wantErr: err_c.ErrAccessDenied},
+		{name: "Unknown role is rejected", ctx: adminCtx, id: 5, role: "Завхоз", wantErr: err_c.ErrValidation},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			r := NewDoctorService(mockRepo)
+			got, err := r.SetRole(tt.ctx, tt.id, tt.role)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("SetRole() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SetRole() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
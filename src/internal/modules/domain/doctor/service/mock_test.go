@@ -109,6 +109,51 @@ func (mr *MockIDoctorRepoMockRecorder) List(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIDoctorRepo)(nil).List), arg0)
 }
 
+// SetLanguage mocks base method.
+func (m *MockIDoctorRepo) SetLanguage(arg0 context.Context, arg1 int, arg2 string) (*dto.Doctor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLanguage", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dto.Doctor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetLanguage indicates an expected call of SetLanguage.
+func (mr *MockIDoctorRepoMockRecorder) SetLanguage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLanguage", reflect.TypeOf((*MockIDoctorRepo)(nil).SetLanguage), arg0, arg1, arg2)
+}
+
+// SetOnCall mocks base method.
+func (m *MockIDoctorRepo) SetOnCall(arg0 context.Context, arg1 int, arg2 bool) (*dto.Doctor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOnCall", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dto.Doctor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetOnCall indicates an expected call of SetOnCall.
+func (mr *MockIDoctorRepoMockRecorder) SetOnCall(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOnCall", reflect.TypeOf((*MockIDoctorRepo)(nil).SetOnCall), arg0, arg1, arg2)
+}
+
+// SetRole mocks base method.
+func (m *MockIDoctorRepo) SetRole(arg0 context.Context, arg1 int, arg2 string) (*dto.Doctor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRole", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dto.Doctor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRole indicates an expected call of SetRole.
+func (mr *MockIDoctorRepoMockRecorder) SetRole(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRole", reflect.TypeOf((*MockIDoctorRepo)(nil).SetRole), arg0, arg1, arg2)
+}
+
 // Update mocks base method.
 func (m *MockIDoctorRepo) Update(arg0 context.Context, arg1 int, arg2 *dto.UpdateDoctor) (*dto.Doctor, error) {
 	m.ctrl.T.Helper()
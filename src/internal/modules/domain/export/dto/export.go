@@ -0,0 +1,148 @@
+package dto
+
+import (
+	"hospital/internal/models/validation"
+	patient_dto "hospital/internal/modules/domain/patient/dto"
+)
+
+// Kind - что выгружается
+type Kind string
+
+const (
+	KindPatients Kind = "patients"
+	KindRooms    Kind = "rooms"
+	KindDoctors  Kind = "doctors"
+)
+
+var Kinds = []Kind{KindPatients, KindRooms, KindDoctors}
+
+// Format - формат файла выгрузки
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+)
+
+var Formats = []Format{FormatCSV, FormatXLSX}
+
+// ContentType - MIME-тип файла в формате
+func (f Format) ContentType() string {
+	if f == FormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// Имена столбцов выгрузки
+const (
+	ColumnId         = "id"
+	ColumnSurname    = "surname"
+	ColumnName       = "name"
+	ColumnPatronymic = "patronymic"
+	ColumnHeight     = "height"
+	ColumnWeight     = "weight"
+	ColumnRoom       = "room"
+	ColumnDanger     = "danger"
+	ColumnRoomNum    = "room_num"
+	ColumnFloor      = "floor"
+	ColumnBeds       = "beds"
+	ColumnOccupied   = "occupied"
+	ColumnRoomType   = "room_type"
+	ColumnDepartment = "department"
+	ColumnSpeciality = "speciality"
+	ColumnRole       = "role"
+	ColumnOnCall     = "on_call"
+	ColumnLanguage   = "language"
+)
+
+// Labels - заголовки столбцов и названия листов по умолчанию
+var Labels = map[string]string{
+	ColumnId:         "ID",
+	ColumnSurname:    "Фамилия",
+	ColumnName:       "Имя",
+	ColumnPatronymic: "Отчество",
+	ColumnHeight:     "Рост, см",
+	ColumnWeight:     "Вес, кг",
+	ColumnRoom:       "Палата",
+	ColumnDanger:     "Степень опасности",
+	ColumnRoomNum:    "Номер палаты",
+	ColumnFloor:      "Этаж",
+	ColumnBeds:       "Количество кроватей",
+	ColumnOccupied:   "Занято кроватей",
+	ColumnRoomType:   "Тип палаты",
+	ColumnDepartment: "Отделение",
+	ColumnSpeciality: "Специальность",
+	ColumnRole:       "Роль",
+	ColumnOnCall:     "На дежурстве",
+	ColumnLanguage:   "Язык",
+
+	string(KindPatients): "Пациенты",
+	string(KindRooms):    "Палаты",
+	string(KindDoctors):  "Врачи",
+}
+
+// Filter - отбор строк выгрузки; нулевые поля не ограничивают выборку.
+// Каждая выгрузка учитывает только поля, которые к ней относятся.
+type Filter struct {
+	// Room - номер палаты (пациенты)
+	Room int
+	// Floor - этаж (пациенты и палаты)
+	Floor *int
+	// DepartmentId - отделение (пациенты и палаты)
+	DepartmentId int
+	// MinDanger - наименьшая степень опасности (пациенты)
+	MinDanger int
+	// FreeOnly - только палаты со свободными кроватями
+	FreeOnly bool
+	// Role - роль (врачи)
+	Role string
+	// OnCall - только дежурные врачи
+	OnCall bool
+}
+
+type Request struct {
+	Kind   Kind
+	Format Format
+	Filter Filter
+	// Label возвращает заголовок столбца или название листа по имени столбца или выгрузки;
+	// если не задан, используются Labels
+	Label func(name string) string
+}
+
+func (r *Request) Validate() error {
+	var v validation.Validator
+	v.CheckCode(validKind(r.Kind), "Kind", "Выгрузка", validation.CodeInvalid)
+	v.CheckCode(validFormat(r.Format), "Format", "Формат", validation.CodeInvalid)
+	if r.Filter.MinDanger != 0 {
+		v.IntRange("MinDanger", "Степень опасности", r.Filter.MinDanger, patient_dto.MinDanger, patient_dto.MaxDanger)
+	}
+	return v.Err()
+}
+
+func validKind(kind Kind) bool {
+	for _, k := range Kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func validFormat(format Format) bool {
+	for _, f := range Formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// File - готовый файл выгрузки
+type File struct {
+	Name        string
+	ContentType string
+	Data        []byte
+	// Rows - количество строк без заголовка
+	Rows int
+}
@@ -0,0 +1,39 @@
+package export
+
+import (
+	"go.uber.org/fx"
+	department_serv "hospital/internal/modules/domain/department/service"
+	doctor_serv "hospital/internal/modules/domain/doctor/service"
+	"hospital/internal/modules/domain/export/service"
+	patient_serv "hospital/internal/modules/domain/patient/service"
+	room_serv "hospital/internal/modules/domain/room/service"
+)
+
+var (
+	Module = fx.Options(
+		service.Module,
+
+		fx.Provide(
+			fx.Annotate(
+				func(s *patient_serv.PatientService) *patient_serv.PatientService { return s },
+				fx.As(new(service.IPatientLister)),
+			),
+			fx.Annotate(
+				func(s *room_serv.RoomService) *room_serv.RoomService { return s },
+				fx.As(new(service.IRoomLister)),
+			),
+			fx.Annotate(
+				func(s *doctor_serv.DoctorService) *doctor_serv.DoctorService { return s },
+				fx.As(new(service.IDoctorLister)),
+			),
+			fx.Annotate(
+				func(s *department_serv.DepartmentService) *department_serv.DepartmentService { return s },
+				fx.As(new(service.IDepartmentLister)),
+			),
+		),
+	)
+
+	Invokables = fx.Options(
+		service.Invokables,
+	)
+)
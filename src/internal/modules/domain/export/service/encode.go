@@ -0,0 +1,210 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// encodeCSV - таблица в CSV с разделителем-запятой. Файл начинается с метки порядка байтов:
+// без нее Excel открывает UTF-8 в однобайтовой кодировке, и кириллица превращается в мусор.
+// Текст, который табличный редактор принял бы за формулу, экранируется, см. escapeFormula.
+func encodeCSV(header []string, rows [][]interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("\ufeff")
+
+	w := csv.NewWriter(&buf)
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+	record := make([]string, len(header))
+	for _, row := range rows {
+		for i, v := range row {
+			record[i] = formatValue(v)
+			if _, ok := v.(string); ok {
+				record[i] = escapeFormula(record[i])
+			}
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// escapeFormula ставит апостроф перед текстом, который начинается с =, +, -, @, табуляции
+// или перевода строки: иначе Excel выполнит фамилию вида "=HYPERLINK(...)" как формулу.
+// Числа не экранируются, поэтому отрицательные значения остаются числами.
+func escapeFormula(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+func formatValue(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return fmt.Sprint(v)
+}
+
+// Части книги XLSX (Office Open XML), которые не зависят от данных
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+		`</Types>`
+	xlsxRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`
+	xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+		`</Relationships>`
+	// Стиль 1 - полужирный шрифт для заголовка
+	xlsxStyles = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+		`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+		`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+		`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+		`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+		`<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+		`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/></cellXfs>` +
+		`</styleSheet>`
+)
+
+// encodeXLSX - таблица в книге XLSX из одного листа. Строки записываются прямо в ячейки,
+// числа и логические значения - как значения соответствующего типа, заголовок закреплен.
+func encodeXLSX(sheet string, header []string, rows [][]interface{}) ([]byte, error) {
+	var data bytes.Buffer
+	data.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n")
+	data.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">`)
+	data.WriteString(`<sheetViews><sheetView workbookViewId="0"><pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/></sheetView></sheetViews>`)
+	data.WriteString(`<sheetData>`)
+	headerRow := make([]interface{}, len(header))
+	for i, h := range header {
+		headerRow[i] = h
+	}
+	writeRow(&data, 1, headerRow, 1)
+	for i, row := range rows {
+		writeRow(&data, i+2, row, 0)
+	}
+	data.WriteString(`</sheetData></worksheet>`)
+
+	var workbook bytes.Buffer
+	workbook.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n")
+	workbook.WriteString(`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="`)
+	escape(&workbook, sheetName(sheet))
+	workbook.WriteString(`" sheetId="1" r:id="rId1"/></sheets></workbook>`)
+
+	var buf bytes.Buffer
+	z := zip.NewWriter(&buf)
+	parts := []struct {
+		name string
+		data []byte
+	}{
+		{"[Content_Types].xml", []byte(xlsxContentTypes)},
+		{"_rels/.rels", []byte(xlsxRels)},
+		{"xl/workbook.xml", workbook.Bytes()},
+		{"xl/_rels/workbook.xml.rels", []byte(xlsxWorkbookRels)},
+		{"xl/styles.xml", []byte(xlsxStyles)},
+		{"xl/worksheets/sheet1.xml", data.Bytes()},
+	}
+	for _, p := range parts {
+		w, err := z.Create(p.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = w.Write(p.data); err != nil {
+			return nil, err
+		}
+	}
+	if err := z.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writeRow записывает строку листа с номером n; style - номер стиля ячеек
+func writeRow(buf *bytes.Buffer, n int, row []interface{}, style int) {
+	fmt.Fprintf(buf, `<row r="%d">`, n)
+	for i, v := range row {
+		ref := cellRef(i, n)
+		s := ""
+		if style != 0 {
+			s = fmt.Sprintf(` s="%d"`, style)
+		}
+		switch v := v.(type) {
+		case int, float64:
+			fmt.Fprintf(buf, `<c r="%s"%s><v>%s</v></c>`, ref, s, formatValue(v))
+		case bool:
+			b := 0
+			if v {
+				b = 1
+			}
+			fmt.Fprintf(buf, `<c r="%s"%s t="b"><v>%d</v></c>`, ref, s, b)
+		default:
+			text := formatValue(v)
+			if text == "" {
+				continue
+			}
+			fmt.Fprintf(buf, `<c r="%s"%s t="inlineStr"><is><t xml:space="preserve">`, ref, s)
+			escape(buf, text)
+			buf.WriteString(`</t></is></c>`)
+		}
+	}
+	buf.WriteString(`</row>`)
+}
+
+// cellRef - адрес ячейки вида A1 по номеру столбца с нуля и номеру строки с единицы
+func cellRef(col int, row int) string {
+	var name []byte
+	for col++; col > 0; col = (col - 1) / 26 {
+		name = append([]byte{byte('A' + (col-1)%26)}, name...)
+	}
+	return string(name) + strconv.Itoa(row)
+}
+
+// sheetName приводит название к требованиям Excel: не длиннее 31 символа и без []:*?/\
+func sheetName(name string) string {
+	var out []rune
+	for _, r := range name {
+		switch r {
+		case '[', ']', ':', '*', '?', '/', '\\':
+			r = ' '
+		}
+		out = append(out, r)
+		if len(out) == 31 {
+			break
+		}
+	}
+	if len(out) == 0 {
+		return "Sheet1"
+	}
+	return string(out)
+}
+
+func escape(buf *bytes.Buffer, s string) {
+	// xml.EscapeText пишет в bytes.Buffer без ошибок
+	_ = xml.EscapeText(buf, []byte(s))
+}
@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"hospital/internal/models/errors"
+	"hospital/internal/models/role"
+	"hospital/internal/models/session"
+	department_dto "hospital/internal/modules/domain/department/dto"
+	doctor_dto "hospital/internal/modules/domain/doctor/dto"
+	"hospital/internal/modules/domain/export/dto"
+	patient_dto "hospital/internal/modules/domain/patient/dto"
+	room_dto "hospital/internal/modules/domain/room/dto"
+	"sort"
+	"strings"
+	"time"
+)
+
+//go:generate mockgen -destination mock_test.go -package service . IPatientLister,IRoomLister,IDoctorLister,IDepartmentLister
+
+// Выгрузка читает данные через сервисы, а не репозитории: так просмотр пациентов
+// попадает в журнал доступа, а список ограничивается отделением пользователя
+
+type IPatientLister interface {
+	List(ctx context.Context) (patient_dto.Patients, error)
+}
+
+type IRoomLister interface {
+	List(ctx context.Context) (room_dto.Rooms, error)
+}
+
+type IDoctorLister interface {
+	List(ctx context.Context) (doctor_dto.Doctors, error)
+}
+
+type IDepartmentLister interface {
+	List(ctx context.Context) (department_dto.Departments, error)
+}
+
+type ExportService struct {
+	patients    IPatientLister
+	rooms       IRoomLister
+	doctors     IDoctorLister
+	departments IDepartmentLister
+	now         func() time.Time
+}
+
+func NewExportService(patients IPatientLister, rooms IRoomLister, doctors IDoctorLister, departments IDepartmentLister) *ExportService {
+	return &ExportService{
+		patients:    patients,
+		rooms:       rooms,
+		doctors:     doctors,
+		departments: departments,
+		now:         time.Now,
+	}
+}
+
+// table - выгрузка до кодирования: имена столбцов и строки значений string, int, float64 или bool
+type table struct {
+	columns []string
+	rows    [][]interface{}
+}
+
+// Export выгружает пациентов, палаты или врачей в файл CSV или XLSX, доступно только администраторам
+func (r *ExportService) Export(ctx context.Context, req *dto.Request) (*dto.File, error) {
+	s, ok := session.GetSessionFromCtx(ctx)
+	if !ok {
+		return nil, errors.ErrUnauthorized
+	}
+	if !role.IsAdmin(s.Role) {
+		return nil, errors.ErrAccessDenied
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var t *table
+	var err error
+	switch req.Kind {
+	case dto.KindPatients:
+		t, err = r.patientTable(ctx, req.Filter)
+	case dto.KindRooms:
+		t, err = r.roomTable(ctx, req.Filter)
+	case dto.KindDoctors:
+		t, err = r.doctorTable(ctx, req.Filter)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	label := req.Label
+	if label == nil {
+		label = func(name string) string { return dto.Labels[name] }
+	}
+	header := make([]string, len(t.columns))
+	for i, c := range t.columns {
+		header[i] = label(c)
+	}
+
+	var data []byte
+	if req.Format == dto.FormatXLSX {
+		data, err = encodeXLSX(label(string(req.Kind)), header, t.rows)
+	} else {
+		data, err = encodeCSV(header, t.rows)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.File{
+		Name:        fmt.Sprintf("%s-%s.%s", req.Kind, r.now().Format("2006-01-02"), req.Format),
+		ContentType: req.Format.ContentType(),
+		Data:        data,
+		Rows:        len(t.rows),
+	}, nil
+}
+
+func (r *ExportService) patientTable(ctx context.Context, f dto.Filter) (*table, error) {
+	patients, err := r.patients.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// У пациента хранится ID палаты; номер, этаж и отделение берутся из самой палаты
+	list, err := r.rooms.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rooms := make(map[int]*room_dto.Room, len(list))
+	for _, room := range list {
+		rooms[room.Id] = room
+	}
+
+	sort.SliceStable(patients, func(i, j int) bool {
+		if patients[i].Surname != patients[j].Surname {
+			return patients[i].Surname < patients[j].Surname
+		}
+		return patients[i].Name < patients[j].Name
+	})
+
+	t := &table{columns: []string{
+		dto.ColumnId, dto.ColumnSurname, dto.ColumnName, dto.ColumnPatronymic,
+		dto.ColumnHeight, dto.ColumnWeight, dto.ColumnRoom, dto.ColumnDanger,
+	}}
+	for _, p := range patients {
+		if p.DegreeOfDanger < f.MinDanger {
+			continue
+		}
+		room := rooms[p.RoomNumber]
+		if f.Room != 0 && (room == nil || room.Num != f.Room) {
+			continue
+		}
+		if (f.Floor != nil || f.DepartmentId != 0) && !roomMatches(room, f) {
+			continue
+		}
+		// Палата, скрытая правилами доступа, выгружается пустой ячейкой
+		var num interface{} = ""
+		if room != nil {
+			num = room.Num
+		}
+		t.rows = append(t.rows, []interface{}{
+			p.Id, p.Surname, p.Name, p.Patronymic, p.Height, p.Weight, num, p.DegreeOfDanger,
+		})
+	}
+	return t, nil
+}
+
+func (r *ExportService) roomTable(ctx context.Context, f dto.Filter) (*table, error) {
+	rooms, err := r.rooms.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	departments, err := r.departments.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[int]string, len(departments))
+	for _, d := range departments {
+		names[d.Id] = d.Name
+	}
+
+	sort.SliceStable(rooms, func(i, j int) bool { return rooms[i].Num < rooms[j].Num })
+
+	t := &table{columns: []string{
+		dto.ColumnId, dto.ColumnRoomNum, dto.ColumnFloor, dto.ColumnBeds,
+		dto.ColumnOccupied, dto.ColumnRoomType, dto.ColumnDepartment,
+	}}
+	for _, room := range rooms {
+		if !roomMatches(room, f) || f.FreeOnly && room.NumberPatients >= room.NumberBeds {
+			continue
+		}
+		var department string
+		if room.DepartmentId != nil {
+			department = names[*room.DepartmentId]
+		}
+		t.rows = append(t.rows, []interface{}{
+			room.Id, room.Num, room.Floor, room.NumberBeds, room.NumberPatients, room.TypeRoom, department,
+		})
+	}
+	return t, nil
+}
+
+func (r *ExportService) doctorTable(ctx context.Context, f dto.Filter) (*table, error) {
+	doctors, err := r.doctors.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(doctors, func(i, j int) bool { return doctors[i].Surname < doctors[j].Surname })
+
+	// TokenId не выгружается: по нему врач входит в систему
+	t := &table{columns: []string{
+		dto.ColumnId, dto.ColumnSurname, dto.ColumnSpeciality, dto.ColumnRole, dto.ColumnOnCall, dto.ColumnLanguage,
+	}}
+	for _, d := range doctors {
+		if f.Role != "" && !strings.EqualFold(d.Role, f.Role) || f.OnCall && !d.OnCall {
+			continue
+		}
+		t.rows = append(t.rows, []interface{}{d.Id, d.Surname, d.Speciality, d.Role, d.OnCall, d.Language})
+	}
+	return t, nil
+}
+
+// roomMatches - подходит ли палата под отбор по этажу и отделению; неизвестная палата не подходит
+func roomMatches(room *room_dto.Room, f dto.Filter) bool {
+	if room == nil {
+		return false
+	}
+	if f.Floor != nil && room.Floor != *f.Floor {
+		return false
+	}
+	return f.DepartmentId == 0 || room.DepartmentId != nil && *room.DepartmentId == f.DepartmentId
+}
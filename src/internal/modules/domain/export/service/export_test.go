@@ -0,0 +1,289 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	err_c "hospital/internal/models/errors"
+	"hospital/internal/models/role"
+	"hospital/internal/models/session"
+	department_dto "hospital/internal/modules/domain/department/dto"
+	doctor_dto "hospital/internal/modules/domain/doctor/dto"
+	"hospital/internal/modules/domain/export/dto"
+	patient_dto "hospital/internal/modules/domain/patient/dto"
+	room_dto "hospital/internal/modules/domain/room/dto"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestExportService(ctrl *gomock.Controller) (*ExportService, *MockIPatientLister, *MockIRoomLister, *MockIDoctorLister, *MockIDepartmentLister) {
+	patients := NewMockIPatientLister(ctrl)
+	rooms := NewMockIRoomLister(ctrl)
+	doctors := NewMockIDoctorLister(ctrl)
+	departments := NewMockIDepartmentLister(ctrl)
+	r := NewExportService(patients, rooms, doctors, departments)
+	r.now = func() time.Time { return time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC) }
+	return r, patients, rooms, doctors, departments
+}
+
+func TestExportService_Export_Access(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r, _, _, _, _ := newTestExportService(ctrl)
+	headCtx := session.SetSessionToCtx(context.Background(), session.Session{UserId: 1, Role: role.HeadPhysician})
+	doctorCtx := session.SetSessionToCtx(context.Background(), session.Session{UserId: 2, Role: role.Doctor})
+
+	for _, tt := range []struct {
+		name    string
+		ctx     context.Context
+		req     *dto.Request
+		wantErr error
+	}{
+		{
+			name:    "Anonymous user is unauthorized",
+			ctx:     context.Background(),
+			req:     &dto.Request{Kind: dto.KindPatients, Format: dto.FormatCSV},
+			wantErr: err_c.ErrUnauthorized,
+		},
+		{
+			name:    "Doctor is denied",
+			ctx:     doctorCtx,
+			req:     &dto.Request{Kind: dto.KindPatients, Format: dto.FormatCSV},
+			wantErr: err_c.ErrAccessDenied,
+		},
+		{
+			name:    "Unknown format is rejected",
+			ctx:     headCtx,
+			req:     &dto.Request{Kind: dto.KindPatients, Format: "pdf"},
+			wantErr: err_c.ErrValidation,
+		},
+		{
+			name:    "Unknown kind is rejected",
+			ctx:     headCtx,
+			req:     &dto.Request{Kind: "diseases", Format: dto.FormatCSV},
+			wantErr: err_c.ErrValidation,
+		},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			if _, err := r.Export(tt.ctx, tt.req); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Export() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExportService_Export_Patients(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r, patients, rooms, _, _ := newTestExportService(ctrl)
+	ctx := session.SetSessionToCtx(context.Background(), session.Session{UserId: 1, Role: role.HeadPhysician})
+	surgery, floor := 7, 2
+	// RoomNumber пациента - ID палаты, в выгрузку попадает ее номер
+	list := patient_dto.Patients{
+		{Id: 1, Surname: "Петров", Name: "Иван", Height: 180, Weight: 80.5, RoomNumber: 10, DegreeOfDanger: 4},
+		{Id: 2, Surname: "Иванова", Name: "Анна", Patronymic: "Сергеевна, мл.", Height: 165, Weight: 55, RoomNumber: 10, DegreeOfDanger: 5},
+		{Id: 3, Surname: "Сидоров", Name: "Петр", Height: 175, Weight: 70, RoomNumber: 20, DegreeOfDanger: 5},
+	}
+	roomList := room_dto.Rooms{
+		{Id: 10, Num: 101, Floor: 1, DepartmentId: &surgery},
+		{Id: 20, Num: 202, Floor: 2},
+	}
+
+	for _, tt := range []struct {
+		name   string
+		filter dto.Filter
+		want   string
+	}{
+		{
+			name: "All patients are sorted by surname",
+			want: "\ufeffID,Фамилия,Имя,Отчество,\"Рост, см\",\"Вес, кг\",Палата,Степень опасности\n" +
+				"2,Иванова,Анна,\"Сергеевна, мл.\",165,55,101,5\n" +
+				"1,Петров,Иван,,180,80.5,101,4\n" +
+				"3,Сидоров,Петр,,175,70,202,5\n",
+		},
+		{
+			name:   "Patients are filtered by room and danger",
+			filter: dto.Filter{Room: 101, MinDanger: 5},
+			want: "\ufeffID,Фамилия,Имя,Отчество,\"Рост, см\",\"Вес, кг\",Палата,Степень опасности\n" +
+				"2,Иванова,Анна,\"Сергеевна, мл.\",165,55,101,5\n",
+		},
+		{
+			name:   "Patients are filtered by department of their room",
+			filter: dto.Filter{DepartmentId: surgery},
+			want: "\ufeffID,Фамилия,Имя,Отчество,\"Рост, см\",\"Вес, кг\",Палата,Степень опасности\n" +
+				"2,Иванова,Анна,\"Сергеевна, мл.\",165,55,101,5\n" +
+				"1,Петров,Иван,,180,80.5,101,4\n",
+		},
+		{
+			name:   "Patients are filtered by floor of their room",
+			filter: dto.Filter{Floor: &floor},
+			want: "\ufeffID,Фамилия,Имя,Отчество,\"Рост, см\",\"Вес, кг\",Палата,Степень опасности\n" +
+				"3,Сидоров,Петр,,175,70,202,5\n",
+		},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			patients.EXPECT().List(ctx).Return(append(patient_dto.Patients{}, list...), nil)
+			rooms.EXPECT().List(ctx).Return(roomList, nil)
+
+			got, err := r.Export(ctx, &dto.Request{Kind: dto.KindPatients, Format: dto.FormatCSV, Filter: tt.filter})
+			if err != nil {
+				t.Fatalf("Export() error = %v", err)
+			}
+			if got.Name != "patients-2024-03-05.csv" {
+				t.Errorf("Export() name = %q", got.Name)
+			}
+			if string(got.Data) != tt.want {
+				t.Errorf("Export() data = %q, want %q", got.Data, tt.want)
+			}
+		})
+	}
+}
+
+func TestExportService_Export_RoomsXLSX(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r, _, rooms, _, departments := newTestExportService(ctrl)
+	ctx := session.SetSessionToCtx(context.Background(), session.Session{UserId: 1, Role: role.Admin})
+	surgery := 7
+
+	rooms.EXPECT().List(ctx).Return(room_dto.Rooms{
+		{Id: 20, Num: 202, Floor: 2, NumberBeds: 2, NumberPatients: 2, TypeRoom: "Обычная"},
+		{Id: 10, Num: 101, Floor: 1, NumberBeds: 4, NumberPatients: 1, TypeRoom: "Реанимация", DepartmentId: &surgery},
+	}, nil)
+	departments.EXPECT().List(ctx).Return(department_dto.Departments{{Id: surgery, Name: "Хирургия & травматология"}}, nil)
+
+	runner.Run(t, "Free rooms are written to a workbook", func(t provider.T) {
+		got, err := r.Export(ctx, &dto.Request{
+			Kind:   dto.KindRooms,
+			Format: dto.FormatXLSX,
+			Filter: dto.Filter{FreeOnly: true},
+			Label:  strings.ToUpper,
+		})
+		if err != nil {
+			t.Fatalf("Export() error = %v", err)
+		}
+		if got.Rows != 1 || got.Name != "rooms-2024-03-05.xlsx" || got.ContentType != dto.FormatXLSX.ContentType() {
+			t.Fatalf("Export() got = %+v", got)
+		}
+
+		workbook := readZipPart(t, got.Data, "xl/workbook.xml")
+		if !strings.Contains(workbook, `<sheet name="ROOMS"`) {
+			t.Errorf("workbook.xml = %s", workbook)
+		}
+		sheet := readZipPart(t, got.Data, "xl/worksheets/sheet1.xml")
+		for _, want := range []string{
+			`<c r="A1" s="1" t="inlineStr"><is><t xml:space="preserve">ID</t></is></c>`,
+			`<c r="B2"><v>101</v></c>`,
+			`<t xml:space="preserve">Хирургия &amp; травматология</t>`,
+		} {
+			if !strings.Contains(sheet, want) {
+				t.Errorf("sheet1.xml does not contain %s", want)
+			}
+		}
+		if strings.Contains(sheet, "202") {
+			t.Errorf("occupied room must be filtered out")
+		}
+	})
+}
+
+func TestExportService_Export_Doctors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r, _, _, doctors, _ := newTestExportService(ctrl)
+	ctx := session.SetSessionToCtx(context.Background(), session.Session{UserId: 1, Role: role.Admin})
+
+	doctors.EXPECT().List(ctx).Return(doctor_dto.Doctors{
+		{Id: 1, Surname: "Смирнов", TokenId: "100", Speciality: "Хирург", Role: role.Doctor, OnCall: true},
+		{Id: 2, Surname: "Кузнецов", TokenId: "200", Speciality: "Терапевт", Role: role.Doctor},
+		{Id: 3, Surname: "Волков", TokenId: "300", Speciality: "Кардиолог", Role: role.HeadPhysician, OnCall: true},
+	}, nil)
+
+	runner.Run(t, "Doctors on call are filtered by role without login tokens", func(t provider.T) {
+		got, err := r.Export(ctx, &dto.Request{
+			Kind:   dto.KindDoctors,
+			Format: dto.FormatCSV,
+			Filter: dto.Filter{Role: role.Doctor, OnCall: true},
+		})
+		if err != nil {
+			t.Fatalf("Export() error = %v", err)
+		}
+		want := "\ufeffID,Фамилия,Специальность,Роль,На дежурстве,Язык\n1,Смирнов,Хирург,Врач,true,\n"
+		if string(got.Data) != want {
+			t.Errorf("Export() data = %q, want %q", got.Data, want)
+		}
+	})
+}
+
+func TestCellRef(t *testing.T) {
+	for _, tt := range []struct {
+		col  int
+		row  int
+		want string
+	}{
+		{0, 1, "A1"},
+		{25, 2, "Z2"},
+		{26, 3, "AA3"},
+		{701, 4, "ZZ4"},
+		{702, 5, "AAA5"},
+	} {
+		runner.Run(t, tt.want, func(t provider.T) {
+			if got := cellRef(tt.col, tt.row); got != tt.want {
+				t.Errorf("cellRef() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func readZipPart(t provider.T, data []byte, name string) string {
+	z, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("zip.NewReader() error = %v", err)
+	}
+	f, err := z.Open(name)
+	if err != nil {
+		t.Fatalf("Open(%s) error = %v", name, err)
+	}
+	defer f.Close()
+	part, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatalf("ReadAll(%s) error = %v", name, err)
+	}
+	return string(part)
+}
+
+func TestEncodeCSV_Formulas(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{name: "Formula", value: "=HYPERLINK(\"http://evil\",\"Иванов\")", want: "\"'=HYPERLINK(\"\"http://evil\"\",\"\"Иванов\"\")\""},
+		{name: "Plus", value: "+79990000000", want: "'+79990000000"},
+		{name: "Minus", value: "-2+3", want: "'-2+3"},
+		{name: "At sign", value: "@SUM(A1)", want: "'@SUM(A1)"},
+		{name: "Tab", value: "\tИванов", want: "'\tИванов"},
+		{name: "Plain text", value: "Иванов", want: "Иванов"},
+		{name: "Negative number", value: -5, want: "-5"},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			got, err := encodeCSV([]string{"Фамилия"}, [][]interface{}{{tt.value}})
+			if err != nil {
+				t.Fatalf("encodeCSV() error = %v", err)
+			}
+			want := "\ufeffФамилия\n" + tt.want + "\n"
+			if string(got) != want {
+				t.Errorf("encodeCSV() = %q, want %q", got, want)
+			}
+		})
+	}
+}
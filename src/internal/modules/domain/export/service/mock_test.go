@@ -0,0 +1,168 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: hospital/internal/modules/domain/export/service (interfaces: IPatientLister,IRoomLister,IDoctorLister,IDepartmentLister)
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	dto "hospital/internal/modules/domain/department/dto"
+	dto0 "hospital/internal/modules/domain/doctor/dto"
+	dto1 "hospital/internal/modules/domain/patient/dto"
+	dto2 "hospital/internal/modules/domain/room/dto"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIPatientLister is a mock of IPatientLister interface.
+type MockIPatientLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPatientListerMockRecorder
+}
+
+// MockIPatientListerMockRecorder is the mock recorder for MockIPatientLister.
+type MockIPatientListerMockRecorder struct {
+	mock *MockIPatientLister
+}
+
+// NewMockIPatientLister creates a new mock instance.
+func NewMockIPatientLister(ctrl *gomock.Controller) *MockIPatientLister {
+	mock := &MockIPatientLister{ctrl: ctrl}
+	mock.recorder = &MockIPatientListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPatientLister) EXPECT() *MockIPatientListerMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockIPatientLister) List(arg0 context.Context) (dto1.Patients, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0)
+	ret0, _ := ret[0].(dto1.Patients)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIPatientListerMockRecorder) List(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIPatientLister)(nil).List), arg0)
+}
+
+// MockIRoomLister is a mock of IRoomLister interface.
+type MockIRoomLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRoomListerMockRecorder
+}
+
+// MockIRoomListerMockRecorder is the mock recorder for MockIRoomLister.
+type MockIRoomListerMockRecorder struct {
+	mock *MockIRoomLister
+}
+
+// NewMockIRoomLister creates a new mock instance.
+func NewMockIRoomLister(ctrl *gomock.Controller) *MockIRoomLister {
+	mock := &MockIRoomLister{ctrl: ctrl}
+	mock.recorder = &MockIRoomListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRoomLister) EXPECT() *MockIRoomListerMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockIRoomLister) List(arg0 context.Context) (dto2.Rooms, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0)
+	ret0, _ := ret[0].(dto2.Rooms)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIRoomListerMockRecorder) List(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIRoomLister)(nil).List), arg0)
+}
+
+// MockIDoctorLister is a mock of IDoctorLister interface.
+type MockIDoctorLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockIDoctorListerMockRecorder
+}
+
+// MockIDoctorListerMockRecorder is the mock recorder for MockIDoctorLister.
+type MockIDoctorListerMockRecorder struct {
+	mock *MockIDoctorLister
+}
+
+// NewMockIDoctorLister creates a new mock instance.
+func NewMockIDoctorLister(ctrl *gomock.Controller) *MockIDoctorLister {
+	mock := &MockIDoctorLister{ctrl: ctrl}
+	mock.recorder = &MockIDoctorListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIDoctorLister) EXPECT() *MockIDoctorListerMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockIDoctorLister) List(arg0 context.Context) (dto0.Doctors, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0)
+	ret0, _ := ret[0].(dto0.Doctors)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIDoctorListerMockRecorder) List(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIDoctorLister)(nil).List), arg0)
+}
+
+// MockIDepartmentLister is a mock of IDepartmentLister interface.
+type MockIDepartmentLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockIDepartmentListerMockRecorder
+}
+
+// MockIDepartmentListerMockRecorder is the mock recorder for MockIDepartmentLister.
+type MockIDepartmentListerMockRecorder struct {
+	mock *MockIDepartmentLister
+}
+
+// NewMockIDepartmentLister creates a new mock instance.
+func NewMockIDepartmentLister(ctrl *gomock.Controller) *MockIDepartmentLister {
+	mock := &MockIDepartmentLister{ctrl: ctrl}
+	mock.recorder = &MockIDepartmentListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIDepartmentLister) EXPECT() *MockIDepartmentListerMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockIDepartmentLister) List(arg0 context.Context) (dto.Departments, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0)
+	ret0, _ := ret[0].(dto.Departments)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIDepartmentListerMockRecorder) List(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIDepartmentLister)(nil).List), arg0)
+}
@@ -2,11 +2,19 @@ package domain
 
 import (
 	"go.uber.org/fx"
+	"hospital/internal/modules/domain/access"
+	"hospital/internal/modules/domain/alert"
+	"hospital/internal/modules/domain/audit"
 	"hospital/internal/modules/domain/auth"
+	"hospital/internal/modules/domain/conversation"
+	"hospital/internal/modules/domain/department"
 	"hospital/internal/modules/domain/disease"
 	"hospital/internal/modules/domain/doctor"
+	"hospital/internal/modules/domain/export"
 	"hospital/internal/modules/domain/patient"
 	"hospital/internal/modules/domain/room"
+	"hospital/internal/modules/domain/session"
+	"hospital/internal/modules/domain/ward"
 )
 
 var (
@@ -17,6 +25,14 @@ var (
 		room.Module,
 		auth.Module,
 		disease.Module,
+		session.Module,
+		audit.Module,
+		access.Module,
+		department.Module,
+		conversation.Module,
+		alert.Module,
+		export.Module,
+		ward.Module,
 	)
 	Invokables = fx.Options(
 
@@ -25,5 +41,13 @@ var (
 		room.Invokables,
 		auth.Invokables,
 		disease.Invokables,
+		session.Invokables,
+		audit.Invokables,
+		access.Invokables,
+		department.Invokables,
+		conversation.Invokables,
+		alert.Invokables,
+		export.Invokables,
+		ward.Invokables,
 	)
 )
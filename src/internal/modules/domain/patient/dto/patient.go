@@ -1,5 +1,10 @@
 package dto
 
+import (
+	"hospital/internal/models/validation"
+	"time"
+)
+
 type Patient struct {
 	Id             int
 	Surname        string
@@ -32,3 +37,123 @@ type UpdatePatient struct {
 	RoomNumber     int
 	DegreeOfDanger int
 }
+
+// Допустимые значения полей пациента
+const (
+	MinHeight = 30
+	MaxHeight = 250
+	MinWeight = 0.3
+	MaxWeight = 400.0
+	MinDanger = 1
+	MaxDanger = 5
+)
+
+func (r *CreatePatient) Validate() error {
+	return validatePatient(r.Surname, r.Name, r.Height, r.Weight, r.RoomNumber, r.DegreeOfDanger)
+}
+
+func (r *UpdatePatient) Validate() error {
+	return validatePatient(r.Surname, r.Name, r.Height, r.Weight, r.RoomNumber, r.DegreeOfDanger)
+}
+
+func validatePatient(surname string, name string, height int, weight float64, roomNumber int, danger int) error {
+	var v validation.Validator
+	v.Required("Surname", "Фамилия", surname)
+	v.Required("Name", "Имя", name)
+	v.IntRange("Height", "Рост, см", height, MinHeight, MaxHeight)
+	v.FloatRange("Weight", "Вес, кг", weight, MinWeight, MaxWeight)
+	v.Positive("RoomNumber", "Палата", roomNumber)
+	v.IntRange("DegreeOfDanger", "Степень опасности", danger, MinDanger, MaxDanger)
+	return v.Err()
+}
+
+// Vitals - замер показателей жизнедеятельности пациента
+type Vitals struct {
+	Id          int
+	PatientId   int
+	AuthorId    *int
+	Pulse       int
+	Systolic    int
+	Diastolic   int
+	Temperature float64
+	Saturation  int
+	CreatedAt   time.Time
+}
+
+type CreateVitals struct {
+	Pulse       int
+	Systolic    int
+	Diastolic   int
+	Temperature float64
+	Saturation  int
+}
+
+// Допустимые значения замера: за пределами - ошибка ввода, а не состояние пациента
+const (
+	MinPulse       = 20
+	MaxPulse       = 250
+	MinPressure    = 40
+	MaxPressure    = 300
+	MinTemperature = 30.0
+	MaxTemperature = 45.0
+	MinSaturation  = 50
+	MaxSaturation  = 100
+)
+
+// Границы нормы показателей
+const (
+	NormPulseLow        = 50
+	NormPulseHigh       = 120
+	NormSystolicLow     = 90
+	NormSystolicHigh    = 160
+	NormDiastolicHigh   = 100
+	NormTemperatureHigh = 38.5
+	NormSaturationLow   = 92
+	// CriticalSaturation - насыщение кислородом, при котором тревога критическая
+	CriticalSaturation = 88
+)
+
+// Названия показателей в Abnormal
+const (
+	VitalPulse       = "pulse"
+	VitalSystolic    = "systolic"
+	VitalDiastolic   = "diastolic"
+	VitalTemperature = "temperature"
+	VitalSaturation  = "saturation"
+)
+
+func (r *CreateVitals) Validate() error {
+	var v validation.Validator
+	v.IntRange("Pulse", "Пульс", r.Pulse, MinPulse, MaxPulse)
+	v.IntRange("Systolic", "Верхнее давление", r.Systolic, MinPressure, MaxPressure)
+	v.IntRange("Diastolic", "Нижнее давление", r.Diastolic, MinPressure, MaxPressure)
+	v.FloatRange("Temperature", "Температура", r.Temperature, MinTemperature, MaxTemperature)
+	v.IntRange("Saturation", "Сатурация", r.Saturation, MinSaturation, MaxSaturation)
+	return v.Err()
+}
+
+// Abnormal - показатели за границами нормы
+func (r *Vitals) Abnormal() []string {
+	var abnormal []string
+	if r.Pulse < NormPulseLow || r.Pulse > NormPulseHigh {
+		abnormal = append(abnormal, VitalPulse)
+	}
+	if r.Systolic < NormSystolicLow || r.Systolic > NormSystolicHigh {
+		abnormal = append(abnormal, VitalSystolic)
+	}
+	if r.Diastolic > NormDiastolicHigh {
+		abnormal = append(abnormal, VitalDiastolic)
+	}
+	if r.Temperature > NormTemperatureHigh {
+		abnormal = append(abnormal, VitalTemperature)
+	}
+	if r.Saturation < NormSaturationLow {
+		abnormal = append(abnormal, VitalSaturation)
+	}
+	return abnormal
+}
+
+// Critical - состояние, требующее немедленной реакции: низкая сатурация или несколько отклонений сразу
+func (r *Vitals) Critical() bool {
+	return r.Saturation < CriticalSaturation || len(r.Abnormal()) >= 3
+}
@@ -2,6 +2,7 @@ package patient
 
 import (
 	"go.uber.org/fx"
+	access_service "hospital/internal/modules/domain/access/service"
 	"hospital/internal/modules/domain/patient/repo"
 	"hospital/internal/modules/domain/patient/service"
 )
@@ -16,6 +17,10 @@ var (
 				func(r *repo.PatientRepo) *repo.PatientRepo { return r },
 				fx.As(new(service.IPatientRepo)),
 			),
+			fx.Annotate(
+				func(s *access_service.AccessService) *access_service.AccessService { return s },
+				fx.As(new(service.IAccessRecorder)),
+			),
 		),
 	)
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"hospital/internal/modules/db"
 	"hospital/internal/modules/db/ent"
+	"hospital/internal/modules/db/ent/patient"
 	"hospital/internal/modules/domain/patient/dto"
 )
 
@@ -35,15 +36,72 @@ func (r *PatientRepo) List(ctx context.Context) (dto.Patients, error) {
 	return ToPatientDTOs(Patients), nil
 }
 
+// ListByRoom возвращает пациентов, лежащих в палате
+func (r *PatientRepo) ListByRoom(ctx context.Context, roomId int) (dto.Patients, error) {
+	Patients, err := r.client.Patient.Query().
+		Where(patient.RoomNumberEQ(roomId)).
+		Order(ent.Asc(patient.FieldSurname), ent.Asc(patient.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToPatientDTOs(Patients), nil
+}
+
+func (r *PatientRepo) Search(ctx context.Context, query string) (dto.Patients, error) {
+	Patients, err := r.client.Patient.Query().
+		Where(patient.Or(
+			patient.SurnameContainsFold(query),
+			patient.NameContainsFold(query),
+		)).
+		All(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToPatientDTOs(Patients), nil
+}
+
+// Create кладет пациента в палату; счетчик пациентов палаты меняется в той же транзакции.
+// Палата читается с правами пользователя, поэтому в палату чужой клиники пациента не положить.
 func (r *PatientRepo) Create(ctx context.Context, dtm *dto.CreatePatient) (*dto.Patient, error) {
-	Patient, err := r.client.Patient.Create().
+	var Patient *ent.Patient
+	err := db.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		if _, err := tx.Room.Get(ctx, dtm.RoomNumber); err != nil {
+			return db.WrapError(err)
+		}
+
+		var err error
+		Patient, err = tx.Patient.Create().
+			SetName(dtm.Name).
+			SetHeight(dtm.Height).
+			SetPatronymic(dtm.Patronymic).
+			SetDegreeOfDanger(dtm.DegreeOfDanger).
+			SetSurname(dtm.Surname).
+			SetWeight(dtm.Weight).
+			SetRoomNumber(dtm.RoomNumber).
+			Save(ctx)
+		if err != nil {
+			return db.WrapError(err)
+		}
+		return addRoomPatients(ctx, tx, dtm.RoomNumber, 1)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ToPatientDTO(Patient), nil
+}
+
+func (r *PatientRepo) Update(ctx context.Context, id int, dtm *dto.UpdatePatient) (*dto.Patient, error) {
+	Patient, err := r.client.Patient.UpdateOneID(id).
 		SetName(dtm.Name).
 		SetHeight(dtm.Height).
 		SetPatronymic(dtm.Patronymic).
 		SetDegreeOfDanger(dtm.DegreeOfDanger).
 		SetSurname(dtm.Surname).
 		SetWeight(dtm.Weight).
-		SetRoomNumber(dtm.RoomNumber).
 		Save(ctx)
 	if err != nil {
 		return nil, db.WrapError(err)
@@ -52,14 +110,10 @@ func (r *PatientRepo) Create(ctx context.Context, dtm *dto.CreatePatient) (*dto.
 	return ToPatientDTO(Patient), nil
 }
 
-func (r *PatientRepo) Update(ctx context.Context, id int, dtm *dto.UpdatePatient) (*dto.Patient, error) {
+// SetDisease ставит пациенту диагноз
+func (r *PatientRepo) SetDisease(ctx context.Context, id int, diseaseId int) (*dto.Patient, error) {
 	Patient, err := r.client.Patient.UpdateOneID(id).
-		SetName(dtm.Name).
-		SetHeight(dtm.Height).
-		SetPatronymic(dtm.Patronymic).
-		SetDegreeOfDanger(dtm.DegreeOfDanger).
-		SetSurname(dtm.Surname).
-		SetWeight(dtm.Weight).
+		SetIllsID(diseaseId).
 		Save(ctx)
 	if err != nil {
 		return nil, db.WrapError(err)
@@ -68,8 +122,62 @@ func (r *PatientRepo) Update(ctx context.Context, id int, dtm *dto.UpdatePatient
 	return ToPatientDTO(Patient), nil
 }
 
+// AssignDoctor закрепляет пациента за лечащим врачом
+func (r *PatientRepo) AssignDoctor(ctx context.Context, id int, doctorId int) (*dto.Patient, error) {
+	Patient, err := r.client.Patient.UpdateOneID(id).
+		AddDoctorIDs(doctorId).
+		Save(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToPatientDTO(Patient), nil
+}
+
+// AddVitals сохраняет замер показателей пациента; уведомления об отклонениях от нормы
+// сохраняются в той же транзакции
+func (r *PatientRepo) AddVitals(ctx context.Context, id int, authorId *int, dtm *dto.CreateVitals) (*dto.Vitals, error) {
+	var Vital *ent.Vital
+	err := db.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		var err error
+		Vital, err = tx.Vital.Create().
+			SetPatientId(id).
+			SetNillableAuthorId(authorId).
+			SetPulse(dtm.Pulse).
+			SetSystolic(dtm.Systolic).
+			SetDiastolic(dtm.Diastolic).
+			SetTemperature(dtm.Temperature).
+			SetSaturation(dtm.Saturation).
+			Save(ctx)
+		return db.WrapError(err)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ToVitalsDTO(Vital), nil
+}
+
+// Delete выписывает пациента; счетчик пациентов палаты меняется в той же транзакции
 func (r *PatientRepo) Delete(ctx context.Context, id int) error {
-	err := r.client.Patient.DeleteOneID(id).Exec(ctx)
+	return db.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		Patient, err := tx.Patient.Get(ctx, id)
+		if err != nil {
+			return db.WrapError(err)
+		}
+		if err = tx.Patient.DeleteOneID(id).Exec(ctx); err != nil {
+			return db.WrapError(err)
+		}
+		return addRoomPatients(ctx, tx, Patient.RoomNumber, -1)
+	})
+}
+
+// addRoomPatients меняет счетчик пациентов палаты на delta. Счетчик увеличивается
+// в самом запросе, поэтому параллельные изменения одной палаты не теряются.
+func addRoomPatients(ctx context.Context, tx *ent.Tx, roomId int, delta int) error {
+	err := tx.Room.UpdateOneID(roomId).
+		AddNumberPatients(delta).
+		Exec(db.SystemContext(ctx))
 	if err != nil {
 		return db.WrapError(err)
 	}
@@ -112,3 +220,20 @@ func ToPatientDTOs(models ent.Patients) dto.Patients {
 	}
 	return dtms
 }
+
+func ToVitalsDTO(model *ent.Vital) *dto.Vitals {
+	if model == nil {
+		return nil
+	}
+	return &dto.Vitals{
+		Id:          model.ID,
+		PatientId:   model.PatientId,
+		AuthorId:    model.AuthorId,
+		Pulse:       model.Pulse,
+		Systolic:    model.Systolic,
+		Diastolic:   model.Diastolic,
+		Temperature: model.Temperature,
+		Saturation:  model.Saturation,
+		CreatedAt:   model.CreatedAt,
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"github.com/ozontech/allure-go/pkg/framework/provider"
 	"github.com/ozontech/allure-go/pkg/framework/runner"
+	err_c "hospital/internal/models/errors"
+	"hospital/internal/models/role"
+	"hospital/internal/models/session"
 	"hospital/internal/modules/config"
 	"hospital/internal/modules/db"
 	"hospital/internal/modules/db/ent"
@@ -65,7 +68,7 @@ func TestPatientRepo_Create(t *testing.T) {
 		SetNumber(1).
 		SetNumberBeds(1).
 		SetTypeRoom("1").
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create patient: %v", err)
 	}
@@ -78,7 +81,7 @@ func TestPatientRepo_Create(t *testing.T) {
 		SetWeight(80).
 		SetPatronymic("Abob").
 		SetRoomNumber(room.ID).
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create patient: %v", err)
 	}
@@ -112,7 +115,7 @@ func TestPatientRepo_Create(t *testing.T) {
 
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		got, err := testCase1.repo.GetById(context.Background(), testCase1.id)
+		got, err := testCase1.repo.GetById(db.SystemContext(context.Background()), testCase1.id)
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("GetById() error = %v, wantErr %v", err, testCase1.wantErr)
 			return
@@ -139,7 +142,7 @@ func TestPatientRepo_Create(t *testing.T) {
 
 	// Run the test case 2
 	runner.Run(t, testCase2.name, func(t provider.T) {
-		got, err := testCase2.repo.GetById(context.Background(), testCase2.id)
+		got, err := testCase2.repo.GetById(db.SystemContext(context.Background()), testCase2.id)
 		if (err != nil) != testCase2.wantErr {
 			t.Errorf("GetById() error = %v, wantErr %v", err, testCase2.wantErr)
 			return
@@ -150,6 +153,67 @@ func TestPatientRepo_Create(t *testing.T) {
 	})
 }
 
+func TestPatientRepo_Create_ForeignRoom(t *testing.T) {
+	log, levelog, err := logger.NewLogger()
+	if err != nil {
+		t.Fatalf("failed to init logger: %v", err)
+	}
+	cfg, err := config.NewConfig(log, levelog)
+	if err != nil {
+		t.Fatalf("failed to init config: %v", err)
+	}
+	client, err := db.NewDBClient(cfg, log)
+	err = db.TruncateAll(client)
+	if err != nil {
+		t.Fatalf("failed to truncate all: %v", err)
+	}
+	sysCtx := db.SystemContext(context.Background())
+	orgA, err := client.Organization.Create().SetName("Клиника A").Save(sysCtx)
+	if err != nil {
+		t.Fatalf("failed to create organization: %v", err)
+	}
+	orgB, err := client.Organization.Create().SetName("Клиника B").Save(sysCtx)
+	if err != nil {
+		t.Fatalf("failed to create organization: %v", err)
+	}
+	room, err := client.Room.Create().
+		SetOrganizationId(orgB.ID).
+		SetNumberPatients(0).
+		SetFloor(1).
+		SetNumber(1).
+		SetNumberBeds(1).
+		SetTypeRoom("1").
+		Save(sysCtx)
+	if err != nil {
+		t.Fatalf("failed to create room: %v", err)
+	}
+
+	repo := NewPatientRepo(client)
+	adminCtx := session.SetSessionToCtx(context.Background(), session.Session{UserId: 1, Role: role.Admin, OrganizationId: orgA.ID})
+
+	runner.Run(t, "Clinic admin cannot admit a patient to another clinic room", func(t provider.T) {
+		_, err := repo.Create(adminCtx, &dto.CreatePatient{
+			Name:           "John",
+			Surname:        "Doe",
+			Patronymic:     "Abob",
+			Height:         180,
+			Weight:         80,
+			DegreeOfDanger: 2,
+			RoomNumber:     room.ID,
+		})
+		if err != err_c.ErrDatabaseRecordNotFound {
+			t.Errorf("Create() error = %v, want %v", err, err_c.ErrDatabaseRecordNotFound)
+		}
+		got, err := client.Room.Get(sysCtx, room.ID)
+		if err != nil {
+			t.Fatalf("failed to get room: %v", err)
+		}
+		if got.NumberPatients != 0 {
+			t.Errorf("NumberPatients = %d, want 0", got.NumberPatients)
+		}
+	})
+}
+
 func TestPatientRepo_Delete(t *testing.T) {
 	log, levelog, err := logger.NewLogger()
 
@@ -173,7 +237,7 @@ func TestPatientRepo_Delete(t *testing.T) {
 		SetNumber(1).
 		SetNumberBeds(1).
 		SetTypeRoom("1").
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create patient: %v", err)
 	}
@@ -186,7 +250,7 @@ func TestPatientRepo_Delete(t *testing.T) {
 		SetWeight(80).
 		SetPatronymic("Abob").
 		SetRoomNumber(room.ID).
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create patient: %v", err)
 	}
@@ -224,7 +288,7 @@ func TestPatientRepo_Delete(t *testing.T) {
 
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		err := testCase1.repo.Delete(context.Background(), testCase1.id)
+		err := testCase1.repo.Delete(db.SystemContext(context.Background()), testCase1.id)
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("Delete() error = %v, wantErr %v", err, testCase1.wantErr)
 			return
@@ -248,7 +312,7 @@ func TestPatientRepo_Delete(t *testing.T) {
 
 	// Run the test case 2
 	runner.Run(t, testCase2.name, func(t provider.T) {
-		err := testCase2.repo.Delete(context.Background(), testCase2.id)
+		err := testCase2.repo.Delete(db.SystemContext(context.Background()), testCase2.id)
 		if (err != nil) != testCase2.wantErr {
 			t.Errorf("Delete() error = %v, wantErr %v", err, testCase2.wantErr)
 			return
@@ -279,7 +343,7 @@ func TestPatientRepo_GetById(t *testing.T) {
 		SetNumber(1).
 		SetNumberBeds(1).
 		SetTypeRoom("1").
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create patient: %v", err)
 	}
@@ -292,7 +356,7 @@ func TestPatientRepo_GetById(t *testing.T) {
 		SetWeight(80).
 		SetPatronymic("Abob").
 		SetRoomNumber(room.ID).
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create patient: %v", err)
 	}
@@ -326,7 +390,7 @@ func TestPatientRepo_GetById(t *testing.T) {
 
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		got, err := testCase1.repo.GetById(context.Background(), testCase1.id)
+		got, err := testCase1.repo.GetById(db.SystemContext(context.Background()), testCase1.id)
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("GetById() error = %v, wantErr %v", err, testCase1.wantErr)
 			return
@@ -353,7 +417,7 @@ func TestPatientRepo_GetById(t *testing.T) {
 
 	// Run the test case 2
 	runner.Run(t, testCase2.name, func(t provider.T) {
-		got, err := testCase2.repo.GetById(context.Background(), testCase2.id)
+		got, err := testCase2.repo.GetById(db.SystemContext(context.Background()), testCase2.id)
 		if (err != nil) != testCase2.wantErr {
 			t.Errorf("GetById() error = %v, wantErr %v", err, testCase2.wantErr)
 			return
@@ -387,7 +451,7 @@ func TestPatientRepo_List(t *testing.T) {
 		SetNumber(1).
 		SetNumberBeds(1).
 		SetTypeRoom("1").
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create patient: %v", err)
 	}
@@ -400,7 +464,7 @@ func TestPatientRepo_List(t *testing.T) {
 		SetWeight(80).
 		SetPatronymic("Abob").
 		SetRoomNumber(room.ID).
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create patient: %v", err)
 	}
@@ -436,7 +500,7 @@ func TestPatientRepo_List(t *testing.T) {
 
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		got, err := testCase1.repo.List(context.Background())
+		got, err := testCase1.repo.List(db.SystemContext(context.Background()))
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("List() error = %v, wantErr %v", err, testCase1.wantErr)
 			return
@@ -471,7 +535,7 @@ func TestPatientRepo_Restore(t *testing.T) {
 		SetNumber(1).
 		SetNumberBeds(1).
 		SetTypeRoom("1").
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create patient: %v", err)
 	}
@@ -484,7 +548,7 @@ func TestPatientRepo_Restore(t *testing.T) {
 		SetWeight(80).
 		SetPatronymic("Abob").
 		SetRoomNumber(room.ID).
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create patient: %v", err)
 	}
@@ -522,7 +586,7 @@ func TestPatientRepo_Restore(t *testing.T) {
 
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		got, err := testCase1.repo.Restore(context.Background(), testCase1.id)
+		got, err := testCase1.repo.Restore(db.SystemContext(context.Background()), testCase1.id)
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("Restore() error = %v, wantErr %v", err, testCase1.wantErr)
 			return
@@ -549,7 +613,7 @@ func TestPatientRepo_Restore(t *testing.T) {
 
 	// Run the test case 2
 	runner.Run(t, testCase2.name, func(t provider.T) {
-		got, err := testCase2.repo.Restore(context.Background(), testCase2.id)
+		got, err := testCase2.repo.Restore(db.SystemContext(context.Background()), testCase2.id)
 		if (err != nil) != testCase2.wantErr {
 			t.Errorf("Restore() error = %v, wantErr %v", err, testCase2.wantErr)
 			return
@@ -583,7 +647,7 @@ func TestPatientRepo_Update(t *testing.T) {
 		SetNumber(1).
 		SetNumberBeds(1).
 		SetTypeRoom("1").
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create patient: %v", err)
 	}
@@ -596,7 +660,7 @@ func TestPatientRepo_Update(t *testing.T) {
 		SetWeight(80).
 		SetPatronymic("Abob").
 		SetRoomNumber(room.ID).
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create patient: %v", err)
 	}
@@ -609,7 +673,7 @@ func TestPatientRepo_Update(t *testing.T) {
 		SetWeight(80).
 		SetPatronymic("Abob").
 		SetRoomNumber(room.ID).
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to update patient: %v", err)
 	}
@@ -651,7 +715,7 @@ func TestPatientRepo_Update(t *testing.T) {
 	}
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		got, err := testCase1.repo.Update(context.Background(), testCase1.id, &upd_patient)
+		got, err := testCase1.repo.Update(db.SystemContext(context.Background()), testCase1.id, &upd_patient)
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("Update() error = %v, wantErr %v", err, testCase1.wantErr)
 			return
@@ -678,7 +742,7 @@ func TestPatientRepo_Update(t *testing.T) {
 
 	// Run the test case 2
 	runner.Run(t, testCase2.name, func(t provider.T) {
-		got, err := testCase2.repo.Update(context.Background(), testCase2.id, &upd_patient)
+		got, err := testCase2.repo.Update(db.SystemContext(context.Background()), testCase2.id, &upd_patient)
 		if (err != nil) != testCase2.wantErr {
 			t.Errorf("Update() error = %v, wantErr %v", err, testCase2.wantErr)
 			return
@@ -712,7 +776,7 @@ func TestToPatientDTO(t *testing.T) {
 		SetNumber(1).
 		SetNumberBeds(1).
 		SetTypeRoom("1").
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create patient: %v", err)
 	}
@@ -725,7 +789,7 @@ func TestToPatientDTO(t *testing.T) {
 		SetWeight(80).
 		SetPatronymic("Abob").
 		SetRoomNumber(room.ID).
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create patient: %v", err)
 	}
@@ -794,7 +858,7 @@ func TestToPatientDTOs(t *testing.T) {
 		SetNumber(1).
 		SetNumberBeds(1).
 		SetTypeRoom("1").
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create patient: %v", err)
 	}
@@ -807,7 +871,7 @@ func TestToPatientDTOs(t *testing.T) {
 		SetWeight(80).
 		SetPatronymic("Abob").
 		SetRoomNumber(room.ID).
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create patient: %v", err)
 	}
@@ -843,7 +907,7 @@ func TestToPatientDTOs(t *testing.T) {
 
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		patients, err := client.Patient.Query().All(context.Background())
+		patients, err := client.Patient.Query().All(db.SystemContext(context.Background()))
 		got := ToPatientDTOs(patients)
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("ToPatientDTOs() error = %v, wantErr %v", err, testCase1.wantErr)
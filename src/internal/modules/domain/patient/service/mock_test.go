@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: hospital/internal/modules/domain/patient/service (interfaces: IPatientRepo)
+// Source: hospital/internal/modules/domain/patient/service (interfaces: IPatientRepo,IAccessRecorder)
 
 // Package service is a generated GoMock package.
 package service
@@ -35,6 +35,36 @@ func (m *MockIPatientRepo) EXPECT() *MockIPatientRepoMockRecorder {
 	return m.recorder
 }
 
+// AddVitals mocks base method.
+func (m *MockIPatientRepo) AddVitals(arg0 context.Context, arg1 int, arg2 *int, arg3 *dto.CreateVitals) (*dto.Vitals, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddVitals", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*dto.Vitals)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddVitals indicates an expected call of AddVitals.
+func (mr *MockIPatientRepoMockRecorder) AddVitals(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVitals", reflect.TypeOf((*MockIPatientRepo)(nil).AddVitals), arg0, arg1, arg2, arg3)
+}
+
+// AssignDoctor mocks base method.
+func (m *MockIPatientRepo) AssignDoctor(arg0 context.Context, arg1, arg2 int) (*dto.Patient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignDoctor", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dto.Patient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignDoctor indicates an expected call of AssignDoctor.
+func (mr *MockIPatientRepoMockRecorder) AssignDoctor(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignDoctor", reflect.TypeOf((*MockIPatientRepo)(nil).AssignDoctor), arg0, arg1, arg2)
+}
+
 // Create mocks base method.
 func (m *MockIPatientRepo) Create(arg0 context.Context, arg1 *dto.CreatePatient) (*dto.Patient, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +124,51 @@ func (mr *MockIPatientRepoMockRecorder) List(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIPatientRepo)(nil).List), arg0)
 }
 
+// ListByRoom mocks base method.
+func (m *MockIPatientRepo) ListByRoom(arg0 context.Context, arg1 int) (dto.Patients, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByRoom", arg0, arg1)
+	ret0, _ := ret[0].(dto.Patients)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByRoom indicates an expected call of ListByRoom.
+func (mr *MockIPatientRepoMockRecorder) ListByRoom(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByRoom", reflect.TypeOf((*MockIPatientRepo)(nil).ListByRoom), arg0, arg1)
+}
+
+// Search mocks base method.
+func (m *MockIPatientRepo) Search(arg0 context.Context, arg1 string) (dto.Patients, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1)
+	ret0, _ := ret[0].(dto.Patients)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockIPatientRepoMockRecorder) Search(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockIPatientRepo)(nil).Search), arg0, arg1)
+}
+
+// SetDisease mocks base method.
+func (m *MockIPatientRepo) SetDisease(arg0 context.Context, arg1, arg2 int) (*dto.Patient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDisease", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dto.Patient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDisease indicates an expected call of SetDisease.
+func (mr *MockIPatientRepoMockRecorder) SetDisease(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDisease", reflect.TypeOf((*MockIPatientRepo)(nil).SetDisease), arg0, arg1, arg2)
+}
+
 // Update mocks base method.
 func (m *MockIPatientRepo) Update(arg0 context.Context, arg1 int, arg2 *dto.UpdatePatient) (*dto.Patient, error) {
 	m.ctrl.T.Helper()
@@ -108,3 +183,40 @@ func (mr *MockIPatientRepoMockRecorder) Update(arg0, arg1, arg2 interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIPatientRepo)(nil).Update), arg0, arg1, arg2)
 }
+
+// MockIAccessRecorder is a mock of IAccessRecorder interface.
+type MockIAccessRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAccessRecorderMockRecorder
+}
+
+// MockIAccessRecorderMockRecorder is the mock recorder for MockIAccessRecorder.
+type MockIAccessRecorderMockRecorder struct {
+	mock *MockIAccessRecorder
+}
+
+// NewMockIAccessRecorder creates a new mock instance.
+func NewMockIAccessRecorder(ctrl *gomock.Controller) *MockIAccessRecorder {
+	mock := &MockIAccessRecorder{ctrl: ctrl}
+	mock.recorder = &MockIAccessRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAccessRecorder) EXPECT() *MockIAccessRecorderMockRecorder {
+	return m.recorder
+}
+
+// Record mocks base method.
+func (m *MockIAccessRecorder) Record(arg0 context.Context, arg1 string, arg2 []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockIAccessRecorderMockRecorder) Record(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockIAccessRecorder)(nil).Record), arg0, arg1, arg2)
+}
@@ -2,45 +2,148 @@ package service
 
 import (
 	"context"
+	"hospital/internal/models/session"
+	access_dto "hospital/internal/modules/domain/access/dto"
 	"hospital/internal/modules/domain/patient/dto"
 )
 
-//go:generate mockgen -destination mock_test.go -package service . IPatientRepo
+//go:generate mockgen -destination mock_test.go -package service . IPatientRepo,IAccessRecorder
 
 type IPatientRepo interface {
 	GetById(ctx context.Context, id int) (*dto.Patient, error)
 	List(ctx context.Context) (dto.Patients, error)
+	ListByRoom(ctx context.Context, roomId int) (dto.Patients, error)
+	Search(ctx context.Context, query string) (dto.Patients, error)
 	Create(ctx context.Context, dtm *dto.CreatePatient) (*dto.Patient, error)
 	Update(ctx context.Context, num int, dtm *dto.UpdatePatient) (*dto.Patient, error)
+	SetDisease(ctx context.Context, id int, diseaseId int) (*dto.Patient, error)
+	AssignDoctor(ctx context.Context, id int, doctorId int) (*dto.Patient, error)
+	AddVitals(ctx context.Context, id int, authorId *int, dtm *dto.CreateVitals) (*dto.Vitals, error)
 	Delete(ctx context.Context, num int) error
 }
 
+// IAccessRecorder - журнал просмотров данных пациентов
+type IAccessRecorder interface {
+	Record(ctx context.Context, action string, patientIds []int) error
+}
+
 type PatientService struct {
-	repo IPatientRepo
+	repo   IPatientRepo
+	access IAccessRecorder
 }
 
-func NewPatientService(repo IPatientRepo) *PatientService {
+func NewPatientService(repo IPatientRepo, access IAccessRecorder) *PatientService {
 	return &PatientService{
-		repo: repo,
+		repo:   repo,
+		access: access,
 	}
 }
 
 func (r *PatientService) GetById(ctx context.Context, id int) (*dto.Patient, error) {
-	return r.repo.GetById(ctx, id)
+	patient, err := r.repo.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.access.Record(ctx, access_dto.ActionGet, []int{patient.Id}); err != nil {
+		return nil, err
+	}
+
+	return patient, nil
 }
 
 func (r *PatientService) List(ctx context.Context) (dto.Patients, error) {
-	return r.repo.List(ctx)
+	patients, err := r.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.access.Record(ctx, access_dto.ActionList, patientIds(patients)); err != nil {
+		return nil, err
+	}
+
+	return patients, nil
+}
+
+// ListByRoom возвращает пациентов палаты
+func (r *PatientService) ListByRoom(ctx context.Context, roomId int) (dto.Patients, error) {
+	patients, err := r.repo.ListByRoom(ctx, roomId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.access.Record(ctx, access_dto.ActionList, patientIds(patients)); err != nil {
+		return nil, err
+	}
+
+	return patients, nil
+}
+
+// Search ищет пациентов по фамилии или имени
+func (r *PatientService) Search(ctx context.Context, query string) (dto.Patients, error) {
+	patients, err := r.repo.Search(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.access.Record(ctx, access_dto.ActionSearch, patientIds(patients)); err != nil {
+		return nil, err
+	}
+
+	return patients, nil
 }
 
 func (r *PatientService) Create(ctx context.Context, dtm *dto.CreatePatient) (*dto.Patient, error) {
+	if err := dtm.Validate(); err != nil {
+		return nil, err
+	}
+
 	return r.repo.Create(ctx, dtm)
 }
 
 func (r *PatientService) Update(ctx context.Context, id int, dtm *dto.UpdatePatient) (*dto.Patient, error) {
+	if err := dtm.Validate(); err != nil {
+		return nil, err
+	}
+
 	return r.repo.Update(ctx, id, dtm)
 }
 
+// SetDisease ставит пациенту диагноз
+func (r *PatientService) SetDisease(ctx context.Context, id int, diseaseId int) (*dto.Patient, error) {
+	return r.repo.SetDisease(ctx, id, diseaseId)
+}
+
+// AssignDoctor закрепляет пациента за лечащим врачом; врач получит уведомление
+func (r *PatientService) AssignDoctor(ctx context.Context, id int, doctorId int) (*dto.Patient, error) {
+	return r.repo.AssignDoctor(ctx, id, doctorId)
+}
+
+// RecordVitals сохраняет замер показателей; об отклонениях от нормы уведомляются лечащие врачи
+func (r *PatientService) RecordVitals(ctx context.Context, id int, dtm *dto.CreateVitals) (*dto.Vitals, error) {
+	if err := dtm.Validate(); err != nil {
+		return nil, err
+	}
+	// Замер можно внести только пациенту, которого пользователь видит
+	if _, err := r.repo.GetById(ctx, id); err != nil {
+		return nil, err
+	}
+
+	var authorId *int
+	if s, ok := session.GetSessionFromCtx(ctx); ok {
+		authorId = &s.UserId
+	}
+	return r.repo.AddVitals(ctx, id, authorId, dtm)
+}
+
 func (r *PatientService) Delete(ctx context.Context, id int) error {
 	return r.repo.Delete(ctx, id)
 }
+
+func patientIds(patients dto.Patients) []int {
+	ids := make([]int, len(patients))
+	for i := range patients {
+		ids[i] = patients[i].Id
+	}
+	return ids
+}
@@ -6,6 +6,9 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/ozontech/allure-go/pkg/framework/provider"
 	"github.com/ozontech/allure-go/pkg/framework/runner"
+	model_errors "hospital/internal/models/errors"
+	"hospital/internal/models/session"
+	"hospital/internal/models/validation"
 	"hospital/internal/modules/domain/patient/dto"
 
 	"reflect"
@@ -14,9 +17,11 @@ import (
 
 func TestNewPatientService(t *testing.T) {
 	type args struct {
-		repo IPatientRepo
+		repo   IPatientRepo
+		access IAccessRecorder
 	}
 	mockPatient := new(MockIPatientRepo)
+	mockAccess := new(MockIAccessRecorder)
 
 	tests := []struct {
 		name string
@@ -26,16 +31,18 @@ func TestNewPatientService(t *testing.T) {
 		{
 			name: "Simple positive test",
 			args: args{
-				repo: mockPatient,
+				repo:   mockPatient,
+				access: mockAccess,
 			},
 			want: &PatientService{
-				repo: mockPatient,
+				repo:   mockPatient,
+				access: mockAccess,
 			},
 		},
 	}
 	for _, tt := range tests {
 		runner.Run(t, tt.name, func(t provider.T) {
-			if got := NewPatientService(tt.args.repo); !reflect.DeepEqual(got, tt.want) {
+			if got := NewPatientService(tt.args.repo, tt.args.access); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("NewPatientService() = %v, want %v", got, tt.want)
 			}
 		})
@@ -252,7 +259,8 @@ func TestPatientService_Delete(t *testing.T) {
 
 func TestPatientService_GetById(t *testing.T) {
 	type fields struct {
-		repo IPatientRepo
+		repo   IPatientRepo
+		access IAccessRecorder
 	}
 	type args struct {
 		ctx context.Context
@@ -262,6 +270,7 @@ func TestPatientService_GetById(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := NewMockIPatientRepo(ctrl)
+	mockAccess := NewMockIAccessRecorder(ctrl)
 
 	// Test case 1: Successful get
 	testCase1 := struct {
@@ -273,7 +282,8 @@ func TestPatientService_GetById(t *testing.T) {
 	}{
 		name: "Successful get",
 		fields: fields{
-			repo: mockRepo,
+			repo:   mockRepo,
+			access: mockAccess,
 		},
 		args: args{
 			ctx: context.Background(),
@@ -293,6 +303,7 @@ func TestPatientService_GetById(t *testing.T) {
 	}
 
 	mockRepo.EXPECT().GetById(gomock.Any(), testCase1.args.id).Return(testCase1.want, nil)
+	mockAccess.EXPECT().Record(gomock.Any(), "get", []int{1}).Return(nil)
 
 	// Test case 2: Error while getting patient
 	testCase2 := struct {
@@ -304,7 +315,8 @@ func TestPatientService_GetById(t *testing.T) {
 	}{
 		name: "Error while getting patient",
 		fields: fields{
-			repo: mockRepo,
+			repo:   mockRepo,
+			access: mockAccess,
 		},
 		args: args{
 			ctx: context.Background(),
@@ -329,7 +341,8 @@ func TestPatientService_GetById(t *testing.T) {
 	} {
 		runner.Run(t, tt.name, func(t provider.T) {
 			r := &PatientService{
-				repo: tt.fields.repo,
+				repo:   tt.fields.repo,
+				access: tt.fields.access,
 			}
 			got, err := r.GetById(tt.args.ctx, tt.args.id)
 			if (err != nil) != tt.wantErr {
@@ -345,7 +358,8 @@ func TestPatientService_GetById(t *testing.T) {
 
 func TestPatientService_List(t *testing.T) {
 	type fields struct {
-		repo IPatientRepo
+		repo   IPatientRepo
+		access IAccessRecorder
 	}
 	type args struct {
 		ctx context.Context
@@ -354,6 +368,7 @@ func TestPatientService_List(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := NewMockIPatientRepo(ctrl)
+	mockAccess := NewMockIAccessRecorder(ctrl)
 
 	// Test case 1: Successful list
 	testCase1 := struct {
@@ -365,7 +380,8 @@ func TestPatientService_List(t *testing.T) {
 	}{
 		name: "Successful list",
 		fields: fields{
-			repo: mockRepo,
+			repo:   mockRepo,
+			access: mockAccess,
 		},
 		args: args{
 			ctx: context.Background(),
@@ -396,6 +412,7 @@ func TestPatientService_List(t *testing.T) {
 	}
 
 	mockRepo.EXPECT().List(gomock.Any()).Return(testCase1.want, nil)
+	mockAccess.EXPECT().Record(gomock.Any(), "list", []int{1, 2}).Return(nil)
 
 	// Test case 2: Error while listing patients
 	testCase2 := struct {
@@ -407,7 +424,8 @@ func TestPatientService_List(t *testing.T) {
 	}{
 		name: "Error while listing patients",
 		fields: fields{
-			repo: mockRepo,
+			repo:   mockRepo,
+			access: mockAccess,
 		},
 		args: args{
 			ctx: context.Background(),
@@ -431,7 +449,8 @@ func TestPatientService_List(t *testing.T) {
 	} {
 		runner.Run(t, tt.name, func(t provider.T) {
 			r := &PatientService{
-				repo: tt.fields.repo,
+				repo:   tt.fields.repo,
+				access: tt.fields.access,
 			}
 			got, err := r.List(tt.args.ctx)
 			if (err != nil) != tt.wantErr {
@@ -554,7 +573,7 @@ func TestPatientService_Update(t *testing.T) {
 		wantErr: true,
 	}
 
-	mockRepo.EXPECT().Update(gomock.Any(), testCase3.args.id, testCase3.args.dtm).Return(nil, errors.New("invalid update data"))
+	// Некорректные данные отклоняются сервисом, репозиторий не вызывается
 
 	// Run the test cases
 	for _, tt := range []struct {
@@ -583,3 +602,228 @@ func TestPatientService_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestPatientService_Search(t *testing.T) {
+	type fields struct {
+		repo   IPatientRepo
+		access IAccessRecorder
+	}
+	type args struct {
+		ctx   context.Context
+		query string
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockIPatientRepo(ctrl)
+	mockAccess := NewMockIAccessRecorder(ctrl)
+
+	found := dto.Patients{
+		{
+			Id:      3,
+			Surname: "Doe",
+			Name:    "John",
+		},
+	}
+
+	// Test case 1: Successful search is recorded
+	mockRepo.EXPECT().Search(gomock.Any(), "doe").Return(found, nil)
+	mockAccess.EXPECT().Record(gomock.Any(), "search", []int{3}).Return(nil)
+
+	// Test case 2: Results are not shown when access can't be recorded
+	mockRepo.EXPECT().Search(gomock.Any(), "john").Return(found, nil)
+	mockAccess.EXPECT().Record(gomock.Any(), "search", []int{3}).Return(errors.New("error while recording access"))
+
+	for _, tt := range []struct {
+		name    string
+		fields  fields
+		args    args
+		want    dto.Patients
+		wantErr bool
+	}{
+		{
+			name:    "Successful search",
+			fields:  fields{repo: mockRepo, access: mockAccess},
+			args:    args{ctx: context.Background(), query: "doe"},
+			want:    found,
+			wantErr: false,
+		},
+		{
+			name:    "Error while recording access",
+			fields:  fields{repo: mockRepo, access: mockAccess},
+			args:    args{ctx: context.Background(), query: "john"},
+			want:    nil,
+			wantErr: true,
+		},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			r := &PatientService{
+				repo:   tt.fields.repo,
+				access: tt.fields.access,
+			}
+			got, err := r.Search(tt.args.ctx, tt.args.query)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Search() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Search() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPatientService_ListByRoom(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockIPatientRepo(ctrl)
+	mockAccess := NewMockIAccessRecorder(ctrl)
+
+	occupants := dto.Patients{
+		{Id: 3, Surname: "Doe", RoomNumber: 5},
+		{Id: 4, Surname: "Roe", RoomNumber: 5},
+	}
+
+	// Test case 1: Occupants are returned and their viewing is recorded
+	mockRepo.EXPECT().ListByRoom(gomock.Any(), 5).Return(occupants, nil)
+	mockAccess.EXPECT().Record(gomock.Any(), "list", []int{3, 4}).Return(nil)
+
+	// Test case 2: Error while getting occupants
+	mockRepo.EXPECT().ListByRoom(gomock.Any(), 6).Return(nil, errors.New("error while listing patients"))
+
+	for _, tt := range []struct {
+		name    string
+		roomId  int
+		want    dto.Patients
+		wantErr bool
+	}{
+		{name: "Successful list", roomId: 5, want: occupants},
+		{name: "Error while listing", roomId: 6, wantErr: true},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			r := &PatientService{repo: mockRepo, access: mockAccess}
+			got, err := r.ListByRoom(context.Background(), tt.roomId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ListByRoom() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListByRoom() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPatientService_CreateValidation(t *testing.T) {
+	valid := dto.CreatePatient{
+		Surname:        "Doe",
+		Name:           "John",
+		Height:         180,
+		Weight:         75.5,
+		RoomNumber:     101,
+		DegreeOfDanger: 2,
+	}
+
+	for _, tt := range []struct {
+		name   string
+		modify func(p *dto.CreatePatient)
+		field  string
+	}{
+		{name: "Height below range", modify: func(p *dto.CreatePatient) { p.Height = 0 }, field: "Height"},
+		{name: "Height above range", modify: func(p *dto.CreatePatient) { p.Height = 300 }, field: "Height"},
+		{name: "Weight out of range", modify: func(p *dto.CreatePatient) { p.Weight = 0 }, field: "Weight"},
+		{name: "No room", modify: func(p *dto.CreatePatient) { p.RoomNumber = 0 }, field: "RoomNumber"},
+		{name: "Danger out of range", modify: func(p *dto.CreatePatient) { p.DegreeOfDanger = 6 }, field: "DegreeOfDanger"},
+		{name: "Empty surname", modify: func(p *dto.CreatePatient) { p.Surname = " " }, field: "Surname"},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			patient := valid
+			tt.modify(&patient)
+			r := &PatientService{repo: NewMockIPatientRepo(ctrl)}
+
+			_, err := r.Create(context.Background(), &patient)
+			if !errors.Is(err, model_errors.ErrValidation) {
+				t.Fatalf("Create() error = %v, want validation error", err)
+			}
+			var fields validation.Errors
+			if !errors.As(err, &fields) || len(fields) != 1 || fields.Field(tt.field) == nil {
+				t.Errorf("Create() errors = %v, want only %s", err, tt.field)
+			}
+		})
+	}
+}
+
+func TestPatientService_SetDisease(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockIPatientRepo(ctrl)
+	patient := &dto.Patient{Id: 3, Surname: "Doe"}
+	mockRepo.EXPECT().SetDisease(gomock.Any(), 3, 7).Return(patient, nil)
+	mockRepo.EXPECT().SetDisease(gomock.Any(), 4, 7).Return(nil, errors.New("not found"))
+
+	for _, tt := range []struct {
+		name    string
+		id      int
+		want    *dto.Patient
+		wantErr bool
+	}{
+		{name: "Successful set", id: 3, want: patient},
+		{name: "Error while setting disease", id: 4, wantErr: true},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			r := &PatientService{repo: mockRepo}
+			got, err := r.SetDisease(context.Background(), tt.id, 7)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SetDisease() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SetDisease() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPatientService_RecordVitals(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockIPatientRepo(ctrl)
+	ctx := session.SetSessionToCtx(context.Background(), session.Session{SessionID: "s1", UserId: 2})
+	authorId := 2
+	normal := &dto.CreateVitals{Pulse: 80, Systolic: 120, Diastolic: 80, Temperature: 36.6, Saturation: 98}
+	recorded := &dto.Vitals{Id: 1, PatientId: 3, AuthorId: &authorId, Pulse: 80, Systolic: 120, Diastolic: 80, Temperature: 36.6, Saturation: 98}
+
+	mockRepo.EXPECT().GetById(gomock.Any(), 3).Return(&dto.Patient{Id: 3}, nil)
+	mockRepo.EXPECT().AddVitals(gomock.Any(), 3, &authorId, normal).Return(recorded, nil)
+	mockRepo.EXPECT().GetById(gomock.Any(), 4).Return(nil, errors.New("not found"))
+
+	for _, tt := range []struct {
+		name    string
+		id      int
+		vitals  *dto.CreateVitals
+		want    *dto.Vitals
+		wantErr bool
+	}{
+		{name: "Vitals are recorded by the current user", id: 3, vitals: normal, want: recorded},
+		{name: "Invisible patient", id: 4, vitals: normal, wantErr: true},
+		{name: "Impossible values are rejected", id: 3, vitals: &dto.CreateVitals{Pulse: 800, Systolic: 120, Diastolic: 80, Temperature: 36.6, Saturation: 98}, wantErr: true},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			r := &PatientService{repo: mockRepo}
+			got, err := r.RecordVitals(ctx, tt.id, tt.vitals)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RecordVitals() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RecordVitals() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package dto
 
+import "hospital/internal/models/validation"
+
 type Room struct {
 	Id             int
 	Num            int
@@ -7,6 +9,7 @@ type Room struct {
 	NumberBeds     int
 	TypeRoom       string
 	NumberPatients int
+	DepartmentId   *int
 }
 
 type Rooms []*Room
@@ -17,6 +20,7 @@ type CreateRoom struct {
 	NumberBeds     int
 	TypeRoom       string
 	NumberPatients int
+	DepartmentId   *int
 }
 
 type UpdateRoom struct {
@@ -25,4 +29,32 @@ type UpdateRoom struct {
 	NumberBeds     int
 	TypeRoom       string
 	NumberPatients int
+	DepartmentId   *int
+}
+
+// Допустимые значения полей палаты
+const (
+	MinFloor = -3
+	MaxFloor = 50
+	MinBeds  = 1
+	MaxBeds  = 20
+)
+
+func (r *CreateRoom) Validate() error {
+	return validateRoom(r.Num, r.Floor, r.NumberBeds, r.TypeRoom, r.NumberPatients)
+}
+
+func (r *UpdateRoom) Validate() error {
+	return validateRoom(r.Num, r.Floor, r.NumberBeds, r.TypeRoom, r.NumberPatients)
+}
+
+func validateRoom(num int, floor int, beds int, typeRoom string, patients int) error {
+	var v validation.Validator
+	v.Positive("Num", "Номер палаты", num)
+	v.IntRange("Floor", "Этаж", floor, MinFloor, MaxFloor)
+	v.IntRange("NumberBeds", "Количество кроватей", beds, MinBeds, MaxBeds)
+	v.Required("TypeRoom", "Тип палаты", typeRoom)
+	// Пациентов в палате не больше, чем кроватей
+	v.IntRange("NumberPatients", "Количество пациентов", patients, 0, beds)
+	return v.Err()
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"hospital/internal/modules/db"
 	"hospital/internal/modules/db/ent"
+	"hospital/internal/modules/db/ent/room"
 	"hospital/internal/modules/domain/room/dto"
 )
 
@@ -17,7 +18,7 @@ func NewRoomRepo(client *ent.Client) *RoomRepo {
 	}
 }
 
-func (r *RoomRepo) GetByNum(ctx context.Context, id int) (*dto.Room, error) {
+func (r *RoomRepo) GetById(ctx context.Context, id int) (*dto.Room, error) {
 	Room, err := r.client.Room.Get(ctx, id)
 	if err != nil {
 		return nil, db.WrapError(err)
@@ -26,6 +27,18 @@ func (r *RoomRepo) GetByNum(ctx context.Context, id int) (*dto.Room, error) {
 	return ToRoomDTO(Room), nil
 }
 
+// GetByNum ищет палату по ее номеру; номер уникален в пределах клиники
+func (r *RoomRepo) GetByNum(ctx context.Context, num int) (*dto.Room, error) {
+	Room, err := r.client.Room.Query().
+		Where(room.NumberEQ(num)).
+		Only(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToRoomDTO(Room), nil
+}
+
 func (r *RoomRepo) List(ctx context.Context) (dto.Rooms, error) {
 	Rooms, err := r.client.Room.Query().All(ctx)
 	if err != nil {
@@ -42,6 +55,7 @@ func (r *RoomRepo) Create(ctx context.Context, dtm *dto.CreateRoom) (*dto.Room,
 		SetFloor(dtm.Floor).
 		SetNumberBeds(dtm.NumberBeds).
 		SetTypeRoom(dtm.TypeRoom).
+		SetNillableDepartmentId(dtm.DepartmentId).
 		Save(ctx)
 	if err != nil {
 		return nil, db.WrapError(err)
@@ -56,6 +70,7 @@ func (r *RoomRepo) Update(ctx context.Context, id int, dtm *dto.UpdateRoom) (*dt
 		SetFloor(dtm.Floor).
 		SetNumberBeds(dtm.NumberBeds).
 		SetTypeRoom(dtm.TypeRoom).
+		SetNillableDepartmentId(dtm.DepartmentId).
 		Save(ctx)
 	if err != nil {
 		return nil, db.WrapError(err)
@@ -93,6 +108,7 @@ func ToRoomDTO(model *ent.Room) *dto.Room {
 		Floor:          model.Floor,
 		NumberPatients: model.NumberPatients,
 		Num:            model.Number,
+		DepartmentId:   model.DepartmentId,
 	}
 }
 
@@ -65,7 +65,7 @@ func TestRoomRepo_Create(t *testing.T) {
 		SetNumber(1).
 		SetNumberBeds(1).
 		SetTypeRoom("1").
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create room: %v", err)
 	}
@@ -97,7 +97,7 @@ func TestRoomRepo_Create(t *testing.T) {
 
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		got, err := testCase1.repo.GetByNum(context.Background(), testCase1.id)
+		got, err := testCase1.repo.GetById(db.SystemContext(context.Background()), testCase1.id)
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("Create() error = %v, wantErr %v", err, testCase1.wantErr)
 			return
@@ -124,13 +124,13 @@ func TestRoomRepo_Create(t *testing.T) {
 
 	// Run the test case 2
 	runner.Run(t, testCase2.name, func(t provider.T) {
-		got, err := testCase2.repo.GetByNum(context.Background(), testCase2.id)
+		got, err := testCase2.repo.GetById(db.SystemContext(context.Background()), testCase2.id)
 		if (err != nil) != testCase2.wantErr {
-			t.Errorf("GetByNum() error = %v, wantErr %v", err, testCase2.wantErr)
+			t.Errorf("GetById() error = %v, wantErr %v", err, testCase2.wantErr)
 			return
 		}
 		if !reflect.DeepEqual(got, testCase2.want) {
-			t.Errorf("GetByNum() got = %v, want %v", got, testCase2.want)
+			t.Errorf("GetById() got = %v, want %v", got, testCase2.want)
 		}
 	})
 }
@@ -158,7 +158,7 @@ func TestRoomRepo_Delete(t *testing.T) {
 		SetNumber(1).
 		SetNumberBeds(1).
 		SetTypeRoom("1").
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create room: %v", err)
 	}
@@ -197,7 +197,7 @@ func TestRoomRepo_Delete(t *testing.T) {
 
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		err := testCase1.repo.Delete(context.Background(), testCase1.id)
+		err := testCase1.repo.Delete(db.SystemContext(context.Background()), testCase1.id)
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("Delete() error = %v, wantErr %v", err, testCase1.wantErr)
 			return
@@ -221,7 +221,7 @@ func TestRoomRepo_Delete(t *testing.T) {
 
 	// Run the test case 2
 	runner.Run(t, testCase2.name, func(t provider.T) {
-		err := testCase2.repo.Delete(context.Background(), testCase2.id)
+		err := testCase2.repo.Delete(db.SystemContext(context.Background()), testCase2.id)
 		if (err != nil) != testCase2.wantErr {
 			t.Errorf("Delete() error = %v, wantErr %v", err, testCase2.wantErr)
 			return
@@ -252,7 +252,7 @@ func TestRoomRepo_GetByNum(t *testing.T) {
 		SetNumber(1).
 		SetNumberBeds(1).
 		SetTypeRoom("1").
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create room: %v", err)
 	}
@@ -263,7 +263,7 @@ func TestRoomRepo_GetByNum(t *testing.T) {
 	// Create a new room repository
 	repo := NewRoomRepo(client)
 
-	// Test case 1: Successful get by ID
+	// Test case 1: Successful get by number
 	testCase1 := struct {
 		name    string
 		repo    *RoomRepo
@@ -271,9 +271,9 @@ func TestRoomRepo_GetByNum(t *testing.T) {
 		want    *dto.Room
 		wantErr bool
 	}{
-		name: "Successful get by ID",
+		name: "Successful get by number",
 		repo: repo,
-		id:   room.ID,
+		id:   room.Number,
 		want: &dto.Room{
 			Id:             room.ID,
 			Floor:          1,
@@ -287,7 +287,7 @@ func TestRoomRepo_GetByNum(t *testing.T) {
 
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		got, err := testCase1.repo.GetByNum(context.Background(), testCase1.id)
+		got, err := testCase1.repo.GetByNum(db.SystemContext(context.Background()), testCase1.id)
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("GetByNum() error = %v, wantErr %v", err, testCase1.wantErr)
 			return
@@ -297,7 +297,7 @@ func TestRoomRepo_GetByNum(t *testing.T) {
 		}
 	})
 
-	// Test case 2: Get by ID with non-existent room
+	// Test case 2: Get by number with non-existent room
 	testCase2 := struct {
 		name    string
 		repo    *RoomRepo
@@ -305,7 +305,7 @@ func TestRoomRepo_GetByNum(t *testing.T) {
 		want    *dto.Room
 		wantErr bool
 	}{
-		name:    "Get by ID with non-existent room",
+		name:    "Get by number with non-existent room",
 		repo:    repo,
 		id:      100,
 		want:    nil,
@@ -314,7 +314,7 @@ func TestRoomRepo_GetByNum(t *testing.T) {
 
 	// Run the test case 2
 	runner.Run(t, testCase2.name, func(t provider.T) {
-		got, err := testCase2.repo.GetByNum(context.Background(), testCase2.id)
+		got, err := testCase2.repo.GetByNum(db.SystemContext(context.Background()), testCase2.id)
 		if (err != nil) != testCase2.wantErr {
 			t.Errorf("GetByNum() error = %v, wantErr %v", err, testCase2.wantErr)
 			return
@@ -348,7 +348,7 @@ func TestRoomRepo_List(t *testing.T) {
 		SetNumber(1).
 		SetNumberBeds(1).
 		SetTypeRoom("1").
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create room: %v", err)
 	}
@@ -386,7 +386,7 @@ func TestRoomRepo_List(t *testing.T) {
 
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		got, err := testCase1.repo.List(context.Background())
+		got, err := testCase1.repo.List(db.SystemContext(context.Background()))
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("List() error = %v, wantErr %v", err, testCase1.wantErr)
 			return
@@ -421,7 +421,7 @@ func TestRoomRepo_Restore(t *testing.T) {
 		SetNumber(1).
 		SetNumberBeds(1).
 		SetTypeRoom("1").
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create room: %v", err)
 	}
@@ -461,7 +461,7 @@ func TestRoomRepo_Restore(t *testing.T) {
 
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		got, err := testCase1.repo.Restore(context.Background(), testCase1.id)
+		got, err := testCase1.repo.Restore(db.SystemContext(context.Background()), testCase1.id)
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("Restore() error = %v, wantErr %v", err, testCase1.wantErr)
 			return
@@ -488,7 +488,7 @@ func TestRoomRepo_Restore(t *testing.T) {
 
 	// Run the test case 2
 	runner.Run(t, testCase2.name, func(t provider.T) {
-		got, err := testCase2.repo.Restore(context.Background(), testCase2.id)
+		got, err := testCase2.repo.Restore(db.SystemContext(context.Background()), testCase2.id)
 		if (err != nil) != testCase2.wantErr {
 			t.Errorf("Restore() error = %v, wantErr %v", err, testCase2.wantErr)
 			return
@@ -522,7 +522,7 @@ func TestRoomRepo_Update(t *testing.T) {
 		SetNumber(1).
 		SetNumberBeds(1).
 		SetTypeRoom("1").
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create room: %v", err)
 	}
@@ -536,7 +536,7 @@ func TestRoomRepo_Update(t *testing.T) {
 		SetNumber(1).
 		SetNumberBeds(1).
 		SetTypeRoom("1").
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to update room: %v", err)
 	}
@@ -574,7 +574,7 @@ func TestRoomRepo_Update(t *testing.T) {
 	}
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		got, err := testCase1.repo.Update(context.Background(), testCase1.id, &upd_room)
+		got, err := testCase1.repo.Update(db.SystemContext(context.Background()), testCase1.id, &upd_room)
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("Update() error = %v, wantErr %v", err, testCase1.wantErr)
 			return
@@ -601,7 +601,7 @@ func TestRoomRepo_Update(t *testing.T) {
 
 	// Run the test case 2
 	runner.Run(t, testCase2.name, func(t provider.T) {
-		got, err := testCase2.repo.Update(context.Background(), testCase2.id, &upd_room)
+		got, err := testCase2.repo.Update(db.SystemContext(context.Background()), testCase2.id, &upd_room)
 		if (err != nil) != testCase2.wantErr {
 			t.Errorf("Update() error = %v, wantErr %v", err, testCase2.wantErr)
 			return
@@ -635,7 +635,7 @@ func TestToRoomDTO(t *testing.T) {
 		SetNumber(1).
 		SetNumberBeds(1).
 		SetTypeRoom("1").
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create room: %v", err)
 	}
@@ -705,7 +705,7 @@ func TestToRoomDTOs(t *testing.T) {
 		SetNumber(1).
 		SetNumberBeds(1).
 		SetTypeRoom("1").
-		Save(context.Background())
+		Save(db.SystemContext(context.Background()))
 	if err != nil {
 		t.Fatalf("failed to create room: %v", err)
 	}
@@ -743,7 +743,7 @@ func TestToRoomDTOs(t *testing.T) {
 
 	// Run the test case 1
 	runner.Run(t, testCase1.name, func(t provider.T) {
-		rooms, err := client.Room.Query().All(context.Background())
+		rooms, err := client.Room.Query().All(db.SystemContext(context.Background()))
 		got := ToRoomDTOs(rooms)
 		if (err != nil) != testCase1.wantErr {
 			t.Errorf("ToRoomDTOs() error = %v, wantErr %v", err, testCase1.wantErr)
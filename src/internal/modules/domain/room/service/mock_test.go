@@ -64,6 +64,21 @@ func (mr *MockIRoomRepoMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIRoomRepo)(nil).Delete), arg0, arg1)
 }
 
+// GetById mocks base method.
+func (m *MockIRoomRepo) GetById(arg0 context.Context, arg1 int) (*dto.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", arg0, arg1)
+	ret0, _ := ret[0].(*dto.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockIRoomRepoMockRecorder) GetById(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockIRoomRepo)(nil).GetById), arg0, arg1)
+}
+
 // GetByNum mocks base method.
 func (m *MockIRoomRepo) GetByNum(arg0 context.Context, arg1 int) (*dto.Room, error) {
 	m.ctrl.T.Helper()
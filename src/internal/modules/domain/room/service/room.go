@@ -8,6 +8,7 @@ import (
 //go:generate mockgen -destination mock_test.go -package service . IRoomRepo
 
 type IRoomRepo interface {
+	GetById(ctx context.Context, id int) (*dto.Room, error)
 	GetByNum(ctx context.Context, num int) (*dto.Room, error)
 	List(ctx context.Context) (dto.Rooms, error)
 	Create(ctx context.Context, dtm *dto.CreateRoom) (*dto.Room, error)
@@ -25,6 +26,11 @@ func NewRoomService(repo IRoomRepo) *RoomService {
 	}
 }
 
+func (r *RoomService) GetById(ctx context.Context, id int) (*dto.Room, error) {
+	return r.repo.GetById(ctx, id)
+}
+
+// GetByNum ищет палату по номеру, а не по ID
 func (r *RoomService) GetByNum(ctx context.Context, num int) (*dto.Room, error) {
 	return r.repo.GetByNum(ctx, num)
 }
@@ -34,10 +40,18 @@ func (r *RoomService) List(ctx context.Context) (dto.Rooms, error) {
 }
 
 func (r *RoomService) Create(ctx context.Context, dtm *dto.CreateRoom) (*dto.Room, error) {
+	if err := dtm.Validate(); err != nil {
+		return nil, err
+	}
+
 	return r.repo.Create(ctx, dtm)
 }
 
 func (r *RoomService) Update(ctx context.Context, num int, dtm *dto.UpdateRoom) (*dto.Room, error) {
+	if err := dtm.Validate(); err != nil {
+		return nil, err
+	}
+
 	return r.repo.Update(ctx, num, dtm)
 }
 
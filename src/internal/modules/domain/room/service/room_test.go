@@ -6,6 +6,8 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/ozontech/allure-go/pkg/framework/provider"
 	"github.com/ozontech/allure-go/pkg/framework/runner"
+	model_errors "hospital/internal/models/errors"
+	"hospital/internal/models/validation"
 	"hospital/internal/modules/domain/room/dto"
 	"reflect"
 	"testing"
@@ -265,6 +267,97 @@ func TestRoomService_GetById(t *testing.T) {
 		wantErr: false,
 	}
 
+	mockRepo.EXPECT().GetById(gomock.Any(), testCase1.args.id).Return(testCase1.want, nil)
+
+	// Test case 2: Error while getting room
+	testCase2 := struct {
+		name    string
+		fields  fields
+		args    args
+		want    *dto.Room
+		wantErr bool
+	}{
+		name: "Error while getting room",
+		fields: fields{
+			repo: mockRepo,
+		},
+		args: args{
+			ctx: context.Background(),
+			id:  2,
+		},
+		want:    nil,
+		wantErr: true,
+	}
+
+	mockRepo.EXPECT().GetById(gomock.Any(), testCase2.args.id).Return(nil, errors.New("error while getting room"))
+
+	// Run the test cases
+	for _, tt := range []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *dto.Room
+		wantErr bool
+	}{
+		testCase1,
+		testCase2,
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			r := &RoomService{
+				repo: tt.fields.repo,
+			}
+			got, err := r.GetById(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetById() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetById() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoomService_GetByNum(t *testing.T) {
+	type fields struct {
+		repo IRoomRepo
+	}
+	type args struct {
+		ctx context.Context
+		id  int
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockIRoomRepo(ctrl)
+
+	// Test case 1: Successful get
+	testCase1 := struct {
+		name    string
+		fields  fields
+		args    args
+		want    *dto.Room
+		wantErr bool
+	}{
+		name: "Successful get",
+		fields: fields{
+			repo: mockRepo,
+		},
+		args: args{
+			ctx: context.Background(),
+			id:  1,
+		},
+		want: &dto.Room{
+			Id:             1,
+			Num:            1,
+			Floor:          1,
+			NumberPatients: 1,
+			NumberBeds:     1,
+			TypeRoom:       "1",
+		},
+		wantErr: false,
+	}
+
 	mockRepo.EXPECT().GetByNum(gomock.Any(), testCase1.args.id).Return(testCase1.want, nil)
 
 	// Test case 2: Error while getting room
@@ -306,11 +399,11 @@ func TestRoomService_GetById(t *testing.T) {
 			}
 			got, err := r.GetByNum(tt.args.ctx, tt.args.id)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("GetById() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("GetByNum() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("GetById() got = %v, want %v", got, tt.want)
+				t.Errorf("GetByNum() got = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -549,3 +642,37 @@ func TestRoomService_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestRoomService_CreateValidation(t *testing.T) {
+	valid := dto.CreateRoom{Num: 1, Floor: 1, NumberBeds: 2, TypeRoom: "Общая"}
+
+	for _, tt := range []struct {
+		name   string
+		modify func(r *dto.CreateRoom)
+		field  string
+	}{
+		{name: "Number not positive", modify: func(r *dto.CreateRoom) { r.Num = 0 }, field: "Num"},
+		{name: "Floor out of range", modify: func(r *dto.CreateRoom) { r.Floor = 100 }, field: "Floor"},
+		{name: "No beds", modify: func(r *dto.CreateRoom) { r.NumberBeds = 0; r.NumberPatients = 0 }, field: "NumberBeds"},
+		{name: "More patients than beds", modify: func(r *dto.CreateRoom) { r.NumberPatients = 3 }, field: "NumberPatients"},
+		{name: "Empty type", modify: func(r *dto.CreateRoom) { r.TypeRoom = "" }, field: "TypeRoom"},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			room := valid
+			tt.modify(&room)
+			r := &RoomService{repo: NewMockIRoomRepo(ctrl)}
+
+			_, err := r.Create(context.Background(), &room)
+			if !errors.Is(err, model_errors.ErrValidation) {
+				t.Fatalf("Create() error = %v, want validation error", err)
+			}
+			var fields validation.Errors
+			if !errors.As(err, &fields) || len(fields) != 1 || fields.Field(tt.field) == nil {
+				t.Errorf("Create() errors = %v, want only %s", err, tt.field)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package dto
+
+import "time"
+
+type Session struct {
+	Id         int
+	SessionId  string
+	DoctorId   int
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	ExpiresAt  time.Time
+	RevokedAt  *time.Time
+
+	// Отделение и клиника, в которых сейчас работает врач
+	DepartmentId   *int
+	OrganizationId *int
+}
+
+type Sessions []*Session
+
+type CreateSession struct {
+	SessionId  string
+	DoctorId   int
+	LastSeenAt time.Time
+	ExpiresAt  time.Time
+
+	DepartmentId   *int
+	OrganizationId *int
+}
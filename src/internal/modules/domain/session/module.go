@@ -0,0 +1,31 @@
+package session
+
+import (
+	"go.uber.org/fx"
+	department_repo "hospital/internal/modules/domain/department/repo"
+	"hospital/internal/modules/domain/session/repo"
+	"hospital/internal/modules/domain/session/service"
+)
+
+var (
+	Module = fx.Options(
+		service.Module,
+		repo.Module,
+
+		fx.Provide(
+			fx.Annotate(
+				func(r *repo.SessionRepo) *repo.SessionRepo { return r },
+				fx.As(new(service.ISessionRepo)),
+			),
+			fx.Annotate(
+				func(r *department_repo.DepartmentRepo) *department_repo.DepartmentRepo { return r },
+				fx.As(new(service.IDepartmentRepo)),
+			),
+		),
+	)
+
+	Invokables = fx.Options(
+		service.Invokables,
+		repo.Invokables,
+	)
+)
@@ -0,0 +1,122 @@
+package repo
+
+import (
+	"context"
+	"hospital/internal/models/errors"
+	"hospital/internal/modules/domain/session/dto"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MemorySessionRepo - хранилище сессий в памяти процесса, используется в тестах
+type MemorySessionRepo struct {
+	mu       sync.Mutex
+	lastId   int
+	sessions map[string]*dto.Session
+}
+
+func NewMemorySessionRepo() *MemorySessionRepo {
+	return &MemorySessionRepo{
+		sessions: make(map[string]*dto.Session),
+	}
+}
+
+func (r *MemorySessionRepo) GetBySessionId(_ context.Context, sessionId string) (*dto.Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s, ok := r.sessions[sessionId]
+	if !ok {
+		return nil, errors.ErrDatabaseRecordNotFound
+	}
+	copied := *s
+	return &copied, nil
+}
+
+func (r *MemorySessionRepo) ListActive(_ context.Context, doctorId int) (dto.Sessions, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var res dto.Sessions
+	for _, s := range r.sessions {
+		if s.DoctorId == doctorId && s.RevokedAt == nil {
+			copied := *s
+			res = append(res, &copied)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].LastSeenAt.After(res[j].LastSeenAt)
+	})
+	return res, nil
+}
+
+func (r *MemorySessionRepo) Create(_ context.Context, dtm *dto.CreateSession) (*dto.Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.sessions[dtm.SessionId]; ok {
+		return nil, errors.ErrUniqueViolation
+	}
+	r.lastId++
+	s := &dto.Session{
+		Id:         r.lastId,
+		SessionId:  dtm.SessionId,
+		DoctorId:   dtm.DoctorId,
+		CreatedAt:  dtm.LastSeenAt,
+		LastSeenAt: dtm.LastSeenAt,
+		ExpiresAt:  dtm.ExpiresAt,
+
+		DepartmentId:   dtm.DepartmentId,
+		OrganizationId: dtm.OrganizationId,
+	}
+	r.sessions[s.SessionId] = s
+	copied := *s
+	return &copied, nil
+}
+
+func (r *MemorySessionRepo) Touch(_ context.Context, sessionId string, lastSeenAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if s, ok := r.sessions[sessionId]; ok {
+		s.LastSeenAt = lastSeenAt
+	}
+	return nil
+}
+
+func (r *MemorySessionRepo) SetDepartment(_ context.Context, sessionId string, departmentId int, organizationId int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if s, ok := r.sessions[sessionId]; ok {
+		s.DepartmentId = &departmentId
+		s.OrganizationId = &organizationId
+	}
+	return nil
+}
+
+func (r *MemorySessionRepo) Revoke(_ context.Context, sessionId string, revokedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if s, ok := r.sessions[sessionId]; ok && s.RevokedAt == nil {
+		s.RevokedAt = &revokedAt
+	}
+	return nil
+}
+
+func (r *MemorySessionRepo) RevokeAll(_ context.Context, doctorId int, revokedAt time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	n := 0
+	for _, s := range r.sessions {
+		if s.DoctorId == doctorId && s.RevokedAt == nil {
+			at := revokedAt
+			s.RevokedAt = &at
+			n++
+		}
+	}
+	return n, nil
+}
@@ -0,0 +1,8 @@
+package repo
+
+import "go.uber.org/fx"
+
+var (
+	Module     = fx.Provide(NewSessionRepo)
+	Invokables = fx.Invoke()
+)
@@ -0,0 +1,137 @@
+package repo
+
+import (
+	"context"
+	"entgo.io/ent/dialect/sql"
+	"hospital/internal/modules/db"
+	"hospital/internal/modules/db/ent"
+	"hospital/internal/modules/db/ent/session"
+	"hospital/internal/modules/domain/session/dto"
+	"time"
+)
+
+type SessionRepo struct {
+	client *ent.Client
+}
+
+func NewSessionRepo(client *ent.Client) *SessionRepo {
+	return &SessionRepo{
+		client: client,
+	}
+}
+
+func (r *SessionRepo) GetBySessionId(ctx context.Context, sessionId string) (*dto.Session, error) {
+	Session, err := r.client.Session.Query().Where(session.SessionIdEQ(sessionId)).Only(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToSessionDTO(Session), nil
+}
+
+// ListActive возвращает неотозванные сессии врача, начиная с последней активной
+func (r *SessionRepo) ListActive(ctx context.Context, doctorId int) (dto.Sessions, error) {
+	Sessions, err := r.client.Session.Query().
+		Where(session.DoctorIdEQ(doctorId), session.RevokedAtIsNil()).
+		Order(session.ByLastSeenAt(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToSessionDTOs(Sessions), nil
+}
+
+func (r *SessionRepo) Create(ctx context.Context, dtm *dto.CreateSession) (*dto.Session, error) {
+	Session, err := r.client.Session.Create().
+		SetSessionId(dtm.SessionId).
+		SetDoctorId(dtm.DoctorId).
+		SetLastSeenAt(dtm.LastSeenAt).
+		SetExpiresAt(dtm.ExpiresAt).
+		SetNillableDepartmentId(dtm.DepartmentId).
+		SetNillableOrganizationId(dtm.OrganizationId).
+		Save(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToSessionDTO(Session), nil
+}
+
+func (r *SessionRepo) Touch(ctx context.Context, sessionId string, lastSeenAt time.Time) error {
+	_, err := r.client.Session.Update().
+		Where(session.SessionIdEQ(sessionId)).
+		SetLastSeenAt(lastSeenAt).
+		Save(ctx)
+	if err != nil {
+		return db.WrapError(err)
+	}
+
+	return nil
+}
+
+func (r *SessionRepo) SetDepartment(ctx context.Context, sessionId string, departmentId int, organizationId int) error {
+	_, err := r.client.Session.Update().
+		Where(session.SessionIdEQ(sessionId)).
+		SetDepartmentId(departmentId).
+		SetOrganizationId(organizationId).
+		Save(ctx)
+	if err != nil {
+		return db.WrapError(err)
+	}
+
+	return nil
+}
+
+func (r *SessionRepo) Revoke(ctx context.Context, sessionId string, revokedAt time.Time) error {
+	_, err := r.client.Session.Update().
+		Where(session.SessionIdEQ(sessionId), session.RevokedAtIsNil()).
+		SetRevokedAt(revokedAt).
+		Save(ctx)
+	if err != nil {
+		return db.WrapError(err)
+	}
+
+	return nil
+}
+
+func (r *SessionRepo) RevokeAll(ctx context.Context, doctorId int, revokedAt time.Time) (int, error) {
+	n, err := r.client.Session.Update().
+		Where(session.DoctorIdEQ(doctorId), session.RevokedAtIsNil()).
+		SetRevokedAt(revokedAt).
+		Save(ctx)
+	if err != nil {
+		return 0, db.WrapError(err)
+	}
+
+	return n, nil
+}
+
+func ToSessionDTO(model *ent.Session) *dto.Session {
+	if model == nil {
+		return nil
+	}
+	return &dto.Session{
+		Id:         model.ID,
+		SessionId:  model.SessionId,
+		DoctorId:   model.DoctorId,
+		CreatedAt:  model.CreatedAt,
+		LastSeenAt: model.LastSeenAt,
+		ExpiresAt:  model.ExpiresAt,
+		RevokedAt:  model.RevokedAt,
+
+		DepartmentId:   model.DepartmentId,
+		OrganizationId: model.OrganizationId,
+	}
+}
+
+func ToSessionDTOs(models ent.Sessions) dto.Sessions {
+	if models == nil {
+		return nil
+	}
+	dtms := make(dto.Sessions, len(models))
+	for i := range models {
+		dtms[i] = ToSessionDTO(models[i])
+	}
+	return dtms
+}
@@ -0,0 +1,178 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: hospital/internal/modules/domain/session/service (interfaces: ISessionRepo,IDepartmentRepo)
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	dto "hospital/internal/modules/domain/department/dto"
+	dto0 "hospital/internal/modules/domain/session/dto"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockISessionRepo is a mock of ISessionRepo interface.
+type MockISessionRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockISessionRepoMockRecorder
+}
+
+// MockISessionRepoMockRecorder is the mock recorder for MockISessionRepo.
+type MockISessionRepoMockRecorder struct {
+	mock *MockISessionRepo
+}
+
+// NewMockISessionRepo creates a new mock instance.
+func NewMockISessionRepo(ctrl *gomock.Controller) *MockISessionRepo {
+	mock := &MockISessionRepo{ctrl: ctrl}
+	mock.recorder = &MockISessionRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISessionRepo) EXPECT() *MockISessionRepoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockISessionRepo) Create(arg0 context.Context, arg1 *dto0.CreateSession) (*dto0.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*dto0.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockISessionRepoMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockISessionRepo)(nil).Create), arg0, arg1)
+}
+
+// GetBySessionId mocks base method.
+func (m *MockISessionRepo) GetBySessionId(arg0 context.Context, arg1 string) (*dto0.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBySessionId", arg0, arg1)
+	ret0, _ := ret[0].(*dto0.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBySessionId indicates an expected call of GetBySessionId.
+func (mr *MockISessionRepoMockRecorder) GetBySessionId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySessionId", reflect.TypeOf((*MockISessionRepo)(nil).GetBySessionId), arg0, arg1)
+}
+
+// ListActive mocks base method.
+func (m *MockISessionRepo) ListActive(arg0 context.Context, arg1 int) (dto0.Sessions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActive", arg0, arg1)
+	ret0, _ := ret[0].(dto0.Sessions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActive indicates an expected call of ListActive.
+func (mr *MockISessionRepoMockRecorder) ListActive(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActive", reflect.TypeOf((*MockISessionRepo)(nil).ListActive), arg0, arg1)
+}
+
+// Revoke mocks base method.
+func (m *MockISessionRepo) Revoke(arg0 context.Context, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockISessionRepoMockRecorder) Revoke(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockISessionRepo)(nil).Revoke), arg0, arg1, arg2)
+}
+
+// RevokeAll mocks base method.
+func (m *MockISessionRepo) RevokeAll(arg0 context.Context, arg1 int, arg2 time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAll indicates an expected call of RevokeAll.
+func (mr *MockISessionRepoMockRecorder) RevokeAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAll", reflect.TypeOf((*MockISessionRepo)(nil).RevokeAll), arg0, arg1, arg2)
+}
+
+// SetDepartment mocks base method.
+func (m *MockISessionRepo) SetDepartment(arg0 context.Context, arg1 string, arg2, arg3 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDepartment", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDepartment indicates an expected call of SetDepartment.
+func (mr *MockISessionRepoMockRecorder) SetDepartment(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDepartment", reflect.TypeOf((*MockISessionRepo)(nil).SetDepartment), arg0, arg1, arg2, arg3)
+}
+
+// Touch mocks base method.
+func (m *MockISessionRepo) Touch(arg0 context.Context, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Touch", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Touch indicates an expected call of Touch.
+func (mr *MockISessionRepoMockRecorder) Touch(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Touch", reflect.TypeOf((*MockISessionRepo)(nil).Touch), arg0, arg1, arg2)
+}
+
+// MockIDepartmentRepo is a mock of IDepartmentRepo interface.
+type MockIDepartmentRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockIDepartmentRepoMockRecorder
+}
+
+// MockIDepartmentRepoMockRecorder is the mock recorder for MockIDepartmentRepo.
+type MockIDepartmentRepoMockRecorder struct {
+	mock *MockIDepartmentRepo
+}
+
+// NewMockIDepartmentRepo creates a new mock instance.
+func NewMockIDepartmentRepo(ctrl *gomock.Controller) *MockIDepartmentRepo {
+	mock := &MockIDepartmentRepo{ctrl: ctrl}
+	mock.recorder = &MockIDepartmentRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIDepartmentRepo) EXPECT() *MockIDepartmentRepoMockRecorder {
+	return m.recorder
+}
+
+// ListByDoctor mocks base method.
+func (m *MockIDepartmentRepo) ListByDoctor(arg0 context.Context, arg1 int) (dto.Departments, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByDoctor", arg0, arg1)
+	ret0, _ := ret[0].(dto.Departments)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByDoctor indicates an expected call of ListByDoctor.
+func (mr *MockIDepartmentRepoMockRecorder) ListByDoctor(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByDoctor", reflect.TypeOf((*MockIDepartmentRepo)(nil).ListByDoctor), arg0, arg1)
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"hospital/internal/models/errors"
+	"hospital/internal/models/session"
+	"hospital/internal/modules/config"
+	department_dto "hospital/internal/modules/domain/department/dto"
+	"hospital/internal/modules/domain/session/dto"
+	"time"
+)
+
+//go:generate mockgen -destination mock_test.go -package service . ISessionRepo,IDepartmentRepo
+
+type ISessionRepo interface {
+	GetBySessionId(ctx context.Context, sessionId string) (*dto.Session, error)
+	ListActive(ctx context.Context, doctorId int) (dto.Sessions, error)
+	Create(ctx context.Context, dtm *dto.CreateSession) (*dto.Session, error)
+	Touch(ctx context.Context, sessionId string, lastSeenAt time.Time) error
+	Revoke(ctx context.Context, sessionId string, revokedAt time.Time) error
+	RevokeAll(ctx context.Context, doctorId int, revokedAt time.Time) (int, error)
+	SetDepartment(ctx context.Context, sessionId string, departmentId int, organizationId int) error
+}
+
+type IDepartmentRepo interface {
+	ListByDoctor(ctx context.Context, doctorId int) (department_dto.Departments, error)
+}
+
+type SessionService struct {
+	repo        ISessionRepo
+	departments IDepartmentRepo
+	idleTimeout time.Duration
+	ttl         time.Duration
+	now         func() time.Time
+}
+
+func NewSessionService(repo ISessionRepo, departments IDepartmentRepo, config config.Config) *SessionService {
+	return &SessionService{
+		repo:        repo,
+		departments: departments,
+		idleTimeout: config.SessionIdleTimeout,
+		ttl:         config.SessionTTL,
+		now:         time.Now,
+	}
+}
+
+// Start выдает врачу новую сессию (вход в систему).
+// Работа начинается в первом из отделений врача, сменить его можно через SwitchDepartment.
+func (r *SessionService) Start(ctx context.Context, doctorId int) (*dto.Session, error) {
+	departments, err := r.departments.ListByDoctor(ctx, doctorId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := r.now()
+	dtm := &dto.CreateSession{
+		SessionId:  uuid.NewString(),
+		DoctorId:   doctorId,
+		LastSeenAt: now,
+		ExpiresAt:  now.Add(r.ttl),
+	}
+	if len(departments) > 0 {
+		dtm.DepartmentId = &departments[0].Id
+		dtm.OrganizationId = &departments[0].OrganizationId
+	}
+
+	return r.repo.Create(ctx, dtm)
+}
+
+// Check проверяет сессию по идентификатору и продлевает ее простой
+func (r *SessionService) Check(ctx context.Context, sessionId string) (*dto.Session, error) {
+	s, err := r.repo.GetBySessionId(ctx, sessionId)
+	if err != nil {
+		if err == errors.ErrDatabaseRecordNotFound {
+			return nil, errors.ErrUnauthorized
+		}
+		return nil, err
+	}
+
+	return r.touch(ctx, s)
+}
+
+// Current возвращает последнюю действующую сессию врача и продлевает ее простой
+func (r *SessionService) Current(ctx context.Context, doctorId int) (*dto.Session, error) {
+	sessions, err := r.repo.ListActive(ctx, doctorId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range sessions {
+		if r.expired(s) {
+			continue
+		}
+		return r.touch(ctx, s)
+	}
+
+	return nil, errors.ErrUnauthorized
+}
+
+// SignedIn проверяет, есть ли у врача действующая сессия. В отличие от Current
+// простой сессии не продлевается: проверка выполняется без участия врача.
+func (r *SessionService) SignedIn(ctx context.Context, doctorId int) (bool, error) {
+	sessions, err := r.repo.ListActive(ctx, doctorId)
+	if err != nil {
+		return false, err
+	}
+
+	for _, s := range sessions {
+		if !r.expired(s) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Logout завершает одну сессию
+func (r *SessionService) Logout(ctx context.Context, sessionId string) error {
+	return r.repo.Revoke(ctx, sessionId, r.now())
+}
+
+// LogoutAll завершает все сессии врача ("выйти везде")
+func (r *SessionService) LogoutAll(ctx context.Context, doctorId int) (int, error) {
+	return r.repo.RevokeAll(ctx, doctorId, r.now())
+}
+
+// Departments возвращает отделения, в которых работает врач текущей сессии
+func (r *SessionService) Departments(ctx context.Context) (department_dto.Departments, error) {
+	s, ok := session.GetSessionFromCtx(ctx)
+	if !ok {
+		return nil, errors.ErrUnauthorized
+	}
+
+	return r.departments.ListByDoctor(ctx, s.UserId)
+}
+
+// SwitchDepartment переключает текущую сессию на другое отделение врача
+func (r *SessionService) SwitchDepartment(ctx context.Context, departmentId int) (*department_dto.Department, error) {
+	s, ok := session.GetSessionFromCtx(ctx)
+	if !ok {
+		return nil, errors.ErrUnauthorized
+	}
+
+	departments, err := r.departments.ListByDoctor(ctx, s.UserId)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range departments {
+		if d.Id != departmentId {
+			continue
+		}
+		if err = r.repo.SetDepartment(ctx, s.SessionID, d.Id, d.OrganizationId); err != nil {
+			return nil, err
+		}
+		return d, nil
+	}
+
+	return nil, errors.ErrAccessDenied
+}
+
+// WithSession кладет сессию в контекст для последующих вызовов сервисов.
+// Роль, отделение и клиника врача используются правилами доступа к записям.
+func (r *SessionService) WithSession(ctx context.Context, s *dto.Session, role string) context.Context {
+	ss := session.Session{
+		SessionID: s.SessionId,
+		UserId:    s.DoctorId,
+		Role:      role,
+	}
+	if s.DepartmentId != nil {
+		ss.DepartmentId = *s.DepartmentId
+	}
+	if s.OrganizationId != nil {
+		ss.OrganizationId = *s.OrganizationId
+	}
+	return session.SetSessionToCtx(ctx, ss)
+}
+
+func (r *SessionService) touch(ctx context.Context, s *dto.Session) (*dto.Session, error) {
+	if r.expired(s) {
+		return nil, errors.ErrSessionExpired
+	}
+
+	now := r.now()
+	if err := r.repo.Touch(ctx, s.SessionId, now); err != nil {
+		return nil, err
+	}
+	s.LastSeenAt = now
+
+	return s, nil
+}
+
+func (r *SessionService) expired(s *dto.Session) bool {
+	now := r.now()
+	if s.RevokedAt != nil || !now.Before(s.ExpiresAt) {
+		return true
+	}
+	return r.idleTimeout > 0 && now.Sub(s.LastSeenAt) >= r.idleTimeout
+}
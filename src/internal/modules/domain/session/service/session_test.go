@@ -0,0 +1,297 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	err_c "hospital/internal/models/errors"
+	"hospital/internal/models/session"
+	"hospital/internal/modules/config"
+	department_dto "hospital/internal/modules/domain/department/dto"
+	"hospital/internal/modules/domain/session/repo"
+	"testing"
+	"time"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func repeat(d time.Duration, n int) []time.Duration {
+	res := make([]time.Duration, n)
+	for i := range res {
+		res[i] = d
+	}
+	return res
+}
+
+// fakeDepartments - отделения врачей по их ID
+type fakeDepartments map[int]department_dto.Departments
+
+func (f fakeDepartments) ListByDoctor(_ context.Context, doctorId int) (department_dto.Departments, error) {
+	return f[doctorId], nil
+}
+
+func newTestSessionService(clock *fakeClock) *SessionService {
+	s := NewSessionService(repo.NewMemorySessionRepo(), fakeDepartments{
+		7: {
+			{Id: 3, Name: "Хирургия", OrganizationId: 1},
+			{Id: 5, Name: "Терапия", OrganizationId: 2},
+		},
+	}, config.Config{
+		SessionIdleTimeout: 30 * time.Minute,
+		SessionTTL:         12 * time.Hour,
+	})
+	s.now = clock.Now
+	return s
+}
+
+func TestNewSessionService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockISessionRepo(ctrl)
+	mockDepartments := NewMockIDepartmentRepo(ctrl)
+
+	runner.Run(t, "Simple positive test", func(t provider.T) {
+		got := NewSessionService(mockRepo, mockDepartments, config.Config{
+			SessionIdleTimeout: time.Minute,
+			SessionTTL:         time.Hour,
+		})
+		if got.repo != mockRepo || got.departments != mockDepartments || got.idleTimeout != time.Minute || got.ttl != time.Hour {
+			t.Errorf("NewSessionService() = %v", got)
+		}
+	})
+}
+
+func TestSessionService_Current(t *testing.T) {
+	start := time.Date(2023, 10, 1, 8, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name    string
+		elapsed []time.Duration
+		wantErr error
+	}{
+		{
+			name:    "Active session",
+			elapsed: []time.Duration{10 * time.Minute},
+			wantErr: nil,
+		},
+		{
+			name:    "Idle timeout",
+			elapsed: []time.Duration{31 * time.Minute},
+			wantErr: err_c.ErrUnauthorized,
+		},
+		{
+			name:    "Sliding idle timeout",
+			elapsed: repeat(20*time.Minute, 3),
+			wantErr: nil,
+		},
+		{
+			name:    "Absolute expiry",
+			elapsed: repeat(25*time.Minute, 30),
+			wantErr: err_c.ErrUnauthorized,
+		},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			clock := &fakeClock{now: start}
+			r := newTestSessionService(clock)
+			ctx := context.Background()
+
+			if _, err := r.Start(ctx, 1); err != nil {
+				t.Fatalf("Start() error = %v", err)
+			}
+
+			var err error
+			for _, d := range tt.elapsed {
+				clock.now = clock.now.Add(d)
+				_, err = r.Current(ctx, 1)
+				if err != nil {
+					break
+				}
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Current() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSessionService_SignedIn(t *testing.T) {
+	start := time.Date(2023, 10, 1, 8, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name    string
+		elapsed []time.Duration
+		logout  bool
+		want    bool
+	}{
+		{
+			name:    "Active session",
+			elapsed: []time.Duration{10 * time.Minute},
+			want:    true,
+		},
+		{
+			name:    "Check does not extend idle timeout",
+			elapsed: repeat(20*time.Minute, 2),
+			want:    false,
+		},
+		{
+			name:    "Signed out",
+			elapsed: []time.Duration{time.Minute},
+			logout:  true,
+			want:    false,
+		},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			clock := &fakeClock{now: start}
+			r := newTestSessionService(clock)
+			ctx := context.Background()
+
+			if _, err := r.Start(ctx, 1); err != nil {
+				t.Fatalf("Start() error = %v", err)
+			}
+			if tt.logout {
+				if _, err := r.LogoutAll(ctx, 1); err != nil {
+					t.Fatalf("LogoutAll() error = %v", err)
+				}
+			}
+
+			var got bool
+			for _, d := range tt.elapsed {
+				clock.now = clock.now.Add(d)
+				var err error
+				if got, err = r.SignedIn(ctx, 1); err != nil {
+					t.Fatalf("SignedIn() error = %v", err)
+				}
+			}
+			if got != tt.want {
+				t.Errorf("SignedIn() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSessionService_Logout(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2023, 10, 1, 8, 0, 0, 0, time.UTC)}
+	ctx := context.Background()
+
+	runner.Run(t, "Logout revokes one session", func(t provider.T) {
+		r := newTestSessionService(clock)
+		first, _ := r.Start(ctx, 1)
+		second, _ := r.Start(ctx, 1)
+
+		if err := r.Logout(ctx, second.SessionId); err != nil {
+			t.Fatalf("Logout() error = %v", err)
+		}
+		if _, err := r.Check(ctx, second.SessionId); !errors.Is(err, err_c.ErrSessionExpired) {
+			t.Errorf("Check() error = %v, want %v", err, err_c.ErrSessionExpired)
+		}
+		if _, err := r.Check(ctx, first.SessionId); err != nil {
+			t.Errorf("Check() error = %v", err)
+		}
+	})
+
+	runner.Run(t, "Logout everywhere", func(t provider.T) {
+		r := newTestSessionService(clock)
+		_, _ = r.Start(ctx, 1)
+		_, _ = r.Start(ctx, 1)
+		other, _ := r.Start(ctx, 2)
+
+		n, err := r.LogoutAll(ctx, 1)
+		if err != nil || n != 2 {
+			t.Fatalf("LogoutAll() = %d, %v", n, err)
+		}
+		if _, err = r.Current(ctx, 1); !errors.Is(err, err_c.ErrUnauthorized) {
+			t.Errorf("Current() error = %v, want %v", err, err_c.ErrUnauthorized)
+		}
+		if _, err = r.Check(ctx, other.SessionId); err != nil {
+			t.Errorf("Check() error = %v", err)
+		}
+	})
+}
+
+func TestSessionService_WithSession(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2023, 10, 1, 8, 0, 0, 0, time.UTC)}
+
+	runner.Run(t, "Session is put into context", func(t provider.T) {
+		r := newTestSessionService(clock)
+		s, _ := r.Start(context.Background(), 7)
+
+		ctx := r.WithSession(context.Background(), s, "Врач")
+		got, ok := session.GetSessionFromCtx(ctx)
+		if !ok || got.UserId != 7 || got.SessionID != s.SessionId || got.Role != "Врач" ||
+			got.DepartmentId != 3 || got.OrganizationId != 1 {
+			t.Errorf("GetSessionFromCtx() = %v, %v", got, ok)
+		}
+	})
+
+	runner.Run(t, "Repo error is returned", func(t provider.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := NewMockISessionRepo(ctrl)
+		mockRepo.EXPECT().ListActive(gomock.Any(), 1).Return(nil, errors.New("db is down"))
+
+		r := &SessionService{repo: mockRepo, now: clock.Now}
+		if _, err := r.Current(context.Background(), 1); err == nil {
+			t.Errorf("Current() error = nil, want error")
+		}
+	})
+}
+
+func TestSessionService_SwitchDepartment(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2023, 10, 1, 8, 0, 0, 0, time.UTC)}
+
+	for _, tt := range []struct {
+		name         string
+		departmentId int
+		wantOrg      int
+		wantErr      error
+	}{
+		{
+			name:         "Switch to another clinic",
+			departmentId: 5,
+			wantOrg:      2,
+		},
+		{
+			name:         "Foreign department",
+			departmentId: 9,
+			wantOrg:      1,
+			wantErr:      err_c.ErrAccessDenied,
+		},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			r := newTestSessionService(clock)
+			s, _ := r.Start(context.Background(), 7)
+			ctx := r.WithSession(context.Background(), s, "Врач")
+
+			if _, err := r.SwitchDepartment(ctx, tt.departmentId); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SwitchDepartment() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			current, err := r.Check(context.Background(), s.SessionId)
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+			got, _ := session.GetSessionFromCtx(r.WithSession(context.Background(), current, "Врач"))
+			if got.OrganizationId != tt.wantOrg {
+				t.Errorf("OrganizationId = %d, want %d", got.OrganizationId, tt.wantOrg)
+			}
+		})
+	}
+
+	runner.Run(t, "Doctor without departments", func(t provider.T) {
+		r := newTestSessionService(clock)
+		s, _ := r.Start(context.Background(), 1)
+		if s.DepartmentId != nil || s.OrganizationId != nil {
+			t.Errorf("Start() = %v, want session without department", s)
+		}
+	})
+}
@@ -0,0 +1,89 @@
+package dto
+
+import (
+	"hospital/internal/models/validation"
+	alert_dto "hospital/internal/modules/domain/alert/dto"
+	"time"
+)
+
+// Kinds - события, о которых сообщается в чат бригады; закрепление пациента за врачом
+// касается только врача и в чат не попадает
+var Kinds = []string{alert_dto.KindDangerRaised, alert_dto.KindVitalsAbnormal}
+
+// WardChat - групповой чат бригады, привязанный к отделению и/или палатам
+type WardChat struct {
+	Id             int
+	OrganizationId int
+	ChatId         int64
+	Title          string
+	DepartmentId   *int
+	Rooms          []int
+	Language       string
+	CreatedBy      int
+	CreatedAt      time.Time
+}
+
+// Covers - относится ли к чату палата с номером roomNum из отделения departmentId
+func (w *WardChat) Covers(roomNum int, departmentId *int) bool {
+	if w.DepartmentId != nil && departmentId != nil && *w.DepartmentId == *departmentId {
+		return true
+	}
+	for _, num := range w.Rooms {
+		if num == roomNum {
+			return true
+		}
+	}
+	return false
+}
+
+type WardChats []*WardChat
+
+// BindWardChat - привязка группового чата; чат получает уведомления о пациентах
+// всех палат отделения DepartmentId и палат Rooms
+type BindWardChat struct {
+	ChatId       int64
+	Title        string
+	DepartmentId *int
+	Rooms        []int
+	Language     string
+}
+
+func (r *BindWardChat) Validate() error {
+	var v validation.Validator
+	v.CheckCode(r.DepartmentId != nil || len(r.Rooms) > 0, "Rooms", "Отделение или палаты", validation.CodeRequired)
+	if r.DepartmentId != nil {
+		v.Positive("DepartmentId", "Отделение", *r.DepartmentId)
+	}
+	for _, num := range r.Rooms {
+		v.Positive("Rooms", "Палата", num)
+	}
+	return v.Err()
+}
+
+// Notice - уведомление для чата бригады
+type Notice struct {
+	Id             int
+	OrganizationId int
+	ChatId         int64
+	Kind           string
+	Severity       string
+	PatientId      int
+	Params         map[string]string
+	Status         string
+	SentAt         *time.Time
+	Attempts       int
+	CreatedAt      time.Time
+	// Language - язык чата, на котором отправляется уведомление
+	Language string
+}
+
+type Notices []*Notice
+
+type CreateNotice struct {
+	OrganizationId int
+	ChatId         int64
+	Kind           string
+	Severity       string
+	PatientId      int
+	Params         map[string]string
+}
@@ -0,0 +1,28 @@
+package ward
+
+import (
+	"go.uber.org/fx"
+	alert_repo "hospital/internal/modules/domain/alert/repo"
+	"hospital/internal/modules/domain/ward/repo"
+	"hospital/internal/modules/domain/ward/service"
+)
+
+var (
+	Module = fx.Options(
+		service.Module,
+		repo.Module,
+
+		fx.Provide(
+			fx.Annotate(
+				func(r *repo.WardRepo) *repo.WardRepo { return r },
+				fx.As(new(service.IWardRepo)),
+			),
+			alert_repo.AsEventHandler(func(s *service.WardService) alert_repo.Raise { return s.Notify }),
+		),
+	)
+
+	Invokables = fx.Options(
+		service.Invokables,
+		repo.Invokables,
+	)
+)
@@ -0,0 +1,309 @@
+package repo
+
+import (
+	"context"
+	"hospital/internal/models/errors"
+	"hospital/internal/modules/db"
+	"hospital/internal/modules/db/ent"
+	"hospital/internal/modules/db/ent/predicate"
+	"hospital/internal/modules/db/ent/wardchat"
+	"hospital/internal/modules/db/ent/wardnotice"
+	alert_dto "hospital/internal/modules/domain/alert/dto"
+	"hospital/internal/modules/domain/ward/dto"
+	"time"
+)
+
+type WardRepo struct {
+	client *ent.Client
+}
+
+func NewWardRepo(client *ent.Client) *WardRepo {
+	return &WardRepo{
+		client: client,
+	}
+}
+
+func (r *WardRepo) GetByChat(ctx context.Context, chatId int64) (*dto.WardChat, error) {
+	model, err := r.client.WardChat.Query().
+		Where(wardchat.ChatIdEQ(chatId)).
+		Only(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToWardChatDTO(model), nil
+}
+
+// Bind создает или заменяет привязку группового чата
+func (r *WardRepo) Bind(ctx context.Context, dtm *dto.BindWardChat, createdBy int) (*dto.WardChat, error) {
+	update := r.client.WardChat.Update().
+		Where(wardchat.ChatIdEQ(dtm.ChatId)).
+		SetTitle(dtm.Title).
+		SetLanguage(dtm.Language).
+		SetCreatedBy(createdBy)
+	if dtm.DepartmentId != nil {
+		update.SetDepartmentId(*dtm.DepartmentId)
+	} else {
+		update.ClearDepartmentId()
+	}
+	if len(dtm.Rooms) > 0 {
+		update.SetRooms(dtm.Rooms)
+	} else {
+		update.ClearRooms()
+	}
+	n, err := update.Save(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+	if n > 0 {
+		return r.GetByChat(ctx, dtm.ChatId)
+	}
+
+	model, err := r.client.WardChat.Create().
+		SetChatId(dtm.ChatId).
+		SetTitle(dtm.Title).
+		SetNillableDepartmentId(dtm.DepartmentId).
+		SetRooms(dtm.Rooms).
+		SetLanguage(dtm.Language).
+		SetCreatedBy(createdBy).
+		Save(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToWardChatDTO(model), nil
+}
+
+// Unbind удаляет привязку чата вместе с недоставленными в него уведомлениями
+func (r *WardRepo) Unbind(ctx context.Context, chatId int64) error {
+	n, err := r.client.WardChat.Delete().
+		Where(wardchat.ChatIdEQ(chatId)).
+		Exec(ctx)
+	if err != nil {
+		return db.WrapError(err)
+	}
+	if n == 0 {
+		return errors.ErrDatabaseRecordNotFound
+	}
+
+	_, err = r.client.WardNotice.Delete().
+		Where(wardnotice.ChatIdEQ(chatId), wardnotice.StatusEQ(alert_dto.StatusPending)).
+		Exec(db.SystemContext(ctx))
+	if err != nil {
+		return db.WrapError(err)
+	}
+
+	return nil
+}
+
+// Covering возвращает чаты бригад, к которым относится палата пациента. Событие поднимает
+// пользователь, который может не видеть чаты, поэтому запрос выполняется от имени системы.
+func (r *WardRepo) Covering(ctx context.Context, patientId int) (dto.WardChats, error) {
+	ctx = db.SystemContext(ctx)
+	p, err := r.client.Patient.Get(ctx, patientId)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	// roomNumber пациента - ID его палаты, а чаты бригад хранят номера палат
+	var number int
+	var departmentId *int
+	roomModel, err := r.client.Room.Get(ctx, p.RoomNumber)
+	switch {
+	case err == nil:
+		number, departmentId = roomModel.Number, roomModel.DepartmentId
+	case !ent.IsNotFound(err):
+		return nil, db.WrapError(err)
+	}
+
+	models, err := r.client.WardChat.Query().
+		Where(sameOrganization[predicate.WardChat](p.OrganizationId, wardchat.OrganizationIdEQ, wardchat.OrganizationIdIsNil)).
+		All(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	var res dto.WardChats
+	for _, m := range models {
+		if chat := ToWardChatDTO(m); chat.Covers(number, departmentId) {
+			res = append(res, chat)
+		}
+	}
+
+	return res, nil
+}
+
+// sameOrganization - условие на организацию; записи без организации хранят в ней NULL
+func sameOrganization[P any](organizationId int, eq func(int) P, isNil func() P) P {
+	if organizationId == 0 {
+		return isNil()
+	}
+	return eq(organizationId)
+}
+
+// CreateNotices сохраняет сообщения для чатов палат; внутри транзакции изменения,
+// которое их подняло, - в ней же
+func (r *WardRepo) CreateNotices(ctx context.Context, dtms ...*dto.CreateNotice) error {
+	client := db.TxClient(ctx, r.client)
+	builders := make([]*ent.WardNoticeCreate, len(dtms))
+	for i, dtm := range dtms {
+		builders[i] = client.WardNotice.Create().
+			SetChatId(dtm.ChatId).
+			SetKind(dtm.Kind).
+			SetSeverity(dtm.Severity).
+			SetPatientId(dtm.PatientId).
+			SetParams(dtm.Params).
+			SetStatus(alert_dto.StatusPending)
+		if dtm.OrganizationId != 0 {
+			builders[i].SetOrganizationId(dtm.OrganizationId)
+		}
+	}
+
+	_, err := client.WardNotice.CreateBulk(builders...).Save(db.SystemContext(ctx))
+	if err != nil {
+		return db.WrapError(err)
+	}
+
+	return nil
+}
+
+// Claim забирает на отправку не больше limit неотправленных уведомлений и возвращает их
+// вместе с языком их чатов. Как и уведомления врачей, каждое уведомление достается одному
+// экземпляру бота и выдается снова после until, если бот упал, не успев его отправить.
+func (r *WardRepo) Claim(ctx context.Context, now, until time.Time, limit int) (dto.Notices, error) {
+	ctx = db.SystemContext(ctx)
+	due := wardnotice.Or(
+		wardnotice.StatusEQ(alert_dto.StatusPending),
+		wardnotice.And(wardnotice.StatusEQ(alert_dto.StatusSending), wardnotice.ClaimedUntilLTE(now)),
+	)
+	ids, err := r.client.WardNotice.Query().
+		Where(due).
+		Order(wardnotice.ByCreatedAt(), wardnotice.ByID()).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	claimed := make([]int, 0, len(ids))
+	for _, id := range ids {
+		n, err := r.client.WardNotice.Update().
+			Where(wardnotice.IDEQ(id), due).
+			SetStatus(alert_dto.StatusSending).
+			SetClaimedUntil(until).
+			Save(ctx)
+		if err != nil {
+			return nil, db.WrapError(err)
+		}
+		if n == 1 {
+			claimed = append(claimed, id)
+		}
+	}
+	if len(claimed) == 0 {
+		return nil, nil
+	}
+
+	models, err := r.client.WardNotice.Query().
+		Where(wardnotice.IDIn(claimed...)).
+		Order(wardnotice.ByCreatedAt(), wardnotice.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	chatIds := make([]int64, 0, len(models))
+	for _, m := range models {
+		chatIds = append(chatIds, m.ChatId)
+	}
+	chats, err := r.client.WardChat.Query().Where(wardchat.ChatIdIn(chatIds...)).All(ctx)
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+	languages := make(map[int64]string, len(chats))
+	for _, c := range chats {
+		languages[c.ChatId] = c.Language
+	}
+
+	res := make(dto.Notices, 0, len(models))
+	for _, m := range models {
+		notice := ToNoticeDTO(m)
+		notice.Language = languages[m.ChatId]
+		res = append(res, notice)
+	}
+
+	return res, nil
+}
+
+func (r *WardRepo) MarkSent(ctx context.Context, id int, at time.Time) error {
+	err := r.client.WardNotice.UpdateOneID(id).
+		SetStatus(alert_dto.StatusSent).
+		SetSentAt(at).
+		ClearClaimedUntil().
+		AddAttempts(1).
+		Exec(db.SystemContext(ctx))
+	if err != nil {
+		return db.WrapError(err)
+	}
+
+	return nil
+}
+
+// MarkFailed учитывает неудачную попытку доставки и возвращает уведомление в очередь;
+// после attempts попыток уведомление больше не отправляется
+func (r *WardRepo) MarkFailed(ctx context.Context, id int, attempts int) error {
+	ctx = db.SystemContext(ctx)
+	model, err := r.client.WardNotice.UpdateOneID(id).
+		AddAttempts(1).
+		ClearClaimedUntil().
+		Save(ctx)
+	if err != nil {
+		return db.WrapError(err)
+	}
+
+	status := alert_dto.StatusPending
+	if model.Attempts >= attempts {
+		status = alert_dto.StatusFailed
+	}
+	err = r.client.WardNotice.UpdateOneID(id).SetStatus(status).Exec(ctx)
+	if err != nil {
+		return db.WrapError(err)
+	}
+
+	return nil
+}
+
+func ToWardChatDTO(model *ent.WardChat) *dto.WardChat {
+	if model == nil {
+		return nil
+	}
+	return &dto.WardChat{
+		Id:             model.ID,
+		OrganizationId: model.OrganizationId,
+		ChatId:         model.ChatId,
+		Title:          model.Title,
+		DepartmentId:   model.DepartmentId,
+		Rooms:          model.Rooms,
+		Language:       model.Language,
+		CreatedBy:      model.CreatedBy,
+		CreatedAt:      model.CreatedAt,
+	}
+}
+
+func ToNoticeDTO(model *ent.WardNotice) *dto.Notice {
+	if model == nil {
+		return nil
+	}
+	return &dto.Notice{
+		Id:             model.ID,
+		OrganizationId: model.OrganizationId,
+		ChatId:         model.ChatId,
+		Kind:           model.Kind,
+		Severity:       model.Severity,
+		PatientId:      model.PatientId,
+		Params:         model.Params,
+		Status:         model.Status,
+		SentAt:         model.SentAt,
+		Attempts:       model.Attempts,
+		CreatedAt:      model.CreatedAt,
+	}
+}
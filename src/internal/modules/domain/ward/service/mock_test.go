@@ -0,0 +1,158 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: hospital/internal/modules/domain/ward/service (interfaces: IWardRepo)
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	dto "hospital/internal/modules/domain/ward/dto"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIWardRepo is a mock of IWardRepo interface.
+type MockIWardRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockIWardRepoMockRecorder
+}
+
+// MockIWardRepoMockRecorder is the mock recorder for MockIWardRepo.
+type MockIWardRepoMockRecorder struct {
+	mock *MockIWardRepo
+}
+
+// NewMockIWardRepo creates a new mock instance.
+func NewMockIWardRepo(ctrl *gomock.Controller) *MockIWardRepo {
+	mock := &MockIWardRepo{ctrl: ctrl}
+	mock.recorder = &MockIWardRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIWardRepo) EXPECT() *MockIWardRepoMockRecorder {
+	return m.recorder
+}
+
+// Bind mocks base method.
+func (m *MockIWardRepo) Bind(arg0 context.Context, arg1 *dto.BindWardChat, arg2 int) (*dto.WardChat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Bind", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dto.WardChat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Bind indicates an expected call of Bind.
+func (mr *MockIWardRepoMockRecorder) Bind(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bind", reflect.TypeOf((*MockIWardRepo)(nil).Bind), arg0, arg1, arg2)
+}
+
+// Claim mocks base method.
+func (m *MockIWardRepo) Claim(arg0 context.Context, arg1, arg2 time.Time, arg3 int) (dto.Notices, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(dto.Notices)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockIWardRepoMockRecorder) Claim(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockIWardRepo)(nil).Claim), arg0, arg1, arg2, arg3)
+}
+
+// Covering mocks base method.
+func (m *MockIWardRepo) Covering(arg0 context.Context, arg1 int) (dto.WardChats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Covering", arg0, arg1)
+	ret0, _ := ret[0].(dto.WardChats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Covering indicates an expected call of Covering.
+func (mr *MockIWardRepoMockRecorder) Covering(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Covering", reflect.TypeOf((*MockIWardRepo)(nil).Covering), arg0, arg1)
+}
+
+// CreateNotices mocks base method.
+func (m *MockIWardRepo) CreateNotices(arg0 context.Context, arg1 ...*dto.CreateNotice) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateNotices", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNotices indicates an expected call of CreateNotices.
+func (mr *MockIWardRepoMockRecorder) CreateNotices(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotices", reflect.TypeOf((*MockIWardRepo)(nil).CreateNotices), varargs...)
+}
+
+// GetByChat mocks base method.
+func (m *MockIWardRepo) GetByChat(arg0 context.Context, arg1 int64) (*dto.WardChat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByChat", arg0, arg1)
+	ret0, _ := ret[0].(*dto.WardChat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByChat indicates an expected call of GetByChat.
+func (mr *MockIWardRepoMockRecorder) GetByChat(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByChat", reflect.TypeOf((*MockIWardRepo)(nil).GetByChat), arg0, arg1)
+}
+
+// MarkFailed mocks base method.
+func (m *MockIWardRepo) MarkFailed(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkFailed", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkFailed indicates an expected call of MarkFailed.
+func (mr *MockIWardRepoMockRecorder) MarkFailed(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFailed", reflect.TypeOf((*MockIWardRepo)(nil).MarkFailed), arg0, arg1, arg2)
+}
+
+// MarkSent mocks base method.
+func (m *MockIWardRepo) MarkSent(arg0 context.Context, arg1 int, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkSent indicates an expected call of MarkSent.
+func (mr *MockIWardRepoMockRecorder) MarkSent(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSent", reflect.TypeOf((*MockIWardRepo)(nil).MarkSent), arg0, arg1, arg2)
+}
+
+// Unbind mocks base method.
+func (m *MockIWardRepo) Unbind(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unbind", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unbind indicates an expected call of Unbind.
+func (mr *MockIWardRepoMockRecorder) Unbind(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unbind", reflect.TypeOf((*MockIWardRepo)(nil).Unbind), arg0, arg1)
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"hospital/internal/models/errors"
+	"hospital/internal/models/role"
+	"hospital/internal/models/session"
+	"hospital/internal/modules/config"
+	alert_dto "hospital/internal/modules/domain/alert/dto"
+	"hospital/internal/modules/domain/ward/dto"
+	"time"
+)
+
+//go:generate mockgen -destination mock_test.go -package service . IWardRepo
+
+type IWardRepo interface {
+	GetByChat(ctx context.Context, chatId int64) (*dto.WardChat, error)
+	Bind(ctx context.Context, dtm *dto.BindWardChat, createdBy int) (*dto.WardChat, error)
+	Unbind(ctx context.Context, chatId int64) error
+	Covering(ctx context.Context, patientId int) (dto.WardChats, error)
+	CreateNotices(ctx context.Context, dtms ...*dto.CreateNotice) error
+	Claim(ctx context.Context, now, until time.Time, limit int) (dto.Notices, error)
+	MarkSent(ctx context.Context, id int, at time.Time) error
+	MarkFailed(ctx context.Context, id int, attempts int) error
+}
+
+// WardService ведет групповые чаты бригад: привязку чата к отделению или палатам
+// и уведомления о пациентах этих палат
+type WardService struct {
+	repo        IWardRepo
+	maxAttempts int
+	// claimTimeout - через сколько забранное, но не отправленное уведомление выдается снова
+	claimTimeout time.Duration
+	now          func() time.Time
+}
+
+func NewWardService(repo IWardRepo, config config.Config) *WardService {
+	return &WardService{
+		repo:         repo,
+		maxAttempts:  config.AlertMaxAttempts,
+		claimTimeout: config.AlertClaimTimeout,
+		now:          time.Now,
+	}
+}
+
+// Get возвращает привязку группового чата
+func (r *WardService) Get(ctx context.Context, chatId int64) (*dto.WardChat, error) {
+	return r.repo.GetByChat(ctx, chatId)
+}
+
+// Bind привязывает групповой чат к отделению и/или палатам, доступно только администраторам
+func (r *WardService) Bind(ctx context.Context, dtm *dto.BindWardChat) (*dto.WardChat, error) {
+	s, err := checkAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = dtm.Validate(); err != nil {
+		return nil, err
+	}
+	return r.repo.Bind(ctx, dtm, s.UserId)
+}
+
+// Unbind отвязывает групповой чат, доступно только администраторам
+func (r *WardService) Unbind(ctx context.Context, chatId int64) error {
+	if _, err := checkAdmin(ctx); err != nil {
+		return err
+	}
+	return r.repo.Unbind(ctx, chatId)
+}
+
+// Forget отвязывает чат, из которого удалили бота; вызывается системой с правами системы
+func (r *WardService) Forget(ctx context.Context, chatId int64) error {
+	err := r.repo.Unbind(ctx, chatId)
+	if err == errors.ErrDatabaseRecordNotFound {
+		return nil
+	}
+	return err
+}
+
+// Notify ставит в очередь уведомления о событии в чаты бригад, к которым относится палата пациента
+func (r *WardService) Notify(ctx context.Context, event *alert_dto.Event) error {
+	if !wardKind(event.Kind) {
+		return nil
+	}
+
+	chats, err := r.repo.Covering(ctx, event.PatientId)
+	if err != nil {
+		return err
+	}
+	if len(chats) == 0 {
+		return nil
+	}
+
+	notices := make([]*dto.CreateNotice, len(chats))
+	for i, chat := range chats {
+		notices[i] = &dto.CreateNotice{
+			OrganizationId: chat.OrganizationId,
+			ChatId:         chat.ChatId,
+			Kind:           event.Kind,
+			Severity:       event.Severity,
+			PatientId:      event.PatientId,
+			Params:         event.Params,
+		}
+	}
+	return r.repo.CreateNotices(ctx, notices...)
+}
+
+// Due забирает на отправку уведомления для чатов бригад; каждое уведомление достается
+// одному экземпляру бота, а не отправленные за ALERT_CLAIM_TIMEOUT выдаются снова
+func (r *WardService) Due(ctx context.Context, limit int) (dto.Notices, error) {
+	now := r.now()
+	return r.repo.Claim(ctx, now, now.Add(r.claimTimeout), limit)
+}
+
+func (r *WardService) MarkSent(ctx context.Context, id int) error {
+	return r.repo.MarkSent(ctx, id, r.now())
+}
+
+// MarkFailed учитывает неудачную доставку; после ALERT_MAX_ATTEMPTS попыток уведомление снимается с доставки
+func (r *WardService) MarkFailed(ctx context.Context, id int) error {
+	return r.repo.MarkFailed(ctx, id, r.maxAttempts)
+}
+
+func wardKind(kind string) bool {
+	for _, k := range dto.Kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func checkAdmin(ctx context.Context) (session.Session, error) {
+	s, ok := session.GetSessionFromCtx(ctx)
+	if !ok {
+		return s, errors.ErrUnauthorized
+	}
+	if !role.IsAdmin(s.Role) {
+		return s, errors.ErrAccessDenied
+	}
+	return s, nil
+}
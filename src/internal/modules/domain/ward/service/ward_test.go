@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	err_c "hospital/internal/models/errors"
+	"hospital/internal/models/role"
+	"hospital/internal/models/session"
+	"hospital/internal/modules/config"
+	alert_dto "hospital/internal/modules/domain/alert/dto"
+	"hospital/internal/modules/domain/ward/dto"
+	"testing"
+	"time"
+)
+
+var testConfig = config.Config{AlertMaxAttempts: 3, AlertClaimTimeout: 5 * time.Minute}
+
+func TestWardService_Bind(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockIWardRepo(ctrl)
+	adminCtx := session.SetSessionToCtx(context.Background(), session.Session{UserId: 1, Role: role.Admin})
+	doctorCtx := session.SetSessionToCtx(context.Background(), session.Session{UserId: 2, Role: role.Doctor})
+	departmentId := 3
+
+	rooms := &dto.BindWardChat{ChatId: -100, Rooms: []int{101, 102}}
+	mockRepo.EXPECT().Bind(gomock.Any(), rooms, 1).Return(&dto.WardChat{ChatId: -100, Rooms: []int{101, 102}}, nil)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		dtm     *dto.BindWardChat
+		wantErr error
+	}{
+		{
+			name:    "Anonymous user is unauthorized",
+			ctx:     context.Background(),
+			dtm:     &dto.BindWardChat{ChatId: -100, DepartmentId: &departmentId},
+			wantErr: err_c.ErrUnauthorized,
+		},
+		{
+			name:    "Doctor cannot bind a group",
+			ctx:     doctorCtx,
+			dtm:     &dto.BindWardChat{ChatId: -100, DepartmentId: &departmentId},
+			wantErr: err_c.ErrAccessDenied,
+		},
+		{
+			name:    "Binding without department and rooms is rejected",
+			ctx:     adminCtx,
+			dtm:     &dto.BindWardChat{ChatId: -100},
+			wantErr: err_c.ErrValidation,
+		},
+		{
+			name: "Admin binds a group to rooms",
+			ctx:  adminCtx,
+			dtm:  rooms,
+		},
+	}
+	for _, tt := range tests {
+		runner.Run(t, tt.name, func(t provider.T) {
+			r := NewWardService(mockRepo, testConfig)
+			_, err := r.Bind(tt.ctx, tt.dtm)
+			if tt.wantErr == nil && err != nil || tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("Bind() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWardService_Forget(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockIWardRepo(ctrl)
+	mockRepo.EXPECT().Unbind(gomock.Any(), int64(-100)).Return(err_c.ErrDatabaseRecordNotFound)
+
+	runner.Run(t, "Forgetting an unbound chat is not an error", func(t provider.T) {
+		r := NewWardService(mockRepo, testConfig)
+		if err := r.Forget(context.Background(), -100); err != nil {
+			t.Errorf("Forget() error = %v", err)
+		}
+	})
+}
+
+func TestWardService_Notify(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockIWardRepo(ctrl)
+	ctx := context.Background()
+	params := map[string]string{alert_dto.ParamPatient: "Иванов Иван"}
+
+	tests := []struct {
+		name    string
+		event   *alert_dto.Event
+		prepare func()
+	}{
+		{
+			name:  "Assignment is not posted to ward chats",
+			event: &alert_dto.Event{Kind: alert_dto.KindPatientAssigned, PatientId: 7, DoctorIds: []int{3}},
+		},
+		{
+			name:  "Nothing is queued when no chat covers the room",
+			event: &alert_dto.Event{Kind: alert_dto.KindDangerRaised, Severity: alert_dto.SeverityWarning, PatientId: 8},
+			prepare: func() {
+				mockRepo.EXPECT().Covering(gomock.Any(), 8).Return(nil, nil)
+			},
+		},
+		{
+			name:  "Notice is queued for every covering chat",
+			event: &alert_dto.Event{Kind: alert_dto.KindVitalsAbnormal, Severity: alert_dto.SeverityCritical, PatientId: 9, Params: params},
+			prepare: func() {
+				mockRepo.EXPECT().Covering(gomock.Any(), 9).Return(dto.WardChats{
+					{OrganizationId: 2, ChatId: -100},
+					{OrganizationId: 2, ChatId: -200},
+				}, nil)
+				mockRepo.EXPECT().CreateNotices(gomock.Any(),
+					&dto.CreateNotice{OrganizationId: 2, ChatId: -100, Kind: alert_dto.KindVitalsAbnormal, Severity: alert_dto.SeverityCritical, PatientId: 9, Params: params},
+					&dto.CreateNotice{OrganizationId: 2, ChatId: -200, Kind: alert_dto.KindVitalsAbnormal, Severity: alert_dto.SeverityCritical, PatientId: 9, Params: params},
+				).Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		runner.Run(t, tt.name, func(t provider.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			r := NewWardService(mockRepo, testConfig)
+			if err := r.Notify(ctx, tt.event); err != nil {
+				t.Errorf("Notify() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestWardService_Due(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockIWardRepo(ctrl)
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	mockRepo.EXPECT().Claim(gomock.Any(), now, now.Add(5*time.Minute), 50).Return(dto.Notices{{Id: 1}}, nil)
+
+	runner.Run(t, "Notices are claimed until the claim timeout", func(t provider.T) {
+		r := NewWardService(mockRepo, testConfig)
+		r.now = func() time.Time { return now }
+		notices, err := r.Due(context.Background(), 50)
+		if err != nil || len(notices) != 1 {
+			t.Errorf("Due() = %v, %v", notices, err)
+		}
+	})
+}
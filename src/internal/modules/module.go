@@ -10,6 +10,7 @@ import (
 	"hospital/internal/modules/domain"
 	"hospital/internal/modules/logger"
 	"hospital/internal/modules/metrics"
+	"hospital/internal/modules/scheduler"
 	"hospital/internal/modules/view/telegram"
 )
 
@@ -23,6 +24,7 @@ var (
 		domain.Module,
 		telegram.Module,
 		metrics.Module,
+		scheduler.Module,
 
 		fx.WithLogger(func(log *zap.Logger) fxevent.Logger {
 			return &fxevent.ZapLogger{Logger: log}
@@ -38,5 +40,7 @@ var (
 		domain.Invokables,
 		metrics.Invokables,
 		telegram.Invokables,
+		// Планировщик останавливается раньше бота: его задачи отправляют сообщения через бота
+		scheduler.Invokables,
 	)
 )
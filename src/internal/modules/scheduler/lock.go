@@ -0,0 +1,69 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"go.uber.org/fx"
+	"hash/fnv"
+	"hospital/internal/modules/config"
+)
+
+// AdvisoryLocker не дает нескольким экземплярам приложения выполнять одну задачу одновременно.
+// Используются сессионные advisory-блокировки Postgres: блокировка держится на отдельном
+// соединении и снимается сама, если экземпляр упал вместе с соединением.
+type AdvisoryLocker struct {
+	db *sql.DB
+}
+
+func NewAdvisoryLocker(cfg config.Config, lifecycle fx.Lifecycle) (*AdvisoryLocker, error) {
+	if cfg.DBDriver != "postgres" {
+		return nil, fmt.Errorf("блокировки задач поддерживаются только для postgres, DB_DRIVER=%s", cfg.DBDriver)
+	}
+	// Соединения основного клиента возвращаются в пул после каждого запроса,
+	// поэтому для блокировок нужен свой пул
+	db, err := sql.Open(cfg.DBDriver, cfg.DBConnection)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при подключении к БД: %w", err)
+	}
+
+	lifecycle.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			return db.Close()
+		},
+	})
+
+	return &AdvisoryLocker{db: db}, nil
+}
+
+// TryLock захватывает блокировку задачи name без ожидания. Если задачу уже выполняет
+// другой экземпляр, возвращает false. Освобождать блокировку нужно вызовом unlock.
+func (r *AdvisoryLocker) TryLock(ctx context.Context, name string) (unlock func(), ok bool, err error) {
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	key := lockKey(name)
+	if err = conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", key).Scan(&ok); err != nil || !ok {
+		_ = conn.Close()
+		return nil, false, err
+	}
+
+	return func() {
+		// Контекст задачи к этому моменту может быть отменен, а блокировку снять нужно
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", key); err != nil {
+			// Соединение с неснятой блокировкой нельзя возвращать в пул: закрываем его совсем
+			_ = conn.Raw(func(any) error { return driver.ErrBadConn })
+		}
+		_ = conn.Close()
+	}, true, nil
+}
+
+// lockKey - ключ advisory-блокировки задачи
+func lockKey(name string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte("scheduler:" + name))
+	return int64(h.Sum64())
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: hospital/internal/modules/scheduler (interfaces: IStateStore,ILocker)
+
+// Package scheduler is a generated GoMock package.
+package scheduler
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIStateStore is a mock of IStateStore interface.
+type MockIStateStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockIStateStoreMockRecorder
+}
+
+// MockIStateStoreMockRecorder is the mock recorder for MockIStateStore.
+type MockIStateStoreMockRecorder struct {
+	mock *MockIStateStore
+}
+
+// NewMockIStateStore creates a new mock instance.
+func NewMockIStateStore(ctrl *gomock.Controller) *MockIStateStore {
+	mock := &MockIStateStore{ctrl: ctrl}
+	mock.recorder = &MockIStateStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIStateStore) EXPECT() *MockIStateStoreMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockIStateStore) Get(arg0 context.Context, arg1 string) (*State, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*State)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockIStateStoreMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIStateStore)(nil).Get), arg0, arg1)
+}
+
+// Save mocks base method.
+func (m *MockIStateStore) Save(arg0 context.Context, arg1 *State) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIStateStoreMockRecorder) Save(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIStateStore)(nil).Save), arg0, arg1)
+}
+
+// MockILocker is a mock of ILocker interface.
+type MockILocker struct {
+	ctrl     *gomock.Controller
+	recorder *MockILockerMockRecorder
+}
+
+// MockILockerMockRecorder is the mock recorder for MockILocker.
+type MockILockerMockRecorder struct {
+	mock *MockILocker
+}
+
+// NewMockILocker creates a new mock instance.
+func NewMockILocker(ctrl *gomock.Controller) *MockILocker {
+	mock := &MockILocker{ctrl: ctrl}
+	mock.recorder = &MockILockerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockILocker) EXPECT() *MockILockerMockRecorder {
+	return m.recorder
+}
+
+// TryLock mocks base method.
+func (m *MockILocker) TryLock(arg0 context.Context, arg1 string) (func(), bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TryLock", arg0, arg1)
+	ret0, _ := ret[0].(func())
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// TryLock indicates an expected call of TryLock.
+func (mr *MockILockerMockRecorder) TryLock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TryLock", reflect.TypeOf((*MockILocker)(nil).TryLock), arg0, arg1)
+}
@@ -0,0 +1,38 @@
+package scheduler
+
+import (
+	"context"
+	"go.uber.org/fx"
+)
+
+var (
+	Module = fx.Provide(
+		NewScheduler,
+		NewStateStore,
+		NewAdvisoryLocker,
+		fx.Annotate(
+			func(r *StateStore) *StateStore { return r },
+			fx.As(new(IStateStore)),
+		),
+		fx.Annotate(
+			func(r *AdvisoryLocker) *AdvisoryLocker { return r },
+			fx.As(new(ILocker)),
+		),
+	)
+	Invokables = fx.Invoke(InvokeScheduler)
+)
+
+func InvokeScheduler(s *Scheduler, lifecycle fx.Lifecycle) {
+	ctx, cancel := context.WithCancel(context.Background())
+	lifecycle.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			s.Start(ctx)
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			cancel()
+			s.Wait()
+			return nil
+		},
+	})
+}
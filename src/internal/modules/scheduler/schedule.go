@@ -0,0 +1,194 @@
+package scheduler
+
+import (
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule - расписание задачи
+type Schedule interface {
+	// Next возвращает ближайшее время запуска строго после t
+	Next(t time.Time) time.Time
+}
+
+// ParseSchedule разбирает расписание в формате cron из пяти полей
+// (минута, час, день месяца, месяц, день недели), например "30 9 * * 1-5",
+// или одно из сокращений: @hourly, @daily, @weekly, @monthly и @every <длительность>
+func ParseSchedule(spec string, location *time.Location) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if d, ok := strings.CutPrefix(spec, "@every "); ok {
+		interval, err := time.ParseDuration(strings.TrimSpace(d))
+		if err != nil {
+			return nil, fmt.Errorf("расписание %q: %w", spec, err)
+		}
+		if interval < time.Minute {
+			return nil, fmt.Errorf("расписание %q: интервал меньше минуты", spec)
+		}
+		return every(interval), nil
+	}
+	if expanded, ok := descriptors[spec]; ok {
+		spec = expanded
+	}
+
+	parts := strings.Fields(spec)
+	if len(parts) != len(cronFields) {
+		return nil, fmt.Errorf("расписание %q: нужно %d полей", spec, len(cronFields))
+	}
+	s := &cron{location: location}
+	sets := []*uint64{&s.minute, &s.hour, &s.dom, &s.month, &s.dow}
+	for i, part := range parts {
+		set, err := cronFields[i].parse(part)
+		if err != nil {
+			return nil, fmt.Errorf("расписание %q: %w", spec, err)
+		}
+		*sets[i] = set
+	}
+	// В cron воскресенье можно записать и как 0, и как 7
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	s.anyDom = parts[2] == "*"
+	s.anyDow = parts[4] == "*"
+	return s, nil
+}
+
+var descriptors = map[string]string{
+	"@hourly":  "0 * * * *",
+	"@daily":   "0 0 * * *",
+	"@weekly":  "0 0 * * 0",
+	"@monthly": "0 0 1 * *",
+}
+
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var cronFields = []cronField{
+	{name: "минута", min: 0, max: 59},
+	{name: "час", min: 0, max: 23},
+	{name: "день месяца", min: 1, max: 31},
+	{name: "месяц", min: 1, max: 12},
+	{name: "день недели", min: 0, max: 7},
+}
+
+// parse разбирает поле из списка через запятую: *, n, a-b и шаг /k у любого из них
+func (f cronField) parse(s string) (uint64, error) {
+	var set uint64
+	for _, item := range strings.Split(s, ",") {
+		rng, step := item, 1
+		if i := strings.IndexByte(item, '/'); i >= 0 {
+			var err error
+			rng = item[:i]
+			step, err = strconv.Atoi(item[i+1:])
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("%s: неверный шаг %q", f.name, item)
+			}
+		}
+
+		from, to := f.min, f.max
+		switch {
+		case rng == "*":
+		case strings.Contains(rng, "-"):
+			bounds := strings.SplitN(rng, "-", 2)
+			var err error
+			if from, err = f.value(bounds[0]); err != nil {
+				return 0, err
+			}
+			if to, err = f.value(bounds[1]); err != nil {
+				return 0, err
+			}
+			if from > to {
+				return 0, fmt.Errorf("%s: неверный диапазон %q", f.name, rng)
+			}
+		default:
+			v, err := f.value(rng)
+			if err != nil {
+				return 0, err
+			}
+			from = v
+			if step == 1 {
+				to = v
+			}
+		}
+
+		for v := from; v <= to; v += step {
+			set |= 1 << v
+		}
+	}
+	return set, nil
+}
+
+func (f cronField) value(s string) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("%s: значение %q вне диапазона %d-%d", f.name, s, f.min, f.max)
+	}
+	return v, nil
+}
+
+// cron - расписание в виде множеств допустимых значений каждого поля
+type cron struct {
+	minute, hour, dom, month, dow uint64
+	// Если ограничены и день месяца, и день недели, подходит любой из них
+	anyDom, anyDow bool
+	location       *time.Location
+}
+
+// cronHorizon - дальше этого срока расписание без подходящих дат (например, 30 февраля) не ищется
+const cronHorizon = 5 * 366 * 24 * time.Hour
+
+func (s *cron) Next(t time.Time) time.Time {
+	if s.location != nil {
+		t = t.In(s.location)
+	}
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(cronHorizon)
+
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			// Переход на следующую подходящую минуту того же часа или на следующий час
+			rest := s.minute >> uint(t.Minute())
+			if rest == 0 {
+				t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			} else {
+				t = t.Add(time.Duration(bits.TrailingZeros64(rest)) * time.Minute)
+			}
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (s *cron) dayMatches(t time.Time) bool {
+	dom := s.dom&(1<<uint(t.Day())) != 0
+	dow := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.anyDom || s.anyDow {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// every - запуск через равные промежутки, отсчитанные от предыдущего запуска
+type every time.Duration
+
+func (s every) Next(t time.Time) time.Time {
+	return t.Add(time.Duration(s))
+}
@@ -0,0 +1,67 @@
+package scheduler
+
+import (
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"testing"
+	"time"
+)
+
+func TestParseSchedule(t *testing.T) {
+	moscow := time.FixedZone("MSK", 3*60*60)
+	// Воскресенье, 1 октября 2023, 12:34 по Москве
+	from := time.Date(2023, 10, 1, 12, 34, 20, 0, moscow)
+
+	tests := []struct {
+		name string
+		spec string
+		want time.Time
+	}{
+		{name: "Every minute", spec: "* * * * *", want: time.Date(2023, 10, 1, 12, 35, 0, 0, moscow)},
+		{name: "Step of minutes", spec: "*/15 * * * *", want: time.Date(2023, 10, 1, 12, 45, 0, 0, moscow)},
+		{name: "List of hours", spec: "0 9,18 * * *", want: time.Date(2023, 10, 1, 18, 0, 0, 0, moscow)},
+		{name: "Working days", spec: "30 9 * * 1-5", want: time.Date(2023, 10, 2, 9, 30, 0, 0, moscow)},
+		{name: "Sunday as 7", spec: "0 13 * * 7", want: time.Date(2023, 10, 1, 13, 0, 0, 0, moscow)},
+		{name: "Day of month or day of week", spec: "0 0 15 * 3", want: time.Date(2023, 10, 4, 0, 0, 0, 0, moscow)},
+		{name: "Next month", spec: "0 0 1 * *", want: time.Date(2023, 11, 1, 0, 0, 0, 0, moscow)},
+		{name: "Leap day", spec: "0 0 29 2 *", want: time.Date(2024, 2, 29, 0, 0, 0, 0, moscow)},
+		{name: "Descriptor", spec: "@daily", want: time.Date(2023, 10, 2, 0, 0, 0, 0, moscow)},
+		{name: "Interval", spec: "@every 90m", want: from.Add(90 * time.Minute)},
+	}
+
+	for _, tt := range tests {
+		runner.Run(t, tt.name, func(t provider.T) {
+			s, err := ParseSchedule(tt.spec, moscow)
+			if err != nil {
+				t.Fatalf("ParseSchedule() error = %v", err)
+			}
+			if got := s.Next(from); !got.Equal(tt.want) {
+				t.Errorf("Next() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSchedule_Invalid(t *testing.T) {
+	specs := []string{"", "* * * *", "60 * * * *", "* 24 * * *", "5-1 * * * *", "*/0 * * * *", "@every 10s", "@yearly"}
+
+	for _, spec := range specs {
+		runner.Run(t, "Invalid schedule "+spec, func(t provider.T) {
+			if _, err := ParseSchedule(spec, time.UTC); err == nil {
+				t.Errorf("ParseSchedule(%q) error = nil", spec)
+			}
+		})
+	}
+}
+
+func TestSchedule_Impossible(t *testing.T) {
+	runner.Run(t, "Schedule without matching dates has no next run", func(t provider.T) {
+		s, err := ParseSchedule("0 0 30 2 *", time.UTC)
+		if err != nil {
+			t.Fatalf("ParseSchedule() error = %v", err)
+		}
+		if got := s.Next(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)); !got.IsZero() {
+			t.Errorf("Next() got = %v, want zero time", got)
+		}
+	})
+}
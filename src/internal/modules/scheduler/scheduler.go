@@ -0,0 +1,279 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"hospital/internal/modules/config"
+	"sync"
+	"time"
+)
+
+//go:generate mockgen -destination mock_test.go -package scheduler . IStateStore,ILocker
+
+var (
+	jobRuns = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "scheduler_job_runs_total",
+		Help: "Запуски фоновых задач по результату: ok, failed",
+	}, []string{"job", "result"})
+	jobSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "scheduler_job_seconds",
+		Help:    "Время выполнения фоновой задачи вместе с повторами",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"job"})
+)
+
+type IStateStore interface {
+	Get(ctx context.Context, name string) (*State, error)
+	Save(ctx context.Context, dtm *State) error
+}
+
+type ILocker interface {
+	TryLock(ctx context.Context, name string) (unlock func(), ok bool, err error)
+}
+
+// Job - фоновая задача. Модули добавляют задачи в группу "jobs" через AsJob.
+type Job struct {
+	// Name - уникальное имя задачи, по нему хранится состояние и берется блокировка
+	Name string
+	// Spec - расписание, см. ParseSchedule
+	Spec string
+	// Every - интервал между запусками вместо Spec для частых задач, например доставки
+	// уведомлений: в расписании интервал не может быть меньше минуты
+	Every time.Duration
+	// Run выполняет задачу; ошибка приводит к повтору с увеличивающейся паузой
+	Run func(ctx context.Context) error
+}
+
+// AsJob регистрирует конструктор задачи в группе "jobs"
+func AsJob(constructor any) any {
+	return fx.Annotate(constructor, fx.ResultTags(`group:"jobs"`))
+}
+
+type job struct {
+	Job
+	schedule Schedule
+}
+
+// Scheduler запускает задачи по расписанию. Задача выполняется на одном экземпляре
+// приложения: остальные пропускают запуск, увидев блокировку или уже сохраненный запуск.
+type Scheduler struct {
+	jobs    []*job
+	store   IStateStore
+	locker  ILocker
+	retries int
+	backoff time.Duration
+	logger  *zap.Logger
+	now     func() time.Time
+
+	wg sync.WaitGroup
+}
+
+type Params struct {
+	fx.In
+
+	Jobs   []Job `group:"jobs"`
+	Store  IStateStore
+	Locker ILocker
+	Config config.Config
+	Logger *zap.Logger
+}
+
+func NewScheduler(p Params) (*Scheduler, error) {
+	location, err := time.LoadLocation(p.Config.SchedulerTimezone)
+	if err != nil {
+		return nil, fmt.Errorf("scheduler timezone: %w", err)
+	}
+
+	s := &Scheduler{
+		store:   p.Store,
+		locker:  p.Locker,
+		retries: p.Config.SchedulerRetries,
+		backoff: p.Config.SchedulerRetryBackoff,
+		logger:  p.Logger,
+		now:     time.Now,
+	}
+	names := map[string]bool{}
+	for _, j := range p.Jobs {
+		if names[j.Name] {
+			return nil, fmt.Errorf("задача %s зарегистрирована дважды", j.Name)
+		}
+		names[j.Name] = true
+
+		var schedule Schedule = every(j.Every)
+		if j.Every <= 0 {
+			schedule, err = ParseSchedule(j.Spec, location)
+			if err != nil {
+				return nil, fmt.Errorf("задача %s: %w", j.Name, err)
+			}
+		}
+		s.jobs = append(s.jobs, &job{Job: j, schedule: schedule})
+	}
+	return s, nil
+}
+
+// Start запускает задачи; они выполняются, пока не отменен ctx. Wait дожидается их остановки.
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, j := range s.jobs {
+		s.wg.Add(1)
+		go func(j *job) {
+			defer s.wg.Done()
+			s.loop(ctx, j)
+		}(j)
+	}
+}
+
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+// stateRetry - через сколько повторить, если состояние задачи не удалось прочитать
+const stateRetry = time.Minute
+
+func (s *Scheduler) loop(ctx context.Context, j *job) {
+	// last - последний запуск, который этот экземпляр выполнил или пропустил
+	var last time.Time
+	for {
+		state, err := s.store.Get(ctx, j.Name)
+		if err != nil {
+			s.logger.Error("failed to load job state", zap.String("job", j.Name), zap.Error(err))
+			if !sleep(ctx, stateRetry) {
+				return
+			}
+			continue
+		}
+
+		at := s.next(j, state, last)
+		if at.IsZero() {
+			s.logger.Error("job schedule has no upcoming runs", zap.String("job", j.Name), zap.String("spec", j.Spec))
+			return
+		}
+		if !sleep(ctx, at.Sub(s.now())) {
+			return
+		}
+		s.runOnce(ctx, j, at)
+		last = at
+	}
+}
+
+// next - время следующего запуска. Запуски, пропущенные, пока приложение не работало,
+// сливаются в один: сразу выполняется последний из них.
+func (s *Scheduler) next(j *job, state *State, last time.Time) time.Time {
+	now := s.now()
+	after := last
+	if state != nil && state.LastRunAt != nil && state.LastRunAt.After(after) {
+		after = *state.LastRunAt
+	}
+	if after.IsZero() {
+		after = now
+	}
+
+	at := j.schedule.Next(after)
+	for !at.IsZero() && at.Before(now) {
+		following := j.schedule.Next(at)
+		if following.IsZero() || following.After(now) {
+			break
+		}
+		at = following
+	}
+	return at
+}
+
+// runOnce выполняет запуск задачи, запланированный на at, если его еще не выполнил другой экземпляр
+func (s *Scheduler) runOnce(ctx context.Context, j *job, at time.Time) {
+	log := s.logger.With(zap.String("job", j.Name), zap.Time("scheduled", at))
+
+	unlock, ok, err := s.locker.TryLock(ctx, j.Name)
+	if err != nil {
+		log.Error("failed to lock job", zap.Error(err))
+		return
+	}
+	if !ok {
+		log.Debug("job is running on another instance")
+		return
+	}
+	defer unlock()
+
+	// Пока ждали блокировку, этот запуск мог выполнить другой экземпляр
+	state, err := s.store.Get(ctx, j.Name)
+	if err != nil {
+		log.Error("failed to load job state", zap.Error(err))
+		return
+	}
+	if state != nil && state.LastRunAt != nil && !state.LastRunAt.Before(at) {
+		return
+	}
+	if state == nil {
+		state = &State{Name: j.Name}
+	}
+
+	started := s.now()
+	attempts, err := s.attempt(ctx, j, log)
+	jobSeconds.WithLabelValues(j.Name).Observe(s.now().Sub(started).Seconds())
+	if ctx.Err() != nil {
+		// Приложение останавливается: запуск не засчитывается и повторится после перезапуска
+		return
+	}
+
+	state.LastRunAt = &at
+	state.Attempts = attempts
+	state.LastError = ""
+	if err != nil {
+		jobRuns.WithLabelValues(j.Name, "failed").Inc()
+		log.Error("job failed", zap.Int("attempts", attempts), zap.Error(err))
+		state.LastError = err.Error()
+	} else {
+		jobRuns.WithLabelValues(j.Name, "ok").Inc()
+		finished := s.now()
+		state.LastSuccessAt = &finished
+	}
+
+	if err = s.store.Save(ctx, state); err != nil {
+		log.Error("failed to save job state", zap.Error(err))
+	}
+}
+
+// attempt выполняет задачу с повторами: перед каждым следующим повтором пауза удваивается
+func (s *Scheduler) attempt(ctx context.Context, j *job, log *zap.Logger) (int, error) {
+	backoff := s.backoff
+	for attempt := 1; ; attempt++ {
+		err := s.run(ctx, j)
+		if err == nil || attempt > s.retries {
+			return attempt, err
+		}
+
+		log.Warn("job attempt failed", zap.Int("attempt", attempt), zap.Duration("retry_in", backoff), zap.Error(err))
+		if !sleep(ctx, backoff) {
+			return attempt, err
+		}
+		backoff *= 2
+	}
+}
+
+// run выполняет задачу, превращая панику в ошибку, чтобы она не останавливала планировщик
+func (s *Scheduler) run(ctx context.Context, j *job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return j.Run(ctx)
+}
+
+// sleep ждет d; возвращает false, если ctx отменили раньше
+func sleep(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
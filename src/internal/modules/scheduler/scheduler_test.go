@@ -0,0 +1,181 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"go.uber.org/zap"
+	"hospital/internal/modules/config"
+	"testing"
+	"time"
+)
+
+func newTestScheduler(store IStateStore, locker ILocker, now time.Time) *Scheduler {
+	return &Scheduler{
+		store:   store,
+		locker:  locker,
+		retries: 2,
+		backoff: time.Millisecond,
+		logger:  zap.NewNop(),
+		now:     func() time.Time { return now },
+	}
+}
+
+func TestScheduler_RunOnce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2023, 10, 1, 9, 0, 5, 0, time.UTC)
+	at := time.Date(2023, 10, 1, 9, 0, 0, 0, time.UTC)
+	earlier := at.Add(-24 * time.Hour)
+
+	tests := []struct {
+		name     string
+		run      func(calls *int) error
+		prepare  func(store *MockIStateStore, locker *MockILocker)
+		wantRuns int
+	}{
+		{
+			name: "Successful run is saved",
+			run:  func(*int) error { return nil },
+			prepare: func(store *MockIStateStore, locker *MockILocker) {
+				locker.EXPECT().TryLock(gomock.Any(), "report").Return(func() {}, true, nil)
+				store.EXPECT().Get(gomock.Any(), "report").Return(&State{Name: "report", LastRunAt: &earlier}, nil)
+				store.EXPECT().Save(gomock.Any(), &State{Name: "report", LastRunAt: &at, LastSuccessAt: &now, Attempts: 1}).Return(nil)
+			},
+			wantRuns: 1,
+		},
+		{
+			name: "Failed run is retried with backoff",
+			run: func(calls *int) error {
+				if *calls < 2 {
+					return errors.New("database is down")
+				}
+				return nil
+			},
+			prepare: func(store *MockIStateStore, locker *MockILocker) {
+				locker.EXPECT().TryLock(gomock.Any(), "report").Return(func() {}, true, nil)
+				store.EXPECT().Get(gomock.Any(), "report").Return(nil, nil)
+				store.EXPECT().Save(gomock.Any(), &State{Name: "report", LastRunAt: &at, LastSuccessAt: &now, Attempts: 2}).Return(nil)
+			},
+			wantRuns: 2,
+		},
+		{
+			name: "Error is saved when retries are exhausted",
+			run:  func(*int) error { panic("broken job") },
+			prepare: func(store *MockIStateStore, locker *MockILocker) {
+				locker.EXPECT().TryLock(gomock.Any(), "report").Return(func() {}, true, nil)
+				store.EXPECT().Get(gomock.Any(), "report").Return(&State{Name: "report", LastRunAt: &earlier, LastSuccessAt: &earlier}, nil)
+				store.EXPECT().Save(gomock.Any(), &State{
+					Name:          "report",
+					LastRunAt:     &at,
+					LastSuccessAt: &earlier,
+					LastError:     "panic: broken job",
+					Attempts:      3,
+				}).Return(nil)
+			},
+			wantRuns: 3,
+		},
+		{
+			name: "Job locked by another instance is skipped",
+			run:  func(*int) error { return nil },
+			prepare: func(store *MockIStateStore, locker *MockILocker) {
+				locker.EXPECT().TryLock(gomock.Any(), "report").Return(nil, false, nil)
+			},
+		},
+		{
+			name: "Run already made by another instance is skipped",
+			run:  func(*int) error { return nil },
+			prepare: func(store *MockIStateStore, locker *MockILocker) {
+				locker.EXPECT().TryLock(gomock.Any(), "report").Return(func() {}, true, nil)
+				store.EXPECT().Get(gomock.Any(), "report").Return(&State{Name: "report", LastRunAt: &at}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		runner.Run(t, tt.name, func(t provider.T) {
+			store := NewMockIStateStore(ctrl)
+			locker := NewMockILocker(ctrl)
+			tt.prepare(store, locker)
+
+			calls := 0
+			j := &job{Job: Job{Name: "report", Run: func(context.Context) error {
+				calls++
+				return tt.run(&calls)
+			}}}
+			newTestScheduler(store, locker, now).runOnce(context.Background(), j, at)
+
+			if calls != tt.wantRuns {
+				t.Errorf("job ran %d times, want %d", calls, tt.wantRuns)
+			}
+		})
+	}
+}
+
+func TestScheduler_Next(t *testing.T) {
+	now := time.Date(2023, 10, 5, 12, 0, 0, 0, time.UTC)
+	daily, err := ParseSchedule("0 9 * * *", time.UTC)
+	if err != nil {
+		t.Fatalf("ParseSchedule() error = %v", err)
+	}
+	j := &job{Job: Job{Name: "report"}, schedule: daily}
+	s := newTestScheduler(nil, nil, now)
+
+	at := func(day, hour int) *time.Time {
+		v := time.Date(2023, 10, day, hour, 0, 0, 0, time.UTC)
+		return &v
+	}
+
+	tests := []struct {
+		name  string
+		state *State
+		last  time.Time
+		want  time.Time
+	}{
+		{name: "Job that never ran waits for its schedule", want: *at(6, 9)},
+		{name: "Missed runs are merged into the latest one", state: &State{LastRunAt: at(1, 9)}, want: *at(5, 9)},
+		{name: "Run made by this instance is not repeated", state: &State{LastRunAt: at(4, 9)}, last: *at(5, 9), want: *at(6, 9)},
+	}
+
+	for _, tt := range tests {
+		runner.Run(t, tt.name, func(t provider.T) {
+			if got := s.next(j, tt.state, tt.last); !got.Equal(tt.want) {
+				t.Errorf("next() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewScheduler(t *testing.T) {
+	from := time.Date(2023, 10, 5, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		job     Job
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "Frequent job runs at its interval", job: Job{Name: "alerts", Every: 15 * time.Second}, want: from.Add(15 * time.Second)},
+		{name: "Job runs by its schedule", job: Job{Name: "report", Spec: "@every 90m"}, want: from.Add(90 * time.Minute)},
+		{name: "Schedule interval is at least a minute", job: Job{Name: "report", Spec: "@every 10s"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		runner.Run(t, tt.name, func(t provider.T) {
+			s, err := NewScheduler(Params{
+				Jobs:   []Job{tt.job},
+				Config: config.Config{SchedulerTimezone: "UTC"},
+				Logger: zap.NewNop(),
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewScheduler() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !s.jobs[0].schedule.Next(from).Equal(tt.want) {
+				t.Errorf("Next() got = %v, want %v", s.jobs[0].schedule.Next(from), tt.want)
+			}
+		})
+	}
+}
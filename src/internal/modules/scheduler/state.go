@@ -0,0 +1,90 @@
+package scheduler
+
+import (
+	"context"
+	"hospital/internal/modules/db"
+	"hospital/internal/modules/db/ent"
+	"hospital/internal/modules/db/ent/jobstate"
+	"time"
+)
+
+// State - сохраненное состояние задачи
+type State struct {
+	Name          string
+	LastRunAt     *time.Time
+	LastSuccessAt *time.Time
+	LastError     string
+	Attempts      int
+}
+
+// StateStore хранит состояние задач в базе, чтобы после перезапуска и на других
+// экземплярах было видно, когда задача выполнялась в последний раз
+type StateStore struct {
+	client *ent.Client
+}
+
+func NewStateStore(client *ent.Client) *StateStore {
+	return &StateStore{
+		client: client,
+	}
+}
+
+// Get возвращает состояние задачи или nil, если задача еще не запускалась
+func (r *StateStore) Get(ctx context.Context, name string) (*State, error) {
+	model, err := r.client.JobState.Query().
+		Where(jobstate.NameEQ(name)).
+		Only(db.SystemContext(ctx))
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, db.WrapError(err)
+	}
+
+	return ToStateDTO(model), nil
+}
+
+func (r *StateStore) Save(ctx context.Context, dtm *State) error {
+	ctx = db.SystemContext(ctx)
+	model, err := r.client.JobState.Query().
+		Where(jobstate.NameEQ(dtm.Name)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		err = r.client.JobState.Create().
+			SetName(dtm.Name).
+			SetNillableLastRunAt(dtm.LastRunAt).
+			SetNillableLastSuccessAt(dtm.LastSuccessAt).
+			SetLastError(dtm.LastError).
+			SetAttempts(dtm.Attempts).
+			Exec(ctx)
+		return db.WrapError(err)
+	}
+	if err != nil {
+		return db.WrapError(err)
+	}
+
+	err = model.Update().
+		SetNillableLastRunAt(dtm.LastRunAt).
+		SetNillableLastSuccessAt(dtm.LastSuccessAt).
+		SetLastError(dtm.LastError).
+		SetAttempts(dtm.Attempts).
+		Exec(ctx)
+	if err != nil {
+		return db.WrapError(err)
+	}
+
+	return nil
+}
+
+func ToStateDTO(model *ent.JobState) *State {
+	if model == nil {
+		return nil
+	}
+	return &State{
+		Name:          model.Name,
+		LastRunAt:     model.LastRunAt,
+		LastSuccessAt: model.LastSuccessAt,
+		LastError:     model.LastError,
+		Attempts:      model.Attempts,
+	}
+}
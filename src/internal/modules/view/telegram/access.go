@@ -0,0 +1,86 @@
+package telegram
+
+import (
+	"context"
+	"hospital/internal/models/access"
+	"hospital/internal/models/errors"
+	"hospital/internal/modules/domain/access/dto"
+	"hospital/internal/modules/view/telegram/controllers"
+	"hospital/internal/modules/view/telegram/dialog"
+	"hospital/internal/modules/view/telegram/form"
+	"hospital/internal/modules/view/telegram/i18n"
+	"strings"
+)
+
+var accessActions = map[string]string{
+	dto.ActionGet:    "access.action.get",
+	dto.ActionList:   "access.action.list",
+	dto.ActionSearch: "access.action.search",
+}
+
+// administration - цель доступа для выгрузок и отчетов: данные пациентов в них читаются
+// не лечащим врачом, и в журнале доступа такие чтения не должны выглядеть как лечение
+func administration(ctx context.Context) context.Context {
+	return access.SetPurposeToCtx(ctx, access.PurposeAdministration)
+}
+
+func accessReportForm(controller *controllers.Controller) *form.Form[patientSelection] {
+	fields := patientSearchFields(controller)
+	for i := range fields {
+		if choose := fields[i].Choices; choose != nil {
+			fields[i].Choices = func(ctx context.Context, values dialog.Values) ([]form.Choice, error) {
+				return choose(administration(ctx), values)
+			}
+		}
+	}
+	return &form.Form[patientSelection]{
+		Name:   "button.access_report",
+		Fields: fields,
+		Submit: func(ctx context.Context, _ *dialog.Conversation, s *patientSelection) dialog.Reply {
+			return dialog.Reply{Text: accessReport(ctx, s.PatientId, controller)}
+		},
+	}
+}
+
+func accessReport(ctx context.Context, id int, controller *controllers.Controller) string {
+	ctx = administration(ctx)
+	records, err := controller.PatientAccessReport(ctx, id)
+	if err == errors.ErrAccessDenied {
+		return i18n.T(ctx, "access.admins_only")
+	}
+	if err != nil {
+		return i18n.T(ctx, "error.request")
+	}
+	if len(records) == 0 {
+		return i18n.T(ctx, "access.none")
+	}
+
+	var b strings.Builder
+	for _, record := range records {
+		b.WriteString(i18n.T(ctx, "access.record",
+			record.CreatedAt.Format("02.01.2006 15:04:05"), actor(ctx, record.ActorId),
+			i18n.T(ctx, accessActions[record.Action]), record.Purpose) + "\n")
+	}
+	return b.String()
+}
+
+func printAccessAnomalies(ctx context.Context, controller *controllers.Controller) string {
+	ctx = administration(ctx)
+	anomalies, err := controller.AccessAnomalies(ctx)
+	if err == errors.ErrAccessDenied {
+		return i18n.T(ctx, "access.admins_only")
+	}
+	if err != nil {
+		return i18n.T(ctx, "error.request")
+	}
+	if len(anomalies) == 0 {
+		return i18n.T(ctx, "access.no_anomalies")
+	}
+
+	var b strings.Builder
+	for _, anomaly := range anomalies {
+		b.WriteString(i18n.T(ctx, "access.anomaly", anomaly.DoctorId,
+			i18n.N(ctx, "access.opened_patients", anomaly.Patients), anomaly.Untreated) + "\n")
+	}
+	return b.String()
+}
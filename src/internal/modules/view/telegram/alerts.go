@@ -0,0 +1,320 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"go.uber.org/zap"
+	"hospital/internal/models/errors"
+	"hospital/internal/modules/config"
+	"hospital/internal/modules/domain/alert/dto"
+	patient_dto "hospital/internal/modules/domain/patient/dto"
+	"hospital/internal/modules/scheduler"
+	"hospital/internal/modules/view/telegram/callback"
+	"hospital/internal/modules/view/telegram/controllers"
+	"hospital/internal/modules/view/telegram/dialog"
+	"hospital/internal/modules/view/telegram/form"
+	"hospital/internal/modules/view/telegram/i18n"
+	"hospital/internal/modules/view/telegram/outbox"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// alertBatch - сколько уведомлений отправляется за один проход
+const alertBatch = 50
+
+// alertAck - данные кнопки подтверждения уведомления
+type alertAck struct {
+	AlertId int
+}
+
+// alertActions - кнопки в уведомлениях
+type alertActions struct {
+	ack *callback.Route[alertAck]
+}
+
+func registerAlertActions(router *callback.Router, controller *controllers.Controller) *alertActions {
+	a := &alertActions{}
+	a.ack = callback.Register(router, "ack", func(ctx context.Context, q callback.Query, p alertAck) (callback.Reply, error) {
+		if _, err := controller.AcknowledgeAlert(ctx, p.AlertId); err != nil {
+			// Кнопка остается, чтобы подтвердить уведомление после входа в систему
+			keyboard, kbErr := a.keyboard(ctx, q.UserId, p.AlertId)
+			if kbErr != nil {
+				return callback.Reply{}, kbErr
+			}
+			return callback.Reply{Text: i18n.Error(ctx, err), Keyboard: &keyboard}, nil
+		}
+		return callback.Reply{Text: i18n.T(ctx, "alert.acknowledged")}, nil
+	})
+	return a
+}
+
+// keyboard - кнопка подтверждения уведомления alertId для пользователя userId
+func (a *alertActions) keyboard(ctx context.Context, userId int64, alertId int) (tgbotapi.InlineKeyboardMarkup, error) {
+	button, err := a.ack.Button(i18n.T(ctx, "alert.ack"), userId, alertAck{AlertId: alertId})
+	if err != nil {
+		return tgbotapi.InlineKeyboardMarkup{}, err
+	}
+	return tgbotapi.NewInlineKeyboardMarkup(tgbotapi.NewInlineKeyboardRow(button)), nil
+}
+
+// NewEscalateJob - задача планировщика, которая каждые ALERT_POLL_INTERVAL пересылает
+// неподтвержденные уведомления по шагам эскалации
+func NewEscalateJob(controller *controllers.Controller, cfg config.Config) scheduler.Job {
+	return scheduler.Job{
+		Name:  "alerts.escalate",
+		Every: cfg.AlertPollInterval,
+		Run:   controller.EscalateAlerts,
+	}
+}
+
+// NewDeliverJob - задача планировщика, которая каждые ALERT_POLL_INTERVAL отправляет
+// накопившиеся уведомления врачам и в чаты бригад. Блокировка планировщика не дает
+// нескольким экземплярам бота отправлять уведомления одновременно.
+func NewDeliverJob(
+	controller *controllers.Controller,
+	actions *alertActions,
+	out *outbox.Outbox,
+	cfg config.Config,
+	logger *zap.Logger) scheduler.Job {
+	return scheduler.Job{
+		Name:  "alerts.deliver",
+		Every: cfg.AlertPollInterval,
+		Run: func(ctx context.Context) error {
+			sendDueAlerts(ctx, controller, actions, out, logger)
+			sendDueWardNotices(ctx, controller, out, logger)
+			return nil
+		},
+	}
+}
+
+func sendDueAlerts(
+	ctx context.Context,
+	controller *controllers.Controller,
+	actions *alertActions,
+	bot Transport,
+	logger *zap.Logger) {
+	deliveries, err := controller.DueAlerts(ctx, alertBatch)
+	if err != nil {
+		logger.Error("failed to load alerts", zap.Error(err))
+		return
+	}
+
+	for _, d := range deliveries {
+		if err = sendAlert(bot, actions, d); err != nil {
+			logger.Warn("failed to deliver alert", zap.Int("alert", d.Alert.Id), zap.Error(err))
+			if err = controller.AlertFailed(ctx, d.Alert.Id); err != nil {
+				logger.Error("failed to mark alert failed", zap.Int("alert", d.Alert.Id), zap.Error(err))
+			}
+			continue
+		}
+		if err = controller.AlertSent(ctx, d.Alert.Id); err != nil {
+			logger.Error("failed to mark alert sent", zap.Int("alert", d.Alert.Id), zap.Error(err))
+		}
+	}
+}
+
+// sendAlert отправляет уведомление в чат врача на его языке с кнопкой подтверждения
+func sendAlert(bot Transport, actions *alertActions, d *dto.Delivery) error {
+	if d.Recipient == nil {
+		return fmt.Errorf("doctor %d not found", d.Alert.DoctorId)
+	}
+	chatId, err := strconv.ParseInt(d.Recipient.TokenId, 10, 64)
+	if err != nil {
+		return fmt.Errorf("doctor %d chat: %w", d.Recipient.DoctorId, err)
+	}
+
+	ctx := context.Background()
+	if lang, ok := i18n.Parse(d.Recipient.Language); ok {
+		ctx = i18n.WithLang(ctx, lang)
+	}
+	msg := tgbotapi.NewMessage(chatId, formatAlert(ctx, d.Alert))
+	// TokenId - id пользователя Telegram; в личном чате он совпадает с id чата
+	keyboard, err := actions.keyboard(ctx, chatId, d.Alert.Id)
+	if err != nil {
+		return err
+	}
+	msg.ReplyMarkup = keyboard
+	_, err = bot.Send(msg)
+	return err
+}
+
+// formatAlert - текст уведомления: важность и описание события
+func formatAlert(ctx context.Context, a *dto.Alert) string {
+	p := a.Params
+	var text string
+	switch a.Kind {
+	case dto.KindDangerRaised:
+		text = i18n.T(ctx, "alert.danger_raised", p[dto.ParamPatient], a.PatientId, p[dto.ParamFrom], p[dto.ParamTo])
+	case dto.KindVitalsAbnormal:
+		text = i18n.T(ctx, "alert.vitals_abnormal", p[dto.ParamPatient], a.PatientId,
+			vitalNames(ctx, strings.Split(p[dto.ParamAbnormal], ",")),
+			p[patient_dto.VitalPulse], p[patient_dto.VitalSystolic], p[patient_dto.VitalDiastolic],
+			p[patient_dto.VitalTemperature], p[patient_dto.VitalSaturation])
+	case dto.KindPatientAssigned:
+		text = i18n.T(ctx, "alert.patient_assigned", p[dto.ParamPatient], a.PatientId)
+	default:
+		text = a.Kind
+	}
+	if from, ok := p[dto.ParamEscalatedFrom]; ok {
+		text += "\n" + i18n.T(ctx, "alert.escalated", from)
+	} else if a.ParentId != nil {
+		text += "\n" + i18n.T(ctx, "alert.unassigned")
+	}
+	return i18n.T(ctx, "alert.severity."+a.Severity) + "\n" + text
+}
+
+// printAlertReport - неподтвержденные и поздно подтвержденные критические уведомления
+func printAlertReport(ctx context.Context, controller *controllers.Controller) string {
+	report, err := controller.AlertAckReport(administration(ctx))
+	if err == errors.ErrAccessDenied {
+		return i18n.T(ctx, "alert.report.admins_only")
+	}
+	if err != nil {
+		return i18n.T(ctx, "error.request")
+	}
+	if len(report.Unacknowledged) == 0 && len(report.Late) == 0 {
+		return i18n.T(ctx, "alert.report.none")
+	}
+
+	var b strings.Builder
+	if len(report.Unacknowledged) > 0 {
+		b.WriteString(i18n.T(ctx, "alert.report.unacknowledged"))
+		for _, a := range report.Unacknowledged {
+			b.WriteString(i18n.T(ctx, "alert.report.line", a.Id, a.CreatedAt.Format(alertTimeLayout),
+				i18n.T(ctx, "alert.kind."+a.Kind), a.PatientId, alertRecipient(ctx, a)))
+		}
+	}
+	if len(report.Late) > 0 {
+		b.WriteString(i18n.T(ctx, "alert.report.late", minutes(ctx, report.Deadline)))
+		for _, a := range report.Late {
+			delay, _ := a.AckDelay()
+			b.WriteString(i18n.T(ctx, "alert.report.late_line", a.Id, a.CreatedAt.Format(alertTimeLayout),
+				i18n.T(ctx, "alert.kind."+a.Kind), a.PatientId, alertRecipient(ctx, a), minutes(ctx, delay)))
+		}
+	}
+	return b.String()
+}
+
+// alertRecipient - получатель уведомления в отчете
+func alertRecipient(ctx context.Context, a *dto.Alert) string {
+	if a.DoctorId == 0 {
+		return i18n.T(ctx, "alert.report.unassigned")
+	}
+	return i18n.T(ctx, "alert.report.doctor", a.DoctorId)
+}
+
+// alertTimeLayout - время уведомления в отчете
+const alertTimeLayout = "02.01 15:04"
+
+// minutes - длительность в минутах с округлением вверх
+func minutes(ctx context.Context, d time.Duration) string {
+	return i18n.N(ctx, "alert.minutes", int((d+time.Minute-1)/time.Minute))
+}
+
+// toggleOnCall начинает или заканчивает дежурство врача
+func toggleOnCall(ctx context.Context, controller *controllers.Controller) string {
+	doctor, err := controller.ToggleOnCall(ctx)
+	if err != nil {
+		return i18n.T(ctx, "error.request")
+	}
+	if doctor.OnCall {
+		return i18n.T(ctx, "doctor.on_call_started")
+	}
+	return i18n.T(ctx, "doctor.on_call_finished")
+}
+
+// vitalNames - названия показателей через запятую на языке пользователя
+func vitalNames(ctx context.Context, names []string) string {
+	labels := make([]string, len(names))
+	for i, name := range names {
+		labels[i] = i18n.T(ctx, "vital."+name)
+	}
+	return strings.Join(labels, ", ")
+}
+
+// alertSettings - ответы формы настроек уведомлений; для каждого вида уведомлений - on или off
+type alertSettings struct {
+	MinSeverity     string
+	QuietHours      string
+	DangerRaised    string
+	VitalsAbnormal  string
+	PatientAssigned string
+}
+
+const (
+	settingOn  = "on"
+	settingOff = "off"
+)
+
+func (r *alertSettings) update() *dto.UpdatePreference {
+	update := &dto.UpdatePreference{MinSeverity: r.MinSeverity, QuietHours: r.QuietHours}
+	settings := map[string]string{
+		dto.KindDangerRaised:    r.DangerRaised,
+		dto.KindVitalsAbnormal:  r.VitalsAbnormal,
+		dto.KindPatientAssigned: r.PatientAssigned,
+	}
+	for _, kind := range dto.Kinds {
+		if settings[kind] == settingOff {
+			update.MutedKinds = append(update.MutedKinds, kind)
+		}
+	}
+	return update
+}
+
+func (r *alertSettings) Validate() error {
+	return r.update().Validate()
+}
+
+func alertSettingsForm(controller *controllers.Controller) *form.Form[alertSettings] {
+	return &form.Form[alertSettings]{
+		Name: "button.alert_settings",
+		Fields: []form.Field{
+			{Name: "minSeverity", Prompt: "alert.prompt.min_severity", Choices: severityChoices},
+			{Name: "quietHours", Prompt: "alert.prompt.quiet_hours", Optional: true},
+			{Name: "dangerRaised", Prompt: "alert.prompt.danger_raised", Choices: switchChoices},
+			{Name: "vitalsAbnormal", Prompt: "alert.prompt.vitals_abnormal", Choices: switchChoices},
+			{Name: "patientAssigned", Prompt: "alert.prompt.patient_assigned", Choices: switchChoices},
+		},
+		Submit: func(ctx context.Context, _ *dialog.Conversation, s *alertSettings) dialog.Reply {
+			pref, err := controller.SetAlertPreference(ctx, s.update())
+			if err != nil {
+				return failed(ctx, err, "alert.settings_failed")
+			}
+			return dialog.Reply{Text: i18n.T(ctx, "alert.settings_saved") + "\n" + formatPreference(ctx, pref)}
+		},
+	}
+}
+
+func severityChoices(ctx context.Context, _ dialog.Values) ([]form.Choice, error) {
+	choices := make([]form.Choice, len(dto.Severities))
+	for i, s := range dto.Severities {
+		choices[i] = form.Choice{Value: s, Label: i18n.T(ctx, "alert.min."+s)}
+	}
+	return choices, nil
+}
+
+func switchChoices(ctx context.Context, _ dialog.Values) ([]form.Choice, error) {
+	return []form.Choice{
+		{Value: settingOn, Label: i18n.T(ctx, "alert.on")},
+		{Value: settingOff, Label: i18n.T(ctx, "alert.off")},
+	}, nil
+}
+
+// formatPreference - настройки уведомлений: важность, время тишины и отключенные виды
+func formatPreference(ctx context.Context, pref *dto.Preference) string {
+	quiet := pref.FormatQuietHours()
+	if quiet == "" {
+		quiet = i18n.T(ctx, "alert.quiet_none")
+	}
+	muted := i18n.T(ctx, "alert.muted_none")
+	if len(pref.MutedKinds) > 0 {
+		kinds := make([]string, len(pref.MutedKinds))
+		for i, kind := range pref.MutedKinds {
+			kinds[i] = i18n.T(ctx, "alert.kind."+kind)
+		}
+		muted = strings.Join(kinds, ", ")
+	}
+	return i18n.T(ctx, "alert.preference", i18n.T(ctx, "alert.min."+pref.MinSeverity), quiet, muted)
+}
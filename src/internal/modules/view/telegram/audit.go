@@ -0,0 +1,99 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"hospital/internal/models/errors"
+	"hospital/internal/modules/db/ent"
+	"hospital/internal/modules/domain/audit/dto"
+	"hospital/internal/modules/view/telegram/controllers"
+	"hospital/internal/modules/view/telegram/dialog"
+	"hospital/internal/modules/view/telegram/i18n"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// auditEntities сопоставляет ключ названия записи, введенного пользователем, с сущностью журнала
+var auditEntities = map[string]string{
+	"entity.patient": ent.TypePatient,
+	"entity.room":    ent.TypeRoom,
+	"entity.doctor":  ent.TypeDoctor,
+	"entity.disease": ent.TypeDisease,
+}
+
+var auditActions = map[string]string{
+	dto.ActionCreate: "audit.action.create",
+	dto.ActionUpdate: "audit.action.update",
+	dto.ActionDelete: "audit.action.delete",
+}
+
+func auditHistoryDialog(controller *controllers.Controller) *dialog.Dialog {
+	return &dialog.Dialog{
+		Name: "button.audit_history",
+		Steps: []dialog.Step{
+			{Name: "entity", Prompt: dialog.Text("audit.prompt.entity")},
+			{Name: "id", Prompt: dialog.Text("audit.prompt.id")},
+		},
+		Finish: finish(EndAuditHistory, controller),
+	}
+}
+
+func EndAuditHistory(ctx context.Context, values dialog.Values, controller *controllers.Controller) string {
+	key, _ := i18n.Match("entity.", values["entity"])
+	entity, ok := auditEntities[key]
+	if !ok {
+		return i18n.T(ctx, "audit.unknown_entity")
+	}
+	id, err := strconv.Atoi(strings.TrimSpace(values["id"]))
+	if err != nil {
+		return i18n.T(ctx, "audit.invalid_id")
+	}
+
+	records, err := controller.History(ctx, entity, id)
+	if err == errors.ErrAccessDenied {
+		return i18n.T(ctx, "audit.admins_only")
+	}
+	if err != nil {
+		return i18n.T(ctx, "error.request")
+	}
+	if len(records) == 0 {
+		return i18n.T(ctx, "audit.none")
+	}
+
+	return formatAuditRecords(ctx, records)
+}
+
+func formatAuditRecords(ctx context.Context, records dto.AuditRecords) string {
+	var b strings.Builder
+	for _, record := range records {
+		fmt.Fprintf(&b, "%s, %s, %s\n",
+			record.CreatedAt.Format("02.01.2006 15:04:05"), actor(ctx, record.ActorId), i18n.T(ctx, auditActions[record.Action]))
+
+		names := make([]string, 0, len(record.Changes))
+		for name := range record.Changes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			change := record.Changes[name]
+			fmt.Fprintf(&b, "  %s: %v -> %v\n", name, orDash(change.Before), orDash(change.After))
+		}
+	}
+	return b.String()
+}
+
+// actor - кто выполнил действие: врач или система
+func actor(ctx context.Context, actorId *int) string {
+	if actorId == nil {
+		return i18n.T(ctx, "actor.system")
+	}
+	return i18n.T(ctx, "actor.doctor", *actorId)
+}
+
+func orDash(v any) any {
+	if v == nil {
+		return "-"
+	}
+	return v
+}
@@ -0,0 +1,203 @@
+package callback
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"hospital/internal/models/errors"
+	"hospital/internal/modules/config"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	sep = "|"
+
+	// sigLen - длина подписи в байтах, данные кнопки в Telegram ограничены 64 байтами
+	sigLen = 8
+	// maxDataLen - ограничение Telegram на callback_data
+	maxDataLen = 64
+)
+
+// Query - нажатие кнопки
+type Query struct {
+	ChatId    int64
+	UserId    int64
+	MessageId int
+}
+
+// Reply - результат обработки нажатия
+type Reply struct {
+	// Text - новое сообщение в чат; пустой текст - сообщение не отправляется
+	Text   string
+	Markup interface{}
+	// ParseMode - разметка Text: пустая строка для обычного текста или tgbotapi.ModeHTML
+	ParseMode string
+	// Keyboard заменяет клавиатуру сообщения с нажатой кнопкой; nil - клавиатура убирается
+	Keyboard *tgbotapi.InlineKeyboardMarkup
+}
+
+type handler func(ctx context.Context, q Query, args []string) (Reply, error)
+
+// Router подписывает данные кнопок и передает нажатия обработчикам маршрутов.
+// Подпись привязывает кнопку к маршруту, данным и пользователю, которому она показана.
+type Router struct {
+	key    []byte
+	routes map[string]handler
+}
+
+// NewRouter требует SECRET: кнопки должны работать после перезапуска бота и на любом
+// его экземпляре, поэтому ключ подписи не может быть случайным.
+func NewRouter(cfg config.Config) (*Router, error) {
+	if cfg.Secret == "" {
+		return nil, fmt.Errorf("callback: SECRET is required to sign buttons")
+	}
+
+	return &Router{
+		key:    []byte(cfg.Secret),
+		routes: map[string]handler{},
+	}, nil
+}
+
+// Route - маршрут кнопок с данными типа T. T - структура из полей int, string и bool.
+type Route[T any] struct {
+	name   string
+	router *Router
+}
+
+// Register добавляет маршрут; имя маршрута входит в данные кнопки, поэтому оно должно быть коротким
+func Register[T any](r *Router, name string, h func(ctx context.Context, q Query, payload T) (Reply, error)) *Route[T] {
+	if _, ok := r.routes[name]; ok || name == "" || strings.Contains(name, sep) {
+		panic(fmt.Sprintf("callback: invalid or duplicate route %q", name))
+	}
+
+	r.routes[name] = func(ctx context.Context, q Query, args []string) (Reply, error) {
+		var payload T
+		if err := decode(&payload, args); err != nil {
+			return Reply{}, errors.ErrInvalidToken
+		}
+		return h(ctx, q, payload)
+	}
+	return &Route[T]{name: name, router: r}
+}
+
+// Data возвращает подписанные данные кнопки для пользователя userId
+func (r *Route[T]) Data(userId int64, payload T) (string, error) {
+	args, err := encode(payload)
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := strings.Join(append([]string{r.name, strconv.FormatInt(userId, 36)}, args...), sep)
+	data := unsigned + sep + r.router.sign(unsigned)
+	if len(data) > maxDataLen {
+		return "", fmt.Errorf("callback: data of route %q is %d bytes, limit is %d", r.name, len(data), maxDataLen)
+	}
+	return data, nil
+}
+
+// Button - кнопка маршрута
+func (r *Route[T]) Button(text string, userId int64, payload T) (tgbotapi.InlineKeyboardButton, error) {
+	data, err := r.Data(userId, payload)
+	if err != nil {
+		return tgbotapi.InlineKeyboardButton{}, err
+	}
+	return tgbotapi.NewInlineKeyboardButtonData(text, data), nil
+}
+
+// Owns - относятся ли данные кнопки к одному из маршрутов
+func (r *Router) Owns(data string) bool {
+	name, _, _ := strings.Cut(data, sep)
+	_, ok := r.routes[name]
+	return ok
+}
+
+// Handle проверяет подпись и владельца кнопки и вызывает обработчик маршрута
+func (r *Router) Handle(ctx context.Context, q Query, data string) (Reply, error) {
+	i := strings.LastIndex(data, sep)
+	if i < 0 || !hmac.Equal([]byte(data[i+1:]), []byte(r.sign(data[:i]))) {
+		return Reply{}, errors.ErrInvalidToken
+	}
+
+	parts := strings.Split(data[:i], sep)
+	h, ok := r.routes[parts[0]]
+	if !ok || len(parts) < 2 {
+		return Reply{}, errors.ErrInvalidToken
+	}
+	owner, err := strconv.ParseInt(parts[1], 36, 64)
+	if err != nil {
+		return Reply{}, errors.ErrInvalidToken
+	}
+	if owner != q.UserId {
+		return Reply{}, errors.ErrCallbackForeign
+	}
+
+	return h(ctx, q, parts[2:])
+}
+
+func (r *Router) sign(unsigned string) string {
+	mac := hmac.New(sha256.New, r.key)
+	mac.Write([]byte(unsigned))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:sigLen])
+}
+
+// encode записывает поля структуры по порядку; числа - в 36-ричной системе
+func encode(payload interface{}) ([]string, error) {
+	v := reflect.ValueOf(payload)
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("callback: payload %T is not a struct", payload)
+	}
+
+	args := make([]string, v.NumField())
+	for i := range args {
+		f := v.Field(i)
+		switch f.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			args[i] = strconv.FormatInt(f.Int(), 36)
+		case reflect.String:
+			if strings.Contains(f.String(), sep) {
+				return nil, fmt.Errorf("callback: field %s contains %q", v.Type().Field(i).Name, sep)
+			}
+			args[i] = f.String()
+		case reflect.Bool:
+			args[i] = strconv.FormatBool(f.Bool())
+		default:
+			return nil, fmt.Errorf("callback: unsupported field %s of kind %s", v.Type().Field(i).Name, f.Kind())
+		}
+	}
+	return args, nil
+}
+
+func decode(payload interface{}, args []string) error {
+	v := reflect.ValueOf(payload).Elem()
+	if v.Kind() != reflect.Struct || v.NumField() != len(args) {
+		return errors.ErrInvalidToken
+	}
+
+	for i, arg := range args {
+		f := v.Field(i)
+		switch f.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			n, err := strconv.ParseInt(arg, 36, f.Type().Bits())
+			if err != nil {
+				return err
+			}
+			f.SetInt(n)
+		case reflect.String:
+			f.SetString(arg)
+		case reflect.Bool:
+			b, err := strconv.ParseBool(arg)
+			if err != nil {
+				return err
+			}
+			f.SetBool(b)
+		default:
+			return errors.ErrInvalidToken
+		}
+	}
+	return nil
+}
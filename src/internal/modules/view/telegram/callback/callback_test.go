@@ -0,0 +1,127 @@
+package callback
+
+import (
+	"context"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	err_c "hospital/internal/models/errors"
+	"hospital/internal/modules/config"
+	"strings"
+	"testing"
+)
+
+type pick struct {
+	Step  string
+	Index int
+	Last  bool
+}
+
+func newTestRouter(t provider.T, secret string, got *[]pick) (*Router, *Route[pick]) {
+	r, err := NewRouter(config.Config{Secret: secret})
+	if err != nil {
+		t.Fatalf("NewRouter() error = %v", err)
+	}
+	route := Register(r, "pk", func(_ context.Context, q Query, p pick) (Reply, error) {
+		*got = append(*got, p)
+		return Reply{Text: "ok"}, nil
+	})
+	return r, route
+}
+
+func TestRouter_Handle(t *testing.T) {
+	want := pick{Step: "patientId", Index: 37, Last: true}
+
+	for _, tt := range []struct {
+		name    string
+		tamper  func(data string) string
+		userId  int64
+		wantErr error
+	}{
+		{name: "Payload is decoded", tamper: func(d string) string { return d }, userId: 100},
+		{
+			name:    "Forged payload",
+			tamper:  func(d string) string { return strings.Replace(d, "|11|", "|12|", 1) },
+			userId:  100,
+			wantErr: err_c.ErrInvalidToken,
+		},
+		{name: "Foreign user", tamper: func(d string) string { return d }, userId: 200, wantErr: err_c.ErrCallbackForeign},
+		{name: "Garbage", tamper: func(string) string { return "pk|x" }, userId: 100, wantErr: err_c.ErrInvalidToken},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			var got []pick
+			r, route := newTestRouter(t, "secret", &got)
+
+			data, err := route.Data(100, want)
+			if err != nil {
+				t.Fatalf("Data() error = %v", err)
+			}
+			data = tt.tamper(data)
+			if !r.Owns(data) {
+				t.Fatalf("Owns(%q) = false", data)
+			}
+
+			reply, err := r.Handle(context.Background(), Query{ChatId: 1, UserId: tt.userId}, data)
+			if err != tt.wantErr {
+				t.Fatalf("Handle() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(got) != 0 {
+					t.Errorf("handler was called: %v", got)
+				}
+				return
+			}
+			if reply.Text != "ok" || len(got) != 1 || got[0] != want {
+				t.Errorf("Handle() = %q, payloads %v, want %v", reply.Text, got, want)
+			}
+		})
+	}
+}
+
+func TestRouter_Secret(t *testing.T) {
+	runner.Run(t, "Button signed with another secret is rejected", func(t provider.T) {
+		var got []pick
+		_, other := newTestRouter(t, "other", &got)
+		r, _ := newTestRouter(t, "secret", &got)
+
+		data, err := other.Data(100, pick{Step: "a"})
+		if err != nil {
+			t.Fatalf("Data() error = %v", err)
+		}
+		if _, err = r.Handle(context.Background(), Query{UserId: 100}, data); err != err_c.ErrInvalidToken {
+			t.Errorf("Handle() error = %v, want %v", err, err_c.ErrInvalidToken)
+		}
+	})
+
+	runner.Run(t, "Secret is required", func(t provider.T) {
+		if _, err := NewRouter(config.Config{}); err == nil {
+			t.Errorf("NewRouter() error = nil, want error")
+		}
+	})
+}
+
+func TestRoute_Data(t *testing.T) {
+	runner.Run(t, "Data longer than Telegram limit", func(t provider.T) {
+		var got []pick
+		_, route := newTestRouter(t, "secret", &got)
+		if _, err := route.Data(100, pick{Step: strings.Repeat("x", 60)}); err == nil {
+			t.Errorf("Data() error = nil, want limit error")
+		}
+	})
+	runner.Run(t, "Separator in string field", func(t provider.T) {
+		var got []pick
+		_, route := newTestRouter(t, "secret", &got)
+		if _, err := route.Data(100, pick{Step: "a|b"}); err == nil {
+			t.Errorf("Data() error = nil, want separator error")
+		}
+	})
+	runner.Run(t, "Duplicate route", func(t provider.T) {
+		var got []pick
+		r, _ := newTestRouter(t, "secret", &got)
+		defer func() {
+			if recover() == nil {
+				t.Errorf("Register() did not panic")
+			}
+		}()
+		Register(r, "pk", func(context.Context, Query, pick) (Reply, error) { return Reply{}, nil })
+	})
+}
@@ -0,0 +1,186 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"hospital/internal/modules/view/telegram/dialog"
+	"hospital/internal/modules/view/telegram/i18n"
+	"strings"
+)
+
+// Call - вызов команды пользователем
+type Call struct {
+	ChatId int64
+	UserId int64
+	// Role - роль вошедшего врача; пустая, если пользователь не вошел
+	Role string
+	// Registered - пользователь зарегистрирован, даже если сейчас не вошел
+	Registered bool
+	// Group - команда отправлена в групповом чате; Title - название группы
+	Group bool
+	Title string
+	Args  []string
+}
+
+// Command - слэш-команда бота
+type Command struct {
+	// Name - имя без слэша, например patient
+	Name string
+	// Usage описывает аргументы для справки, например <id>; Usage и Description - ключи каталога сообщений
+	Usage       string
+	Description string
+	// Args - количество обязательных аргументов
+	Args int
+	// Public - команда доступна без входа в систему
+	Public bool
+	// Roles - роли, которым доступна команда; пустой список - всем вошедшим
+	Roles []string
+	// PrivateOnly - команда показывает данные пациентов и выполняется только в личном чате:
+	// в групповом чате ответ увидели бы все участники
+	PrivateOnly bool
+	Handler     func(ctx context.Context, call Call) dialog.Reply
+}
+
+// Registry - список команд и кнопок меню бота; по нему строятся справка,
+// меню команд в Telegram и клавиатура меню
+type Registry struct {
+	commands []*Command
+	index    map[string]*Command
+	menu     [][]*Button
+	buttons  map[string]bool
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		index:   map[string]*Command{},
+		buttons: map[string]bool{},
+	}
+}
+
+// Register добавляет команды в порядке, в котором они показываются в справке
+func (r *Registry) Register(commands ...*Command) {
+	for _, c := range commands {
+		if _, ok := r.index[c.Name]; ok {
+			panic(fmt.Sprintf("command: duplicate command /%s", c.Name))
+		}
+		r.commands = append(r.commands, c)
+		r.index[c.Name] = c
+	}
+}
+
+// Parse разбирает сообщение вида /name@bot arg1 arg2
+func Parse(text string) (string, []string, bool) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 || !strings.HasPrefix(fields[0], "/") || len(fields[0]) == 1 {
+		return "", nil, false
+	}
+
+	name, _, _ := strings.Cut(fields[0][1:], "@")
+	return strings.ToLower(name), fields[1:], true
+}
+
+// Has - зарегистрирована ли команда, которой начинается сообщение
+func (r *Registry) Has(text string) bool {
+	name, _, ok := Parse(text)
+	if !ok {
+		return false
+	}
+	_, ok = r.index[name]
+	return ok
+}
+
+// Dispatch выполняет зарегистрированную команду. Если сообщение не является
+// зарегистрированной командой, возвращает false.
+func (r *Registry) Dispatch(ctx context.Context, text string, call Call) (dialog.Reply, bool) {
+	name, args, ok := Parse(text)
+	if !ok {
+		return dialog.Reply{}, false
+	}
+	c, ok := r.index[name]
+	if !ok {
+		return dialog.Reply{}, false
+	}
+
+	if !c.availableTo(call.Role) {
+		if call.Role == "" {
+			return dialog.Reply{Text: i18n.T(ctx, "bot.login_required")}, true
+		}
+		return dialog.Reply{Text: i18n.T(ctx, "command.forbidden")}, true
+	}
+	if c.PrivateOnly && call.Group {
+		return dialog.Reply{Text: i18n.T(ctx, "command.private_only", "/"+c.Name)}, true
+	}
+	if len(args) < c.Args {
+		return dialog.Reply{Text: i18n.T(ctx, "command.usage", c.signature(i18n.LangOf(ctx)))}, true
+	}
+
+	call.Args = args
+	return c.Handler(ctx, call), true
+}
+
+// Available - команды, доступные роли; пустая роль - пользователь не вошел
+func (r *Registry) Available(role string) []*Command {
+	var available []*Command
+	for _, c := range r.commands {
+		if c.availableTo(role) {
+			available = append(available, c)
+		}
+	}
+	return available
+}
+
+// Help - справка по командам, доступным роли, на языке запроса
+func (r *Registry) Help(ctx context.Context, role string) string {
+	lang := i18n.LangOf(ctx)
+	var b strings.Builder
+	b.WriteString(i18n.Tr(lang, "command.help_title") + "\n")
+	for _, c := range r.Available(role) {
+		fmt.Fprintf(&b, "%s - %s\n", c.signature(lang), i18n.Tr(lang, c.Description))
+	}
+	return b.String()
+}
+
+// BotCommands - меню команд для setMyCommands на языке lang
+func (r *Registry) BotCommands(lang i18n.Lang, role string) []tgbotapi.BotCommand {
+	available := r.Available(role)
+	commands := make([]tgbotapi.BotCommand, len(available))
+	for i, c := range available {
+		description := i18n.Tr(lang, c.Description)
+		if c.Usage != "" {
+			description += " " + i18n.Tr(lang, c.Usage)
+		}
+		commands[i] = tgbotapi.BotCommand{Command: c.Name, Description: description}
+	}
+	return commands
+}
+
+func (c *Command) availableTo(role string) bool {
+	if c.Public {
+		return true
+	}
+	if role == "" {
+		return false
+	}
+	return hasRole(c.Roles, role)
+}
+
+// hasRole - входит ли роль в список; пустой список разрешает любую роль
+func hasRole(roles []string, role string) bool {
+	if len(roles) == 0 {
+		return true
+	}
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Command) signature(lang i18n.Lang) string {
+	if c.Usage == "" {
+		return "/" + c.Name
+	}
+	return "/" + c.Name + " " + i18n.Tr(lang, c.Usage)
+}
@@ -0,0 +1,173 @@
+package command
+
+import (
+	"context"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"hospital/internal/models/role"
+	"hospital/internal/modules/view/telegram/dialog"
+	"hospital/internal/modules/view/telegram/i18n"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func newTestRegistry() *Registry {
+	echo := func(_ context.Context, call Call) dialog.Reply {
+		return dialog.Reply{Text: strings.Join(call.Args, ",")}
+	}
+	r := NewRegistry()
+	r.Register(
+		&Command{Name: "help", Description: "список команд", Public: true, Handler: echo},
+		&Command{Name: "patient", Usage: "<id>", Description: "карточка пациента", Args: 1, PrivateOnly: true, Handler: echo},
+		&Command{Name: "anomalies", Description: "подозрительные просмотры", Roles: []string{role.Admin}, Handler: echo},
+	)
+	return r
+}
+
+func TestParse(t *testing.T) {
+	for _, tt := range []struct {
+		text     string
+		wantName string
+		wantArgs []string
+		wantOk   bool
+	}{
+		{text: "/patient 12", wantName: "patient", wantArgs: []string{"12"}, wantOk: true},
+		{text: "/Patient@hospital_bot  12  3", wantName: "patient", wantArgs: []string{"12", "3"}, wantOk: true},
+		{text: "/help", wantName: "help", wantArgs: []string{}, wantOk: true},
+		{text: "Помощь"},
+		{text: "/"},
+		{text: ""},
+	} {
+		runner.Run(t, tt.text, func(t provider.T) {
+			name, args, ok := Parse(tt.text)
+			if ok != tt.wantOk || name != tt.wantName || ok && !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("Parse() = %q, %v, %v, want %q, %v, %v", name, args, ok, tt.wantName, tt.wantArgs, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestRegistry_Dispatch(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		text      string
+		role      string
+		group     bool
+		wantReply string
+		wantOk    bool
+	}{
+		{name: "Arguments are passed", text: "/patient 12", role: role.Doctor, wantReply: "12", wantOk: true},
+		{name: "Missing argument", text: "/patient", role: role.Doctor, wantReply: "Использование: /patient <id>", wantOk: true},
+		{
+			name:      "Not logged in",
+			text:      "/patient 12",
+			wantReply: "Войдите в систему: нажмите Войти или Зарегестрироваться",
+			wantOk:    true,
+		},
+		{name: "Public command", text: "/help", wantReply: "", wantOk: true},
+		{name: "Role restricted", text: "/anomalies", role: role.Nurse, wantReply: "Команда недоступна для вашей роли", wantOk: true},
+		{
+			name:      "Patient data is not shown in a group chat",
+			text:      "/patient 12",
+			role:      role.Doctor,
+			group:     true,
+			wantReply: "Команда /patient показывает данные пациентов и работает только в личном чате с ботом",
+			wantOk:    true,
+		},
+		{name: "Public command in a group chat", text: "/help 1", role: role.Doctor, group: true, wantReply: "1", wantOk: true},
+		{name: "Unknown command", text: "/unknown", role: role.Doctor},
+		{name: "Not a command", text: "Вывести все палаты", role: role.Doctor},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			reply, ok := newTestRegistry().Dispatch(context.Background(), tt.text, Call{ChatId: 1, Role: tt.role, Group: tt.group})
+			if ok != tt.wantOk || reply.Text != tt.wantReply {
+				t.Errorf("Dispatch() = %q, %v, want %q, %v", reply.Text, ok, tt.wantReply, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestRegistry_Help(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		role string
+		want string
+		menu []string
+	}{
+		{
+			name: "Guest",
+			want: "Команды:\n/help - список команд\n",
+			menu: []string{"help"},
+		},
+		{
+			name: "Doctor",
+			role: role.Doctor,
+			want: "Команды:\n/help - список команд\n/patient <id> - карточка пациента\n",
+			menu: []string{"help", "patient"},
+		},
+		{
+			name: "Admin",
+			role: role.Admin,
+			want: "Команды:\n/help - список команд\n/patient <id> - карточка пациента\n/anomalies - подозрительные просмотры\n",
+			menu: []string{"help", "patient", "anomalies"},
+		},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			r := newTestRegistry()
+			if got := r.Help(context.Background(), tt.role); got != tt.want {
+				t.Errorf("Help() = %q, want %q", got, tt.want)
+			}
+
+			var menu []string
+			for _, c := range r.BotCommands(i18n.RU, tt.role) {
+				menu = append(menu, c.Command)
+			}
+			if !reflect.DeepEqual(menu, tt.menu) {
+				t.Errorf("BotCommands() = %v, want %v", menu, tt.menu)
+			}
+		})
+	}
+}
+
+func TestRegistry_Menu(t *testing.T) {
+	r := NewRegistry()
+	r.RegisterMenu(
+		[]*Button{{Key: "register", Show: Guests}, {Key: "login", Show: SignedOut}, {Key: "help", Show: Everyone}},
+		[]*Button{{Key: "my_patients"}, {Key: "logout"}},
+		[]*Button{{Key: "record_vitals", Roles: []string{role.Nurse, role.Doctor}}},
+		[]*Button{{Key: "add_room", Roles: []string{role.Admin}}},
+	)
+
+	for _, tt := range []struct {
+		name   string
+		viewer Viewer
+		want   [][]string
+	}{
+		{
+			name: "Unregistered user",
+			want: [][]string{{"register", "help"}},
+		},
+		{
+			name:   "Registered user is not logged in",
+			viewer: Viewer{Registered: true},
+			want:   [][]string{{"login", "help"}},
+		},
+		{
+			name:   "Nurse",
+			viewer: Viewer{Registered: true, Role: role.Nurse},
+			want:   [][]string{{"help"}, {"my_patients", "logout"}, {"record_vitals"}},
+		},
+		{
+			name:   "Admin",
+			viewer: Viewer{Registered: true, Role: role.Admin},
+			want:   [][]string{{"help"}, {"my_patients", "logout"}, {"add_room"}},
+		},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			if got := r.Menu(tt.viewer); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Menu() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package command
+
+import "fmt"
+
+// Audience - кому показывается кнопка меню
+type Audience int
+
+const (
+	// Members - вошедшим врачам; Roles ограничивает их роли
+	Members Audience = iota
+	// Everyone - всем пользователям
+	Everyone
+	// Guests - тем, кто еще не зарегистрирован
+	Guests
+	// SignedOut - зарегистрированным врачам, которые не вошли в систему
+	SignedOut
+)
+
+// Button - кнопка клавиатуры меню
+type Button struct {
+	// Key - ключ каталога сообщений с текстом кнопки
+	Key  string
+	Show Audience
+	// Roles - роли, которым видна кнопка; пустой список - всем вошедшим
+	Roles []string
+}
+
+// Viewer - пользователь, для которого строится клавиатура
+type Viewer struct {
+	Registered bool
+	// Role - роль вошедшего врача; пустая, если пользователь не вошел
+	Role string
+}
+
+// Viewer - пользователь, вызвавший команду
+func (c Call) Viewer() Viewer {
+	return Viewer{Registered: c.Registered, Role: c.Role}
+}
+
+// RegisterMenu добавляет ряды кнопок клавиатуры меню в порядке показа
+func (r *Registry) RegisterMenu(rows ...[]*Button) {
+	for _, row := range rows {
+		for _, b := range row {
+			if r.buttons[b.Key] {
+				panic(fmt.Sprintf("command: duplicate menu button %s", b.Key))
+			}
+			r.buttons[b.Key] = true
+		}
+		r.menu = append(r.menu, row)
+	}
+}
+
+// Menu - ключи кнопок меню по рядам, доступные пользователю; пустые ряды пропускаются
+func (r *Registry) Menu(v Viewer) [][]string {
+	var rows [][]string
+	for _, row := range r.menu {
+		var keys []string
+		for _, b := range row {
+			if b.visibleTo(v) {
+				keys = append(keys, b.Key)
+			}
+		}
+		if len(keys) > 0 {
+			rows = append(rows, keys)
+		}
+	}
+	return rows
+}
+
+func (b *Button) visibleTo(v Viewer) bool {
+	switch b.Show {
+	case Everyone:
+		return true
+	case Guests:
+		return !v.Registered
+	case SignedOut:
+		return v.Registered && v.Role == ""
+	default:
+		return v.Role != "" && hasRole(b.Roles, v.Role)
+	}
+}
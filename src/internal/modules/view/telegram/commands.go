@@ -0,0 +1,380 @@
+package telegram
+
+import (
+	"context"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"go.uber.org/zap"
+	"hospital/internal/models/errors"
+	"hospital/internal/models/role"
+	"hospital/internal/modules/db"
+	"hospital/internal/modules/view/telegram/command"
+	"hospital/internal/modules/view/telegram/controllers"
+	"hospital/internal/modules/view/telegram/dialog"
+	"hospital/internal/modules/view/telegram/i18n"
+	"hospital/internal/modules/view/telegram/render"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// registerCommands подключает слэш-команды; справка и меню команд строятся по этому списку
+func registerCommands(commands *command.Registry, controller *controllers.Controller, dialogs *dialog.Engine, menu *commandMenu) {
+	commands.Register(
+		&command.Command{
+			Name:        "start",
+			Description: "command.start.description",
+			Public:      true,
+			Handler: func(ctx context.Context, call command.Call) dialog.Reply {
+				text := i18n.T(ctx, "command.start.greeting")
+				if call.Role != "" {
+					text = i18n.T(ctx, "command.start.menu")
+				}
+				return dialog.Reply{Text: text, Markup: menu.keyboard(ctx, call.ChatId, call.Viewer())}
+			},
+		},
+		&command.Command{
+			Name:        "help",
+			Description: "command.help.description",
+			Public:      true,
+			Handler: func(ctx context.Context, call command.Call) dialog.Reply {
+				return dialog.Reply{Text: helpText(ctx, commands, call.Role)}
+			},
+		},
+		&command.Command{
+			Name:        "patients",
+			Description: "command.patients.description",
+			PrivateOnly: true,
+			Handler: func(ctx context.Context, call command.Call) dialog.Reply {
+				return dialog.Reply{Text: getInfoAboutPatients(ctx, call.ChatId, controller), ParseMode: tgbotapi.ModeHTML}
+			},
+		},
+		&command.Command{
+			Name:        "patient",
+			Usage:       "command.patient.usage",
+			Description: "command.patient.description",
+			Args:        1,
+			PrivateOnly: true,
+			Handler: func(ctx context.Context, call command.Call) dialog.Reply {
+				id, err := strconv.Atoi(call.Args[0])
+				if err != nil {
+					return dialog.Reply{Text: i18n.T(ctx, "command.patient.invalid")}
+				}
+				return dialog.Reply{Text: patientCard(ctx, id, controller), ParseMode: tgbotapi.ModeHTML}
+			},
+		},
+		&command.Command{
+			Name:        "rooms",
+			Description: "command.rooms.description",
+			PrivateOnly: true,
+			Handler: func(ctx context.Context, call command.Call) dialog.Reply {
+				return dialog.Reply{Text: printAllRooms(ctx, call.ChatId, controller), ParseMode: tgbotapi.ModeHTML}
+			},
+		},
+		&command.Command{
+			Name:        "room",
+			Usage:       "command.room.usage",
+			Description: "command.room.description",
+			Args:        1,
+			PrivateOnly: true,
+			Handler: func(ctx context.Context, call command.Call) dialog.Reply {
+				num, err := strconv.Atoi(call.Args[0])
+				if err != nil {
+					return dialog.Reply{Text: i18n.T(ctx, "command.room.invalid")}
+				}
+				return dialog.Reply{Text: findRoom(ctx, num, controller), ParseMode: tgbotapi.ModeHTML}
+			},
+		},
+		&command.Command{
+			Name:        "anomalies",
+			Description: "command.anomalies.description",
+			Roles:       []string{role.Admin, role.HeadPhysician},
+			PrivateOnly: true,
+			Handler: func(ctx context.Context, _ command.Call) dialog.Reply {
+				return dialog.Reply{Text: printAccessAnomalies(ctx, controller)}
+			},
+		},
+		&command.Command{
+			Name:        "export",
+			Usage:       "command.export.usage",
+			Description: "command.export.description",
+			Args:        1,
+			Roles:       []string{role.Admin, role.HeadPhysician},
+			PrivateOnly: true,
+			Handler: func(ctx context.Context, call command.Call) dialog.Reply {
+				return exportFile(ctx, call.Args, controller)
+			},
+		},
+		&command.Command{
+			Name:        "grant_role",
+			Usage:       "command.grant_role.usage",
+			Description: "command.grant_role.description",
+			Args:        2,
+			Roles:       []string{role.Admin, role.HeadPhysician},
+			PrivateOnly: true,
+			Handler: func(ctx context.Context, call command.Call) dialog.Reply {
+				return dialog.Reply{Text: grantRole(ctx, call.Args[0], call.Args[1], controller)}
+			},
+		},
+		&command.Command{
+			Name:        "bind_group",
+			Usage:       "command.bind_group.usage",
+			Description: "command.bind_group.description",
+			Args:        1,
+			Roles:       []string{role.Admin, role.HeadPhysician},
+			Handler: func(ctx context.Context, call command.Call) dialog.Reply {
+				return bindGroup(ctx, call, controller)
+			},
+		},
+		&command.Command{
+			Name:        "unbind_group",
+			Description: "command.unbind_group.description",
+			Roles:       []string{role.Admin, role.HeadPhysician},
+			Handler: func(ctx context.Context, call command.Call) dialog.Reply {
+				return unbindGroup(ctx, call, controller)
+			},
+		},
+		&command.Command{
+			Name:        "group",
+			Description: "command.group.description",
+			Handler: func(ctx context.Context, call command.Call) dialog.Reply {
+				return groupInfo(ctx, call, controller)
+			},
+		},
+		&command.Command{
+			Name:        "language",
+			Usage:       "command.language.usage",
+			Description: "command.language.description",
+			Args:        1,
+			Handler: func(ctx context.Context, call command.Call) dialog.Reply {
+				lang, ok := i18n.Parse(call.Args[0])
+				if !ok {
+					return dialog.Reply{Text: i18n.T(ctx, "language.unknown", languageList())}
+				}
+				if _, err := controller.SetLanguage(ctx, string(lang)); err != nil {
+					return dialog.Reply{Text: i18n.T(ctx, "language.failed")}
+				}
+				// Ответ, меню команд и клавиатура сразу на новом языке
+				ctx = i18n.WithLang(ctx, lang)
+				if !call.Group {
+					menu.publish(call.ChatId, call.Role, lang)
+				}
+				return dialog.Reply{Text: i18n.T(ctx, "language.changed"), Markup: menu.keyboard(ctx, call.ChatId, call.Viewer())}
+			},
+		},
+		&command.Command{
+			Name:        "cancel",
+			Description: "command.cancel.description",
+			Public:      true,
+			Handler: func(ctx context.Context, call command.Call) dialog.Reply {
+				if dialogs.Cancel(ctx, call.ChatId) {
+					return dialog.Reply{Text: i18n.T(ctx, "dialog.cancelled")}
+				}
+				return dialog.Reply{Text: i18n.T(ctx, "command.cancel.nothing")}
+			},
+		},
+	)
+}
+
+// grantRole назначает роль врачу из клиники администратора
+func grantRole(ctx context.Context, id string, code string, controller *controllers.Controller) string {
+	doctorId, err := strconv.Atoi(id)
+	if err != nil {
+		return i18n.T(ctx, "role.invalid_id")
+	}
+	r, ok := roleCodes[strings.ToLower(code)]
+	if !ok {
+		return i18n.T(ctx, "role.unknown", code)
+	}
+	doctor, err := controller.SetDoctorRole(ctx, doctorId, r)
+	if err != nil {
+		return i18n.Error(ctx, err)
+	}
+	return i18n.T(ctx, "role.granted", doctor.Surname, roleName(ctx, doctor.Role))
+}
+
+func helpText(ctx context.Context, commands *command.Registry, role string) string {
+	return commands.Help(ctx, role) + i18n.T(ctx, "help.footer")
+}
+
+// languageList - коды поддерживаемых языков через запятую
+func languageList() string {
+	codes := make([]string, len(i18n.Langs))
+	for i, lang := range i18n.Langs {
+		codes[i] = string(lang)
+	}
+	return strings.Join(codes, ", ")
+}
+
+// patientCard - карточка пациента в HTML
+func patientCard(ctx context.Context, id int, controller *controllers.Controller) string {
+	patient, err := controller.Patient(ctx, id)
+	if err == errors.ErrDatabaseRecordNotFound {
+		return render.Escape(i18n.T(ctx, "patient.not_found", id))
+	}
+	if err != nil {
+		return render.Escape(i18n.T(ctx, "error.request"))
+	}
+	return render.Card(i18n.T(ctx, "patient.card", patient.Id),
+		render.Field{Label: i18n.T(ctx, "field.surname"), Value: patient.Surname},
+		render.Field{Label: i18n.T(ctx, "field.name"), Value: patient.Name},
+		render.Field{Label: i18n.T(ctx, "field.patronymic"), Value: patient.Patronymic},
+		render.Field{Label: i18n.T(ctx, "field.height"), Value: strconv.Itoa(patient.Height)},
+		render.Field{Label: i18n.T(ctx, "field.weight"), Value: strconv.FormatFloat(patient.Weight, 'g', -1, 64)},
+		render.Field{Label: i18n.T(ctx, "field.room"), Value: strconv.Itoa(patient.RoomNumber)},
+		render.Field{Label: i18n.T(ctx, "field.danger"), Value: strconv.Itoa(patient.DegreeOfDanger)},
+	)
+}
+
+// commandMenu публикует меню команд через setMyCommands: общее меню для тех, кто не вошел,
+// на каждом языке и меню чата по роли и языку врача. Меню чата обновляется, когда меняется роль или язык.
+// Еще commandMenu помнит, какая клавиатура меню отправлена в чат, чтобы заменить ее при смене роли.
+type commandMenu struct {
+	bot      Transport
+	commands *command.Registry
+	logger   *zap.Logger
+
+	mu        sync.Mutex
+	published map[int64]chatMenu
+	keyboards map[int64]chatKeyboard
+}
+
+// chatKeyboard - клавиатура меню, отправленная в чат; closed - пользователь ее убрал
+type chatKeyboard struct {
+	viewer command.Viewer
+	lang   i18n.Lang
+	closed bool
+}
+
+// chatMenu - опубликованное меню чата
+type chatMenu struct {
+	role string
+	lang i18n.Lang
+}
+
+func newCommandMenu(bot Transport, commands *command.Registry, logger *zap.Logger) *commandMenu {
+	return &commandMenu{
+		bot:       bot,
+		commands:  commands,
+		logger:    logger,
+		published: map[int64]chatMenu{},
+		keyboards: map[int64]chatKeyboard{},
+	}
+}
+
+// keyboard - клавиатура меню пользователя на языке запроса; запоминается как отправленная в чат
+func (r *commandMenu) keyboard(ctx context.Context, chatId int64, v command.Viewer) tgbotapi.ReplyKeyboardMarkup {
+	r.mu.Lock()
+	r.keyboards[chatId] = chatKeyboard{viewer: v, lang: i18n.LangOf(ctx)}
+	r.mu.Unlock()
+	return menuKeyboard(ctx, r.commands, v)
+}
+
+// refresh возвращает новую клавиатуру, если пользователь только что вошел, вышел или
+// зарегистрировался (changed) или с тех пор, как чат получил клавиатуру, сменил роль или язык.
+// Если бот не знает, какая клавиатура у чата, например после перезапуска, текущая запоминается.
+func (r *commandMenu) refresh(ctx context.Context, chatId int64, v command.Viewer, changed bool) (tgbotapi.ReplyKeyboardMarkup, bool) {
+	current := chatKeyboard{viewer: v, lang: i18n.LangOf(ctx)}
+	if !changed {
+		r.mu.Lock()
+		sent, ok := r.keyboards[chatId]
+		if !ok {
+			r.keyboards[chatId] = current
+		}
+		r.mu.Unlock()
+		if !ok || sent.closed || sent == current {
+			return tgbotapi.ReplyKeyboardMarkup{}, false
+		}
+	}
+	return r.keyboard(ctx, chatId, v), true
+}
+
+// close запоминает, что пользователь убрал клавиатуру: при смене роли она не возвращается
+func (r *commandMenu) close(chatId int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.keyboards[chatId] = chatKeyboard{closed: true}
+}
+
+// publishAll задает общее меню и меню чатов всех зарегистрированных врачей: вошедшим - по роли,
+// вышедшим - общее. Чаты, меню которых уже обновили сообщения врачей, не трогаются.
+func (r *commandMenu) publishAll(controller *controllers.Controller) {
+	scope := tgbotapi.NewBotCommandScopeDefault()
+	// Меню без кода языка Telegram показывает пользователям, для языка которых нет отдельного меню
+	configs := []tgbotapi.Chattable{tgbotapi.NewSetMyCommandsWithScope(scope, r.commands.BotCommands(i18n.Default, "")...)}
+	for _, lang := range i18n.Langs {
+		configs = append(configs,
+			tgbotapi.NewSetMyCommandsWithScopeAndLanguage(scope, string(lang), r.commands.BotCommands(lang, "")...))
+	}
+	for _, config := range configs {
+		if _, err := r.bot.Request(config); err != nil {
+			r.logger.Error("failed to set default commands", zap.Error(err))
+		}
+	}
+
+	ctx := db.SystemContext(context.Background())
+	doctors, err := controller.GetAllDoctors(ctx)
+	if err != nil {
+		r.logger.Error("failed to list doctors for commands", zap.Error(err))
+		return
+	}
+	for _, d := range doctors {
+		chatId, err := strconv.ParseInt(d.TokenId, 10, 64)
+		if err != nil {
+			continue
+		}
+		signedIn, err := controller.SignedIn(ctx, d.Id)
+		if err != nil {
+			r.logger.Error("failed to check doctor session for commands", zap.Int("doctorId", d.Id), zap.Error(err))
+			continue
+		}
+		menu := chatMenu{}
+		if signedIn {
+			menu.role = d.Role
+			menu.lang, _ = i18n.Parse(d.Language)
+		}
+		r.set(chatId, menu, true)
+	}
+}
+
+// publish задает меню чата по роли и языку; пустая роль возвращает чату общее меню
+func (r *commandMenu) publish(chatId int64, role string, lang i18n.Lang) {
+	r.set(chatId, chatMenu{role: role, lang: lang}, false)
+}
+
+// set публикует меню чата, если оно изменилось. Чату без известного меню общее меню
+// не отправляется: у него и так общее. При запуске (initial) бот не знает, какое меню
+// осталось у чата, поэтому меню задается, только если сообщения врача еще не обновили его.
+// Запрос к Telegram выполняется без блокировки: меню запоминается заранее и
+// забывается, если запрос не удался.
+func (r *commandMenu) set(chatId int64, menu chatMenu, initial bool) {
+	// Язык общего меню Telegram выбирает сам по языку пользователя
+	if menu.role == "" {
+		menu.lang = ""
+	}
+	r.mu.Lock()
+	current, ok := r.published[chatId]
+	if ok && (initial || current == menu) || !ok && !initial && menu.role == "" {
+		r.mu.Unlock()
+		return
+	}
+	r.published[chatId] = menu
+	r.mu.Unlock()
+
+	scope := tgbotapi.NewBotCommandScopeChat(chatId)
+	var config tgbotapi.Chattable = tgbotapi.NewDeleteMyCommandsWithScope(scope)
+	if menu.role != "" {
+		config = tgbotapi.NewSetMyCommandsWithScope(scope, r.commands.BotCommands(menu.lang, menu.role)...)
+	}
+	if _, err := r.bot.Request(config); err != nil {
+		r.logger.Warn("failed to set chat commands", zap.Int64("chatId", chatId), zap.Error(err))
+		r.mu.Lock()
+		if r.published[chatId] == menu {
+			if ok {
+				r.published[chatId] = current
+			} else {
+				delete(r.published, chatId)
+			}
+		}
+		r.mu.Unlock()
+	}
+}
@@ -0,0 +1,125 @@
+package confirm
+
+import (
+	"context"
+	"fmt"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"hospital/internal/models/errors"
+	"hospital/internal/models/session"
+	"hospital/internal/modules/config"
+	"hospital/internal/modules/view/telegram/callback"
+	"hospital/internal/modules/view/telegram/i18n"
+	"time"
+)
+
+// route - маршрут кнопок подтверждения
+const route = "cf"
+
+type ITokenStore interface {
+	Use(ctx context.Context, key string, expiresAt, now time.Time) (bool, error)
+}
+
+// Action описывает необратимое действие над записью
+type Action struct {
+	// Summary возвращает описание того, что будет удалено
+	Summary func(ctx context.Context, id int) (string, error)
+	// Execute выполняет действие и возвращает ответ пользователю
+	Execute func(ctx context.Context, id int) (string, error)
+}
+
+// payload - данные кнопки подтверждения: действие, запись, срок действия и выбор пользователя
+type payload struct {
+	Action  string
+	Id      int
+	Expires int64
+	Confirm bool
+}
+
+// Confirmer запрашивает подтверждение необратимых действий кнопками маршрута подтверждений.
+// Маршрут подписывает кнопку и привязывает ее к пользователю, Confirmer - к действию
+// и записи; подтверждение действует ограниченное время.
+type Confirmer struct {
+	ttl     time.Duration
+	now     func() time.Time
+	actions map[string]Action
+	route   *callback.Route[payload]
+	tokens  ITokenStore
+}
+
+func NewConfirmer(cfg config.Config, router *callback.Router, tokens ITokenStore) *Confirmer {
+	r := &Confirmer{
+		ttl:     cfg.ConfirmTTL,
+		now:     time.Now,
+		actions: map[string]Action{},
+		tokens:  tokens,
+	}
+	r.route = callback.Register(router, route, r.handle)
+	return r
+}
+
+// Register добавляет действие, требующее подтверждения
+func (r *Confirmer) Register(name string, action Action) {
+	r.actions[name] = action
+}
+
+// Ask формирует сообщение с описанием действия и кнопками подтверждения и отмены
+func (r *Confirmer) Ask(ctx context.Context, name string, id int, userId int64) (string, tgbotapi.InlineKeyboardMarkup, error) {
+	action, ok := r.actions[name]
+	if !ok {
+		return "", tgbotapi.InlineKeyboardMarkup{}, fmt.Errorf("неизвестное действие %q", name)
+	}
+
+	summary, err := action.Summary(ctx, id)
+	if err != nil {
+		return "", tgbotapi.InlineKeyboardMarkup{}, err
+	}
+
+	p := payload{Action: name, Id: id, Expires: r.now().Add(r.ttl).Unix(), Confirm: true}
+	yes, err := r.route.Button(i18n.T(ctx, "confirm.yes"), userId, p)
+	if err != nil {
+		return "", tgbotapi.InlineKeyboardMarkup{}, err
+	}
+	p.Confirm = false
+	no, err := r.route.Button(i18n.T(ctx, "confirm.no"), userId, p)
+	if err != nil {
+		return "", tgbotapi.InlineKeyboardMarkup{}, err
+	}
+
+	return summary + "\n\n" + i18n.T(ctx, "confirm.ask"), tgbotapi.NewInlineKeyboardMarkup(tgbotapi.NewInlineKeyboardRow(yes, no)), nil
+}
+
+// handle выполняет действие, если пользователь вошел в систему и подтвердил его вовремя.
+// Подпись и владельца кнопки уже проверил маршрут.
+func (r *Confirmer) handle(ctx context.Context, q callback.Query, p payload) (callback.Reply, error) {
+	if _, ok := session.GetSessionFromCtx(ctx); !ok {
+		return callback.Reply{Text: i18n.T(ctx, "bot.login_required")}, nil
+	}
+
+	action, ok := r.actions[p.Action]
+	if !ok {
+		return callback.Reply{}, errors.ErrInvalidToken
+	}
+
+	now := r.now()
+	if now.Unix() > p.Expires {
+		return callback.Reply{}, errors.ErrConfirmExpired
+	}
+	// Подтверждение и отмена одного запроса взаимоисключающие: срабатывает первое нажатие
+	key := fmt.Sprintf("%s|%d|%d|%d", p.Action, p.Id, q.UserId, p.Expires)
+	fresh, err := r.tokens.Use(ctx, key, time.Unix(p.Expires, 0), now)
+	if err != nil {
+		return callback.Reply{}, err
+	}
+	if !fresh {
+		return callback.Reply{}, errors.ErrConfirmExpired
+	}
+
+	if !p.Confirm {
+		return callback.Reply{Text: i18n.T(ctx, "dialog.cancelled")}, nil
+	}
+	text, err := action.Execute(ctx, p.Id)
+	if err != nil {
+		return callback.Reply{}, err
+	}
+	return callback.Reply{Text: text}, nil
+}
@@ -0,0 +1,219 @@
+package confirm
+
+import (
+	"context"
+	"errors"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	err_c "hospital/internal/models/errors"
+	"hospital/internal/models/session"
+	"hospital/internal/modules/config"
+	"hospital/internal/modules/view/telegram/callback"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeTokens - использованные кнопки в памяти вместо базы
+type fakeTokens map[string]time.Time
+
+func (f fakeTokens) Use(_ context.Context, key string, expiresAt, _ time.Time) (bool, error) {
+	if _, ok := f[key]; ok {
+		return false, nil
+	}
+	f[key] = expiresAt
+	return true, nil
+}
+
+func newTestConfirmer(t provider.T, now *time.Time, deleted *[]int) (*Confirmer, *callback.Router) {
+	return newTestConfirmerWith(t, fakeTokens{}, now, deleted)
+}
+
+func newTestConfirmerWith(t provider.T, tokens ITokenStore, now *time.Time, deleted *[]int) (*Confirmer, *callback.Router) {
+	cfg := config.Config{Secret: "secret", ConfirmTTL: 2 * time.Minute}
+	router, err := callback.NewRouter(cfg)
+	if err != nil {
+		t.Fatalf("NewRouter() error = %v", err)
+	}
+	c := NewConfirmer(cfg, router, tokens)
+	c.now = func() time.Time { return *now }
+	c.Register("dp", Action{
+		Summary: func(ctx context.Context, id int) (string, error) {
+			return "Пациент Иванов", nil
+		},
+		Execute: func(ctx context.Context, id int) (string, error) {
+			*deleted = append(*deleted, id)
+			return "Удалено", nil
+		},
+	})
+	return c, router
+}
+
+func buttons(t provider.T, c *Confirmer, userId int64) (string, string) {
+	_, markup, err := c.Ask(context.Background(), "dp", 42, userId)
+	if err != nil {
+		t.Fatalf("Ask() error = %v", err)
+	}
+	row := markup.InlineKeyboard[0]
+	return *row[0].CallbackData, *row[1].CallbackData
+}
+
+func signedIn(userId int64) context.Context {
+	return session.SetSessionToCtx(context.Background(), session.Session{SessionID: "s", UserId: int(userId)})
+}
+
+func TestConfirmer_Ask(t *testing.T) {
+	runner.Run(t, "Callback data fits Telegram limit", func(t provider.T) {
+		now := time.Date(2023, 10, 1, 8, 0, 0, 0, time.UTC)
+		var deleted []int
+		c, _ := newTestConfirmer(t, &now, &deleted)
+
+		text, markup, err := c.Ask(context.Background(), "dp", 1234567, 9223372036854775807)
+		if err != nil {
+			t.Fatalf("Ask() error = %v", err)
+		}
+		if !strings.Contains(text, "Пациент Иванов") {
+			t.Errorf("Ask() text = %q", text)
+		}
+		for _, b := range markup.InlineKeyboard[0] {
+			if len(*b.CallbackData) > 64 {
+				t.Errorf("callback data is %d bytes", len(*b.CallbackData))
+			}
+		}
+		if len(deleted) != 0 {
+			t.Errorf("action executed before confirmation")
+		}
+	})
+
+	runner.Run(t, "Unknown action", func(t provider.T) {
+		now := time.Now()
+		var deleted []int
+		c, _ := newTestConfirmer(t, &now, &deleted)
+		if _, _, err := c.Ask(context.Background(), "dr", 1, 1); err == nil {
+			t.Errorf("Ask() error = nil, want error")
+		}
+	})
+}
+
+func TestConfirmer_Handle(t *testing.T) {
+	start := time.Date(2023, 10, 1, 8, 0, 0, 0, time.UTC)
+	id := "|" + strconv.FormatInt(42, 36) + "|"
+
+	for _, tt := range []struct {
+		name        string
+		userId      int64
+		signedOut   bool
+		elapsed     time.Duration
+		cancel      bool
+		tamper      func(string) string
+		wantErr     error
+		wantText    string
+		wantDeleted int
+	}{
+		{
+			name:        "Confirmed by the same user",
+			userId:      7,
+			wantText:    "Удалено",
+			wantDeleted: 1,
+		},
+		{
+			name:     "Cancelled",
+			userId:   7,
+			cancel:   true,
+			wantText: "Действие отменено",
+		},
+		{
+			name:      "Signed out",
+			userId:    7,
+			signedOut: true,
+			wantText:  "Войдите в систему",
+		},
+		{
+			name:    "Another user",
+			userId:  8,
+			wantErr: err_c.ErrCallbackForeign,
+		},
+		{
+			name:    "Expired",
+			userId:  7,
+			elapsed: 3 * time.Minute,
+			wantErr: err_c.ErrConfirmExpired,
+		},
+		{
+			name:    "Tampered record id",
+			userId:  7,
+			tamper:  func(s string) string { return strings.Replace(s, id, "|17|", 1) },
+			wantErr: err_c.ErrInvalidToken,
+		},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			now := start
+			var deleted []int
+			c, router := newTestConfirmer(t, &now, &deleted)
+
+			yes, no := buttons(t, c, 7)
+			data := yes
+			if tt.cancel {
+				data = no
+			}
+			if tt.tamper != nil {
+				data = tt.tamper(data)
+			}
+			now = now.Add(tt.elapsed)
+
+			ctx := signedIn(tt.userId)
+			if tt.signedOut {
+				ctx = context.Background()
+			}
+			reply, err := router.Handle(ctx, callback.Query{UserId: tt.userId}, data)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Handle() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantText != "" && !strings.Contains(reply.Text, tt.wantText) {
+				t.Errorf("Handle() text = %q, want %q", reply.Text, tt.wantText)
+			}
+			if len(deleted) != tt.wantDeleted {
+				t.Errorf("executed %d times, want %d", len(deleted), tt.wantDeleted)
+			}
+		})
+	}
+
+	runner.Run(t, "Token is single use", func(t provider.T) {
+		now := start
+		var deleted []int
+		c, router := newTestConfirmer(t, &now, &deleted)
+
+		yes, no := buttons(t, c, 7)
+		if _, err := router.Handle(signedIn(7), callback.Query{UserId: 7}, no); err != nil {
+			t.Fatalf("Handle() error = %v", err)
+		}
+		if _, err := router.Handle(signedIn(7), callback.Query{UserId: 7}, yes); !errors.Is(err, err_c.ErrConfirmExpired) {
+			t.Errorf("Handle() error = %v, want %v", err, err_c.ErrConfirmExpired)
+		}
+		if len(deleted) != 0 {
+			t.Errorf("action executed after cancel")
+		}
+	})
+
+	runner.Run(t, "Used token survives restart", func(t provider.T) {
+		now := start
+		var deleted []int
+		tokens := fakeTokens{}
+		c, router := newTestConfirmerWith(t, tokens, &now, &deleted)
+
+		yes, no := buttons(t, c, 7)
+		if _, err := router.Handle(signedIn(7), callback.Query{UserId: 7}, no); err != nil {
+			t.Fatalf("Handle() error = %v", err)
+		}
+
+		// Новый экземпляр бота с тем же секретом и той же базой
+		_, restarted := newTestConfirmerWith(t, tokens, &now, &deleted)
+		if _, err := restarted.Handle(signedIn(7), callback.Query{UserId: 7}, yes); !errors.Is(err, err_c.ErrConfirmExpired) {
+			t.Errorf("Handle() error = %v, want %v", err, err_c.ErrConfirmExpired)
+		}
+		if len(deleted) != 0 {
+			t.Errorf("action executed after cancel")
+		}
+	})
+}
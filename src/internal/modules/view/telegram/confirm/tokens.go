@@ -0,0 +1,46 @@
+package confirm
+
+import (
+	"context"
+	"hospital/internal/modules/db"
+	"hospital/internal/modules/db/ent"
+	"hospital/internal/modules/db/ent/confirmtoken"
+	"time"
+)
+
+// TokenStore хранит использованные кнопки подтверждения в базе, чтобы повторное нажатие
+// не срабатывало после перезапуска бота и на других его экземплярах
+type TokenStore struct {
+	client *ent.Client
+}
+
+func NewTokenStore(client *ent.Client) *TokenStore {
+	return &TokenStore{
+		client: client,
+	}
+}
+
+// Use помечает кнопку key использованной до expiresAt и возвращает false, если ее уже
+// использовали. Заодно удаляются записи о кнопках, срок которых истек к now.
+func (r *TokenStore) Use(ctx context.Context, key string, expiresAt, now time.Time) (bool, error) {
+	ctx = db.SystemContext(ctx)
+	_, err := r.client.ConfirmToken.Delete().
+		Where(confirmtoken.ExpiresAtLT(now)).
+		Exec(ctx)
+	if err != nil {
+		return false, db.WrapError(err)
+	}
+
+	err = r.client.ConfirmToken.Create().
+		SetKey(key).
+		SetExpiresAt(expiresAt).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, db.WrapError(err)
+	}
+
+	return true, nil
+}
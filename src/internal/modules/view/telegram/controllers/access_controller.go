@@ -0,0 +1,16 @@
+package controllers
+
+import (
+	"context"
+	dto1 "hospital/internal/modules/domain/access/dto"
+)
+
+func (r *Controller) PatientAccessReport(ctx context.Context, patientId int) (dto1.AccessRecords, error) {
+	records, err := r.accessService.Report(ctx, patientId)
+	return records, err
+}
+
+func (r *Controller) AccessAnomalies(ctx context.Context) (dto1.Anomalies, error) {
+	anomalies, err := r.accessService.Anomalies(ctx)
+	return anomalies, err
+}
@@ -0,0 +1,46 @@
+package controllers
+
+import (
+	"context"
+	"hospital/internal/modules/domain/alert/dto"
+)
+
+func (r *Controller) DueAlerts(ctx context.Context, limit int) (dto.Deliveries, error) {
+	deliveries, err := r.alertService.Due(ctx, limit)
+	return deliveries, err
+}
+
+func (r *Controller) AlertSent(ctx context.Context, id int) error {
+	err := r.alertService.MarkSent(ctx, id)
+	return err
+}
+
+func (r *Controller) AlertFailed(ctx context.Context, id int) error {
+	err := r.alertService.MarkFailed(ctx, id)
+	return err
+}
+
+func (r *Controller) AlertPreference(ctx context.Context) (*dto.Preference, error) {
+	pref, err := r.alertService.Preference(ctx)
+	return pref, err
+}
+
+func (r *Controller) SetAlertPreference(ctx context.Context, dtm *dto.UpdatePreference) (*dto.Preference, error) {
+	pref, err := r.alertService.SetPreference(ctx, dtm)
+	return pref, err
+}
+
+func (r *Controller) AcknowledgeAlert(ctx context.Context, id int) (*dto.Alert, error) {
+	alert, err := r.alertService.Acknowledge(ctx, id)
+	return alert, err
+}
+
+func (r *Controller) EscalateAlerts(ctx context.Context) error {
+	err := r.alertService.Escalate(ctx)
+	return err
+}
+
+func (r *Controller) AlertAckReport(ctx context.Context) (*dto.AckReport, error) {
+	report, err := r.alertService.AckReport(ctx)
+	return report, err
+}
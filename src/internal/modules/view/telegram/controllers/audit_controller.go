@@ -0,0 +1,11 @@
+package controllers
+
+import (
+	"context"
+	dto1 "hospital/internal/modules/domain/audit/dto"
+)
+
+func (r *Controller) History(ctx context.Context, entity string, id int) (dto1.AuditRecords, error) {
+	records, err := r.auditService.History(ctx, entity, id)
+	return records, err
+}
@@ -1,19 +1,33 @@
 package controllers
 
 import (
+	access_serv "hospital/internal/modules/domain/access/service"
+	alert_serv "hospital/internal/modules/domain/alert/service"
+	audit_serv "hospital/internal/modules/domain/audit/service"
 	auth_serv "hospital/internal/modules/domain/auth/service"
+	department_serv "hospital/internal/modules/domain/department/service"
 	disease_servis "hospital/internal/modules/domain/disease/service"
 	doctor_server "hospital/internal/modules/domain/doctor/service"
+	export_serv "hospital/internal/modules/domain/export/service"
 	patient_servis "hospital/internal/modules/domain/patient/service"
 	room_servis "hospital/internal/modules/domain/room/service"
+	session_servis "hospital/internal/modules/domain/session/service"
+	ward_serv "hospital/internal/modules/domain/ward/service"
 )
 
 type Controller struct {
-	doctorService  *doctor_server.DoctorService
-	authService    *auth_serv.AuthService
-	patientService *patient_servis.PatientService
-	roomService    *room_servis.RoomService
-	diseaseService *disease_servis.DiseaseService
+	doctorService     *doctor_server.DoctorService
+	authService       *auth_serv.AuthService
+	patientService    *patient_servis.PatientService
+	roomService       *room_servis.RoomService
+	diseaseService    *disease_servis.DiseaseService
+	sessionService    *session_servis.SessionService
+	auditService      *audit_serv.AuditService
+	accessService     *access_serv.AccessService
+	departmentService *department_serv.DepartmentService
+	alertService      *alert_serv.AlertService
+	exportService     *export_serv.ExportService
+	wardService       *ward_serv.WardService
 }
 
 func NewController(
@@ -22,14 +36,28 @@ func NewController(
 	patientService *patient_servis.PatientService,
 	roomService *room_servis.RoomService,
 	diseaseService *disease_servis.DiseaseService,
+	sessionService *session_servis.SessionService,
+	auditService *audit_serv.AuditService,
+	accessService *access_serv.AccessService,
+	departmentService *department_serv.DepartmentService,
+	alertService *alert_serv.AlertService,
+	exportService *export_serv.ExportService,
+	wardService *ward_serv.WardService,
 ) *Controller {
 
 	r := &Controller{
-		authService:    authService,
-		doctorService:  doctorService,
-		patientService: patientService,
-		roomService:    roomService,
-		diseaseService: diseaseService,
+		authService:       authService,
+		doctorService:     doctorService,
+		patientService:    patientService,
+		roomService:       roomService,
+		diseaseService:    diseaseService,
+		sessionService:    sessionService,
+		auditService:      auditService,
+		accessService:     accessService,
+		departmentService: departmentService,
+		alertService:      alertService,
+		exportService:     exportService,
+		wardService:       wardService,
 	}
 
 	return r
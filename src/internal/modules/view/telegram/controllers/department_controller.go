@@ -0,0 +1,31 @@
+package controllers
+
+import (
+	"context"
+	dto1 "hospital/internal/modules/domain/department/dto"
+)
+
+func (r *Controller) GetAllDepartments(ctx context.Context) (dto1.Departments, error) {
+	departments, err := r.departmentService.List(ctx)
+	return departments, err
+}
+
+func (r *Controller) AddDepartment(ctx context.Context, dtm *dto1.CreateDepartment) (*dto1.Department, error) {
+	department, err := r.departmentService.Create(ctx, dtm)
+	return department, err
+}
+
+func (r *Controller) AssignDepartment(ctx context.Context, doctorId int, departmentId int) (*dto1.Department, error) {
+	department, err := r.departmentService.AssignDoctor(ctx, doctorId, departmentId)
+	return department, err
+}
+
+func (r *Controller) GetAllOrganizations(ctx context.Context) (dto1.Organizations, error) {
+	organizations, err := r.departmentService.ListOrganizations(ctx)
+	return organizations, err
+}
+
+func (r *Controller) AddOrganization(ctx context.Context, dtm *dto1.CreateOrganization) (*dto1.Organization, error) {
+	organization, err := r.departmentService.CreateOrganization(ctx, dtm)
+	return organization, err
+}
@@ -2,7 +2,10 @@ package controllers
 
 import (
 	"context"
+	"hospital/internal/models/errors"
+	"hospital/internal/models/session"
 	dto1 "hospital/internal/modules/domain/doctor/dto"
+	"hospital/internal/modules/view/telegram/i18n"
 )
 
 func (r *Controller) Doctor(ctx context.Context, id int) (*dto1.Doctor, error) {
@@ -11,8 +14,54 @@ func (r *Controller) Doctor(ctx context.Context, id int) (*dto1.Doctor, error) {
 	return user, err
 }
 
+// WithLanguage кладет в контекст язык врача с этим токеном, если врач его выбирал
+func (r *Controller) WithLanguage(ctx context.Context, token string) context.Context {
+	doctor, err := r.doctorService.GetByTokenId(ctx, token)
+	if err != nil {
+		return ctx
+	}
+	if lang, ok := i18n.Parse(doctor.Language); ok {
+		return i18n.WithLang(ctx, lang)
+	}
+	return ctx
+}
+
 func (r *Controller) DoctorToken(ctx context.Context, token string) (*dto1.Doctor, error) {
 	user, err := r.doctorService.GetByTokenId(ctx, token)
 
 	return user, err
 }
+
+func (r *Controller) GetAllDoctors(ctx context.Context) (dto1.Doctors, error) {
+	doctors, err := r.doctorService.List(ctx)
+	return doctors, err
+}
+
+func (r *Controller) SetDoctorRole(ctx context.Context, id int, role string) (*dto1.Doctor, error) {
+	doctor, err := r.doctorService.SetRole(ctx, id, role)
+	return doctor, err
+}
+
+// SetLanguage задает язык интерфейса врачу текущей сессии
+func (r *Controller) SetLanguage(ctx context.Context, lang string) (*dto1.Doctor, error) {
+	s, ok := session.GetSessionFromCtx(ctx)
+	if !ok {
+		return nil, errors.ErrUnauthorized
+	}
+
+	return r.doctorService.SetLanguage(ctx, s.UserId, lang)
+}
+
+// ToggleOnCall начинает или заканчивает дежурство врача текущей сессии
+func (r *Controller) ToggleOnCall(ctx context.Context) (*dto1.Doctor, error) {
+	s, ok := session.GetSessionFromCtx(ctx)
+	if !ok {
+		return nil, errors.ErrUnauthorized
+	}
+
+	doctor, err := r.doctorService.GetById(ctx, s.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return r.doctorService.SetOnCall(ctx, s.UserId, !doctor.OnCall)
+}
@@ -0,0 +1,11 @@
+package controllers
+
+import (
+	"context"
+	"hospital/internal/modules/domain/export/dto"
+)
+
+func (r *Controller) Export(ctx context.Context, req *dto.Request) (*dto.File, error) {
+	file, err := r.exportService.Export(ctx, req)
+	return file, err
+}
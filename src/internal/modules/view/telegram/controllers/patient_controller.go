@@ -20,3 +20,28 @@ func (r *Controller) GetAllPatients(ctx context.Context) (dto1.Patients, error)
 	user, err := r.patientService.List(ctx)
 	return user, err
 }
+
+func (r *Controller) DeletePatient(ctx context.Context, id int) error {
+	err := r.patientService.Delete(ctx, id)
+	return err
+}
+
+func (r *Controller) SearchPatients(ctx context.Context, query string) (dto1.Patients, error) {
+	patients, err := r.patientService.Search(ctx, query)
+	return patients, err
+}
+
+func (r *Controller) SetPatientDisease(ctx context.Context, id int, diseaseId int) (*dto1.Patient, error) {
+	patient, err := r.patientService.SetDisease(ctx, id, diseaseId)
+	return patient, err
+}
+
+func (r *Controller) AssignPatientDoctor(ctx context.Context, id int, doctorId int) (*dto1.Patient, error) {
+	patient, err := r.patientService.AssignDoctor(ctx, id, doctorId)
+	return patient, err
+}
+
+func (r *Controller) RecordVitals(ctx context.Context, id int, vitals *dto1.CreateVitals) (*dto1.Vitals, error) {
+	res, err := r.patientService.RecordVitals(ctx, id, vitals)
+	return res, err
+}
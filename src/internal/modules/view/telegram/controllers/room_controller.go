@@ -2,15 +2,27 @@ package controllers
 
 import (
 	"context"
+	patient_dto "hospital/internal/modules/domain/patient/dto"
 	dto1 "hospital/internal/modules/domain/room/dto"
 )
 
 func (r *Controller) Room(ctx context.Context, id int) (*dto1.Room, error) {
-	user, err := r.roomService.GetByNum(ctx, id)
+	user, err := r.roomService.GetById(ctx, id)
 
 	return user, err
 }
 
+// RoomCard возвращает палату по номеру и ее пациентов
+func (r *Controller) RoomCard(ctx context.Context, num int) (*dto1.Room, patient_dto.Patients, error) {
+	room, err := r.roomService.GetByNum(ctx, num)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	patients, err := r.patientService.ListByRoom(ctx, room.Id)
+	return room, patients, err
+}
+
 func (r *Controller) AddRoom(ctx context.Context, room *dto1.CreateRoom) (*dto1.Room, error) {
 	user, err := r.roomService.Create(ctx, room)
 	return user, err
@@ -20,3 +32,8 @@ func (r *Controller) GetAllRooms(ctx context.Context) (dto1.Rooms, error) {
 	room, err := r.roomService.List(ctx)
 	return room, err
 }
+
+func (r *Controller) DeleteRoom(ctx context.Context, id int) error {
+	err := r.roomService.Delete(ctx, id)
+	return err
+}
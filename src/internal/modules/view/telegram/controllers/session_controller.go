@@ -0,0 +1,74 @@
+package controllers
+
+import (
+	"context"
+	"hospital/internal/models/errors"
+	"hospital/internal/models/session"
+	department_dto "hospital/internal/modules/domain/department/dto"
+	dto1 "hospital/internal/modules/domain/session/dto"
+	"hospital/internal/modules/view/telegram/i18n"
+)
+
+func (r *Controller) Login(ctx context.Context, token string) (*dto1.Session, error) {
+	doctor, err := r.doctorService.GetByTokenId(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.sessionService.Start(ctx, doctor.Id)
+}
+
+// Authenticate находит действующую сессию врача и кладет ее в контекст вместе с языком врача.
+// Если врача с таким токеном нет, возвращает ErrNotRegistered.
+func (r *Controller) Authenticate(ctx context.Context, token string) (context.Context, error) {
+	doctor, err := r.doctorService.GetByTokenId(ctx, token)
+	if err == errors.ErrDatabaseRecordNotFound {
+		return ctx, errors.ErrNotRegistered
+	}
+	if err != nil {
+		return ctx, errors.ErrUnauthorized
+	}
+	if lang, ok := i18n.Parse(doctor.Language); ok {
+		ctx = i18n.WithLang(ctx, lang)
+	}
+
+	s, err := r.sessionService.Current(ctx, doctor.Id)
+	if err != nil {
+		return ctx, err
+	}
+
+	return r.sessionService.WithSession(ctx, s, doctor.Role), nil
+}
+
+func (r *Controller) Logout(ctx context.Context) error {
+	s, ok := session.GetSessionFromCtx(ctx)
+	if !ok {
+		return errors.ErrUnauthorized
+	}
+
+	return r.sessionService.Logout(ctx, s.SessionID)
+}
+
+func (r *Controller) LogoutAll(ctx context.Context) (int, error) {
+	s, ok := session.GetSessionFromCtx(ctx)
+	if !ok {
+		return 0, errors.ErrUnauthorized
+	}
+
+	return r.sessionService.LogoutAll(ctx, s.UserId)
+}
+
+func (r *Controller) MyDepartments(ctx context.Context) (department_dto.Departments, error) {
+	departments, err := r.sessionService.Departments(ctx)
+	return departments, err
+}
+
+func (r *Controller) SwitchDepartment(ctx context.Context, departmentId int) (*department_dto.Department, error) {
+	department, err := r.sessionService.SwitchDepartment(ctx, departmentId)
+	return department, err
+}
+
+// SignedIn проверяет, вошел ли врач, не продлевая его сессию
+func (r *Controller) SignedIn(ctx context.Context, doctorId int) (bool, error) {
+	return r.sessionService.SignedIn(ctx, doctorId)
+}
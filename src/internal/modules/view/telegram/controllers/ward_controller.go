@@ -0,0 +1,37 @@
+package controllers
+
+import (
+	"context"
+	"hospital/internal/modules/domain/ward/dto"
+)
+
+func (r *Controller) BindWardChat(ctx context.Context, dtm *dto.BindWardChat) (*dto.WardChat, error) {
+	chat, err := r.wardService.Bind(ctx, dtm)
+	return chat, err
+}
+
+func (r *Controller) UnbindWardChat(ctx context.Context, chatId int64) error {
+	return r.wardService.Unbind(ctx, chatId)
+}
+
+func (r *Controller) WardChat(ctx context.Context, chatId int64) (*dto.WardChat, error) {
+	chat, err := r.wardService.Get(ctx, chatId)
+	return chat, err
+}
+
+func (r *Controller) ForgetWardChat(ctx context.Context, chatId int64) error {
+	return r.wardService.Forget(ctx, chatId)
+}
+
+func (r *Controller) DueWardNotices(ctx context.Context, limit int) (dto.Notices, error) {
+	notices, err := r.wardService.Due(ctx, limit)
+	return notices, err
+}
+
+func (r *Controller) WardNoticeSent(ctx context.Context, id int) error {
+	return r.wardService.MarkSent(ctx, id)
+}
+
+func (r *Controller) WardNoticeFailed(ctx context.Context, id int) error {
+	return r.wardService.MarkFailed(ctx, id)
+}
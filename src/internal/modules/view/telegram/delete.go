@@ -0,0 +1,112 @@
+package telegram
+
+import (
+	"context"
+	"hospital/internal/models/errors"
+	"hospital/internal/modules/view/telegram/confirm"
+	"hospital/internal/modules/view/telegram/controllers"
+	"hospital/internal/modules/view/telegram/dialog"
+	"hospital/internal/modules/view/telegram/form"
+	"hospital/internal/modules/view/telegram/i18n"
+)
+
+const (
+	actionDeletePatient = "dp"
+	actionDeleteRoom    = "dr"
+)
+
+// registerDeleteActions подключает удаление пациентов и палат к подтверждению
+func registerDeleteActions(confirmer *confirm.Confirmer, controller *controllers.Controller) {
+	confirmer.Register(actionDeletePatient, confirm.Action{
+		Summary: func(ctx context.Context, id int) (string, error) {
+			patient, err := controller.Patient(ctx, id)
+			if err != nil {
+				return "", err
+			}
+			return i18n.T(ctx, "delete.patient_summary",
+				patient.Id, patient.Surname, patient.Name, patient.Patronymic, patient.RoomNumber), nil
+		},
+		Execute: func(ctx context.Context, id int) (string, error) {
+			if err := controller.DeletePatient(ctx, id); err != nil {
+				return deleteFailed(ctx, err), nil
+			}
+			return i18n.T(ctx, "delete.patient_done"), nil
+		},
+	})
+	confirmer.Register(actionDeleteRoom, confirm.Action{
+		Summary: func(ctx context.Context, id int) (string, error) {
+			room, err := controller.Room(ctx, id)
+			if err != nil {
+				return "", err
+			}
+			return i18n.T(ctx, "delete.room_summary",
+				room.Id, room.Num, room.Floor, room.NumberPatients), nil
+		},
+		Execute: func(ctx context.Context, id int) (string, error) {
+			if err := controller.DeleteRoom(ctx, id); err != nil {
+				return deleteFailed(ctx, err), nil
+			}
+			return i18n.T(ctx, "delete.room_done"), nil
+		},
+	})
+}
+
+// deleteFailed - ответ пользователю, если удалить запись не удалось
+func deleteFailed(ctx context.Context, err error) string {
+	switch err {
+	case errors.ErrAccessDenied, errors.ErrDatabaseRecordNotFound:
+		return i18n.Error(ctx, err)
+	default:
+		return i18n.T(ctx, "delete.failed")
+	}
+}
+
+// patientSelection - ответы формы выбора пациента из результатов поиска
+type patientSelection struct {
+	Query     string
+	PatientId int
+}
+
+// patientSearchFields - поиск пациента по фамилии или имени и выбор из найденных
+func patientSearchFields(controller *controllers.Controller) []form.Field {
+	return []form.Field{
+		{Name: "query", Prompt: "patient.prompt.query"},
+		{Name: "patientId", Prompt: "patient.prompt.choose", Kind: form.Int, Choices: patientChoices(controller, "query")},
+	}
+}
+
+func deletePatientForm(controller *controllers.Controller, confirmer *confirm.Confirmer) *form.Form[patientSelection] {
+	return &form.Form[patientSelection]{
+		Name:   "button.delete_patient",
+		Fields: patientSearchFields(controller),
+		Submit: func(ctx context.Context, c *dialog.Conversation, s *patientSelection) dialog.Reply {
+			return askConfirm(ctx, actionDeletePatient, s.PatientId, c.UserId, confirmer)
+		},
+	}
+}
+
+// roomSelection - ответ формы выбора палаты
+type roomSelection struct {
+	RoomId int
+}
+
+func deleteRoomForm(controller *controllers.Controller, confirmer *confirm.Confirmer) *form.Form[roomSelection] {
+	return &form.Form[roomSelection]{
+		Name: "button.delete_room",
+		Fields: []form.Field{
+			{Name: "roomId", Prompt: "room.prompt.choose", Kind: form.Int, Choices: roomChoices(controller, false)},
+		},
+		Submit: func(ctx context.Context, c *dialog.Conversation, s *roomSelection) dialog.Reply {
+			return askConfirm(ctx, actionDeleteRoom, s.RoomId, c.UserId, confirmer)
+		},
+	}
+}
+
+// askConfirm показывает, что будет удалено, и прикладывает кнопки подтверждения
+func askConfirm(ctx context.Context, action string, id int, userId int64, confirmer *confirm.Confirmer) dialog.Reply {
+	text, markup, err := confirmer.Ask(ctx, action, id, userId)
+	if err != nil {
+		return dialog.Reply{Text: i18n.Error(ctx, err)}
+	}
+	return dialog.Reply{Text: text, Markup: markup}
+}
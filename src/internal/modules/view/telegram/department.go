@@ -0,0 +1,163 @@
+package telegram
+
+import (
+	"context"
+	"hospital/internal/models/errors"
+	"hospital/internal/modules/domain/department/dto"
+	"hospital/internal/modules/view/telegram/controllers"
+	"hospital/internal/modules/view/telegram/dialog"
+	"hospital/internal/modules/view/telegram/form"
+	"hospital/internal/modules/view/telegram/i18n"
+)
+
+func addOrganizationForm(controller *controllers.Controller) *form.Form[dto.CreateOrganization] {
+	return &form.Form[dto.CreateOrganization]{
+		Name: "button.add_organization",
+		Fields: []form.Field{
+			{Name: "name", Prompt: "organization.prompt.name"},
+		},
+		Submit: func(ctx context.Context, _ *dialog.Conversation, organization *dto.CreateOrganization) dialog.Reply {
+			return dialog.Reply{Text: addOrganization(ctx, organization, controller)}
+		},
+	}
+}
+
+func addOrganization(ctx context.Context, organization *dto.CreateOrganization, controller *controllers.Controller) string {
+	_, err := controller.AddOrganization(ctx, organization)
+	if err == errors.ErrAccessDenied {
+		return i18n.T(ctx, "organization.admins_only")
+	}
+	if err != nil {
+		return i18n.T(ctx, "organization.add_failed")
+	}
+	return i18n.T(ctx, "organization.added")
+}
+
+func printOrganizations(ctx context.Context, controller *controllers.Controller) string {
+	organizations, err := controller.GetAllOrganizations(ctx)
+	if err != nil {
+		return i18n.T(ctx, "error.request")
+	}
+	if len(organizations) == 0 {
+		return i18n.T(ctx, "organization.none")
+	}
+
+	var msg string
+	for _, o := range organizations {
+		msg += i18n.T(ctx, "organization.card", o.Id, o.Name)
+	}
+	return msg
+}
+
+func addDepartmentForm(controller *controllers.Controller) *form.Form[dto.CreateDepartment] {
+	return &form.Form[dto.CreateDepartment]{
+		Name: "button.add_department",
+		Fields: []form.Field{
+			{Name: "name", Prompt: "department.prompt.name"},
+			{Name: "organizationId", Prompt: "organization.prompt.choose", Kind: form.Int, Choices: organizationChoices(controller)},
+		},
+		Submit: func(ctx context.Context, _ *dialog.Conversation, department *dto.CreateDepartment) dialog.Reply {
+			return dialog.Reply{Text: addDepartment(ctx, department, controller)}
+		},
+	}
+}
+
+func addDepartment(ctx context.Context, department *dto.CreateDepartment, controller *controllers.Controller) string {
+	_, err := controller.AddDepartment(ctx, department)
+	if err == errors.ErrAccessDenied {
+		return i18n.T(ctx, "department.admins_only")
+	}
+	if err != nil {
+		return i18n.T(ctx, "department.add_failed")
+	}
+	return i18n.T(ctx, "department.added")
+}
+
+// assignment - ответы формы назначения врача в отделение
+type assignment struct {
+	DoctorId     int
+	DepartmentId int
+}
+
+func assignDepartmentForm(controller *controllers.Controller) *form.Form[assignment] {
+	return &form.Form[assignment]{
+		Name: "button.assign_department",
+		Fields: []form.Field{
+			{Name: "doctorId", Prompt: "doctor.prompt.id", Kind: form.Int},
+			{
+				Name:    "departmentId",
+				Prompt:  "department.prompt.choose",
+				Kind:    form.Int,
+				Choices: departmentChoices(controller.GetAllDepartments),
+			},
+		},
+		Submit: func(ctx context.Context, _ *dialog.Conversation, a *assignment) dialog.Reply {
+			return dialog.Reply{Text: assignDepartment(ctx, a, controller)}
+		},
+	}
+}
+
+func assignDepartment(ctx context.Context, a *assignment, controller *controllers.Controller) string {
+	department, err := controller.AssignDepartment(ctx, a.DoctorId, a.DepartmentId)
+	if err == errors.ErrAccessDenied {
+		return i18n.T(ctx, "department.assign_admins_only")
+	}
+	if err != nil {
+		return i18n.T(ctx, "department.assign_failed")
+	}
+	return i18n.T(ctx, "department.assigned", department.Name)
+}
+
+func printDepartments(ctx context.Context, controller *controllers.Controller) string {
+	departments, err := controller.GetAllDepartments(ctx)
+	if err != nil {
+		return i18n.T(ctx, "error.request")
+	}
+	if len(departments) == 0 {
+		return i18n.T(ctx, "department.none")
+	}
+
+	return formatDepartments(ctx, departments)
+}
+
+// departmentSelection - ответ формы выбора отделения
+type departmentSelection struct {
+	DepartmentId int
+}
+
+// switchDepartmentForm предлагает выбрать одно из отделений врача
+func switchDepartmentForm(controller *controllers.Controller) *form.Form[departmentSelection] {
+	return &form.Form[departmentSelection]{
+		Name: "button.switch_department",
+		Fields: []form.Field{
+			{
+				Name:    "departmentId",
+				Prompt:  "department.prompt.choose",
+				Kind:    form.Int,
+				Choices: departmentChoices(controller.MyDepartments),
+			},
+		},
+		Submit: func(ctx context.Context, _ *dialog.Conversation, s *departmentSelection) dialog.Reply {
+			return dialog.Reply{Text: switchDepartment(ctx, s.DepartmentId, controller)}
+		},
+	}
+}
+
+func switchDepartment(ctx context.Context, departmentId int, controller *controllers.Controller) string {
+	department, err := controller.SwitchDepartment(ctx, departmentId)
+	if err == errors.ErrAccessDenied {
+		return i18n.T(ctx, "department.not_member")
+	}
+	if err != nil {
+		return i18n.T(ctx, "department.switch_failed")
+	}
+	return i18n.T(ctx, "department.current", department.Name)
+}
+
+func formatDepartments(ctx context.Context, departments dto.Departments) string {
+	var msg string
+	for _, d := range departments {
+		msg += i18n.T(ctx, "department.card", d.Id, d.Name, d.OrganizationId)
+	}
+	return msg
+}
@@ -0,0 +1,396 @@
+package dialog
+
+import (
+	"context"
+	"fmt"
+	"hospital/internal/models/errors"
+	"hospital/internal/modules/config"
+	"hospital/internal/modules/domain/conversation/dto"
+	"hospital/internal/modules/view/telegram/i18n"
+	"time"
+)
+
+// Done - имя перехода, которым диалог завершается
+const Done = ""
+
+// Команды, доступные на любом шаге диалога; кроме слэш-команд принимаются слова на любом языке
+var (
+	cancelCommands = map[string]bool{"/cancel": true}
+	backCommands   = map[string]bool{"/back": true}
+	resumeCommands = map[string]bool{"/continue": true}
+)
+
+func isCommand(commands map[string]bool, word string, input string) bool {
+	if commands[input] {
+		return true
+	}
+	key, ok := i18n.Match("word.", input)
+	return ok && key == word
+}
+
+// Values - ответы пользователя по именам шагов
+type Values = dto.Values
+
+// Conversation - состояние диалога в одном чате
+type Conversation = dto.Conversation
+
+// Store хранит состояние диалогов; оно сохраняется на каждом шаге
+type Store interface {
+	Get(ctx context.Context, chatId int64) (*dto.Conversation, error)
+	List(ctx context.Context) (dto.Conversations, error)
+	Save(ctx context.Context, dtm *dto.Conversation) error
+	Delete(ctx context.Context, chatId int64) error
+}
+
+// Reply - ответ бота: текст и, при необходимости, клавиатура
+type Reply struct {
+	Text   string
+	Markup interface{}
+	// ParseMode - разметка Text: пустая строка для обычного текста или tgbotapi.ModeHTML
+	ParseMode string
+	// Document - файл, который отправляется вместо сообщения; Text становится его подписью
+	Document *Document
+	// Retry - шаг, вопрос которого Finish просит задать повторно, предварив его Text;
+	// диалог при этом не завершается
+	Retry string
+}
+
+// Document - файл ответа
+type Document struct {
+	Name string
+	Data []byte
+}
+
+// Notice - сообщение, которое бот отправляет в чат сам, без запроса пользователя
+type Notice struct {
+	ChatId int64
+	Reply
+}
+
+// Choice - вариант ответа на вопрос шага
+type Choice struct {
+	Value string
+	Label string
+}
+
+// Keyboard строит клавиатуру для выбора из вариантов ответа текущего шага
+type Keyboard func(ctx context.Context, c *Conversation, choices []Choice) (interface{}, error)
+
+// Language кладет в контекст язык пользователя для сообщений, которые бот отправляет сам
+type Language func(ctx context.Context, userId int64) context.Context
+
+// Step - состояние диалога
+type Step struct {
+	Name string
+	// Prompt возвращает вопрос, который задается при входе в состояние
+	Prompt func(ctx context.Context, c *Conversation) (string, error)
+	// Choices возвращает варианты ответа; без клавиатуры они перечисляются в вопросе
+	Choices func(ctx context.Context, c *Conversation) ([]Choice, error)
+	// Validate проверяет ответ; при ошибке вопрос задается повторно
+	Validate func(ctx context.Context, c *Conversation, input string) error
+	// Next выбирает следующее состояние; по умолчанию - следующий шаг по порядку
+	Next func(c *Conversation) string
+}
+
+// Dialog - конечный автомат диалога
+type Dialog struct {
+	Name  string
+	Steps []Step
+	// Finish вызывается после последнего шага с собранными ответами
+	Finish func(ctx context.Context, c *Conversation) Reply
+}
+
+// Text - вопрос без обращения к данным: ключ сообщения каталога или готовый текст
+func Text(prompt string, args ...interface{}) func(ctx context.Context, c *Conversation) (string, error) {
+	return func(ctx context.Context, _ *Conversation) (string, error) {
+		return i18n.T(ctx, prompt, args...), nil
+	}
+}
+
+func (d *Dialog) step(name string) (int, *Step) {
+	for i := range d.Steps {
+		if d.Steps[i].Name == name {
+			return i, &d.Steps[i]
+		}
+	}
+	return -1, nil
+}
+
+func (d *Dialog) next(c *Conversation) string {
+	i, s := d.step(c.State)
+	if s.Next != nil {
+		return s.Next(c)
+	}
+	if i+1 < len(d.Steps) {
+		return d.Steps[i+1].Name
+	}
+	return Done
+}
+
+// Engine ведет диалоги независимо в каждом чате
+type Engine struct {
+	dialogs  map[string]*Dialog
+	store    Store
+	keyboard Keyboard
+	lang     Language
+	timeout  time.Duration
+	grace    time.Duration
+	now      func() time.Time
+}
+
+func NewEngine(store Store, cfg config.Config) *Engine {
+	return &Engine{
+		dialogs: map[string]*Dialog{},
+		store:   store,
+		timeout: cfg.DialogIdleTimeout,
+		grace:   cfg.DialogResumeGrace,
+		now:     time.Now,
+	}
+}
+
+// Register добавляет диалоги, которые запускаются командой с их именем
+func (r *Engine) Register(dialogs ...*Dialog) {
+	for _, d := range dialogs {
+		r.dialogs[d.Name] = d
+	}
+}
+
+// UseKeyboard задает клавиатуру для шагов с вариантами ответа
+func (r *Engine) UseKeyboard(keyboard Keyboard) {
+	r.keyboard = keyboard
+}
+
+// UseLanguage задает выбор языка пользователя для сообщений вне его запросов
+func (r *Engine) UseLanguage(lang Language) {
+	r.lang = lang
+}
+
+// Has - есть ли диалог с таким именем
+func (r *Engine) Has(name string) bool {
+	_, ok := r.dialogs[name]
+	return ok
+}
+
+// Active - ведется ли в чате диалог
+func (r *Engine) Active(ctx context.Context, chatId int64) bool {
+	c, err := r.store.Get(ctx, chatId)
+	return err == nil && c != nil
+}
+
+// Start начинает диалог в чате, прерывая предыдущий
+func (r *Engine) Start(ctx context.Context, name string, chatId int64, userId int64) Reply {
+	d, ok := r.dialogs[name]
+	if !ok || len(d.Steps) == 0 {
+		return Reply{Text: i18n.T(ctx, "bot.unknown_command")}
+	}
+
+	c := &Conversation{
+		ChatId: chatId,
+		UserId: userId,
+		Dialog: name,
+		Values: Values{},
+	}
+	reply, _ := r.ask(ctx, d, at(c, d.Steps[0].Name), "")
+	return reply
+}
+
+// Handle передает сообщение диалогу чата. Если диалога нет или он истек, возвращает false,
+// и сообщение обрабатывается как команда.
+func (r *Engine) Handle(ctx context.Context, chatId int64, input string) (Reply, bool) {
+	c, err := r.store.Get(ctx, chatId)
+	if err == errors.ErrDatabaseRecordNotFound {
+		return Reply{}, false
+	}
+	if err != nil {
+		return Reply{Text: i18n.T(ctx, "dialog.load_failed")}, true
+	}
+
+	d, ok := r.dialogs[c.Dialog]
+	if !ok || r.timeout > 0 && r.now().Sub(c.UpdatedAt) >= r.timeout {
+		r.drop(ctx, chatId)
+		return Reply{Text: i18n.T(ctx, "dialog.expired")}, false
+	}
+
+	if c.Suspended {
+		if !isCommand(resumeCommands, "word.yes", input) {
+			r.drop(ctx, chatId)
+			if isCommand(cancelCommands, "word.cancel", input) {
+				return Reply{Text: i18n.T(ctx, "dialog.cancelled")}, true
+			}
+			return Reply{}, false
+		}
+		c.Suspended = false
+		return r.ask(ctx, d, c, "")
+	}
+
+	switch {
+	case isCommand(cancelCommands, "word.cancel", input):
+		r.drop(ctx, chatId)
+		return Reply{Text: i18n.T(ctx, "dialog.cancelled")}, true
+	case isCommand(backCommands, "word.back", input):
+		if len(c.History) == 0 {
+			return r.ask(ctx, d, c, i18n.T(ctx, "dialog.first_step"))
+		}
+		prev := c.History[len(c.History)-1]
+		c.History = c.History[:len(c.History)-1]
+		return r.ask(ctx, d, at(c, prev), "")
+	}
+
+	_, s := d.step(c.State)
+	if s == nil {
+		r.drop(ctx, chatId)
+		return Reply{Text: i18n.T(ctx, "dialog.unknown_step", c.State)}, true
+	}
+	if s.Validate != nil {
+		if err = s.Validate(ctx, c, input); err != nil {
+			return r.ask(ctx, d, c, err.Error())
+		}
+	}
+	c.Values[c.State] = input
+
+	next := d.next(c)
+	c.History = append(c.History, c.State)
+	if next != Done {
+		return r.ask(ctx, d, at(c, next), "")
+	}
+
+	reply := d.Finish(ctx, c)
+	if reply.Retry != "" {
+		return r.ask(ctx, d, at(c, reply.Retry), reply.Text)
+	}
+	r.drop(ctx, chatId)
+	return reply, true
+}
+
+// Suspend вызывается при запуске бота: незавершенные диалоги ставятся на паузу,
+// а пользователям на их языке предлагается их продолжить. Истекшие диалоги удаляются.
+// Диалоги, которые менялись недавно, не трогаются: их может вести другой экземпляр бота.
+func (r *Engine) Suspend(ctx context.Context) ([]Notice, error) {
+	conversations, err := r.store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var notices []Notice
+	for _, c := range conversations {
+		idle := r.now().Sub(c.UpdatedAt)
+		if _, ok := r.dialogs[c.Dialog]; !ok || r.timeout > 0 && idle >= r.timeout {
+			r.drop(ctx, c.ChatId)
+			continue
+		}
+		if idle < r.grace {
+			continue
+		}
+
+		c.Suspended = true
+		c.UpdatedAt = r.now()
+		if err = r.store.Save(ctx, c); err != nil {
+			return notices, err
+		}
+		userCtx := ctx
+		if r.lang != nil {
+			userCtx = r.lang(ctx, c.UserId)
+		}
+		notices = append(notices, Notice{
+			ChatId: c.ChatId,
+			Reply:  Reply{Text: i18n.T(userCtx, "dialog.resume", i18n.T(userCtx, c.Dialog))},
+		})
+	}
+	return notices, nil
+}
+
+// Choices возвращает текущий шаг диалога в чате и его варианты ответа
+func (r *Engine) Choices(ctx context.Context, chatId int64) (string, []Choice, error) {
+	c, err := r.store.Get(ctx, chatId)
+	if err != nil {
+		return "", nil, err
+	}
+	d, ok := r.dialogs[c.Dialog]
+	if !ok {
+		return "", nil, errors.ErrDatabaseRecordNotFound
+	}
+	_, s := d.step(c.State)
+	if s == nil || s.Choices == nil {
+		return c.State, nil, nil
+	}
+
+	choices, err := s.Choices(ctx, c)
+	return c.State, choices, err
+}
+
+// Cancel прерывает диалог в чате
+func (r *Engine) Cancel(ctx context.Context, chatId int64) bool {
+	if !r.Active(ctx, chatId) {
+		return false
+	}
+	r.drop(ctx, chatId)
+	return true
+}
+
+// ask задает вопрос текущего состояния, предваряя его сообщением об ошибке, и сохраняет диалог
+func (r *Engine) ask(ctx context.Context, d *Dialog, c *Conversation, notice string) (Reply, bool) {
+	_, s := d.step(c.State)
+	if s == nil {
+		r.drop(ctx, c.ChatId)
+		return Reply{Text: i18n.T(ctx, "dialog.unknown_step", c.State)}, true
+	}
+
+	prompt, err := s.Prompt(ctx, c)
+	if err != nil {
+		r.drop(ctx, c.ChatId)
+		return Reply{Text: i18n.T(ctx, "error.request")}, true
+	}
+
+	var markup interface{}
+	if s.Choices != nil {
+		choices, err := s.Choices(ctx, c)
+		if err != nil {
+			r.drop(ctx, c.ChatId)
+			return Reply{Text: i18n.T(ctx, "error.request")}, true
+		}
+		if len(choices) == 0 {
+			r.drop(ctx, c.ChatId)
+			return Reply{Text: i18n.T(ctx, "dialog.no_choices")}, true
+		}
+
+		if r.keyboard != nil {
+			if markup, err = r.keyboard(ctx, c, choices); err != nil {
+				r.drop(ctx, c.ChatId)
+				return Reply{Text: i18n.T(ctx, "error.request")}, true
+			}
+		} else {
+			prompt += listChoices(choices)
+		}
+	}
+	if notice != "" {
+		prompt = notice + "\n" + prompt
+	}
+
+	c.UpdatedAt = r.now()
+	if err = r.store.Save(ctx, c); err != nil {
+		return Reply{Text: i18n.T(ctx, "dialog.save_failed")}, true
+	}
+
+	return Reply{Text: prompt, Markup: markup}, true
+}
+
+func listChoices(choices []Choice) string {
+	var list string
+	for _, c := range choices {
+		if c.Label == "" {
+			list += "\n" + c.Value
+			continue
+		}
+		list += fmt.Sprintf("\n%s - %s", c.Value, c.Label)
+	}
+	return list
+}
+
+func (r *Engine) drop(ctx context.Context, chatId int64) {
+	_ = r.store.Delete(ctx, chatId)
+}
+
+func at(c *Conversation, state string) *Conversation {
+	c.State = state
+	return c
+}
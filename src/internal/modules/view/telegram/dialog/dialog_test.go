@@ -0,0 +1,338 @@
+package dialog
+
+import (
+	"context"
+	"errors"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"hospital/internal/modules/config"
+	"hospital/internal/modules/domain/conversation/repo"
+	"hospital/internal/modules/view/telegram/i18n"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func newTestEngine(now *time.Time, finished *[]Values) *Engine {
+	e := NewEngine(repo.NewMemoryConversationRepo(), config.Config{DialogIdleTimeout: 10 * time.Minute})
+	e.now = func() time.Time { return *now }
+	e.Register(&Dialog{
+		Name: "Добавить палату",
+		Steps: []Step{
+			{Name: "number", Prompt: Text("Номер")},
+			{
+				Name:   "floor",
+				Prompt: Text("Этаж"),
+				Validate: func(_ context.Context, _ *Conversation, input string) error {
+					if _, err := strconv.Atoi(input); err != nil {
+						return errors.New("Этаж - это число")
+					}
+					return nil
+				},
+				Next: func(c *Conversation) string {
+					if c.Values["floor"] == "0" {
+						return Done
+					}
+					return "type"
+				},
+			},
+			{Name: "type", Prompt: Text("Тип")},
+		},
+		Finish: func(_ context.Context, c *Conversation) Reply {
+			*finished = append(*finished, c.Values)
+			return Reply{Text: "Готово"}
+		},
+	})
+	return e
+}
+
+func send(t provider.T, e *Engine, chatId int64, inputs ...string) Reply {
+	var reply Reply
+	for _, input := range inputs {
+		var ok bool
+		reply, ok = e.Handle(context.Background(), chatId, input)
+		if !ok {
+			t.Fatalf("Handle(%q) not handled", input)
+		}
+	}
+	return reply
+}
+
+func TestEngine_Handle(t *testing.T) {
+	start := time.Date(2023, 10, 1, 8, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name      string
+		inputs    []string
+		wantReply string
+		want      []Values
+	}{
+		{
+			name:      "All steps",
+			inputs:    []string{"12", "3", "Общая"},
+			wantReply: "Готово",
+			want:      []Values{{"number": "12", "floor": "3", "type": "Общая"}},
+		},
+		{
+			name:      "Transition skips a step",
+			inputs:    []string{"12", "0"},
+			wantReply: "Готово",
+			want:      []Values{{"number": "12", "floor": "0"}},
+		},
+		{
+			name:      "Invalid answer is asked again",
+			inputs:    []string{"12", "третий"},
+			wantReply: "Этаж - это число\nЭтаж",
+		},
+		{
+			name:      "Back returns to previous step",
+			inputs:    []string{"12", "Назад", "13", "2", "Общая"},
+			wantReply: "Готово",
+			want:      []Values{{"number": "13", "floor": "2", "type": "Общая"}},
+		},
+		{
+			name:      "Back on first step",
+			inputs:    []string{"/back"},
+			wantReply: "Это первый шаг. Для выхода напишите Отмена\nНомер",
+		},
+		{
+			name:      "Cancel",
+			inputs:    []string{"12", "/cancel"},
+			wantReply: "Действие отменено",
+		},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			now := start
+			var finished []Values
+			e := newTestEngine(&now, &finished)
+
+			if got := e.Start(context.Background(), "Добавить палату", 1, 1); got.Text != "Номер" {
+				t.Fatalf("Start() = %q", got.Text)
+			}
+			if got := send(t, e, 1, tt.inputs...); got.Text != tt.wantReply {
+				t.Errorf("Handle() = %q, want %q", got.Text, tt.wantReply)
+			}
+			if len(finished) != len(tt.want) {
+				t.Fatalf("finished %d times, want %d", len(finished), len(tt.want))
+			}
+			for i := range tt.want {
+				for k, v := range tt.want[i] {
+					if finished[i][k] != v {
+						t.Errorf("value %s = %q, want %q", k, finished[i][k], v)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestEngine_PerChat(t *testing.T) {
+	runner.Run(t, "Dialogs in different chats are independent", func(t provider.T) {
+		now := time.Date(2023, 10, 1, 8, 0, 0, 0, time.UTC)
+		var finished []Values
+		e := newTestEngine(&now, &finished)
+
+		e.Start(context.Background(), "Добавить палату", 1, 1)
+		if _, ok := e.Handle(context.Background(), 2, "Помощь"); ok {
+			t.Errorf("message from another chat was routed into the dialog")
+		}
+		e.Start(context.Background(), "Добавить палату", 2, 2)
+		send(t, e, 1, "11")
+		send(t, e, 2, "21", "0")
+		if e.Active(context.Background(), 2) || !e.Active(context.Background(), 1) {
+			t.Errorf("Active() = %v, %v", e.Active(context.Background(), 1), e.Active(context.Background(), 2))
+		}
+		if len(finished) != 1 || finished[0]["number"] != "21" {
+			t.Errorf("finished = %v", finished)
+		}
+	})
+}
+
+func TestEngine_IdleTimeout(t *testing.T) {
+	runner.Run(t, "Expired dialog is dropped", func(t provider.T) {
+		now := time.Date(2023, 10, 1, 8, 0, 0, 0, time.UTC)
+		var finished []Values
+		e := newTestEngine(&now, &finished)
+
+		e.Start(context.Background(), "Добавить палату", 1, 1)
+		now = now.Add(11 * time.Minute)
+
+		reply, ok := e.Handle(context.Background(), 1, "Вывести все палаты")
+		if ok || reply.Text == "" {
+			t.Errorf("Handle() = %q, %v, want notice and not handled", reply.Text, ok)
+		}
+		if e.Active(context.Background(), 1) {
+			t.Errorf("dialog is still active")
+		}
+	})
+}
+
+func TestEngine_Suspend(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		answer    string
+		wantReply string
+		handled   bool
+		active    bool
+	}{
+		{name: "Resume", answer: "Да", wantReply: "Этаж", handled: true, active: true},
+		{name: "Decline", answer: "Отмена", wantReply: "Действие отменено", handled: true},
+		{name: "Other command drops the dialog", answer: "Вывести все палаты", handled: false},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			now := time.Date(2023, 10, 1, 8, 0, 0, 0, time.UTC)
+			var finished []Values
+			store := repo.NewMemoryConversationRepo()
+
+			before := NewEngine(store, config.Config{DialogIdleTimeout: 10 * time.Minute})
+			before.now = func() time.Time { return now }
+			before.Register(newTestEngine(&now, &finished).dialogs["Добавить палату"])
+			before.Start(context.Background(), "Добавить палату", 1, 1)
+			send(t, before, 1, "12")
+			before.Start(context.Background(), "Добавить палату", 2, 2)
+
+			// Перезапуск: диалог второго чата истек
+			now = now.Add(5 * time.Minute)
+			if err := store.Save(context.Background(), &Conversation{
+				ChatId: 2, Dialog: "Добавить палату", State: "number", Values: Values{},
+				UpdatedAt: now.Add(-time.Hour),
+			}); err != nil {
+				t.Fatalf("Save() error = %v", err)
+			}
+			after := newTestEngine(&now, &finished)
+			after.store = store
+
+			notices, err := after.Suspend(context.Background())
+			if err != nil {
+				t.Fatalf("Suspend() error = %v", err)
+			}
+			if len(notices) != 1 || notices[0].ChatId != 1 {
+				t.Fatalf("Suspend() = %v, want one notice for chat 1", notices)
+			}
+			if after.Active(context.Background(), 2) {
+				t.Errorf("expired dialog was not removed")
+			}
+
+			reply, handled := after.Handle(context.Background(), 1, tt.answer)
+			if reply.Text != tt.wantReply || handled != tt.handled {
+				t.Errorf("Handle() = %q, %v, want %q, %v", reply.Text, handled, tt.wantReply, tt.handled)
+			}
+			if after.Active(context.Background(), 1) != tt.active {
+				t.Errorf("Active() = %v, want %v", !tt.active, tt.active)
+			}
+			if tt.active {
+				if got := send(t, after, 1, "0"); got.Text != "Готово" || finished[0]["number"] != "12" {
+					t.Errorf("resumed dialog = %q, %v", got.Text, finished)
+				}
+			}
+		})
+	}
+}
+
+func TestEngine_Suspend_Replicas(t *testing.T) {
+	runner.Run(t, "Recent dialog is left to another instance", func(t provider.T) {
+		now := time.Date(2023, 10, 1, 8, 0, 0, 0, time.UTC)
+		var finished []Values
+		e := newTestEngine(&now, &finished)
+		e.grace = time.Minute
+		e.Start(context.Background(), "Добавить палату", 1, 1)
+		e.Start(context.Background(), "Добавить палату", 2, 2)
+
+		now = now.Add(30 * time.Second)
+		if err := e.store.Save(context.Background(), &Conversation{
+			ChatId: 2, UserId: 2, Dialog: "Добавить палату", State: "number", Values: Values{},
+			UpdatedAt: now.Add(-5 * time.Minute),
+		}); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+
+		notices, err := e.Suspend(context.Background())
+		if err != nil {
+			t.Fatalf("Suspend() error = %v", err)
+		}
+		if len(notices) != 1 || notices[0].ChatId != 2 {
+			t.Fatalf("Suspend() = %v, want one notice for chat 2", notices)
+		}
+		if got := send(t, e, 1, "12"); got.Text != "Этаж" {
+			t.Errorf("recent dialog = %q, want it to go on", got.Text)
+		}
+	})
+
+	runner.Run(t, "Prompt is in the user language", func(t provider.T) {
+		now := time.Date(2023, 10, 1, 8, 0, 0, 0, time.UTC)
+		var finished []Values
+		e := newTestEngine(&now, &finished)
+		e.UseLanguage(func(ctx context.Context, userId int64) context.Context {
+			if userId == 7 {
+				return i18n.WithLang(ctx, i18n.EN)
+			}
+			return ctx
+		})
+		e.Start(context.Background(), "Добавить палату", 1, 7)
+		now = now.Add(5 * time.Minute)
+
+		notices, err := e.Suspend(context.Background())
+		if err != nil {
+			t.Fatalf("Suspend() error = %v", err)
+		}
+		want := i18n.Tr(i18n.EN, "dialog.resume", "Добавить палату")
+		if len(notices) != 1 || notices[0].Text != want {
+			t.Errorf("Suspend() = %v, want %q", notices, want)
+		}
+	})
+}
+
+func TestEngine_Choices(t *testing.T) {
+	choicesDialog := func(choices []Choice) *Dialog {
+		return &Dialog{
+			Name: "Выбрать палату",
+			Steps: []Step{{
+				Name:   "room",
+				Prompt: Text("Палата"),
+				Choices: func(context.Context, *Conversation) ([]Choice, error) {
+					return choices, nil
+				},
+			}},
+			Finish: func(_ context.Context, c *Conversation) Reply { return Reply{Text: c.Values["room"]} },
+		}
+	}
+	rooms := []Choice{{Value: "1", Label: "Общая"}, {Value: "2"}}
+
+	runner.Run(t, "Choices are listed without keyboard", func(t provider.T) {
+		e := NewEngine(repo.NewMemoryConversationRepo(), config.Config{})
+		e.Register(choicesDialog(rooms))
+
+		got := e.Start(context.Background(), "Выбрать палату", 1, 1)
+		if got.Text != "Палата\n1 - Общая\n2" || got.Markup != nil {
+			t.Errorf("Start() = %q, %v", got.Text, got.Markup)
+		}
+	})
+	runner.Run(t, "Keyboard replaces the list", func(t provider.T) {
+		e := NewEngine(repo.NewMemoryConversationRepo(), config.Config{})
+		e.Register(choicesDialog(rooms))
+		e.UseKeyboard(func(_ context.Context, c *Conversation, choices []Choice) (interface{}, error) {
+			return len(choices), nil
+		})
+
+		got := e.Start(context.Background(), "Выбрать палату", 1, 1)
+		if got.Text != "Палата" || got.Markup != 2 {
+			t.Errorf("Start() = %q, %v", got.Text, got.Markup)
+		}
+		step, choices, err := e.Choices(context.Background(), 1)
+		if err != nil || step != "room" || len(choices) != 2 {
+			t.Errorf("Choices() = %q, %v, %v", step, choices, err)
+		}
+		if reply, _ := e.Handle(context.Background(), 1, "2"); reply.Text != "2" {
+			t.Errorf("Handle() = %q", reply.Text)
+		}
+	})
+	runner.Run(t, "Dialog is cancelled when there is nothing to choose", func(t provider.T) {
+		e := NewEngine(repo.NewMemoryConversationRepo(), config.Config{})
+		e.Register(choicesDialog(nil))
+
+		e.Start(context.Background(), "Выбрать палату", 1, 1)
+		if e.Active(context.Background(), 1) {
+			t.Errorf("dialog is still active")
+		}
+	})
+}
@@ -0,0 +1,41 @@
+package telegram
+
+import (
+	"context"
+	"hospital/internal/modules/view/telegram/confirm"
+	"hospital/internal/modules/view/telegram/controllers"
+	"hospital/internal/modules/view/telegram/dialog"
+)
+
+// registerDialogs подключает формы ввода данных и остальные диалоги; каждый запускается командой с его именем
+func registerDialogs(dialogs *dialog.Engine, controller *controllers.Controller, confirmer *confirm.Confirmer) {
+	dialogs.Register(
+		singUpForm(controller).Dialog(),
+		addPatientForm(controller).Dialog(),
+		addRoomForm(controller).Dialog(),
+		addDiseaseForm(controller).Dialog(),
+		addOrganizationForm(controller).Dialog(),
+		addDepartmentForm(controller).Dialog(),
+		assignDepartmentForm(controller).Dialog(),
+		switchDepartmentForm(controller).Dialog(),
+		diagnoseForm(controller).Dialog(),
+		recordVitalsForm(controller).Dialog(),
+		assignDoctorForm(controller).Dialog(),
+		alertSettingsForm(controller).Dialog(),
+		accessReportForm(controller).Dialog(),
+		deletePatientForm(controller, confirmer).Dialog(),
+		deleteRoomForm(controller, confirmer).Dialog(),
+		findRoomForm(controller).Dialog(),
+		auditHistoryDialog(controller),
+	)
+}
+
+// finish превращает End-функцию диалога в обработчик его завершения
+func finish(
+	end func(ctx context.Context, values dialog.Values, controller *controllers.Controller) string,
+	controller *controllers.Controller) func(ctx context.Context, c *dialog.Conversation) dialog.Reply {
+
+	return func(ctx context.Context, c *dialog.Conversation) dialog.Reply {
+		return dialog.Reply{Text: end(ctx, c.Values, controller)}
+	}
+}
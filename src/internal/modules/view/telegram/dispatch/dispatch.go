@@ -0,0 +1,230 @@
+package dispatch
+
+import (
+	"context"
+	"errors"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+	"hospital/internal/modules/config"
+	"sync"
+	"time"
+)
+
+var (
+	queueDepth = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "bot_updates_queue_depth",
+		Help: "Обновления, ожидающие обработки",
+	})
+	activeChats = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "bot_updates_active_chats",
+		Help: "Чаты, у которых есть необработанные обновления",
+	})
+	busyWorkers = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "bot_workers_busy",
+		Help: "Обработчики, занятые обновлением",
+	})
+	waitSeconds = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "bot_update_wait_seconds",
+		Help:    "Время от получения обновления до начала обработки",
+		Buckets: prometheus.DefBuckets,
+	})
+	handleSeconds = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "bot_update_handle_seconds",
+		Help:    "Время обработки обновления",
+		Buckets: prometheus.DefBuckets,
+	})
+	handled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bot_updates_handled_total",
+		Help: "Обработанные обновления по результату: ok, timeout, panic",
+	}, []string{"result"})
+)
+
+// Handler обрабатывает одно обновление; ctx отменяется по истечении таймаута обработки
+type Handler func(ctx context.Context, update tgbotapi.Update)
+
+type queued struct {
+	update   tgbotapi.Update
+	received time.Time
+}
+
+// Dispatcher распределяет обновления по ограниченному числу обработчиков.
+// Обновления одного чата обрабатываются по очереди в порядке получения,
+// обновления разных чатов - параллельно.
+type Dispatcher struct {
+	workers int
+	limit   int
+	timeout time.Duration
+	logger  *zap.Logger
+
+	mu       sync.Mutex
+	notEmpty *sync.Cond
+	notFull  *sync.Cond
+	// pending - очереди чатов; чат есть в карте, пока он в ready или его обновление обрабатывается
+	pending map[int64][]queued
+	ready   []int64
+	size    int
+	closed  bool
+}
+
+func NewDispatcher(cfg config.Config, logger *zap.Logger) *Dispatcher {
+	d := &Dispatcher{
+		workers: cfg.BotWorkers,
+		limit:   cfg.BotQueueSize,
+		timeout: cfg.BotHandlerTimeout,
+		logger:  logger,
+		pending: map[int64][]queued{},
+	}
+	if d.workers <= 0 {
+		d.workers = 1
+	}
+	if d.limit <= 0 {
+		d.limit = 1
+	}
+	d.notEmpty = sync.NewCond(&d.mu)
+	d.notFull = sync.NewCond(&d.mu)
+	return d
+}
+
+// Run обрабатывает обновления, пока канал не закроется, и дожидается уже принятых
+func (d *Dispatcher) Run(updates tgbotapi.UpdatesChannel, handler Handler) {
+	var wg sync.WaitGroup
+	for i := 0; i < d.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d.work(handler)
+		}()
+	}
+
+	for update := range updates {
+		d.push(update)
+	}
+
+	d.mu.Lock()
+	d.closed = true
+	d.notEmpty.Broadcast()
+	d.mu.Unlock()
+	wg.Wait()
+}
+
+// push ставит обновление в очередь чата; при заполненной очереди ждет, пока обработчики освободят место
+func (d *Dispatcher) push(update tgbotapi.Update) {
+	chatId := ChatOf(update)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for d.size >= d.limit {
+		d.notFull.Wait()
+	}
+
+	q, active := d.pending[chatId]
+	d.pending[chatId] = append(q, queued{update: update, received: time.Now()})
+	d.size++
+	queueDepth.Inc()
+	if !active {
+		activeChats.Inc()
+		d.ready = append(d.ready, chatId)
+		d.notEmpty.Signal()
+	}
+}
+
+// next выдает обработчику очередное обновление чата, которого сейчас никто не обрабатывает
+func (d *Dispatcher) next() (int64, queued, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for len(d.ready) == 0 {
+		if d.closed {
+			return 0, queued{}, false
+		}
+		d.notEmpty.Wait()
+	}
+
+	chatId := d.ready[0]
+	d.ready = d.ready[1:]
+	item := d.pending[chatId][0]
+	d.pending[chatId] = d.pending[chatId][1:]
+	d.size--
+	queueDepth.Dec()
+	d.notFull.Signal()
+	return chatId, item, true
+}
+
+// done возвращает чат в очередь, если у него остались обновления
+func (d *Dispatcher) done(chatId int64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if len(d.pending[chatId]) == 0 {
+		delete(d.pending, chatId)
+		activeChats.Dec()
+		return
+	}
+	// Чат встает в конец очереди, чтобы активный чат не занимал обработчик надолго
+	d.ready = append(d.ready, chatId)
+	d.notEmpty.Signal()
+}
+
+func (d *Dispatcher) work(handler Handler) {
+	for {
+		chatId, item, ok := d.next()
+		if !ok {
+			return
+		}
+		waitSeconds.Observe(time.Since(item.received).Seconds())
+		d.handle(chatId, item.update, handler)
+		d.done(chatId)
+	}
+}
+
+func (d *Dispatcher) handle(chatId int64, update tgbotapi.Update, handler Handler) {
+	ctx := context.Background()
+	if d.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.timeout)
+		defer cancel()
+	}
+
+	busyWorkers.Inc()
+	start := time.Now()
+	defer func() {
+		busyWorkers.Dec()
+		handleSeconds.Observe(time.Since(start).Seconds())
+
+		// Ошибка в обработке одного чата не должна останавливать бота
+		if r := recover(); r != nil {
+			handled.WithLabelValues("panic").Inc()
+			d.logger.Error("update handler panicked",
+				zap.Int64("chat", chatId), zap.Int("update", update.UpdateID), zap.Any("panic", r))
+			return
+		}
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			handled.WithLabelValues("timeout").Inc()
+			d.logger.Warn("update handler timed out",
+				zap.Int64("chat", chatId), zap.Int("update", update.UpdateID), zap.Duration("timeout", d.timeout))
+			return
+		}
+		handled.WithLabelValues("ok").Inc()
+	}()
+
+	handler(ctx, update)
+}
+
+// ChatOf - чат, к которому относится обновление; обновления без чата обрабатываются по порядку в общем чате 0
+func ChatOf(update tgbotapi.Update) int64 {
+	switch {
+	case update.Message != nil && update.Message.Chat != nil:
+		return update.Message.Chat.ID
+	case update.CallbackQuery != nil && update.CallbackQuery.Message != nil && update.CallbackQuery.Message.Chat != nil:
+		return update.CallbackQuery.Message.Chat.ID
+	case update.CallbackQuery != nil && update.CallbackQuery.From != nil:
+		return update.CallbackQuery.From.ID
+	case update.MyChatMember != nil:
+		return update.MyChatMember.Chat.ID
+	default:
+		return 0
+	}
+}
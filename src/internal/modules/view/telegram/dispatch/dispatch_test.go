@@ -0,0 +1,146 @@
+package dispatch
+
+import (
+	"context"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"go.uber.org/zap"
+	"hospital/internal/modules/config"
+	"sync"
+	"testing"
+	"time"
+)
+
+func message(updateId int, chatId int64) tgbotapi.Update {
+	return tgbotapi.Update{
+		UpdateID: updateId,
+		Message:  &tgbotapi.Message{Chat: &tgbotapi.Chat{ID: chatId}, Text: "text"},
+	}
+}
+
+func run(d *Dispatcher, handler Handler, updates ...tgbotapi.Update) {
+	ch := make(chan tgbotapi.Update, len(updates))
+	for _, u := range updates {
+		ch <- u
+	}
+	close(ch)
+	d.Run(ch, handler)
+}
+
+func TestDispatcher_ChatOrder(t *testing.T) {
+	runner.Run(t, "Updates of one chat keep their order", func(t provider.T) {
+		d := NewDispatcher(config.Config{BotWorkers: 4, BotQueueSize: 100}, zap.NewNop())
+
+		var mu sync.Mutex
+		got := map[int64][]int{}
+		var updates []tgbotapi.Update
+		for i := 1; i <= 60; i++ {
+			updates = append(updates, message(i, int64(i%3)))
+		}
+
+		run(d, func(_ context.Context, u tgbotapi.Update) {
+			// Первые обновления обрабатываются дольше, чтобы следующие могли их обогнать
+			time.Sleep(time.Duration(60-u.UpdateID) * 50 * time.Microsecond)
+			mu.Lock()
+			got[ChatOf(u)] = append(got[ChatOf(u)], u.UpdateID)
+			mu.Unlock()
+		}, updates...)
+
+		for chat, ids := range got {
+			if len(ids) != 20 {
+				t.Errorf("chat %d handled %d updates, want 20", chat, len(ids))
+			}
+			for i := 1; i < len(ids); i++ {
+				if ids[i] < ids[i-1] {
+					t.Errorf("chat %d handled update %d after %d", chat, ids[i], ids[i-1])
+				}
+			}
+		}
+	})
+}
+
+func TestDispatcher_ChatsInParallel(t *testing.T) {
+	runner.Run(t, "Slow chat does not block others", func(t provider.T) {
+		d := NewDispatcher(config.Config{BotWorkers: 2, BotQueueSize: 10}, zap.NewNop())
+
+		release := make(chan struct{})
+		fast := make(chan struct{})
+		go func() {
+			select {
+			case <-fast:
+			case <-time.After(5 * time.Second):
+				t.Errorf("update of the second chat waited for the slow chat")
+			}
+			close(release)
+		}()
+
+		run(d, func(_ context.Context, u tgbotapi.Update) {
+			if ChatOf(u) == 1 {
+				<-release
+				return
+			}
+			close(fast)
+		}, message(1, 1), message(2, 2))
+	})
+}
+
+func TestDispatcher_Timeout(t *testing.T) {
+	runner.Run(t, "Handler context expires", func(t provider.T) {
+		d := NewDispatcher(config.Config{BotWorkers: 1, BotQueueSize: 10, BotHandlerTimeout: 10 * time.Millisecond}, zap.NewNop())
+
+		var err error
+		run(d, func(ctx context.Context, u tgbotapi.Update) {
+			<-ctx.Done()
+			err = ctx.Err()
+		}, message(1, 1))
+
+		if err != context.DeadlineExceeded {
+			t.Errorf("ctx.Err() = %v, want %v", err, context.DeadlineExceeded)
+		}
+	})
+}
+
+func TestDispatcher_Panic(t *testing.T) {
+	runner.Run(t, "Panic in one update does not stop the chat", func(t provider.T) {
+		d := NewDispatcher(config.Config{BotWorkers: 1, BotQueueSize: 10}, zap.NewNop())
+
+		var got []int
+		run(d, func(_ context.Context, u tgbotapi.Update) {
+			if u.UpdateID == 1 {
+				panic("boom")
+			}
+			got = append(got, u.UpdateID)
+		}, message(1, 1), message(2, 1))
+
+		if len(got) != 1 || got[0] != 2 {
+			t.Errorf("handled = %v, want [2]", got)
+		}
+	})
+}
+
+func TestChatOf(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		update tgbotapi.Update
+		want   int64
+	}{
+		{name: "Message", update: message(1, 42), want: 42},
+		{
+			name: "Callback",
+			update: tgbotapi.Update{CallbackQuery: &tgbotapi.CallbackQuery{
+				From:    &tgbotapi.User{ID: 7},
+				Message: &tgbotapi.Message{Chat: &tgbotapi.Chat{ID: 42}},
+			}},
+			want: 42,
+		},
+		{name: "Inline callback", update: tgbotapi.Update{CallbackQuery: &tgbotapi.CallbackQuery{From: &tgbotapi.User{ID: 7}}}, want: 7},
+		{name: "Without chat", update: tgbotapi.Update{}, want: 0},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			if got := ChatOf(tt.update); got != tt.want {
+				t.Errorf("ChatOf() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package telegram
+
+import (
+	"context"
+	export_dto "hospital/internal/modules/domain/export/dto"
+	"hospital/internal/modules/view/telegram/controllers"
+	"hospital/internal/modules/view/telegram/dialog"
+	"hospital/internal/modules/view/telegram/i18n"
+	"strconv"
+	"strings"
+)
+
+// exportFile выполняет /export <patients|rooms|doctors> [csv|xlsx] [условия]: выгрузка
+// отправляется документом. Условия записываются как room=101, floor=2, department=3,
+// danger=4, role=doctor или флагами free и on_call.
+func exportFile(ctx context.Context, args []string, controller *controllers.Controller) dialog.Reply {
+	ctx = administration(ctx)
+	req := &export_dto.Request{
+		Kind:   export_dto.Kind(strings.ToLower(args[0])),
+		Format: export_dto.FormatXLSX,
+		Label: func(name string) string {
+			if i18n.Has("export.kind." + name) {
+				return i18n.T(ctx, "export.kind."+name)
+			}
+			return i18n.T(ctx, "field."+name)
+		},
+	}
+
+	for _, arg := range args[1:] {
+		arg = strings.ToLower(arg)
+		if format := export_dto.Format(arg); format == export_dto.FormatCSV || format == export_dto.FormatXLSX {
+			req.Format = format
+			continue
+		}
+		if !parseExportFilter(&req.Filter, arg) {
+			return dialog.Reply{Text: i18n.T(ctx, "export.invalid_filter", arg)}
+		}
+	}
+
+	file, err := controller.Export(ctx, req)
+	if err != nil {
+		return dialog.Reply{Text: i18n.Error(ctx, err)}
+	}
+	return dialog.Reply{
+		Text:     i18n.N(ctx, "export.rows", file.Rows),
+		Document: &dialog.Document{Name: file.Name, Data: file.Data},
+	}
+}
+
+// parseExportFilter добавляет в отбор условие вида ключ=значение или флаг
+func parseExportFilter(f *export_dto.Filter, arg string) bool {
+	key, value, hasValue := strings.Cut(arg, "=")
+	if !hasValue {
+		switch key {
+		case "free":
+			f.FreeOnly = true
+		case "on_call":
+			f.OnCall = true
+		default:
+			return false
+		}
+		return true
+	}
+
+	if key == "role" {
+		r, ok := roleCodes[value]
+		f.Role = r
+		return ok
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return false
+	}
+	switch key {
+	case "room":
+		f.Room = n
+	case "floor":
+		f.Floor = &n
+	case "department":
+		f.DepartmentId = n
+	case "danger":
+		f.MinDanger = n
+	default:
+		return false
+	}
+	return true
+}
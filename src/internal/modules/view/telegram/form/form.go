@@ -0,0 +1,326 @@
+package form
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hospital/internal/models/validation"
+	"hospital/internal/modules/view/telegram/dialog"
+	"hospital/internal/modules/view/telegram/i18n"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Skip - ответ, которым пропускается необязательное поле
+const Skip = "-"
+
+// Kind - тип значения поля
+type Kind int
+
+const (
+	String Kind = iota
+	Int
+	Float
+)
+
+// Choice - допустимый вариант ответа
+type Choice = dialog.Choice
+
+// Field описывает поле формы. Ответ записывается в поле структуры с тегом form:"<Name>"
+// или, если тега нет, в поле с таким же именем без учета регистра.
+type Field struct {
+	Name string
+	// Prompt - ключ вопроса в каталоге сообщений, PromptArgs - его аргументы
+	Prompt     string
+	PromptArgs []interface{}
+	Kind       Kind
+	Optional   bool
+	// Choices возвращает допустимые ответы с учетом уже полученных; бот предлагает их кнопками
+	Choices func(ctx context.Context, values dialog.Values) ([]Choice, error)
+	// Validate проверяет уже разобранное значение: string, int или float64
+	Validate func(ctx context.Context, value interface{}) error
+}
+
+// validator - значение с собственными правилами проверки, например DTO сервиса
+type validator interface {
+	Validate() error
+}
+
+// Form - декларативное описание диалога ввода данных. После последнего поля
+// ответы собираются в значение типа T и передаются в Submit. Если *T реализует
+// Validate() error, ошибки validation.Errors относятся к полям формы: ответ на
+// поле проверяется сразу, а перед Submit повторно задаются только неверные поля.
+type Form[T any] struct {
+	Name   string
+	Fields []Field
+	Submit func(ctx context.Context, c *dialog.Conversation, value *T) dialog.Reply
+}
+
+// Dialog строит по форме диалог для dialog.Engine
+func (f *Form[T]) Dialog() *dialog.Dialog {
+	steps := make([]dialog.Step, 0, len(f.Fields))
+	for i := range f.Fields {
+		field := f.Fields[i]
+		steps = append(steps, dialog.Step{
+			Name:    field.Name,
+			Prompt:  field.prompt,
+			Choices: field.choices(),
+			Validate: func(ctx context.Context, c *dialog.Conversation, input string) error {
+				return f.validateField(ctx, c.Values, &field, input)
+			},
+			Next: f.nextField,
+		})
+	}
+
+	return &dialog.Dialog{
+		Name:  f.Name,
+		Steps: steps,
+		Finish: func(ctx context.Context, c *dialog.Conversation) dialog.Reply {
+			value, err := f.Decode(ctx, c.Values)
+			if err != nil {
+				return dialog.Reply{Text: i18n.T(ctx, "form.failed")}
+			}
+			if reply, ok := f.retry(ctx, c, value); ok {
+				return reply
+			}
+			return f.Submit(ctx, c, value)
+		},
+	}
+}
+
+// nextField - первое поле формы, на которое еще нет ответа
+func (f *Form[T]) nextField(c *dialog.Conversation) string {
+	for _, field := range f.Fields {
+		if _, ok := c.Values[field.Name]; !ok {
+			return field.Name
+		}
+	}
+	return dialog.Done
+}
+
+// validateField проверяет ответ на поле: тип, варианты, Validate поля и правила T для этого поля
+func (f *Form[T]) validateField(ctx context.Context, values dialog.Values, field *Field, input string) error {
+	if _, err := field.parse(ctx, values, input); err != nil {
+		return err
+	}
+
+	partial := dialog.Values{}
+	for k, v := range values {
+		partial[k] = v
+	}
+	partial[field.Name] = input
+	value, err := f.decode(ctx, partial, true)
+	if err != nil {
+		return err
+	}
+
+	if fe := fieldErrors(value).Field(f.structField(field.Name)); fe != nil {
+		return errors.New(i18n.Field(ctx, fe))
+	}
+	return nil
+}
+
+// retry убирает ответы на поля, которые не прошли проверку T, и просит задать их повторно
+func (f *Form[T]) retry(ctx context.Context, c *dialog.Conversation, value *T) (dialog.Reply, bool) {
+	errs := fieldErrors(value)
+
+	var messages []string
+	for _, field := range f.Fields {
+		if fe := errs.Field(f.structField(field.Name)); fe != nil {
+			messages = append(messages, i18n.Field(ctx, fe))
+			delete(c.Values, field.Name)
+		}
+	}
+	if len(messages) == 0 {
+		return dialog.Reply{}, false
+	}
+
+	return dialog.Reply{Text: strings.Join(messages, "\n"), Retry: f.nextField(c)}, true
+}
+
+// structField - имя поля T, в которое записывается ответ; по нему сопоставляются ошибки проверки
+func (f *Form[T]) structField(name string) string {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if i := lookupIndex(t, name); i >= 0 {
+		return t.Field(i).Name
+	}
+	return name
+}
+
+func fieldErrors(value interface{}) validation.Errors {
+	v, ok := value.(validator)
+	if !ok {
+		return nil
+	}
+	var errs validation.Errors
+	if errors.As(v.Validate(), &errs) {
+		return errs
+	}
+	return nil
+}
+
+// Decode разбирает ответы и заполняет ими значение типа T
+func (f *Form[T]) Decode(ctx context.Context, values dialog.Values) (*T, error) {
+	return f.decode(ctx, values, false)
+}
+
+// decode заполняет значение типа T; при partial поля без ответа остаются нулевыми
+func (f *Form[T]) decode(ctx context.Context, values dialog.Values, partial bool) (*T, error) {
+	value := new(T)
+	target := reflect.ValueOf(value).Elem()
+	if target.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("form %q: %T is not a struct", f.Name, *value)
+	}
+
+	for _, field := range f.Fields {
+		input, ok := values[field.Name]
+		if !ok {
+			if field.Optional || partial {
+				continue
+			}
+			return nil, fmt.Errorf("form %q: no value for %q", f.Name, field.Name)
+		}
+		converted, err := field.convert(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if converted == nil {
+			continue
+		}
+
+		dst := lookup(target, field.Name)
+		if !dst.IsValid() {
+			return nil, fmt.Errorf("form %q: %T has no field %q", f.Name, *value, field.Name)
+		}
+		if err = assign(dst, reflect.ValueOf(converted)); err != nil {
+			return nil, fmt.Errorf("form %q, field %q: %w", f.Name, field.Name, err)
+		}
+	}
+
+	return value, nil
+}
+
+func (f *Field) prompt(ctx context.Context, _ *dialog.Conversation) (string, error) {
+	prompt := i18n.T(ctx, f.Prompt, f.PromptArgs...)
+	if f.Optional && f.Choices == nil {
+		return i18n.T(ctx, "form.skip_hint", prompt, Skip), nil
+	}
+	return prompt, nil
+}
+
+// choices - варианты ответа шага; необязательное поле можно пропустить отдельным вариантом
+func (f *Field) choices() func(ctx context.Context, c *dialog.Conversation) ([]Choice, error) {
+	if f.Choices == nil {
+		return nil
+	}
+	return func(ctx context.Context, c *dialog.Conversation) ([]Choice, error) {
+		choices, err := f.Choices(ctx, c.Values)
+		if err != nil || !f.Optional {
+			return choices, err
+		}
+		return append(choices, Choice{Value: Skip, Label: i18n.T(ctx, "form.skip")}), nil
+	}
+}
+
+// parse проверяет ответ и приводит его к типу поля; для пропущенного поля возвращает nil
+func (f *Field) parse(ctx context.Context, values dialog.Values, input string) (interface{}, error) {
+	value, err := f.convert(ctx, input)
+	if err != nil || value == nil {
+		return nil, err
+	}
+
+	if f.Choices != nil {
+		choices, err := f.Choices(ctx, values)
+		if err != nil {
+			return nil, errors.New(i18n.T(ctx, "form.choices_failed"))
+		}
+		found := false
+		for _, c := range choices {
+			if c.Value == strings.TrimSpace(input) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.New(i18n.T(ctx, "form.choose_offered"))
+		}
+	}
+
+	if f.Validate != nil {
+		if err = f.Validate(ctx, value); err != nil {
+			return nil, err
+		}
+	}
+	return value, nil
+}
+
+// convert приводит ответ к типу поля без обращения к вариантам ответа
+func (f *Field) convert(ctx context.Context, input string) (interface{}, error) {
+	input = strings.TrimSpace(input)
+	if input == "" || input == Skip {
+		if f.Optional {
+			return nil, nil
+		}
+		return nil, errors.New(i18n.T(ctx, "form.required"))
+	}
+
+	switch f.Kind {
+	case Int:
+		n, err := strconv.Atoi(input)
+		if err != nil {
+			return nil, errors.New(i18n.T(ctx, "form.integer"))
+		}
+		return n, nil
+	case Float:
+		x, err := strconv.ParseFloat(strings.Replace(input, ",", ".", 1), 64)
+		if err != nil {
+			return nil, errors.New(i18n.T(ctx, "form.number"))
+		}
+		return x, nil
+	default:
+		return input, nil
+	}
+}
+
+func lookup(target reflect.Value, name string) reflect.Value {
+	if i := lookupIndex(target.Type(), name); i >= 0 {
+		return target.Field(i)
+	}
+	return reflect.Value{}
+}
+
+// lookupIndex ищет поле структуры по тегу form, а затем по имени без учета регистра
+func lookupIndex(t reflect.Type, name string) int {
+	if t.Kind() != reflect.Struct {
+		return -1
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if tag, ok := t.Field(i).Tag.Lookup("form"); ok && tag == name {
+			return i
+		}
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if _, ok := t.Field(i).Tag.Lookup("form"); !ok && strings.EqualFold(t.Field(i).Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// assign записывает значение в поле, при необходимости через указатель
+func assign(dst reflect.Value, src reflect.Value) error {
+	if dst.Kind() == reflect.Ptr {
+		ptr := reflect.New(dst.Type().Elem())
+		if err := assign(ptr.Elem(), src); err != nil {
+			return err
+		}
+		dst.Set(ptr)
+		return nil
+	}
+	if !src.Type().ConvertibleTo(dst.Type()) || src.Kind() == reflect.Int && dst.Kind() == reflect.String {
+		return fmt.Errorf("cannot assign %s to %s", src.Type(), dst.Type())
+	}
+	dst.Set(src.Convert(dst.Type()))
+	return nil
+}
@@ -0,0 +1,171 @@
+package form
+
+import (
+	"context"
+	"errors"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"hospital/internal/models/validation"
+	"hospital/internal/modules/config"
+	"hospital/internal/modules/domain/conversation/repo"
+	"hospital/internal/modules/view/telegram/dialog"
+	"testing"
+	"time"
+)
+
+type room struct {
+	Number       int `form:"num"`
+	Weight       float64
+	Type         string
+	DepartmentId *int
+}
+
+func (r *room) Validate() error {
+	var v validation.Validator
+	v.Check(r.Number <= 1000, "Number", "Номер: не больше 1000")
+	v.Check(r.Type != "Бокс" || r.Weight <= 10, "Weight", "Вес для бокса: не больше 10")
+	return v.Err()
+}
+
+func testForm(submitted *[]*room) *Form[room] {
+	return &Form[room]{
+		Name: "Добавить палату",
+		Fields: []Field{
+			{
+				Name: "num",
+				Kind: Int,
+				Validate: func(_ context.Context, v interface{}) error {
+					if v.(int) <= 0 {
+						return errors.New("Номер должен быть положительным")
+					}
+					return nil
+				},
+				Prompt: "Номер",
+			},
+			{Name: "weight", Prompt: "Вес", Kind: Float},
+			{
+				Name:   "type",
+				Prompt: "Тип",
+				Choices: func(context.Context, dialog.Values) ([]Choice, error) {
+					return []Choice{{Value: "Общая"}, {Value: "Бокс", Label: "изолятор"}}, nil
+				},
+			},
+			{Name: "departmentId", Prompt: "Отделение", Kind: Int, Optional: true},
+		},
+		Submit: func(_ context.Context, _ *dialog.Conversation, value *room) dialog.Reply {
+			*submitted = append(*submitted, value)
+			return dialog.Reply{Text: "Готово"}
+		},
+	}
+}
+
+func TestForm_Dialog(t *testing.T) {
+	department := 7
+
+	for _, tt := range []struct {
+		name      string
+		inputs    []string
+		wantReply string
+		want      *room
+	}{
+		{
+			name:      "All fields",
+			inputs:    []string{"12", "3,5", "Бокс", "7"},
+			wantReply: "Готово",
+			want:      &room{Number: 12, Weight: 3.5, Type: "Бокс", DepartmentId: &department},
+		},
+		{
+			name:      "Optional field is skipped",
+			inputs:    []string{"12", "3", "Общая", "-"},
+			wantReply: "Готово",
+			want:      &room{Number: 12, Weight: 3, Type: "Общая"},
+		},
+		{
+			name:      "Wrong type is asked again",
+			inputs:    []string{"двенадцать"},
+			wantReply: "Введите целое число\nНомер",
+		},
+		{
+			name:      "Validation error is asked again",
+			inputs:    []string{"-1"},
+			wantReply: "Номер должен быть положительным\nНомер",
+		},
+		{
+			name:      "Unknown choice is asked again",
+			inputs:    []string{"12", "3", "Люкс"},
+			wantReply: "Выберите один из предложенных вариантов\nТип\nОбщая\nБокс - изолятор",
+		},
+		{
+			name:      "Rule of the target type is checked for the answered field",
+			inputs:    []string{"1001"},
+			wantReply: "Номер: не больше 1000\nНомер",
+		},
+		{
+			name:      "Only the field that failed the final check is asked again",
+			inputs:    []string{"12", "30", "Бокс", "-"},
+			wantReply: "Вес для бокса: не больше 10\nВес",
+		},
+		{
+			name:      "Form is submitted after the failed field is corrected",
+			inputs:    []string{"12", "30", "Бокс", "-", "5"},
+			wantReply: "Готово",
+			want:      &room{Number: 12, Weight: 5, Type: "Бокс"},
+		},
+		{
+			name:      "Required field",
+			inputs:    []string{"12", " "},
+			wantReply: "Поле обязательно для заполнения\nВес",
+		},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			var submitted []*room
+			e := dialog.NewEngine(repo.NewMemoryConversationRepo(), config.Config{DialogIdleTimeout: time.Minute})
+			e.Register(testForm(&submitted).Dialog())
+
+			ctx := context.Background()
+			if got := e.Start(ctx, "Добавить палату", 1, 1); got.Text != "Номер" {
+				t.Fatalf("Start() = %q", got.Text)
+			}
+			var reply dialog.Reply
+			for _, input := range tt.inputs {
+				reply, _ = e.Handle(ctx, 1, input)
+			}
+			if reply.Text != tt.wantReply {
+				t.Errorf("Handle() = %q, want %q", reply.Text, tt.wantReply)
+			}
+
+			if tt.want == nil {
+				if len(submitted) != 0 {
+					t.Errorf("submitted = %v, want nothing", submitted)
+				}
+				return
+			}
+			if len(submitted) != 1 {
+				t.Fatalf("submitted %d times, want 1", len(submitted))
+			}
+			got := submitted[0]
+			if got.Number != tt.want.Number || got.Weight != tt.want.Weight || got.Type != tt.want.Type {
+				t.Errorf("submitted = %+v, want %+v", got, tt.want)
+			}
+			if (got.DepartmentId == nil) != (tt.want.DepartmentId == nil) ||
+				got.DepartmentId != nil && *got.DepartmentId != *tt.want.DepartmentId {
+				t.Errorf("DepartmentId = %v, want %v", got.DepartmentId, tt.want.DepartmentId)
+			}
+		})
+	}
+}
+
+func TestForm_Decode(t *testing.T) {
+	runner.Run(t, "Field missing in target type", func(t provider.T) {
+		f := &Form[room]{Name: "test", Fields: []Field{{Name: "floor", Kind: Int}}}
+		if _, err := f.Decode(context.Background(), dialog.Values{"floor": "2"}); err == nil {
+			t.Errorf("Decode() error = nil, want error")
+		}
+	})
+	runner.Run(t, "Missing required value", func(t provider.T) {
+		var submitted []*room
+		if _, err := testForm(&submitted).Decode(context.Background(), dialog.Values{"num": "1"}); err == nil {
+			t.Errorf("Decode() error = nil, want error")
+		}
+	})
+}
@@ -0,0 +1,151 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hospital/internal/models/role"
+	"hospital/internal/models/validation"
+	auth_dto "hospital/internal/modules/domain/auth/dto"
+	department_dto "hospital/internal/modules/domain/department/dto"
+	room_dto "hospital/internal/modules/domain/room/dto"
+	"hospital/internal/modules/view/telegram/controllers"
+	"hospital/internal/modules/view/telegram/dialog"
+	"hospital/internal/modules/view/telegram/form"
+	"hospital/internal/modules/view/telegram/i18n"
+	"strconv"
+	"strings"
+)
+
+// failed - ответ формы на ошибку сервиса; недопустимые значения полей показываются пользователю,
+// иначе - сообщение key
+func failed(ctx context.Context, err error, key string) dialog.Reply {
+	var fields validation.Errors
+	if errors.As(err, &fields) {
+		return dialog.Reply{Text: i18n.Error(ctx, fields)}
+	}
+	return dialog.Reply{Text: i18n.T(ctx, key)}
+}
+
+// Источники вариантов ответа для полей форм
+
+type choices func(ctx context.Context, values dialog.Values) ([]form.Choice, error)
+
+// roleKeys - ключи названий ролей; сами роли хранятся в базе как есть
+var roleKeys = map[string]string{
+	role.Doctor:        "role.doctor",
+	role.Nurse:         "role.nurse",
+	role.HeadPhysician: "role.head_physician",
+	role.Admin:         "role.admin",
+}
+
+// roleCodes - роли по латинским именам, которые принимают аргументы команд
+var roleCodes = map[string]string{
+	"doctor":         role.Doctor,
+	"nurse":          role.Nurse,
+	"head_physician": role.HeadPhysician,
+	"admin":          role.Admin,
+}
+
+// roleName - название роли на языке пользователя
+func roleName(ctx context.Context, r string) string {
+	if key, ok := roleKeys[r]; ok {
+		return i18n.T(ctx, key)
+	}
+	return r
+}
+
+// signUpRoleChoices - роли, которые можно выбрать при регистрации
+func signUpRoleChoices(ctx context.Context, _ dialog.Values) ([]form.Choice, error) {
+	choices := make([]form.Choice, len(auth_dto.SignUpRoles))
+	for i, r := range auth_dto.SignUpRoles {
+		choices[i] = form.Choice{Value: r, Label: roleName(ctx, r)}
+	}
+	return choices, nil
+}
+
+// roomChoices перечисляет палаты; при onlyFree - только палаты со свободными кроватями
+func roomChoices(controller *controllers.Controller, onlyFree bool) choices {
+	return func(ctx context.Context, _ dialog.Values) ([]form.Choice, error) {
+		rooms, err := controller.GetAllRooms(ctx)
+		if err != nil {
+			return nil, err
+		}
+		choices := make([]form.Choice, 0, len(rooms))
+		for _, r := range rooms {
+			if onlyFree && r.NumberPatients >= r.NumberBeds {
+				continue
+			}
+			choices = append(choices, form.Choice{Value: strconv.Itoa(r.Id), Label: roomLabel(ctx, r)})
+		}
+		return choices, nil
+	}
+}
+
+func roomLabel(ctx context.Context, r *room_dto.Room) string {
+	return i18n.T(ctx, "room.label", r.Num, r.Floor, r.TypeRoom, r.NumberPatients, r.NumberBeds)
+}
+
+func diseaseChoices(controller *controllers.Controller) choices {
+	return func(ctx context.Context, _ dialog.Values) ([]form.Choice, error) {
+		diseases, err := controller.GetAllDiseases(ctx)
+		if err != nil {
+			return nil, err
+		}
+		choices := make([]form.Choice, 0, len(diseases))
+		for _, d := range diseases {
+			choices = append(choices, form.Choice{
+				Value: strconv.Itoa(d.Id),
+				Label: i18n.T(ctx, "disease.label", d.Name, d.DegreeOfDanger),
+			})
+		}
+		return choices, nil
+	}
+}
+
+// patientChoices перечисляет пациентов, найденных по ответу на поле queryField
+func patientChoices(controller *controllers.Controller, queryField string) choices {
+	return func(ctx context.Context, values dialog.Values) ([]form.Choice, error) {
+		patients, err := controller.SearchPatients(ctx, strings.TrimSpace(values[queryField]))
+		if err != nil {
+			return nil, err
+		}
+		choices := make([]form.Choice, 0, len(patients))
+		for _, p := range patients {
+			choices = append(choices, form.Choice{
+				Value: strconv.Itoa(p.Id),
+				Label: fmt.Sprintf("%s %s %s", p.Surname, p.Name, p.Patronymic),
+			})
+		}
+		return choices, nil
+	}
+}
+
+// departmentChoices перечисляет отделения, которые вернул list: все или только отделения врача
+func departmentChoices(list func(ctx context.Context) (department_dto.Departments, error)) choices {
+	return func(ctx context.Context, _ dialog.Values) ([]form.Choice, error) {
+		departments, err := list(ctx)
+		if err != nil {
+			return nil, err
+		}
+		choices := make([]form.Choice, 0, len(departments))
+		for _, d := range departments {
+			choices = append(choices, form.Choice{Value: strconv.Itoa(d.Id), Label: d.Name})
+		}
+		return choices, nil
+	}
+}
+
+func organizationChoices(controller *controllers.Controller) choices {
+	return func(ctx context.Context, _ dialog.Values) ([]form.Choice, error) {
+		organizations, err := controller.GetAllOrganizations(ctx)
+		if err != nil {
+			return nil, err
+		}
+		choices := make([]form.Choice, 0, len(organizations))
+		for _, o := range organizations {
+			choices = append(choices, form.Choice{Value: strconv.Itoa(o.Id), Label: o.Name})
+		}
+		return choices, nil
+	}
+}
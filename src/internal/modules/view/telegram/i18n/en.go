@@ -0,0 +1,377 @@
+package i18n
+
+// en - сообщения на английском языке
+var en = map[string]string{
+	// Кнопки меню и названия диалогов
+	"button.register":          "Sign up",
+	"button.help":              "Help",
+	"button.about_me":          "About me",
+	"button.login":             "Log in",
+	"button.logout":            "Log out",
+	"button.logout_all":        "Log out everywhere",
+	"button.delete_patient":    "Delete patient",
+	"button.add_patient":       "Add patient",
+	"button.my_patients":       "My patients",
+	"button.find_room":         "Find room by number",
+	"button.all_rooms":         "All rooms",
+	"button.add_room":          "Add room",
+	"button.delete_room":       "Delete room",
+	"button.add_disease":       "Add disease",
+	"button.diagnose":          "Diagnose",
+	"button.audit_history":     "Change history",
+	"button.access_report":     "Who viewed patient",
+	"button.access_anomalies":  "Suspicious access",
+	"button.departments":       "Departments",
+	"button.add_department":    "Add department",
+	"button.assign_department": "Assign department",
+	"button.switch_department": "Switch department",
+	"button.organizations":     "Clinics",
+	"button.add_organization":  "Add clinic",
+	"button.record_vitals":     "Record vitals",
+	"button.assign_doctor":     "Assign attending doctor",
+	"button.alert_settings":    "Notification settings",
+	"button.on_call":           "On call",
+	"button.alert_report":      "Unacknowledged alerts",
+
+	// Слова, которые пользователь пишет во время диалога
+	"word.cancel": "Cancel",
+	"word.back":   "Back",
+	"word.yes":    "Yes",
+
+	"bot.unknown_command": "Unknown command, type Help",
+	"bot.login_required":  "Please log in: press Log in or Sign up",
+	"help.footer":         "\nMenu buttons: /start. While entering data: Back - return to the previous question, Cancel - stop",
+
+	// Ошибки по стабильным кодам
+	"error.id_validate":      "Invalid record ID",
+	"error.invalid_token":    "Invalid request",
+	"error.bad_request":      "Invalid request parameters",
+	"error.json_unmarshal":   "Could not read the data",
+	"error.json_marshal":     "Could not save the data",
+	"error.record_not_found": "Record not found",
+	"error.unique_violation": "Such a record already exists",
+	"error.validation":       "Invalid field values",
+	"error.access_denied":    "Access denied",
+	"error.unauthorized":     "Please log in: press Log in or Sign up",
+	"error.session_expired":  "Session expired, please log in again",
+	"error.not_registered":   "You are not registered yet: press Sign up",
+	"error.confirm_expired":  "Confirmation expired, repeat the command",
+	"error.callback_foreign": "This button is meant for another user",
+	"error.internal":         "Internal error, try again later",
+	"error.request":          "Request failed",
+
+	// Ошибки полей: первый аргумент - подпись поля
+	"validation.required": "%s: required field",
+	"validation.positive": "%s: must be greater than 0",
+	"validation.range":    "%s: allowed values are %v to %v",
+	"validation.invalid":  "%s: invalid value",
+
+	// Подписи полей сущностей
+	"field.surname":         "Surname",
+	"field.name":            "Name",
+	"field.patronymic":      "Patronymic",
+	"field.id":              "ID",
+	"field.title":           "Title",
+	"field.height":          "Height, cm",
+	"field.weight":          "Weight, kg",
+	"field.room":            "Room",
+	"field.danger":          "Danger level",
+	"field.room_num":        "Room number",
+	"field.floor":           "Floor",
+	"field.beds":            "Number of beds",
+	"field.room_type":       "Room type",
+	"field.occupied":        "Beds occupied",
+	"field.department":      "Department",
+	"field.number_patients": "Number of patients",
+	"field.speciality":      "Speciality",
+	"field.role":            "Role",
+	"field.on_call":         "On call",
+	"field.language":        "Language",
+	"field.ward_scope":      "Department or rooms",
+	"field.pulse":           "Pulse",
+	"field.systolic":        "Systolic pressure",
+	"field.diastolic":       "Diastolic pressure",
+	"field.temperature":     "Temperature",
+	"field.saturation":      "Saturation",
+	"field.min_severity":    "Severity",
+	"field.muted_kinds":     "Notification types",
+	"field.quiet_hours":     "Quiet hours",
+
+	"entity.patient": "patient",
+	"entity.room":    "room",
+	"entity.doctor":  "doctor",
+	"entity.disease": "disease",
+
+	"role.doctor":         "Doctor",
+	"role.nurse":          "Nurse",
+	"role.head_physician": "Head physician",
+	"role.admin":          "Administrator",
+	"role.invalid_id":     "Doctor ID must be a whole number",
+	"role.unknown":        "Unknown role %s: use doctor, nurse, head_physician or admin",
+	"role.granted":        "%s now has the role %s",
+
+	"actor.system": "system",
+	"actor.doctor": "doctor ID %d",
+
+	// Диалоги и формы
+	"dialog.load_failed":  "Could not load the dialog",
+	"dialog.expired":      "The previous dialog was cancelled: no answer in time",
+	"dialog.cancelled":    "Action cancelled",
+	"dialog.first_step":   "This is the first step. Type Cancel to exit",
+	"dialog.unknown_step": "Unknown dialog step %q",
+	"dialog.resume":       "The bot was restarted before you finished «%s». Continue? Type Yes or Cancel",
+	"dialog.no_choices":   "No suitable options. Action cancelled",
+	"dialog.save_failed":  "Could not save the dialog",
+
+	"form.skip_hint":      "%s\n(%s - skip)",
+	"form.skip":           "skip",
+	"form.failed":         "Could not fill in the form",
+	"form.choices_failed": "Could not load the options",
+	"form.choose_offered": "Choose one of the offered options",
+	"form.required":       "This field is required",
+	"form.integer":        "Enter a whole number",
+	"form.number":         "Enter a number",
+
+	"picker.stale":   "This choice is no longer relevant",
+	"picker.changed": "The list has changed, choose again",
+	"picker.chosen":  "Chosen: %s\n%s",
+
+	"confirm.yes": "Confirm",
+	"confirm.no":  "Cancel",
+	"confirm.ask": "Confirm the action",
+
+	"callback.stale":   "The button is outdated",
+	"callback.invalid": "Invalid button",
+	"callback.failed":  "Could not handle the button",
+
+	// Слэш-команды
+	"command.forbidden":                "The command is not available for your role",
+	"command.private_only":             "The %s command shows patient data and works only in a private chat with the bot",
+	"command.usage":                    "Usage: %s",
+	"command.help_title":               "Commands:",
+	"command.start.description":        "start and open the menu",
+	"command.start.greeting":           "Hello! Log in or sign up with the menu buttons. Commands: /help",
+	"command.start.menu":               "Menu opened. Commands: /help",
+	"command.help.description":         "list of commands",
+	"command.patients.description":     "your patients",
+	"command.patient.usage":            "<id>",
+	"command.patient.description":      "patient card",
+	"command.patient.invalid":          "Usage: /patient <id>, ID is a whole number",
+	"command.rooms.description":        "all rooms",
+	"command.room.usage":               "<number>",
+	"command.room.description":         "room card with patients",
+	"command.room.invalid":             "Usage: /room <number>, number is a whole number",
+	"command.anomalies.description":    "suspicious views of patient data",
+	"command.export.usage":             "<patients|rooms|doctors> [csv|xlsx] [filters]",
+	"command.export.description":       "export to a file; filters: room=, floor=, department=, danger=, role=, free, on_call",
+	"command.grant_role.usage":         "<doctor id> <doctor|nurse|head_physician|admin>",
+	"command.grant_role.description":   "grant a role to a doctor of your clinic",
+	"command.bind_group.usage":         "[department=<id>] [rooms=101,102]",
+	"command.bind_group.description":   "bind the ward group chat to a department or rooms",
+	"command.unbind_group.description": "unbind the ward group chat",
+	"command.group.description":        "which rooms the group chat is bound to",
+	"command.language.usage":           "<ru|en>",
+	"command.language.description":     "interface language",
+	"command.cancel.description":       "stop the current action",
+	"command.cancel.nothing":           "There is no action to stop",
+
+	"language.changed": "Interface language: English",
+	"language.unknown": "Unknown language. Available languages: %s",
+	"language.failed":  "Could not change the language",
+
+	// Вход и регистрация
+	"signup.prompt.surname":    "Enter your surname",
+	"signup.prompt.speciality": "Enter your speciality",
+	"signup.prompt.role":       "Choose your role",
+	"signup.exists":            "Already signed up",
+	"signup.role_forbidden":    "Only the doctor or nurse role can be chosen when signing up",
+	"signup.login_failed":      "Signed up, but could not log in",
+	"signup.done":              "Signed up",
+
+	"auth.not_registered":        "You are not signed up",
+	"auth.logged_in":             "You are logged in",
+	"auth.logout_failed":         "Could not log out",
+	"auth.logged_out":            "You are logged out",
+	"auth.sessions_closed#one":   "Closed %d session",
+	"auth.sessions_closed#other": "Closed %d sessions",
+
+	"doctor.card":             "Surname: %s \nSpeciality: %s \nRole: %s \n",
+	"doctor.prompt.id":        "Enter the doctor ID",
+	"doctor.on_call_started":  "You are on call: unacknowledged critical alerts of your colleagues will come to you",
+	"doctor.on_call_finished": "On-call duty finished",
+
+	// Пациенты
+	"patient.prompt.surname":    "Enter the patient's surname",
+	"patient.prompt.name":       "Enter the patient's name",
+	"patient.prompt.patronymic": "Enter the patient's patronymic",
+	"patient.prompt.height":     "Enter the patient's height, cm (%d-%d)",
+	"patient.prompt.weight":     "Enter the patient's weight, kg (%g-%g)",
+	"patient.prompt.room":       "Choose the patient's room",
+	"patient.prompt.danger":     "Enter the patient's danger level (%d-%d)",
+	"patient.prompt.query":      "Enter the patient's surname or name",
+	"patient.prompt.choose":     "Choose a patient",
+	"patient.add_failed":        "Could not add the patient",
+	"patient.added":             "Patient added",
+	"patient.not_found":         "Patient ID %d not found",
+	"patient.list":              "Patients: %d",
+	"patient.none":              "No patients",
+	"patient.card":              "Patient ID %d",
+	"patient.assign_failed":     "Could not assign the attending doctor",
+	"patient.assigned":          "Patient %s %s is assigned to doctor ID %d",
+
+	// Палаты
+	"room.prompt.num":        "Enter the room number",
+	"room.prompt.floor":      "Enter the room floor (%d-%d)",
+	"room.prompt.beds":       "Enter the number of beds (%d-%d)",
+	"room.prompt.type":       "Enter the room type",
+	"room.prompt.department": "Choose the room department",
+	"room.prompt.choose":     "Choose a room",
+	"room.add_failed":        "Could not add the room",
+	"room.added":             "Room added",
+	"room.not_found":         "Room #%d not found",
+	"room.list":              "Rooms: %d",
+	"room.none":              "No rooms",
+	"room.label":             "#%d, floor %d, %s, %d of %d occupied",
+	"room.card":              "Room #%d (ID %d)",
+	"room.occupied":          "%d of %d",
+	"room.no_patients":       "No patients",
+	"room.patients":          "Patients:",
+	"room.patient":           "%d. %s %s %s, ID %d, danger %d\n",
+
+	// Заболевания
+	"disease.prompt.name":   "Enter the disease",
+	"disease.prompt.danger": "Enter the disease danger level (%d-%d)",
+	"disease.prompt.threat": "Enter the treatment",
+	"disease.prompt.choose": "Choose a disease",
+	"disease.add_failed":    "Could not add the disease",
+	"disease.added":         "Disease added",
+	"disease.label":         "%s, danger %d",
+	"diagnosis.failed":      "Could not set the diagnosis",
+	"diagnosis.done":        "Diagnosis set: %s %s",
+
+	// Удаление
+	"delete.patient_summary": "Patient ID %d will be deleted \nSurname: %s \nName: %s \nPatronymic: %s \nRoom: %d",
+	"delete.patient_done":    "Patient deleted",
+	"delete.room_summary":    "Room ID %d will be deleted \nNumber: %d \nFloor: %d \nPatients: %d",
+	"delete.room_done":       "Room deleted",
+	"delete.failed":          "Could not delete",
+
+	// Клиники и отделения
+	"organization.prompt.name":   "Enter the clinic name",
+	"organization.prompt.choose": "Choose a clinic",
+	"organization.admins_only":   "Only administrators not bound to a clinic can add clinics",
+	"organization.add_failed":    "Could not add the clinic",
+	"organization.added":         "Clinic added",
+	"organization.none":          "No clinics",
+	"organization.card":          "ID %d \nTitle: %s \n",
+
+	"department.prompt.name":        "Enter the department name",
+	"department.prompt.choose":      "Choose a department",
+	"department.admins_only":        "Only administrators of the clinic can add departments",
+	"department.add_failed":         "Could not add the department",
+	"department.added":              "Department added",
+	"department.assign_admins_only": "Only administrators can assign departments",
+	"department.assign_failed":      "Could not assign the department",
+	"department.assigned":           "Doctor added to department %s",
+	"department.none":               "No departments",
+	"department.not_member":         "You do not work in this department",
+	"department.switch_failed":      "Could not switch the department",
+	"department.current":            "Current department: %s",
+	"department.card":               "ID %d \nTitle: %s \nClinic: %d \n",
+
+	// Журналы
+	"audit.prompt.entity":  "Enter the record type: patient, room, doctor or disease",
+	"audit.prompt.id":      "Enter the record ID",
+	"audit.unknown_entity": "Unknown record type",
+	"audit.invalid_id":     "Invalid record ID",
+	"audit.admins_only":    "Change history is available to administrators only",
+	"audit.none":           "No changes found",
+	"audit.action.create":  "created",
+	"audit.action.update":  "updated",
+	"audit.action.delete":  "deleted",
+
+	"access.admins_only":           "The access log is available to administrators only",
+	"access.none":                  "Nobody has viewed the patient's data",
+	"access.record":                "%s, %s, %s, purpose: %s",
+	"access.no_anomalies":          "No suspicious views found",
+	"access.anomaly":               "Doctor ID %d: opened %s, does not treat %d of them",
+	"access.opened_patients#one":   "%d patient",
+	"access.opened_patients#other": "%d patients",
+	"access.action.get":            "card",
+	"access.action.list":           "list",
+	"access.action.search":         "search",
+
+	// Показатели жизнедеятельности
+	"vitals.prompt.pulse":       "Enter the pulse, bpm (%d-%d)",
+	"vitals.prompt.systolic":    "Enter the systolic pressure, mmHg (%d-%d)",
+	"vitals.prompt.diastolic":   "Enter the diastolic pressure, mmHg (%d-%d)",
+	"vitals.prompt.temperature": "Enter the temperature, °C (%g-%g)",
+	"vitals.prompt.saturation":  "Enter the saturation, %% (%d-%d)",
+	"vitals.failed":             "Could not save the vitals",
+	"vitals.recorded":           "Vitals saved",
+	"vitals.recorded_abnormal":  "Vitals saved. Out of range: %s, attending doctors are notified",
+	"vital.pulse":               "pulse",
+	"vital.systolic":            "systolic pressure",
+	"vital.diastolic":           "diastolic pressure",
+	"vital.temperature":         "temperature",
+	"vital.saturation":          "saturation",
+
+	// Уведомления врачам
+	"alert.severity.info":           "Info",
+	"alert.severity.warning":        "Warning",
+	"alert.severity.critical":       "CRITICAL",
+	"alert.danger_raised":           "Patient %s (ID %d): danger level rose from %s to %s",
+	"alert.vitals_abnormal":         "Patient %s (ID %d): vitals out of range - %s\nPulse %s, pressure %s/%s, temperature %s, saturation %s",
+	"alert.patient_assigned":        "Patient %s (ID %d) is assigned to you",
+	"alert.kind.danger_raised":      "danger level rising",
+	"alert.kind.vitals_abnormal":    "vitals out of range",
+	"alert.kind.patient_assigned":   "new patients",
+	"alert.min.info":                "All notifications",
+	"alert.min.warning":             "Only warnings and critical",
+	"alert.min.critical":            "Only critical",
+	"alert.on":                      "Send",
+	"alert.off":                     "Do not send",
+	"alert.prompt.min_severity":     "Which notifications to send? Critical ones always arrive",
+	"alert.prompt.quiet_hours":      "Enter quiet hours, for example 22:00-07:00. Only critical notifications arrive during them",
+	"alert.prompt.danger_raised":    "Notify when a patient's danger level rises?",
+	"alert.prompt.vitals_abnormal":  "Notify about vitals out of range?",
+	"alert.prompt.patient_assigned": "Notify when patients are assigned to you?",
+	"alert.settings_failed":         "Could not save the notification settings",
+	"alert.settings_saved":          "Notification settings saved",
+	"alert.preference":              "Send: %s\nQuiet hours: %s\nMuted: %s",
+	"alert.quiet_none":              "none",
+	"alert.muted_none":              "nothing",
+	"alert.ack":                     "Acknowledge",
+	"alert.acknowledged":            "Alert acknowledged",
+	"alert.escalated":               "Doctor ID %s did not acknowledge the alert in time",
+	"alert.unassigned":              "The patient has no attending doctor",
+	"alert.minutes#one":             "%d minute",
+	"alert.minutes#other":           "%d minutes",
+	"alert.report.admins_only":      "The alert report is available to administrators only",
+	"alert.report.none":             "All critical alerts were acknowledged in time",
+	"alert.report.unacknowledged":   "Not acknowledged:\n",
+	"alert.report.late":             "Acknowledged later than %s:\n",
+	"alert.report.line":             "#%d %s, %s, patient ID %d, %s\n",
+	"alert.report.late_line":        "#%d %s, %s, patient ID %d, %s, acknowledged after %s\n",
+	"alert.report.doctor":           "doctor ID %d",
+	"alert.report.unassigned":       "no attending doctor",
+
+	// Выгрузка в файл
+	"export.kind.patients":  "Patients",
+	"export.kind.rooms":     "Rooms",
+	"export.kind.doctors":   "Doctors",
+	"export.invalid_filter": "Unknown export filter: %s",
+	"export.rows#one":       "Exported %d row",
+	"export.rows#other":     "Exported %d rows",
+
+	// Групповые чаты бригад
+	"group.added":            "Hello! An administrator can bind this chat to a department or rooms with /bind_group department=<id> or /bind_group rooms=101,102, and patient notifications will be posted here.",
+	"group.only":             "This command works only in a ward group chat",
+	"group.invalid_argument": "Unknown binding: %s. Use department=<id> or rooms=101,102",
+	"group.bound":            "Chat bound to %s. Patient notifications will be posted here",
+	"group.unbound":          "Chat unbound, notifications will no longer be posted here",
+	"group.not_bound":        "This chat is not bound to a department or rooms",
+	"group.info":             "Patient notifications: %s",
+	"group.scope.department": "department ID %d",
+	"group.scope.rooms":      "rooms %s",
+}
@@ -0,0 +1,173 @@
+// Package i18n - каталог сообщений бота. Сообщения хранятся в наборах по языкам
+// под стабильными ключами; сообщения с числом имеют формы множественного числа
+// с суффиксами #one, #few, #many, #other.
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"hospital/internal/models/errors"
+	"hospital/internal/models/validation"
+	"strings"
+)
+
+type Lang string
+
+const (
+	RU Lang = "ru"
+	EN Lang = "en"
+
+	Default = RU
+)
+
+// Langs - поддерживаемые языки в порядке показа пользователю
+var Langs = []Lang{RU, EN}
+
+var bundles = map[Lang]map[string]string{
+	RU: ru,
+	EN: en,
+}
+
+// Parse возвращает поддерживаемый язык по коду вида "en" или "en-US"
+func Parse(code string) (Lang, bool) {
+	code = strings.ToLower(code)
+	if i := strings.IndexAny(code, "-_"); i >= 0 {
+		code = code[:i]
+	}
+	if _, ok := bundles[Lang(code)]; ok {
+		return Lang(code), true
+	}
+	return Default, false
+}
+
+type langCtx struct{}
+
+func WithLang(ctx context.Context, lang Lang) context.Context {
+	return context.WithValue(ctx, langCtx{}, lang)
+}
+
+// FromCtx - язык, выбранный для запроса
+func FromCtx(ctx context.Context) (Lang, bool) {
+	lang, ok := ctx.Value(langCtx{}).(Lang)
+	return lang, ok
+}
+
+// LangOf - язык запроса или язык по умолчанию
+func LangOf(ctx context.Context) Lang {
+	if lang, ok := FromCtx(ctx); ok {
+		return lang
+	}
+	return Default
+}
+
+// T - сообщение key на языке запроса
+func T(ctx context.Context, key string, args ...interface{}) string {
+	return Tr(LangOf(ctx), key, args...)
+}
+
+// Tr - сообщение key на языке lang; без перевода - на языке по умолчанию, без сообщения - сам ключ
+func Tr(lang Lang, key string, args ...interface{}) string {
+	text, ok := lookup(lang, key)
+	if !ok {
+		return key
+	}
+	if len(args) == 0 {
+		return text
+	}
+	return fmt.Sprintf(text, args...)
+}
+
+// N - сообщение key в форме для числа n; n передается первым аргументом
+func N(ctx context.Context, key string, n int, args ...interface{}) string {
+	lang := LangOf(ctx)
+	args = append([]interface{}{n}, args...)
+	if text, ok := lookup(lang, key+"#"+plural(lang, n)); ok {
+		return fmt.Sprintf(text, args...)
+	}
+	return Tr(lang, key+"#other", args...)
+}
+
+// Has - есть ли сообщение key
+func Has(key string) bool {
+	_, ok := lookup(Default, key)
+	return ok
+}
+
+// Match находит ключ с префиксом prefix, текст которого на любом языке совпадает с text
+// без учета регистра. Так распознаются кнопки и слова, введенные пользователем.
+func Match(prefix string, text string) (string, bool) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return "", false
+	}
+	for _, lang := range Langs {
+		for key, message := range bundles[lang] {
+			if strings.HasPrefix(key, prefix) && strings.EqualFold(message, text) {
+				return key, true
+			}
+		}
+	}
+	return "", false
+}
+
+// Error переводит ошибку по ее коду; ошибки полей перечисляются с подписями полей
+func Error(ctx context.Context, err error) string {
+	if fields, ok := err.(validation.Errors); ok {
+		messages := make([]string, len(fields))
+		for i, f := range fields {
+			messages[i] = Field(ctx, f)
+		}
+		return strings.Join(messages, "\n")
+	}
+	key := "error." + errors.Code(err)
+	if !Has(key) {
+		key = "error." + errors.CodeInternal
+	}
+	return T(ctx, key)
+}
+
+// Field переводит ошибку поля. Подпись поля переводится по ее тексту на языке по умолчанию:
+// одноименные поля разных сущностей могут подписываться по-разному.
+// Подпись без перевода показывается как есть.
+func Field(ctx context.Context, f *validation.FieldError) string {
+	label := f.Label
+	if key, ok := Match("field.", f.Label); ok {
+		label = T(ctx, key)
+	}
+	key := "validation." + f.Code
+	if !Has(key) {
+		return f.Message
+	}
+	return T(ctx, key, append([]interface{}{label}, f.Args...)...)
+}
+
+func lookup(lang Lang, key string) (string, bool) {
+	if text, ok := bundles[lang][key]; ok {
+		return text, true
+	}
+	text, ok := bundles[Default][key]
+	return text, ok
+}
+
+// plural - форма множественного числа по правилам CLDR
+func plural(lang Lang, n int) string {
+	if n < 0 {
+		n = -n
+	}
+	switch lang {
+	case RU:
+		switch {
+		case n%10 == 1 && n%100 != 11:
+			return "one"
+		case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+			return "few"
+		default:
+			return "many"
+		}
+	default:
+		if n == 1 {
+			return "one"
+		}
+		return "other"
+	}
+}
@@ -0,0 +1,160 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"hospital/internal/models/errors"
+	"hospital/internal/models/validation"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var verb = regexp.MustCompile(`%[-+# 0-9.]*[a-zA-Z]`)
+
+// baseKeys - ключи набора без суффиксов форм множественного числа
+func baseKeys(bundle map[string]string) map[string]string {
+	keys := map[string]string{}
+	for key, text := range bundle {
+		base, _, _ := strings.Cut(key, "#")
+		keys[base] = text
+	}
+	return keys
+}
+
+func TestBundles_SameKeys(t *testing.T) {
+	runner.Run(t, "Every language has every message with the same arguments", func(t provider.T) {
+		def := baseKeys(bundles[Default])
+		for _, lang := range Langs {
+			keys := baseKeys(bundles[lang])
+			for key, text := range def {
+				translated, ok := keys[key]
+				if !ok {
+					t.Errorf("%s: no message %q", lang, key)
+					continue
+				}
+				if got, want := verb.FindAllString(translated, -1), verb.FindAllString(text, -1); fmt.Sprint(got) != fmt.Sprint(want) {
+					t.Errorf("%s: message %q has verbs %v, want %v", lang, key, got, want)
+				}
+			}
+			for key := range keys {
+				if _, ok := def[key]; !ok {
+					t.Errorf("%s: message %q is missing in %s", lang, key, Default)
+				}
+			}
+		}
+	})
+}
+
+func TestN(t *testing.T) {
+	for _, tt := range []struct {
+		lang Lang
+		n    int
+		want string
+	}{
+		{RU, 1, "Завершена 1 сессия"},
+		{RU, 2, "Завершено 2 сессии"},
+		{RU, 5, "Завершено 5 сессий"},
+		{RU, 11, "Завершено 11 сессий"},
+		{RU, 21, "Завершена 21 сессия"},
+		{RU, 22, "Завершено 22 сессии"},
+		{RU, 112, "Завершено 112 сессий"},
+		{EN, 1, "Closed 1 session"},
+		{EN, 2, "Closed 2 sessions"},
+		{EN, 21, "Closed 21 sessions"},
+	} {
+		runner.Run(t, fmt.Sprintf("%s %d", tt.lang, tt.n), func(t provider.T) {
+			ctx := WithLang(context.Background(), tt.lang)
+			if got := N(ctx, "auth.sessions_closed", tt.n); got != tt.want {
+				t.Errorf("N() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestT(t *testing.T) {
+	runner.Run(t, "Messages fall back to the default language and to the key", func(t provider.T) {
+		ctx := context.Background()
+		if got := T(ctx, "dialog.cancelled"); got != "Действие отменено" {
+			t.Errorf("T() without language = %q", got)
+		}
+		if got := T(WithLang(ctx, EN), "dialog.cancelled"); got != "Action cancelled" {
+			t.Errorf("T(en) = %q", got)
+		}
+		if got := T(WithLang(ctx, EN), "no.such.key"); got != "no.such.key" {
+			t.Errorf("T(missing) = %q", got)
+		}
+	})
+}
+
+func TestParse(t *testing.T) {
+	for _, tt := range []struct {
+		code string
+		want Lang
+		ok   bool
+	}{
+		{"ru", RU, true},
+		{"en-US", EN, true},
+		{"EN", EN, true},
+		{"de", Default, false},
+		{"", Default, false},
+	} {
+		runner.Run(t, fmt.Sprintf("Parse %q", tt.code), func(t provider.T) {
+			got, ok := Parse(tt.code)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("Parse() = %v, %v, want %v, %v", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	for _, tt := range []struct {
+		text string
+		want string
+		ok   bool
+	}{
+		{"Добавить пациента", "button.add_patient", true},
+		{"add patient", "button.add_patient", true},
+		{" Помощь ", "button.help", true},
+		{"Отмена", "", false},
+		{"", "", false},
+	} {
+		runner.Run(t, fmt.Sprintf("Match %q", tt.text), func(t provider.T) {
+			got, ok := Match("button.", tt.text)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("Match() = %q, %v, want %q, %v", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestError(t *testing.T) {
+	var v validation.Validator
+	v.Required("Surname", "Фамилия", "")
+	v.IntRange("Floor", "Этаж", 0, 1, 10)
+	v.Check(false, "Type", "Тип: только бокс")
+	fields := v.Err()
+
+	for _, tt := range []struct {
+		name string
+		lang Lang
+		err  error
+		want string
+	}{
+		{"Code ru", RU, errors.ErrAccessDenied, "Недостаточно прав"},
+		{"Code en", EN, errors.ErrAccessDenied, "Access denied"},
+		{"Wrapped code", EN, fmt.Errorf("delete: %w", errors.ErrDatabaseRecordNotFound), "Record not found"},
+		{"Unknown error", EN, fmt.Errorf("boom"), "Internal error, try again later"},
+		{"Fields ru", RU, fields, "Фамилия: обязательное поле\nЭтаж: допустимые значения от 1 до 10\nТип: только бокс"},
+		{"Fields en", EN, fields, "Surname: required field\nFloor: allowed values are 1 to 10\nТип: только бокс"},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			if got := Error(WithLang(context.Background(), tt.lang), tt.err); got != tt.want {
+				t.Errorf("Error() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
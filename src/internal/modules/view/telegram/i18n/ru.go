@@ -0,0 +1,385 @@
+package i18n
+
+// ru - сообщения на русском языке; это язык по умолчанию, в нем должны быть все ключи
+var ru = map[string]string{
+	// Кнопки меню и названия диалогов
+	"button.register":          "Зарегестрироваться",
+	"button.help":              "Помощь",
+	"button.about_me":          "Просмотреть данные о себе",
+	"button.login":             "Войти",
+	"button.logout":            "Выйти",
+	"button.logout_all":        "Выйти везде",
+	"button.delete_patient":    "Удалить пациента",
+	"button.add_patient":       "Добавить пациента",
+	"button.my_patients":       "Посмотреть своих пациентов",
+	"button.find_room":         "Найти палату по номеру",
+	"button.all_rooms":         "Вывести все палаты",
+	"button.add_room":          "Добавить палату",
+	"button.delete_room":       "Удалить палату",
+	"button.add_disease":       "Добавить заболевание",
+	"button.diagnose":          "Поставить диагноз",
+	"button.audit_history":     "История изменений",
+	"button.access_report":     "Кто смотрел пациента",
+	"button.access_anomalies":  "Подозрительный доступ",
+	"button.departments":       "Вывести отделения",
+	"button.add_department":    "Добавить отделение",
+	"button.assign_department": "Назначить отделение",
+	"button.switch_department": "Сменить отделение",
+	"button.organizations":     "Вывести клиники",
+	"button.add_organization":  "Добавить клинику",
+	"button.record_vitals":     "Внести показатели",
+	"button.assign_doctor":     "Назначить лечащего врача",
+	"button.alert_settings":    "Настройки уведомлений",
+	"button.on_call":           "Дежурство",
+	"button.alert_report":      "Неподтвержденные уведомления",
+
+	// Слова, которые пользователь пишет во время диалога
+	"word.cancel": "Отмена",
+	"word.back":   "Назад",
+	"word.yes":    "Да",
+
+	"bot.unknown_command": "Команда не найдена, напишите Помощь",
+	"bot.login_required":  "Войдите в систему: нажмите Войти или Зарегестрироваться",
+	"help.footer":         "\nКнопки меню: /start. Во время ввода данных: Назад - вернуться к предыдущему вопросу, Отмена - прервать",
+
+	// Ошибки по стабильным кодам
+	"error.id_validate":      "Неверно указан ID записи",
+	"error.invalid_token":    "Некорректный запрос",
+	"error.bad_request":      "Ошибка параметров запроса",
+	"error.json_unmarshal":   "Не удалось прочитать данные",
+	"error.json_marshal":     "Не удалось сохранить данные",
+	"error.record_not_found": "Запись не найдена",
+	"error.unique_violation": "Такая запись уже есть",
+	"error.validation":       "Недопустимые значения полей",
+	"error.access_denied":    "Недостаточно прав",
+	"error.unauthorized":     "Войдите в систему: нажмите Войти или Зарегестрироваться",
+	"error.session_expired":  "Сессия истекла, войдите снова",
+	"error.not_registered":   "Вы еще не зарегистрированы: нажмите Зарегестрироваться",
+	"error.confirm_expired":  "Время подтверждения истекло, повторите команду",
+	"error.callback_foreign": "Эта кнопка предназначена другому пользователю",
+	"error.internal":         "Внутренняя ошибка, попробуйте позже",
+	"error.request":          "Ошибка запроса",
+
+	// Ошибки полей: первый аргумент - подпись поля
+	"validation.required": "%s: обязательное поле",
+	"validation.positive": "%s: должно быть больше 0",
+	"validation.range":    "%s: допустимые значения от %v до %v",
+	"validation.invalid":  "%s: недопустимое значение",
+
+	// Подписи полей сущностей
+	"field.surname":         "Фамилия",
+	"field.name":            "Имя",
+	"field.patronymic":      "Отчество",
+	"field.id":              "ID",
+	"field.title":           "Название",
+	"field.height":          "Рост, см",
+	"field.weight":          "Вес, кг",
+	"field.room":            "Палата",
+	"field.danger":          "Степень опасности",
+	"field.room_num":        "Номер палаты",
+	"field.floor":           "Этаж",
+	"field.beds":            "Количество кроватей",
+	"field.room_type":       "Тип палаты",
+	"field.occupied":        "Занято кроватей",
+	"field.department":      "Отделение",
+	"field.number_patients": "Количество пациентов",
+	"field.speciality":      "Специальность",
+	"field.role":            "Роль",
+	"field.on_call":         "На дежурстве",
+	"field.language":        "Язык",
+	"field.ward_scope":      "Отделение или палаты",
+	"field.pulse":           "Пульс",
+	"field.systolic":        "Верхнее давление",
+	"field.diastolic":       "Нижнее давление",
+	"field.temperature":     "Температура",
+	"field.saturation":      "Сатурация",
+	"field.min_severity":    "Важность",
+	"field.muted_kinds":     "Виды уведомлений",
+	"field.quiet_hours":     "Время тишины",
+
+	"entity.patient": "пациент",
+	"entity.room":    "палата",
+	"entity.doctor":  "врач",
+	"entity.disease": "заболевание",
+
+	"role.doctor":         "Врач",
+	"role.nurse":          "Медсестра",
+	"role.head_physician": "Глав врач",
+	"role.admin":          "Администратор",
+	"role.invalid_id":     "ID врача - целое число",
+	"role.unknown":        "Неизвестная роль %s: укажите doctor, nurse, head_physician или admin",
+	"role.granted":        "Врачу %s назначена роль %s",
+
+	"actor.system": "система",
+	"actor.doctor": "врач ID %d",
+
+	// Диалоги и формы
+	"dialog.load_failed":  "Ошибка загрузки диалога",
+	"dialog.expired":      "Предыдущий диалог отменен: истекло время ожидания ответа",
+	"dialog.cancelled":    "Действие отменено",
+	"dialog.first_step":   "Это первый шаг. Для выхода напишите Отмена",
+	"dialog.unknown_step": "Неизвестный шаг диалога %q",
+	"dialog.resume":       "Бот был перезапущен, а вы не закончили «%s». Продолжить? Напишите Да или Отмена",
+	"dialog.no_choices":   "Нет подходящих вариантов. Действие отменено",
+	"dialog.save_failed":  "Ошибка сохранения диалога",
+
+	"form.skip_hint":      "%s\n(%s - пропустить)",
+	"form.skip":           "пропустить",
+	"form.failed":         "Ошибка заполнения формы",
+	"form.choices_failed": "Не удалось загрузить варианты ответа",
+	"form.choose_offered": "Выберите один из предложенных вариантов",
+	"form.required":       "Поле обязательно для заполнения",
+	"form.integer":        "Введите целое число",
+	"form.number":         "Введите число",
+
+	"picker.stale":   "Этот выбор уже неактуален",
+	"picker.changed": "Список изменился, выберите еще раз",
+	"picker.chosen":  "Выбрано: %s\n%s",
+
+	"confirm.yes": "Подтвердить",
+	"confirm.no":  "Отмена",
+	"confirm.ask": "Подтвердите действие",
+
+	"callback.stale":   "Кнопка устарела",
+	"callback.invalid": "Некорректная кнопка",
+	"callback.failed":  "Ошибка обработки кнопки",
+
+	// Слэш-команды
+	"command.forbidden":                "Команда недоступна для вашей роли",
+	"command.private_only":             "Команда %s показывает данные пациентов и работает только в личном чате с ботом",
+	"command.usage":                    "Использование: %s",
+	"command.help_title":               "Команды:",
+	"command.start.description":        "начать работу и открыть меню",
+	"command.start.greeting":           "Здравствуйте! Войдите или зарегистрируйтесь кнопками меню. Список команд: /help",
+	"command.start.menu":               "Меню открыто. Список команд: /help",
+	"command.help.description":         "список команд",
+	"command.patients.description":     "ваши пациенты",
+	"command.patient.usage":            "<id>",
+	"command.patient.description":      "карточка пациента",
+	"command.patient.invalid":          "Использование: /patient <id>, ID - целое число",
+	"command.rooms.description":        "все палаты",
+	"command.room.usage":               "<номер>",
+	"command.room.description":         "карточка палаты с пациентами",
+	"command.room.invalid":             "Использование: /room <номер>, номер - целое число",
+	"command.anomalies.description":    "подозрительные просмотры данных пациентов",
+	"command.export.usage":             "<patients|rooms|doctors> [csv|xlsx] [условия]",
+	"command.export.description":       "выгрузка в файл; условия: room=, floor=, department=, danger=, role=, free, on_call",
+	"command.grant_role.usage":         "<id врача> <doctor|nurse|head_physician|admin>",
+	"command.grant_role.description":   "назначить роль врачу своей клиники",
+	"command.bind_group.usage":         "[department=<id>] [rooms=101,102]",
+	"command.bind_group.description":   "привязать групповой чат бригады к отделению или палатам",
+	"command.unbind_group.description": "отвязать групповой чат бригады",
+	"command.group.description":        "к каким палатам привязан групповой чат",
+	"command.language.usage":           "<ru|en>",
+	"command.language.description":     "язык интерфейса",
+	"command.cancel.description":       "прервать текущее действие",
+	"command.cancel.nothing":           "Нет действия, которое можно прервать",
+
+	"language.changed": "Язык интерфейса: русский",
+	"language.unknown": "Неизвестный язык. Доступные языки: %s",
+	"language.failed":  "Не удалось сменить язык",
+
+	// Вход и регистрация
+	"signup.prompt.surname":    "Введите свою фамилию",
+	"signup.prompt.speciality": "Введите свою специальность",
+	"signup.prompt.role":       "Выберите свою роль",
+	"signup.exists":            "Уже зарегистрированы",
+	"signup.role_forbidden":    "При регистрации можно выбрать только роль врача или медсестры",
+	"signup.login_failed":      "Зарегистрирован, но войти не удалось",
+	"signup.done":              "Зарегистрирован",
+
+	"auth.not_registered":        "Вы не зарегистрированы",
+	"auth.logged_in":             "Вы вошли в систему",
+	"auth.logout_failed":         "Ошибка выхода",
+	"auth.logged_out":            "Вы вышли из системы",
+	"auth.sessions_closed#one":   "Завершена %d сессия",
+	"auth.sessions_closed#few":   "Завершено %d сессии",
+	"auth.sessions_closed#many":  "Завершено %d сессий",
+	"auth.sessions_closed#other": "Завершено %d сессий",
+
+	"doctor.card":             "Фамилия: %s \nСпециальность: %s \nРоль: %s \n",
+	"doctor.prompt.id":        "Введите ID врача",
+	"doctor.on_call_started":  "Вы на дежурстве: вам будут приходить неподтвержденные критические уведомления коллег",
+	"doctor.on_call_finished": "Дежурство закончено",
+
+	// Пациенты
+	"patient.prompt.surname":    "Введите фамилию пациента",
+	"patient.prompt.name":       "Введите имя пациента",
+	"patient.prompt.patronymic": "Введите отчество пациента",
+	"patient.prompt.height":     "Введите рост пациента, см (%d-%d)",
+	"patient.prompt.weight":     "Введите вес пациента, кг (%g-%g)",
+	"patient.prompt.room":       "Выберите палату пациента",
+	"patient.prompt.danger":     "Введите степень опасности пациента (%d-%d)",
+	"patient.prompt.query":      "Введите фамилию или имя пациента",
+	"patient.prompt.choose":     "Выберите пациента",
+	"patient.add_failed":        "Ошибка добавления пациента",
+	"patient.added":             "Пациент добавлен",
+	"patient.not_found":         "Пациент ID %d не найден",
+	"patient.list":              "Пациенты: %d",
+	"patient.none":              "Пациентов нет",
+	"patient.card":              "Пациент ID %d",
+	"patient.assign_failed":     "Не удалось назначить лечащего врача",
+	"patient.assigned":          "Пациент %s %s закреплен за врачом ID %d",
+
+	// Палаты
+	"room.prompt.num":        "Введите номер палаты",
+	"room.prompt.floor":      "Введите этаж палаты (%d-%d)",
+	"room.prompt.beds":       "Введите количество кроватей палаты (%d-%d)",
+	"room.prompt.type":       "Введите тип палаты",
+	"room.prompt.department": "Выберите отделение палаты",
+	"room.prompt.choose":     "Выберите палату",
+	"room.add_failed":        "Ошибка добавления палаты",
+	"room.added":             "Палата добавлена",
+	"room.not_found":         "Палата №%d не найдена",
+	"room.list":              "Палаты: %d",
+	"room.none":              "Палат нет",
+	"room.label":             "№%d, этаж %d, %s, занято %d из %d",
+	"room.card":              "Палата №%d (ID %d)",
+	"room.occupied":          "%d из %d",
+	"room.no_patients":       "Пациентов нет",
+	"room.patients":          "Пациенты:",
+	"room.patient":           "%d. %s %s %s, ID %d, опасность %d\n",
+
+	// Заболевания
+	"disease.prompt.name":   "Введите заболевание",
+	"disease.prompt.danger": "Введите степень опасности заболевания (%d-%d)",
+	"disease.prompt.threat": "Введите способ лечения",
+	"disease.prompt.choose": "Выберите заболевание",
+	"disease.add_failed":    "Ошибка добавления заболевания",
+	"disease.added":         "Заболевание добавлено",
+	"disease.label":         "%s, опасность %d",
+	"diagnosis.failed":      "Ошибка постановки диагноза",
+	"diagnosis.done":        "Диагноз поставлен: %s %s",
+
+	// Удаление
+	"delete.patient_summary": "Будет удален пациент ID %d \nФамилия: %s \nИмя: %s \nОтчество: %s \nПалата: %d",
+	"delete.patient_done":    "Пациент удален",
+	"delete.room_summary":    "Будет удалена палата ID %d \nНомер: %d \nЭтаж: %d \nПациентов: %d",
+	"delete.room_done":       "Палата удалена",
+	"delete.failed":          "Ошибка удаления",
+
+	// Клиники и отделения
+	"organization.prompt.name":   "Введите название клиники",
+	"organization.prompt.choose": "Выберите клинику",
+	"organization.admins_only":   "Клиники заводят только администраторы, не привязанные к клинике",
+	"organization.add_failed":    "Ошибка добавления клиники",
+	"organization.added":         "Клиника добавлена",
+	"organization.none":          "Клиник нет",
+	"organization.card":          "ID %d \nНазвание: %s \n",
+
+	"department.prompt.name":        "Введите название отделения",
+	"department.prompt.choose":      "Выберите отделение",
+	"department.admins_only":        "Отделения заводят только администраторы своей клиники",
+	"department.add_failed":         "Ошибка добавления отделения",
+	"department.added":              "Отделение добавлено",
+	"department.assign_admins_only": "Назначать отделения могут только администраторы",
+	"department.assign_failed":      "Ошибка назначения отделения",
+	"department.assigned":           "Врач добавлен в отделение %s",
+	"department.none":               "Отделений нет",
+	"department.not_member":         "Вы не работаете в этом отделении",
+	"department.switch_failed":      "Ошибка смены отделения",
+	"department.current":            "Текущее отделение: %s",
+	"department.card":               "ID %d \nНазвание: %s \nКлиника: %d \n",
+
+	// Журналы
+	"audit.prompt.entity":  "Введите тип записи: пациент, палата, врач или заболевание",
+	"audit.prompt.id":      "Введите ID записи",
+	"audit.unknown_entity": "Неизвестный тип записи",
+	"audit.invalid_id":     "Неверно указан ID записи",
+	"audit.admins_only":    "История изменений доступна только администраторам",
+	"audit.none":           "Изменений не найдено",
+	"audit.action.create":  "создание",
+	"audit.action.update":  "изменение",
+	"audit.action.delete":  "удаление",
+
+	"access.admins_only":           "Журнал просмотров доступен только администраторам",
+	"access.none":                  "Данные пациента никто не просматривал",
+	"access.record":                "%s, %s, %s, цель: %s",
+	"access.no_anomalies":          "Подозрительных просмотров не найдено",
+	"access.anomaly":               "Врач ID %d: открыл %s, из них не лечит %d",
+	"access.opened_patients#one":   "%d пациента",
+	"access.opened_patients#few":   "%d пациентов",
+	"access.opened_patients#many":  "%d пациентов",
+	"access.opened_patients#other": "%d пациентов",
+	"access.action.get":            "карточка",
+	"access.action.list":           "список",
+	"access.action.search":         "поиск",
+
+	// Показатели жизнедеятельности
+	"vitals.prompt.pulse":       "Введите пульс, уд/мин (%d-%d)",
+	"vitals.prompt.systolic":    "Введите верхнее давление, мм рт. ст. (%d-%d)",
+	"vitals.prompt.diastolic":   "Введите нижнее давление, мм рт. ст. (%d-%d)",
+	"vitals.prompt.temperature": "Введите температуру, °C (%g-%g)",
+	"vitals.prompt.saturation":  "Введите сатурацию, %% (%d-%d)",
+	"vitals.failed":             "Не удалось сохранить показатели",
+	"vitals.recorded":           "Показатели сохранены",
+	"vitals.recorded_abnormal":  "Показатели сохранены. Вне нормы: %s, лечащие врачи уведомлены",
+	"vital.pulse":               "пульс",
+	"vital.systolic":            "верхнее давление",
+	"vital.diastolic":           "нижнее давление",
+	"vital.temperature":         "температура",
+	"vital.saturation":          "сатурация",
+
+	// Уведомления врачам
+	"alert.severity.info":           "Информация",
+	"alert.severity.warning":        "Внимание",
+	"alert.severity.critical":       "КРИТИЧНО",
+	"alert.danger_raised":           "Пациент %s (ID %d): степень опасности выросла с %s до %s",
+	"alert.vitals_abnormal":         "Пациент %s (ID %d): показатели вне нормы - %s\nПульс %s, давление %s/%s, температура %s, сатурация %s",
+	"alert.patient_assigned":        "За вами закреплен пациент %s (ID %d)",
+	"alert.kind.danger_raised":      "рост степени опасности",
+	"alert.kind.vitals_abnormal":    "показатели вне нормы",
+	"alert.kind.patient_assigned":   "новые пациенты",
+	"alert.min.info":                "Все уведомления",
+	"alert.min.warning":             "Только важные и критические",
+	"alert.min.critical":            "Только критические",
+	"alert.on":                      "Присылать",
+	"alert.off":                     "Не присылать",
+	"alert.prompt.min_severity":     "Какие уведомления присылать? Критические приходят всегда",
+	"alert.prompt.quiet_hours":      "Введите время тишины, например 22:00-07:00. В это время приходят только критические уведомления",
+	"alert.prompt.danger_raised":    "Уведомлять о росте степени опасности пациентов?",
+	"alert.prompt.vitals_abnormal":  "Уведомлять о показателях вне нормы?",
+	"alert.prompt.patient_assigned": "Уведомлять о закреплении за вами пациентов?",
+	"alert.settings_failed":         "Не удалось сохранить настройки уведомлений",
+	"alert.settings_saved":          "Настройки уведомлений сохранены",
+	"alert.preference":              "Присылать: %s\nВремя тишины: %s\nОтключены: %s",
+	"alert.quiet_none":              "нет",
+	"alert.muted_none":              "ничего",
+	"alert.ack":                     "Принято",
+	"alert.acknowledged":            "Получение уведомления подтверждено",
+	"alert.escalated":               "Врач ID %s не подтвердил уведомление вовремя",
+	"alert.unassigned":              "У пациента нет лечащего врача",
+	"alert.minutes#one":             "%d минуту",
+	"alert.minutes#few":             "%d минуты",
+	"alert.minutes#many":            "%d минут",
+	"alert.minutes#other":           "%d минуты",
+	"alert.report.admins_only":      "Отчет об уведомлениях доступен только администраторам",
+	"alert.report.none":             "Все критические уведомления подтверждены вовремя",
+	"alert.report.unacknowledged":   "Не подтверждены:\n",
+	"alert.report.late":             "Подтверждены позже, чем через %s:\n",
+	"alert.report.line":             "#%d %s, %s, пациент ID %d, %s\n",
+	"alert.report.late_line":        "#%d %s, %s, пациент ID %d, %s, подтверждено через %s\n",
+	"alert.report.doctor":           "врач ID %d",
+	"alert.report.unassigned":       "нет лечащего врача",
+
+	// Выгрузка в файл
+	"export.kind.patients":  "Пациенты",
+	"export.kind.rooms":     "Палаты",
+	"export.kind.doctors":   "Врачи",
+	"export.invalid_filter": "Непонятное условие выгрузки: %s",
+	"export.rows#one":       "Выгружена %d строка",
+	"export.rows#few":       "Выгружено %d строки",
+	"export.rows#many":      "Выгружено %d строк",
+	"export.rows#other":     "Выгружено %d строк",
+
+	// Групповые чаты бригад
+	"group.added":            "Здравствуйте! Администратор может привязать этот чат к отделению или палатам командой /bind_group department=<id> или /bind_group rooms=101,102, и сюда будут приходить уведомления о пациентах.",
+	"group.only":             "Команда работает только в групповом чате бригады",
+	"group.invalid_argument": "Непонятное условие привязки: %s. Укажите department=<id> или rooms=101,102",
+	"group.bound":            "Чат привязан: %s. Сюда будут приходить уведомления о пациентах",
+	"group.unbound":          "Чат отвязан, уведомления сюда больше не приходят",
+	"group.not_bound":        "Чат не привязан к отделению или палатам",
+	"group.info":             "Уведомления о пациентах: %s",
+	"group.scope.department": "отделение ID %d",
+	"group.scope.rooms":      "палаты %s",
+}
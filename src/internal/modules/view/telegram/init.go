@@ -4,319 +4,784 @@ import (
 	"context"
 	"fmt"
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"go.uber.org/fx"
 	"go.uber.org/zap"
+	"hospital/internal/models/access"
+	"hospital/internal/models/errors"
+	"hospital/internal/models/role"
+	"hospital/internal/models/session"
 	"hospital/internal/modules/config"
+	"hospital/internal/modules/db"
 	auth_dto "hospital/internal/modules/domain/auth/dto"
 	disease_dto "hospital/internal/modules/domain/disease/dto"
 	patient_dto "hospital/internal/modules/domain/patient/dto"
 	room_dto "hospital/internal/modules/domain/room/dto"
+	"hospital/internal/modules/view/telegram/callback"
+	"hospital/internal/modules/view/telegram/command"
+	"hospital/internal/modules/view/telegram/confirm"
 	"hospital/internal/modules/view/telegram/controllers"
+	"hospital/internal/modules/view/telegram/dialog"
+	"hospital/internal/modules/view/telegram/dispatch"
+	"hospital/internal/modules/view/telegram/form"
+	"hospital/internal/modules/view/telegram/i18n"
+	"hospital/internal/modules/view/telegram/outbox"
+	"hospital/internal/modules/view/telegram/render"
+	"hospital/internal/modules/view/telegram/webhook"
 	"strconv"
 )
 
-var numericKeyboard = tgbotapi.NewReplyKeyboard(
-	tgbotapi.NewKeyboardButtonRow(
-		tgbotapi.NewKeyboardButton("Зарегестрироваться"),
-		tgbotapi.NewKeyboardButton("Помощь"),
-		tgbotapi.NewKeyboardButton("Просмотреть данные о себе"),
-	),
-	tgbotapi.NewKeyboardButtonRow(
-		tgbotapi.NewKeyboardButton("Удалить пациента"),
-		tgbotapi.NewKeyboardButton("Добавить пациента"),
-		tgbotapi.NewKeyboardButton("Посмотреть своих пациентов"),
-	),
-	tgbotapi.NewKeyboardButtonRow(
-		tgbotapi.NewKeyboardButton("Найти палату по номеру"),
-		tgbotapi.NewKeyboardButton("Вывести все палаты"),
-		tgbotapi.NewKeyboardButton("Добавить палату"),
-	),
-	tgbotapi.NewKeyboardButtonRow(
-		tgbotapi.NewKeyboardButton("Добавить заболевание"),
-	),
+var (
+	// adminRoles управляют палатами, отделениями, клиниками и журналами
+	adminRoles = []string{role.Admin, role.HeadPhysician}
+	// doctorRoles ведут пациентов: принимают, ставят диагнозы, назначают лечащих врачей
+	doctorRoles = []string{role.Doctor, role.Admin, role.HeadPhysician}
 )
 
-func singUp(chatId int64, user *UsersMessage) string {
-	var msg string
-	addNextMessages("Введите свою фамилию", user, chatId)
-	addNextMessages("Введите свою специальность", user, chatId)
-	addNextMessages("Введите свою роль", user, chatId)
-	msg, user.NextMessages = printNewMessage(user.NextMessages)
-	return msg
+// menuButtons - кнопки меню по рядам. Клавиатура строится по регистрации и роли пользователя;
+// тексты кнопок берутся из каталога сообщений на языке пользователя.
+var menuButtons = [][]*command.Button{
+	{
+		{Key: "button.register", Show: command.Guests},
+		{Key: "button.login", Show: command.SignedOut},
+		{Key: "button.help", Show: command.Everyone},
+		{Key: "button.about_me"},
+	},
+	{{Key: "button.logout"}, {Key: "button.logout_all"}},
+	{
+		{Key: "button.my_patients"},
+		{Key: "button.add_patient", Roles: doctorRoles},
+		{Key: "button.delete_patient", Roles: doctorRoles},
+	},
+	{{Key: "button.record_vitals"}, {Key: "button.diagnose", Roles: doctorRoles}, {Key: "button.assign_doctor", Roles: doctorRoles}},
+	{
+		{Key: "button.find_room"},
+		{Key: "button.all_rooms"},
+		{Key: "button.add_room", Roles: adminRoles},
+		{Key: "button.delete_room", Roles: adminRoles},
+	},
+	{{Key: "button.add_disease", Roles: doctorRoles}},
+	{{Key: "button.alert_settings"}, {Key: "button.on_call"}, {Key: "button.alert_report", Roles: adminRoles}},
+	{
+		{Key: "button.departments"},
+		{Key: "button.switch_department"},
+		{Key: "button.add_department", Roles: adminRoles},
+		{Key: "button.assign_department", Roles: adminRoles},
+	},
+	{{Key: "button.organizations", Roles: adminRoles}, {Key: "button.add_organization", Roles: adminRoles}},
+	{
+		{Key: "button.audit_history", Roles: adminRoles},
+		{Key: "button.access_report", Roles: adminRoles},
+		{Key: "button.access_anomalies", Roles: adminRoles},
+	},
 }
 
-func EndSingUp(user *UsersMessage, chatId int64, controller *controllers.Controller) string {
-	var reply string
+// menuKeyboard - клавиатура меню пользователя на языке запроса
+func menuKeyboard(ctx context.Context, commands *command.Registry, v command.Viewer) tgbotapi.ReplyKeyboardMarkup {
+	menu := commands.Menu(v)
+	rows := make([][]tgbotapi.KeyboardButton, len(menu))
+	for i, keys := range menu {
+		for _, key := range keys {
+			rows[i] = append(rows[i], tgbotapi.NewKeyboardButton(i18n.T(ctx, key)))
+		}
+	}
+	return tgbotapi.NewReplyKeyboard(rows...)
+}
 
-	newDoctor := &auth_dto.NewDoctor{
-		TokenId:    strconv.FormatInt(chatId, 10),
-		Surname:    user.UserMessages[0],
-		Speciality: user.UserMessages[1],
-		Role:       user.UserMessages[2],
+// viewerOf - регистрация и роль пользователя по результату Authenticate
+func viewerOf(ctx context.Context, authErr error) command.Viewer {
+	v := command.Viewer{Registered: authErr != errors.ErrNotRegistered}
+	if s, ok := session.GetSessionFromCtx(ctx); ok && authErr == nil {
+		v.Role = s.Role
 	}
-	_, err := controller.SingUp(context.Background(), newDoctor)
+	return v
+}
+
+// publicCommands - команды, доступные без входа в систему
+var publicCommands = map[string]bool{
+	"button.help":     true,
+	"button.register": true,
+	"button.login":    true,
+	"open":            true,
+	"close":           true,
+}
+
+func singUpForm(controller *controllers.Controller) *form.Form[auth_dto.NewDoctor] {
+	return &form.Form[auth_dto.NewDoctor]{
+		Name: "button.register",
+		Fields: []form.Field{
+			{Name: "surname", Prompt: "signup.prompt.surname"},
+			{Name: "speciality", Prompt: "signup.prompt.speciality"},
+			{Name: "role", Prompt: "signup.prompt.role", Choices: signUpRoleChoices},
+		},
+		Submit: func(ctx context.Context, c *dialog.Conversation, doctor *auth_dto.NewDoctor) dialog.Reply {
+			// Врача узнаем по id пользователя Telegram, а не по чату: в групповом чате пишут многие
+			doctor.TokenId = strconv.FormatInt(c.UserId, 10)
+			return dialog.Reply{Text: singUp(ctx, doctor, controller)}
+		},
+	}
+}
+
+func EndSingUp(ctx context.Context, values dialog.Values, userId int64, controller *controllers.Controller) string {
+	doctor, err := singUpForm(controller).Decode(ctx, values)
 	if err != nil {
-		reply = "Уже зарегистрированы"
-		return reply
+		return i18n.T(ctx, "form.failed")
 	}
-	reply = "Зарегистрирован"
+	doctor.TokenId = strconv.FormatInt(userId, 10)
 
-	return reply
+	return singUp(ctx, doctor, controller)
 }
 
-func EndAddPatient(user *UsersMessage, controller *controllers.Controller) string {
-	var reply string
-	height, _ := strconv.Atoi(user.UserMessages[3])
-	weight, _ := strconv.ParseFloat(user.UserMessages[4], 64)
-	roomNumber, _ := strconv.Atoi(user.UserMessages[5])
-	degreeOfDanger, _ := strconv.Atoi(user.UserMessages[6])
-
-	newPatient := &patient_dto.CreatePatient{
-		Surname:        user.UserMessages[0],
-		Name:           user.UserMessages[1],
-		Patronymic:     user.UserMessages[2],
-		Height:         height,
-		Weight:         weight,
-		RoomNumber:     roomNumber,
-		DegreeOfDanger: degreeOfDanger,
+func singUp(ctx context.Context, newDoctor *auth_dto.NewDoctor, controller *controllers.Controller) string {
+	_, err := controller.SingUp(ctx, newDoctor)
+	if err == errors.ErrAccessDenied {
+		return i18n.T(ctx, "signup.role_forbidden")
 	}
-	_, err := controller.AddPatient(context.Background(), newPatient)
 	if err != nil {
-		reply = "Ошбика добавления"
-		return reply
+		return i18n.T(ctx, "signup.exists")
+	}
+	_, err = controller.Login(ctx, newDoctor.TokenId)
+	if err != nil {
+		return i18n.T(ctx, "signup.login_failed")
 	}
-	reply = "Добавлен"
 
-	return reply
+	return i18n.T(ctx, "signup.done")
 }
 
-func EndAddRoom(user *UsersMessage, controller *controllers.Controller) string {
-	var reply string
-
-	num, _ := strconv.Atoi(user.UserMessages[0])
-	floor, _ := strconv.Atoi(user.UserMessages[1])
-	numberBeds, _ := strconv.Atoi(user.UserMessages[2])
+func addPatientForm(controller *controllers.Controller) *form.Form[patient_dto.CreatePatient] {
+	return &form.Form[patient_dto.CreatePatient]{
+		Name: "button.add_patient",
+		Fields: []form.Field{
+			{Name: "surname", Prompt: "patient.prompt.surname"},
+			{Name: "name", Prompt: "patient.prompt.name"},
+			{Name: "patronymic", Prompt: "patient.prompt.patronymic"},
+			{
+				Name:       "height",
+				Prompt:     "patient.prompt.height",
+				PromptArgs: []interface{}{patient_dto.MinHeight, patient_dto.MaxHeight},
+				Kind:       form.Int,
+			},
+			{
+				Name:       "weight",
+				Prompt:     "patient.prompt.weight",
+				PromptArgs: []interface{}{patient_dto.MinWeight, patient_dto.MaxWeight},
+				Kind:       form.Float,
+			},
+			{Name: "roomNumber", Prompt: "patient.prompt.room", Kind: form.Int, Choices: roomChoices(controller, true)},
+			{
+				Name:       "degreeOfDanger",
+				Prompt:     "patient.prompt.danger",
+				PromptArgs: []interface{}{patient_dto.MinDanger, patient_dto.MaxDanger},
+				Kind:       form.Int,
+			},
+		},
+		Submit: func(ctx context.Context, _ *dialog.Conversation, patient *patient_dto.CreatePatient) dialog.Reply {
+			if _, err := controller.AddPatient(ctx, patient); err != nil {
+				return failed(ctx, err, "patient.add_failed")
+			}
+			return dialog.Reply{Text: i18n.T(ctx, "patient.added")}
+		},
+	}
+}
 
-	newRoom := &room_dto.CreateRoom{
-		Num:            num,
-		Floor:          floor,
-		NumberBeds:     numberBeds,
-		TypeRoom:       user.UserMessages[3],
-		NumberPatients: 0,
+func addRoomForm(controller *controllers.Controller) *form.Form[room_dto.CreateRoom] {
+	return &form.Form[room_dto.CreateRoom]{
+		Name: "button.add_room",
+		Fields: []form.Field{
+			{Name: "num", Prompt: "room.prompt.num", Kind: form.Int},
+			{
+				Name:       "floor",
+				Prompt:     "room.prompt.floor",
+				PromptArgs: []interface{}{room_dto.MinFloor, room_dto.MaxFloor},
+				Kind:       form.Int,
+			},
+			{
+				Name:       "numberBeds",
+				Prompt:     "room.prompt.beds",
+				PromptArgs: []interface{}{room_dto.MinBeds, room_dto.MaxBeds},
+				Kind:       form.Int,
+			},
+			{Name: "typeRoom", Prompt: "room.prompt.type"},
+			{
+				Name:     "departmentId",
+				Prompt:   "room.prompt.department",
+				Kind:     form.Int,
+				Optional: true,
+				Choices:  departmentChoices(controller.GetAllDepartments),
+			},
+		},
+		Submit: func(ctx context.Context, _ *dialog.Conversation, room *room_dto.CreateRoom) dialog.Reply {
+			if _, err := controller.AddRoom(ctx, room); err != nil {
+				return failed(ctx, err, "room.add_failed")
+			}
+			return dialog.Reply{Text: i18n.T(ctx, "room.added")}
+		},
 	}
-	_, err := controller.AddRoom(context.Background(), newRoom)
-	if err != nil {
-		reply = "Ошбика добавления комнаты"
-		return reply
+}
+
+func addDiseaseForm(controller *controllers.Controller) *form.Form[disease_dto.CreateDisease] {
+	return &form.Form[disease_dto.CreateDisease]{
+		Name: "button.add_disease",
+		Fields: []form.Field{
+			{Name: "name", Prompt: "disease.prompt.name"},
+			{
+				Name:       "degreeOfDanger",
+				Prompt:     "disease.prompt.danger",
+				PromptArgs: []interface{}{disease_dto.MinDanger, disease_dto.MaxDanger},
+				Kind:       form.Int,
+			},
+			{Name: "threat", Prompt: "disease.prompt.threat"},
+		},
+		Submit: func(ctx context.Context, _ *dialog.Conversation, disease *disease_dto.CreateDisease) dialog.Reply {
+			if _, err := controller.AddDisease(ctx, disease); err != nil {
+				return failed(ctx, err, "disease.add_failed")
+			}
+			return dialog.Reply{Text: i18n.T(ctx, "disease.added")}
+		},
 	}
-	reply = "Комната добалена!"
+}
 
-	return reply
+// diagnosis - ответы формы постановки диагноза
+type diagnosis struct {
+	Query     string
+	PatientId int
+	DiseaseId int
 }
 
-func EndAddDisease(user *UsersMessage, controller *controllers.Controller) string {
-	var reply string
+func diagnoseForm(controller *controllers.Controller) *form.Form[diagnosis] {
+	return &form.Form[diagnosis]{
+		Name: "button.diagnose",
+		Fields: append(patientSearchFields(controller),
+			form.Field{Name: "diseaseId", Prompt: "disease.prompt.choose", Kind: form.Int, Choices: diseaseChoices(controller)},
+		),
+		Submit: func(ctx context.Context, _ *dialog.Conversation, d *diagnosis) dialog.Reply {
+			patient, err := controller.SetPatientDisease(ctx, d.PatientId, d.DiseaseId)
+			if err != nil {
+				return dialog.Reply{Text: i18n.T(ctx, "diagnosis.failed")}
+			}
+			return dialog.Reply{Text: i18n.T(ctx, "diagnosis.done", patient.Surname, patient.Name)}
+		},
+	}
+}
 
-	degreeOfDanger, _ := strconv.Atoi(user.UserMessages[1])
+// vitalsEntry - ответы формы замера показателей
+type vitalsEntry struct {
+	Query       string
+	PatientId   int
+	Pulse       int
+	Systolic    int
+	Diastolic   int
+	Temperature float64
+	Saturation  int
+}
 
-	newDisease := &disease_dto.CreateDisease{
-		Name:           user.UserMessages[0],
-		DegreeOfDanger: degreeOfDanger,
-		Threat:         user.UserMessages[2],
-	}
-	_, err := controller.AddDisease(context.Background(), newDisease)
-	if err != nil {
-		reply = "Ошбика добавления заболевания"
-		return reply
+func (r *vitalsEntry) vitals() *patient_dto.CreateVitals {
+	return &patient_dto.CreateVitals{
+		Pulse:       r.Pulse,
+		Systolic:    r.Systolic,
+		Diastolic:   r.Diastolic,
+		Temperature: r.Temperature,
+		Saturation:  r.Saturation,
 	}
-	reply = "Заболевание добалено! еее"
+}
 
-	return reply
+func (r *vitalsEntry) Validate() error {
+	return r.vitals().Validate()
 }
 
-func handleUsers(
-	Users []UsersMessage, chatId int64,
-	userMessage string,
-	controller *controllers.Controller) (string, []UsersMessage) {
-
-	var msg string
-	for i, u := range Users {
-		if u.ChatId == chatId {
-			Users[i].UserMessages = append(Users[i].UserMessages, userMessage)
-			if len(u.NextMessages) > 0 {
-				msg, Users[i].NextMessages = printNewMessage(u.NextMessages)
-			} else {
-				switch u.Command {
-				case "Зарегестрироваться":
-					msg = EndSingUp(&Users[i], chatId, controller)
-				case "Добавить пациента":
-					msg = EndAddPatient(&Users[i], controller)
-				case "Добавить палату":
-					msg = EndAddRoom(&Users[i], controller)
-				case "Добавить заболевание":
-					msg = EndAddDisease(&Users[i], controller)
+func recordVitalsForm(controller *controllers.Controller) *form.Form[vitalsEntry] {
+	return &form.Form[vitalsEntry]{
+		Name: "button.record_vitals",
+		Fields: append(patientSearchFields(controller),
+			form.Field{
+				Name:       "pulse",
+				Prompt:     "vitals.prompt.pulse",
+				PromptArgs: []interface{}{patient_dto.MinPulse, patient_dto.MaxPulse},
+				Kind:       form.Int,
+			},
+			form.Field{
+				Name:       "systolic",
+				Prompt:     "vitals.prompt.systolic",
+				PromptArgs: []interface{}{patient_dto.MinPressure, patient_dto.MaxPressure},
+				Kind:       form.Int,
+			},
+			form.Field{
+				Name:       "diastolic",
+				Prompt:     "vitals.prompt.diastolic",
+				PromptArgs: []interface{}{patient_dto.MinPressure, patient_dto.MaxPressure},
+				Kind:       form.Int,
+			},
+			form.Field{
+				Name:       "temperature",
+				Prompt:     "vitals.prompt.temperature",
+				PromptArgs: []interface{}{patient_dto.MinTemperature, patient_dto.MaxTemperature},
+				Kind:       form.Float,
+			},
+			form.Field{
+				Name:       "saturation",
+				Prompt:     "vitals.prompt.saturation",
+				PromptArgs: []interface{}{patient_dto.MinSaturation, patient_dto.MaxSaturation},
+				Kind:       form.Int,
+			},
+		),
+		Submit: func(ctx context.Context, _ *dialog.Conversation, v *vitalsEntry) dialog.Reply {
+			vitals, err := controller.RecordVitals(ctx, v.PatientId, v.vitals())
+			if err != nil {
+				return failed(ctx, err, "vitals.failed")
+			}
+			if abnormal := vitals.Abnormal(); len(abnormal) > 0 {
+				return dialog.Reply{Text: i18n.T(ctx, "vitals.recorded_abnormal", vitalNames(ctx, abnormal))}
+			}
+			return dialog.Reply{Text: i18n.T(ctx, "vitals.recorded")}
+		},
+	}
+}
 
-				}
-				Users = Users[:i+copy(Users[i:], Users[i+1:])]
+// doctorAssignment - ответы формы назначения лечащего врача
+type doctorAssignment struct {
+	Query     string
+	PatientId int
+	DoctorId  int
+}
 
+func assignDoctorForm(controller *controllers.Controller) *form.Form[doctorAssignment] {
+	return &form.Form[doctorAssignment]{
+		Name: "button.assign_doctor",
+		Fields: append(patientSearchFields(controller),
+			form.Field{Name: "doctorId", Prompt: "doctor.prompt.id", Kind: form.Int},
+		),
+		Submit: func(ctx context.Context, _ *dialog.Conversation, a *doctorAssignment) dialog.Reply {
+			patient, err := controller.AssignPatientDoctor(ctx, a.PatientId, a.DoctorId)
+			if err != nil {
+				return failed(ctx, err, "patient.assign_failed")
 			}
-		}
+			return dialog.Reply{Text: i18n.T(ctx, "patient.assigned", patient.Surname, patient.Name, a.DoctorId)}
+		},
 	}
-	return msg, Users
 }
 
-func GetInfoAboutDoctor(id int64, controller *controllers.Controller) string {
-	token := strconv.FormatInt(id, 10)
-	doctor, err := controller.DoctorToken(context.Background(), token)
+func login(ctx context.Context, userId int64, controller *controllers.Controller) string {
+	_, err := controller.Login(ctx, strconv.FormatInt(userId, 10))
 	if err != nil {
-		msg := "Вы не зарегистрированы"
-		return msg
+		return i18n.T(ctx, "auth.not_registered")
 	}
-	msg := fmt.Sprintf("Фамилия: %s \nСпециальность: %s \nРоль: %s \n",
-		doctor.Surname, doctor.Speciality, doctor.Role)
-	return msg
+	return i18n.T(ctx, "auth.logged_in")
 }
 
-func addPatient(chatId int64, user *UsersMessage) string {
-	var msg string
-	addNextMessages("Введите фамилию пациента", user, chatId)
-	addNextMessages("Введите имя пациента", user, chatId)
-	addNextMessages("Введите отчество пациента", user, chatId)
-	addNextMessages("Введите Рост пациента", user, chatId)
-	addNextMessages("Введите Вес пациента", user, chatId)
-	addNextMessages("Введите номер комнаты пациента", user, chatId)
-	addNextMessages("Введите степень опасности пациента", user, chatId)
-	msg, user.NextMessages = printNewMessage(user.NextMessages)
-	return msg
+func logout(ctx context.Context, controller *controllers.Controller) string {
+	err := controller.Logout(ctx)
+	if err != nil {
+		return i18n.T(ctx, "auth.logout_failed")
+	}
+	return i18n.T(ctx, "auth.logged_out")
 }
 
-func addRoom(chatId int64, user *UsersMessage) string {
-	var msg string
-	addNextMessages("Введите Номер палаты", user, chatId)
-	addNextMessages("Введите Этаж палаты", user, chatId)
-	addNextMessages("Введите Количетво кроватей палаты", user, chatId)
-	addNextMessages("Введите Тип палаты", user, chatId)
-	msg, user.NextMessages = printNewMessage(user.NextMessages)
-	return msg
+func logoutAll(ctx context.Context, controller *controllers.Controller) string {
+	n, err := controller.LogoutAll(ctx)
+	if err != nil {
+		return i18n.T(ctx, "auth.logout_failed")
+	}
+	return i18n.N(ctx, "auth.sessions_closed", n)
 }
 
-func addDisease(chatId int64, user *UsersMessage) string {
-	var msg string
-	addNextMessages("Введите заболевание", user, chatId)
-	addNextMessages("Введите степень опасности заболевания", user, chatId)
-	addNextMessages("Введите способ лечения", user, chatId)
-	msg, user.NextMessages = printNewMessage(user.NextMessages)
+func GetInfoAboutDoctor(ctx context.Context, id int64, controller *controllers.Controller) string {
+	token := strconv.FormatInt(id, 10)
+	doctor, err := controller.DoctorToken(ctx, token)
+	if err != nil {
+		msg := i18n.T(ctx, "auth.not_registered")
+		return msg
+	}
+	msg := i18n.T(ctx, "doctor.card",
+		doctor.Surname, doctor.Speciality, roleName(ctx, doctor.Role))
 	return msg
 }
 
-func getInfoAboutPatients(id int64, controller *controllers.Controller) string {
-	var msg string = ""
-	patients, err := controller.GetAllPatients(context.Background())
+// getInfoAboutPatients - таблица пациентов в HTML
+func getInfoAboutPatients(ctx context.Context, id int64, controller *controllers.Controller) string {
+	patients, err := controller.GetAllPatients(ctx)
 	if err != nil {
-		msg := "Ошбика запроса"
-		return msg
+		return render.Escape(i18n.T(ctx, "error.request"))
+	}
+	if len(patients) == 0 {
+		return render.Escape(i18n.T(ctx, "patient.none"))
 	}
 
-	for i := range patients {
-		msg += fmt.Sprintf("ID %d \nФамилия: %s \nИмя: %s \nОтчество: %s \n",
-			patients[i].Id, patients[i].Surname, patients[i].Name, patients[i].Patronymic)
+	rows := make([][]string, len(patients))
+	for i, p := range patients {
+		rows[i] = []string{strconv.Itoa(p.Id), p.Surname, p.Name, p.Patronymic}
 	}
-	return msg
+	return render.Bold(i18n.T(ctx, "patient.list", len(patients))) + "\n" + render.Table(
+		[]string{i18n.T(ctx, "field.id"), i18n.T(ctx, "field.surname"), i18n.T(ctx, "field.name"), i18n.T(ctx, "field.patronymic")},
+		rows)
 }
 
-func printAllRooms(id int64, controller *controllers.Controller) string {
-	var msg string = ""
-	rooms, err := controller.GetAllRooms(context.Background())
+// printAllRooms - таблица палат в HTML
+func printAllRooms(ctx context.Context, id int64, controller *controllers.Controller) string {
+	rooms, err := controller.GetAllRooms(ctx)
 	if err != nil {
-		msg := "Ошбика запроса"
-		return msg
+		return render.Escape(i18n.T(ctx, "error.request"))
+	}
+	if len(rooms) == 0 {
+		return render.Escape(i18n.T(ctx, "room.none"))
 	}
 
-	for i := range rooms {
-		msg += fmt.Sprintf("ID %d \nНомер: %d \nЭтаж: %d \nТип: %s \n",
-			rooms[i].Id, rooms[i].Num, rooms[i].Floor, rooms[i].TypeRoom)
+	rows := make([][]string, len(rooms))
+	for i, r := range rooms {
+		rows[i] = []string{strconv.Itoa(r.Id), strconv.Itoa(r.Num), strconv.Itoa(r.Floor), r.TypeRoom}
 	}
-	return msg
+	return render.Bold(i18n.T(ctx, "room.list", len(rooms))) + "\n" + render.Table(
+		[]string{i18n.T(ctx, "field.id"), i18n.T(ctx, "field.room_num"), i18n.T(ctx, "field.floor"), i18n.T(ctx, "field.room_type")},
+		rows)
 }
 
 func handleBot(
 	controller *controllers.Controller,
+	router *callback.Router,
+	dialogs *dialog.Engine,
+	commands *command.Registry,
+	menu *commandMenu,
+	dispatcher *dispatch.Dispatcher,
 	updates tgbotapi.UpdatesChannel,
-	bot *tgbotapi.BotAPI,
+	bot Transport,
 	logger *zap.Logger) {
 
-	var Users []UsersMessage
-
-	for update := range updates {
-		if update.Message != nil {
+	dispatcher.Run(updates, func(ctx context.Context, update tgbotapi.Update) {
+		if update.MyChatMember != nil {
+			handleChatMember(ctx, controller, update.MyChatMember, bot, logger)
+		} else if update.Message != nil && update.Message.From != nil {
 
 			ChatId := update.Message.Chat.ID
+			// Врач определяется по отправителю: в групповом чате бригады пишут разные пользователи
+			UserId := update.Message.From.ID
+			group := !update.Message.Chat.IsPrivate()
+			// В групповом чате бот отвечает только на команды, остальная переписка его не касается
+			if group && !commands.Has(update.Message.Text) {
+				return
+			}
 			msg := tgbotapi.NewMessage(ChatId, update.Message.Text)
 
 			logger.Info(msg.Text)
 
-			if len(Users) > 0 {
-				msg.Text, Users = handleUsers(Users, ChatId, update.Message.Text, controller)
+			// Сессия врача передается в контексте во все вызовы сервисов
+			ctx, authErr := controller.Authenticate(ctx, strconv.FormatInt(UserId, 10))
+			ctx = withUserLang(ctx, update.Message.From)
+			// Данные пациентов в боте просматриваются лечащими врачами; выгрузки и отчеты
+			// меняют цель на администрирование, см. administration
+			ctx = access.SetPurposeToCtx(ctx, access.PurposeTreatment)
+
+			viewer := viewerOf(ctx, authErr)
+			role := viewer.Role
+			if !group {
+				menu.publish(ChatId, role, i18n.LangOf(ctx))
+			}
+
+			// Кнопки меню распознаются на любом языке и дальше обрабатываются по ключу
+			action := update.Message.Text
+			if key, ok := i18n.Match("button.", action); ok {
+				action = key
+			}
+
+			// Слэш-команды выполняются и во время диалога, не прерывая его
+			commandReply, isCommand := commands.Dispatch(ctx, update.Message.Text, command.Call{
+				ChatId:     ChatId,
+				UserId:     UserId,
+				Role:       role,
+				Registered: viewer.Registered,
+				Group:      group,
+				Title:      update.Message.Chat.Title,
+			})
+
+			// Диалог ведется отдельно в каждом чате и не мешает остальным пользователям
+			var reply dialog.Reply
+			var document *dialog.Document
+			handled := isCommand
+			if isCommand {
+				msg.Text = commandReply.Text
+				msg.ParseMode = commandReply.ParseMode
+				document = commandReply.Document
+				if commandReply.Markup != nil {
+					msg.ReplyMarkup = commandReply.Markup
+				}
+			} else if reply, handled = dialogs.Handle(ctx, ChatId, update.Message.Text); handled {
+				msg.Text = reply.Text
+				msg.ParseMode = reply.ParseMode
+				if reply.Markup != nil {
+					msg.ReplyMarkup = reply.Markup
+				}
+			} else if authErr != nil && !publicCommands[action] {
+				msg.Text = i18n.T(ctx, "bot.login_required")
+			} else if dialogs.Has(action) {
+				started := dialogs.Start(ctx, action, ChatId, UserId)
+				msg.Text = started.Text
+				msg.ParseMode = started.ParseMode
+				if started.Markup != nil {
+					msg.ReplyMarkup = started.Markup
+				}
 			} else {
-				switch update.Message.Text {
-				case "Помощь":
-					msg.Text = "Напишите: Зарегестрироваться или open"
-				case "Зарегестрироваться":
-					Users = append(Users, UsersMessage{ChatId: ChatId, Command: update.Message.Text})
-					msg.Text = singUp(ChatId, &Users[len(Users)-1])
-				case "Просмотреть данные о себе":
-					msg.Text = GetInfoAboutDoctor(ChatId, controller)
-				case "Добавить пациента":
-					Users = append(Users, UsersMessage{ChatId: ChatId, Command: update.Message.Text})
-					msg.Text = addPatient(ChatId, &Users[len(Users)-1])
-				case "Посмотреть своих пациентов":
-					msg.Text = getInfoAboutPatients(ChatId, controller)
-				case "Вывести все палаты":
-					msg.Text = printAllRooms(ChatId, controller)
-				case "Добавить палату":
-					Users = append(Users, UsersMessage{ChatId: ChatId, Command: update.Message.Text})
-					msg.Text = addRoom(ChatId, &Users[len(Users)-1])
-				case "Добавить заболевание":
-					Users = append(Users, UsersMessage{ChatId: ChatId, Command: update.Message.Text})
-					msg.Text = addDisease(ChatId, &Users[len(Users)-1])
+				switch action {
+				case "button.help":
+					msg.Text = helpText(ctx, commands, role)
+				case "button.login":
+					msg.Text = login(ctx, UserId, controller)
+				case "button.logout":
+					msg.Text = logout(ctx, controller)
+				case "button.logout_all":
+					msg.Text = logoutAll(ctx, controller)
+				case "button.about_me":
+					msg.Text = GetInfoAboutDoctor(ctx, UserId, controller)
+				case "button.my_patients":
+					msg.Text = getInfoAboutPatients(ctx, ChatId, controller)
+					msg.ParseMode = tgbotapi.ModeHTML
+				case "button.all_rooms":
+					msg.Text = printAllRooms(ctx, ChatId, controller)
+					msg.ParseMode = tgbotapi.ModeHTML
+				case "button.access_anomalies":
+					msg.Text = printAccessAnomalies(ctx, controller)
+				case "button.departments":
+					msg.Text = printDepartments(ctx, controller)
+				case "button.organizations":
+					msg.Text = printOrganizations(ctx, controller)
+				case "button.on_call":
+					msg.Text = toggleOnCall(ctx, controller)
+				case "button.alert_report":
+					msg.Text = printAlertReport(ctx, controller)
 				case "open":
-					msg.ReplyMarkup = numericKeyboard
+					msg.ReplyMarkup = menu.keyboard(ctx, ChatId, viewer)
 				case "close":
+					menu.close(ChatId)
 					msg.ReplyMarkup = tgbotapi.NewRemoveKeyboard(true)
 				default:
-					msg.Text = "Команда не найдена, напишите Помощь"
+					msg.Text = i18n.T(ctx, "bot.unknown_command")
 					logger.Warn("Неверная пользовательская комманда")
 				}
 			}
+			if !handled && reply.Text != "" {
+				prefix := reply.Text
+				if msg.ParseMode == tgbotapi.ModeHTML {
+					prefix = render.Escape(prefix)
+				}
+				msg.Text = prefix + "\n" + msg.Text
+			}
 
-			if _, err := bot.Send(msg); err != nil {
-				logger.Error("Ошибка запроса")
-				panic(err)
+			if !group {
+				// Вход, выход и регистрация меняют клавиатуру: после них пользователь проверяется заново.
+				// Клавиатура обновляется и когда роль врача поменяли между сообщениями.
+				before := viewer
+				if authErr != nil && (handled || publicCommands[action]) || action == "button.logout" || action == "button.logout_all" {
+					var err error
+					// Язык после входа - язык, выбранный врачом
+					ctx, err = controller.Authenticate(ctx, strconv.FormatInt(UserId, 10))
+					viewer = viewerOf(ctx, err)
+					menu.publish(ChatId, viewer.Role, i18n.LangOf(ctx))
+				}
+				if keyboard, ok := menu.refresh(ctx, ChatId, viewer, viewer != before); ok && msg.ReplyMarkup == nil {
+					msg.ReplyMarkup = keyboard
+				}
+			} else {
+				// Клавиатура меню в группе появилась бы у всех участников; ответ адресуем отправителю
+				if _, ok := msg.ReplyMarkup.(tgbotapi.ReplyKeyboardMarkup); ok {
+					msg.ReplyMarkup = nil
+				}
+				msg.ReplyToMessageID = update.Message.MessageID
+			}
+
+			var err error
+			if document != nil {
+				err = sendDocument(bot, msg, document)
+			} else {
+				err = sendText(bot, msg)
+			}
+			if err != nil {
+				logger.Error("Ошибка запроса", zap.Int64("chat", ChatId), zap.Error(err))
 			}
 		} else if update.CallbackQuery != nil {
-			callback := tgbotapi.NewCallback(update.CallbackQuery.ID, update.CallbackQuery.Data)
-			if _, err := bot.Request(callback); err != nil {
-				panic(err)
+			query := update.CallbackQuery
+			ChatId := query.Message.Chat.ID
+
+			// Вход проверяют обработчики кнопок: без входа контекст остается без сессии
+			ctx, _ = controller.Authenticate(ctx, strconv.FormatInt(query.From.ID, 10))
+			ctx = withUserLang(ctx, query.From)
+			ctx = access.SetPurposeToCtx(ctx, access.PurposeTreatment)
+
+			var reply callback.Reply
+			switch {
+			case router.Owns(query.Data):
+				reply = handleCallback(ctx, router, query)
+			default:
+				reply.Text = i18n.T(ctx, "callback.stale")
+			}
+
+			// Кнопки подтверждения и выбора одноразовые: убираем их или заменяем новой страницей
+			keyboard := tgbotapi.InlineKeyboardMarkup{InlineKeyboard: [][]tgbotapi.InlineKeyboardButton{}}
+			if reply.Keyboard != nil {
+				keyboard = *reply.Keyboard
+			}
+			edit := tgbotapi.NewEditMessageReplyMarkup(ChatId, query.Message.MessageID, keyboard)
+			if _, err := bot.Request(edit); err != nil {
+				logger.Warn("Не удалось обновить кнопки сообщения", zap.Error(err))
 			}
-			msg := tgbotapi.NewMessage(update.CallbackQuery.Message.Chat.ID, update.CallbackQuery.Data)
-			if _, err := bot.Send(msg); err != nil {
-				panic(err)
+
+			if _, err := bot.Request(tgbotapi.NewCallback(query.ID, "")); err != nil {
+				logger.Warn("Не удалось ответить на нажатие кнопки", zap.Error(err))
+			}
+			if reply.Text != "" {
+				msg := tgbotapi.NewMessage(ChatId, reply.Text)
+				msg.ParseMode = reply.ParseMode
+				if reply.Markup != nil {
+					msg.ReplyMarkup = reply.Markup
+				}
+				if err := sendText(bot, msg); err != nil {
+					logger.Error("Ошибка запроса", zap.Int64("chat", ChatId), zap.Error(err))
+				}
 			}
 		}
-	}
+	})
 }
 
-func startBot(controller *controllers.Controller, cfg config.Config, logger *zap.Logger) {
-	dotenv := cfg.TelegramToken
+// handleCallback передает нажатие кнопки маршруту и переводит ошибки в ответ пользователю
+func handleCallback(ctx context.Context, router *callback.Router, query *tgbotapi.CallbackQuery) callback.Reply {
+	reply, err := router.Handle(ctx, callback.Query{
+		ChatId:    query.Message.Chat.ID,
+		UserId:    query.From.ID,
+		MessageId: query.Message.MessageID,
+	}, query.Data)
+	switch err {
+	case nil:
+		return reply
+	case errors.ErrCallbackForeign, errors.ErrConfirmExpired:
+		return callback.Reply{Text: i18n.Error(ctx, err)}
+	case errors.ErrInvalidToken:
+		return callback.Reply{Text: i18n.T(ctx, "callback.invalid")}
+	default:
+		return callback.Reply{Text: i18n.T(ctx, "callback.failed")}
+	}
+}
 
-	bot, err := tgbotapi.NewBotAPI(dotenv)
-	if err != nil {
-		panic(err)
+// withUserLang выбирает язык по настройкам Telegram, если врач не выбрал язык сам
+func withUserLang(ctx context.Context, from *tgbotapi.User) context.Context {
+	if _, ok := i18n.FromCtx(ctx); ok || from == nil {
+		return ctx
 	}
+	if lang, ok := i18n.Parse(from.LanguageCode); ok {
+		return i18n.WithLang(ctx, lang)
+	}
+	return ctx
+}
 
-	bot.Debug = true
-	updateConfig := tgbotapi.NewUpdate(0)
-	updateConfig.Timeout = 30
-	updates := bot.GetUpdatesChan(updateConfig)
+func startBot(
+	controller *controllers.Controller,
+	confirmer *confirm.Confirmer,
+	router *callback.Router,
+	dialogs *dialog.Engine,
+	commands *command.Registry,
+	dispatcher *dispatch.Dispatcher,
+	bot *tgbotapi.BotAPI,
+	out *outbox.Outbox,
+	cfg config.Config,
+	logger *zap.Logger,
+	shutdowner fx.Shutdowner,
+	lifecycle fx.Lifecycle) {
+	registerDeleteActions(confirmer, controller)
+	registerDialogs(dialogs, controller, confirmer)
+	dialogs.UseLanguage(func(ctx context.Context, userId int64) context.Context {
+		return controller.WithLanguage(ctx, strconv.FormatInt(userId, 10))
+	})
+	registerPicker(router, dialogs)
+	menu := newCommandMenu(out, commands, logger)
+	commands.RegisterMenu(menuButtons...)
+	registerCommands(commands, controller, dialogs, menu)
+
+	var stop func(context.Context) error
+	// handled закрывается, когда обработаны все принятые обновления
+	handled := make(chan struct{})
+	lifecycle.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			updates, stopReceiving, err := receiveUpdates(bot, cfg, shutdowner, logger)
+			if err != nil {
+				return err
+			}
+			stop = stopReceiving
+
+			// Меню команд публикуется в фоне: запросы к Telegram не задерживают обработку сообщений
+			go menu.publishAll(controller)
 
-	handleBot(controller, updates, bot, logger)
+			go func() {
+				defer close(handled)
+
+				// Диалоги, прерванные перезапуском, хранятся в базе: предлагаем их продолжить
+				notices, err := dialogs.Suspend(db.SystemContext(context.Background()))
+				if err != nil {
+					logger.Error("failed to restore dialogs", zap.Error(err))
+				}
+				for _, n := range notices {
+					if err = sendText(out, tgbotapi.NewMessage(n.ChatId, n.Text)); err != nil {
+						logger.Error("failed to send resume notice", zap.Error(err))
+					}
+				}
+
+				handleBot(controller, router, dialogs, commands, menu, dispatcher, updates, out, logger)
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			if stop != nil {
+				if err := stop(ctx); err != nil {
+					return err
+				}
+				// Обновления, которые уже приняты, обрабатываются до остановки: Telegram
+				// их больше не пришлет
+				select {
+				case <-handled:
+				case <-ctx.Done():
+					logger.Warn("stopped before all received updates were handled")
+				}
+			}
+			// Ответы, уже поставленные в очередь, отправляются до остановки
+			return out.Close(ctx)
+		},
+	})
+}
+
+// receiveUpdates выбирает способ получения обновлений по TELEGRAM_MODE
+// и возвращает функцию, прекращающую прием обновлений
+func receiveUpdates(
+	bot *tgbotapi.BotAPI,
+	cfg config.Config,
+	shutdowner fx.Shutdowner,
+	logger *zap.Logger) (tgbotapi.UpdatesChannel, func(context.Context) error, error) {
+	switch cfg.TelegramMode {
+	case "webhook":
+		server, err := webhook.New(cfg, logger)
+		if err != nil {
+			return nil, nil, err
+		}
+		go func() {
+			if err := server.Listen(); err != nil {
+				// Без сервера обновления не приходят: приложение останавливается штатно,
+				// чтобы планировщик и очередь ответов успели завершиться
+				logger.Error("webhook server stopped", zap.Error(err))
+				if err = shutdowner.Shutdown(fx.ExitCode(1)); err != nil {
+					logger.Error("failed to shut down", zap.Error(err))
+				}
+			}
+		}()
+		if err = server.Register(bot); err != nil {
+			return nil, nil, err
+		}
+		logger.Info("receiving updates via webhook", zap.String("listen", cfg.WebhookListen))
+		return server.Updates(), server.Shutdown, nil
+	case "polling", "":
+		// Пока установлен вебхук, getUpdates не работает
+		if err := webhook.DeleteWebhook(bot); err != nil {
+			return nil, nil, err
+		}
+		updateConfig := tgbotapi.NewUpdate(0)
+		updateConfig.Timeout = 30
+		stop := func(context.Context) error {
+			bot.StopReceivingUpdates()
+			return nil
+		}
+		return bot.GetUpdatesChan(updateConfig), stop, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown TELEGRAM_MODE %q, expected polling or webhook", cfg.TelegramMode)
+	}
 }
@@ -2,10 +2,38 @@ package telegram
 
 import (
 	"go.uber.org/fx"
+	"hospital/internal/modules/domain/conversation/repo"
+	"hospital/internal/modules/scheduler"
+	"hospital/internal/modules/view/telegram/callback"
+	"hospital/internal/modules/view/telegram/command"
+	"hospital/internal/modules/view/telegram/confirm"
 	"hospital/internal/modules/view/telegram/controllers"
+	"hospital/internal/modules/view/telegram/dialog"
+	"hospital/internal/modules/view/telegram/dispatch"
 )
 
 var (
-	Module     = fx.Provide(controllers.NewController)
+	Module = fx.Provide(
+		controllers.NewController,
+		NewBotAPI,
+		NewOutbox,
+		confirm.NewConfirmer,
+		confirm.NewTokenStore,
+		callback.NewRouter,
+		command.NewRegistry,
+		dialog.NewEngine,
+		dispatch.NewDispatcher,
+		registerAlertActions,
+		scheduler.AsJob(NewEscalateJob),
+		scheduler.AsJob(NewDeliverJob),
+		fx.Annotate(
+			func(r *confirm.TokenStore) *confirm.TokenStore { return r },
+			fx.As(new(confirm.ITokenStore)),
+		),
+		fx.Annotate(
+			func(r *repo.ConversationRepo) *repo.ConversationRepo { return r },
+			fx.As(new(dialog.Store)),
+		),
+	)
 	Invokables = fx.Invoke(startBot)
 )
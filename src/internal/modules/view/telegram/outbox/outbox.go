@@ -0,0 +1,383 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+	"hospital/internal/modules/config"
+	"net/http"
+	"sync"
+	"time"
+)
+
+var (
+	queueDepth = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "bot_send_queue_depth",
+		Help: "Исходящие запросы к Bot API, ожидающие отправки",
+	})
+	sent = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bot_send_total",
+		Help: "Исходящие запросы по итогу: ok - отправлен, dead - отброшен после ошибок",
+	}, []string{"result"})
+	failures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bot_send_failures_total",
+		Help: "Неудачные попытки отправки по причине: rate_limited, transient, permanent",
+	}, []string{"reason"})
+	delaySeconds = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "bot_send_delay_seconds",
+		Help:    "Время от постановки запроса в очередь до успешной отправки",
+		Buckets: prometheus.DefBuckets,
+	})
+)
+
+const (
+	reasonRateLimited = "rate_limited"
+	reasonTransient   = "transient"
+	reasonPermanent   = "permanent"
+)
+
+// maxBackoff - наибольшая пауза между повторами после временной ошибки
+const maxBackoff = time.Minute
+
+var ErrClosed = errors.New("outbox: closed")
+
+// Transport - методы Bot API, через которые отправляются запросы
+type Transport interface {
+	Send(c tgbotapi.Chattable) (tgbotapi.Message, error)
+	Request(c tgbotapi.Chattable) (*tgbotapi.APIResponse, error)
+}
+
+// item - запрос в очереди
+type item struct {
+	chatId int64
+	kind   string
+	call   func() error
+	queued time.Time
+	done   chan error
+}
+
+// lane - очередь запросов одного чата; запросы чата отправляются по одному в порядке постановки
+type lane struct {
+	items   []*item
+	running bool
+	bucket  bucket
+	// pausedUntil - до этого времени Telegram просил не писать в чат
+	pausedUntil time.Time
+}
+
+// Outbox - очередь исходящих запросов к Bot API. Outbox реализует те же Send и Request,
+// что и бот, и возвращает результат после отправки, поэтому его можно подставить вместо бота.
+// Запросы одного чата уходят по порядку не чаще BOT_CHAT_RATE в секунду, всех чатов -
+// не чаще BOT_SEND_RATE. Ответ 429 приостанавливает чат на retry_after, временные ошибки
+// повторяются с удваивающейся паузой, а запросы, которые так и не удалось отправить,
+// записываются в журнал недоставленных сообщений.
+type Outbox struct {
+	bot       Transport
+	chatRate  float64
+	chatBurst int
+	retries   int
+	backoff   time.Duration
+	logger    *zap.Logger
+	dead      *zap.Logger
+	now       func() time.Time
+
+	// ctx отменяется, если при остановке очередь не успела опустеть
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu     sync.Mutex
+	lanes  map[int64]*lane
+	global bucket
+	// pausedUntil - пауза для запросов, не привязанных к чату
+	pausedUntil time.Time
+	closed      bool
+}
+
+func New(bot Transport, cfg config.Config, logger *zap.Logger) *Outbox {
+	ctx, cancel := context.WithCancel(context.Background())
+	burst := int(cfg.BotSendRate)
+	if burst < 1 {
+		burst = 1
+	}
+	return &Outbox{
+		bot:       bot,
+		chatRate:  cfg.BotChatRate,
+		chatBurst: cfg.BotChatBurst,
+		retries:   cfg.BotSendRetries,
+		backoff:   cfg.BotSendBackoff,
+		logger:    logger,
+		dead:      logger.Named("dead_letter"),
+		now:       time.Now,
+		ctx:       ctx,
+		cancel:    cancel,
+		lanes:     map[int64]*lane{},
+		global:    newBucket(cfg.BotSendRate, burst),
+	}
+}
+
+func (o *Outbox) Send(c tgbotapi.Chattable) (tgbotapi.Message, error) {
+	var msg tgbotapi.Message
+	err := o.do(c, func() error {
+		var err error
+		msg, err = o.bot.Send(c)
+		return err
+	})
+	return msg, err
+}
+
+func (o *Outbox) Request(c tgbotapi.Chattable) (*tgbotapi.APIResponse, error) {
+	var resp *tgbotapi.APIResponse
+	err := o.do(c, func() error {
+		var err error
+		resp, err = o.bot.Request(c)
+		return err
+	})
+	return resp, err
+}
+
+// Close перестает принимать запросы и ждет, пока уйдут уже принятые. Если ctx
+// истекает раньше, оставшиеся запросы отбрасываются в журнал недоставленных.
+func (o *Outbox) Close(ctx context.Context) error {
+	o.mu.Lock()
+	o.closed = true
+	o.mu.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		o.wg.Wait()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+		o.cancel()
+		return nil
+	case <-ctx.Done():
+		o.cancel()
+		<-drained
+		return ctx.Err()
+	}
+}
+
+// do ставит запрос в очередь его чата и ждет результата отправки
+func (o *Outbox) do(c tgbotapi.Chattable, call func() error) error {
+	it := &item{
+		chatId: ChatOf(c),
+		kind:   fmt.Sprintf("%T", c),
+		call:   call,
+		queued: o.now(),
+		done:   make(chan error, 1),
+	}
+
+	o.mu.Lock()
+	if o.closed {
+		o.mu.Unlock()
+		return ErrClosed
+	}
+	l, ok := o.lanes[it.chatId]
+	if !ok {
+		l = &lane{bucket: newBucket(o.chatRate, o.chatBurst)}
+		if it.chatId == 0 {
+			// Ответы на нажатия кнопок и настройки бота ограничены только общим лимитом
+			l.bucket = newBucket(0, 1)
+		}
+		o.lanes[it.chatId] = l
+	}
+	l.items = append(l.items, it)
+	queueDepth.Inc()
+	if !l.running {
+		l.running = true
+		o.wg.Add(1)
+		go o.run(l)
+	}
+	o.mu.Unlock()
+
+	return <-it.done
+}
+
+// run отправляет запросы чата, пока его очередь не опустеет
+func (o *Outbox) run(l *lane) {
+	defer o.wg.Done()
+	for {
+		o.mu.Lock()
+		if len(l.items) == 0 {
+			l.running = false
+			o.prune()
+			o.mu.Unlock()
+			return
+		}
+		it := l.items[0]
+		o.mu.Unlock()
+
+		err := o.deliver(l, it)
+
+		o.mu.Lock()
+		l.items = l.items[1:]
+		o.mu.Unlock()
+		queueDepth.Dec()
+		it.done <- err
+	}
+}
+
+// deliver отправляет запрос, повторяя его после 429 и временных ошибок
+func (o *Outbox) deliver(l *lane, it *item) error {
+	backoff := o.backoff
+	for attempt := 1; ; attempt++ {
+		if !o.wait(l, it.chatId) {
+			o.deadLetter(it, attempt-1, ErrClosed)
+			return ErrClosed
+		}
+
+		err := it.call()
+		if err == nil {
+			sent.WithLabelValues("ok").Inc()
+			delaySeconds.Observe(o.now().Sub(it.queued).Seconds())
+			return nil
+		}
+
+		reason, retryAfter := classify(err)
+		failures.WithLabelValues(reason).Inc()
+		if reason == reasonPermanent || attempt > o.retries {
+			o.deadLetter(it, attempt, err)
+			return err
+		}
+
+		delay := backoff
+		if reason == reasonRateLimited {
+			delay = retryAfter
+			o.pause(l, it.chatId, retryAfter)
+		} else {
+			backoff *= 2
+			if backoff > maxBackoff {
+				backoff = maxBackoff
+			}
+		}
+		o.logger.Warn("bot request failed, will retry",
+			zap.Int64("chat", it.chatId),
+			zap.String("request", it.kind),
+			zap.Int("attempt", attempt),
+			zap.Duration("retry_in", delay),
+			zap.Error(err))
+		if reason != reasonRateLimited && !sleep(o.ctx, delay) {
+			o.deadLetter(it, attempt, err)
+			return err
+		}
+	}
+}
+
+// wait ждет, пока чат и бот в целом снова могут отправлять запросы, и занимает место в лимитах
+func (o *Outbox) wait(l *lane, chatId int64) bool {
+	for {
+		o.mu.Lock()
+		now := o.now()
+		d := l.pausedUntil.Sub(now)
+		if chatId == 0 {
+			d = o.pausedUntil.Sub(now)
+		}
+		if d <= 0 {
+			d = l.bucket.wait(now)
+			if g := o.global.wait(now); g > d {
+				d = g
+			}
+		}
+		if d <= 0 {
+			l.bucket.take(now)
+			o.global.take(now)
+			o.mu.Unlock()
+			return true
+		}
+		o.mu.Unlock()
+
+		if !sleep(o.ctx, d) {
+			return false
+		}
+	}
+}
+
+// pause приостанавливает чат, а для запросов без чата - все такие запросы
+func (o *Outbox) pause(l *lane, chatId int64, d time.Duration) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	until := o.now().Add(d)
+	if chatId == 0 {
+		o.pausedUntil = until
+	}
+	l.pausedUntil = until
+}
+
+// prune удаляет простаивающие чаты, которые уже не ограничены лимитом и паузой; вызывается под mu
+func (o *Outbox) prune() {
+	now := o.now()
+	for chatId, l := range o.lanes {
+		if !l.running && len(l.items) == 0 && l.bucket.full(now) && !now.Before(l.pausedUntil) {
+			delete(o.lanes, chatId)
+		}
+	}
+}
+
+// deadLetter записывает запрос, который не удалось отправить. Текст сообщения
+// не записывается: в нем могут быть данные пациентов.
+func (o *Outbox) deadLetter(it *item, attempts int, err error) {
+	sent.WithLabelValues("dead").Inc()
+	o.dead.Error("bot request dropped",
+		zap.Int64("chat", it.chatId),
+		zap.String("request", it.kind),
+		zap.Int("attempts", attempts),
+		zap.Time("queued", it.queued),
+		zap.Error(err))
+}
+
+// classify определяет, стоит ли повторять запрос, и сколько ждать после 429
+func classify(err error) (string, time.Duration) {
+	var apiErr *tgbotapi.Error
+	if !errors.As(err, &apiErr) {
+		// Ошибка сети или разбора ответа
+		return reasonTransient, 0
+	}
+	switch {
+	case apiErr.Code == http.StatusTooManyRequests:
+		retryAfter := time.Duration(apiErr.RetryAfter) * time.Second
+		if retryAfter < time.Second {
+			retryAfter = time.Second
+		}
+		return reasonRateLimited, retryAfter
+	case apiErr.Code >= http.StatusInternalServerError:
+		return reasonTransient, 0
+	default:
+		// Неверный запрос, бот заблокирован пользователем, чат не найден: повтор не поможет
+		return reasonPermanent, 0
+	}
+}
+
+// ChatOf - чат, к которому относится запрос; 0 - запрос не привязан к чату
+func ChatOf(c tgbotapi.Chattable) int64 {
+	switch c := c.(type) {
+	case tgbotapi.MessageConfig:
+		return c.ChatID
+	case tgbotapi.DocumentConfig:
+		return c.ChatID
+	case tgbotapi.EditMessageTextConfig:
+		return c.ChatID
+	case tgbotapi.EditMessageReplyMarkupConfig:
+		return c.ChatID
+	case tgbotapi.DeleteMessageConfig:
+		return c.ChatID
+	}
+	return 0
+}
+
+// sleep ждет d; возвращает false, если ctx отменили раньше
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
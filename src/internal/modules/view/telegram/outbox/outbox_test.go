@@ -0,0 +1,173 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"hospital/internal/modules/config"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeBot отвечает на запросы ошибками из errs по очереди, а затем успехом
+type fakeBot struct {
+	mu   sync.Mutex
+	errs []error
+	sent []string
+	at   []time.Time
+}
+
+func (b *fakeBot) Send(c tgbotapi.Chattable) (tgbotapi.Message, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.at = append(b.at, time.Now())
+	if len(b.errs) > 0 {
+		err := b.errs[0]
+		b.errs = b.errs[1:]
+		return tgbotapi.Message{}, err
+	}
+	b.sent = append(b.sent, c.(tgbotapi.MessageConfig).Text)
+	return tgbotapi.Message{Text: c.(tgbotapi.MessageConfig).Text}, nil
+}
+
+func (b *fakeBot) Request(tgbotapi.Chattable) (*tgbotapi.APIResponse, error) {
+	return &tgbotapi.APIResponse{Ok: true}, nil
+}
+
+func newTestOutbox(bot Transport, cfg config.Config) (*Outbox, *observer.ObservedLogs) {
+	core, logs := observer.New(zap.InfoLevel)
+	if cfg.BotSendBackoff == 0 {
+		cfg.BotSendBackoff = time.Millisecond
+	}
+	return New(bot, cfg, zap.New(core)), logs
+}
+
+func TestOutbox_Send(t *testing.T) {
+	tests := []struct {
+		name     string
+		errs     []error
+		retries  int
+		wantErr  bool
+		wantDead int
+		wantWait time.Duration
+	}{
+		{name: "Message is sent"},
+		{
+			name:    "Network error is retried",
+			errs:    []error{errors.New("connection reset"), &tgbotapi.Error{Code: 502, Message: "Bad Gateway"}},
+			retries: 3,
+		},
+		{
+			name:     "Too many requests waits for retry_after",
+			errs:     []error{&tgbotapi.Error{Code: 429, ResponseParameters: tgbotapi.ResponseParameters{RetryAfter: 1}}},
+			retries:  3,
+			wantWait: time.Second,
+		},
+		{
+			name:     "Blocked bot is not retried",
+			errs:     []error{&tgbotapi.Error{Code: 403, Message: "Forbidden: bot was blocked by the user"}},
+			retries:  3,
+			wantErr:  true,
+			wantDead: 1,
+		},
+		{
+			name:     "Message is dropped when retries are exhausted",
+			errs:     []error{errors.New("timeout"), errors.New("timeout"), errors.New("timeout")},
+			retries:  2,
+			wantErr:  true,
+			wantDead: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		runner.Run(t, tt.name, func(t provider.T) {
+			bot := &fakeBot{errs: tt.errs}
+			o, logs := newTestOutbox(bot, config.Config{BotSendRetries: tt.retries})
+
+			started := time.Now()
+			msg, err := o.Send(tgbotapi.NewMessage(1, "text"))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Send() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && msg.Text != "text" {
+				t.Errorf("Send() got = %q, want %q", msg.Text, "text")
+			}
+			if waited := time.Since(started); waited < tt.wantWait {
+				t.Errorf("Send() returned after %v, want at least %v", waited, tt.wantWait)
+			}
+			if dead := logs.FilterMessage("bot request dropped").Len(); dead != tt.wantDead {
+				t.Errorf("dead letters = %d, want %d", dead, tt.wantDead)
+			}
+		})
+	}
+}
+
+func TestOutbox_ChatOrderAndRate(t *testing.T) {
+	runner.Run(t, "Messages of a chat keep their order and rate", func(t provider.T) {
+		bot := &fakeBot{}
+		o, _ := newTestOutbox(bot, config.Config{BotChatRate: 20, BotChatBurst: 1})
+
+		// Сообщения ставятся в очередь по одному, как части длинного ответа
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for _, text := range []string{"1", "2", "3", "4"} {
+				if _, err := o.Send(tgbotapi.NewMessage(7, text)); err != nil {
+					t.Errorf("Send() error = %v", err)
+				}
+			}
+		}()
+		<-done
+
+		if got := bot.sent; len(got) != 4 || got[0] != "1" || got[3] != "4" {
+			t.Errorf("sent = %v, want [1 2 3 4]", got)
+		}
+		// 20 сообщений в секунду: между соседними не меньше 50 мс
+		for i := 1; i < len(bot.at); i++ {
+			if gap := bot.at[i].Sub(bot.at[i-1]); gap < 45*time.Millisecond {
+				t.Errorf("messages %d and %d sent %v apart", i, i+1, gap)
+			}
+		}
+	})
+}
+
+func TestOutbox_Close(t *testing.T) {
+	runner.Run(t, "Closed outbox rejects new messages", func(t provider.T) {
+		o, _ := newTestOutbox(&fakeBot{}, config.Config{})
+		if err := o.Close(context.Background()); err != nil {
+			t.Fatalf("Close() error = %v", err)
+		}
+		if _, err := o.Send(tgbotapi.NewMessage(1, "late")); err != ErrClosed {
+			t.Errorf("Send() error = %v, want %v", err, ErrClosed)
+		}
+	})
+
+	runner.Run(t, "Pending messages are dropped when shutdown times out", func(t provider.T) {
+		bot := &fakeBot{errs: []error{&tgbotapi.Error{Code: 429, ResponseParameters: tgbotapi.ResponseParameters{RetryAfter: 60}}}}
+		o, logs := newTestOutbox(bot, config.Config{BotSendRetries: 3})
+
+		result := make(chan error, 1)
+		go func() {
+			_, err := o.Send(tgbotapi.NewMessage(1, "text"))
+			result <- err
+		}()
+		time.Sleep(50 * time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		if err := o.Close(ctx); err != context.DeadlineExceeded {
+			t.Errorf("Close() error = %v, want %v", err, context.DeadlineExceeded)
+		}
+		if err := <-result; err != ErrClosed {
+			t.Errorf("Send() error = %v, want %v", err, ErrClosed)
+		}
+		if logs.FilterMessage("bot request dropped").Len() != 1 {
+			t.Errorf("pending message must be written to the dead letter log")
+		}
+	})
+}
@@ -0,0 +1,148 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"hash/fnv"
+	"hospital/internal/modules/view/telegram/callback"
+	"hospital/internal/modules/view/telegram/dialog"
+	"hospital/internal/modules/view/telegram/i18n"
+	"hospital/internal/modules/view/telegram/render"
+	"strconv"
+)
+
+// pickerPageSize - количество вариантов на одной странице выбора
+const pickerPageSize = 8
+
+// pickPayload - выбор варианта Index на шаге Step. Check защищает от выбора
+// другого варианта, если список изменился после показа кнопок.
+type pickPayload struct {
+	Step  string
+	Index int
+	Check string
+}
+
+// pagePayload - переход на страницу Page списка вариантов шага Step
+type pagePayload struct {
+	Step string
+	Page int
+}
+
+// picker показывает варианты ответа шага диалога постраничными кнопками
+// и передает выбранный вариант в диалог как ответ пользователя
+type picker struct {
+	dialogs *dialog.Engine
+	pick    *callback.Route[pickPayload]
+	page    *callback.Route[pagePayload]
+}
+
+func registerPicker(router *callback.Router, dialogs *dialog.Engine) *picker {
+	p := &picker{dialogs: dialogs}
+	p.pick = callback.Register(router, "pk", p.handlePick)
+	p.page = callback.Register(router, "pg", p.handlePage)
+	dialogs.UseKeyboard(p.keyboard)
+	return p
+}
+
+func (p *picker) keyboard(_ context.Context, c *dialog.Conversation, choices []dialog.Choice) (interface{}, error) {
+	return p.render(c.UserId, c.State, choices, 0)
+}
+
+func (p *picker) render(userId int64, step string, choices []dialog.Choice, page int) (tgbotapi.InlineKeyboardMarkup, error) {
+	pages := (len(choices) + pickerPageSize - 1) / pickerPageSize
+	if page < 0 || page >= pages {
+		page = 0
+	}
+
+	var rows [][]tgbotapi.InlineKeyboardButton
+	for i := page * pickerPageSize; i < len(choices) && i < (page+1)*pickerPageSize; i++ {
+		button, err := p.pick.Button(choiceText(choices[i]), userId, pickPayload{
+			Step:  step,
+			Index: i,
+			Check: checksum(choices[i].Value),
+		})
+		if err != nil {
+			return tgbotapi.InlineKeyboardMarkup{}, err
+		}
+		rows = append(rows, tgbotapi.NewInlineKeyboardRow(button))
+	}
+
+	if pages > 1 {
+		var nav []tgbotapi.InlineKeyboardButton
+		if page > 0 {
+			button, err := p.page.Button("‹", userId, pagePayload{Step: step, Page: page - 1})
+			if err != nil {
+				return tgbotapi.InlineKeyboardMarkup{}, err
+			}
+			nav = append(nav, button)
+		}
+		current, err := p.page.Button(fmt.Sprintf("%d/%d", page+1, pages), userId, pagePayload{Step: step, Page: page})
+		if err != nil {
+			return tgbotapi.InlineKeyboardMarkup{}, err
+		}
+		nav = append(nav, current)
+		if page < pages-1 {
+			button, err := p.page.Button("›", userId, pagePayload{Step: step, Page: page + 1})
+			if err != nil {
+				return tgbotapi.InlineKeyboardMarkup{}, err
+			}
+			nav = append(nav, button)
+		}
+		rows = append(rows, nav)
+	}
+
+	return tgbotapi.NewInlineKeyboardMarkup(rows...), nil
+}
+
+func (p *picker) handlePage(ctx context.Context, q callback.Query, payload pagePayload) (callback.Reply, error) {
+	step, choices, err := p.dialogs.Choices(ctx, q.ChatId)
+	if err != nil || step != payload.Step || len(choices) == 0 {
+		return callback.Reply{Text: i18n.T(ctx, "picker.stale")}, nil
+	}
+
+	keyboard, err := p.render(q.UserId, step, choices, payload.Page)
+	if err != nil {
+		return callback.Reply{}, err
+	}
+	return callback.Reply{Keyboard: &keyboard}, nil
+}
+
+func (p *picker) handlePick(ctx context.Context, q callback.Query, payload pickPayload) (callback.Reply, error) {
+	step, choices, err := p.dialogs.Choices(ctx, q.ChatId)
+	if err != nil || step != payload.Step {
+		return callback.Reply{Text: i18n.T(ctx, "picker.stale")}, nil
+	}
+	if payload.Index >= len(choices) || checksum(choices[payload.Index].Value) != payload.Check {
+		keyboard, err := p.render(q.UserId, step, choices, 0)
+		if err != nil {
+			return callback.Reply{}, err
+		}
+		return callback.Reply{Text: i18n.T(ctx, "picker.changed"), Keyboard: &keyboard}, nil
+	}
+
+	choice := choices[payload.Index]
+	reply, _ := p.dialogs.Handle(ctx, q.ChatId, choice.Value)
+	chosen := choiceText(choice)
+	if reply.ParseMode == tgbotapi.ModeHTML {
+		chosen = render.Escape(chosen)
+	}
+	return callback.Reply{
+		Text:      i18n.T(ctx, "picker.chosen", chosen, reply.Text),
+		Markup:    reply.Markup,
+		ParseMode: reply.ParseMode,
+	}, nil
+}
+
+func choiceText(c dialog.Choice) string {
+	if c.Label == "" {
+		return c.Value
+	}
+	return c.Label
+}
+
+func checksum(value string) string {
+	h := fnv.New32a()
+	h.Write([]byte(value))
+	return strconv.FormatUint(uint64(h.Sum32()%(36*36*36*36)), 36)
+}
@@ -0,0 +1,83 @@
+package render
+
+import (
+	"html"
+	"strings"
+	"unicode/utf8"
+)
+
+// Ответы с разметкой отправляются с ParseMode = tgbotapi.ModeHTML. Все данные
+// пользователей экранируются здесь, поэтому вызывающему коду не нужно думать о разметке.
+
+// Escape экранирует текст для вставки в HTML-сообщение
+func Escape(s string) string {
+	return html.EscapeString(s)
+}
+
+// Bold - полужирный текст
+func Bold(s string) string {
+	return "<b>" + Escape(s) + "</b>"
+}
+
+// Field - строка карточки: подпись и значение
+type Field struct {
+	Label string
+	Value string
+}
+
+// Card - карточка: заголовок и строки «подпись: значение»
+func Card(title string, fields ...Field) string {
+	var b strings.Builder
+	b.WriteString(Bold(title))
+	b.WriteByte('\n')
+	for _, f := range fields {
+		b.WriteString(Bold(f.Label + ":"))
+		b.WriteByte(' ')
+		b.WriteString(Escape(f.Value))
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// Table - таблица моноширинным шрифтом с выровненными столбцами. Каждая строка таблицы -
+// отдельная строка текста, поэтому длинная таблица делится между сообщениями по строкам.
+func Table(header []string, rows [][]string) string {
+	widths := make([]int, len(header))
+	measure := func(row []string) {
+		for i, cell := range row {
+			if n := utf8.RuneCountInString(cell); i < len(widths) && n > widths[i] {
+				widths[i] = n
+			}
+		}
+	}
+	measure(header)
+	for _, row := range rows {
+		measure(row)
+	}
+
+	var b strings.Builder
+	b.WriteString("<pre>")
+	line := func(row []string) {
+		for i := range widths {
+			var cell string
+			if i < len(row) {
+				cell = row[i]
+			}
+			if i > 0 {
+				b.WriteString("  ")
+			}
+			b.WriteString(Escape(cell))
+			// Последний столбец не дополняется пробелами
+			if i < len(widths)-1 {
+				b.WriteString(strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell)))
+			}
+		}
+		b.WriteByte('\n')
+	}
+	line(header)
+	for _, row := range rows {
+		line(row)
+	}
+	b.WriteString("</pre>")
+	return b.String()
+}
@@ -0,0 +1,122 @@
+package render
+
+import (
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCard(t *testing.T) {
+	runner.Run(t, "Card escapes user data", func(t provider.T) {
+		got := Card("Пациент <1>", Field{Label: "Фамилия", Value: "O'Brien & <Sons>"})
+		want := "<b>Пациент &lt;1&gt;</b>\n<b>Фамилия:</b> O&#39;Brien &amp; &lt;Sons&gt;\n"
+		if got != want {
+			t.Errorf("Card() got = %q, want %q", got, want)
+		}
+	})
+}
+
+func TestTable(t *testing.T) {
+	runner.Run(t, "Table aligns columns by characters", func(t provider.T) {
+		got := Table([]string{"ID", "Фамилия", "Имя"}, [][]string{
+			{"1", "Иванов", "Иван"},
+			{"12", "Ли", "<Мин>"},
+		})
+		want := "<pre>" +
+			"ID  Фамилия  Имя\n" +
+			"1   Иванов   Иван\n" +
+			"12  Ли       &lt;Мин&gt;\n" +
+			"</pre>"
+		if got != want {
+			t.Errorf("Table() got = %q, want %q", got, want)
+		}
+	})
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		html  bool
+		limit int
+		want  []string
+	}{
+		{
+			name:  "Short text is not split",
+			text:  "one\ntwo",
+			limit: 10,
+			want:  []string{"one\ntwo"},
+		},
+		{
+			name:  "Text is split by lines",
+			text:  "one\ntwo\nthree\n",
+			limit: 9,
+			want:  []string{"one\ntwo\n", "three\n"},
+		},
+		{
+			name:  "Long line is split by characters",
+			text:  "абвгдежзий",
+			limit: 4,
+			want:  []string{"абвг", "дежз", "ий"},
+		},
+		{
+			name:  "Tags are closed and reopened",
+			text:  "<pre>aaa\nbbb\nccc\n</pre>",
+			html:  true,
+			limit: 20,
+			want:  []string{"<pre>aaa\nbbb\n</pre>", "<pre>ccc\n</pre>"},
+		},
+		{
+			name:  "Entities are not broken",
+			text:  "a&amp;&lt;&gt;",
+			html:  true,
+			limit: 6,
+			want:  []string{"a&amp;", "&lt;", "&gt;"},
+		},
+		{
+			name:  "Empty parts are dropped",
+			text:  "aaaa\n\n\nbbbb",
+			limit: 5,
+			want:  []string{"aaaa\n", "bbbb"},
+		},
+	}
+
+	for _, tt := range tests {
+		runner.Run(t, tt.name, func(t provider.T) {
+			got := Split(tt.text, tt.html, tt.limit)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Split() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplit_Limit(t *testing.T) {
+	runner.Run(t, "Every part of a long table fits into a message", func(t provider.T) {
+		rows := make([][]string, 500)
+		for i := range rows {
+			rows[i] = []string{strings.Repeat("№", i%7+1), "Иванов & <Петров>", "Палата"}
+		}
+		text := "<b>Пациенты</b>\n" + Table([]string{"ID", "ФИО", "Палата"}, rows)
+
+		parts := Split(text, true, MaxLength)
+		if len(parts) < 2 {
+			t.Fatalf("Split() returned %d parts, want several", len(parts))
+		}
+		var joined strings.Builder
+		for i, part := range parts {
+			if length(part) > MaxLength {
+				t.Errorf("part %d has %d characters", i, length(part))
+			}
+			if strings.Count(part, "<pre>") != strings.Count(part, "</pre>") {
+				t.Errorf("part %d has unbalanced tags", i)
+			}
+			joined.WriteString(visible(part, true))
+		}
+		if joined.String() != visible(text, true) {
+			t.Errorf("Split() lost text")
+		}
+	})
+}
@@ -0,0 +1,155 @@
+package render
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxLength - наибольшая длина текста сообщения в Telegram
+const MaxLength = 4096
+
+// Split делит текст на части не длиннее limit символов. Части заканчиваются на переносе
+// строки, а строка, которая не помещается в часть целиком, делится посимвольно.
+// В HTML-тексте теги и сущности не разрываются: теги, открытые на месте разреза,
+// закрываются в конце части и открываются заново в начале следующей.
+func Split(text string, html bool, limit int) []string {
+	if length(text) <= limit {
+		return []string{text}
+	}
+
+	var parts []string
+	var open []string
+	// prefix - теги, открытые заново в начале текущей части; size - длина части вместе с ним
+	prefix, start, size := "", 0, 0
+	// Позиция сразу после последнего переноса строки в части, длина части до нее и открытые там теги
+	brk, brkSize := -1, 0
+	var brkOpen []string
+
+	add := func(part string) {
+		if strings.TrimSpace(visible(part, html)) != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	for i := 0; i < len(text); {
+		tok := token(text, i, html)
+		next := apply(open, tok, html)
+		n := length(tok)
+		if size+n+closingLength(next) > limit && i > start {
+			if brk > start {
+				add(prefix + text[start:brk] + closing(brkOpen))
+				prefix = strings.Join(brkOpen, "")
+				size = length(prefix) + size - brkSize
+				start = brk
+			} else {
+				add(prefix + text[start:i] + closing(open))
+				prefix = strings.Join(open, "")
+				size = length(prefix)
+				start = i
+			}
+			brk = -1
+			continue
+		}
+
+		open = next
+		size += n
+		i += len(tok)
+		if tok == "\n" {
+			brk, brkSize, brkOpen = i, size, open
+		}
+	}
+	add(prefix + text[start:])
+
+	return parts
+}
+
+// token - тег, HTML-сущность или один символ, начинающийся с позиции i
+func token(text string, i int, html bool) string {
+	if html {
+		switch text[i] {
+		case '<':
+			if end := strings.IndexByte(text[i:], '>'); end > 0 {
+				return text[i : i+end+1]
+			}
+		case '&':
+			// Самая длинная сущность, которую понимает Telegram, - &quot; или числовая &#1234567;
+			if end := strings.IndexByte(text[i:], ';'); end > 0 && end <= 10 {
+				return text[i : i+end+1]
+			}
+		}
+	}
+	_, size := utf8.DecodeRuneInString(text[i:])
+	return text[i : i+size]
+}
+
+// apply возвращает теги, открытые после tok; исходный срез не меняется
+func apply(open []string, tok string, html bool) []string {
+	if !html || len(tok) < 3 || tok[0] != '<' || tok[len(tok)-1] != '>' {
+		return open
+	}
+	if tok[1] == '/' {
+		if len(open) == 0 {
+			return open
+		}
+		return open[: len(open)-1 : len(open)-1]
+	}
+	next := make([]string, len(open), len(open)+1)
+	copy(next, open)
+	return append(next, tok)
+}
+
+// closing - закрывающие теги для открытых тегов open
+func closing(open []string) string {
+	var b strings.Builder
+	for i := len(open) - 1; i >= 0; i-- {
+		b.WriteString("</" + tagName(open[i]) + ">")
+	}
+	return b.String()
+}
+
+func closingLength(open []string) int {
+	n := 0
+	for _, tag := range open {
+		n += len(tagName(tag)) + 3
+	}
+	return n
+}
+
+// tagName - имя тега из открывающего тега, например a из <a href="...">
+func tagName(tag string) string {
+	name := strings.TrimSuffix(strings.TrimPrefix(tag, "<"), ">")
+	if i := strings.IndexAny(name, " \t\n"); i >= 0 {
+		name = name[:i]
+	}
+	return name
+}
+
+// visible - текст без тегов
+func visible(part string, html bool) string {
+	if !html {
+		return part
+	}
+	var b strings.Builder
+	for i := 0; i < len(part); {
+		tok := token(part, i, html)
+		if tok[0] != '<' || len(tok) == 1 {
+			b.WriteString(tok)
+		}
+		i += len(tok)
+	}
+	return b.String()
+}
+
+// length - длина текста так, как ее считает Telegram: в кодовых единицах UTF-16.
+// Теги и сущности считаются целиком, поэтому для HTML длина получается с запасом.
+func length(s string) int {
+	n := 0
+	for _, r := range s {
+		if r >= 0x10000 {
+			n += 2
+		} else {
+			n++
+		}
+	}
+	return n
+}
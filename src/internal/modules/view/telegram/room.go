@@ -0,0 +1,70 @@
+package telegram
+
+import (
+	"context"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"hospital/internal/models/errors"
+	patient_dto "hospital/internal/modules/domain/patient/dto"
+	room_dto "hospital/internal/modules/domain/room/dto"
+	"hospital/internal/modules/view/telegram/controllers"
+	"hospital/internal/modules/view/telegram/dialog"
+	"hospital/internal/modules/view/telegram/form"
+	"hospital/internal/modules/view/telegram/i18n"
+	"hospital/internal/modules/view/telegram/render"
+	"strconv"
+	"strings"
+)
+
+// roomNumber - ответ формы поиска палаты
+type roomNumber struct {
+	Num int
+}
+
+func findRoomForm(controller *controllers.Controller) *form.Form[roomNumber] {
+	return &form.Form[roomNumber]{
+		Name: "button.find_room",
+		Fields: []form.Field{
+			{Name: "num", Prompt: "room.prompt.num", Kind: form.Int},
+		},
+		Submit: func(ctx context.Context, _ *dialog.Conversation, r *roomNumber) dialog.Reply {
+			return dialog.Reply{Text: findRoom(ctx, r.Num, controller), ParseMode: tgbotapi.ModeHTML}
+		},
+	}
+}
+
+// findRoom - карточка палаты в HTML
+func findRoom(ctx context.Context, num int, controller *controllers.Controller) string {
+	room, patients, err := controller.RoomCard(ctx, num)
+	if err == errors.ErrDatabaseRecordNotFound {
+		return render.Escape(i18n.T(ctx, "room.not_found", num))
+	}
+	if err != nil {
+		return render.Escape(i18n.T(ctx, "error.request"))
+	}
+	return formatRoomCard(ctx, room, patients)
+}
+
+// formatRoomCard - карточка палаты со списком пациентов, которые в ней лежат
+func formatRoomCard(ctx context.Context, room *room_dto.Room, patients patient_dto.Patients) string {
+	fields := []render.Field{
+		{Label: i18n.T(ctx, "field.floor"), Value: strconv.Itoa(room.Floor)},
+		{Label: i18n.T(ctx, "field.room_type"), Value: room.TypeRoom},
+		{Label: i18n.T(ctx, "field.occupied"), Value: i18n.T(ctx, "room.occupied", len(patients), room.NumberBeds)},
+	}
+	if room.DepartmentId != nil {
+		fields = append(fields, render.Field{Label: i18n.T(ctx, "field.department"), Value: strconv.Itoa(*room.DepartmentId)})
+	}
+
+	var b strings.Builder
+	b.WriteString(render.Card(i18n.T(ctx, "room.card", room.Num, room.Id), fields...))
+	if len(patients) == 0 {
+		b.WriteString(render.Escape(i18n.T(ctx, "room.no_patients")))
+		return b.String()
+	}
+	b.WriteString(render.Bold(i18n.T(ctx, "room.patients")) + "\n")
+	for i, p := range patients {
+		b.WriteString(render.Escape(i18n.T(ctx, "room.patient",
+			i+1, p.Surname, p.Name, p.Patronymic, p.Id, p.DegreeOfDanger)))
+	}
+	return b.String()
+}
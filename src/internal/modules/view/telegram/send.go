@@ -0,0 +1,34 @@
+package telegram
+
+import (
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"hospital/internal/modules/view/telegram/dialog"
+	"hospital/internal/modules/view/telegram/render"
+)
+
+// sendText отправляет сообщение, разделив слишком длинный текст на несколько сообщений.
+// Клавиатура прикрепляется к последнему из них, чтобы оказаться под всем ответом.
+func sendText(bot Transport, msg tgbotapi.MessageConfig) error {
+	parts := render.Split(msg.Text, msg.ParseMode == tgbotapi.ModeHTML, render.MaxLength)
+	for i, part := range parts {
+		m := msg
+		m.Text = part
+		if i < len(parts)-1 {
+			m.ReplyMarkup = nil
+		}
+		if _, err := bot.Send(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sendDocument отправляет файл; текст сообщения становится подписью к нему
+func sendDocument(bot Transport, msg tgbotapi.MessageConfig, document *dialog.Document) error {
+	doc := tgbotapi.NewDocument(msg.ChatID, tgbotapi.FileBytes{Name: document.Name, Bytes: document.Data})
+	doc.Caption = msg.Text
+	doc.ParseMode = msg.ParseMode
+	doc.ReplyMarkup = msg.ReplyMarkup
+	_, err := bot.Send(doc)
+	return err
+}
@@ -0,0 +1,343 @@
+// Package telegramtest - локальный сервер Bot API для тестов бота без доступа к сети.
+// Сервер отдает боту подставленные тестом обновления через getUpdates
+// и записывает все вызовы методов, в том числе отправленные сообщения.
+package telegramtest
+
+import (
+	"encoding/json"
+	"fmt"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Token - токен, с которым бот обращается к серверу
+const Token = "123456:test-token"
+
+// maxPoll ограничивает длинный опрос, чтобы бот быстро останавливался в тестах
+const maxPoll = time.Second
+
+// Call - вызов метода Bot API
+type Call struct {
+	Method string
+	Params map[string]string
+}
+
+// Message - сообщение, отправленное ботом
+type Message struct {
+	Id     int
+	ChatId int64
+	Text   string
+	// Keyboard - inline-клавиатура сообщения; заменяется при editMessageReplyMarkup
+	Keyboard *tgbotapi.InlineKeyboardMarkup
+	// Markup - reply_markup в том виде, в каком его передал бот
+	Markup string
+}
+
+// Button возвращает данные inline-кнопки с текстом text
+func (m Message) Button(text string) (string, bool) {
+	if m.Keyboard == nil {
+		return "", false
+	}
+	for _, row := range m.Keyboard.InlineKeyboard {
+		for _, b := range row {
+			if b.Text == text && b.CallbackData != nil {
+				return *b.CallbackData, true
+			}
+		}
+	}
+	return "", false
+}
+
+type Server struct {
+	srv *httptest.Server
+
+	mu       sync.Mutex
+	changed  chan struct{}
+	closed   bool
+	updates  []tgbotapi.Update
+	calls    []Call
+	messages []*Message
+	nextId   int
+}
+
+func NewServer() *Server {
+	s := &Server{changed: make(chan struct{})}
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// Endpoint - шаблон адреса методов для tgbotapi.NewBotAPIWithAPIEndpoint и TELEGRAM_API_ENDPOINT
+func (s *Server) Endpoint() string {
+	return s.srv.URL + "/bot%s/%s"
+}
+
+func (s *Server) Close() {
+	s.mu.Lock()
+	s.closed = true
+	s.notify()
+	s.mu.Unlock()
+	s.srv.Close()
+}
+
+// SendText подставляет сообщение пользователя в личном чате: id чата совпадает с id пользователя
+func (s *Server) SendText(chatId int64, text string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextId++
+	s.push(tgbotapi.Update{Message: &tgbotapi.Message{
+		MessageID: s.nextId,
+		From:      &tgbotapi.User{ID: chatId, FirstName: "Test"},
+		Chat:      &tgbotapi.Chat{ID: chatId, Type: "private"},
+		Date:      int(time.Now().Unix()),
+		Text:      text,
+		Entities:  commandEntities(text),
+	}})
+}
+
+// Press подставляет нажатие inline-кнопки с данными data под сообщением бота
+func (s *Server) Press(m Message, data string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.push(tgbotapi.Update{CallbackQuery: &tgbotapi.CallbackQuery{
+		ID:   strconv.Itoa(len(s.updates) + 1),
+		From: &tgbotapi.User{ID: m.ChatId, FirstName: "Test"},
+		Message: &tgbotapi.Message{
+			MessageID: m.Id,
+			Chat:      &tgbotapi.Chat{ID: m.ChatId, Type: "private"},
+			Text:      m.Text,
+		},
+		Data: data,
+	}})
+}
+
+// Calls - вызовы метода method; пустой method - все вызовы
+func (s *Server) Calls(method string) []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var calls []Call
+	for _, c := range s.calls {
+		if method == "" || c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// Messages - сообщения, отправленные ботом в чат
+func (s *Server) Messages(chatId int64) []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.chatMessages(chatId)
+}
+
+// WaitMessages ждет, пока бот отправит в чат не меньше n сообщений
+func (s *Server) WaitMessages(chatId int64, n int, timeout time.Duration) ([]Message, error) {
+	deadline := time.After(timeout)
+	for {
+		s.mu.Lock()
+		messages := s.chatMessages(chatId)
+		changed := s.changed
+		s.mu.Unlock()
+
+		if len(messages) >= n {
+			return messages, nil
+		}
+		select {
+		case <-changed:
+		case <-deadline:
+			return messages, fmt.Errorf("telegramtest: chat %d has %d messages, want %d", chatId, len(messages), n)
+		}
+	}
+}
+
+// Say отправляет текст от пользователя и возвращает следующий ответ бота в этот чат
+func (s *Server) Say(chatId int64, text string, timeout time.Duration) (Message, error) {
+	n := len(s.Messages(chatId))
+	s.SendText(chatId, text)
+	return s.next(chatId, n, timeout)
+}
+
+// Click нажимает кнопку с текстом text под сообщением m и возвращает следующий ответ бота
+func (s *Server) Click(m Message, text string, timeout time.Duration) (Message, error) {
+	data, ok := m.Button(text)
+	if !ok {
+		return Message{}, fmt.Errorf("telegramtest: message %d has no button %q", m.Id, text)
+	}
+	n := len(s.Messages(m.ChatId))
+	s.Press(m, data)
+	return s.next(m.ChatId, n, timeout)
+}
+
+func (s *Server) next(chatId int64, n int, timeout time.Duration) (Message, error) {
+	messages, err := s.WaitMessages(chatId, n+1, timeout)
+	if err != nil {
+		return Message{}, err
+	}
+	return messages[n], nil
+}
+
+func (s *Server) chatMessages(chatId int64) []Message {
+	var messages []Message
+	for _, m := range s.messages {
+		if m.ChatId == chatId {
+			messages = append(messages, *m)
+		}
+	}
+	return messages
+}
+
+// push и notify вызываются под s.mu
+func (s *Server) push(u tgbotapi.Update) {
+	u.UpdateID = len(s.updates) + 1
+	s.updates = append(s.updates, u)
+	s.notify()
+}
+
+func (s *Server) notify() {
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+type response struct {
+	Ok          bool        `json:"ok"`
+	Result      interface{} `json:"result,omitempty"`
+	ErrorCode   int         `json:"error_code,omitempty"`
+	Description string      `json:"description,omitempty"`
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	// Адрес метода: /bot<token>/<method>
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if len(parts) != 2 || parts[0] != "bot"+Token {
+		writeJSON(w, http.StatusUnauthorized, response{ErrorCode: http.StatusUnauthorized, Description: "Unauthorized"})
+		return
+	}
+	if err := r.ParseMultipartForm(1 << 20); err != nil && err != http.ErrNotMultipart {
+		writeJSON(w, http.StatusBadRequest, response{ErrorCode: http.StatusBadRequest, Description: err.Error()})
+		return
+	}
+	call := Call{Method: parts[1], Params: map[string]string{}}
+	for k := range r.Form {
+		call.Params[k] = r.Form.Get(k)
+	}
+
+	if call.Method == "getUpdates" {
+		writeJSON(w, http.StatusOK, response{Ok: true, Result: s.poll(call.Params)})
+		return
+	}
+
+	s.mu.Lock()
+	s.calls = append(s.calls, call)
+	result, err := s.apply(call)
+	s.notify()
+	s.mu.Unlock()
+
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, response{ErrorCode: http.StatusBadRequest, Description: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, response{Ok: true, Result: result})
+}
+
+// poll отдает обновления начиная с offset, ожидая новых не дольше timeout
+func (s *Server) poll(params map[string]string) []tgbotapi.Update {
+	offset, _ := strconv.Atoi(params["offset"])
+	timeout, _ := strconv.Atoi(params["timeout"])
+	wait := time.Duration(timeout) * time.Second
+	if wait > maxPoll {
+		wait = maxPoll
+	}
+	deadline := time.After(wait)
+
+	for {
+		s.mu.Lock()
+		var updates []tgbotapi.Update
+		for _, u := range s.updates {
+			if u.UpdateID >= offset {
+				updates = append(updates, u)
+			}
+		}
+		changed, closed := s.changed, s.closed
+		s.mu.Unlock()
+
+		if len(updates) > 0 || closed {
+			return updates
+		}
+		select {
+		case <-changed:
+		case <-deadline:
+			return []tgbotapi.Update{}
+		}
+	}
+}
+
+// apply выполняет вызов метода; вызывается под s.mu
+func (s *Server) apply(call Call) (interface{}, error) {
+	switch call.Method {
+	case "getMe":
+		return tgbotapi.User{ID: 1, IsBot: true, FirstName: "Hospital", UserName: "hospital_test_bot"}, nil
+	case "getWebhookInfo":
+		return tgbotapi.WebhookInfo{}, nil
+	case "sendMessage":
+		chatId, err := strconv.ParseInt(call.Params["chat_id"], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Bad Request: chat_id is invalid")
+		}
+		if call.Params["text"] == "" {
+			return nil, fmt.Errorf("Bad Request: message text is empty")
+		}
+		s.nextId++
+		m := &Message{Id: s.nextId, ChatId: chatId, Text: call.Params["text"], Markup: call.Params["reply_markup"]}
+		m.Keyboard = inlineKeyboard(m.Markup)
+		s.messages = append(s.messages, m)
+		return tgbotapi.Message{
+			MessageID: m.Id,
+			Chat:      &tgbotapi.Chat{ID: chatId, Type: "private"},
+			Date:      int(time.Now().Unix()),
+			Text:      m.Text,
+		}, nil
+	case "editMessageReplyMarkup":
+		id, _ := strconv.Atoi(call.Params["message_id"])
+		for _, m := range s.messages {
+			if m.Id == id {
+				m.Markup = call.Params["reply_markup"]
+				m.Keyboard = inlineKeyboard(m.Markup)
+				return true, nil
+			}
+		}
+		return nil, fmt.Errorf("Bad Request: message to edit not found")
+	default:
+		return true, nil
+	}
+}
+
+func inlineKeyboard(markup string) *tgbotapi.InlineKeyboardMarkup {
+	var keyboard tgbotapi.InlineKeyboardMarkup
+	if err := json.Unmarshal([]byte(markup), &keyboard); err != nil || len(keyboard.InlineKeyboard) == 0 {
+		return nil
+	}
+	return &keyboard
+}
+
+// commandEntities размечает слэш-команду в начале текста, как это делает Telegram
+func commandEntities(text string) []tgbotapi.MessageEntity {
+	if !strings.HasPrefix(text, "/") {
+		return nil
+	}
+	name, _, _ := strings.Cut(text, " ")
+	return []tgbotapi.MessageEntity{{Type: "bot_command", Offset: 0, Length: len([]rune(name))}}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
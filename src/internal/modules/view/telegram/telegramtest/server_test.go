@@ -0,0 +1,93 @@
+package telegramtest
+
+import (
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"testing"
+	"time"
+)
+
+// echo - простейший бот: повторяет текст и показывает кнопку, нажатие убирает ее
+func echo(bot *tgbotapi.BotAPI) {
+	updates := bot.GetUpdatesChan(tgbotapi.UpdateConfig{Timeout: 30})
+	for u := range updates {
+		switch {
+		case u.Message != nil:
+			msg := tgbotapi.NewMessage(u.Message.Chat.ID, "echo: "+u.Message.Text)
+			msg.ReplyMarkup = tgbotapi.NewInlineKeyboardMarkup(tgbotapi.NewInlineKeyboardRow(
+				tgbotapi.NewInlineKeyboardButtonData("Ок", "ok|"+u.Message.Text),
+			))
+			_, _ = bot.Send(msg)
+		case u.CallbackQuery != nil:
+			q := u.CallbackQuery
+			_, _ = bot.Request(tgbotapi.NewEditMessageReplyMarkup(q.Message.Chat.ID, q.Message.MessageID,
+				tgbotapi.InlineKeyboardMarkup{InlineKeyboard: [][]tgbotapi.InlineKeyboardButton{}}))
+			_, _ = bot.Request(tgbotapi.NewCallback(q.ID, ""))
+			_, _ = bot.Send(tgbotapi.NewMessage(q.Message.Chat.ID, "pressed: "+q.Data))
+		}
+	}
+}
+
+func TestServer_Conversation(t *testing.T) {
+	runner.Run(t, "Multi-turn conversation", func(t provider.T) {
+		s := NewServer()
+		defer s.Close()
+
+		bot, err := tgbotapi.NewBotAPIWithAPIEndpoint(Token, s.Endpoint())
+		if err != nil {
+			t.Fatalf("NewBotAPIWithAPIEndpoint() error = %v", err)
+		}
+		defer bot.StopReceivingUpdates()
+		go echo(bot)
+
+		first, err := s.Say(7, "/help", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Say() error = %v", err)
+		}
+		if first.Text != "echo: /help" || first.Keyboard == nil {
+			t.Errorf("first reply = %+v", first)
+		}
+
+		second, err := s.Say(7, "Привет", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Say() error = %v", err)
+		}
+		if second.Text != "echo: Привет" {
+			t.Errorf("second reply = %q", second.Text)
+		}
+
+		pressed, err := s.Click(first, "Ок", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Click() error = %v", err)
+		}
+		if pressed.Text != "pressed: ok|/help" {
+			t.Errorf("click reply = %q", pressed.Text)
+		}
+
+		messages := s.Messages(7)
+		if len(messages) != 3 || messages[0].Keyboard != nil {
+			t.Errorf("keyboard of the pressed message was not removed: %+v", messages)
+		}
+		if len(s.Messages(8)) != 0 {
+			t.Errorf("messages leaked to another chat")
+		}
+		if calls := s.Calls("answerCallbackQuery"); len(calls) != 1 {
+			t.Errorf("answerCallbackQuery calls = %d, want 1", len(calls))
+		}
+		if _, ok := first.Button("Нет"); ok {
+			t.Errorf("Button() found a missing button")
+		}
+	})
+}
+
+func TestServer_WrongToken(t *testing.T) {
+	runner.Run(t, "Unknown token is rejected", func(t provider.T) {
+		s := NewServer()
+		defer s.Close()
+
+		if _, err := tgbotapi.NewBotAPIWithAPIEndpoint("1:other", s.Endpoint()); err == nil {
+			t.Errorf("NewBotAPIWithAPIEndpoint() with a wrong token succeeded")
+		}
+	})
+}
@@ -0,0 +1,40 @@
+package telegram
+
+import (
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"go.uber.org/zap"
+	"hospital/internal/modules/config"
+	"hospital/internal/modules/view/telegram/outbox"
+)
+
+// Transport - методы Bot API, через которые бот отвечает пользователям.
+// Реализуется *tgbotapi.BotAPI; в тестах его можно направить на telegramtest.Server.
+type Transport interface {
+	Send(c tgbotapi.Chattable) (tgbotapi.Message, error)
+	Request(c tgbotapi.Chattable) (*tgbotapi.APIResponse, error)
+}
+
+var (
+	_ Transport = (*tgbotapi.BotAPI)(nil)
+	_ Transport = (*outbox.Outbox)(nil)
+)
+
+// NewOutbox - очередь, через которую бот отправляет все ответы и уведомления
+func NewOutbox(bot *tgbotapi.BotAPI, cfg config.Config, logger *zap.Logger) *outbox.Outbox {
+	return outbox.New(bot, cfg, logger)
+}
+
+func NewBotAPI(cfg config.Config) (*tgbotapi.BotAPI, error) {
+	endpoint := cfg.TelegramAPIEndpoint
+	if endpoint == "" {
+		endpoint = tgbotapi.APIEndpoint
+	}
+
+	bot, err := tgbotapi.NewBotAPIWithAPIEndpoint(cfg.TelegramToken, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	bot.Debug = true
+
+	return bot, nil
+}
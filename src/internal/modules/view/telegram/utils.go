@@ -13,8 +13,3 @@ func goDotEnvVariable(key string) string {
 	}
 	return os.Getenv(key)
 }
-
-func popElemFront(a []string) (string, []string) {
-	x, a := a[0], a[1:]
-	return x, a
-}
@@ -0,0 +1,189 @@
+package telegram
+
+import (
+	"context"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"go.uber.org/zap"
+	"hospital/internal/models/errors"
+	"hospital/internal/modules/db"
+	alert_dto "hospital/internal/modules/domain/alert/dto"
+	ward_dto "hospital/internal/modules/domain/ward/dto"
+	"hospital/internal/modules/view/telegram/command"
+	"hospital/internal/modules/view/telegram/controllers"
+	"hospital/internal/modules/view/telegram/dialog"
+	"hospital/internal/modules/view/telegram/i18n"
+	"strconv"
+	"strings"
+)
+
+// handleChatMember встречает бригаду, когда бота добавили в групповой чат,
+// и отвязывает чат, когда бота из него удалили
+func handleChatMember(
+	ctx context.Context,
+	controller *controllers.Controller,
+	update *tgbotapi.ChatMemberUpdated,
+	bot Transport,
+	logger *zap.Logger) {
+	if update.Chat.IsPrivate() {
+		return
+	}
+	chatId := update.Chat.ID
+
+	switch {
+	case memberLeft(update.NewChatMember) && !memberLeft(update.OldChatMember):
+		if err := controller.ForgetWardChat(db.SystemContext(ctx), chatId); err != nil {
+			logger.Error("failed to unbind ward chat", zap.Int64("chat", chatId), zap.Error(err))
+		}
+	case !memberLeft(update.NewChatMember) && memberLeft(update.OldChatMember):
+		ctx = withUserLang(ctx, &update.From)
+		if err := sendText(bot, tgbotapi.NewMessage(chatId, i18n.T(ctx, "group.added"))); err != nil {
+			logger.Error("Ошибка запроса", zap.Int64("chat", chatId), zap.Error(err))
+		}
+	}
+}
+
+func memberLeft(member tgbotapi.ChatMember) bool {
+	return member.HasLeft() || member.WasKicked()
+}
+
+// bindGroup выполняет /bind_group [department=<id>] [rooms=101,102]: уведомления о пациентах
+// отделения и перечисленных палат будут приходить в этот чат на языке администратора
+func bindGroup(ctx context.Context, call command.Call, controller *controllers.Controller) dialog.Reply {
+	if !call.Group {
+		return dialog.Reply{Text: i18n.T(ctx, "group.only")}
+	}
+
+	dtm := &ward_dto.BindWardChat{
+		ChatId:   call.ChatId,
+		Title:    call.Title,
+		Language: string(i18n.LangOf(ctx)),
+	}
+	for _, arg := range call.Args {
+		if !parseWardScope(dtm, strings.ToLower(arg)) {
+			return dialog.Reply{Text: i18n.T(ctx, "group.invalid_argument", arg)}
+		}
+	}
+
+	chat, err := controller.BindWardChat(ctx, dtm)
+	if err != nil {
+		return dialog.Reply{Text: i18n.Error(ctx, err)}
+	}
+	return dialog.Reply{Text: i18n.T(ctx, "group.bound", wardScope(ctx, chat))}
+}
+
+// parseWardScope разбирает аргумент department=<id> или rooms=101,102
+func parseWardScope(dtm *ward_dto.BindWardChat, arg string) bool {
+	key, value, ok := strings.Cut(arg, "=")
+	if !ok || value == "" {
+		return false
+	}
+
+	switch key {
+	case "department":
+		id, err := strconv.Atoi(value)
+		if err != nil {
+			return false
+		}
+		dtm.DepartmentId = &id
+	case "rooms":
+		for _, s := range strings.Split(value, ",") {
+			num, err := strconv.Atoi(s)
+			if err != nil {
+				return false
+			}
+			dtm.Rooms = append(dtm.Rooms, num)
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+func unbindGroup(ctx context.Context, call command.Call, controller *controllers.Controller) dialog.Reply {
+	if !call.Group {
+		return dialog.Reply{Text: i18n.T(ctx, "group.only")}
+	}
+
+	err := controller.UnbindWardChat(ctx, call.ChatId)
+	if err == errors.ErrDatabaseRecordNotFound {
+		return dialog.Reply{Text: i18n.T(ctx, "group.not_bound")}
+	}
+	if err != nil {
+		return dialog.Reply{Text: i18n.Error(ctx, err)}
+	}
+	return dialog.Reply{Text: i18n.T(ctx, "group.unbound")}
+}
+
+// groupInfo - к каким палатам привязан групповой чат
+func groupInfo(ctx context.Context, call command.Call, controller *controllers.Controller) dialog.Reply {
+	if !call.Group {
+		return dialog.Reply{Text: i18n.T(ctx, "group.only")}
+	}
+
+	chat, err := controller.WardChat(ctx, call.ChatId)
+	if err == errors.ErrDatabaseRecordNotFound {
+		return dialog.Reply{Text: i18n.T(ctx, "group.not_bound")}
+	}
+	if err != nil {
+		return dialog.Reply{Text: i18n.Error(ctx, err)}
+	}
+	return dialog.Reply{Text: i18n.T(ctx, "group.info", wardScope(ctx, chat))}
+}
+
+// wardScope - отделение и палаты чата бригады через запятую
+func wardScope(ctx context.Context, chat *ward_dto.WardChat) string {
+	var parts []string
+	if chat.DepartmentId != nil {
+		parts = append(parts, i18n.T(ctx, "group.scope.department", *chat.DepartmentId))
+	}
+	if len(chat.Rooms) > 0 {
+		rooms := make([]string, len(chat.Rooms))
+		for i, num := range chat.Rooms {
+			rooms[i] = strconv.Itoa(num)
+		}
+		parts = append(parts, i18n.T(ctx, "group.scope.rooms", strings.Join(rooms, ", ")))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// sendDueWardNotices отправляет накопившиеся уведомления в чаты бригад. Подтверждать их
+// некому, поэтому кнопки подтверждения у них нет.
+func sendDueWardNotices(
+	ctx context.Context,
+	controller *controllers.Controller,
+	bot Transport,
+	logger *zap.Logger) {
+	notices, err := controller.DueWardNotices(ctx, alertBatch)
+	if err != nil {
+		logger.Error("failed to load ward notices", zap.Error(err))
+		return
+	}
+
+	for _, n := range notices {
+		if err = sendWardNotice(bot, n); err != nil {
+			logger.Warn("failed to deliver ward notice", zap.Int("notice", n.Id), zap.Int64("chat", n.ChatId), zap.Error(err))
+			if err = controller.WardNoticeFailed(ctx, n.Id); err != nil {
+				logger.Error("failed to mark ward notice failed", zap.Int("notice", n.Id), zap.Error(err))
+			}
+			continue
+		}
+		if err = controller.WardNoticeSent(ctx, n.Id); err != nil {
+			logger.Error("failed to mark ward notice sent", zap.Int("notice", n.Id), zap.Error(err))
+		}
+	}
+}
+
+func sendWardNotice(bot Transport, n *ward_dto.Notice) error {
+	ctx := context.Background()
+	if lang, ok := i18n.Parse(n.Language); ok {
+		ctx = i18n.WithLang(ctx, lang)
+	}
+	text := formatAlert(ctx, &alert_dto.Alert{
+		Kind:      n.Kind,
+		Severity:  n.Severity,
+		PatientId: n.PatientId,
+		Params:    n.Params,
+	})
+	_, err := bot.Send(tgbotapi.NewMessage(n.ChatId, text))
+	return err
+}
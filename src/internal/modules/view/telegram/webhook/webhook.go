@@ -0,0 +1,184 @@
+package webhook
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"go.uber.org/zap"
+	"hospital/internal/modules/config"
+	"net/http"
+	"net/url"
+	"regexp"
+	"time"
+)
+
+// SecretHeader - заголовок, в котором Telegram передает секрет, указанный при setWebhook
+const SecretHeader = "X-Telegram-Bot-Api-Secret-Token"
+
+// bufferSize - сколько обновлений ждут обработки, прежде чем Telegram получит 503 и повторит запрос
+const bufferSize = 100
+
+var secretPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,256}$`)
+
+// Server принимает обновления от Telegram по HTTP.
+// Каждый экземпляр бота за балансировщиком принимает обновления независимо,
+// поэтому бот масштабируется горизонтально без общего опроса getUpdates.
+//
+// Обновления доставляются не более одного раза: Telegram получает ответ 200, как только
+// обновление попало в буфер, и больше его не присылает. При штатной остановке принятые
+// обновления обрабатываются до конца, а если процесс упадет, буфер и очередь обработки
+// теряются. Поэтому буфер небольшой, а когда он заполнен, Telegram получает 503 и повторит запрос.
+type Server struct {
+	url     *url.URL
+	secret  string
+	cfg     config.Config
+	updates chan tgbotapi.Update
+	server  *http.Server
+	logger  *zap.Logger
+}
+
+func New(cfg config.Config, logger *zap.Logger) (*Server, error) {
+	if cfg.WebhookURL == "" {
+		return nil, fmt.Errorf("webhook: WEBHOOK_URL is required in webhook mode")
+	}
+	u, err := url.Parse(cfg.WebhookURL)
+	if err != nil {
+		return nil, fmt.Errorf("webhook: invalid WEBHOOK_URL: %w", err)
+	}
+	if u.Scheme != "https" {
+		return nil, fmt.Errorf("webhook: WEBHOOK_URL must be https, got %q", u.Scheme)
+	}
+	if !secretPattern.MatchString(cfg.WebhookSecret) {
+		return nil, fmt.Errorf("webhook: WEBHOOK_SECRET must be 1-256 characters of A-Z, a-z, 0-9, _ and -")
+	}
+	if (cfg.WebhookCert == "") != (cfg.WebhookKey == "") {
+		return nil, fmt.Errorf("webhook: WEBHOOK_CERT and WEBHOOK_KEY must be set together")
+	}
+	if cfg.WebhookSelfSigned && cfg.WebhookCert == "" {
+		return nil, fmt.Errorf("webhook: WEBHOOK_SELF_SIGNED requires WEBHOOK_CERT")
+	}
+
+	path := u.Path
+	if path == "" {
+		path = "/"
+	}
+
+	s := &Server{
+		url:     u,
+		secret:  cfg.WebhookSecret,
+		cfg:     cfg,
+		updates: make(chan tgbotapi.Update, bufferSize),
+		logger:  logger,
+	}
+
+	// Отдельный ServeMux, чтобы не обрабатывать запросы на глобальном
+	mux := http.NewServeMux()
+	mux.Handle(path, s)
+	s.server = &http.Server{
+		Addr:              cfg.WebhookListen,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	return s, nil
+}
+
+// Updates - канал принятых обновлений
+func (s *Server) Updates() tgbotapi.UpdatesChannel {
+	return s.updates
+}
+
+// ServeHTTP проверяет секрет и кладет обновление в буфер; ответ 200 подтверждает
+// Telegram прием, а не обработку обновления
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	token := r.Header.Get(SecretHeader)
+	if subtle.ConstantTimeCompare([]byte(token), []byte(s.secret)) != 1 {
+		s.logger.Warn("webhook request with invalid secret token", zap.String("remote", r.RemoteAddr))
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return
+	}
+
+	var update tgbotapi.Update
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+
+	select {
+	case s.updates <- update:
+		w.WriteHeader(http.StatusOK)
+	default:
+		// Буфер заполнен: обновление не принимается, Telegram повторит доставку позже
+		http.Error(w, "busy", http.StatusServiceUnavailable)
+	}
+}
+
+// Register сообщает Telegram адрес и секрет вебхука
+func (s *Server) Register(bot *tgbotapi.BotAPI) error {
+	params := tgbotapi.Params{}
+	params["url"] = s.url.String()
+	params["secret_token"] = s.secret
+	params.AddNonZero("max_connections", s.cfg.WebhookMaxConnections)
+
+	var err error
+	if s.cfg.WebhookSelfSigned {
+		// Самоподписанный сертификат Telegram должен получить заранее
+		_, err = bot.UploadFiles("setWebhook", params, []tgbotapi.RequestFile{{
+			Name: "certificate",
+			Data: tgbotapi.FilePath(s.cfg.WebhookCert),
+		}})
+	} else {
+		_, err = bot.MakeRequest("setWebhook", params)
+	}
+	if err != nil {
+		return fmt.Errorf("webhook: setWebhook: %w", err)
+	}
+
+	info, err := bot.GetWebhookInfo()
+	if err != nil {
+		return fmt.Errorf("webhook: getWebhookInfo: %w", err)
+	}
+	s.logger.Info("webhook registered",
+		zap.String("url", info.URL),
+		zap.Int("pending", info.PendingUpdateCount),
+		zap.String("last_error", info.LastErrorMessage))
+	return nil
+}
+
+// Listen принимает запросы до остановки сервера.
+// С сертификатом и ключом сервер сам завершает TLS, иначе ожидается обратный прокси.
+func (s *Server) Listen() error {
+	var err error
+	if s.cfg.WebhookCert != "" {
+		err = s.server.ListenAndServeTLS(s.cfg.WebhookCert, s.cfg.WebhookKey)
+	} else {
+		err = s.server.ListenAndServe()
+	}
+	if err == http.ErrServerClosed {
+		return nil
+	}
+	return err
+}
+
+// Shutdown останавливает прием запросов, дожидается текущих и закрывает канал обновлений
+func (s *Server) Shutdown(ctx context.Context) error {
+	if err := s.server.Shutdown(ctx); err != nil {
+		return err
+	}
+	close(s.updates)
+	return nil
+}
+
+// DeleteWebhook снимает вебхук, чтобы бот мог получать обновления опросом
+func DeleteWebhook(bot *tgbotapi.BotAPI) error {
+	if _, err := bot.Request(tgbotapi.DeleteWebhookConfig{}); err != nil {
+		return fmt.Errorf("webhook: deleteWebhook: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package webhook
+
+import (
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"go.uber.org/zap"
+	"hospital/internal/modules/config"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func testConfig() config.Config {
+	return config.Config{
+		WebhookURL:    "https://bot.example.com/telegram",
+		WebhookListen: "127.0.0.1:0",
+		WebhookSecret: "s3cret_token-1",
+	}
+}
+
+func TestNew(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		modify  func(cfg *config.Config)
+		wantErr bool
+	}{
+		{name: "Reverse proxy", modify: func(cfg *config.Config) {}},
+		{name: "Own TLS", modify: func(cfg *config.Config) { cfg.WebhookCert, cfg.WebhookKey = "cert.pem", "key.pem" }},
+		{name: "Missing URL", modify: func(cfg *config.Config) { cfg.WebhookURL = "" }, wantErr: true},
+		{name: "Plain HTTP URL", modify: func(cfg *config.Config) { cfg.WebhookURL = "http://bot.example.com" }, wantErr: true},
+		{name: "Missing secret", modify: func(cfg *config.Config) { cfg.WebhookSecret = "" }, wantErr: true},
+		{name: "Secret with forbidden characters", modify: func(cfg *config.Config) { cfg.WebhookSecret = "a b" }, wantErr: true},
+		{name: "Certificate without key", modify: func(cfg *config.Config) { cfg.WebhookCert = "cert.pem" }, wantErr: true},
+		{name: "Self-signed without certificate", modify: func(cfg *config.Config) { cfg.WebhookSelfSigned = true }, wantErr: true},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			cfg := testConfig()
+			tt.modify(&cfg)
+			_, err := New(cfg, zap.NewNop())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestServer_ServeHTTP(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		method     string
+		secret     string
+		body       string
+		wantStatus int
+		wantUpdate bool
+	}{
+		{
+			name:       "Update is accepted",
+			method:     http.MethodPost,
+			secret:     "s3cret_token-1",
+			body:       `{"update_id": 7, "message": {"message_id": 1, "text": "/help", "chat": {"id": 42}}}`,
+			wantStatus: http.StatusOK,
+			wantUpdate: true,
+		},
+		{name: "Wrong secret", method: http.MethodPost, secret: "other", body: `{"update_id": 7}`, wantStatus: http.StatusForbidden},
+		{name: "Missing secret", method: http.MethodPost, body: `{"update_id": 7}`, wantStatus: http.StatusForbidden},
+		{name: "Wrong method", method: http.MethodGet, secret: "s3cret_token-1", wantStatus: http.StatusMethodNotAllowed},
+		{name: "Malformed body", method: http.MethodPost, secret: "s3cret_token-1", body: `{`, wantStatus: http.StatusBadRequest},
+	} {
+		runner.Run(t, tt.name, func(t provider.T) {
+			s, err := New(testConfig(), zap.NewNop())
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+
+			r := httptest.NewRequest(tt.method, "/telegram", strings.NewReader(tt.body))
+			if tt.secret != "" {
+				r.Header.Set(SecretHeader, tt.secret)
+			}
+			w := httptest.NewRecorder()
+			s.server.Handler.ServeHTTP(w, r)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			select {
+			case u := <-s.Updates():
+				if !tt.wantUpdate {
+					t.Errorf("unexpected update %d", u.UpdateID)
+				} else if u.UpdateID != 7 || u.Message.Text != "/help" || u.Message.Chat.ID != 42 {
+					t.Errorf("update = %+v", u)
+				}
+			default:
+				if tt.wantUpdate {
+					t.Errorf("update was not delivered")
+				}
+			}
+		})
+	}
+}
+
+func TestServer_ServeHTTP_Busy(t *testing.T) {
+	runner.Run(t, "Full buffer is not acknowledged", func(t provider.T) {
+		s, err := New(testConfig(), zap.NewNop())
+		if err != nil {
+			t.Fatalf("New() error = %v", err)
+		}
+
+		post := func() int {
+			r := httptest.NewRequest(http.MethodPost, "/telegram", strings.NewReader(`{"update_id": 7}`))
+			r.Header.Set(SecretHeader, "s3cret_token-1")
+			w := httptest.NewRecorder()
+			s.server.Handler.ServeHTTP(w, r)
+			return w.Code
+		}
+		for i := 0; i < bufferSize; i++ {
+			if code := post(); code != http.StatusOK {
+				t.Fatalf("status = %d, want %d", code, http.StatusOK)
+			}
+		}
+		if code := post(); code != http.StatusServiceUnavailable {
+			t.Errorf("status = %d, want %d", code, http.StatusServiceUnavailable)
+		}
+	})
+}
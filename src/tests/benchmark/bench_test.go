@@ -1,10 +1,12 @@
 package benchamark
 
 import (
+	"context"
 	"hospital/internal/modules/db"
 	"hospital/internal/modules/db/ent"
 	"hospital/internal/modules/view/telegram"
 	"hospital/internal/modules/view/telegram/controllers"
+	"hospital/internal/modules/view/telegram/dialog"
 	"testing"
 )
 
@@ -13,17 +15,14 @@ func AddDoctorBenchmark(t *testing.B, client *ent.Client, controller *controller
 	if err != nil {
 		return
 	}
-	user := telegram.UsersMessage{
-		ChatId:       1,
-		UserMessages: []string{"Kovel", "Психотерапевт", "Глав врач"},
-	}
+	values := dialog.Values{"surname": "Kovel", "speciality": "Психотерапевт", "role": "Врач"}
 
 	for i := 0; i < t.N; i++ {
-		_ = telegram.EndSingUp(&user, int64(i), controller)
+		_ = telegram.EndSingUp(context.Background(), values, int64(i), controller)
 	}
 
 	for i := 0; i < t.N; i++ {
-		_ = telegram.GetInfoAboutDoctor(int64(i), controller)
+		_ = telegram.GetInfoAboutDoctor(context.Background(), int64(i), controller)
 	}
 
 }
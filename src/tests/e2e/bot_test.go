@@ -0,0 +1,116 @@
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/fx"
+	"hospital/internal/models/role"
+	"hospital/internal/modules/config"
+	"hospital/internal/modules/db"
+	"hospital/internal/modules/db/ent"
+	"hospital/internal/modules/view/telegram"
+	"hospital/internal/modules/view/telegram/telegramtest"
+	"testing"
+	"time"
+)
+
+const replyTimeout = 10 * time.Second
+
+// TestBot ведет диалоги с ботом через цикл обновлений и локальный сервер Bot API
+func TestBot(t *testing.T) {
+	server := telegramtest.NewServer()
+	defer server.Close()
+
+	fx.New(
+		testModule,
+		testInvokables,
+		telegram.Invokables,
+
+		fx.Decorate(func(cfg config.Config) config.Config {
+			cfg.TelegramMode = "polling"
+			cfg.TelegramToken = telegramtest.Token
+			cfg.TelegramAPIEndpoint = server.Endpoint()
+			return cfg
+		}),
+		fx.Supply(t, server),
+		fx.Invoke(execBotTests),
+	).Run()
+}
+
+func execBotTests(
+	t *testing.T,
+	server *telegramtest.Server,
+	client *ent.Client,
+	lifecycle fx.Lifecycle,
+	shutdowner fx.Shutdowner,
+) {
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			go func() {
+				if assert.NoError(t, db.TruncateAll(client)) {
+					botConversationTest(t, server)
+				}
+
+				_ = shutdowner.Shutdown()
+			}()
+
+			return nil
+		},
+	})
+}
+
+func botConversationTest(t *testing.T, server *telegramtest.Server) {
+	var chatId int64 = 2
+
+	say := func(text string) telegramtest.Message {
+		reply, err := server.Say(chatId, text, replyTimeout)
+		assert.NoError(t, err, "reply to %q", text)
+		return reply
+	}
+
+	reply := say("/start")
+	assert.Equal(t, "Здравствуйте! Войдите или зарегистрируйтесь кнопками меню. Список команд: /help", reply.Text)
+
+	reply = say("/patients")
+	assert.Equal(t, "Войдите в систему: нажмите Войти или Зарегестрироваться", reply.Text)
+
+	// Регистрация - диалог из нескольких шагов с выбором роли кнопкой
+	assert.Equal(t, "Введите свою фамилию", say("Зарегестрироваться").Text)
+	assert.Equal(t, "Введите свою специальность", say("Kovel").Text)
+
+	reply = say("Психотерапевт")
+	assert.Equal(t, "Выберите свою роль", reply.Text)
+	// Главврача и администратора назначает администратор клиники, при регистрации их не выбрать
+	if assert.NotNil(t, reply.Keyboard) {
+		var buttons []string
+		for _, row := range reply.Keyboard.InlineKeyboard {
+			for _, b := range row {
+				buttons = append(buttons, b.Text)
+			}
+		}
+		assert.Contains(t, buttons, role.Nurse)
+		assert.NotContains(t, buttons, role.HeadPhysician)
+		assert.NotContains(t, buttons, role.Admin)
+	}
+	reply, err := server.Click(reply, role.Doctor, replyTimeout)
+	assert.NoError(t, err)
+	assert.Equal(t, "Выбрано: "+role.Doctor+"\nЗарегистрирован", reply.Text)
+
+	messages := server.Messages(chatId)
+	assert.Nil(t, messages[len(messages)-2].Keyboard, "role keyboard must be removed after the choice")
+
+	reply = say("Просмотреть данные о себе")
+	assert.Equal(t, fmt.Sprintf("Фамилия: %s \nСпециальность: %s \nРоль: %s \n",
+		"Kovel", "Психотерапевт", role.Doctor), reply.Text)
+
+	reply = say("/help")
+	assert.NotContains(t, reply.Text, "/anomalies")
+
+	// Команда отмены прерывает начатый диалог
+	assert.Equal(t, "Введите номер палаты", say("Найти палату по номеру").Text)
+	assert.Equal(t, "Действие отменено", say("/cancel").Text)
+	assert.Equal(t, "Команда не найдена, напишите Помощь", say("12").Text)
+
+	assert.NotEmpty(t, server.Calls("setMyCommands"), "command menu must be published")
+}
@@ -1,6 +1,7 @@
 package e2e
 
 import (
+	"context"
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	"hospital/internal/modules/db"
@@ -8,30 +9,28 @@ import (
 	auth_dto "hospital/internal/modules/domain/auth/dto"
 	telegram "hospital/internal/modules/view/telegram"
 	"hospital/internal/modules/view/telegram/controllers"
+	"hospital/internal/modules/view/telegram/dialog"
 	"testing"
 )
 
 func telegramServiceTest(t *testing.T, client *ent.Client, controller *controllers.Controller) {
-	err := db.TruncateAll(client)
-	if err != nil {
+	if !assert.NoError(t, db.TruncateAll(client)) {
 		return
 	}
-	user := telegram.UsersMessage{
-		ChatId:       1,
-		UserMessages: []string{"Kovel", "Психотерапевт", "Глав врач"},
-	}
+	var chatId int64 = 1
+	values := dialog.Values{"surname": "Kovel", "speciality": "Психотерапевт", "role": "Врач"}
 
 	newUser := &auth_dto.NewDoctor{
 		TokenId:    "1",
 		Surname:    "Kovel",
 		Speciality: "Психотерапевт",
-		Role:       "Глав врач",
+		Role:       "Врач",
 	}
 
-	reply := telegram.EndSingUp(&user, user.ChatId, controller)
+	reply := telegram.EndSingUp(context.Background(), values, chatId, controller)
 	assert.Equal(t, reply, "Зарегистрирован")
 
-	reply = telegram.GetInfoAboutDoctor(user.ChatId, controller)
+	reply = telegram.GetInfoAboutDoctor(context.Background(), chatId, controller)
 	excepted := fmt.Sprintf("Фамилия: %s \nСпециальность: %s \nРоль: %s \n",
 		newUser.Surname, newUser.Speciality, newUser.Role)
 
@@ -19,7 +19,7 @@ func doctorServiceTest(t *testing.T, DoctorService *doctor_server.DoctorService,
 		TokenId:    "1",
 		Surname:    "Kovel",
 		Speciality: "Психотерапевт",
-		Role:       "Глав врач",
+		Role:       "Врач",
 	}
 	currentDoctor, err := authService.SignUp(context.Background(), newDoctor)
 	assert.NoError(t, err)
@@ -43,7 +43,6 @@ func doctorServiceTest(t *testing.T, DoctorService *doctor_server.DoctorService,
 	updateDoctor := &dto.UpdateDoctor{
 		Surname:    currentDoctor.Surname,
 		Speciality: currentDoctor.Speciality,
-		Role:       currentDoctor.Role,
 		TokenId:    currentDoctor.TokenId,
 	}
 	u2, err := DoctorService.Update(ctx, currentDoctor.Id, updateDoctor)
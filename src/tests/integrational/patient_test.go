@@ -22,7 +22,7 @@ func patientServiceTest(t *testing.T, service *service.PatientService, authServi
 		TokenId:    "1",
 		Surname:    "Kovel",
 		Speciality: "Психотерапевт",
-		Role:       "Глав врач",
+		Role:       "Врач",
 	}
 	currentUser, err := authService.SignUp(context.Background(), newUser)
 	assert.NoError(t, err)
@@ -54,7 +54,7 @@ func patientServiceTest(t *testing.T, service *service.PatientService, authServi
 		Height:         183,
 		Weight:         80,
 		RoomNumber:     room.Id,
-		DegreeOfDanger: 10,
+		DegreeOfDanger: 5,
 	}
 	patient, err := service.Create(ctx, newpatient)
 	assert.NoError(t, err)
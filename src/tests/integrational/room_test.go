@@ -18,7 +18,7 @@ func roomServiceTest(t *testing.T, service *service.RoomService, authService *au
 		TokenId:    "1",
 		Surname:    "Kovel",
 		Speciality: "Психотерапевт",
-		Role:       "Глав врач",
+		Role:       "Врач",
 	}
 	currentUser, err := authService.SignUp(context.Background(), newUser)
 	assert.NoError(t, err)
@@ -43,7 +43,7 @@ func roomServiceTest(t *testing.T, service *service.RoomService, authService *au
 		return
 	}
 
-	t1, err := service.GetByNum(ctx, room.Id)
+	t1, err := service.GetById(ctx, room.Id)
 	assert.NoError(t, err)
 	assert.Equal(t, room, t1)
 
@@ -66,7 +66,7 @@ func roomServiceTest(t *testing.T, service *service.RoomService, authService *au
 	err = service.Delete(ctx, room.Id)
 	assert.NoError(t, err)
 
-	_, err = service.GetByNum(ctx, room.Id)
+	_, err = service.GetById(ctx, room.Id)
 	assert.Error(t, err)
 
 	ts2, err := service.List(ctx)
@@ -4,33 +4,39 @@ import (
 	"context"
 	"github.com/google/uuid"
 	"hospital/internal/models/session"
+	"hospital/internal/modules/db"
 	"hospital/internal/modules/db/ent"
 	"hospital/internal/modules/domain/doctor/dto"
 )
 
+// makeCtxByUser кладет в контекст сессию врача. Тесты проверяют сервисы, а не правила доступа,
+// поэтому проверки прав и клиники отключены.
 func makeCtxByUser(doctor *dto.Doctor) context.Context {
 	ss := session.Session{
 		SessionID: uuid.NewString(),
 		UserId:    doctor.Id,
+		Role:      doctor.Role,
 	}
 
-	ctx := context.Background()
+	ctx := db.SystemContext(context.Background())
 	return session.SetSessionToCtx(ctx, ss)
 }
 
 func truncateAll(client *ent.Client) error {
-	_, err := client.Patient.Delete().Exec(context.Background())
+	ctx := db.SystemContext(context.Background())
+
+	_, err := client.Patient.Delete().Exec(ctx)
 	if err != nil {
 		return err
 	}
 
-	_, err = client.Doctor.Delete().Exec(context.Background())
+	_, err = client.Doctor.Delete().Exec(ctx)
 	if err != nil {
 		return err
 	}
 
-	_, err = client.Room.Delete().Exec(context.Background())
-	_, err = client.Disease.Delete().Exec(context.Background())
+	_, err = client.Room.Delete().Exec(ctx)
+	_, err = client.Disease.Delete().Exec(ctx)
 
 	if err != nil {
 		return err